@@ -0,0 +1,268 @@
+// Spec: docs/specs/006-idempotency-keys.md
+
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// MetadataKey is the gRPC metadata header carrying the client's key
+	MetadataKey = "idempotency-key"
+
+	// ReplayedMetadataKey is set on the response header of a replayed call
+	ReplayedMetadataKey = "idempotent-replayed"
+
+	// MaxKeyLength is the longest idempotency key accepted
+	MaxKeyLength = 255
+
+	// CallerMetadataKey is the gRPC metadata header carrying the caller
+	// identity. Keys are scoped to the caller that sent them.
+	CallerMetadataKey = "x-user-id"
+
+	// AnonymousCaller is the scope of keys sent without a caller identity
+	AnonymousCaller = "anonymous"
+
+	// PendingTimeout is how long a request may hold its key before the
+	// outcome of the request is treated as unknown
+	PendingTimeout = 15 * time.Minute
+)
+
+// Record statuses
+const (
+	StatusPending   = "PENDING"
+	StatusCompleted = "COMPLETED"
+)
+
+// Record is a stored idempotency key and the result of its request
+// Spec: docs/specs/006-idempotency-keys.md#stored-records
+type Record struct {
+	Caller      string
+	Key         string
+	Method      string
+	RequestHash string
+	Status      string
+	Response    []byte // anypb.Any of the response message
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// Store persists idempotency records. Each service implements it on its
+// own database.
+// Spec: docs/specs/006-idempotency-keys.md#store-interface
+type Store interface {
+	// Reserve claims rec.Key of rec.Caller for a new request. If the key
+	// is held by a PENDING record, or by a record that has not expired,
+	// that record is returned and nothing is written. PENDING records never
+	// expire, since the request that wrote one may have been applied.
+	Reserve(ctx context.Context, rec *Record) (*Record, error)
+
+	// Complete stores the response of a reserved request
+	Complete(ctx context.Context, rec *Record) error
+
+	// Release removes the reservation of a request that failed, so the
+	// client can retry it with the same key
+	Release(ctx context.Context, rec *Record) error
+}
+
+// Interceptor makes mutating RPCs idempotent for clients that send an
+// idempotency-key header
+// Spec: docs/specs/006-idempotency-keys.md
+type Interceptor struct {
+	store   Store
+	ttl     time.Duration
+	methods map[string]bool
+}
+
+// NewInterceptor creates an interceptor for the given full method names.
+// Keys expire ttl after the first request that used them.
+func NewInterceptor(store Store, ttl time.Duration, methods ...string) *Interceptor {
+	i := &Interceptor{
+		store:   store,
+		ttl:     ttl,
+		methods: make(map[string]bool, len(methods)),
+	}
+	for _, method := range methods {
+		i.methods[method] = true
+	}
+	return i
+}
+
+// Unary returns the gRPC unary server interceptor
+// Spec: docs/specs/006-idempotency-keys.md#request-flow
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !i.methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		key, err := KeyFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		msg, ok := req.(proto.Message)
+		if key == "" || !ok {
+			return handler(ctx, req)
+		}
+
+		hash, err := RequestHash(info.FullMethod, msg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to hash request: %v", err)
+		}
+
+		now := time.Now().UTC()
+		rec := &Record{
+			Caller:      CallerFromContext(ctx),
+			Key:         key,
+			Method:      info.FullMethod,
+			RequestHash: hash,
+			Status:      StatusPending,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(i.ttl),
+		}
+
+		existing, err := i.store.Reserve(ctx, rec)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return replay(ctx, existing, rec)
+		}
+
+		// The outcome is stored even if the client has gone away, so its
+		// retry finds it
+		storeCtx := context.WithoutCancel(ctx)
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := i.store.Release(storeCtx, rec); releaseErr != nil {
+				log.Printf("Warning: failed to release idempotency key %q: %v", key, releaseErr)
+			}
+			return nil, err
+		}
+
+		rec.Response, err = marshalResponse(resp)
+		if err != nil {
+			log.Printf("Warning: failed to encode response for idempotency key %q: %v", key, err)
+			return resp, nil
+		}
+		// A record left PENDING by a failure here is never replaced, so
+		// retries cannot apply the request a second time
+		rec.Status = StatusCompleted
+		if err := i.store.Complete(storeCtx, rec); err != nil {
+			log.Printf("Warning: failed to store response for idempotency key %q: %v", key, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// KeyFromContext returns the idempotency key sent by the client, or ""
+func KeyFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	for _, key := range md.Get(MetadataKey) {
+		if key == "" {
+			continue
+		}
+		if len(key) > MaxKeyLength {
+			return "", status.Errorf(codes.InvalidArgument, "%s must be %d characters or less", MetadataKey, MaxKeyLength)
+		}
+		return key, nil
+	}
+	return "", nil
+}
+
+// CallerFromContext returns the caller identity sent by the client, or
+// AnonymousCaller
+func CallerFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return AnonymousCaller
+	}
+	for _, caller := range md.Get(CallerMetadataKey) {
+		if caller != "" {
+			return caller
+		}
+	}
+	return AnonymousCaller
+}
+
+// RequestHash returns the SHA-256 of the method and the deterministic
+// encoding of the request
+func RequestHash(method string, req proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// replay returns the stored outcome of a request made with the same key
+// Spec: docs/specs/006-idempotency-keys.md#request-flow
+func replay(ctx context.Context, existing, rec *Record) (interface{}, error) {
+	if existing.RequestHash != rec.RequestHash {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"%s %q was already used with a different request", MetadataKey, rec.Key)
+	}
+	if existing.Status != StatusCompleted {
+		// The request crashed or failed to store its response, and may
+		// have been applied. It is never run again under this key.
+		if rec.CreatedAt.Sub(existing.CreatedAt) > PendingTimeout {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"outcome of request with %s %q is unknown, check its result before retrying with a new key", MetadataKey, rec.Key)
+		}
+		return nil, status.Errorf(codes.Aborted,
+			"request with %s %q is still in progress", MetadataKey, rec.Key)
+	}
+
+	resp, err := unmarshalResponse(existing.Response)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode stored response: %v", err)
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(ReplayedMetadataKey, "true")); err != nil {
+		log.Printf("Warning: failed to set %s header: %v", ReplayedMetadataKey, err)
+	}
+	return resp, nil
+}
+
+// marshalResponse encodes a response with its type so it can be replayed
+func marshalResponse(resp interface{}) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "response %T is not a proto message", resp)
+	}
+	wrapped, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(wrapped)
+}
+
+// unmarshalResponse decodes a response stored by marshalResponse
+func unmarshalResponse(data []byte) (proto.Message, error) {
+	wrapped := &anypb.Any{}
+	if err := proto.Unmarshal(data, wrapped); err != nil {
+		return nil, err
+	}
+	return wrapped.UnmarshalNew()
+}
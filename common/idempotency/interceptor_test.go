@@ -0,0 +1,268 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testMethod = "/test.Service/Create"
+
+// memoryStore is an in-memory Store for tests
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: make(map[string]*Record)}
+}
+
+// recordKey returns the map key of a caller's idempotency key
+func recordKey(caller, key string) string {
+	return caller + "/" + key
+}
+
+func (s *memoryStore) Reserve(ctx context.Context, rec *Record) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.records[recordKey(rec.Caller, rec.Key)]
+	if ok && (existing.Status == StatusPending || existing.ExpiresAt.After(rec.CreatedAt)) {
+		return existing, nil
+	}
+	stored := *rec
+	s.records[recordKey(rec.Caller, rec.Key)] = &stored
+	return nil, nil
+}
+
+func (s *memoryStore) Complete(ctx context.Context, rec *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := *rec
+	s.records[recordKey(rec.Caller, rec.Key)] = &stored
+	return nil
+}
+
+func (s *memoryStore) Release(ctx context.Context, rec *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, recordKey(rec.Caller, rec.Key))
+	return nil
+}
+
+// countingHandler returns a handler that echoes the request with a call count
+func countingHandler(calls *int, err error) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		*calls++
+		if err != nil {
+			return nil, err
+		}
+		return wrapperspb.String(req.(*wrapperspb.StringValue).Value + "-created"), nil
+	}
+}
+
+func withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, key))
+}
+
+func withCallerKey(caller, key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(CallerMetadataKey, caller, MetadataKey, key))
+}
+
+// TestInterceptorReplay tests that a retried request returns the original
+// response without running the handler again
+// Spec: docs/specs/006-idempotency-keys.md#request-flow
+func TestInterceptorReplay(t *testing.T) {
+	interceptor := NewInterceptor(newMemoryStore(), time.Hour, testMethod).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	calls := 0
+
+	first, err := interceptor(withKey("key-1"), wrapperspb.String("usd"), info, countingHandler(&calls, nil))
+	if err != nil {
+		t.Fatalf("first call error = %v", err)
+	}
+	second, err := interceptor(withKey("key-1"), wrapperspb.String("usd"), info, countingHandler(&calls, nil))
+	if err != nil {
+		t.Fatalf("replay error = %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("handler called %d times, want 1", calls)
+	}
+	if !proto.Equal(first.(proto.Message), second.(proto.Message)) {
+		t.Errorf("replay = %v, want %v", second, first)
+	}
+}
+
+// TestInterceptorRejects tests requests that must not run the handler
+// Spec: docs/specs/006-idempotency-keys.md#error-handling
+func TestInterceptorRejects(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+	t.Run("different request under the same key", func(t *testing.T) {
+		interceptor := NewInterceptor(newMemoryStore(), time.Hour, testMethod).Unary()
+		calls := 0
+		if _, err := interceptor(withKey("key-1"), wrapperspb.String("usd"), info, countingHandler(&calls, nil)); err != nil {
+			t.Fatalf("first call error = %v", err)
+		}
+
+		_, err := interceptor(withKey("key-1"), wrapperspb.String("eur"), info, countingHandler(&calls, nil))
+
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("error = %v, want InvalidArgument", err)
+		}
+		if calls != 1 {
+			t.Errorf("handler called %d times, want 1", calls)
+		}
+	})
+
+	t.Run("request still in progress", func(t *testing.T) {
+		store := newMemoryStore()
+		interceptor := NewInterceptor(store, time.Hour, testMethod).Unary()
+		hash, _ := RequestHash(testMethod, wrapperspb.String("usd"))
+		store.records[recordKey(AnonymousCaller, "key-1")] = &Record{Key: "key-1", RequestHash: hash, Status: StatusPending,
+			CreatedAt: time.Now(), ExpiresAt: time.Now().Add(time.Hour)}
+		calls := 0
+
+		_, err := interceptor(withKey("key-1"), wrapperspb.String("usd"), info, countingHandler(&calls, nil))
+
+		if status.Code(err) != codes.Aborted {
+			t.Errorf("error = %v, want Aborted", err)
+		}
+		if calls != 0 {
+			t.Errorf("handler called %d times, want 0", calls)
+		}
+	})
+
+	t.Run("request left pending", func(t *testing.T) {
+		store := newMemoryStore()
+		interceptor := NewInterceptor(store, time.Hour, testMethod).Unary()
+		hash, _ := RequestHash(testMethod, wrapperspb.String("usd"))
+		// Crashed or failed to store its response, and has since expired
+		created := time.Now().Add(-2 * time.Hour)
+		store.records[recordKey(AnonymousCaller, "key-1")] = &Record{Key: "key-1", RequestHash: hash, Status: StatusPending,
+			CreatedAt: created, ExpiresAt: created.Add(time.Hour)}
+		calls := 0
+
+		_, err := interceptor(withKey("key-1"), wrapperspb.String("usd"), info, countingHandler(&calls, nil))
+
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("error = %v, want FailedPrecondition", err)
+		}
+		if calls != 0 {
+			t.Errorf("handler called %d times, want 0", calls)
+		}
+	})
+
+	t.Run("key too long", func(t *testing.T) {
+		interceptor := NewInterceptor(newMemoryStore(), time.Hour, testMethod).Unary()
+		calls := 0
+		key := string(make([]byte, MaxKeyLength+1))
+
+		_, err := interceptor(withKey(key), wrapperspb.String("usd"), info, countingHandler(&calls, nil))
+
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("error = %v, want InvalidArgument", err)
+		}
+	})
+}
+
+// TestInterceptorPassThrough tests calls that are not made idempotent
+func TestInterceptorPassThrough(t *testing.T) {
+	store := newMemoryStore()
+	interceptor := NewInterceptor(store, time.Hour, testMethod).Unary()
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+	}{
+		{"no key", context.Background(), testMethod},
+		{"read-only method", withKey("key-1"), "/test.Service/Get"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			handler := countingHandler(&calls, nil)
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+
+			for n := 0; n < 2; n++ {
+				if _, err := interceptor(tt.ctx, wrapperspb.String("usd"), info, handler); err != nil {
+					t.Fatalf("call error = %v", err)
+				}
+			}
+			if calls != 2 {
+				t.Errorf("handler called %d times, want 2", calls)
+			}
+			if len(store.records) != 0 {
+				t.Errorf("stored %d records, want 0", len(store.records))
+			}
+		})
+	}
+}
+
+// TestInterceptorReleasesFailedRequests tests that a failed request can be
+// retried with the same key
+func TestInterceptorReleasesFailedRequests(t *testing.T) {
+	interceptor := NewInterceptor(newMemoryStore(), time.Hour, testMethod).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	calls := 0
+
+	_, err := interceptor(withKey("key-1"), wrapperspb.String("usd"), info,
+		countingHandler(&calls, status.Error(codes.Unavailable, "database unavailable")))
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("error = %v, want Unavailable", err)
+	}
+
+	if _, err := interceptor(withKey("key-1"), wrapperspb.String("usd"), info, countingHandler(&calls, nil)); err != nil {
+		t.Fatalf("retry error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("handler called %d times, want 2", calls)
+	}
+}
+
+// TestInterceptorExpiredKey tests that an expired key can be reused
+func TestInterceptorExpiredKey(t *testing.T) {
+	store := newMemoryStore()
+	interceptor := NewInterceptor(store, time.Hour, testMethod).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	store.records[recordKey(AnonymousCaller, "key-1")] = &Record{Key: "key-1", RequestHash: "other", Status: StatusCompleted, ExpiresAt: time.Now().Add(-time.Minute)}
+	calls := 0
+
+	if _, err := interceptor(withKey("key-1"), wrapperspb.String("usd"), info, countingHandler(&calls, nil)); err != nil {
+		t.Fatalf("call error = %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("handler called %d times, want 1", calls)
+	}
+}
+
+// TestInterceptorScopesKeysByCaller tests that callers cannot replay each
+// other's responses
+// Spec: docs/specs/006-idempotency-keys.md#caller-scope
+func TestInterceptorScopesKeysByCaller(t *testing.T) {
+	interceptor := NewInterceptor(newMemoryStore(), time.Hour, testMethod).Unary()
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	calls := 0
+
+	if _, err := interceptor(withCallerKey("alice", "key-1"), wrapperspb.String("usd"), info, countingHandler(&calls, nil)); err != nil {
+		t.Fatalf("first caller error = %v", err)
+	}
+	if _, err := interceptor(withCallerKey("bob", "key-1"), wrapperspb.String("eur"), info, countingHandler(&calls, nil)); err != nil {
+		t.Fatalf("second caller error = %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("handler called %d times, want 2", calls)
+	}
+}
@@ -0,0 +1,56 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Symbol positions, as stored in treasury.currencies.symbol_position
+const (
+	SymbolBefore = "before"
+	SymbolAfter  = "after"
+)
+
+// Currency is the part of a treasury currency that arithmetic and display
+// need
+// Spec: docs/specs/007-money.md#currencies
+type Currency struct {
+	Code           string // ISO 4217 code
+	MinorUnits     int32  // Decimal places of the currency, e.g. 2 for USD
+	Symbol         string // Display symbol, e.g. $; the code is shown when empty
+	SymbolPosition string // SymbolBefore or SymbolAfter
+}
+
+// Format returns the amount for display in currency c: rounded half to
+// even to the minor units, digits grouped in thousands, and the symbol
+// placed by its position, e.g. "$1,250.75", "-$0.50" or "1,250 kr". The
+// code is used when the currency has no symbol.
+// Spec: docs/specs/007-money.md#formatting
+func (m Money) Format(c Currency) (string, error) {
+	rounded, err := m.RoundToCurrency(c, HalfEven)
+	if err != nil {
+		return "", err
+	}
+
+	amount := rounded.Abs().Amount()
+	whole, frac, hasPoint := strings.Cut(amount, ".")
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+	if hasPoint {
+		whole += "." + frac
+	}
+
+	sign := ""
+	if rounded.Sign() < 0 {
+		sign = "-"
+	}
+	switch {
+	case c.Symbol == "":
+		return fmt.Sprintf("%s%s %s", sign, whole, c.Code), nil
+	case c.SymbolPosition == SymbolAfter:
+		return fmt.Sprintf("%s%s %s", sign, whole, c.Symbol), nil
+	default:
+		return sign + c.Symbol + whole, nil
+	}
+}
@@ -0,0 +1,298 @@
+// Spec: docs/specs/007-money.md
+
+// Package money provides an exact decimal amount in a currency, with
+// rounding to the currency's minor units, allocation without losing units
+// and display formatting. Floating point is never used.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// MaxScale is the largest number of decimal places an amount may carry
+const MaxScale = 18
+
+var (
+	// ErrCurrencyMismatch is returned when amounts in different currencies
+	// are combined
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+
+	// ErrOutOfRange is returned when an amount does not fit the requested
+	// representation
+	ErrOutOfRange = errors.New("amount is out of range")
+)
+
+// Money is an exact decimal amount in a currency. The amount is held as an
+// integer number of units of 10^-scale. Values are immutable and the zero
+// value is 0 with no currency.
+// Spec: docs/specs/007-money.md#money-type
+type Money struct {
+	units    *big.Int
+	scale    int32
+	currency string
+}
+
+// New returns units x 10^-scale in currency, e.g. New(12507500, 4, "USD")
+// is 1250.7500 USD
+func New(units int64, scale int32, currency string) Money {
+	return Money{units: big.NewInt(units), scale: scale, currency: currency}
+}
+
+// Zero returns 0 in currency
+func Zero(currency string) Money {
+	return Money{currency: currency}
+}
+
+// Parse reads a decimal string such as "1250.75" or "-0.5" in currency.
+// The scale of the result is the number of decimal places given.
+// Exponents, grouping separators and more than MaxScale decimal places are
+// rejected.
+func Parse(amount, currency string) (Money, error) {
+	s := strings.TrimSpace(amount)
+	negative := strings.HasPrefix(s, "-")
+	if negative || strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if (whole == "" && frac == "") || (hasPoint && frac == "") || !isDigits(whole) || !isDigits(frac) {
+		return Money{}, fmt.Errorf("amount %q is not a decimal number", amount)
+	}
+	if len(frac) > MaxScale {
+		return Money{}, fmt.Errorf("amount %q has more than %d decimal places", amount, MaxScale)
+	}
+
+	units, _ := new(big.Int).SetString(whole+frac, 10)
+	if negative {
+		units.Neg(units)
+	}
+	return Money{units: units, scale: int32(len(frac)), currency: currency}, nil
+}
+
+// FromRat rounds an exact rational to scale decimal places in currency
+func FromRat(r *big.Rat, currency string, scale int32, mode RoundingMode) Money {
+	return Money{units: roundRat(r, scale, mode), scale: scale, currency: currency}
+}
+
+// Currency returns the ISO 4217 code of the amount
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// Scale returns the number of decimal places the amount carries
+func (m Money) Scale() int32 {
+	return m.scale
+}
+
+// Sign returns -1, 0 or +1 for negative, zero and positive amounts
+func (m Money) Sign() int {
+	return m.int().Sign()
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Rat returns the amount as an exact rational
+func (m Money) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(m.int(), pow10(m.scale))
+}
+
+// Units returns the amount as an integer number of units of 10^-scale,
+// the form the ledger stores. It fails rather than round when the amount
+// has more decimal places than scale.
+func (m Money) Units(scale int32) (int64, error) {
+	units, ok := m.rescale(scale)
+	if !ok {
+		return 0, fmt.Errorf("amount %s has more than %d decimal places", m.Amount(), scale)
+	}
+	if !units.IsInt64() {
+		return 0, ErrOutOfRange
+	}
+	return units.Int64(), nil
+}
+
+// Amount returns the decimal string of the amount with exactly Scale
+// decimal places, e.g. "1250.7500" or "-0.50"
+func (m Money) Amount() string {
+	units := m.int()
+	digits := new(big.Int).Abs(units).String()
+	sign := ""
+	if units.Sign() < 0 {
+		sign = "-"
+	}
+	if m.scale <= 0 {
+		return sign + digits + strings.Repeat("0", int(-m.scale))
+	}
+	if pad := int(m.scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	point := len(digits) - int(m.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// String returns the amount and currency, e.g. "1250.75 USD"
+func (m Money) String() string {
+	if m.currency == "" {
+		return m.Amount()
+	}
+	return m.Amount() + " " + m.currency
+}
+
+// Add returns m + other. The result carries the larger scale.
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	scale := max(m.scale, other.scale)
+	a, _ := m.rescale(scale)
+	b, _ := other.rescale(scale)
+	return Money{units: a.Add(a, b), scale: scale, currency: m.currency}, nil
+}
+
+// Sub returns m - other. The result carries the larger scale.
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(other.Neg())
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return Money{units: new(big.Int).Neg(m.int()), scale: m.scale, currency: m.currency}
+}
+
+// Abs returns |m|
+func (m Money) Abs() Money {
+	return Money{units: new(big.Int).Abs(m.int()), scale: m.scale, currency: m.currency}
+}
+
+// Cmp compares m and other, returning -1, 0 or +1
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return 0, err
+	}
+	return m.Rat().Cmp(other.Rat()), nil
+}
+
+// Round returns the amount rounded to scale decimal places
+// Spec: docs/specs/007-money.md#rounding
+func (m Money) Round(scale int32, mode RoundingMode) Money {
+	return FromRat(m.Rat(), m.currency, scale, mode)
+}
+
+// RoundToCurrency returns the amount rounded to the minor units of its
+// currency, e.g. 2 decimal places for USD and 0 for JPY
+// Spec: docs/specs/007-money.md#rounding
+func (m Money) RoundToCurrency(c Currency, mode RoundingMode) (Money, error) {
+	if c.Code != m.currency {
+		return Money{}, fmt.Errorf("%w: amount in %s, currency %s", ErrCurrencyMismatch, m.currency, c.Code)
+	}
+	return m.Round(c.MinorUnits, mode), nil
+}
+
+// Convert multiplies the amount by an exchange rate into currency and
+// rounds the result to scale decimal places
+// Spec: docs/specs/007-money.md#conversion
+func (m Money) Convert(rate *big.Rat, currency string, scale int32, mode RoundingMode) Money {
+	return FromRat(new(big.Rat).Mul(m.Rat(), rate), currency, scale, mode)
+}
+
+// Allocate splits the amount in the given ratios at its own scale. The
+// parts always add up to the amount: units left over after rounding each
+// part down go one at a time to the parts with the largest remainders,
+// earlier parts first on ties.
+// Spec: docs/specs/007-money.md#allocation
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("at least one ratio is required")
+	}
+	total := new(big.Int)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("ratios must not be negative")
+		}
+		total.Add(total, big.NewInt(ratio))
+	}
+	if total.Sign() == 0 {
+		return nil, fmt.Errorf("ratios must not all be zero")
+	}
+
+	amount := new(big.Int).Abs(m.int())
+	shares := make([]*big.Int, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	left := new(big.Int).Set(amount)
+	for i, ratio := range ratios {
+		product := new(big.Int).Mul(amount, big.NewInt(ratio))
+		shares[i], remainders[i] = new(big.Int).QuoRem(product, total, new(big.Int))
+		left.Sub(left, shares[i])
+	}
+
+	// Hand out the leftover units by largest remainder, stable on ties
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	for i := 1; i < len(order); i++ {
+		for j := i; j > 0 && remainders[order[j]].Cmp(remainders[order[j-1]]) > 0; j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+	for i := 0; left.Sign() > 0; i++ {
+		shares[order[i]].Add(shares[order[i]], big.NewInt(1))
+		left.Sub(left, big.NewInt(1))
+	}
+
+	parts := make([]Money, len(ratios))
+	for i, share := range shares {
+		if m.Sign() < 0 {
+			share.Neg(share)
+		}
+		parts[i] = Money{units: share, scale: m.scale, currency: m.currency}
+	}
+	return parts, nil
+}
+
+// int returns the units, treating the zero value as 0
+func (m Money) int() *big.Int {
+	if m.units == nil {
+		return new(big.Int)
+	}
+	return m.units
+}
+
+// rescale returns the units at scale and whether that is exact
+func (m Money) rescale(scale int32) (*big.Int, bool) {
+	units := new(big.Int).Set(m.int())
+	if scale >= m.scale {
+		return units.Mul(units, pow10(scale-m.scale)), true
+	}
+	quo, rem := new(big.Int).QuoRem(units, pow10(m.scale-scale), new(big.Int))
+	return quo, rem.Sign() == 0
+}
+
+// sameCurrency returns ErrCurrencyMismatch unless both amounts are in the
+// same currency
+func (m Money) sameCurrency(other Money) error {
+	if m.currency != other.currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return nil
+}
+
+// pow10 returns 10^n for n >= 0
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// isDigits reports whether s consists only of ASCII digits
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,219 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+var (
+	usd = Currency{Code: "USD", MinorUnits: 2, Symbol: "$", SymbolPosition: SymbolBefore}
+	jpy = Currency{Code: "JPY", MinorUnits: 0, Symbol: "¥", SymbolPosition: SymbolBefore}
+	sek = Currency{Code: "SEK", MinorUnits: 2, Symbol: "kr", SymbolPosition: SymbolAfter}
+	bhd = Currency{Code: "BHD", MinorUnits: 3}
+)
+
+// mustParse parses an amount or fails the test
+func mustParse(t *testing.T, amount, currency string) Money {
+	t.Helper()
+	m, err := Parse(amount, currency)
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", amount, err)
+	}
+	return m
+}
+
+// TestParse tests reading decimal strings
+// Spec: docs/specs/007-money.md#money-type
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in    string
+		want  string
+		scale int32
+	}{
+		{"1250.75", "1250.75", 2},
+		{"-0.5", "-0.5", 1},
+		{"+3", "3", 0},
+		{".25", "0.25", 2},
+		{"0.0000", "0.0000", 4},
+	}
+	for _, tt := range tests {
+		m := mustParse(t, tt.in, "USD")
+		if m.Amount() != tt.want || m.Scale() != tt.scale {
+			t.Errorf("Parse(%q) = %s scale %d, want %s scale %d", tt.in, m.Amount(), m.Scale(), tt.want, tt.scale)
+		}
+	}
+
+	for _, in := range []string{"", "-", "1.", "1e3", "1,000", "abc", "1.0000000000000000001", "-+5", "+-5", "-+0.10", "--5"} {
+		if _, err := Parse(in, "USD"); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", in)
+		}
+	}
+}
+
+// TestUnits tests conversion to and from the ledger's scaled integers
+func TestUnits(t *testing.T) {
+	m := New(12507500, 4, "USD")
+	if m.Amount() != "1250.7500" {
+		t.Errorf("Amount() = %s, want 1250.7500", m.Amount())
+	}
+
+	units, err := mustParse(t, "1250.75", "USD").Units(4)
+	if err != nil || units != 12507500 {
+		t.Errorf("Units(4) = %d, %v, want 12507500", units, err)
+	}
+
+	if _, err := mustParse(t, "0.12345", "USD").Units(4); err == nil {
+		t.Error("Units(4) of 0.12345 succeeded, want error")
+	}
+	if _, err := mustParse(t, "99999999999999999999", "USD").Units(4); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Units(4) error = %v, want ErrOutOfRange", err)
+	}
+}
+
+// TestArithmetic tests adding, subtracting and comparing amounts
+func TestArithmetic(t *testing.T) {
+	a := mustParse(t, "10.5", "USD")
+	b := mustParse(t, "0.25", "USD")
+
+	sum, err := a.Add(b)
+	if err != nil || sum.Amount() != "10.75" {
+		t.Errorf("Add = %s, %v, want 10.75", sum.Amount(), err)
+	}
+	diff, err := b.Sub(a)
+	if err != nil || diff.Amount() != "-10.25" {
+		t.Errorf("Sub = %s, %v, want -10.25", diff.Amount(), err)
+	}
+	if cmp, _ := a.Cmp(b); cmp != 1 {
+		t.Errorf("Cmp = %d, want 1", cmp)
+	}
+	if !Zero("USD").IsZero() || (Money{}).Amount() != "0" {
+		t.Error("zero values are not zero")
+	}
+
+	if _, err := a.Add(mustParse(t, "1", "EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add across currencies error = %v, want ErrCurrencyMismatch", err)
+	}
+}
+
+// TestRound tests each rounding mode
+// Spec: docs/specs/007-money.md#rounding
+func TestRound(t *testing.T) {
+	tests := []struct {
+		in   string
+		mode RoundingMode
+		want string
+	}{
+		{"2.345", HalfEven, "2.34"},
+		{"2.355", HalfEven, "2.36"},
+		{"2.3451", HalfEven, "2.35"},
+		{"-2.345", HalfEven, "-2.34"},
+		{"2.345", HalfUp, "2.35"},
+		{"-2.345", HalfUp, "-2.35"},
+		{"2.344", HalfUp, "2.34"},
+		{"2.349", Down, "2.34"},
+		{"-2.349", Down, "-2.34"},
+		{"2.3", HalfEven, "2.30"},
+	}
+	for _, tt := range tests {
+		got := mustParse(t, tt.in, "USD").Round(2, tt.mode)
+		if got.Amount() != tt.want {
+			t.Errorf("Round(%s, %s) = %s, want %s", tt.in, tt.mode, got.Amount(), tt.want)
+		}
+	}
+
+	yen, err := mustParse(t, "1234.5", "JPY").RoundToCurrency(jpy, HalfEven)
+	if err != nil || yen.Amount() != "1234" {
+		t.Errorf("RoundToCurrency(JPY) = %s, %v, want 1234", yen.Amount(), err)
+	}
+	dinar, _ := mustParse(t, "1.23456", "BHD").RoundToCurrency(bhd, HalfUp)
+	if dinar.Amount() != "1.235" {
+		t.Errorf("RoundToCurrency(BHD) = %s, want 1.235", dinar.Amount())
+	}
+	if _, err := mustParse(t, "1", "EUR").RoundToCurrency(usd, HalfEven); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("RoundToCurrency error = %v, want ErrCurrencyMismatch", err)
+	}
+}
+
+// TestParseRoundingMode tests reading modes from configuration
+func TestParseRoundingMode(t *testing.T) {
+	for _, mode := range []RoundingMode{HalfEven, HalfUp, Down} {
+		got, err := ParseRoundingMode(mode.String())
+		if err != nil || got != mode {
+			t.Errorf("ParseRoundingMode(%q) = %v, %v", mode.String(), got, err)
+		}
+	}
+	if _, err := ParseRoundingMode("ceiling"); err == nil {
+		t.Error("ParseRoundingMode(ceiling) succeeded, want error")
+	}
+}
+
+// TestConvert tests applying an exchange rate
+// Spec: docs/specs/007-money.md#conversion
+func TestConvert(t *testing.T) {
+	rate, _ := new(big.Rat).SetString("1.0850")
+	got := mustParse(t, "100.01", "EUR").Convert(rate, "USD", 2, HalfEven)
+	if got.String() != "108.51 USD" {
+		t.Errorf("Convert = %s, want 108.51 USD", got)
+	}
+}
+
+// TestAllocate tests splitting amounts without losing units
+// Spec: docs/specs/007-money.md#allocation
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		in     string
+		ratios []int64
+		want   []string
+	}{
+		{"100.00", []int64{1, 1, 1}, []string{"33.34", "33.33", "33.33"}},
+		{"0.05", []int64{3, 7}, []string{"0.02", "0.03"}},
+		{"-10.00", []int64{1, 2}, []string{"-3.33", "-6.67"}},
+		{"1", []int64{0, 1}, []string{"0", "1"}},
+	}
+	for _, tt := range tests {
+		parts, err := mustParse(t, tt.in, "USD").Allocate(tt.ratios...)
+		if err != nil {
+			t.Fatalf("Allocate(%s) error = %v", tt.in, err)
+		}
+		total := Zero("USD")
+		for i, part := range parts {
+			if part.Amount() != tt.want[i] {
+				t.Errorf("Allocate(%s, %v)[%d] = %s, want %s", tt.in, tt.ratios, i, part.Amount(), tt.want[i])
+			}
+			total, _ = total.Add(part)
+		}
+		if cmp, _ := total.Cmp(mustParse(t, tt.in, "USD")); cmp != 0 {
+			t.Errorf("Allocate(%s) parts add up to %s", tt.in, total.Amount())
+		}
+	}
+
+	if _, err := mustParse(t, "1", "USD").Allocate(0, 0); err == nil {
+		t.Error("Allocate with zero ratios succeeded, want error")
+	}
+	if _, err := mustParse(t, "1", "USD").Allocate(1, -1); err == nil {
+		t.Error("Allocate with a negative ratio succeeded, want error")
+	}
+}
+
+// TestFormat tests display formatting by symbol position
+// Spec: docs/specs/007-money.md#formatting
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		in       string
+		currency Currency
+		want     string
+	}{
+		{"1250.7500", usd, "$1,250.75"},
+		{"-0.505", usd, "-$0.50"},
+		{"1234567.5", jpy, "¥1,234,568"},
+		{"1250", sek, "1,250.00 kr"},
+		{"12.3456", bhd, "12.346 BHD"},
+	}
+	for _, tt := range tests {
+		got, err := mustParse(t, tt.in, tt.currency.Code).Format(tt.currency)
+		if err != nil || got != tt.want {
+			t.Errorf("Format(%s %s) = %q, %v, want %q", tt.in, tt.currency.Code, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode selects how amounts are rounded to fewer decimal places
+// Spec: docs/specs/007-money.md#rounding
+type RoundingMode int
+
+const (
+	// HalfEven rounds to the nearest value and ties to the even digit
+	// (banker's rounding). It has no bias over many roundings.
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest value and ties away from zero
+	HalfUp
+	// Down truncates toward zero
+	Down
+)
+
+// String returns the configuration name of the mode
+func (r RoundingMode) String() string {
+	switch r {
+	case HalfEven:
+		return "half_even"
+	case HalfUp:
+		return "half_up"
+	case Down:
+		return "down"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(r))
+	}
+}
+
+// ParseRoundingMode reads a mode by its configuration name: half_even,
+// half_up or down
+func ParseRoundingMode(name string) (RoundingMode, error) {
+	for _, mode := range []RoundingMode{HalfEven, HalfUp, Down} {
+		if mode.String() == name {
+			return mode, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown rounding mode %q: must be half_even, half_up or down", name)
+}
+
+// roundRat returns r x 10^scale rounded to an integer
+func roundRat(r *big.Rat, scale int32, mode RoundingMode) *big.Int {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(scale)))
+
+	// QuoRem truncates toward zero
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if rem.Sign() == 0 || mode == Down {
+		return quo
+	}
+
+	// Compare twice the remainder with the denominator to find ties
+	twice := new(big.Int).Abs(rem)
+	twice.Lsh(twice, 1)
+	cmp := twice.Cmp(scaled.Denom())
+	if cmp > 0 || (cmp == 0 && (mode == HalfUp || quo.Bit(0) == 1)) {
+		if scaled.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return quo
+}
@@ -0,0 +1,57 @@
+package pagination
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestCodecRoundTrip tests encoding and decoding a cursor
+// Spec: docs/specs/005-cursor-pagination.md#cursor-format
+func TestCodecRoundTrip(t *testing.T) {
+	codec := NewCodec("secret")
+	filters := Filters{"currency_code": "USD", "account_type": ""}
+
+	token := codec.Encode("accounts", filters, "2025-09-02T10:00:00Z", "acc-1")
+
+	keys, err := codec.Decode(token, "accounts", Filters{"currency_code": "USD"}, 2)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if keys[0] != "2025-09-02T10:00:00Z" || keys[1] != "acc-1" {
+		t.Errorf("Decode() keys = %v", keys)
+	}
+}
+
+// TestCodecRejectsTokens tests that altered or misused cursors are rejected
+// Spec: docs/specs/005-cursor-pagination.md#validation
+func TestCodecRejectsTokens(t *testing.T) {
+	codec := NewCodec("secret")
+	filters := Filters{"status": "ACTIVE"}
+	token := codec.Encode("currencies", filters, "USD")
+	body, sig, _ := strings.Cut(token, ".")
+
+	tests := []struct {
+		name    string
+		codec   *Codec
+		token   string
+		scope   string
+		filters Filters
+		want    error
+	}{
+		{"garbage", codec, "12", "currencies", filters, ErrInvalidToken},
+		{"tampered body", codec, "x" + body[1:] + "." + sig, "currencies", filters, ErrInvalidToken},
+		{"other key", NewCodec("other"), token, "currencies", filters, ErrInvalidToken},
+		{"random key", NewCodec(""), token, "currencies", filters, ErrInvalidToken},
+		{"other scope", codec, token, "institutions", filters, ErrInvalidToken},
+		{"changed filters", codec, token, "currencies", Filters{"status": "INACTIVE"}, ErrFilterMismatch},
+		{"dropped filters", codec, token, "currencies", Filters{}, ErrFilterMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.codec.Decode(tt.token, tt.scope, tt.filters, 1); err != tt.want {
+				t.Errorf("Decode() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
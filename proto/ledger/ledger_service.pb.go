@@ -196,6 +196,282 @@ func (AccountType) EnumDescriptor() ([]byte, []int) {
 	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{2}
 }
 
+// Account lifecycle statuses, matching the account_statuses table
+// Spec: docs/specs/009-account-lifecycle.md#data-models
+type AccountStatus int32
+
+const (
+	AccountStatus_ACCOUNT_STATUS_UNSPECIFIED AccountStatus = 0 // Unknown or unspecified
+	AccountStatus_ACCOUNT_STATUS_ACTIVE      AccountStatus = 1 // Active and can transact
+	AccountStatus_ACCOUNT_STATUS_INACTIVE    AccountStatus = 2 // Temporarily inactive
+	AccountStatus_ACCOUNT_STATUS_CLOSED      AccountStatus = 3 // Permanently closed
+	AccountStatus_ACCOUNT_STATUS_FROZEN      AccountStatus = 4 // Frozen for compliance reasons
+	AccountStatus_ACCOUNT_STATUS_PENDING     AccountStatus = 5 // Pending activation
+)
+
+// Enum value maps for AccountStatus.
+var (
+	AccountStatus_name = map[int32]string{
+		0: "ACCOUNT_STATUS_UNSPECIFIED",
+		1: "ACCOUNT_STATUS_ACTIVE",
+		2: "ACCOUNT_STATUS_INACTIVE",
+		3: "ACCOUNT_STATUS_CLOSED",
+		4: "ACCOUNT_STATUS_FROZEN",
+		5: "ACCOUNT_STATUS_PENDING",
+	}
+	AccountStatus_value = map[string]int32{
+		"ACCOUNT_STATUS_UNSPECIFIED": 0,
+		"ACCOUNT_STATUS_ACTIVE":      1,
+		"ACCOUNT_STATUS_INACTIVE":    2,
+		"ACCOUNT_STATUS_CLOSED":      3,
+		"ACCOUNT_STATUS_FROZEN":      4,
+		"ACCOUNT_STATUS_PENDING":     5,
+	}
+)
+
+func (x AccountStatus) Enum() *AccountStatus {
+	p := new(AccountStatus)
+	*p = x
+	return p
+}
+
+func (x AccountStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[3].Descriptor()
+}
+
+func (AccountStatus) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[3]
+}
+
+func (x AccountStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountStatus.Descriptor instead.
+func (AccountStatus) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{3}
+}
+
+// Side of the ledger on which an account type normally carries its balance
+// Spec: docs/specs/005-account-balances.md#data-models
+type NormalBalance int32
+
+const (
+	NormalBalance_NORMAL_BALANCE_UNSPECIFIED NormalBalance = 0 // Unknown or unspecified
+	NormalBalance_NORMAL_BALANCE_DEBIT       NormalBalance = 1 // Assets and expenses
+	NormalBalance_NORMAL_BALANCE_CREDIT      NormalBalance = 2 // Liabilities, equity and revenue
+)
+
+// Enum value maps for NormalBalance.
+var (
+	NormalBalance_name = map[int32]string{
+		0: "NORMAL_BALANCE_UNSPECIFIED",
+		1: "NORMAL_BALANCE_DEBIT",
+		2: "NORMAL_BALANCE_CREDIT",
+	}
+	NormalBalance_value = map[string]int32{
+		"NORMAL_BALANCE_UNSPECIFIED": 0,
+		"NORMAL_BALANCE_DEBIT":       1,
+		"NORMAL_BALANCE_CREDIT":      2,
+	}
+)
+
+func (x NormalBalance) Enum() *NormalBalance {
+	p := new(NormalBalance)
+	*p = x
+	return p
+}
+
+func (x NormalBalance) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NormalBalance) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[4].Descriptor()
+}
+
+func (NormalBalance) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[4]
+}
+
+func (x NormalBalance) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NormalBalance.Descriptor instead.
+func (NormalBalance) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{4}
+}
+
+// Journal entry lifecycle status
+// Spec: docs/specs/004-journal-entries.md#api-design
+type JournalEntryStatus int32
+
+const (
+	JournalEntryStatus_JOURNAL_ENTRY_STATUS_UNSPECIFIED JournalEntryStatus = 0 // Unknown or unspecified
+	JournalEntryStatus_JOURNAL_ENTRY_STATUS_PENDING     JournalEntryStatus = 1 // Recorded but not yet posted
+	JournalEntryStatus_JOURNAL_ENTRY_STATUS_POSTED      JournalEntryStatus = 2 // Posted to the ledger
+	JournalEntryStatus_JOURNAL_ENTRY_STATUS_CANCELLED   JournalEntryStatus = 3 // Cancelled before posting
+)
+
+// Enum value maps for JournalEntryStatus.
+var (
+	JournalEntryStatus_name = map[int32]string{
+		0: "JOURNAL_ENTRY_STATUS_UNSPECIFIED",
+		1: "JOURNAL_ENTRY_STATUS_PENDING",
+		2: "JOURNAL_ENTRY_STATUS_POSTED",
+		3: "JOURNAL_ENTRY_STATUS_CANCELLED",
+	}
+	JournalEntryStatus_value = map[string]int32{
+		"JOURNAL_ENTRY_STATUS_UNSPECIFIED": 0,
+		"JOURNAL_ENTRY_STATUS_PENDING":     1,
+		"JOURNAL_ENTRY_STATUS_POSTED":      2,
+		"JOURNAL_ENTRY_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x JournalEntryStatus) Enum() *JournalEntryStatus {
+	p := new(JournalEntryStatus)
+	*p = x
+	return p
+}
+
+func (x JournalEntryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JournalEntryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[5].Descriptor()
+}
+
+func (JournalEntryStatus) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[5]
+}
+
+func (x JournalEntryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JournalEntryStatus.Descriptor instead.
+func (JournalEntryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{5}
+}
+
+// Accounting period statuses
+// Spec: docs/specs/012-accounting-periods.md#data-models
+type PeriodStatus int32
+
+const (
+	PeriodStatus_PERIOD_STATUS_UNSPECIFIED PeriodStatus = 0 // Unknown or unspecified
+	PeriodStatus_PERIOD_STATUS_OPEN        PeriodStatus = 1 // Accepts postings
+	PeriodStatus_PERIOD_STATUS_SOFT_CLOSED PeriodStatus = 2 // Accepts period adjustments only
+	PeriodStatus_PERIOD_STATUS_CLOSED      PeriodStatus = 3 // Accepts no postings
+)
+
+// Enum value maps for PeriodStatus.
+var (
+	PeriodStatus_name = map[int32]string{
+		0: "PERIOD_STATUS_UNSPECIFIED",
+		1: "PERIOD_STATUS_OPEN",
+		2: "PERIOD_STATUS_SOFT_CLOSED",
+		3: "PERIOD_STATUS_CLOSED",
+	}
+	PeriodStatus_value = map[string]int32{
+		"PERIOD_STATUS_UNSPECIFIED": 0,
+		"PERIOD_STATUS_OPEN":        1,
+		"PERIOD_STATUS_SOFT_CLOSED": 2,
+		"PERIOD_STATUS_CLOSED":      3,
+	}
+)
+
+func (x PeriodStatus) Enum() *PeriodStatus {
+	p := new(PeriodStatus)
+	*p = x
+	return p
+}
+
+func (x PeriodStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeriodStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[6].Descriptor()
+}
+
+func (PeriodStatus) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[6]
+}
+
+func (x PeriodStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeriodStatus.Descriptor instead.
+func (PeriodStatus) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{6}
+}
+
+// Hold statuses
+// Spec: docs/specs/013-holds.md#data-models
+type HoldStatus int32
+
+const (
+	HoldStatus_HOLD_STATUS_UNSPECIFIED HoldStatus = 0 // Unknown or unspecified
+	HoldStatus_HOLD_STATUS_ACTIVE      HoldStatus = 1 // Reserves its amount
+	HoldStatus_HOLD_STATUS_CAPTURED    HoldStatus = 2 // Posted as a journal entry
+	HoldStatus_HOLD_STATUS_RELEASED    HoldStatus = 3 // Released without posting
+	HoldStatus_HOLD_STATUS_EXPIRED     HoldStatus = 4 // Passed expires_at while active
+)
+
+// Enum value maps for HoldStatus.
+var (
+	HoldStatus_name = map[int32]string{
+		0: "HOLD_STATUS_UNSPECIFIED",
+		1: "HOLD_STATUS_ACTIVE",
+		2: "HOLD_STATUS_CAPTURED",
+		3: "HOLD_STATUS_RELEASED",
+		4: "HOLD_STATUS_EXPIRED",
+	}
+	HoldStatus_value = map[string]int32{
+		"HOLD_STATUS_UNSPECIFIED": 0,
+		"HOLD_STATUS_ACTIVE":      1,
+		"HOLD_STATUS_CAPTURED":    2,
+		"HOLD_STATUS_RELEASED":    3,
+		"HOLD_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x HoldStatus) Enum() *HoldStatus {
+	p := new(HoldStatus)
+	*p = x
+	return p
+}
+
+func (x HoldStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HoldStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[7].Descriptor()
+}
+
+func (HoldStatus) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes[7]
+}
+
+func (x HoldStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldStatus.Descriptor instead.
+func (HoldStatus) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{7}
+}
+
 // The empty request
 type ManifestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1470,6 +1746,10 @@ type Account struct {
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                // Creation timestamp
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                // Last update timestamp
 	Version         int64                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`                                                    // Version for optimistic locking
+	Status          AccountStatus          `protobuf:"varint,10,opt,name=status,proto3,enum=ledger.AccountStatus" json:"status,omitempty"`                           // Lifecycle status
+	StatusReason    string                 `protobuf:"bytes,11,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`                      // Reason given for the last status change
+	StatusChangedBy string                 `protobuf:"bytes,12,opt,name=status_changed_by,json=statusChangedBy,proto3" json:"status_changed_by,omitempty"`           // Actor of the last status change
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`           // Time of the last status change
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1567,6 +1847,34 @@ func (x *Account) GetVersion() int64 {
 	return 0
 }
 
+func (x *Account) GetStatus() AccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *Account) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *Account) GetStatusChangedBy() string {
+	if x != nil {
+		return x.StatusChangedBy
+	}
+	return ""
+}
+
+func (x *Account) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
 // Create account request
 // Spec: docs/specs/003-account-management.md#story-1-create-account
 type CreateAccountRequest struct {
@@ -1694,6 +2002,9 @@ func (x *CreateAccountResponse) GetAccount() *Account {
 type GetAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // System account ID
+	Verified      bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`                   // Optional: Verify the row against the trusted ledger state
+	AsOfTx        uint64                 `protobuf:"varint,3,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`       // Optional: Read the account as committed at this ImmuDB tx
+	AsOfTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`  // Optional: Read the account as committed at this time
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1735,9 +2046,31 @@ func (x *GetAccountRequest) GetAccountId() string {
 	return ""
 }
 
+func (x *GetAccountRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *GetAccountRequest) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+func (x *GetAccountRequest) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
 type GetAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Verification  *VerificationProof     `protobuf:"bytes,2,opt,name=verification,proto3" json:"verification,omitempty"` // Set when verified=true
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1779,29 +2112,40 @@ func (x *GetAccountResponse) GetAccount() *Account {
 	return nil
 }
 
-// Get by external ID request
-// Spec: docs/specs/003-account-management.md#story-5-retrieve-account-by-external-id
-type GetAccountByExternalIdRequest struct {
+func (x *GetAccountResponse) GetVerification() *VerificationProof {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// Account revision as stored by ImmuDB
+// Spec: docs/specs/007-account-history.md#data-models
+type AccountRevision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExternalId    string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`                               // 1-based revision number (matches Account.version)
+	TxId          uint64                 `protobuf:"varint,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`                           // ImmuDB tx that committed this revision
+	CommittedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=committed_at,json=committedAt,proto3" json:"committed_at,omitempty"`       // Commit time of tx_id
+	Account       *Account               `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`                                  // Account as of this revision
+	ChangedFields []string               `protobuf:"bytes,5,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"` // Fields changed relative to the previous revision
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAccountByExternalIdRequest) Reset() {
-	*x = GetAccountByExternalIdRequest{}
+func (x *AccountRevision) Reset() {
+	*x = AccountRevision{}
 	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAccountByExternalIdRequest) String() string {
+func (x *AccountRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountByExternalIdRequest) ProtoMessage() {}
+func (*AccountRevision) ProtoMessage() {}
 
-func (x *GetAccountByExternalIdRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountRevision) ProtoReflect() protoreflect.Message {
 	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1813,39 +2157,69 @@ func (x *GetAccountByExternalIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountByExternalIdRequest.ProtoReflect.Descriptor instead.
-func (*GetAccountByExternalIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountRevision.ProtoReflect.Descriptor instead.
+func (*AccountRevision) Descriptor() ([]byte, []int) {
 	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetAccountByExternalIdRequest) GetExternalId() string {
+func (x *AccountRevision) GetRevision() int64 {
 	if x != nil {
-		return x.ExternalId
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-type GetAccountByExternalIdResponse struct {
+func (x *AccountRevision) GetTxId() uint64 {
+	if x != nil {
+		return x.TxId
+	}
+	return 0
+}
+
+func (x *AccountRevision) GetCommittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommittedAt
+	}
+	return nil
+}
+
+func (x *AccountRevision) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountRevision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+// Get account history request
+// Spec: docs/specs/007-account-history.md#story-1-account-revision-history
+type GetAccountHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Required: System account ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAccountByExternalIdResponse) Reset() {
-	*x = GetAccountByExternalIdResponse{}
+func (x *GetAccountHistoryRequest) Reset() {
+	*x = GetAccountHistoryRequest{}
 	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAccountByExternalIdResponse) String() string {
+func (x *GetAccountHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountByExternalIdResponse) ProtoMessage() {}
+func (*GetAccountHistoryRequest) ProtoMessage() {}
 
-func (x *GetAccountByExternalIdResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAccountHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1857,43 +2231,39 @@ func (x *GetAccountByExternalIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountByExternalIdResponse.ProtoReflect.Descriptor instead.
-func (*GetAccountByExternalIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetAccountByExternalIdResponse) GetAccount() *Account {
+func (x *GetAccountHistoryRequest) GetAccountId() string {
 	if x != nil {
-		return x.Account
+		return x.AccountId
 	}
-	return nil
+	return ""
 }
 
-// Update account request
-// Spec: docs/specs/003-account-management.md#story-3-update-account
-type UpdateAccountRequest struct {
+type GetAccountHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`    // Required: Account to update
-	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`                         // Updated account data
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields to update
+	Revisions     []*AccountRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // Oldest revision first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAccountRequest) Reset() {
-	*x = UpdateAccountRequest{}
+func (x *GetAccountHistoryResponse) Reset() {
+	*x = GetAccountHistoryResponse{}
 	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAccountRequest) String() string {
+func (x *GetAccountHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAccountRequest) ProtoMessage() {}
+func (*GetAccountHistoryResponse) ProtoMessage() {}
 
-func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAccountHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1905,54 +2275,4130 @@ func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateAccountRequest) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *UpdateAccountRequest) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-func (x *UpdateAccountRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *GetAccountHistoryResponse) GetRevisions() []*AccountRevision {
 	if x != nil {
-		return x.UpdateMask
+		return x.Revisions
 	}
 	return nil
 }
 
-type UpdateAccountResponse struct {
+// Get by external ID request
+// Spec: docs/specs/003-account-management.md#story-5-retrieve-account-by-external-id
+type GetAccountByExternalIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAccountResponse) Reset() {
-	*x = UpdateAccountResponse{}
+func (x *GetAccountByExternalIdRequest) Reset() {
+	*x = GetAccountByExternalIdRequest{}
 	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAccountResponse) String() string {
+func (x *GetAccountByExternalIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountByExternalIdRequest) ProtoMessage() {}
+
+func (x *GetAccountByExternalIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountByExternalIdRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountByExternalIdRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAccountByExternalIdRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type GetAccountByExternalIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountByExternalIdResponse) Reset() {
+	*x = GetAccountByExternalIdResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountByExternalIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountByExternalIdResponse) ProtoMessage() {}
+
+func (x *GetAccountByExternalIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountByExternalIdResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountByExternalIdResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAccountByExternalIdResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Update account request
+// Spec: docs/specs/003-account-management.md#story-3-update-account
+type UpdateAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`    // Required: Account to update
+	Account       *Account               `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`                         // Updated account data
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields to update
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRequest) Reset() {
+	*x = UpdateAccountRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *UpdateAccountRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *UpdateAccountRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountResponse) Reset() {
+	*x = UpdateAccountResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountResponse) ProtoMessage() {}
+
+func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// List accounts request
+// Spec: docs/specs/003-account-management.md#story-4-list-accounts
+type ListAccountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                  // Number of results (max 200)
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                // Pagination token
+	AccountType     AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=ledger.AccountType" json:"account_type,omitempty"` // Filter by type
+	CurrencyCode    string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`                       // Filter by currency
+	ExternalGroupId string                 `protobuf:"bytes,5,opt,name=external_group_id,json=externalGroupId,proto3" json:"external_group_id,omitempty"`            // Filter by group
+	NameSearch      string                 `protobuf:"bytes,6,opt,name=name_search,json=nameSearch,proto3" json:"name_search,omitempty"`                             // Search in name (partial match)
+	Status          AccountStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=ledger.AccountStatus" json:"status,omitempty"`                            // Filter by lifecycle status
+	SkipTotalCount  bool                   `protobuf:"varint,8,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"`              // Optional: Leave total_count unset to skip counting matches
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAccountsRequest) Reset() {
+	*x = ListAccountsRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsRequest) ProtoMessage() {}
+
+func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListAccountsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccountsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ListAccountsRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetExternalGroupId() string {
+	if x != nil {
+		return x.ExternalGroupId
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetNameSearch() string {
+	if x != nil {
+		return x.NameSearch
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetStatus() AccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *ListAccountsRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+type ListAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountsResponse) Reset() {
+	*x = ListAccountsResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountsResponse) ProtoMessage() {}
+
+func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ListAccountsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAccountsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Freeze account request
+// Spec: docs/specs/009-account-lifecycle.md#story-1-freeze-account
+type FreezeAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Required: Account to freeze
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                        // Required: Why the account is frozen
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                          // Who requested the change (defaults to x-user-id metadata)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeAccountRequest) Reset() {
+	*x = FreezeAccountRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeAccountRequest) ProtoMessage() {}
+
+func (x *FreezeAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeAccountRequest.ProtoReflect.Descriptor instead.
+func (*FreezeAccountRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FreezeAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *FreezeAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeAccountRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type FreezeAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeAccountResponse) Reset() {
+	*x = FreezeAccountResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeAccountResponse) ProtoMessage() {}
+
+func (x *FreezeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeAccountResponse.ProtoReflect.Descriptor instead.
+func (*FreezeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FreezeAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Close account request
+// Spec: docs/specs/009-account-lifecycle.md#story-2-close-account
+type CloseAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Required: Account to close
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                        // Required: Why the account is closed
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                          // Who requested the change (defaults to x-user-id metadata)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAccountRequest) Reset() {
+	*x = CloseAccountRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAccountRequest) ProtoMessage() {}
+
+func (x *CloseAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAccountRequest.ProtoReflect.Descriptor instead.
+func (*CloseAccountRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CloseAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CloseAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CloseAccountRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type CloseAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseAccountResponse) Reset() {
+	*x = CloseAccountResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseAccountResponse) ProtoMessage() {}
+
+func (x *CloseAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseAccountResponse.ProtoReflect.Descriptor instead.
+func (*CloseAccountResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CloseAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Reopen account request
+// Spec: docs/specs/009-account-lifecycle.md#story-3-reopen-account
+type ReopenAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Required: Account to reopen
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                        // Required: Why the account is reopened
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                          // Who requested the change (defaults to x-user-id metadata)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenAccountRequest) Reset() {
+	*x = ReopenAccountRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenAccountRequest) ProtoMessage() {}
+
+func (x *ReopenAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenAccountRequest.ProtoReflect.Descriptor instead.
+func (*ReopenAccountRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReopenAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ReopenAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReopenAccountRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type ReopenAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenAccountResponse) Reset() {
+	*x = ReopenAccountResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenAccountResponse) ProtoMessage() {}
+
+func (x *ReopenAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenAccountResponse.ProtoReflect.Descriptor instead.
+func (*ReopenAccountResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReopenAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// Posted and available balance of an account at a point in time
+// Spec: docs/specs/005-account-balances.md#data-models
+type AccountBalance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                        // Account the balance belongs to
+	CurrencyCode     string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`                               // ISO 4217 currency code
+	AccountType      AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=ledger.AccountType" json:"account_type,omitempty"`         // Account type
+	NormalBalance    NormalBalance          `protobuf:"varint,4,opt,name=normal_balance,json=normalBalance,proto3,enum=ledger.NormalBalance" json:"normal_balance,omitempty"` // Normal balance side of the account type
+	DebitTotal       string                 `protobuf:"bytes,5,opt,name=debit_total,json=debitTotal,proto3" json:"debit_total,omitempty"`                                     // Sum of posted debits (decimal string)
+	CreditTotal      string                 `protobuf:"bytes,6,opt,name=credit_total,json=creditTotal,proto3" json:"credit_total,omitempty"`                                  // Sum of posted credits (decimal string)
+	Balance          string                 `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance,omitempty"`                                                             // Net balance signed by normal balance
+	LineCount        int64                  `protobuf:"varint,8,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`                                       // Number of posted journal lines
+	AsOfTime         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`                                         // Entry date cut-off applied, if any
+	AsOfTx           uint64                 `protobuf:"varint,10,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`                                             // ImmuDB transaction the balance was read at, if any
+	PostedBalance    string                 `protobuf:"bytes,11,opt,name=posted_balance,json=postedBalance,proto3" json:"posted_balance,omitempty"`                           // Same as balance: net of posted lines only
+	HeldTotal        string                 `protobuf:"bytes,12,opt,name=held_total,json=heldTotal,proto3" json:"held_total,omitempty"`                                       // Sum of active holds on the account (decimal string)
+	AvailableBalance string                 `protobuf:"bytes,13,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`                  // posted_balance minus held_total
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AccountBalance) Reset() {
+	*x = AccountBalance{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountBalance) ProtoMessage() {}
+
+func (x *AccountBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountBalance.ProtoReflect.Descriptor instead.
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AccountBalance) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *AccountBalance) GetNormalBalance() NormalBalance {
+	if x != nil {
+		return x.NormalBalance
+	}
+	return NormalBalance_NORMAL_BALANCE_UNSPECIFIED
+}
+
+func (x *AccountBalance) GetDebitTotal() string {
+	if x != nil {
+		return x.DebitTotal
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetCreditTotal() string {
+	if x != nil {
+		return x.CreditTotal
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetLineCount() int64 {
+	if x != nil {
+		return x.LineCount
+	}
+	return 0
+}
+
+func (x *AccountBalance) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *AccountBalance) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+func (x *AccountBalance) GetPostedBalance() string {
+	if x != nil {
+		return x.PostedBalance
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetHeldTotal() string {
+	if x != nil {
+		return x.HeldTotal
+	}
+	return ""
+}
+
+func (x *AccountBalance) GetAvailableBalance() string {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return ""
+}
+
+// Get account balance request
+// Spec: docs/specs/005-account-balances.md#story-1-current-account-balance
+type GetAccountBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Required: Account ID
+	AsOfTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`  // Optional: Include entries dated on or before this time
+	AsOfTx        uint64                 `protobuf:"varint,3,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`       // Optional: Read ledger state as of this ImmuDB tx
+	Verified      bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`                   // Optional: Verify the ledger state the balance is read from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalanceRequest) Reset() {
+	*x = GetAccountBalanceRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalanceRequest) ProtoMessage() {}
+
+func (x *GetAccountBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAccountBalanceRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetAccountBalanceRequest) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *GetAccountBalanceRequest) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+func (x *GetAccountBalanceRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type GetAccountBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       *AccountBalance        `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Verification  *VerificationProof     `protobuf:"bytes,2,opt,name=verification,proto3" json:"verification,omitempty"` // Set when verified=true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalanceResponse) Reset() {
+	*x = GetAccountBalanceResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalanceResponse) ProtoMessage() {}
+
+func (x *GetAccountBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAccountBalanceResponse) GetBalance() *AccountBalance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *GetAccountBalanceResponse) GetVerification() *VerificationProof {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// Get account balances request
+// Spec: docs/specs/005-account-balances.md#story-3-batch-balances
+type GetAccountBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountIds    []string               `protobuf:"bytes,1,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"` // Required: Account IDs (max 100)
+	AsOfTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`     // Optional: Include entries dated on or before this time
+	AsOfTx        uint64                 `protobuf:"varint,3,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`          // Optional: Read ledger state as of this ImmuDB tx
+	Verified      bool                   `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`                      // Optional: Verify the ledger state the balances are read from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalancesRequest) Reset() {
+	*x = GetAccountBalancesRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalancesRequest) ProtoMessage() {}
+
+func (x *GetAccountBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetAccountBalancesRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *GetAccountBalancesRequest) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *GetAccountBalancesRequest) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+func (x *GetAccountBalancesRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type GetAccountBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*AccountBalance      `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`         // Same order as requested account_ids
+	Verification  *VerificationProof     `protobuf:"bytes,2,opt,name=verification,proto3" json:"verification,omitempty"` // Set when verified=true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountBalancesResponse) Reset() {
+	*x = GetAccountBalancesResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountBalancesResponse) ProtoMessage() {}
+
+func (x *GetAccountBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetAccountBalancesResponse) GetBalances() []*AccountBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *GetAccountBalancesResponse) GetVerification() *VerificationProof {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// JournalEntry is a balanced set of debit and credit lines
+// Spec: docs/specs/004-journal-entries.md#api-design
+type JournalEntry struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                       // System-generated UUID
+	EntryDate              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=entry_date,json=entryDate,proto3" json:"entry_date,omitempty"`                                                        // Accounting date of the entry
+	Description            string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                                                     // Free-form description
+	Reference              string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`                                                                         // External reference (e.g. invoice number)
+	CurrencyCode           string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`                                               // ISO 4217 currency code
+	Status                 JournalEntryStatus     `protobuf:"varint,6,opt,name=status,proto3,enum=ledger.JournalEntryStatus" json:"status,omitempty"`                                               // Entry status
+	Lines                  []*JournalEntryLine    `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"`                                                                                 // Debit and credit lines
+	Metadata               map[string]string      `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional key/value data
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                        // Creation timestamp
+	CreatedBy              string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                                                       // Identity that posted the entry
+	FunctionalCurrencyCode string                 `protobuf:"bytes,11,opt,name=functional_currency_code,json=functionalCurrencyCode,proto3" json:"functional_currency_code,omitempty"`              // Currency the entry balances in, empty on entries posted before multi-currency support
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *JournalEntry) Reset() {
+	*x = JournalEntry{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntry) ProtoMessage() {}
+
+func (x *JournalEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEntry.ProtoReflect.Descriptor instead.
+func (*JournalEntry) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *JournalEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetEntryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EntryDate
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetStatus() JournalEntryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JournalEntryStatus_JOURNAL_ENTRY_STATUS_UNSPECIFIED
+}
+
+func (x *JournalEntry) GetLines() []*JournalEntryLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetFunctionalCurrencyCode() string {
+	if x != nil {
+		return x.FunctionalCurrencyCode
+	}
+	return ""
+}
+
+// JournalEntryLine debits or credits a single account
+// Spec: docs/specs/004-journal-entries.md#api-design
+type JournalEntryLine struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                          // System-generated UUID
+	LineNumber             int32                  `protobuf:"varint,2,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`                                       // 1-based position within the entry
+	AccountId              string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                           // Account being debited or credited
+	DebitAmount            string                 `protobuf:"bytes,4,opt,name=debit_amount,json=debitAmount,proto3" json:"debit_amount,omitempty"`                                     // Decimal string, e.g. "1250.00"
+	CreditAmount           string                 `protobuf:"bytes,5,opt,name=credit_amount,json=creditAmount,proto3" json:"credit_amount,omitempty"`                                  // Decimal string, e.g. "1250.00"
+	Description            string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                                        // Optional line description
+	CurrencyCode           string                 `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`                                  // Currency of the line (account currency), defaults to the entry currency
+	ExchangeRate           string                 `protobuf:"bytes,8,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`                                  // Line currency to functional currency rate (decimal string)
+	FunctionalDebitAmount  string                 `protobuf:"bytes,9,opt,name=functional_debit_amount,json=functionalDebitAmount,proto3" json:"functional_debit_amount,omitempty"`     // Debit in the functional currency (decimal string)
+	FunctionalCreditAmount string                 `protobuf:"bytes,10,opt,name=functional_credit_amount,json=functionalCreditAmount,proto3" json:"functional_credit_amount,omitempty"` // Credit in the functional currency (decimal string)
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *JournalEntryLine) Reset() {
+	*x = JournalEntryLine{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalEntryLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalEntryLine) ProtoMessage() {}
+
+func (x *JournalEntryLine) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalEntryLine.ProtoReflect.Descriptor instead.
+func (*JournalEntryLine) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *JournalEntryLine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JournalEntryLine) GetLineNumber() int32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *JournalEntryLine) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *JournalEntryLine) GetDebitAmount() string {
+	if x != nil {
+		return x.DebitAmount
+	}
+	return ""
+}
+
+func (x *JournalEntryLine) GetCreditAmount() string {
+	if x != nil {
+		return x.CreditAmount
+	}
+	return ""
+}
+
+func (x *JournalEntryLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *JournalEntryLine) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *JournalEntryLine) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *JournalEntryLine) GetFunctionalDebitAmount() string {
+	if x != nil {
+		return x.FunctionalDebitAmount
+	}
+	return ""
+}
+
+func (x *JournalEntryLine) GetFunctionalCreditAmount() string {
+	if x != nil {
+		return x.FunctionalCreditAmount
+	}
+	return ""
+}
+
+// Post journal entry request
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+type PostJournalEntryRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EntryDate        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=entry_date,json=entryDate,proto3" json:"entry_date,omitempty"`                                                        // Optional: Defaults to now
+	Description      string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                                                     // Optional: Entry description
+	Reference        string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`                                                                         // Optional: External reference
+	CurrencyCode     string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`                                               // Required: ISO 4217 code
+	Lines            []*JournalEntryLine    `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`                                                                                 // Required: At least two lines
+	Metadata         map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Optional: Additional data
+	PeriodAdjustment bool                   `protobuf:"varint,7,opt,name=period_adjustment,json=periodAdjustment,proto3" json:"period_adjustment,omitempty"`                                  // Optional: Allow posting into a soft-closed period
+	ExchangeRate     string                 `protobuf:"bytes,8,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`                                               // Optional: Rate to the functional currency for lines in currency_code
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PostJournalEntryRequest) Reset() {
+	*x = PostJournalEntryRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostJournalEntryRequest) ProtoMessage() {}
+
+func (x *PostJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*PostJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PostJournalEntryRequest) GetEntryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EntryDate
+	}
+	return nil
+}
+
+func (x *PostJournalEntryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PostJournalEntryRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PostJournalEntryRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *PostJournalEntryRequest) GetLines() []*JournalEntryLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PostJournalEntryRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PostJournalEntryRequest) GetPeriodAdjustment() bool {
+	if x != nil {
+		return x.PeriodAdjustment
+	}
+	return false
+}
+
+func (x *PostJournalEntryRequest) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+type PostJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalEntry  *JournalEntry          `protobuf:"bytes,1,opt,name=journal_entry,json=journalEntry,proto3" json:"journal_entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostJournalEntryResponse) Reset() {
+	*x = PostJournalEntryResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostJournalEntryResponse) ProtoMessage() {}
+
+func (x *PostJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*PostJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PostJournalEntryResponse) GetJournalEntry() *JournalEntry {
+	if x != nil {
+		return x.JournalEntry
+	}
+	return nil
+}
+
+// Get journal entry request
+// Spec: docs/specs/004-journal-entries.md#story-2-retrieve-journal-entry
+type GetJournalEntryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JournalEntryId string                 `protobuf:"bytes,1,opt,name=journal_entry_id,json=journalEntryId,proto3" json:"journal_entry_id,omitempty"` // System journal entry ID
+	Verified       bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`                                    // Optional: Verify header and lines against the trusted ledger state
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetJournalEntryRequest) Reset() {
+	*x = GetJournalEntryRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalEntryRequest) ProtoMessage() {}
+
+func (x *GetJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetJournalEntryRequest) GetJournalEntryId() string {
+	if x != nil {
+		return x.JournalEntryId
+	}
+	return ""
+}
+
+func (x *GetJournalEntryRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type GetJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JournalEntry  *JournalEntry          `protobuf:"bytes,1,opt,name=journal_entry,json=journalEntry,proto3" json:"journal_entry,omitempty"`
+	Verification  *VerificationProof     `protobuf:"bytes,2,opt,name=verification,proto3" json:"verification,omitempty"` // Set when verified=true
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalEntryResponse) Reset() {
+	*x = GetJournalEntryResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalEntryResponse) ProtoMessage() {}
+
+func (x *GetJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetJournalEntryResponse) GetJournalEntry() *JournalEntry {
+	if x != nil {
+		return x.JournalEntry
+	}
+	return nil
+}
+
+func (x *GetJournalEntryResponse) GetVerification() *VerificationProof {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// List journal entries request
+// Spec: docs/specs/004-journal-entries.md#story-3-list-journal-entries
+type ListJournalEntriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                     // Number of results (max 200)
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // Pagination token
+	CurrencyCode   string                 `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`          // Filter by currency
+	Reference      string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`                                    // Filter by reference
+	StartDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                   // Entries on or after this date
+	EndDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                         // Entries before this date
+	Verified       bool                   `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`                                     // Optional: Verify every returned entry
+	SkipTotalCount bool                   `protobuf:"varint,8,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"` // Optional: Leave total_count unset to skip counting matches
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesRequest) Reset() {
+	*x = ListJournalEntriesRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesRequest) ProtoMessage() {}
+
+func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListJournalEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJournalEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListJournalEntriesRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListJournalEntriesRequest) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ListJournalEntriesRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+type ListJournalEntriesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JournalEntries []*JournalEntry        `protobuf:"bytes,1,rep,name=journal_entries,json=journalEntries,proto3" json:"journal_entries,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount     int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Verifications  []*VerificationProof   `protobuf:"bytes,4,rep,name=verifications,proto3" json:"verifications,omitempty"` // Same order as journal_entries, set when verified=true
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesResponse) Reset() {
+	*x = ListJournalEntriesResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesResponse) ProtoMessage() {}
+
+func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListJournalEntriesResponse) GetJournalEntries() []*JournalEntry {
+	if x != nil {
+		return x.JournalEntries
+	}
+	return nil
+}
+
+func (x *ListJournalEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListJournalEntriesResponse) GetVerifications() []*VerificationProof {
+	if x != nil {
+		return x.Verifications
+	}
+	return nil
+}
+
+// Cryptographic proof material for a verified read. The service has already
+// checked the proofs against its locally persisted trusted state; the material
+// is returned so that clients can re-verify independently.
+// Spec: docs/specs/006-verified-reads.md#verification-proof
+type VerificationProof struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Database         string                 `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`                                            // ImmuDB database the proof belongs to
+	TxId             uint64                 `protobuf:"varint,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`                                       // Transaction that committed the verified data
+	TxHash           []byte                 `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                                  // Accumulated linear hash (Alh) of tx_id, set for state verification
+	TxTime           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=tx_time,json=txTime,proto3" json:"tx_time,omitempty"`                                  // Commit time of tx_id
+	InclusionLeaf    int32                  `protobuf:"varint,5,opt,name=inclusion_leaf,json=inclusionLeaf,proto3" json:"inclusion_leaf,omitempty"`            // Leaf index of the row within tx_id
+	InclusionWidth   int32                  `protobuf:"varint,6,opt,name=inclusion_width,json=inclusionWidth,proto3" json:"inclusion_width,omitempty"`         // Number of entries in tx_id
+	InclusionProof   [][]byte               `protobuf:"bytes,7,rep,name=inclusion_proof,json=inclusionProof,proto3" json:"inclusion_proof,omitempty"`          // Merkle inclusion proof terms of the row within tx_id
+	ConsistencyProof [][]byte               `protobuf:"bytes,8,rep,name=consistency_proof,json=consistencyProof,proto3" json:"consistency_proof,omitempty"`    // Consistency proof terms from tx_id to the trusted state
+	TrustedTxId      uint64                 `protobuf:"varint,9,opt,name=trusted_tx_id,json=trustedTxId,proto3" json:"trusted_tx_id,omitempty"`                // Transaction of the trusted state after verification
+	TrustedTxHash    []byte                 `protobuf:"bytes,10,opt,name=trusted_tx_hash,json=trustedTxHash,proto3" json:"trusted_tx_hash,omitempty"`          // Hash of the trusted state after verification
+	StateSignature   []byte                 `protobuf:"bytes,11,opt,name=state_signature,json=stateSignature,proto3" json:"state_signature,omitempty"`         // Server signature of the trusted state, if signing is enabled
+	SigningPublicKey []byte                 `protobuf:"bytes,12,opt,name=signing_public_key,json=signingPublicKey,proto3" json:"signing_public_key,omitempty"` // Public key that produced state_signature
+	VerifiedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`                     // When the service verified the proof
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VerificationProof) Reset() {
+	*x = VerificationProof{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationProof) ProtoMessage() {}
+
+func (x *VerificationProof) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationProof.ProtoReflect.Descriptor instead.
+func (*VerificationProof) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VerificationProof) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *VerificationProof) GetTxId() uint64 {
+	if x != nil {
+		return x.TxId
+	}
+	return 0
+}
+
+func (x *VerificationProof) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetTxTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TxTime
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetInclusionLeaf() int32 {
+	if x != nil {
+		return x.InclusionLeaf
+	}
+	return 0
+}
+
+func (x *VerificationProof) GetInclusionWidth() int32 {
+	if x != nil {
+		return x.InclusionWidth
+	}
+	return 0
+}
+
+func (x *VerificationProof) GetInclusionProof() [][]byte {
+	if x != nil {
+		return x.InclusionProof
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetConsistencyProof() [][]byte {
+	if x != nil {
+		return x.ConsistencyProof
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetTrustedTxId() uint64 {
+	if x != nil {
+		return x.TrustedTxId
+	}
+	return 0
+}
+
+func (x *VerificationProof) GetTrustedTxHash() []byte {
+	if x != nil {
+		return x.TrustedTxHash
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetStateSignature() []byte {
+	if x != nil {
+		return x.StateSignature
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetSigningPublicKey() []byte {
+	if x != nil {
+		return x.SigningPublicKey
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+// A recorded change made by a mutating RPC
+// Spec: docs/specs/008-audit-log.md#data-models
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                       // System-generated UUID
+	EntityType    string                 `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`                                                     // Audited table (accounts, journal_entries)
+	EntityId      string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`                                                           // ID of the changed record
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`                                                                               // CREATE or UPDATE
+	OldValues     string                 `protobuf:"bytes,5,opt,name=old_values,json=oldValues,proto3" json:"old_values,omitempty"`                                                        // JSON of changed fields before the change (empty for CREATE)
+	NewValues     string                 `protobuf:"bytes,6,opt,name=new_values,json=newValues,proto3" json:"new_values,omitempty"`                                                        // JSON of changed fields after the change
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                                 // Caller identity from x-user-id metadata
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                        // When the change was recorded
+	Metadata      map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // RPC method and peer address
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOldValues() string {
+	if x != nil {
+		return x.OldValues
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetNewValues() string {
+	if x != nil {
+		return x.NewValues
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// List audit events request
+// Spec: docs/specs/008-audit-log.md#story-2-list-audit-events
+type ListAuditEventsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                     // Number of results (max 200)
+	PageToken      string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // Pagination token
+	EntityType     string                 `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`                // Filter by entity type
+	EntityId       string                 `protobuf:"bytes,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`                      // Filter by entity ID
+	UserId         string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // Filter by caller identity
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                   // Events at or after this time
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                         // Events before this time
+	SkipTotalCount bool                   `protobuf:"varint,8,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"` // Optional: Leave total_count unset to skip counting matches
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEventsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Trial balance row for one account
+// Spec: docs/specs/011-financial-reports.md#data-models
+type TrialBalanceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                                // Account ID
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`                          // Account name
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`                             // External system identifier
+	AccountType   AccountType            `protobuf:"varint,4,opt,name=account_type,json=accountType,proto3,enum=ledger.AccountType" json:"account_type,omitempty"` // Account type
+	DebitTotal    string                 `protobuf:"bytes,5,opt,name=debit_total,json=debitTotal,proto3" json:"debit_total,omitempty"`                             // Sum of posted debits (decimal string)
+	CreditTotal   string                 `protobuf:"bytes,6,opt,name=credit_total,json=creditTotal,proto3" json:"credit_total,omitempty"`                          // Sum of posted credits (decimal string)
+	DebitBalance  string                 `protobuf:"bytes,7,opt,name=debit_balance,json=debitBalance,proto3" json:"debit_balance,omitempty"`                       // Net balance when debits exceed credits, else zero
+	CreditBalance string                 `protobuf:"bytes,8,opt,name=credit_balance,json=creditBalance,proto3" json:"credit_balance,omitempty"`                    // Net balance when credits exceed debits, else zero
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrialBalanceLine) Reset() {
+	*x = TrialBalanceLine{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrialBalanceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrialBalanceLine) ProtoMessage() {}
+
+func (x *TrialBalanceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrialBalanceLine.ProtoReflect.Descriptor instead.
+func (*TrialBalanceLine) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TrialBalanceLine) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *TrialBalanceLine) GetDebitTotal() string {
+	if x != nil {
+		return x.DebitTotal
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetCreditTotal() string {
+	if x != nil {
+		return x.CreditTotal
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetDebitBalance() string {
+	if x != nil {
+		return x.DebitBalance
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetCreditBalance() string {
+	if x != nil {
+		return x.CreditBalance
+	}
+	return ""
+}
+
+// Get trial balance request
+// Spec: docs/specs/011-financial-reports.md#story-1-trial-balance
+type GetTrialBalanceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AsOfTime        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`                      // Optional: Include entries dated on or before this time
+	CurrencyCode    string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`            // Required: ISO 4217 code of the accounts to report
+	ExternalGroupId string                 `protobuf:"bytes,3,opt,name=external_group_id,json=externalGroupId,proto3" json:"external_group_id,omitempty"` // Optional: Only accounts in this group
+	AsOfTx          uint64                 `protobuf:"varint,4,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`                           // Optional: Read ledger state as of this ImmuDB tx
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTrialBalanceRequest) Reset() {
+	*x = GetTrialBalanceRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrialBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrialBalanceRequest) ProtoMessage() {}
+
+func (x *GetTrialBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrialBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetTrialBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetTrialBalanceRequest) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GetTrialBalanceRequest) GetExternalGroupId() string {
+	if x != nil {
+		return x.ExternalGroupId
+	}
+	return ""
+}
+
+func (x *GetTrialBalanceRequest) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+type GetTrialBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*TrialBalanceLine    `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`                                   // Ordered by account type, then external ID
+	TotalDebits   string                 `protobuf:"bytes,2,opt,name=total_debits,json=totalDebits,proto3" json:"total_debits,omitempty"`    // Sum of debit_balance
+	TotalCredits  string                 `protobuf:"bytes,3,opt,name=total_credits,json=totalCredits,proto3" json:"total_credits,omitempty"` // Sum of credit_balance
+	IsBalanced    bool                   `protobuf:"varint,4,opt,name=is_balanced,json=isBalanced,proto3" json:"is_balanced,omitempty"`      // total_debits equals total_credits
+	CurrencyCode  string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // Currency of every amount
+	AsOfTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`           // Entry date cut-off applied, if any
+	AsOfTx        uint64                 `protobuf:"varint,7,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`                // ImmuDB transaction the report was read at, if any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrialBalanceResponse) Reset() {
+	*x = GetTrialBalanceResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrialBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrialBalanceResponse) ProtoMessage() {}
+
+func (x *GetTrialBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrialBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetTrialBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetTrialBalanceResponse) GetLines() []*TrialBalanceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceResponse) GetTotalDebits() string {
+	if x != nil {
+		return x.TotalDebits
+	}
+	return ""
+}
+
+func (x *GetTrialBalanceResponse) GetTotalCredits() string {
+	if x != nil {
+		return x.TotalCredits
+	}
+	return ""
+}
+
+func (x *GetTrialBalanceResponse) GetIsBalanced() bool {
+	if x != nil {
+		return x.IsBalanced
+	}
+	return false
+}
+
+func (x *GetTrialBalanceResponse) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GetTrialBalanceResponse) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceResponse) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+// Statement row for one account
+// Spec: docs/specs/011-financial-reports.md#data-models
+type ReportLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`       // Account ID
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"` // Account name
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`    // External system identifier
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                              // Net balance signed by the section's normal balance
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLine) Reset() {
+	*x = ReportLine{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLine) ProtoMessage() {}
+
+func (x *ReportLine) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLine.ProtoReflect.Descriptor instead.
+func (*ReportLine) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReportLine) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ReportLine) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ReportLine) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ReportLine) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// Statement section holding the accounts of one account type
+// Spec: docs/specs/011-financial-reports.md#data-models
+type ReportSection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountType   AccountType            `protobuf:"varint,1,opt,name=account_type,json=accountType,proto3,enum=ledger.AccountType" json:"account_type,omitempty"` // Account type of every line
+	Lines         []*ReportLine          `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`                                                         // Ordered by external ID
+	Total         string                 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`                                                         // Sum of line amounts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSection) Reset() {
+	*x = ReportSection{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSection) ProtoMessage() {}
+
+func (x *ReportSection) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSection.ProtoReflect.Descriptor instead.
+func (*ReportSection) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReportSection) GetAccountType() AccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *ReportSection) GetLines() []*ReportLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ReportSection) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+// Get balance sheet request
+// Spec: docs/specs/011-financial-reports.md#story-2-balance-sheet
+type GetBalanceSheetRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AsOfTime        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`                      // Optional: Include entries dated on or before this time
+	CurrencyCode    string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`            // Required: ISO 4217 code of the accounts to report
+	ExternalGroupId string                 `protobuf:"bytes,3,opt,name=external_group_id,json=externalGroupId,proto3" json:"external_group_id,omitempty"` // Optional: Only accounts in this group
+	AsOfTx          uint64                 `protobuf:"varint,4,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`                           // Optional: Read ledger state as of this ImmuDB tx
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetBalanceSheetRequest) Reset() {
+	*x = GetBalanceSheetRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceSheetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceSheetRequest) ProtoMessage() {}
+
+func (x *GetBalanceSheetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceSheetRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceSheetRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetBalanceSheetRequest) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *GetBalanceSheetRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GetBalanceSheetRequest) GetExternalGroupId() string {
+	if x != nil {
+		return x.ExternalGroupId
+	}
+	return ""
+}
+
+func (x *GetBalanceSheetRequest) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+type GetBalanceSheetResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Assets                    *ReportSection         `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
+	Liabilities               *ReportSection         `protobuf:"bytes,2,opt,name=liabilities,proto3" json:"liabilities,omitempty"`
+	Equity                    *ReportSection         `protobuf:"bytes,3,opt,name=equity,proto3" json:"equity,omitempty"`
+	RetainedEarnings          string                 `protobuf:"bytes,4,opt,name=retained_earnings,json=retainedEarnings,proto3" json:"retained_earnings,omitempty"`                                // Revenue less expenses to date, not yet closed to equity
+	TotalAssets               string                 `protobuf:"bytes,5,opt,name=total_assets,json=totalAssets,proto3" json:"total_assets,omitempty"`                                               // Assets total
+	TotalLiabilitiesAndEquity string                 `protobuf:"bytes,6,opt,name=total_liabilities_and_equity,json=totalLiabilitiesAndEquity,proto3" json:"total_liabilities_and_equity,omitempty"` // Liabilities, equity and retained earnings
+	IsBalanced                bool                   `protobuf:"varint,7,opt,name=is_balanced,json=isBalanced,proto3" json:"is_balanced,omitempty"`                                                 // total_assets equals total_liabilities_and_equity
+	CurrencyCode              string                 `protobuf:"bytes,8,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`                                            // Currency of every amount
+	AsOfTime                  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`                                                      // Entry date cut-off applied, if any
+	AsOfTx                    uint64                 `protobuf:"varint,10,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`                                                          // ImmuDB transaction the report was read at, if any
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetBalanceSheetResponse) Reset() {
+	*x = GetBalanceSheetResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceSheetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceSheetResponse) ProtoMessage() {}
+
+func (x *GetBalanceSheetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceSheetResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceSheetResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetBalanceSheetResponse) GetAssets() *ReportSection {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *GetBalanceSheetResponse) GetLiabilities() *ReportSection {
+	if x != nil {
+		return x.Liabilities
+	}
+	return nil
+}
+
+func (x *GetBalanceSheetResponse) GetEquity() *ReportSection {
+	if x != nil {
+		return x.Equity
+	}
+	return nil
+}
+
+func (x *GetBalanceSheetResponse) GetRetainedEarnings() string {
+	if x != nil {
+		return x.RetainedEarnings
+	}
+	return ""
+}
+
+func (x *GetBalanceSheetResponse) GetTotalAssets() string {
+	if x != nil {
+		return x.TotalAssets
+	}
+	return ""
+}
+
+func (x *GetBalanceSheetResponse) GetTotalLiabilitiesAndEquity() string {
+	if x != nil {
+		return x.TotalLiabilitiesAndEquity
+	}
+	return ""
+}
+
+func (x *GetBalanceSheetResponse) GetIsBalanced() bool {
+	if x != nil {
+		return x.IsBalanced
+	}
+	return false
+}
+
+func (x *GetBalanceSheetResponse) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GetBalanceSheetResponse) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *GetBalanceSheetResponse) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+// Get income statement request
+// Spec: docs/specs/011-financial-reports.md#story-3-income-statement
+type GetIncomeStatementRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                     // Required: Entries dated on or after this time
+	EndTime         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                           // Required: Entries dated before this time
+	CurrencyCode    string                 `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`            // Required: ISO 4217 code of the accounts to report
+	ExternalGroupId string                 `protobuf:"bytes,4,opt,name=external_group_id,json=externalGroupId,proto3" json:"external_group_id,omitempty"` // Optional: Only accounts in this group
+	AsOfTx          uint64                 `protobuf:"varint,5,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`                           // Optional: Read ledger state as of this ImmuDB tx
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetIncomeStatementRequest) Reset() {
+	*x = GetIncomeStatementRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncomeStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomeStatementRequest) ProtoMessage() {}
+
+func (x *GetIncomeStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomeStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetIncomeStatementRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetIncomeStatementRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetIncomeStatementRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetIncomeStatementRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GetIncomeStatementRequest) GetExternalGroupId() string {
+	if x != nil {
+		return x.ExternalGroupId
+	}
+	return ""
+}
+
+func (x *GetIncomeStatementRequest) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+type GetIncomeStatementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revenue       *ReportSection         `protobuf:"bytes,1,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Expenses      *ReportSection         `protobuf:"bytes,2,opt,name=expenses,proto3" json:"expenses,omitempty"`
+	TotalRevenue  string                 `protobuf:"bytes,3,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`    // Revenue total
+	TotalExpenses string                 `protobuf:"bytes,4,opt,name=total_expenses,json=totalExpenses,proto3" json:"total_expenses,omitempty"` // Expenses total
+	NetIncome     string                 `protobuf:"bytes,5,opt,name=net_income,json=netIncome,proto3" json:"net_income,omitempty"`             // Revenue less expenses, negative for a loss
+	CurrencyCode  string                 `protobuf:"bytes,6,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`    // Currency of every amount
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`             // Start of the period
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                   // End of the period
+	AsOfTx        uint64                 `protobuf:"varint,9,opt,name=as_of_tx,json=asOfTx,proto3" json:"as_of_tx,omitempty"`                   // ImmuDB transaction the report was read at, if any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncomeStatementResponse) Reset() {
+	*x = GetIncomeStatementResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncomeStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomeStatementResponse) ProtoMessage() {}
+
+func (x *GetIncomeStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomeStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetIncomeStatementResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetIncomeStatementResponse) GetRevenue() *ReportSection {
+	if x != nil {
+		return x.Revenue
+	}
+	return nil
+}
+
+func (x *GetIncomeStatementResponse) GetExpenses() *ReportSection {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *GetIncomeStatementResponse) GetTotalRevenue() string {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return ""
+}
+
+func (x *GetIncomeStatementResponse) GetTotalExpenses() string {
+	if x != nil {
+		return x.TotalExpenses
+	}
+	return ""
+}
+
+func (x *GetIncomeStatementResponse) GetNetIncome() string {
+	if x != nil {
+		return x.NetIncome
+	}
+	return ""
+}
+
+func (x *GetIncomeStatementResponse) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *GetIncomeStatementResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetIncomeStatementResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetIncomeStatementResponse) GetAsOfTx() uint64 {
+	if x != nil {
+		return x.AsOfTx
+	}
+	return 0
+}
+
+// Monthly accounting period of an entity
+// Spec: docs/specs/012-accounting-periods.md#data-models
+type AccountingPeriod struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                    // System-generated UUID
+	EntityId        string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`                        // External group of the entity's accounts, empty for ungrouped accounts
+	Period          string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`                                            // Calendar month, e.g. "2025-08"
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                     // First instant of the period (UTC)
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                           // First instant after the period (UTC)
+	Status          PeriodStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=ledger.PeriodStatus" json:"status,omitempty"`                  // Period status
+	StatusReason    string                 `protobuf:"bytes,7,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`            // Reason given for the last status change
+	StatusChangedBy string                 `protobuf:"bytes,8,opt,name=status_changed_by,json=statusChangedBy,proto3" json:"status_changed_by,omitempty"` // Actor of the last status change
+	StatusChangedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"` // Time of the last status change
+	SnapshotCount   int32                  `protobuf:"varint,10,opt,name=snapshot_count,json=snapshotCount,proto3" json:"snapshot_count,omitempty"`       // Balance snapshots written at the last close
+	Version         int64                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                                        // Version for optimistic locking
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AccountingPeriod) Reset() {
+	*x = AccountingPeriod{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountingPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingPeriod) ProtoMessage() {}
+
+func (x *AccountingPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingPeriod.ProtoReflect.Descriptor instead.
+func (*AccountingPeriod) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AccountingPeriod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *AccountingPeriod) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *AccountingPeriod) GetStatus() PeriodStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PeriodStatus_PERIOD_STATUS_UNSPECIFIED
+}
+
+func (x *AccountingPeriod) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetStatusChangedBy() string {
+	if x != nil {
+		return x.StatusChangedBy
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+func (x *AccountingPeriod) GetSnapshotCount() int32 {
+	if x != nil {
+		return x.SnapshotCount
+	}
+	return 0
+}
+
+func (x *AccountingPeriod) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Close period request
+// Spec: docs/specs/012-accounting-periods.md#story-1-close-period
+type ClosePeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"` // Optional: Entity to close, empty for ungrouped accounts
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`                     // Required: Calendar month, e.g. "2025-08"
+	Soft          bool                   `protobuf:"varint,3,opt,name=soft,proto3" json:"soft,omitempty"`                        // Optional: Soft-close instead of close
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                     // Required: Why the period is closed
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`                       // Who requested the change (defaults to x-user-id metadata)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePeriodRequest) Reset() {
+	*x = ClosePeriodRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodRequest) ProtoMessage() {}
+
+func (x *ClosePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodRequest.ProtoReflect.Descriptor instead.
+func (*ClosePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ClosePeriodRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ClosePeriodRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ClosePeriodRequest) GetSoft() bool {
+	if x != nil {
+		return x.Soft
+	}
+	return false
+}
+
+func (x *ClosePeriodRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ClosePeriodRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type ClosePeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *AccountingPeriod      `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePeriodResponse) Reset() {
+	*x = ClosePeriodResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePeriodResponse) ProtoMessage() {}
+
+func (x *ClosePeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePeriodResponse.ProtoReflect.Descriptor instead.
+func (*ClosePeriodResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ClosePeriodResponse) GetPeriod() *AccountingPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+// Reopen period request
+// Spec: docs/specs/012-accounting-periods.md#story-3-reopen-period
+type ReopenPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"` // Optional: Entity of the period, empty for ungrouped accounts
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`                     // Required: Calendar month, e.g. "2025-08"
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                     // Required: Why the period is reopened
+	Actor         string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`                       // Who requested the change (defaults to x-user-id metadata)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenPeriodRequest) Reset() {
+	*x = ReopenPeriodRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenPeriodRequest) ProtoMessage() {}
+
+func (x *ReopenPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenPeriodRequest.ProtoReflect.Descriptor instead.
+func (*ReopenPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ReopenPeriodRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ReopenPeriodRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ReopenPeriodRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReopenPeriodRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type ReopenPeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *AccountingPeriod      `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenPeriodResponse) Reset() {
+	*x = ReopenPeriodResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenPeriodResponse) ProtoMessage() {}
+
+func (x *ReopenPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenPeriodResponse.ProtoReflect.Descriptor instead.
+func (*ReopenPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReopenPeriodResponse) GetPeriod() *AccountingPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+// List periods request
+// Spec: docs/specs/012-accounting-periods.md#story-4-list-periods
+type ListPeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityId      string                 `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"` // Optional: Entity, empty for ungrouped accounts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeriodsRequest) Reset() {
+	*x = ListPeriodsRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeriodsRequest) ProtoMessage() {}
+
+func (x *ListPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ListPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListPeriodsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type ListPeriodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Periods       []*AccountingPeriod    `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"` // Oldest period first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPeriodsResponse) Reset() {
+	*x = ListPeriodsResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeriodsResponse) ProtoMessage() {}
+
+func (x *ListPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*ListPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListPeriodsResponse) GetPeriods() []*AccountingPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+// Amount reserved on an account
+// Spec: docs/specs/013-holds.md#data-models
+type Hold struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // System-generated UUID
+	AccountId      string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                   // Account the funds are reserved on
+	CurrencyCode   string                 `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`          // Account currency
+	Amount         string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`                                          // Reserved amount (decimal string)
+	CapturedAmount string                 `protobuf:"bytes,5,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount,omitempty"`    // Amount posted by the capture (decimal string)
+	Status         HoldStatus             `protobuf:"varint,6,opt,name=status,proto3,enum=ledger.HoldStatus" json:"status,omitempty"`                  // Hold status
+	Reference      string                 `protobuf:"bytes,7,opt,name=reference,proto3" json:"reference,omitempty"`                                    // External reference (e.g. payment ID)
+	Description    string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`                                // Free-form description
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                   // When an active hold stops reserving funds
+	JournalEntryId string                 `protobuf:"bytes,10,opt,name=journal_entry_id,json=journalEntryId,proto3" json:"journal_entry_id,omitempty"` // Entry posted by the capture
+	StatusReason   string                 `protobuf:"bytes,11,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`         // Reason given for the release
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                  // Creation timestamp
+	CreatedBy      string                 `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`                  // Identity that created the hold
+	SettledAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`                  // When the hold was captured or released
+	SettledBy      string                 `protobuf:"bytes,15,opt,name=settled_by,json=settledBy,proto3" json:"settled_by,omitempty"`                  // Identity that captured or released the hold
+	Version        int64                  `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`                                      // Version for optimistic locking
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Hold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hold) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Hold) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Hold) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Hold) GetCapturedAmount() string {
+	if x != nil {
+		return x.CapturedAmount
+	}
+	return ""
+}
+
+func (x *Hold) GetStatus() HoldStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+func (x *Hold) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Hold) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Hold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Hold) GetJournalEntryId() string {
+	if x != nil {
+		return x.JournalEntryId
+	}
+	return ""
+}
+
+func (x *Hold) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *Hold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Hold) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Hold) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+func (x *Hold) GetSettledBy() string {
+	if x != nil {
+		return x.SettledBy
+	}
+	return ""
+}
+
+func (x *Hold) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Create hold request
+// Spec: docs/specs/013-holds.md#story-1-create-hold
+type CreateHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // Required: Account to reserve funds on
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                        // Required: Positive decimal string
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional: Defaults to 7 days from now
+	Reference     string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`                  // Optional: External reference
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`              // Optional: Description
+	Actor         string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`                          // Who created the hold (defaults to x-user-id metadata)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHoldRequest) Reset() {
+	*x = CreateHoldRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHoldRequest) ProtoMessage() {}
+
+func (x *CreateHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHoldRequest.ProtoReflect.Descriptor instead.
+func (*CreateHoldRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateHoldRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateHoldRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreateHoldRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateHoldRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CreateHoldRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateHoldRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type CreateHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHoldResponse) Reset() {
+	*x = CreateHoldResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHoldResponse) ProtoMessage() {}
+
+func (x *CreateHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHoldResponse.ProtoReflect.Descriptor instead.
+func (*CreateHoldResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// Capture hold request
+// Spec: docs/specs/013-holds.md#story-2-capture-hold
+type CaptureHoldRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	HoldId           string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`                                // Required: Hold to capture
+	Amount           string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`                                              // Optional: Amount to post, defaults to the full hold
+	OffsetAccountId  string                 `protobuf:"bytes,3,opt,name=offset_account_id,json=offsetAccountId,proto3" json:"offset_account_id,omitempty"`   // Required: Account posted on the other side of the entry
+	EntryDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=entry_date,json=entryDate,proto3" json:"entry_date,omitempty"`                       // Optional: Entry date, defaults to now
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                                    // Optional: Entry description, defaults to the hold's
+	PeriodAdjustment bool                   `protobuf:"varint,6,opt,name=period_adjustment,json=periodAdjustment,proto3" json:"period_adjustment,omitempty"` // Optional: Allow posting into a soft-closed period
+	Actor            string                 `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`                                                // Who captured the hold (defaults to x-user-id metadata)
+	ExchangeRate     string                 `protobuf:"bytes,8,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`              // Optional: Rate to the functional currency for a foreign-currency hold
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CaptureHoldRequest) Reset() {
+	*x = CaptureHoldRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldRequest) ProtoMessage() {}
+
+func (x *CaptureHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldRequest.ProtoReflect.Descriptor instead.
+func (*CaptureHoldRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CaptureHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetOffsetAccountId() string {
+	if x != nil {
+		return x.OffsetAccountId
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetEntryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EntryDate
+	}
+	return nil
+}
+
+func (x *CaptureHoldRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetPeriodAdjustment() bool {
+	if x != nil {
+		return x.PeriodAdjustment
+	}
+	return false
+}
+
+func (x *CaptureHoldRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *CaptureHoldRequest) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+type CaptureHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	JournalEntry  *JournalEntry          `protobuf:"bytes,2,opt,name=journal_entry,json=journalEntry,proto3" json:"journal_entry,omitempty"` // Entry that posted the captured amount
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptureHoldResponse) Reset() {
+	*x = CaptureHoldResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureHoldResponse) ProtoMessage() {}
+
+func (x *CaptureHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureHoldResponse.ProtoReflect.Descriptor instead.
+func (*CaptureHoldResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CaptureHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *CaptureHoldResponse) GetJournalEntry() *JournalEntry {
+	if x != nil {
+		return x.JournalEntry
+	}
+	return nil
+}
+
+// Release hold request
+// Spec: docs/specs/013-holds.md#story-3-release-hold
+type ReleaseHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"` // Required: Hold to release
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`               // Required: Why the hold is released
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                 // Who released the hold (defaults to x-user-id metadata)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldRequest) Reset() {
+	*x = ReleaseHoldRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ReleaseHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ReleaseHoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReleaseHoldRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type ReleaseHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseHoldResponse) Reset() {
+	*x = ReleaseHoldResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReleaseHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// Get hold request
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+type GetHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"` // System hold ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldRequest) Reset() {
+	*x = GetHoldRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldRequest) ProtoMessage() {}
+
+func (x *GetHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldRequest.ProtoReflect.Descriptor instead.
+func (*GetHoldRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+type GetHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldResponse) Reset() {
+	*x = GetHoldResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldResponse) ProtoMessage() {}
+
+func (x *GetHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldResponse.ProtoReflect.Descriptor instead.
+func (*GetHoldResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// List holds request
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+type ListHoldsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`                   // Required: Account whose holds are listed
+	Status         HoldStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=ledger.HoldStatus" json:"status,omitempty"`                  // Optional: Filter by status
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                     // Number of results (max 200)
+	PageToken      string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // Pagination token
+	SkipTotalCount bool                   `protobuf:"varint,5,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"` // Optional: Leave total_count unset to skip counting matches
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListHoldsRequest) Reset() {
+	*x = ListHoldsRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldsRequest) ProtoMessage() {}
+
+func (x *ListHoldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldsRequest.ProtoReflect.Descriptor instead.
+func (*ListHoldsRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListHoldsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListHoldsRequest) GetStatus() HoldStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HoldStatus_HOLD_STATUS_UNSPECIFIED
+}
+
+func (x *ListHoldsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHoldsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListHoldsRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+type ListHoldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holds         []*Hold                `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"` // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHoldsResponse) Reset() {
+	*x = ListHoldsResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAccountResponse) ProtoMessage() {}
+func (*ListHoldsResponse) ProtoMessage() {}
 
-func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[25]
+func (x *ListHoldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,47 +6409,149 @@ func (x *UpdateAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAccountResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAccountResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListHoldsResponse.ProtoReflect.Descriptor instead.
+func (*ListHoldsResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *UpdateAccountResponse) GetAccount() *Account {
+func (x *ListHoldsResponse) GetHolds() []*Hold {
 	if x != nil {
-		return x.Account
+		return x.Holds
 	}
 	return nil
 }
 
-// List accounts request
-// Spec: docs/specs/003-account-management.md#story-4-list-accounts
-type ListAccountsRequest struct {
+func (x *ListHoldsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListHoldsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Run revaluation request
+// Spec: docs/specs/014-multi-currency.md#story-3-run-revaluation
+type RunRevaluationRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AsOf              *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`                                                                 // Required: Balances and entry date of the revaluation
+	RateSource        string                 `protobuf:"bytes,2,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty"`                                               // Required: Source of the closing rates (e.g. ECB)
+	Rates             map[string]string      `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Closing rate to the functional currency per currency code. Currencies not listed are read from the Treasury Service
+	GainLossAccountId string                 `protobuf:"bytes,4,opt,name=gain_loss_account_id,json=gainLossAccountId,proto3" json:"gain_loss_account_id,omitempty"`                      // Required: Functional-currency account for unrealized gains and losses
+	DryRun            bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                          // Optional: Compute adjustments without posting them
+	Actor             string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`                                                                           // Who ran the revaluation (defaults to x-user-id metadata)
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RunRevaluationRequest) Reset() {
+	*x = RunRevaluationRequest{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunRevaluationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRevaluationRequest) ProtoMessage() {}
+
+func (x *RunRevaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRevaluationRequest.ProtoReflect.Descriptor instead.
+func (*RunRevaluationRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RunRevaluationRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *RunRevaluationRequest) GetRateSource() string {
+	if x != nil {
+		return x.RateSource
+	}
+	return ""
+}
+
+func (x *RunRevaluationRequest) GetRates() map[string]string {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *RunRevaluationRequest) GetGainLossAccountId() string {
+	if x != nil {
+		return x.GainLossAccountId
+	}
+	return ""
+}
+
+func (x *RunRevaluationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RunRevaluationRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+// Revaluation of a single account
+// Spec: docs/specs/014-multi-currency.md#revaluation
+type AccountRevaluation struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                  // Number of results (max 200)
-	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                // Pagination token
-	AccountType     AccountType            `protobuf:"varint,3,opt,name=account_type,json=accountType,proto3,enum=ledger.AccountType" json:"account_type,omitempty"` // Filter by type
-	CurrencyCode    string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`                       // Filter by currency
-	ExternalGroupId string                 `protobuf:"bytes,5,opt,name=external_group_id,json=externalGroupId,proto3" json:"external_group_id,omitempty"`            // Filter by group
-	NameSearch      string                 `protobuf:"bytes,6,opt,name=name_search,json=nameSearch,proto3" json:"name_search,omitempty"`                             // Search in name (partial match)
+	AccountId       string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	CurrencyCode    string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`          // Account currency
+	Balance         string                 `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`                                        // Debits minus credits in the account currency
+	ExchangeRate    string                 `protobuf:"bytes,4,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`          // Closing rate applied
+	CarriedBalance  string                 `protobuf:"bytes,5,opt,name=carried_balance,json=carriedBalance,proto3" json:"carried_balance,omitempty"`    // Functional balance before the revaluation
+	RevaluedBalance string                 `protobuf:"bytes,6,opt,name=revalued_balance,json=revaluedBalance,proto3" json:"revalued_balance,omitempty"` // balance x exchange_rate
+	Adjustment      string                 `protobuf:"bytes,7,opt,name=adjustment,proto3" json:"adjustment,omitempty"`                                  // revalued_balance - carried_balance, positive is a debit
+	SkippedReason   string                 `protobuf:"bytes,8,opt,name=skipped_reason,json=skippedReason,proto3" json:"skipped_reason,omitempty"`       // Set when the account was not revalued
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListAccountsRequest) Reset() {
-	*x = ListAccountsRequest{}
-	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[26]
+func (x *AccountRevaluation) Reset() {
+	*x = AccountRevaluation{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAccountsRequest) String() string {
+func (x *AccountRevaluation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsRequest) ProtoMessage() {}
+func (*AccountRevaluation) ProtoMessage() {}
 
-func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[26]
+func (x *AccountRevaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,77 +6562,91 @@ func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
-func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AccountRevaluation.ProtoReflect.Descriptor instead.
+func (*AccountRevaluation) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ListAccountsRequest) GetPageSize() int32 {
+func (x *AccountRevaluation) GetAccountId() string {
 	if x != nil {
-		return x.PageSize
+		return x.AccountId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListAccountsRequest) GetPageToken() string {
+func (x *AccountRevaluation) GetCurrencyCode() string {
 	if x != nil {
-		return x.PageToken
+		return x.CurrencyCode
 	}
 	return ""
 }
 
-func (x *ListAccountsRequest) GetAccountType() AccountType {
+func (x *AccountRevaluation) GetBalance() string {
 	if x != nil {
-		return x.AccountType
+		return x.Balance
 	}
-	return AccountType_ACCOUNT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ListAccountsRequest) GetCurrencyCode() string {
+func (x *AccountRevaluation) GetExchangeRate() string {
 	if x != nil {
-		return x.CurrencyCode
+		return x.ExchangeRate
 	}
 	return ""
 }
 
-func (x *ListAccountsRequest) GetExternalGroupId() string {
+func (x *AccountRevaluation) GetCarriedBalance() string {
 	if x != nil {
-		return x.ExternalGroupId
+		return x.CarriedBalance
 	}
 	return ""
 }
 
-func (x *ListAccountsRequest) GetNameSearch() string {
+func (x *AccountRevaluation) GetRevaluedBalance() string {
 	if x != nil {
-		return x.NameSearch
+		return x.RevaluedBalance
 	}
 	return ""
 }
 
-type ListAccountsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AccountRevaluation) GetAdjustment() string {
+	if x != nil {
+		return x.Adjustment
+	}
+	return ""
 }
 
-func (x *ListAccountsResponse) Reset() {
-	*x = ListAccountsResponse{}
-	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[27]
+func (x *AccountRevaluation) GetSkippedReason() string {
+	if x != nil {
+		return x.SkippedReason
+	}
+	return ""
+}
+
+type RunRevaluationResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	FunctionalCurrencyCode string                 `protobuf:"bytes,1,opt,name=functional_currency_code,json=functionalCurrencyCode,proto3" json:"functional_currency_code,omitempty"`
+	Revaluations           []*AccountRevaluation  `protobuf:"bytes,2,rep,name=revaluations,proto3" json:"revaluations,omitempty"`                     // Every foreign-currency asset and liability account
+	JournalEntry           *JournalEntry          `protobuf:"bytes,3,opt,name=journal_entry,json=journalEntry,proto3" json:"journal_entry,omitempty"` // Entry posted, unset on dry runs or when nothing changed
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RunRevaluationResponse) Reset() {
+	*x = RunRevaluationResponse{}
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAccountsResponse) String() string {
+func (x *RunRevaluationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAccountsResponse) ProtoMessage() {}
+func (*RunRevaluationResponse) ProtoMessage() {}
 
-func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[27]
+func (x *RunRevaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,30 +6657,30 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RunRevaluationResponse.ProtoReflect.Descriptor instead.
+func (*RunRevaluationResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ListAccountsResponse) GetAccounts() []*Account {
+func (x *RunRevaluationResponse) GetFunctionalCurrencyCode() string {
 	if x != nil {
-		return x.Accounts
+		return x.FunctionalCurrencyCode
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAccountsResponse) GetNextPageToken() string {
+func (x *RunRevaluationResponse) GetRevaluations() []*AccountRevaluation {
 	if x != nil {
-		return x.NextPageToken
+		return x.Revaluations
 	}
-	return ""
+	return nil
 }
 
-func (x *ListAccountsResponse) GetTotalCount() int32 {
+func (x *RunRevaluationResponse) GetJournalEntry() *JournalEntry {
 	if x != nil {
-		return x.TotalCount
+		return x.JournalEntry
 	}
-	return 0
+	return nil
 }
 
 var File_services_treasury_services_ledger_service_proto_ledger_service_proto protoreflect.FileDescriptor
@@ -2242,7 +6804,7 @@ const file_services_treasury_services_ledger_service_proto_ledger_service_proto_
 	"\x10idle_connections\x18\x03 \x01(\x05R\x0fidleConnections\x12\x1d\n" +
 	"\n" +
 	"wait_count\x18\x04 \x01(\x05R\twaitCount\x12(\n" +
-	"\x10wait_duration_ms\x18\x05 \x01(\x03R\x0ewaitDurationMs\"\xe7\x02\n" +
+	"\x10wait_duration_ms\x18\x05 \x01(\x03R\x0ewaitDurationMs\"\xaf\x04\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
@@ -2255,7 +6817,12 @@ const file_services_treasury_services_ledger_service_proto_ledger_service_proto_
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
-	"\aversion\x18\t \x01(\x03R\aversion\"\xd4\x01\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\x12-\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x15.ledger.AccountStatusR\x06status\x12#\n" +
+	"\rstatus_reason\x18\v \x01(\tR\fstatusReason\x12*\n" +
+	"\x11status_changed_by\x18\f \x01(\tR\x0fstatusChangedBy\x12F\n" +
+	"\x11status_changed_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\"\xd4\x01\n" +
 	"\x14CreateAccountRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
 	"\vexternal_id\x18\x02 \x01(\tR\n" +
@@ -2264,12 +6831,28 @@ const file_services_treasury_services_ledger_service_proto_ledger_service_proto_
 	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\x126\n" +
 	"\faccount_type\x18\x05 \x01(\x0e2\x13.ledger.AccountTypeR\vaccountType\"B\n" +
 	"\x15CreateAccountResponse\x12)\n" +
-	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\"2\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\"\xa2\x01\n" +
 	"\x11GetAccountRequest\x12\x1d\n" +
 	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\"?\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\bR\bverified\x12\x18\n" +
+	"\bas_of_tx\x18\x03 \x01(\x04R\x06asOfTx\x128\n" +
+	"\n" +
+	"as_of_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\"~\n" +
 	"\x12GetAccountResponse\x12)\n" +
-	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\"@\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\x12=\n" +
+	"\fverification\x18\x02 \x01(\v2\x19.ledger.VerificationProofR\fverification\"\xd3\x01\n" +
+	"\x0fAccountRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\x12\x13\n" +
+	"\x05tx_id\x18\x02 \x01(\x04R\x04txId\x12=\n" +
+	"\fcommitted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcommittedAt\x12)\n" +
+	"\aaccount\x18\x04 \x01(\v2\x0f.ledger.AccountR\aaccount\x12%\n" +
+	"\x0echanged_fields\x18\x05 \x03(\tR\rchangedFields\"9\n" +
+	"\x18GetAccountHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"R\n" +
+	"\x19GetAccountHistoryResponse\x125\n" +
+	"\trevisions\x18\x01 \x03(\v2\x17.ledger.AccountRevisionR\trevisions\"@\n" +
 	"\x1dGetAccountByExternalIdRequest\x12\x1f\n" +
 	"\vexternal_id\x18\x01 \x01(\tR\n" +
 	"externalId\"K\n" +
@@ -2282,7 +6865,7 @@ const file_services_treasury_services_ledger_service_proto_ledger_service_proto_
 	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\"B\n" +
 	"\x15UpdateAccountResponse\x12)\n" +
-	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\"\xfb\x01\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\"\xd4\x02\n" +
 	"\x13ListAccountsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -2291,12 +6874,408 @@ const file_services_treasury_services_ledger_service_proto_ledger_service_proto_
 	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\x12*\n" +
 	"\x11external_group_id\x18\x05 \x01(\tR\x0fexternalGroupId\x12\x1f\n" +
 	"\vname_search\x18\x06 \x01(\tR\n" +
-	"nameSearch\"\x8c\x01\n" +
+	"nameSearch\x12-\n" +
+	"\x06status\x18\a \x01(\x0e2\x15.ledger.AccountStatusR\x06status\x12(\n" +
+	"\x10skip_total_count\x18\b \x01(\bR\x0eskipTotalCount\"\x8c\x01\n" +
 	"\x14ListAccountsResponse\x12+\n" +
 	"\baccounts\x18\x01 \x03(\v2\x0f.ledger.AccountR\baccounts\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x03 \x01(\x05R\n" +
-	"totalCount*9\n" +
+	"totalCount\"c\n" +
+	"\x14FreezeAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\"B\n" +
+	"\x15FreezeAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\"b\n" +
+	"\x13CloseAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\"A\n" +
+	"\x14CloseAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\"c\n" +
+	"\x14ReopenAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\"B\n" +
+	"\x15ReopenAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.ledger.AccountR\aaccount\"\x8e\x04\n" +
+	"\x0eAccountBalance\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x126\n" +
+	"\faccount_type\x18\x03 \x01(\x0e2\x13.ledger.AccountTypeR\vaccountType\x12<\n" +
+	"\x0enormal_balance\x18\x04 \x01(\x0e2\x15.ledger.NormalBalanceR\rnormalBalance\x12\x1f\n" +
+	"\vdebit_total\x18\x05 \x01(\tR\n" +
+	"debitTotal\x12!\n" +
+	"\fcredit_total\x18\x06 \x01(\tR\vcreditTotal\x12\x18\n" +
+	"\abalance\x18\a \x01(\tR\abalance\x12\x1d\n" +
+	"\n" +
+	"line_count\x18\b \x01(\x03R\tlineCount\x128\n" +
+	"\n" +
+	"as_of_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12\x18\n" +
+	"\bas_of_tx\x18\n" +
+	" \x01(\x04R\x06asOfTx\x12%\n" +
+	"\x0eposted_balance\x18\v \x01(\tR\rpostedBalance\x12\x1d\n" +
+	"\n" +
+	"held_total\x18\f \x01(\tR\theldTotal\x12+\n" +
+	"\x11available_balance\x18\r \x01(\tR\x10availableBalance\"\xa9\x01\n" +
+	"\x18GetAccountBalanceRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x128\n" +
+	"\n" +
+	"as_of_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12\x18\n" +
+	"\bas_of_tx\x18\x03 \x01(\x04R\x06asOfTx\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\"\x8c\x01\n" +
+	"\x19GetAccountBalanceResponse\x120\n" +
+	"\abalance\x18\x01 \x01(\v2\x16.ledger.AccountBalanceR\abalance\x12=\n" +
+	"\fverification\x18\x02 \x01(\v2\x19.ledger.VerificationProofR\fverification\"\xac\x01\n" +
+	"\x19GetAccountBalancesRequest\x12\x1f\n" +
+	"\vaccount_ids\x18\x01 \x03(\tR\n" +
+	"accountIds\x128\n" +
+	"\n" +
+	"as_of_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12\x18\n" +
+	"\bas_of_tx\x18\x03 \x01(\x04R\x06asOfTx\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\bR\bverified\"\x8f\x01\n" +
+	"\x1aGetAccountBalancesResponse\x122\n" +
+	"\bbalances\x18\x01 \x03(\v2\x16.ledger.AccountBalanceR\bbalances\x12=\n" +
+	"\fverification\x18\x02 \x01(\v2\x19.ledger.VerificationProofR\fverification\"\xb3\x04\n" +
+	"\fJournalEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"entry_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tentryDate\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12#\n" +
+	"\rcurrency_code\x18\x05 \x01(\tR\fcurrencyCode\x122\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1a.ledger.JournalEntryStatusR\x06status\x12.\n" +
+	"\x05lines\x18\a \x03(\v2\x18.ledger.JournalEntryLineR\x05lines\x12>\n" +
+	"\bmetadata\x18\b \x03(\v2\".ledger.JournalEntry.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x128\n" +
+	"\x18functional_currency_code\x18\v \x01(\tR\x16functionalCurrencyCode\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x88\x03\n" +
+	"\x10JournalEntryLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vline_number\x18\x02 \x01(\x05R\n" +
+	"lineNumber\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12!\n" +
+	"\fdebit_amount\x18\x04 \x01(\tR\vdebitAmount\x12#\n" +
+	"\rcredit_amount\x18\x05 \x01(\tR\fcreditAmount\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12#\n" +
+	"\rcurrency_code\x18\a \x01(\tR\fcurrencyCode\x12#\n" +
+	"\rexchange_rate\x18\b \x01(\tR\fexchangeRate\x126\n" +
+	"\x17functional_debit_amount\x18\t \x01(\tR\x15functionalDebitAmount\x128\n" +
+	"\x18functional_credit_amount\x18\n" +
+	" \x01(\tR\x16functionalCreditAmount\"\xc3\x03\n" +
+	"\x17PostJournalEntryRequest\x129\n" +
+	"\n" +
+	"entry_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tentryDate\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12#\n" +
+	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\x12.\n" +
+	"\x05lines\x18\x05 \x03(\v2\x18.ledger.JournalEntryLineR\x05lines\x12I\n" +
+	"\bmetadata\x18\x06 \x03(\v2-.ledger.PostJournalEntryRequest.MetadataEntryR\bmetadata\x12+\n" +
+	"\x11period_adjustment\x18\a \x01(\bR\x10periodAdjustment\x12#\n" +
+	"\rexchange_rate\x18\b \x01(\tR\fexchangeRate\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\x18PostJournalEntryResponse\x129\n" +
+	"\rjournal_entry\x18\x01 \x01(\v2\x14.ledger.JournalEntryR\fjournalEntry\"^\n" +
+	"\x16GetJournalEntryRequest\x12(\n" +
+	"\x10journal_entry_id\x18\x01 \x01(\tR\x0ejournalEntryId\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\bR\bverified\"\x93\x01\n" +
+	"\x17GetJournalEntryResponse\x129\n" +
+	"\rjournal_entry\x18\x01 \x01(\v2\x14.ledger.JournalEntryR\fjournalEntry\x12=\n" +
+	"\fverification\x18\x02 \x01(\v2\x19.ledger.VerificationProofR\fverification\"\xd2\x02\n" +
+	"\x19ListJournalEntriesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12#\n" +
+	"\rcurrency_code\x18\x03 \x01(\tR\fcurrencyCode\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bverified\x18\a \x01(\bR\bverified\x12(\n" +
+	"\x10skip_total_count\x18\b \x01(\bR\x0eskipTotalCount\"\xe5\x01\n" +
+	"\x1aListJournalEntriesResponse\x12=\n" +
+	"\x0fjournal_entries\x18\x01 \x03(\v2\x14.ledger.JournalEntryR\x0ejournalEntries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12?\n" +
+	"\rverifications\x18\x04 \x03(\v2\x19.ledger.VerificationProofR\rverifications\"\x98\x04\n" +
+	"\x11VerificationProof\x12\x1a\n" +
+	"\bdatabase\x18\x01 \x01(\tR\bdatabase\x12\x13\n" +
+	"\x05tx_id\x18\x02 \x01(\x04R\x04txId\x12\x17\n" +
+	"\atx_hash\x18\x03 \x01(\fR\x06txHash\x123\n" +
+	"\atx_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06txTime\x12%\n" +
+	"\x0einclusion_leaf\x18\x05 \x01(\x05R\rinclusionLeaf\x12'\n" +
+	"\x0finclusion_width\x18\x06 \x01(\x05R\x0einclusionWidth\x12'\n" +
+	"\x0finclusion_proof\x18\a \x03(\fR\x0einclusionProof\x12+\n" +
+	"\x11consistency_proof\x18\b \x03(\fR\x10consistencyProof\x12\"\n" +
+	"\rtrusted_tx_id\x18\t \x01(\x04R\vtrustedTxId\x12&\n" +
+	"\x0ftrusted_tx_hash\x18\n" +
+	" \x01(\fR\rtrustedTxHash\x12'\n" +
+	"\x0fstate_signature\x18\v \x01(\fR\x0estateSignature\x12,\n" +
+	"\x12signing_public_key\x18\f \x01(\fR\x10signingPublicKey\x12;\n" +
+	"\vverified_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\"\xff\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\ventity_type\x18\x02 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"old_values\x18\x05 \x01(\tR\toldValues\x12\x1d\n" +
+	"\n" +
+	"new_values\x18\x06 \x01(\tR\tnewValues\x12\x17\n" +
+	"\auser_id\x18\a \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\bmetadata\x18\t \x03(\v2 .ledger.AuditEvent.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\ventity_type\x18\x03 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x04 \x01(\tR\bentityId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12(\n" +
+	"\x10skip_total_count\x18\b \x01(\bR\x0eskipTotalCount\"\x8e\x01\n" +
+	"\x17ListAuditEventsResponse\x12*\n" +
+	"\x06events\x18\x01 \x03(\v2\x12.ledger.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xbd\x02\n" +
+	"\x10TrialBalanceLine\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x126\n" +
+	"\faccount_type\x18\x04 \x01(\x0e2\x13.ledger.AccountTypeR\vaccountType\x12\x1f\n" +
+	"\vdebit_total\x18\x05 \x01(\tR\n" +
+	"debitTotal\x12!\n" +
+	"\fcredit_total\x18\x06 \x01(\tR\vcreditTotal\x12#\n" +
+	"\rdebit_balance\x18\a \x01(\tR\fdebitBalance\x12%\n" +
+	"\x0ecredit_balance\x18\b \x01(\tR\rcreditBalance\"\xbd\x01\n" +
+	"\x16GetTrialBalanceRequest\x128\n" +
+	"\n" +
+	"as_of_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12*\n" +
+	"\x11external_group_id\x18\x03 \x01(\tR\x0fexternalGroupId\x12\x18\n" +
+	"\bas_of_tx\x18\x04 \x01(\x04R\x06asOfTx\"\xab\x02\n" +
+	"\x17GetTrialBalanceResponse\x12.\n" +
+	"\x05lines\x18\x01 \x03(\v2\x18.ledger.TrialBalanceLineR\x05lines\x12!\n" +
+	"\ftotal_debits\x18\x02 \x01(\tR\vtotalDebits\x12#\n" +
+	"\rtotal_credits\x18\x03 \x01(\tR\ftotalCredits\x12\x1f\n" +
+	"\vis_balanced\x18\x04 \x01(\bR\n" +
+	"isBalanced\x12#\n" +
+	"\rcurrency_code\x18\x05 \x01(\tR\fcurrencyCode\x128\n" +
+	"\n" +
+	"as_of_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12\x18\n" +
+	"\bas_of_tx\x18\a \x01(\x04R\x06asOfTx\"\x87\x01\n" +
+	"\n" +
+	"ReportLine\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\"\x87\x01\n" +
+	"\rReportSection\x126\n" +
+	"\faccount_type\x18\x01 \x01(\x0e2\x13.ledger.AccountTypeR\vaccountType\x12(\n" +
+	"\x05lines\x18\x02 \x03(\v2\x12.ledger.ReportLineR\x05lines\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\tR\x05total\"\xbd\x01\n" +
+	"\x16GetBalanceSheetRequest\x128\n" +
+	"\n" +
+	"as_of_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12*\n" +
+	"\x11external_group_id\x18\x03 \x01(\tR\x0fexternalGroupId\x12\x18\n" +
+	"\bas_of_tx\x18\x04 \x01(\x04R\x06asOfTx\"\xdb\x03\n" +
+	"\x17GetBalanceSheetResponse\x12-\n" +
+	"\x06assets\x18\x01 \x01(\v2\x15.ledger.ReportSectionR\x06assets\x127\n" +
+	"\vliabilities\x18\x02 \x01(\v2\x15.ledger.ReportSectionR\vliabilities\x12-\n" +
+	"\x06equity\x18\x03 \x01(\v2\x15.ledger.ReportSectionR\x06equity\x12+\n" +
+	"\x11retained_earnings\x18\x04 \x01(\tR\x10retainedEarnings\x12!\n" +
+	"\ftotal_assets\x18\x05 \x01(\tR\vtotalAssets\x12?\n" +
+	"\x1ctotal_liabilities_and_equity\x18\x06 \x01(\tR\x19totalLiabilitiesAndEquity\x12\x1f\n" +
+	"\vis_balanced\x18\a \x01(\bR\n" +
+	"isBalanced\x12#\n" +
+	"\rcurrency_code\x18\b \x01(\tR\fcurrencyCode\x128\n" +
+	"\n" +
+	"as_of_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12\x18\n" +
+	"\bas_of_tx\x18\n" +
+	" \x01(\x04R\x06asOfTx\"\xf8\x01\n" +
+	"\x19GetIncomeStatementRequest\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12#\n" +
+	"\rcurrency_code\x18\x03 \x01(\tR\fcurrencyCode\x12*\n" +
+	"\x11external_group_id\x18\x04 \x01(\tR\x0fexternalGroupId\x12\x18\n" +
+	"\bas_of_tx\x18\x05 \x01(\x04R\x06asOfTx\"\x9c\x03\n" +
+	"\x1aGetIncomeStatementResponse\x12/\n" +
+	"\arevenue\x18\x01 \x01(\v2\x15.ledger.ReportSectionR\arevenue\x121\n" +
+	"\bexpenses\x18\x02 \x01(\v2\x15.ledger.ReportSectionR\bexpenses\x12#\n" +
+	"\rtotal_revenue\x18\x03 \x01(\tR\ftotalRevenue\x12%\n" +
+	"\x0etotal_expenses\x18\x04 \x01(\tR\rtotalExpenses\x12\x1d\n" +
+	"\n" +
+	"net_income\x18\x05 \x01(\tR\tnetIncome\x12#\n" +
+	"\rcurrency_code\x18\x06 \x01(\tR\fcurrencyCode\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x18\n" +
+	"\bas_of_tx\x18\t \x01(\x04R\x06asOfTx\"\xd1\x03\n" +
+	"\x10AccountingPeriod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12,\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x14.ledger.PeriodStatusR\x06status\x12#\n" +
+	"\rstatus_reason\x18\a \x01(\tR\fstatusReason\x12*\n" +
+	"\x11status_changed_by\x18\b \x01(\tR\x0fstatusChangedBy\x12F\n" +
+	"\x11status_changed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\x12%\n" +
+	"\x0esnapshot_count\x18\n" +
+	" \x01(\x05R\rsnapshotCount\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\"\x8b\x01\n" +
+	"\x12ClosePeriodRequest\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x12\n" +
+	"\x04soft\x18\x03 \x01(\bR\x04soft\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\"G\n" +
+	"\x13ClosePeriodResponse\x120\n" +
+	"\x06period\x18\x01 \x01(\v2\x18.ledger.AccountingPeriodR\x06period\"x\n" +
+	"\x13ReopenPeriodRequest\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\"H\n" +
+	"\x14ReopenPeriodResponse\x120\n" +
+	"\x06period\x18\x01 \x01(\v2\x18.ledger.AccountingPeriodR\x06period\"1\n" +
+	"\x12ListPeriodsRequest\x12\x1b\n" +
+	"\tentity_id\x18\x01 \x01(\tR\bentityId\"I\n" +
+	"\x13ListPeriodsResponse\x122\n" +
+	"\aperiods\x18\x01 \x03(\v2\x18.ledger.AccountingPeriodR\aperiods\"\xdf\x04\n" +
+	"\x04Hold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12#\n" +
+	"\rcurrency_code\x18\x03 \x01(\tR\fcurrencyCode\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12'\n" +
+	"\x0fcaptured_amount\x18\x05 \x01(\tR\x0ecapturedAmount\x12*\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x12.ledger.HoldStatusR\x06status\x12\x1c\n" +
+	"\treference\x18\a \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12(\n" +
+	"\x10journal_entry_id\x18\n" +
+	" \x01(\tR\x0ejournalEntryId\x12#\n" +
+	"\rstatus_reason\x18\v \x01(\tR\fstatusReason\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\r \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"settled_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\x12\x1d\n" +
+	"\n" +
+	"settled_by\x18\x0f \x01(\tR\tsettledBy\x12\x18\n" +
+	"\aversion\x18\x10 \x01(\x03R\aversion\"\xdb\x01\n" +
+	"\x11CreateHoldRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\"6\n" +
+	"\x12CreateHoldResponse\x12 \n" +
+	"\x04hold\x18\x01 \x01(\v2\f.ledger.HoldR\x04hold\"\xb6\x02\n" +
+	"\x12CaptureHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12*\n" +
+	"\x11offset_account_id\x18\x03 \x01(\tR\x0foffsetAccountId\x129\n" +
+	"\n" +
+	"entry_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tentryDate\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12+\n" +
+	"\x11period_adjustment\x18\x06 \x01(\bR\x10periodAdjustment\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12#\n" +
+	"\rexchange_rate\x18\b \x01(\tR\fexchangeRate\"r\n" +
+	"\x13CaptureHoldResponse\x12 \n" +
+	"\x04hold\x18\x01 \x01(\v2\f.ledger.HoldR\x04hold\x129\n" +
+	"\rjournal_entry\x18\x02 \x01(\v2\x14.ledger.JournalEntryR\fjournalEntry\"[\n" +
+	"\x12ReleaseHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\"7\n" +
+	"\x13ReleaseHoldResponse\x12 \n" +
+	"\x04hold\x18\x01 \x01(\v2\f.ledger.HoldR\x04hold\")\n" +
+	"\x0eGetHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\"3\n" +
+	"\x0fGetHoldResponse\x12 \n" +
+	"\x04hold\x18\x01 \x01(\v2\f.ledger.HoldR\x04hold\"\xc3\x01\n" +
+	"\x10ListHoldsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12*\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.ledger.HoldStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12(\n" +
+	"\x10skip_total_count\x18\x05 \x01(\bR\x0eskipTotalCount\"\x80\x01\n" +
+	"\x11ListHoldsResponse\x12\"\n" +
+	"\x05holds\x18\x01 \x03(\v2\f.ledger.HoldR\x05holds\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xc3\x02\n" +
+	"\x15RunRevaluationRequest\x12/\n" +
+	"\x05as_of\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1f\n" +
+	"\vrate_source\x18\x02 \x01(\tR\n" +
+	"rateSource\x12>\n" +
+	"\x05rates\x18\x03 \x03(\v2(.ledger.RunRevaluationRequest.RatesEntryR\x05rates\x12/\n" +
+	"\x14gain_loss_account_id\x18\x04 \x01(\tR\x11gainLossAccountId\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x1a8\n" +
+	"\n" +
+	"RatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb2\x02\n" +
+	"\x12AccountRevaluation\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\tR\abalance\x12#\n" +
+	"\rexchange_rate\x18\x04 \x01(\tR\fexchangeRate\x12'\n" +
+	"\x0fcarried_balance\x18\x05 \x01(\tR\x0ecarriedBalance\x12)\n" +
+	"\x10revalued_balance\x18\x06 \x01(\tR\x0frevaluedBalance\x12\x1e\n" +
+	"\n" +
+	"adjustment\x18\a \x01(\tR\n" +
+	"adjustment\x12%\n" +
+	"\x0eskipped_reason\x18\b \x01(\tR\rskippedReason\"\xcd\x01\n" +
+	"\x16RunRevaluationResponse\x128\n" +
+	"\x18functional_currency_code\x18\x01 \x01(\tR\x16functionalCurrencyCode\x12>\n" +
+	"\frevaluations\x18\x02 \x03(\v2\x1a.ledger.AccountRevaluationR\frevaluations\x129\n" +
+	"\rjournal_entry\x18\x03 \x01(\v2\x14.ledger.JournalEntryR\fjournalEntry*9\n" +
 	"\rServiceStatus\x12\v\n" +
 	"\aHEALTHY\x10\x00\x12\f\n" +
 	"\bDEGRADED\x10\x01\x12\r\n" +
@@ -2315,19 +7294,76 @@ const file_services_treasury_services_ledger_service_proto_ledger_service_proto_
 	"\x16ACCOUNT_TYPE_LIABILITY\x10\x02\x12\x18\n" +
 	"\x14ACCOUNT_TYPE_REVENUE\x10\x03\x12\x18\n" +
 	"\x14ACCOUNT_TYPE_EXPENSE\x10\x04\x12\x17\n" +
-	"\x13ACCOUNT_TYPE_EQUITY\x10\x052N\n" +
+	"\x13ACCOUNT_TYPE_EQUITY\x10\x05*\xb9\x01\n" +
+	"\rAccountStatus\x12\x1e\n" +
+	"\x1aACCOUNT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ACCOUNT_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
+	"\x17ACCOUNT_STATUS_INACTIVE\x10\x02\x12\x19\n" +
+	"\x15ACCOUNT_STATUS_CLOSED\x10\x03\x12\x19\n" +
+	"\x15ACCOUNT_STATUS_FROZEN\x10\x04\x12\x1a\n" +
+	"\x16ACCOUNT_STATUS_PENDING\x10\x05*d\n" +
+	"\rNormalBalance\x12\x1e\n" +
+	"\x1aNORMAL_BALANCE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14NORMAL_BALANCE_DEBIT\x10\x01\x12\x19\n" +
+	"\x15NORMAL_BALANCE_CREDIT\x10\x02*\xa1\x01\n" +
+	"\x12JournalEntryStatus\x12$\n" +
+	" JOURNAL_ENTRY_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cJOURNAL_ENTRY_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bJOURNAL_ENTRY_STATUS_POSTED\x10\x02\x12\"\n" +
+	"\x1eJOURNAL_ENTRY_STATUS_CANCELLED\x10\x03*~\n" +
+	"\fPeriodStatus\x12\x1d\n" +
+	"\x19PERIOD_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PERIOD_STATUS_OPEN\x10\x01\x12\x1d\n" +
+	"\x19PERIOD_STATUS_SOFT_CLOSED\x10\x02\x12\x18\n" +
+	"\x14PERIOD_STATUS_CLOSED\x10\x03*\x8e\x01\n" +
+	"\n" +
+	"HoldStatus\x12\x1b\n" +
+	"\x17HOLD_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12HOLD_STATUS_ACTIVE\x10\x01\x12\x18\n" +
+	"\x14HOLD_STATUS_CAPTURED\x10\x02\x12\x18\n" +
+	"\x14HOLD_STATUS_RELEASED\x10\x03\x12\x17\n" +
+	"\x13HOLD_STATUS_EXPIRED\x10\x042N\n" +
 	"\bManifest\x12B\n" +
 	"\vGetManifest\x12\x17.ledger.ManifestRequest\x1a\x18.ledger.ManifestResponse\"\x002\x8a\x01\n" +
 	"\x06Health\x12B\n" +
 	"\vGetLiveness\x12\x17.ledger.LivenessRequest\x1a\x18.ledger.LivenessResponse\"\x00\x12<\n" +
-	"\tGetHealth\x12\x15.ledger.HealthRequest\x1a\x16.ledger.HealthResponse\"\x002\xaf\x03\n" +
+	"\tGetHealth\x12\x15.ledger.HealthRequest\x1a\x16.ledger.HealthResponse\"\x002\xb3\a\n" +
 	"\x0eAccountService\x12N\n" +
 	"\rCreateAccount\x12\x1c.ledger.CreateAccountRequest\x1a\x1d.ledger.CreateAccountResponse\"\x00\x12E\n" +
 	"\n" +
 	"GetAccount\x12\x19.ledger.GetAccountRequest\x1a\x1a.ledger.GetAccountResponse\"\x00\x12i\n" +
 	"\x16GetAccountByExternalId\x12%.ledger.GetAccountByExternalIdRequest\x1a&.ledger.GetAccountByExternalIdResponse\"\x00\x12N\n" +
 	"\rUpdateAccount\x12\x1c.ledger.UpdateAccountRequest\x1a\x1d.ledger.UpdateAccountResponse\"\x00\x12K\n" +
-	"\fListAccounts\x12\x1b.ledger.ListAccountsRequest\x1a\x1c.ledger.ListAccountsResponse\"\x00B'Z%example.com/go-mono-repo/proto/ledgerb\x06proto3"
+	"\fListAccounts\x12\x1b.ledger.ListAccountsRequest\x1a\x1c.ledger.ListAccountsResponse\"\x00\x12Z\n" +
+	"\x11GetAccountBalance\x12 .ledger.GetAccountBalanceRequest\x1a!.ledger.GetAccountBalanceResponse\"\x00\x12]\n" +
+	"\x12GetAccountBalances\x12!.ledger.GetAccountBalancesRequest\x1a\".ledger.GetAccountBalancesResponse\"\x00\x12Z\n" +
+	"\x11GetAccountHistory\x12 .ledger.GetAccountHistoryRequest\x1a!.ledger.GetAccountHistoryResponse\"\x00\x12N\n" +
+	"\rFreezeAccount\x12\x1c.ledger.FreezeAccountRequest\x1a\x1d.ledger.FreezeAccountResponse\"\x00\x12K\n" +
+	"\fCloseAccount\x12\x1b.ledger.CloseAccountRequest\x1a\x1c.ledger.CloseAccountResponse\"\x00\x12N\n" +
+	"\rReopenAccount\x12\x1c.ledger.ReopenAccountRequest\x1a\x1d.ledger.ReopenAccountResponse\"\x002\x9e\x02\n" +
+	"\x0eJournalService\x12W\n" +
+	"\x10PostJournalEntry\x12\x1f.ledger.PostJournalEntryRequest\x1a .ledger.PostJournalEntryResponse\"\x00\x12T\n" +
+	"\x0fGetJournalEntry\x12\x1e.ledger.GetJournalEntryRequest\x1a\x1f.ledger.GetJournalEntryResponse\"\x00\x12]\n" +
+	"\x12ListJournalEntries\x12!.ledger.ListJournalEntriesRequest\x1a\".ledger.ListJournalEntriesResponse\"\x002d\n" +
+	"\fAuditService\x12T\n" +
+	"\x0fListAuditEvents\x12\x1e.ledger.ListAuditEventsRequest\x1a\x1f.ledger.ListAuditEventsResponse\"\x002\x9d\x02\n" +
+	"\x10ReportingService\x12T\n" +
+	"\x0fGetTrialBalance\x12\x1e.ledger.GetTrialBalanceRequest\x1a\x1f.ledger.GetTrialBalanceResponse\"\x00\x12T\n" +
+	"\x0fGetBalanceSheet\x12\x1e.ledger.GetBalanceSheetRequest\x1a\x1f.ledger.GetBalanceSheetResponse\"\x00\x12]\n" +
+	"\x12GetIncomeStatement\x12!.ledger.GetIncomeStatementRequest\x1a\".ledger.GetIncomeStatementResponse\"\x002\xf0\x01\n" +
+	"\rPeriodService\x12H\n" +
+	"\vClosePeriod\x12\x1a.ledger.ClosePeriodRequest\x1a\x1b.ledger.ClosePeriodResponse\"\x00\x12K\n" +
+	"\fReopenPeriod\x12\x1b.ledger.ReopenPeriodRequest\x1a\x1c.ledger.ReopenPeriodResponse\"\x00\x12H\n" +
+	"\vListPeriods\x12\x1a.ledger.ListPeriodsRequest\x1a\x1b.ledger.ListPeriodsResponse\"\x002\xea\x02\n" +
+	"\vHoldService\x12E\n" +
+	"\n" +
+	"CreateHold\x12\x19.ledger.CreateHoldRequest\x1a\x1a.ledger.CreateHoldResponse\"\x00\x12H\n" +
+	"\vCaptureHold\x12\x1a.ledger.CaptureHoldRequest\x1a\x1b.ledger.CaptureHoldResponse\"\x00\x12H\n" +
+	"\vReleaseHold\x12\x1a.ledger.ReleaseHoldRequest\x1a\x1b.ledger.ReleaseHoldResponse\"\x00\x12<\n" +
+	"\aGetHold\x12\x16.ledger.GetHoldRequest\x1a\x17.ledger.GetHoldResponse\"\x00\x12B\n" +
+	"\tListHolds\x12\x18.ledger.ListHoldsRequest\x1a\x19.ledger.ListHoldsResponse\"\x002g\n" +
+	"\x12RevaluationService\x12Q\n" +
+	"\x0eRunRevaluation\x12\x1d.ledger.RunRevaluationRequest\x1a\x1e.ledger.RunRevaluationResponse\"\x00B'Z%example.com/go-mono-repo/proto/ledgerb\x06proto3"
 
 var (
 	file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescOnce sync.Once
@@ -2341,97 +7377,289 @@ func file_services_treasury_services_ledger_service_proto_ledger_service_proto_r
 	return file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDescData
 }
 
-var file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_services_treasury_services_ledger_service_proto_ledger_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_services_treasury_services_ledger_service_proto_ledger_service_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_services_treasury_services_ledger_service_proto_ledger_service_proto_goTypes = []any{
 	(ServiceStatus)(0),                     // 0: ledger.ServiceStatus
 	(DependencyType)(0),                    // 1: ledger.DependencyType
 	(AccountType)(0),                       // 2: ledger.AccountType
-	(*ManifestRequest)(nil),                // 3: ledger.ManifestRequest
-	(*ManifestResponse)(nil),               // 4: ledger.ManifestResponse
-	(*ServiceIdentity)(nil),                // 5: ledger.ServiceIdentity
-	(*BuildInfo)(nil),                      // 6: ledger.BuildInfo
-	(*RuntimeInfo)(nil),                    // 7: ledger.RuntimeInfo
-	(*ServiceMetadata)(nil),                // 8: ledger.ServiceMetadata
-	(*ServiceCapabilities)(nil),            // 9: ledger.ServiceCapabilities
-	(*ServiceDependency)(nil),              // 10: ledger.ServiceDependency
-	(*LivenessRequest)(nil),                // 11: ledger.LivenessRequest
-	(*LivenessResponse)(nil),               // 12: ledger.LivenessResponse
-	(*HealthRequest)(nil),                  // 13: ledger.HealthRequest
-	(*HealthResponse)(nil),                 // 14: ledger.HealthResponse
-	(*ComponentCheck)(nil),                 // 15: ledger.ComponentCheck
-	(*LivenessInfo)(nil),                   // 16: ledger.LivenessInfo
-	(*DependencyHealth)(nil),               // 17: ledger.DependencyHealth
-	(*DependencyConfig)(nil),               // 18: ledger.DependencyConfig
-	(*ConnectionPoolInfo)(nil),             // 19: ledger.ConnectionPoolInfo
-	(*Account)(nil),                        // 20: ledger.Account
-	(*CreateAccountRequest)(nil),           // 21: ledger.CreateAccountRequest
-	(*CreateAccountResponse)(nil),          // 22: ledger.CreateAccountResponse
-	(*GetAccountRequest)(nil),              // 23: ledger.GetAccountRequest
-	(*GetAccountResponse)(nil),             // 24: ledger.GetAccountResponse
-	(*GetAccountByExternalIdRequest)(nil),  // 25: ledger.GetAccountByExternalIdRequest
-	(*GetAccountByExternalIdResponse)(nil), // 26: ledger.GetAccountByExternalIdResponse
-	(*UpdateAccountRequest)(nil),           // 27: ledger.UpdateAccountRequest
-	(*UpdateAccountResponse)(nil),          // 28: ledger.UpdateAccountResponse
-	(*ListAccountsRequest)(nil),            // 29: ledger.ListAccountsRequest
-	(*ListAccountsResponse)(nil),           // 30: ledger.ListAccountsResponse
-	nil,                                    // 31: ledger.ServiceMetadata.LabelsEntry
-	nil,                                    // 32: ledger.DependencyConfig.MetadataEntry
-	(*timestamppb.Timestamp)(nil),          // 33: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),          // 34: google.protobuf.FieldMask
+	(AccountStatus)(0),                     // 3: ledger.AccountStatus
+	(NormalBalance)(0),                     // 4: ledger.NormalBalance
+	(JournalEntryStatus)(0),                // 5: ledger.JournalEntryStatus
+	(PeriodStatus)(0),                      // 6: ledger.PeriodStatus
+	(HoldStatus)(0),                        // 7: ledger.HoldStatus
+	(*ManifestRequest)(nil),                // 8: ledger.ManifestRequest
+	(*ManifestResponse)(nil),               // 9: ledger.ManifestResponse
+	(*ServiceIdentity)(nil),                // 10: ledger.ServiceIdentity
+	(*BuildInfo)(nil),                      // 11: ledger.BuildInfo
+	(*RuntimeInfo)(nil),                    // 12: ledger.RuntimeInfo
+	(*ServiceMetadata)(nil),                // 13: ledger.ServiceMetadata
+	(*ServiceCapabilities)(nil),            // 14: ledger.ServiceCapabilities
+	(*ServiceDependency)(nil),              // 15: ledger.ServiceDependency
+	(*LivenessRequest)(nil),                // 16: ledger.LivenessRequest
+	(*LivenessResponse)(nil),               // 17: ledger.LivenessResponse
+	(*HealthRequest)(nil),                  // 18: ledger.HealthRequest
+	(*HealthResponse)(nil),                 // 19: ledger.HealthResponse
+	(*ComponentCheck)(nil),                 // 20: ledger.ComponentCheck
+	(*LivenessInfo)(nil),                   // 21: ledger.LivenessInfo
+	(*DependencyHealth)(nil),               // 22: ledger.DependencyHealth
+	(*DependencyConfig)(nil),               // 23: ledger.DependencyConfig
+	(*ConnectionPoolInfo)(nil),             // 24: ledger.ConnectionPoolInfo
+	(*Account)(nil),                        // 25: ledger.Account
+	(*CreateAccountRequest)(nil),           // 26: ledger.CreateAccountRequest
+	(*CreateAccountResponse)(nil),          // 27: ledger.CreateAccountResponse
+	(*GetAccountRequest)(nil),              // 28: ledger.GetAccountRequest
+	(*GetAccountResponse)(nil),             // 29: ledger.GetAccountResponse
+	(*AccountRevision)(nil),                // 30: ledger.AccountRevision
+	(*GetAccountHistoryRequest)(nil),       // 31: ledger.GetAccountHistoryRequest
+	(*GetAccountHistoryResponse)(nil),      // 32: ledger.GetAccountHistoryResponse
+	(*GetAccountByExternalIdRequest)(nil),  // 33: ledger.GetAccountByExternalIdRequest
+	(*GetAccountByExternalIdResponse)(nil), // 34: ledger.GetAccountByExternalIdResponse
+	(*UpdateAccountRequest)(nil),           // 35: ledger.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),          // 36: ledger.UpdateAccountResponse
+	(*ListAccountsRequest)(nil),            // 37: ledger.ListAccountsRequest
+	(*ListAccountsResponse)(nil),           // 38: ledger.ListAccountsResponse
+	(*FreezeAccountRequest)(nil),           // 39: ledger.FreezeAccountRequest
+	(*FreezeAccountResponse)(nil),          // 40: ledger.FreezeAccountResponse
+	(*CloseAccountRequest)(nil),            // 41: ledger.CloseAccountRequest
+	(*CloseAccountResponse)(nil),           // 42: ledger.CloseAccountResponse
+	(*ReopenAccountRequest)(nil),           // 43: ledger.ReopenAccountRequest
+	(*ReopenAccountResponse)(nil),          // 44: ledger.ReopenAccountResponse
+	(*AccountBalance)(nil),                 // 45: ledger.AccountBalance
+	(*GetAccountBalanceRequest)(nil),       // 46: ledger.GetAccountBalanceRequest
+	(*GetAccountBalanceResponse)(nil),      // 47: ledger.GetAccountBalanceResponse
+	(*GetAccountBalancesRequest)(nil),      // 48: ledger.GetAccountBalancesRequest
+	(*GetAccountBalancesResponse)(nil),     // 49: ledger.GetAccountBalancesResponse
+	(*JournalEntry)(nil),                   // 50: ledger.JournalEntry
+	(*JournalEntryLine)(nil),               // 51: ledger.JournalEntryLine
+	(*PostJournalEntryRequest)(nil),        // 52: ledger.PostJournalEntryRequest
+	(*PostJournalEntryResponse)(nil),       // 53: ledger.PostJournalEntryResponse
+	(*GetJournalEntryRequest)(nil),         // 54: ledger.GetJournalEntryRequest
+	(*GetJournalEntryResponse)(nil),        // 55: ledger.GetJournalEntryResponse
+	(*ListJournalEntriesRequest)(nil),      // 56: ledger.ListJournalEntriesRequest
+	(*ListJournalEntriesResponse)(nil),     // 57: ledger.ListJournalEntriesResponse
+	(*VerificationProof)(nil),              // 58: ledger.VerificationProof
+	(*AuditEvent)(nil),                     // 59: ledger.AuditEvent
+	(*ListAuditEventsRequest)(nil),         // 60: ledger.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),        // 61: ledger.ListAuditEventsResponse
+	(*TrialBalanceLine)(nil),               // 62: ledger.TrialBalanceLine
+	(*GetTrialBalanceRequest)(nil),         // 63: ledger.GetTrialBalanceRequest
+	(*GetTrialBalanceResponse)(nil),        // 64: ledger.GetTrialBalanceResponse
+	(*ReportLine)(nil),                     // 65: ledger.ReportLine
+	(*ReportSection)(nil),                  // 66: ledger.ReportSection
+	(*GetBalanceSheetRequest)(nil),         // 67: ledger.GetBalanceSheetRequest
+	(*GetBalanceSheetResponse)(nil),        // 68: ledger.GetBalanceSheetResponse
+	(*GetIncomeStatementRequest)(nil),      // 69: ledger.GetIncomeStatementRequest
+	(*GetIncomeStatementResponse)(nil),     // 70: ledger.GetIncomeStatementResponse
+	(*AccountingPeriod)(nil),               // 71: ledger.AccountingPeriod
+	(*ClosePeriodRequest)(nil),             // 72: ledger.ClosePeriodRequest
+	(*ClosePeriodResponse)(nil),            // 73: ledger.ClosePeriodResponse
+	(*ReopenPeriodRequest)(nil),            // 74: ledger.ReopenPeriodRequest
+	(*ReopenPeriodResponse)(nil),           // 75: ledger.ReopenPeriodResponse
+	(*ListPeriodsRequest)(nil),             // 76: ledger.ListPeriodsRequest
+	(*ListPeriodsResponse)(nil),            // 77: ledger.ListPeriodsResponse
+	(*Hold)(nil),                           // 78: ledger.Hold
+	(*CreateHoldRequest)(nil),              // 79: ledger.CreateHoldRequest
+	(*CreateHoldResponse)(nil),             // 80: ledger.CreateHoldResponse
+	(*CaptureHoldRequest)(nil),             // 81: ledger.CaptureHoldRequest
+	(*CaptureHoldResponse)(nil),            // 82: ledger.CaptureHoldResponse
+	(*ReleaseHoldRequest)(nil),             // 83: ledger.ReleaseHoldRequest
+	(*ReleaseHoldResponse)(nil),            // 84: ledger.ReleaseHoldResponse
+	(*GetHoldRequest)(nil),                 // 85: ledger.GetHoldRequest
+	(*GetHoldResponse)(nil),                // 86: ledger.GetHoldResponse
+	(*ListHoldsRequest)(nil),               // 87: ledger.ListHoldsRequest
+	(*ListHoldsResponse)(nil),              // 88: ledger.ListHoldsResponse
+	(*RunRevaluationRequest)(nil),          // 89: ledger.RunRevaluationRequest
+	(*AccountRevaluation)(nil),             // 90: ledger.AccountRevaluation
+	(*RunRevaluationResponse)(nil),         // 91: ledger.RunRevaluationResponse
+	nil,                                    // 92: ledger.ServiceMetadata.LabelsEntry
+	nil,                                    // 93: ledger.DependencyConfig.MetadataEntry
+	nil,                                    // 94: ledger.JournalEntry.MetadataEntry
+	nil,                                    // 95: ledger.PostJournalEntryRequest.MetadataEntry
+	nil,                                    // 96: ledger.AuditEvent.MetadataEntry
+	nil,                                    // 97: ledger.RunRevaluationRequest.RatesEntry
+	(*timestamppb.Timestamp)(nil),          // 98: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 99: google.protobuf.FieldMask
 }
 var file_services_treasury_services_ledger_service_proto_ledger_service_proto_depIdxs = []int32{
-	5,  // 0: ledger.ManifestResponse.identity:type_name -> ledger.ServiceIdentity
-	6,  // 1: ledger.ManifestResponse.build_info:type_name -> ledger.BuildInfo
-	7,  // 2: ledger.ManifestResponse.runtime_info:type_name -> ledger.RuntimeInfo
-	8,  // 3: ledger.ManifestResponse.metadata:type_name -> ledger.ServiceMetadata
-	9,  // 4: ledger.ManifestResponse.capabilities:type_name -> ledger.ServiceCapabilities
-	31, // 5: ledger.ServiceMetadata.labels:type_name -> ledger.ServiceMetadata.LabelsEntry
-	10, // 6: ledger.ServiceCapabilities.dependencies:type_name -> ledger.ServiceDependency
-	0,  // 7: ledger.LivenessResponse.status:type_name -> ledger.ServiceStatus
-	15, // 8: ledger.LivenessResponse.checks:type_name -> ledger.ComponentCheck
-	0,  // 9: ledger.HealthResponse.status:type_name -> ledger.ServiceStatus
-	16, // 10: ledger.HealthResponse.liveness:type_name -> ledger.LivenessInfo
-	17, // 11: ledger.HealthResponse.dependencies:type_name -> ledger.DependencyHealth
-	15, // 12: ledger.LivenessInfo.components:type_name -> ledger.ComponentCheck
-	1,  // 13: ledger.DependencyHealth.type:type_name -> ledger.DependencyType
-	0,  // 14: ledger.DependencyHealth.status:type_name -> ledger.ServiceStatus
-	18, // 15: ledger.DependencyHealth.config:type_name -> ledger.DependencyConfig
-	19, // 16: ledger.DependencyConfig.pool_info:type_name -> ledger.ConnectionPoolInfo
-	32, // 17: ledger.DependencyConfig.metadata:type_name -> ledger.DependencyConfig.MetadataEntry
-	2,  // 18: ledger.Account.account_type:type_name -> ledger.AccountType
-	33, // 19: ledger.Account.created_at:type_name -> google.protobuf.Timestamp
-	33, // 20: ledger.Account.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 21: ledger.CreateAccountRequest.account_type:type_name -> ledger.AccountType
-	20, // 22: ledger.CreateAccountResponse.account:type_name -> ledger.Account
-	20, // 23: ledger.GetAccountResponse.account:type_name -> ledger.Account
-	20, // 24: ledger.GetAccountByExternalIdResponse.account:type_name -> ledger.Account
-	20, // 25: ledger.UpdateAccountRequest.account:type_name -> ledger.Account
-	34, // 26: ledger.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
-	20, // 27: ledger.UpdateAccountResponse.account:type_name -> ledger.Account
-	2,  // 28: ledger.ListAccountsRequest.account_type:type_name -> ledger.AccountType
-	20, // 29: ledger.ListAccountsResponse.accounts:type_name -> ledger.Account
-	3,  // 30: ledger.Manifest.GetManifest:input_type -> ledger.ManifestRequest
-	11, // 31: ledger.Health.GetLiveness:input_type -> ledger.LivenessRequest
-	13, // 32: ledger.Health.GetHealth:input_type -> ledger.HealthRequest
-	21, // 33: ledger.AccountService.CreateAccount:input_type -> ledger.CreateAccountRequest
-	23, // 34: ledger.AccountService.GetAccount:input_type -> ledger.GetAccountRequest
-	25, // 35: ledger.AccountService.GetAccountByExternalId:input_type -> ledger.GetAccountByExternalIdRequest
-	27, // 36: ledger.AccountService.UpdateAccount:input_type -> ledger.UpdateAccountRequest
-	29, // 37: ledger.AccountService.ListAccounts:input_type -> ledger.ListAccountsRequest
-	4,  // 38: ledger.Manifest.GetManifest:output_type -> ledger.ManifestResponse
-	12, // 39: ledger.Health.GetLiveness:output_type -> ledger.LivenessResponse
-	14, // 40: ledger.Health.GetHealth:output_type -> ledger.HealthResponse
-	22, // 41: ledger.AccountService.CreateAccount:output_type -> ledger.CreateAccountResponse
-	24, // 42: ledger.AccountService.GetAccount:output_type -> ledger.GetAccountResponse
-	26, // 43: ledger.AccountService.GetAccountByExternalId:output_type -> ledger.GetAccountByExternalIdResponse
-	28, // 44: ledger.AccountService.UpdateAccount:output_type -> ledger.UpdateAccountResponse
-	30, // 45: ledger.AccountService.ListAccounts:output_type -> ledger.ListAccountsResponse
-	38, // [38:46] is the sub-list for method output_type
-	30, // [30:38] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	10,  // 0: ledger.ManifestResponse.identity:type_name -> ledger.ServiceIdentity
+	11,  // 1: ledger.ManifestResponse.build_info:type_name -> ledger.BuildInfo
+	12,  // 2: ledger.ManifestResponse.runtime_info:type_name -> ledger.RuntimeInfo
+	13,  // 3: ledger.ManifestResponse.metadata:type_name -> ledger.ServiceMetadata
+	14,  // 4: ledger.ManifestResponse.capabilities:type_name -> ledger.ServiceCapabilities
+	92,  // 5: ledger.ServiceMetadata.labels:type_name -> ledger.ServiceMetadata.LabelsEntry
+	15,  // 6: ledger.ServiceCapabilities.dependencies:type_name -> ledger.ServiceDependency
+	0,   // 7: ledger.LivenessResponse.status:type_name -> ledger.ServiceStatus
+	20,  // 8: ledger.LivenessResponse.checks:type_name -> ledger.ComponentCheck
+	0,   // 9: ledger.HealthResponse.status:type_name -> ledger.ServiceStatus
+	21,  // 10: ledger.HealthResponse.liveness:type_name -> ledger.LivenessInfo
+	22,  // 11: ledger.HealthResponse.dependencies:type_name -> ledger.DependencyHealth
+	20,  // 12: ledger.LivenessInfo.components:type_name -> ledger.ComponentCheck
+	1,   // 13: ledger.DependencyHealth.type:type_name -> ledger.DependencyType
+	0,   // 14: ledger.DependencyHealth.status:type_name -> ledger.ServiceStatus
+	23,  // 15: ledger.DependencyHealth.config:type_name -> ledger.DependencyConfig
+	24,  // 16: ledger.DependencyConfig.pool_info:type_name -> ledger.ConnectionPoolInfo
+	93,  // 17: ledger.DependencyConfig.metadata:type_name -> ledger.DependencyConfig.MetadataEntry
+	2,   // 18: ledger.Account.account_type:type_name -> ledger.AccountType
+	98,  // 19: ledger.Account.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 20: ledger.Account.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 21: ledger.Account.status:type_name -> ledger.AccountStatus
+	98,  // 22: ledger.Account.status_changed_at:type_name -> google.protobuf.Timestamp
+	2,   // 23: ledger.CreateAccountRequest.account_type:type_name -> ledger.AccountType
+	25,  // 24: ledger.CreateAccountResponse.account:type_name -> ledger.Account
+	98,  // 25: ledger.GetAccountRequest.as_of_time:type_name -> google.protobuf.Timestamp
+	25,  // 26: ledger.GetAccountResponse.account:type_name -> ledger.Account
+	58,  // 27: ledger.GetAccountResponse.verification:type_name -> ledger.VerificationProof
+	98,  // 28: ledger.AccountRevision.committed_at:type_name -> google.protobuf.Timestamp
+	25,  // 29: ledger.AccountRevision.account:type_name -> ledger.Account
+	30,  // 30: ledger.GetAccountHistoryResponse.revisions:type_name -> ledger.AccountRevision
+	25,  // 31: ledger.GetAccountByExternalIdResponse.account:type_name -> ledger.Account
+	25,  // 32: ledger.UpdateAccountRequest.account:type_name -> ledger.Account
+	99,  // 33: ledger.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
+	25,  // 34: ledger.UpdateAccountResponse.account:type_name -> ledger.Account
+	2,   // 35: ledger.ListAccountsRequest.account_type:type_name -> ledger.AccountType
+	3,   // 36: ledger.ListAccountsRequest.status:type_name -> ledger.AccountStatus
+	25,  // 37: ledger.ListAccountsResponse.accounts:type_name -> ledger.Account
+	25,  // 38: ledger.FreezeAccountResponse.account:type_name -> ledger.Account
+	25,  // 39: ledger.CloseAccountResponse.account:type_name -> ledger.Account
+	25,  // 40: ledger.ReopenAccountResponse.account:type_name -> ledger.Account
+	2,   // 41: ledger.AccountBalance.account_type:type_name -> ledger.AccountType
+	4,   // 42: ledger.AccountBalance.normal_balance:type_name -> ledger.NormalBalance
+	98,  // 43: ledger.AccountBalance.as_of_time:type_name -> google.protobuf.Timestamp
+	98,  // 44: ledger.GetAccountBalanceRequest.as_of_time:type_name -> google.protobuf.Timestamp
+	45,  // 45: ledger.GetAccountBalanceResponse.balance:type_name -> ledger.AccountBalance
+	58,  // 46: ledger.GetAccountBalanceResponse.verification:type_name -> ledger.VerificationProof
+	98,  // 47: ledger.GetAccountBalancesRequest.as_of_time:type_name -> google.protobuf.Timestamp
+	45,  // 48: ledger.GetAccountBalancesResponse.balances:type_name -> ledger.AccountBalance
+	58,  // 49: ledger.GetAccountBalancesResponse.verification:type_name -> ledger.VerificationProof
+	98,  // 50: ledger.JournalEntry.entry_date:type_name -> google.protobuf.Timestamp
+	5,   // 51: ledger.JournalEntry.status:type_name -> ledger.JournalEntryStatus
+	51,  // 52: ledger.JournalEntry.lines:type_name -> ledger.JournalEntryLine
+	94,  // 53: ledger.JournalEntry.metadata:type_name -> ledger.JournalEntry.MetadataEntry
+	98,  // 54: ledger.JournalEntry.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 55: ledger.PostJournalEntryRequest.entry_date:type_name -> google.protobuf.Timestamp
+	51,  // 56: ledger.PostJournalEntryRequest.lines:type_name -> ledger.JournalEntryLine
+	95,  // 57: ledger.PostJournalEntryRequest.metadata:type_name -> ledger.PostJournalEntryRequest.MetadataEntry
+	50,  // 58: ledger.PostJournalEntryResponse.journal_entry:type_name -> ledger.JournalEntry
+	50,  // 59: ledger.GetJournalEntryResponse.journal_entry:type_name -> ledger.JournalEntry
+	58,  // 60: ledger.GetJournalEntryResponse.verification:type_name -> ledger.VerificationProof
+	98,  // 61: ledger.ListJournalEntriesRequest.start_date:type_name -> google.protobuf.Timestamp
+	98,  // 62: ledger.ListJournalEntriesRequest.end_date:type_name -> google.protobuf.Timestamp
+	50,  // 63: ledger.ListJournalEntriesResponse.journal_entries:type_name -> ledger.JournalEntry
+	58,  // 64: ledger.ListJournalEntriesResponse.verifications:type_name -> ledger.VerificationProof
+	98,  // 65: ledger.VerificationProof.tx_time:type_name -> google.protobuf.Timestamp
+	98,  // 66: ledger.VerificationProof.verified_at:type_name -> google.protobuf.Timestamp
+	98,  // 67: ledger.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	96,  // 68: ledger.AuditEvent.metadata:type_name -> ledger.AuditEvent.MetadataEntry
+	98,  // 69: ledger.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	98,  // 70: ledger.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	59,  // 71: ledger.ListAuditEventsResponse.events:type_name -> ledger.AuditEvent
+	2,   // 72: ledger.TrialBalanceLine.account_type:type_name -> ledger.AccountType
+	98,  // 73: ledger.GetTrialBalanceRequest.as_of_time:type_name -> google.protobuf.Timestamp
+	62,  // 74: ledger.GetTrialBalanceResponse.lines:type_name -> ledger.TrialBalanceLine
+	98,  // 75: ledger.GetTrialBalanceResponse.as_of_time:type_name -> google.protobuf.Timestamp
+	2,   // 76: ledger.ReportSection.account_type:type_name -> ledger.AccountType
+	65,  // 77: ledger.ReportSection.lines:type_name -> ledger.ReportLine
+	98,  // 78: ledger.GetBalanceSheetRequest.as_of_time:type_name -> google.protobuf.Timestamp
+	66,  // 79: ledger.GetBalanceSheetResponse.assets:type_name -> ledger.ReportSection
+	66,  // 80: ledger.GetBalanceSheetResponse.liabilities:type_name -> ledger.ReportSection
+	66,  // 81: ledger.GetBalanceSheetResponse.equity:type_name -> ledger.ReportSection
+	98,  // 82: ledger.GetBalanceSheetResponse.as_of_time:type_name -> google.protobuf.Timestamp
+	98,  // 83: ledger.GetIncomeStatementRequest.start_time:type_name -> google.protobuf.Timestamp
+	98,  // 84: ledger.GetIncomeStatementRequest.end_time:type_name -> google.protobuf.Timestamp
+	66,  // 85: ledger.GetIncomeStatementResponse.revenue:type_name -> ledger.ReportSection
+	66,  // 86: ledger.GetIncomeStatementResponse.expenses:type_name -> ledger.ReportSection
+	98,  // 87: ledger.GetIncomeStatementResponse.start_time:type_name -> google.protobuf.Timestamp
+	98,  // 88: ledger.GetIncomeStatementResponse.end_time:type_name -> google.protobuf.Timestamp
+	98,  // 89: ledger.AccountingPeriod.start_date:type_name -> google.protobuf.Timestamp
+	98,  // 90: ledger.AccountingPeriod.end_date:type_name -> google.protobuf.Timestamp
+	6,   // 91: ledger.AccountingPeriod.status:type_name -> ledger.PeriodStatus
+	98,  // 92: ledger.AccountingPeriod.status_changed_at:type_name -> google.protobuf.Timestamp
+	71,  // 93: ledger.ClosePeriodResponse.period:type_name -> ledger.AccountingPeriod
+	71,  // 94: ledger.ReopenPeriodResponse.period:type_name -> ledger.AccountingPeriod
+	71,  // 95: ledger.ListPeriodsResponse.periods:type_name -> ledger.AccountingPeriod
+	7,   // 96: ledger.Hold.status:type_name -> ledger.HoldStatus
+	98,  // 97: ledger.Hold.expires_at:type_name -> google.protobuf.Timestamp
+	98,  // 98: ledger.Hold.created_at:type_name -> google.protobuf.Timestamp
+	98,  // 99: ledger.Hold.settled_at:type_name -> google.protobuf.Timestamp
+	98,  // 100: ledger.CreateHoldRequest.expires_at:type_name -> google.protobuf.Timestamp
+	78,  // 101: ledger.CreateHoldResponse.hold:type_name -> ledger.Hold
+	98,  // 102: ledger.CaptureHoldRequest.entry_date:type_name -> google.protobuf.Timestamp
+	78,  // 103: ledger.CaptureHoldResponse.hold:type_name -> ledger.Hold
+	50,  // 104: ledger.CaptureHoldResponse.journal_entry:type_name -> ledger.JournalEntry
+	78,  // 105: ledger.ReleaseHoldResponse.hold:type_name -> ledger.Hold
+	78,  // 106: ledger.GetHoldResponse.hold:type_name -> ledger.Hold
+	7,   // 107: ledger.ListHoldsRequest.status:type_name -> ledger.HoldStatus
+	78,  // 108: ledger.ListHoldsResponse.holds:type_name -> ledger.Hold
+	98,  // 109: ledger.RunRevaluationRequest.as_of:type_name -> google.protobuf.Timestamp
+	97,  // 110: ledger.RunRevaluationRequest.rates:type_name -> ledger.RunRevaluationRequest.RatesEntry
+	90,  // 111: ledger.RunRevaluationResponse.revaluations:type_name -> ledger.AccountRevaluation
+	50,  // 112: ledger.RunRevaluationResponse.journal_entry:type_name -> ledger.JournalEntry
+	8,   // 113: ledger.Manifest.GetManifest:input_type -> ledger.ManifestRequest
+	16,  // 114: ledger.Health.GetLiveness:input_type -> ledger.LivenessRequest
+	18,  // 115: ledger.Health.GetHealth:input_type -> ledger.HealthRequest
+	26,  // 116: ledger.AccountService.CreateAccount:input_type -> ledger.CreateAccountRequest
+	28,  // 117: ledger.AccountService.GetAccount:input_type -> ledger.GetAccountRequest
+	33,  // 118: ledger.AccountService.GetAccountByExternalId:input_type -> ledger.GetAccountByExternalIdRequest
+	35,  // 119: ledger.AccountService.UpdateAccount:input_type -> ledger.UpdateAccountRequest
+	37,  // 120: ledger.AccountService.ListAccounts:input_type -> ledger.ListAccountsRequest
+	46,  // 121: ledger.AccountService.GetAccountBalance:input_type -> ledger.GetAccountBalanceRequest
+	48,  // 122: ledger.AccountService.GetAccountBalances:input_type -> ledger.GetAccountBalancesRequest
+	31,  // 123: ledger.AccountService.GetAccountHistory:input_type -> ledger.GetAccountHistoryRequest
+	39,  // 124: ledger.AccountService.FreezeAccount:input_type -> ledger.FreezeAccountRequest
+	41,  // 125: ledger.AccountService.CloseAccount:input_type -> ledger.CloseAccountRequest
+	43,  // 126: ledger.AccountService.ReopenAccount:input_type -> ledger.ReopenAccountRequest
+	52,  // 127: ledger.JournalService.PostJournalEntry:input_type -> ledger.PostJournalEntryRequest
+	54,  // 128: ledger.JournalService.GetJournalEntry:input_type -> ledger.GetJournalEntryRequest
+	56,  // 129: ledger.JournalService.ListJournalEntries:input_type -> ledger.ListJournalEntriesRequest
+	60,  // 130: ledger.AuditService.ListAuditEvents:input_type -> ledger.ListAuditEventsRequest
+	63,  // 131: ledger.ReportingService.GetTrialBalance:input_type -> ledger.GetTrialBalanceRequest
+	67,  // 132: ledger.ReportingService.GetBalanceSheet:input_type -> ledger.GetBalanceSheetRequest
+	69,  // 133: ledger.ReportingService.GetIncomeStatement:input_type -> ledger.GetIncomeStatementRequest
+	72,  // 134: ledger.PeriodService.ClosePeriod:input_type -> ledger.ClosePeriodRequest
+	74,  // 135: ledger.PeriodService.ReopenPeriod:input_type -> ledger.ReopenPeriodRequest
+	76,  // 136: ledger.PeriodService.ListPeriods:input_type -> ledger.ListPeriodsRequest
+	79,  // 137: ledger.HoldService.CreateHold:input_type -> ledger.CreateHoldRequest
+	81,  // 138: ledger.HoldService.CaptureHold:input_type -> ledger.CaptureHoldRequest
+	83,  // 139: ledger.HoldService.ReleaseHold:input_type -> ledger.ReleaseHoldRequest
+	85,  // 140: ledger.HoldService.GetHold:input_type -> ledger.GetHoldRequest
+	87,  // 141: ledger.HoldService.ListHolds:input_type -> ledger.ListHoldsRequest
+	89,  // 142: ledger.RevaluationService.RunRevaluation:input_type -> ledger.RunRevaluationRequest
+	9,   // 143: ledger.Manifest.GetManifest:output_type -> ledger.ManifestResponse
+	17,  // 144: ledger.Health.GetLiveness:output_type -> ledger.LivenessResponse
+	19,  // 145: ledger.Health.GetHealth:output_type -> ledger.HealthResponse
+	27,  // 146: ledger.AccountService.CreateAccount:output_type -> ledger.CreateAccountResponse
+	29,  // 147: ledger.AccountService.GetAccount:output_type -> ledger.GetAccountResponse
+	34,  // 148: ledger.AccountService.GetAccountByExternalId:output_type -> ledger.GetAccountByExternalIdResponse
+	36,  // 149: ledger.AccountService.UpdateAccount:output_type -> ledger.UpdateAccountResponse
+	38,  // 150: ledger.AccountService.ListAccounts:output_type -> ledger.ListAccountsResponse
+	47,  // 151: ledger.AccountService.GetAccountBalance:output_type -> ledger.GetAccountBalanceResponse
+	49,  // 152: ledger.AccountService.GetAccountBalances:output_type -> ledger.GetAccountBalancesResponse
+	32,  // 153: ledger.AccountService.GetAccountHistory:output_type -> ledger.GetAccountHistoryResponse
+	40,  // 154: ledger.AccountService.FreezeAccount:output_type -> ledger.FreezeAccountResponse
+	42,  // 155: ledger.AccountService.CloseAccount:output_type -> ledger.CloseAccountResponse
+	44,  // 156: ledger.AccountService.ReopenAccount:output_type -> ledger.ReopenAccountResponse
+	53,  // 157: ledger.JournalService.PostJournalEntry:output_type -> ledger.PostJournalEntryResponse
+	55,  // 158: ledger.JournalService.GetJournalEntry:output_type -> ledger.GetJournalEntryResponse
+	57,  // 159: ledger.JournalService.ListJournalEntries:output_type -> ledger.ListJournalEntriesResponse
+	61,  // 160: ledger.AuditService.ListAuditEvents:output_type -> ledger.ListAuditEventsResponse
+	64,  // 161: ledger.ReportingService.GetTrialBalance:output_type -> ledger.GetTrialBalanceResponse
+	68,  // 162: ledger.ReportingService.GetBalanceSheet:output_type -> ledger.GetBalanceSheetResponse
+	70,  // 163: ledger.ReportingService.GetIncomeStatement:output_type -> ledger.GetIncomeStatementResponse
+	73,  // 164: ledger.PeriodService.ClosePeriod:output_type -> ledger.ClosePeriodResponse
+	75,  // 165: ledger.PeriodService.ReopenPeriod:output_type -> ledger.ReopenPeriodResponse
+	77,  // 166: ledger.PeriodService.ListPeriods:output_type -> ledger.ListPeriodsResponse
+	80,  // 167: ledger.HoldService.CreateHold:output_type -> ledger.CreateHoldResponse
+	82,  // 168: ledger.HoldService.CaptureHold:output_type -> ledger.CaptureHoldResponse
+	84,  // 169: ledger.HoldService.ReleaseHold:output_type -> ledger.ReleaseHoldResponse
+	86,  // 170: ledger.HoldService.GetHold:output_type -> ledger.GetHoldResponse
+	88,  // 171: ledger.HoldService.ListHolds:output_type -> ledger.ListHoldsResponse
+	91,  // 172: ledger.RevaluationService.RunRevaluation:output_type -> ledger.RunRevaluationResponse
+	143, // [143:173] is the sub-list for method output_type
+	113, // [113:143] is the sub-list for method input_type
+	113, // [113:113] is the sub-list for extension type_name
+	113, // [113:113] is the sub-list for extension extendee
+	0,   // [0:113] is the sub-list for field type_name
 }
 
 func init() { file_services_treasury_services_ledger_service_proto_ledger_service_proto_init() }
@@ -2444,10 +7672,10 @@ func file_services_treasury_services_ledger_service_proto_ledger_service_proto_i
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDesc), len(file_services_treasury_services_ledger_service_proto_ledger_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   30,
+			NumEnums:      8,
+			NumMessages:   90,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   9,
 		},
 		GoTypes:           file_services_treasury_services_ledger_service_proto_ledger_service_proto_goTypes,
 		DependencyIndexes: file_services_treasury_services_ledger_service_proto_ledger_service_proto_depIdxs,
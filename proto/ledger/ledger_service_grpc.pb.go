@@ -284,6 +284,12 @@ const (
 	AccountService_GetAccountByExternalId_FullMethodName = "/ledger.AccountService/GetAccountByExternalId"
 	AccountService_UpdateAccount_FullMethodName          = "/ledger.AccountService/UpdateAccount"
 	AccountService_ListAccounts_FullMethodName           = "/ledger.AccountService/ListAccounts"
+	AccountService_GetAccountBalance_FullMethodName      = "/ledger.AccountService/GetAccountBalance"
+	AccountService_GetAccountBalances_FullMethodName     = "/ledger.AccountService/GetAccountBalances"
+	AccountService_GetAccountHistory_FullMethodName      = "/ledger.AccountService/GetAccountHistory"
+	AccountService_FreezeAccount_FullMethodName          = "/ledger.AccountService/FreezeAccount"
+	AccountService_CloseAccount_FullMethodName           = "/ledger.AccountService/CloseAccount"
+	AccountService_ReopenAccount_FullMethodName          = "/ledger.AccountService/ReopenAccount"
 )
 
 // AccountServiceClient is the client API for AccountService service.
@@ -308,6 +314,24 @@ type AccountServiceClient interface {
 	// List accounts with filtering
 	// Spec: docs/specs/003-account-management.md#story-4-list-accounts
 	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// Get posted balance for an account
+	// Spec: docs/specs/005-account-balances.md#story-1-current-account-balance
+	GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error)
+	// Get posted balances for multiple accounts
+	// Spec: docs/specs/005-account-balances.md#story-3-batch-balances
+	GetAccountBalances(ctx context.Context, in *GetAccountBalancesRequest, opts ...grpc.CallOption) (*GetAccountBalancesResponse, error)
+	// Get every committed revision of an account
+	// Spec: docs/specs/007-account-history.md#story-1-account-revision-history
+	GetAccountHistory(ctx context.Context, in *GetAccountHistoryRequest, opts ...grpc.CallOption) (*GetAccountHistoryResponse, error)
+	// Freeze an account so it can no longer transact
+	// Spec: docs/specs/009-account-lifecycle.md#story-1-freeze-account
+	FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error)
+	// Close an account with a zero balance
+	// Spec: docs/specs/009-account-lifecycle.md#story-2-close-account
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	// Reopen a frozen, inactive or closed account
+	// Spec: docs/specs/009-account-lifecycle.md#story-3-reopen-account
+	ReopenAccount(ctx context.Context, in *ReopenAccountRequest, opts ...grpc.CallOption) (*ReopenAccountResponse, error)
 }
 
 type accountServiceClient struct {
@@ -368,6 +392,66 @@ func (c *accountServiceClient) ListAccounts(ctx context.Context, in *ListAccount
 	return out, nil
 }
 
+func (c *accountServiceClient) GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccountBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccountBalances(ctx context.Context, in *GetAccountBalancesRequest, opts ...grpc.CallOption) (*GetAccountBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccountBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccountHistory(ctx context.Context, in *GetAccountHistoryRequest, opts ...grpc.CallOption) (*GetAccountHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountHistoryResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccountHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_FreezeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_CloseAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ReopenAccount(ctx context.Context, in *ReopenAccountRequest, opts ...grpc.CallOption) (*ReopenAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_ReopenAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility.
@@ -390,6 +474,24 @@ type AccountServiceServer interface {
 	// List accounts with filtering
 	// Spec: docs/specs/003-account-management.md#story-4-list-accounts
 	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// Get posted balance for an account
+	// Spec: docs/specs/005-account-balances.md#story-1-current-account-balance
+	GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error)
+	// Get posted balances for multiple accounts
+	// Spec: docs/specs/005-account-balances.md#story-3-batch-balances
+	GetAccountBalances(context.Context, *GetAccountBalancesRequest) (*GetAccountBalancesResponse, error)
+	// Get every committed revision of an account
+	// Spec: docs/specs/007-account-history.md#story-1-account-revision-history
+	GetAccountHistory(context.Context, *GetAccountHistoryRequest) (*GetAccountHistoryResponse, error)
+	// Freeze an account so it can no longer transact
+	// Spec: docs/specs/009-account-lifecycle.md#story-1-freeze-account
+	FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error)
+	// Close an account with a zero balance
+	// Spec: docs/specs/009-account-lifecycle.md#story-2-close-account
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	// Reopen a frozen, inactive or closed account
+	// Spec: docs/specs/009-account-lifecycle.md#story-3-reopen-account
+	ReopenAccount(context.Context, *ReopenAccountRequest) (*ReopenAccountResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -415,6 +517,24 @@ func (UnimplementedAccountServiceServer) UpdateAccount(context.Context, *UpdateA
 func (UnimplementedAccountServiceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
+func (UnimplementedAccountServiceServer) GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalance not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAccountBalances(context.Context, *GetAccountBalancesRequest) (*GetAccountBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalances not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAccountHistory(context.Context, *GetAccountHistoryRequest) (*GetAccountHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountHistory not implemented")
+}
+func (UnimplementedAccountServiceServer) FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) ReopenAccount(context.Context, *ReopenAccountRequest) (*ReopenAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenAccount not implemented")
+}
 func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 func (UnimplementedAccountServiceServer) testEmbeddedByValue()                        {}
 
@@ -526,6 +646,114 @@ func _AccountService_ListAccounts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_GetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountBalance(ctx, req.(*GetAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountBalances(ctx, req.(*GetAccountBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountHistory(ctx, req.(*GetAccountHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_FreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).FreezeAccount(ctx, req.(*FreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CloseAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ReopenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ReopenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ReopenAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ReopenAccount(ctx, req.(*ReopenAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -553,6 +781,1124 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAccounts",
 			Handler:    _AccountService_ListAccounts_Handler,
 		},
+		{
+			MethodName: "GetAccountBalance",
+			Handler:    _AccountService_GetAccountBalance_Handler,
+		},
+		{
+			MethodName: "GetAccountBalances",
+			Handler:    _AccountService_GetAccountBalances_Handler,
+		},
+		{
+			MethodName: "GetAccountHistory",
+			Handler:    _AccountService_GetAccountHistory_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _AccountService_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _AccountService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "ReopenAccount",
+			Handler:    _AccountService_ReopenAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/ledger-service/proto/ledger_service.proto",
+}
+
+const (
+	JournalService_PostJournalEntry_FullMethodName   = "/ledger.JournalService/PostJournalEntry"
+	JournalService_GetJournalEntry_FullMethodName    = "/ledger.JournalService/GetJournalEntry"
+	JournalService_ListJournalEntries_FullMethodName = "/ledger.JournalService/ListJournalEntries"
+)
+
+// JournalServiceClient is the client API for JournalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Journal entry service for double-entry posting
+// Spec: docs/specs/004-journal-entries.md
+type JournalServiceClient interface {
+	// Post a balanced journal entry
+	// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+	PostJournalEntry(ctx context.Context, in *PostJournalEntryRequest, opts ...grpc.CallOption) (*PostJournalEntryResponse, error)
+	// Get journal entry by ID
+	// Spec: docs/specs/004-journal-entries.md#story-2-retrieve-journal-entry
+	GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*GetJournalEntryResponse, error)
+	// List journal entries with filtering
+	// Spec: docs/specs/004-journal-entries.md#story-3-list-journal-entries
+	ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error)
+}
+
+type journalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJournalServiceClient(cc grpc.ClientConnInterface) JournalServiceClient {
+	return &journalServiceClient{cc}
+}
+
+func (c *journalServiceClient) PostJournalEntry(ctx context.Context, in *PostJournalEntryRequest, opts ...grpc.CallOption) (*PostJournalEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostJournalEntryResponse)
+	err := c.cc.Invoke(ctx, JournalService_PostJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*GetJournalEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJournalEntryResponse)
+	err := c.cc.Invoke(ctx, JournalService_GetJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JournalServiceServer is the server API for JournalService service.
+// All implementations must embed UnimplementedJournalServiceServer
+// for forward compatibility.
+//
+// Journal entry service for double-entry posting
+// Spec: docs/specs/004-journal-entries.md
+type JournalServiceServer interface {
+	// Post a balanced journal entry
+	// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+	PostJournalEntry(context.Context, *PostJournalEntryRequest) (*PostJournalEntryResponse, error)
+	// Get journal entry by ID
+	// Spec: docs/specs/004-journal-entries.md#story-2-retrieve-journal-entry
+	GetJournalEntry(context.Context, *GetJournalEntryRequest) (*GetJournalEntryResponse, error)
+	// List journal entries with filtering
+	// Spec: docs/specs/004-journal-entries.md#story-3-list-journal-entries
+	ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error)
+	mustEmbedUnimplementedJournalServiceServer()
+}
+
+// UnimplementedJournalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJournalServiceServer struct{}
+
+func (UnimplementedJournalServiceServer) PostJournalEntry(context.Context, *PostJournalEntryRequest) (*PostJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostJournalEntry not implemented")
+}
+func (UnimplementedJournalServiceServer) GetJournalEntry(context.Context, *GetJournalEntryRequest) (*GetJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalEntry not implemented")
+}
+func (UnimplementedJournalServiceServer) ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntries not implemented")
+}
+func (UnimplementedJournalServiceServer) mustEmbedUnimplementedJournalServiceServer() {}
+func (UnimplementedJournalServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeJournalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JournalServiceServer will
+// result in compilation errors.
+type UnsafeJournalServiceServer interface {
+	mustEmbedUnimplementedJournalServiceServer()
+}
+
+func RegisterJournalServiceServer(s grpc.ServiceRegistrar, srv JournalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedJournalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JournalService_ServiceDesc, srv)
+}
+
+func _JournalService_PostJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).PostJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_PostJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).PostJournalEntry(ctx, req.(*PostJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_GetJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).GetJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_GetJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).GetJournalEntry(ctx, req.(*GetJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListJournalEntries(ctx, req.(*ListJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JournalService_ServiceDesc is the grpc.ServiceDesc for JournalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JournalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.JournalService",
+	HandlerType: (*JournalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostJournalEntry",
+			Handler:    _JournalService_PostJournalEntry_Handler,
+		},
+		{
+			MethodName: "GetJournalEntry",
+			Handler:    _JournalService_GetJournalEntry_Handler,
+		},
+		{
+			MethodName: "ListJournalEntries",
+			Handler:    _JournalService_ListJournalEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/ledger-service/proto/ledger_service.proto",
+}
+
+const (
+	AuditService_ListAuditEvents_FullMethodName = "/ledger.AuditService/ListAuditEvents"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Audit log read service
+// Spec: docs/specs/008-audit-log.md
+type AuditServiceClient interface {
+	// List audit events with filtering
+	// Spec: docs/specs/008-audit-log.md#story-2-list-audit-events
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, AuditService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility.
+//
+// Audit log read service
+// Spec: docs/specs/008-audit-log.md
+type AuditServiceServer interface {
+	// List audit events with filtering
+	// Spec: docs/specs/008-audit-log.md#story-2-list-audit-events
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServiceServer struct{}
+
+func (UnimplementedAuditServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
+func (UnimplementedAuditServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AuditService_ListAuditEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/ledger-service/proto/ledger_service.proto",
+}
+
+const (
+	ReportingService_GetTrialBalance_FullMethodName    = "/ledger.ReportingService/GetTrialBalance"
+	ReportingService_GetBalanceSheet_FullMethodName    = "/ledger.ReportingService/GetBalanceSheet"
+	ReportingService_GetIncomeStatement_FullMethodName = "/ledger.ReportingService/GetIncomeStatement"
+)
+
+// ReportingServiceClient is the client API for ReportingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Financial reports computed from posted journal lines
+// Spec: docs/specs/011-financial-reports.md
+type ReportingServiceClient interface {
+	// Debit and credit balances of every account with activity
+	// Spec: docs/specs/011-financial-reports.md#story-1-trial-balance
+	GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error)
+	// Assets, liabilities and equity at a point in time
+	// Spec: docs/specs/011-financial-reports.md#story-2-balance-sheet
+	GetBalanceSheet(ctx context.Context, in *GetBalanceSheetRequest, opts ...grpc.CallOption) (*GetBalanceSheetResponse, error)
+	// Revenue and expenses over a period
+	// Spec: docs/specs/011-financial-reports.md#story-3-income-statement
+	GetIncomeStatement(ctx context.Context, in *GetIncomeStatementRequest, opts ...grpc.CallOption) (*GetIncomeStatementResponse, error)
+}
+
+type reportingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportingServiceClient(cc grpc.ClientConnInterface) ReportingServiceClient {
+	return &reportingServiceClient{cc}
+}
+
+func (c *reportingServiceClient) GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrialBalanceResponse)
+	err := c.cc.Invoke(ctx, ReportingService_GetTrialBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingServiceClient) GetBalanceSheet(ctx context.Context, in *GetBalanceSheetRequest, opts ...grpc.CallOption) (*GetBalanceSheetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceSheetResponse)
+	err := c.cc.Invoke(ctx, ReportingService_GetBalanceSheet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportingServiceClient) GetIncomeStatement(ctx context.Context, in *GetIncomeStatementRequest, opts ...grpc.CallOption) (*GetIncomeStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIncomeStatementResponse)
+	err := c.cc.Invoke(ctx, ReportingService_GetIncomeStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportingServiceServer is the server API for ReportingService service.
+// All implementations must embed UnimplementedReportingServiceServer
+// for forward compatibility.
+//
+// Financial reports computed from posted journal lines
+// Spec: docs/specs/011-financial-reports.md
+type ReportingServiceServer interface {
+	// Debit and credit balances of every account with activity
+	// Spec: docs/specs/011-financial-reports.md#story-1-trial-balance
+	GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error)
+	// Assets, liabilities and equity at a point in time
+	// Spec: docs/specs/011-financial-reports.md#story-2-balance-sheet
+	GetBalanceSheet(context.Context, *GetBalanceSheetRequest) (*GetBalanceSheetResponse, error)
+	// Revenue and expenses over a period
+	// Spec: docs/specs/011-financial-reports.md#story-3-income-statement
+	GetIncomeStatement(context.Context, *GetIncomeStatementRequest) (*GetIncomeStatementResponse, error)
+	mustEmbedUnimplementedReportingServiceServer()
+}
+
+// UnimplementedReportingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportingServiceServer struct{}
+
+func (UnimplementedReportingServiceServer) GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedReportingServiceServer) GetBalanceSheet(context.Context, *GetBalanceSheetRequest) (*GetBalanceSheetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceSheet not implemented")
+}
+func (UnimplementedReportingServiceServer) GetIncomeStatement(context.Context, *GetIncomeStatementRequest) (*GetIncomeStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomeStatement not implemented")
+}
+func (UnimplementedReportingServiceServer) mustEmbedUnimplementedReportingServiceServer() {}
+func (UnimplementedReportingServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeReportingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportingServiceServer will
+// result in compilation errors.
+type UnsafeReportingServiceServer interface {
+	mustEmbedUnimplementedReportingServiceServer()
+}
+
+func RegisterReportingServiceServer(s grpc.ServiceRegistrar, srv ReportingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReportingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReportingService_ServiceDesc, srv)
+}
+
+func _ReportingService_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingService_GetTrialBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).GetTrialBalance(ctx, req.(*GetTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportingService_GetBalanceSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).GetBalanceSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingService_GetBalanceSheet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).GetBalanceSheet(ctx, req.(*GetBalanceSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportingService_GetIncomeStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncomeStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).GetIncomeStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportingService_GetIncomeStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).GetIncomeStatement(ctx, req.(*GetIncomeStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReportingService_ServiceDesc is the grpc.ServiceDesc for ReportingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.ReportingService",
+	HandlerType: (*ReportingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTrialBalance",
+			Handler:    _ReportingService_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "GetBalanceSheet",
+			Handler:    _ReportingService_GetBalanceSheet_Handler,
+		},
+		{
+			MethodName: "GetIncomeStatement",
+			Handler:    _ReportingService_GetIncomeStatement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/ledger-service/proto/ledger_service.proto",
+}
+
+const (
+	PeriodService_ClosePeriod_FullMethodName  = "/ledger.PeriodService/ClosePeriod"
+	PeriodService_ReopenPeriod_FullMethodName = "/ledger.PeriodService/ReopenPeriod"
+	PeriodService_ListPeriods_FullMethodName  = "/ledger.PeriodService/ListPeriods"
+)
+
+// PeriodServiceClient is the client API for PeriodService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Accounting period close and reopen
+// Spec: docs/specs/012-accounting-periods.md
+type PeriodServiceClient interface {
+	// Soft-close or close a monthly accounting period of an entity
+	// Spec: docs/specs/012-accounting-periods.md#story-1-close-period
+	ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error)
+	// Reopen a soft-closed or the latest closed period of an entity
+	// Spec: docs/specs/012-accounting-periods.md#story-3-reopen-period
+	ReopenPeriod(ctx context.Context, in *ReopenPeriodRequest, opts ...grpc.CallOption) (*ReopenPeriodResponse, error)
+	// List the periods of an entity that have been closed at least once
+	// Spec: docs/specs/012-accounting-periods.md#story-4-list-periods
+	ListPeriods(ctx context.Context, in *ListPeriodsRequest, opts ...grpc.CallOption) (*ListPeriodsResponse, error)
+}
+
+type periodServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPeriodServiceClient(cc grpc.ClientConnInterface) PeriodServiceClient {
+	return &periodServiceClient{cc}
+}
+
+func (c *periodServiceClient) ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClosePeriodResponse)
+	err := c.cc.Invoke(ctx, PeriodService_ClosePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *periodServiceClient) ReopenPeriod(ctx context.Context, in *ReopenPeriodRequest, opts ...grpc.CallOption) (*ReopenPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenPeriodResponse)
+	err := c.cc.Invoke(ctx, PeriodService_ReopenPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *periodServiceClient) ListPeriods(ctx context.Context, in *ListPeriodsRequest, opts ...grpc.CallOption) (*ListPeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPeriodsResponse)
+	err := c.cc.Invoke(ctx, PeriodService_ListPeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeriodServiceServer is the server API for PeriodService service.
+// All implementations must embed UnimplementedPeriodServiceServer
+// for forward compatibility.
+//
+// Accounting period close and reopen
+// Spec: docs/specs/012-accounting-periods.md
+type PeriodServiceServer interface {
+	// Soft-close or close a monthly accounting period of an entity
+	// Spec: docs/specs/012-accounting-periods.md#story-1-close-period
+	ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error)
+	// Reopen a soft-closed or the latest closed period of an entity
+	// Spec: docs/specs/012-accounting-periods.md#story-3-reopen-period
+	ReopenPeriod(context.Context, *ReopenPeriodRequest) (*ReopenPeriodResponse, error)
+	// List the periods of an entity that have been closed at least once
+	// Spec: docs/specs/012-accounting-periods.md#story-4-list-periods
+	ListPeriods(context.Context, *ListPeriodsRequest) (*ListPeriodsResponse, error)
+	mustEmbedUnimplementedPeriodServiceServer()
+}
+
+// UnimplementedPeriodServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPeriodServiceServer struct{}
+
+func (UnimplementedPeriodServiceServer) ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePeriod not implemented")
+}
+func (UnimplementedPeriodServiceServer) ReopenPeriod(context.Context, *ReopenPeriodRequest) (*ReopenPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenPeriod not implemented")
+}
+func (UnimplementedPeriodServiceServer) ListPeriods(context.Context, *ListPeriodsRequest) (*ListPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeriods not implemented")
+}
+func (UnimplementedPeriodServiceServer) mustEmbedUnimplementedPeriodServiceServer() {}
+func (UnimplementedPeriodServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePeriodServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PeriodServiceServer will
+// result in compilation errors.
+type UnsafePeriodServiceServer interface {
+	mustEmbedUnimplementedPeriodServiceServer()
+}
+
+func RegisterPeriodServiceServer(s grpc.ServiceRegistrar, srv PeriodServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPeriodServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PeriodService_ServiceDesc, srv)
+}
+
+func _PeriodService_ClosePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeriodServiceServer).ClosePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeriodService_ClosePeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeriodServiceServer).ClosePeriod(ctx, req.(*ClosePeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeriodService_ReopenPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeriodServiceServer).ReopenPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeriodService_ReopenPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeriodServiceServer).ReopenPeriod(ctx, req.(*ReopenPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeriodService_ListPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeriodServiceServer).ListPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeriodService_ListPeriods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeriodServiceServer).ListPeriods(ctx, req.(*ListPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PeriodService_ServiceDesc is the grpc.ServiceDesc for PeriodService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PeriodService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.PeriodService",
+	HandlerType: (*PeriodServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClosePeriod",
+			Handler:    _PeriodService_ClosePeriod_Handler,
+		},
+		{
+			MethodName: "ReopenPeriod",
+			Handler:    _PeriodService_ReopenPeriod_Handler,
+		},
+		{
+			MethodName: "ListPeriods",
+			Handler:    _PeriodService_ListPeriods_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/ledger-service/proto/ledger_service.proto",
+}
+
+const (
+	HoldService_CreateHold_FullMethodName  = "/ledger.HoldService/CreateHold"
+	HoldService_CaptureHold_FullMethodName = "/ledger.HoldService/CaptureHold"
+	HoldService_ReleaseHold_FullMethodName = "/ledger.HoldService/ReleaseHold"
+	HoldService_GetHold_FullMethodName     = "/ledger.HoldService/GetHold"
+	HoldService_ListHolds_FullMethodName   = "/ledger.HoldService/ListHolds"
+)
+
+// HoldServiceClient is the client API for HoldService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Holds reserve funds on an account until they are captured or released
+// Spec: docs/specs/013-holds.md
+type HoldServiceClient interface {
+	// Reserve an amount on an account
+	// Spec: docs/specs/013-holds.md#story-1-create-hold
+	CreateHold(ctx context.Context, in *CreateHoldRequest, opts ...grpc.CallOption) (*CreateHoldResponse, error)
+	// Post all or part of a hold as a journal entry
+	// Spec: docs/specs/013-holds.md#story-2-capture-hold
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	// Release a hold without posting it
+	// Spec: docs/specs/013-holds.md#story-3-release-hold
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	// Get hold by ID
+	// Spec: docs/specs/013-holds.md#story-5-list-holds
+	GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error)
+	// List the holds of an account
+	// Spec: docs/specs/013-holds.md#story-5-list-holds
+	ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error)
+}
+
+type holdServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHoldServiceClient(cc grpc.ClientConnInterface) HoldServiceClient {
+	return &holdServiceClient{cc}
+}
+
+func (c *holdServiceClient) CreateHold(ctx context.Context, in *CreateHoldRequest, opts ...grpc.CallOption) (*CreateHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHoldResponse)
+	err := c.cc.Invoke(ctx, HoldService_CreateHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, HoldService_CaptureHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, HoldService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdServiceClient) GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHoldResponse)
+	err := c.cc.Invoke(ctx, HoldService_GetHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holdServiceClient) ListHolds(ctx context.Context, in *ListHoldsRequest, opts ...grpc.CallOption) (*ListHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHoldsResponse)
+	err := c.cc.Invoke(ctx, HoldService_ListHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HoldServiceServer is the server API for HoldService service.
+// All implementations must embed UnimplementedHoldServiceServer
+// for forward compatibility.
+//
+// Holds reserve funds on an account until they are captured or released
+// Spec: docs/specs/013-holds.md
+type HoldServiceServer interface {
+	// Reserve an amount on an account
+	// Spec: docs/specs/013-holds.md#story-1-create-hold
+	CreateHold(context.Context, *CreateHoldRequest) (*CreateHoldResponse, error)
+	// Post all or part of a hold as a journal entry
+	// Spec: docs/specs/013-holds.md#story-2-capture-hold
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	// Release a hold without posting it
+	// Spec: docs/specs/013-holds.md#story-3-release-hold
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	// Get hold by ID
+	// Spec: docs/specs/013-holds.md#story-5-list-holds
+	GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error)
+	// List the holds of an account
+	// Spec: docs/specs/013-holds.md#story-5-list-holds
+	ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error)
+	mustEmbedUnimplementedHoldServiceServer()
+}
+
+// UnimplementedHoldServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHoldServiceServer struct{}
+
+func (UnimplementedHoldServiceServer) CreateHold(context.Context, *CreateHoldRequest) (*CreateHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHold not implemented")
+}
+func (UnimplementedHoldServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedHoldServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedHoldServiceServer) GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHold not implemented")
+}
+func (UnimplementedHoldServiceServer) ListHolds(context.Context, *ListHoldsRequest) (*ListHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHolds not implemented")
+}
+func (UnimplementedHoldServiceServer) mustEmbedUnimplementedHoldServiceServer() {}
+func (UnimplementedHoldServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeHoldServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HoldServiceServer will
+// result in compilation errors.
+type UnsafeHoldServiceServer interface {
+	mustEmbedUnimplementedHoldServiceServer()
+}
+
+func RegisterHoldServiceServer(s grpc.ServiceRegistrar, srv HoldServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHoldServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HoldService_ServiceDesc, srv)
+}
+
+func _HoldService_CreateHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).CreateHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_CreateHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).CreateHold(ctx, req.(*CreateHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_CaptureHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldService_GetHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).GetHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_GetHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).GetHold(ctx, req.(*GetHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HoldService_ListHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).ListHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_ListHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).ListHolds(ctx, req.(*ListHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HoldService_ServiceDesc is the grpc.ServiceDesc for HoldService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HoldService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.HoldService",
+	HandlerType: (*HoldServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateHold",
+			Handler:    _HoldService_CreateHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _HoldService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _HoldService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "GetHold",
+			Handler:    _HoldService_GetHold_Handler,
+		},
+		{
+			MethodName: "ListHolds",
+			Handler:    _HoldService_ListHolds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/ledger-service/proto/ledger_service.proto",
+}
+
+const (
+	RevaluationService_RunRevaluation_FullMethodName = "/ledger.RevaluationService/RunRevaluation"
+)
+
+// RevaluationServiceClient is the client API for RevaluationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RevaluationService revalues foreign-currency balances in the functional
+// currency
+// Spec: docs/specs/014-multi-currency.md
+type RevaluationServiceClient interface {
+	// Post unrealized FX gains and losses for foreign-currency accounts
+	// Spec: docs/specs/014-multi-currency.md#story-3-run-revaluation
+	RunRevaluation(ctx context.Context, in *RunRevaluationRequest, opts ...grpc.CallOption) (*RunRevaluationResponse, error)
+}
+
+type revaluationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRevaluationServiceClient(cc grpc.ClientConnInterface) RevaluationServiceClient {
+	return &revaluationServiceClient{cc}
+}
+
+func (c *revaluationServiceClient) RunRevaluation(ctx context.Context, in *RunRevaluationRequest, opts ...grpc.CallOption) (*RunRevaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunRevaluationResponse)
+	err := c.cc.Invoke(ctx, RevaluationService_RunRevaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RevaluationServiceServer is the server API for RevaluationService service.
+// All implementations must embed UnimplementedRevaluationServiceServer
+// for forward compatibility.
+//
+// RevaluationService revalues foreign-currency balances in the functional
+// currency
+// Spec: docs/specs/014-multi-currency.md
+type RevaluationServiceServer interface {
+	// Post unrealized FX gains and losses for foreign-currency accounts
+	// Spec: docs/specs/014-multi-currency.md#story-3-run-revaluation
+	RunRevaluation(context.Context, *RunRevaluationRequest) (*RunRevaluationResponse, error)
+	mustEmbedUnimplementedRevaluationServiceServer()
+}
+
+// UnimplementedRevaluationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRevaluationServiceServer struct{}
+
+func (UnimplementedRevaluationServiceServer) RunRevaluation(context.Context, *RunRevaluationRequest) (*RunRevaluationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunRevaluation not implemented")
+}
+func (UnimplementedRevaluationServiceServer) mustEmbedUnimplementedRevaluationServiceServer() {}
+func (UnimplementedRevaluationServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeRevaluationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RevaluationServiceServer will
+// result in compilation errors.
+type UnsafeRevaluationServiceServer interface {
+	mustEmbedUnimplementedRevaluationServiceServer()
+}
+
+func RegisterRevaluationServiceServer(s grpc.ServiceRegistrar, srv RevaluationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRevaluationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RevaluationService_ServiceDesc, srv)
+}
+
+func _RevaluationService_RunRevaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRevaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevaluationServiceServer).RunRevaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RevaluationService_RunRevaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevaluationServiceServer).RunRevaluation(ctx, req.(*RunRevaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RevaluationService_ServiceDesc is the grpc.ServiceDesc for RevaluationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RevaluationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.RevaluationService",
+	HandlerType: (*RevaluationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RunRevaluation",
+			Handler:    _RevaluationService_RunRevaluation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/treasury-services/ledger-service/proto/ledger_service.proto",
@@ -192,6 +192,61 @@ func (CurrencyStatus) EnumDescriptor() ([]byte, []int) {
 	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{2}
 }
 
+type CurrencyChangeType int32
+
+const (
+	CurrencyChangeType_CURRENCY_CHANGE_TYPE_UNSPECIFIED CurrencyChangeType = 0
+	CurrencyChangeType_CURRENCY_CHANGE_TYPE_CREATED     CurrencyChangeType = 1
+	CurrencyChangeType_CURRENCY_CHANGE_TYPE_UPDATED     CurrencyChangeType = 2
+	CurrencyChangeType_CURRENCY_CHANGE_TYPE_DEACTIVATED CurrencyChangeType = 3
+	CurrencyChangeType_CURRENCY_CHANGE_TYPE_SCHEDULED   CurrencyChangeType = 4
+)
+
+// Enum value maps for CurrencyChangeType.
+var (
+	CurrencyChangeType_name = map[int32]string{
+		0: "CURRENCY_CHANGE_TYPE_UNSPECIFIED",
+		1: "CURRENCY_CHANGE_TYPE_CREATED",
+		2: "CURRENCY_CHANGE_TYPE_UPDATED",
+		3: "CURRENCY_CHANGE_TYPE_DEACTIVATED",
+		4: "CURRENCY_CHANGE_TYPE_SCHEDULED",
+	}
+	CurrencyChangeType_value = map[string]int32{
+		"CURRENCY_CHANGE_TYPE_UNSPECIFIED": 0,
+		"CURRENCY_CHANGE_TYPE_CREATED":     1,
+		"CURRENCY_CHANGE_TYPE_UPDATED":     2,
+		"CURRENCY_CHANGE_TYPE_DEACTIVATED": 3,
+		"CURRENCY_CHANGE_TYPE_SCHEDULED":   4,
+	}
+)
+
+func (x CurrencyChangeType) Enum() *CurrencyChangeType {
+	p := new(CurrencyChangeType)
+	*p = x
+	return p
+}
+
+func (x CurrencyChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CurrencyChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[3].Descriptor()
+}
+
+func (CurrencyChangeType) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[3]
+}
+
+func (x CurrencyChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CurrencyChangeType.Descriptor instead.
+func (CurrencyChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{3}
+}
+
 type InstitutionType int32
 
 const (
@@ -240,11 +295,11 @@ func (x InstitutionType) String() string {
 }
 
 func (InstitutionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[3].Descriptor()
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[4].Descriptor()
 }
 
 func (InstitutionType) Type() protoreflect.EnumType {
-	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[3]
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[4]
 }
 
 func (x InstitutionType) Number() protoreflect.EnumNumber {
@@ -253,7 +308,7 @@ func (x InstitutionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InstitutionType.Descriptor instead.
 func (InstitutionType) EnumDescriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{3}
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{4}
 }
 
 type InstitutionStatus int32
@@ -295,11 +350,11 @@ func (x InstitutionStatus) String() string {
 }
 
 func (InstitutionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[4].Descriptor()
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[5].Descriptor()
 }
 
 func (InstitutionStatus) Type() protoreflect.EnumType {
-	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[4]
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[5]
 }
 
 func (x InstitutionStatus) Number() protoreflect.EnumNumber {
@@ -308,219 +363,804 @@ func (x InstitutionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InstitutionStatus.Descriptor instead.
 func (InstitutionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{4}
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{5}
 }
 
-type ManifestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Federal Reserve E-Payments routing directory file layouts
+type RoutingDirectoryFormat int32
+
+const (
+	RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_UNSPECIFIED RoutingDirectoryFormat = 0 // Detected from the record length
+	RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDACH      RoutingDirectoryFormat = 1 // FedACH directory, 155-character records
+	RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDWIRE     RoutingDirectoryFormat = 2 // Fedwire Funds directory, 101-character records
+)
+
+// Enum value maps for RoutingDirectoryFormat.
+var (
+	RoutingDirectoryFormat_name = map[int32]string{
+		0: "ROUTING_DIRECTORY_FORMAT_UNSPECIFIED",
+		1: "ROUTING_DIRECTORY_FORMAT_FEDACH",
+		2: "ROUTING_DIRECTORY_FORMAT_FEDWIRE",
+	}
+	RoutingDirectoryFormat_value = map[string]int32{
+		"ROUTING_DIRECTORY_FORMAT_UNSPECIFIED": 0,
+		"ROUTING_DIRECTORY_FORMAT_FEDACH":      1,
+		"ROUTING_DIRECTORY_FORMAT_FEDWIRE":     2,
+	}
+)
+
+func (x RoutingDirectoryFormat) Enum() *RoutingDirectoryFormat {
+	p := new(RoutingDirectoryFormat)
+	*p = x
+	return p
 }
 
-func (x *ManifestRequest) Reset() {
-	*x = ManifestRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x RoutingDirectoryFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ManifestRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (RoutingDirectoryFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[6].Descriptor()
 }
 
-func (*ManifestRequest) ProtoMessage() {}
+func (RoutingDirectoryFormat) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[6]
+}
 
-func (x *ManifestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x RoutingDirectoryFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ManifestRequest.ProtoReflect.Descriptor instead.
-func (*ManifestRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use RoutingDirectoryFormat.Descriptor instead.
+func (RoutingDirectoryFormat) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{6}
 }
 
-type ManifestResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Service identity
-	Identity *ServiceIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
-	// Build information
-	BuildInfo *BuildInfo `protobuf:"bytes,2,opt,name=build_info,json=buildInfo,proto3" json:"build_info,omitempty"`
-	// Runtime information
-	RuntimeInfo *RuntimeInfo `protobuf:"bytes,3,opt,name=runtime_info,json=runtimeInfo,proto3" json:"runtime_info,omitempty"`
-	// Service metadata
-	Metadata *ServiceMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Service capabilities
-	Capabilities  *ServiceCapabilities `protobuf:"bytes,5,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RoutingDirectoryChangeType int32
+
+const (
+	RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_UNSPECIFIED        RoutingDirectoryChangeType = 0
+	RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION RoutingDirectoryChangeType = 1 // New institution for an unknown routing number
+	RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION RoutingDirectoryChangeType = 2 // Directory details of an imported institution changed
+	RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_ADD_ROUTING_NUMBER RoutingDirectoryChangeType = 3 // Known routing number gains the ach or fedwire type
+	RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_FLAG_REMOVED       RoutingDirectoryChangeType = 4 // Routing number no longer in the directory
+	RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_RESTORE            RoutingDirectoryChangeType = 5 // Flagged routing number back in the directory
+)
+
+// Enum value maps for RoutingDirectoryChangeType.
+var (
+	RoutingDirectoryChangeType_name = map[int32]string{
+		0: "ROUTING_DIRECTORY_CHANGE_TYPE_UNSPECIFIED",
+		1: "ROUTING_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION",
+		2: "ROUTING_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION",
+		3: "ROUTING_DIRECTORY_CHANGE_TYPE_ADD_ROUTING_NUMBER",
+		4: "ROUTING_DIRECTORY_CHANGE_TYPE_FLAG_REMOVED",
+		5: "ROUTING_DIRECTORY_CHANGE_TYPE_RESTORE",
+	}
+	RoutingDirectoryChangeType_value = map[string]int32{
+		"ROUTING_DIRECTORY_CHANGE_TYPE_UNSPECIFIED":        0,
+		"ROUTING_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION": 1,
+		"ROUTING_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION": 2,
+		"ROUTING_DIRECTORY_CHANGE_TYPE_ADD_ROUTING_NUMBER": 3,
+		"ROUTING_DIRECTORY_CHANGE_TYPE_FLAG_REMOVED":       4,
+		"ROUTING_DIRECTORY_CHANGE_TYPE_RESTORE":            5,
+	}
+)
+
+func (x RoutingDirectoryChangeType) Enum() *RoutingDirectoryChangeType {
+	p := new(RoutingDirectoryChangeType)
+	*p = x
+	return p
 }
 
-func (x *ManifestResponse) Reset() {
-	*x = ManifestResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x RoutingDirectoryChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ManifestResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (RoutingDirectoryChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[7].Descriptor()
 }
 
-func (*ManifestResponse) ProtoMessage() {}
+func (RoutingDirectoryChangeType) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[7]
+}
 
-func (x *ManifestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x RoutingDirectoryChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use ManifestResponse.ProtoReflect.Descriptor instead.
-func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RoutingDirectoryChangeType.Descriptor instead.
+func (RoutingDirectoryChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ManifestResponse) GetIdentity() *ServiceIdentity {
-	if x != nil {
-		return x.Identity
+type BicDirectoryFormat int32
+
+const (
+	BicDirectoryFormat_BIC_DIRECTORY_FORMAT_UNSPECIFIED BicDirectoryFormat = 0 // Detected from the header line
+	BicDirectoryFormat_BIC_DIRECTORY_FORMAT_CSV         BicDirectoryFormat = 1 // Comma-separated with a header line
+	BicDirectoryFormat_BIC_DIRECTORY_FORMAT_FLAT        BicDirectoryFormat = 2 // Tab-delimited flat file with a header line, as in BICPlus
+)
+
+// Enum value maps for BicDirectoryFormat.
+var (
+	BicDirectoryFormat_name = map[int32]string{
+		0: "BIC_DIRECTORY_FORMAT_UNSPECIFIED",
+		1: "BIC_DIRECTORY_FORMAT_CSV",
+		2: "BIC_DIRECTORY_FORMAT_FLAT",
 	}
-	return nil
+	BicDirectoryFormat_value = map[string]int32{
+		"BIC_DIRECTORY_FORMAT_UNSPECIFIED": 0,
+		"BIC_DIRECTORY_FORMAT_CSV":         1,
+		"BIC_DIRECTORY_FORMAT_FLAT":        2,
+	}
+)
+
+func (x BicDirectoryFormat) Enum() *BicDirectoryFormat {
+	p := new(BicDirectoryFormat)
+	*p = x
+	return p
 }
 
-func (x *ManifestResponse) GetBuildInfo() *BuildInfo {
-	if x != nil {
-		return x.BuildInfo
-	}
-	return nil
+func (x BicDirectoryFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ManifestResponse) GetRuntimeInfo() *RuntimeInfo {
-	if x != nil {
-		return x.RuntimeInfo
-	}
-	return nil
+func (BicDirectoryFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[8].Descriptor()
 }
 
-func (x *ManifestResponse) GetMetadata() *ServiceMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+func (BicDirectoryFormat) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[8]
 }
 
-func (x *ManifestResponse) GetCapabilities() *ServiceCapabilities {
-	if x != nil {
-		return x.Capabilities
-	}
-	return nil
+func (x BicDirectoryFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-type ServiceIdentity struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // Service name (e.g., "treasury-service")
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                         // Semantic version (e.g., "1.2.3")
-	ApiVersion    string                 `protobuf:"bytes,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"` // API version (e.g., "v1")
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                 // Brief service description
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use BicDirectoryFormat.Descriptor instead.
+func (BicDirectoryFormat) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ServiceIdentity) Reset() {
-	*x = ServiceIdentity{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+type BicDirectoryChangeType int32
+
+const (
+	BicDirectoryChangeType_BIC_DIRECTORY_CHANGE_TYPE_UNSPECIFIED        BicDirectoryChangeType = 0
+	BicDirectoryChangeType_BIC_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION BicDirectoryChangeType = 1 // New institution for an unknown head office BIC
+	BicDirectoryChangeType_BIC_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION BicDirectoryChangeType = 2 // Directory details of a known institution changed
+)
+
+// Enum value maps for BicDirectoryChangeType.
+var (
+	BicDirectoryChangeType_name = map[int32]string{
+		0: "BIC_DIRECTORY_CHANGE_TYPE_UNSPECIFIED",
+		1: "BIC_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION",
+		2: "BIC_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION",
+	}
+	BicDirectoryChangeType_value = map[string]int32{
+		"BIC_DIRECTORY_CHANGE_TYPE_UNSPECIFIED":        0,
+		"BIC_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION": 1,
+		"BIC_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION": 2,
+	}
+)
+
+func (x BicDirectoryChangeType) Enum() *BicDirectoryChangeType {
+	p := new(BicDirectoryChangeType)
+	*p = x
+	return p
 }
 
-func (x *ServiceIdentity) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x BicDirectoryChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*ServiceIdentity) ProtoMessage() {}
+func (BicDirectoryChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[9].Descriptor()
+}
 
-func (x *ServiceIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (BicDirectoryChangeType) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[9]
 }
 
-// Deprecated: Use ServiceIdentity.ProtoReflect.Descriptor instead.
-func (*ServiceIdentity) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{2}
+func (x BicDirectoryChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ServiceIdentity) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use BicDirectoryChangeType.Descriptor instead.
+func (BicDirectoryChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ServiceIdentity) GetVersion() string {
-	if x != nil {
-		return x.Version
+type RateType int32
+
+const (
+	RateType_RATE_TYPE_UNSPECIFIED RateType = 0
+	RateType_RATE_TYPE_SPOT        RateType = 1 // Market rate at effective_at
+	RateType_RATE_TYPE_AVERAGE     RateType = 2 // Average over the period ending at effective_at
+	RateType_RATE_TYPE_CLOSING     RateType = 3 // Period-end rate used for revaluation
+)
+
+// Enum value maps for RateType.
+var (
+	RateType_name = map[int32]string{
+		0: "RATE_TYPE_UNSPECIFIED",
+		1: "RATE_TYPE_SPOT",
+		2: "RATE_TYPE_AVERAGE",
+		3: "RATE_TYPE_CLOSING",
+	}
+	RateType_value = map[string]int32{
+		"RATE_TYPE_UNSPECIFIED": 0,
+		"RATE_TYPE_SPOT":        1,
+		"RATE_TYPE_AVERAGE":     2,
+		"RATE_TYPE_CLOSING":     3,
 	}
-	return ""
+)
+
+func (x RateType) Enum() *RateType {
+	p := new(RateType)
+	*p = x
+	return p
 }
 
-func (x *ServiceIdentity) GetApiVersion() string {
-	if x != nil {
-		return x.ApiVersion
-	}
-	return ""
+func (x RateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ServiceIdentity) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (RateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[10].Descriptor()
 }
 
-type BuildInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommitHash    string                 `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"` // Git commit SHA
-	Branch        string                 `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`                           // Git branch name
-	BuildTime     string                 `protobuf:"bytes,3,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"`    // RFC3339 timestamp
-	Builder       string                 `protobuf:"bytes,4,opt,name=builder,proto3" json:"builder,omitempty"`                         // CI system or user
-	IsDirty       bool                   `protobuf:"varint,5,opt,name=is_dirty,json=isDirty,proto3" json:"is_dirty,omitempty"`         // Whether build had uncommitted changes
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (RateType) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[10]
 }
 
-func (x *BuildInfo) Reset() {
-	*x = BuildInfo{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x RateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *BuildInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use RateType.Descriptor instead.
+func (RateType) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (*BuildInfo) ProtoMessage() {}
+// How a returned rate was obtained
+type RateDerivation int32
 
-func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+const (
+	RateDerivation_RATE_DERIVATION_UNSPECIFIED  RateDerivation = 0
+	RateDerivation_RATE_DERIVATION_IDENTITY     RateDerivation = 1 // Base and quote are the same currency
+	RateDerivation_RATE_DERIVATION_DIRECT       RateDerivation = 2 // Stored rate for the pair
+	RateDerivation_RATE_DERIVATION_INVERSE      RateDerivation = 3 // 1 / stored rate for the reverse pair
+	RateDerivation_RATE_DERIVATION_TRIANGULATED RateDerivation = 4 // Product of two rates through the pivot currency
+)
+
+// Enum value maps for RateDerivation.
+var (
+	RateDerivation_name = map[int32]string{
+		0: "RATE_DERIVATION_UNSPECIFIED",
+		1: "RATE_DERIVATION_IDENTITY",
+		2: "RATE_DERIVATION_DIRECT",
+		3: "RATE_DERIVATION_INVERSE",
+		4: "RATE_DERIVATION_TRIANGULATED",
+	}
+	RateDerivation_value = map[string]int32{
+		"RATE_DERIVATION_UNSPECIFIED":  0,
+		"RATE_DERIVATION_IDENTITY":     1,
+		"RATE_DERIVATION_DIRECT":       2,
+		"RATE_DERIVATION_INVERSE":      3,
+		"RATE_DERIVATION_TRIANGULATED": 4,
+	}
+)
+
+func (x RateDerivation) Enum() *RateDerivation {
+	p := new(RateDerivation)
+	*p = x
+	return p
+}
+
+func (x RateDerivation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateDerivation) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[11].Descriptor()
+}
+
+func (RateDerivation) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[11]
+}
+
+func (x RateDerivation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateDerivation.Descriptor instead.
+func (RateDerivation) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{11}
+}
+
+// Rate file layouts accepted by ImportRates
+// Spec: docs/specs/005-exchange-rates.md#file-formats
+type RateFileFormat int32
+
+const (
+	RateFileFormat_RATE_FILE_FORMAT_UNSPECIFIED RateFileFormat = 0
+	RateFileFormat_RATE_FILE_FORMAT_ECB_XML     RateFileFormat = 1 // ECB euro foreign exchange reference rates (EUR base)
+	RateFileFormat_RATE_FILE_FORMAT_CSV         RateFileFormat = 2 // date,base,quote,rate rows, optional header
+)
+
+// Enum value maps for RateFileFormat.
+var (
+	RateFileFormat_name = map[int32]string{
+		0: "RATE_FILE_FORMAT_UNSPECIFIED",
+		1: "RATE_FILE_FORMAT_ECB_XML",
+		2: "RATE_FILE_FORMAT_CSV",
+	}
+	RateFileFormat_value = map[string]int32{
+		"RATE_FILE_FORMAT_UNSPECIFIED": 0,
+		"RATE_FILE_FORMAT_ECB_XML":     1,
+		"RATE_FILE_FORMAT_CSV":         2,
+	}
+)
+
+func (x RateFileFormat) Enum() *RateFileFormat {
+	p := new(RateFileFormat)
+	*p = x
+	return p
+}
+
+func (x RateFileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RateFileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[12].Descriptor()
+}
+
+func (RateFileFormat) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[12]
+}
+
+func (x RateFileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RateFileFormat.Descriptor instead.
+func (RateFileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{12}
+}
+
+type BankAccountPurpose int32
+
+const (
+	BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED  BankAccountPurpose = 0
+	BankAccountPurpose_BANK_ACCOUNT_PURPOSE_OPERATING    BankAccountPurpose = 1
+	BankAccountPurpose_BANK_ACCOUNT_PURPOSE_PAYROLL      BankAccountPurpose = 2
+	BankAccountPurpose_BANK_ACCOUNT_PURPOSE_COLLECTIONS  BankAccountPurpose = 3
+	BankAccountPurpose_BANK_ACCOUNT_PURPOSE_DISBURSEMENT BankAccountPurpose = 4
+	BankAccountPurpose_BANK_ACCOUNT_PURPOSE_RESERVE      BankAccountPurpose = 5
+	BankAccountPurpose_BANK_ACCOUNT_PURPOSE_ESCROW       BankAccountPurpose = 6
+)
+
+// Enum value maps for BankAccountPurpose.
+var (
+	BankAccountPurpose_name = map[int32]string{
+		0: "BANK_ACCOUNT_PURPOSE_UNSPECIFIED",
+		1: "BANK_ACCOUNT_PURPOSE_OPERATING",
+		2: "BANK_ACCOUNT_PURPOSE_PAYROLL",
+		3: "BANK_ACCOUNT_PURPOSE_COLLECTIONS",
+		4: "BANK_ACCOUNT_PURPOSE_DISBURSEMENT",
+		5: "BANK_ACCOUNT_PURPOSE_RESERVE",
+		6: "BANK_ACCOUNT_PURPOSE_ESCROW",
+	}
+	BankAccountPurpose_value = map[string]int32{
+		"BANK_ACCOUNT_PURPOSE_UNSPECIFIED":  0,
+		"BANK_ACCOUNT_PURPOSE_OPERATING":    1,
+		"BANK_ACCOUNT_PURPOSE_PAYROLL":      2,
+		"BANK_ACCOUNT_PURPOSE_COLLECTIONS":  3,
+		"BANK_ACCOUNT_PURPOSE_DISBURSEMENT": 4,
+		"BANK_ACCOUNT_PURPOSE_RESERVE":      5,
+		"BANK_ACCOUNT_PURPOSE_ESCROW":       6,
+	}
+)
+
+func (x BankAccountPurpose) Enum() *BankAccountPurpose {
+	p := new(BankAccountPurpose)
+	*p = x
+	return p
+}
+
+func (x BankAccountPurpose) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BankAccountPurpose) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[13].Descriptor()
+}
+
+func (BankAccountPurpose) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[13]
+}
+
+func (x BankAccountPurpose) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BankAccountPurpose.Descriptor instead.
+func (BankAccountPurpose) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{13}
+}
+
+type BankAccountStatus int32
+
+const (
+	BankAccountStatus_BANK_ACCOUNT_STATUS_UNSPECIFIED BankAccountStatus = 0
+	BankAccountStatus_BANK_ACCOUNT_STATUS_ACTIVE      BankAccountStatus = 1
+	BankAccountStatus_BANK_ACCOUNT_STATUS_CLOSED      BankAccountStatus = 2
+)
+
+// Enum value maps for BankAccountStatus.
+var (
+	BankAccountStatus_name = map[int32]string{
+		0: "BANK_ACCOUNT_STATUS_UNSPECIFIED",
+		1: "BANK_ACCOUNT_STATUS_ACTIVE",
+		2: "BANK_ACCOUNT_STATUS_CLOSED",
+	}
+	BankAccountStatus_value = map[string]int32{
+		"BANK_ACCOUNT_STATUS_UNSPECIFIED": 0,
+		"BANK_ACCOUNT_STATUS_ACTIVE":      1,
+		"BANK_ACCOUNT_STATUS_CLOSED":      2,
+	}
+)
+
+func (x BankAccountStatus) Enum() *BankAccountStatus {
+	p := new(BankAccountStatus)
+	*p = x
+	return p
+}
+
+func (x BankAccountStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BankAccountStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[14].Descriptor()
+}
+
+func (BankAccountStatus) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[14]
+}
+
+func (x BankAccountStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BankAccountStatus.Descriptor instead.
+func (BankAccountStatus) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{14}
+}
+
+type HolidayRuleType int32
+
+const (
+	HolidayRuleType_HOLIDAY_RULE_TYPE_UNSPECIFIED HolidayRuleType = 0
+	HolidayRuleType_HOLIDAY_RULE_TYPE_FIXED       HolidayRuleType = 1 // Same month and day every year
+	HolidayRuleType_HOLIDAY_RULE_TYPE_NTH_WEEKDAY HolidayRuleType = 2 // e.g. third Monday of January, or last Monday of May
+	HolidayRuleType_HOLIDAY_RULE_TYPE_EASTER      HolidayRuleType = 3 // Days from Western Easter Sunday
+	HolidayRuleType_HOLIDAY_RULE_TYPE_DATE        HolidayRuleType = 4 // A single date
+)
+
+// Enum value maps for HolidayRuleType.
+var (
+	HolidayRuleType_name = map[int32]string{
+		0: "HOLIDAY_RULE_TYPE_UNSPECIFIED",
+		1: "HOLIDAY_RULE_TYPE_FIXED",
+		2: "HOLIDAY_RULE_TYPE_NTH_WEEKDAY",
+		3: "HOLIDAY_RULE_TYPE_EASTER",
+		4: "HOLIDAY_RULE_TYPE_DATE",
+	}
+	HolidayRuleType_value = map[string]int32{
+		"HOLIDAY_RULE_TYPE_UNSPECIFIED": 0,
+		"HOLIDAY_RULE_TYPE_FIXED":       1,
+		"HOLIDAY_RULE_TYPE_NTH_WEEKDAY": 2,
+		"HOLIDAY_RULE_TYPE_EASTER":      3,
+		"HOLIDAY_RULE_TYPE_DATE":        4,
+	}
+)
+
+func (x HolidayRuleType) Enum() *HolidayRuleType {
+	p := new(HolidayRuleType)
+	*p = x
+	return p
+}
+
+func (x HolidayRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HolidayRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[15].Descriptor()
+}
+
+func (HolidayRuleType) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[15]
+}
+
+func (x HolidayRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HolidayRuleType.Descriptor instead.
+func (HolidayRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{15}
+}
+
+type HolidayObservance int32
+
+const (
+	HolidayObservance_HOLIDAY_OBSERVANCE_UNSPECIFIED      HolidayObservance = 0 // Same as NONE
+	HolidayObservance_HOLIDAY_OBSERVANCE_NONE             HolidayObservance = 1 // Not moved when it falls on a weekend
+	HolidayObservance_HOLIDAY_OBSERVANCE_SUNDAY_TO_MONDAY HolidayObservance = 2 // Sunday to Monday, Saturday not moved
+	HolidayObservance_HOLIDAY_OBSERVANCE_NEAREST_WEEKDAY  HolidayObservance = 3 // Saturday to Friday, Sunday to Monday
+	HolidayObservance_HOLIDAY_OBSERVANCE_NEXT_WEEKDAY     HolidayObservance = 4 // Next weekday that is not already a holiday
+)
+
+// Enum value maps for HolidayObservance.
+var (
+	HolidayObservance_name = map[int32]string{
+		0: "HOLIDAY_OBSERVANCE_UNSPECIFIED",
+		1: "HOLIDAY_OBSERVANCE_NONE",
+		2: "HOLIDAY_OBSERVANCE_SUNDAY_TO_MONDAY",
+		3: "HOLIDAY_OBSERVANCE_NEAREST_WEEKDAY",
+		4: "HOLIDAY_OBSERVANCE_NEXT_WEEKDAY",
+	}
+	HolidayObservance_value = map[string]int32{
+		"HOLIDAY_OBSERVANCE_UNSPECIFIED":      0,
+		"HOLIDAY_OBSERVANCE_NONE":             1,
+		"HOLIDAY_OBSERVANCE_SUNDAY_TO_MONDAY": 2,
+		"HOLIDAY_OBSERVANCE_NEAREST_WEEKDAY":  3,
+		"HOLIDAY_OBSERVANCE_NEXT_WEEKDAY":     4,
+	}
+)
+
+func (x HolidayObservance) Enum() *HolidayObservance {
+	p := new(HolidayObservance)
+	*p = x
+	return p
+}
+
+func (x HolidayObservance) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HolidayObservance) Descriptor() protoreflect.EnumDescriptor {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[16].Descriptor()
+}
+
+func (HolidayObservance) Type() protoreflect.EnumType {
+	return &file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes[16]
+}
+
+func (x HolidayObservance) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HolidayObservance.Descriptor instead.
+func (HolidayObservance) EnumDescriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{16}
+}
+
+type ManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManifestRequest) Reset() {
+	*x = ManifestRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestRequest) ProtoMessage() {}
+
+func (x *ManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestRequest.ProtoReflect.Descriptor instead.
+func (*ManifestRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{0}
+}
+
+type ManifestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service identity
+	Identity *ServiceIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Build information
+	BuildInfo *BuildInfo `protobuf:"bytes,2,opt,name=build_info,json=buildInfo,proto3" json:"build_info,omitempty"`
+	// Runtime information
+	RuntimeInfo *RuntimeInfo `protobuf:"bytes,3,opt,name=runtime_info,json=runtimeInfo,proto3" json:"runtime_info,omitempty"`
+	// Service metadata
+	Metadata *ServiceMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Service capabilities
+	Capabilities  *ServiceCapabilities `protobuf:"bytes,5,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManifestResponse) Reset() {
+	*x = ManifestResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestResponse) ProtoMessage() {}
+
+func (x *ManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestResponse.ProtoReflect.Descriptor instead.
+func (*ManifestResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ManifestResponse) GetIdentity() *ServiceIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *ManifestResponse) GetBuildInfo() *BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *ManifestResponse) GetRuntimeInfo() *RuntimeInfo {
+	if x != nil {
+		return x.RuntimeInfo
+	}
+	return nil
+}
+
+func (x *ManifestResponse) GetMetadata() *ServiceMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ManifestResponse) GetCapabilities() *ServiceCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type ServiceIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // Service name (e.g., "treasury-service")
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                         // Semantic version (e.g., "1.2.3")
+	ApiVersion    string                 `protobuf:"bytes,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"` // API version (e.g., "v1")
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                 // Brief service description
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceIdentity) Reset() {
+	*x = ServiceIdentity{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceIdentity) ProtoMessage() {}
+
+func (x *ServiceIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceIdentity.ProtoReflect.Descriptor instead.
+func (*ServiceIdentity) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServiceIdentity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceIdentity) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServiceIdentity) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ServiceIdentity) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type BuildInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommitHash    string                 `protobuf:"bytes,1,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"` // Git commit SHA
+	Branch        string                 `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`                           // Git branch name
+	BuildTime     string                 `protobuf:"bytes,3,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"`    // RFC3339 timestamp
+	Builder       string                 `protobuf:"bytes,4,opt,name=builder,proto3" json:"builder,omitempty"`                         // CI system or user
+	IsDirty       bool                   `protobuf:"varint,5,opt,name=is_dirty,json=isDirty,proto3" json:"is_dirty,omitempty"`         // Whether build had uncommitted changes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,73 +1172,3752 @@ func (x *BuildInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
-func (*BuildInfo) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BuildInfo) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetBuildTime() string {
+	if x != nil {
+		return x.BuildTime
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetBuilder() string {
+	if x != nil {
+		return x.Builder
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetIsDirty() bool {
+	if x != nil {
+		return x.IsDirty
+	}
+	return false
+}
+
+type RuntimeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`           // Unique instance identifier
+	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`                                 // Host machine name
+	StartedAt     string                 `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`              // RFC3339 timestamp
+	Environment   string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`                           // Environment (dev/staging/prod)
+	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                                     // Deployment region/zone
+	UptimeSeconds int64                  `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"` // Seconds since start
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuntimeInfo) Reset() {
+	*x = RuntimeInfo{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuntimeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeInfo) ProtoMessage() {}
+
+func (x *RuntimeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeInfo.ProtoReflect.Descriptor instead.
+func (*RuntimeInfo) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RuntimeInfo) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *RuntimeInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *RuntimeInfo) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *RuntimeInfo) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *RuntimeInfo) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RuntimeInfo) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+type ServiceMetadata struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Owner            string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`                                                                             // Team or owner email
+	RepositoryUrl    string                 `protobuf:"bytes,2,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"`                                        // Source code repository
+	DocumentationUrl string                 `protobuf:"bytes,3,opt,name=documentation_url,json=documentationUrl,proto3" json:"documentation_url,omitempty"`                               // Service documentation
+	SupportContact   string                 `protobuf:"bytes,4,opt,name=support_contact,json=supportContact,proto3" json:"support_contact,omitempty"`                                     // Support contact info
+	Labels           map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional labels/tags
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ServiceMetadata) Reset() {
+	*x = ServiceMetadata{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceMetadata) ProtoMessage() {}
+
+func (x *ServiceMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceMetadata.ProtoReflect.Descriptor instead.
+func (*ServiceMetadata) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ServiceMetadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ServiceMetadata) GetRepositoryUrl() string {
+	if x != nil {
+		return x.RepositoryUrl
+	}
+	return ""
+}
+
+func (x *ServiceMetadata) GetDocumentationUrl() string {
+	if x != nil {
+		return x.DocumentationUrl
+	}
+	return ""
+}
+
+func (x *ServiceMetadata) GetSupportContact() string {
+	if x != nil {
+		return x.SupportContact
+	}
+	return ""
+}
+
+func (x *ServiceMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type ServiceCapabilities struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersions   []string               `protobuf:"bytes,1,rep,name=api_versions,json=apiVersions,proto3" json:"api_versions,omitempty"` // Supported API versions
+	Protocols     []string               `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`                        // Supported protocols (grpc, http)
+	Features      []string               `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`                          // Enabled feature flags
+	Dependencies  []*ServiceDependency   `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`                  // Required services
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceCapabilities) Reset() {
+	*x = ServiceCapabilities{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceCapabilities) ProtoMessage() {}
+
+func (x *ServiceCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceCapabilities.ProtoReflect.Descriptor instead.
+func (*ServiceCapabilities) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServiceCapabilities) GetApiVersions() []string {
+	if x != nil {
+		return x.ApiVersions
+	}
+	return nil
+}
+
+func (x *ServiceCapabilities) GetProtocols() []string {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
+func (x *ServiceCapabilities) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *ServiceCapabilities) GetDependencies() []*ServiceDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+type ServiceDependency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                // Dependency service name
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                          // Required version (semver range)
+	IsOptional    bool                   `protobuf:"varint,3,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"` // Whether dependency is optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDependency) Reset() {
+	*x = ServiceDependency{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDependency) ProtoMessage() {}
+
+func (x *ServiceDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDependency.ProtoReflect.Descriptor instead.
+func (*ServiceDependency) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ServiceDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceDependency) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServiceDependency) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+type LivenessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LivenessRequest) Reset() {
+	*x = LivenessRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LivenessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessRequest) ProtoMessage() {}
+
+func (x *LivenessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessRequest.ProtoReflect.Descriptor instead.
+func (*LivenessRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{8}
+}
+
+type LivenessResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Overall liveness status
+	Status ServiceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=treasury.ServiceStatus" json:"status,omitempty"`
+	// Human-readable message
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Component readiness checks
+	Checks []*ComponentCheck `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+	// Timestamp of the check
+	CheckedAt     string `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // RFC3339 format
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LivenessResponse) Reset() {
+	*x = LivenessResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LivenessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessResponse) ProtoMessage() {}
+
+func (x *LivenessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessResponse.ProtoReflect.Descriptor instead.
+func (*LivenessResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LivenessResponse) GetStatus() ServiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ServiceStatus_HEALTHY
+}
+
+func (x *LivenessResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LivenessResponse) GetChecks() []*ComponentCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *LivenessResponse) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
+type HealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Include detailed dependency info
+	IncludeDetails bool `protobuf:"varint,1,opt,name=include_details,json=includeDetails,proto3" json:"include_details,omitempty"`
+	// Check specific dependencies only
+	DependencyFilter []string `protobuf:"bytes,2,rep,name=dependency_filter,json=dependencyFilter,proto3" json:"dependency_filter,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HealthRequest) Reset() {
+	*x = HealthRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRequest) ProtoMessage() {}
+
+func (x *HealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HealthRequest) GetIncludeDetails() bool {
+	if x != nil {
+		return x.IncludeDetails
+	}
+	return false
+}
+
+func (x *HealthRequest) GetDependencyFilter() []string {
+	if x != nil {
+		return x.DependencyFilter
+	}
+	return nil
+}
+
+type HealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Overall health status
+	Status ServiceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=treasury.ServiceStatus" json:"status,omitempty"`
+	// Human-readable message
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Service liveness (internal readiness)
+	Liveness *LivenessInfo `protobuf:"bytes,3,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	// Dependency health checks
+	Dependencies []*DependencyHealth `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// Timestamp of the check
+	CheckedAt string `protobuf:"bytes,5,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // RFC3339 format
+	// Time taken to perform all checks (milliseconds)
+	CheckDurationMs int64 `protobuf:"varint,6,opt,name=check_duration_ms,json=checkDurationMs,proto3" json:"check_duration_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HealthResponse) Reset() {
+	*x = HealthResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthResponse) ProtoMessage() {}
+
+func (x *HealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HealthResponse) GetStatus() ServiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ServiceStatus_HEALTHY
+}
+
+func (x *HealthResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetLiveness() *LivenessInfo {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+func (x *HealthResponse) GetDependencies() []*DependencyHealth {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *HealthResponse) GetCheckedAt() string {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return ""
+}
+
+func (x *HealthResponse) GetCheckDurationMs() int64 {
+	if x != nil {
+		return x.CheckDurationMs
+	}
+	return 0
+}
+
+type ComponentCheck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Component name (e.g., "config", "database_pool", "cache")
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Component status
+	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Optional message
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentCheck) Reset() {
+	*x = ComponentCheck{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentCheck) ProtoMessage() {}
+
+func (x *ComponentCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentCheck.ProtoReflect.Descriptor instead.
+func (*ComponentCheck) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ComponentCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComponentCheck) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ComponentCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LivenessInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Is service ready to accept traffic
+	IsAlive bool `protobuf:"varint,1,opt,name=is_alive,json=isAlive,proto3" json:"is_alive,omitempty"`
+	// Service initialization status
+	ConfigLoaded bool `protobuf:"varint,2,opt,name=config_loaded,json=configLoaded,proto3" json:"config_loaded,omitempty"`
+	PoolsReady   bool `protobuf:"varint,3,opt,name=pools_ready,json=poolsReady,proto3" json:"pools_ready,omitempty"`
+	CacheWarmed  bool `protobuf:"varint,4,opt,name=cache_warmed,json=cacheWarmed,proto3" json:"cache_warmed,omitempty"`
+	// Custom readiness checks
+	Components    []*ComponentCheck `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LivenessInfo) Reset() {
+	*x = LivenessInfo{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LivenessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessInfo) ProtoMessage() {}
+
+func (x *LivenessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessInfo.ProtoReflect.Descriptor instead.
+func (*LivenessInfo) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LivenessInfo) GetIsAlive() bool {
+	if x != nil {
+		return x.IsAlive
+	}
+	return false
+}
+
+func (x *LivenessInfo) GetConfigLoaded() bool {
+	if x != nil {
+		return x.ConfigLoaded
+	}
+	return false
+}
+
+func (x *LivenessInfo) GetPoolsReady() bool {
+	if x != nil {
+		return x.PoolsReady
+	}
+	return false
+}
+
+func (x *LivenessInfo) GetCacheWarmed() bool {
+	if x != nil {
+		return x.CacheWarmed
+	}
+	return false
+}
+
+func (x *LivenessInfo) GetComponents() []*ComponentCheck {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type DependencyHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Dependency name (e.g., "postgres", "redis", "user-service")
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Dependency type
+	Type DependencyType `protobuf:"varint,2,opt,name=type,proto3,enum=treasury.DependencyType" json:"type,omitempty"`
+	// Health status
+	Status ServiceStatus `protobuf:"varint,3,opt,name=status,proto3,enum=treasury.ServiceStatus" json:"status,omitempty"`
+	// Is this dependency critical for service operation
+	IsCritical bool `protobuf:"varint,4,opt,name=is_critical,json=isCritical,proto3" json:"is_critical,omitempty"`
+	// Human-readable status message
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// Configuration details (non-sensitive)
+	Config *DependencyConfig `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
+	// Last successful check timestamp
+	LastSuccess string `protobuf:"bytes,7,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"` // RFC3339 format
+	// Last check timestamp
+	LastCheck string `protobuf:"bytes,8,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"` // RFC3339 format
+	// Response time of health check (milliseconds)
+	ResponseTimeMs int64 `protobuf:"varint,9,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	// Error details if unhealthy
+	Error         string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyHealth) Reset() {
+	*x = DependencyHealth{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyHealth) ProtoMessage() {}
+
+func (x *DependencyHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyHealth.ProtoReflect.Descriptor instead.
+func (*DependencyHealth) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DependencyHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DependencyHealth) GetType() DependencyType {
+	if x != nil {
+		return x.Type
+	}
+	return DependencyType_DATABASE
+}
+
+func (x *DependencyHealth) GetStatus() ServiceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ServiceStatus_HEALTHY
+}
+
+func (x *DependencyHealth) GetIsCritical() bool {
+	if x != nil {
+		return x.IsCritical
+	}
+	return false
+}
+
+func (x *DependencyHealth) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DependencyHealth) GetConfig() *DependencyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *DependencyHealth) GetLastSuccess() string {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return ""
+}
+
+func (x *DependencyHealth) GetLastCheck() string {
+	if x != nil {
+		return x.LastCheck
+	}
+	return ""
+}
+
+func (x *DependencyHealth) GetResponseTimeMs() int64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+func (x *DependencyHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DependencyConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Connection information (non-sensitive)
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Port     int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"` // e.g., "grpc", "http", "postgres"
+	// Database/schema/topic information
+	DatabaseName string `protobuf:"bytes,4,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	SchemaName   string `protobuf:"bytes,5,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
+	TopicName    string `protobuf:"bytes,6,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
+	// Connection pool information
+	PoolInfo *ConnectionPoolInfo `protobuf:"bytes,7,opt,name=pool_info,json=poolInfo,proto3" json:"pool_info,omitempty"`
+	// Service version if applicable
+	Version string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+	// Additional metadata
+	Metadata      map[string]string `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyConfig) Reset() {
+	*x = DependencyConfig{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyConfig) ProtoMessage() {}
+
+func (x *DependencyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyConfig.ProtoReflect.Descriptor instead.
+func (*DependencyConfig) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DependencyConfig) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *DependencyConfig) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *DependencyConfig) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *DependencyConfig) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *DependencyConfig) GetSchemaName() string {
+	if x != nil {
+		return x.SchemaName
+	}
+	return ""
+}
+
+func (x *DependencyConfig) GetTopicName() string {
+	if x != nil {
+		return x.TopicName
+	}
+	return ""
+}
+
+func (x *DependencyConfig) GetPoolInfo() *ConnectionPoolInfo {
+	if x != nil {
+		return x.PoolInfo
+	}
+	return nil
+}
+
+func (x *DependencyConfig) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *DependencyConfig) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ConnectionPoolInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxConnections    int32                  `protobuf:"varint,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	ActiveConnections int32                  `protobuf:"varint,2,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	IdleConnections   int32                  `protobuf:"varint,3,opt,name=idle_connections,json=idleConnections,proto3" json:"idle_connections,omitempty"`
+	WaitCount         int32                  `protobuf:"varint,4,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitDurationMs    int64                  `protobuf:"varint,5,opt,name=wait_duration_ms,json=waitDurationMs,proto3" json:"wait_duration_ms,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConnectionPoolInfo) Reset() {
+	*x = ConnectionPoolInfo{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionPoolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionPoolInfo) ProtoMessage() {}
+
+func (x *ConnectionPoolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionPoolInfo.ProtoReflect.Descriptor instead.
+func (*ConnectionPoolInfo) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConnectionPoolInfo) GetMaxConnections() int32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *ConnectionPoolInfo) GetActiveConnections() int32 {
+	if x != nil {
+		return x.ActiveConnections
+	}
+	return 0
+}
+
+func (x *ConnectionPoolInfo) GetIdleConnections() int32 {
+	if x != nil {
+		return x.IdleConnections
+	}
+	return 0
+}
+
+func (x *ConnectionPoolInfo) GetWaitCount() int32 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *ConnectionPoolInfo) GetWaitDurationMs() int64 {
+	if x != nil {
+		return x.WaitDurationMs
+	}
+	return 0
+}
+
+// Currency represents an ISO 4217 compliant currency
+type Currency struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // UUID
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                           // ISO 4217 code (USD, EUR)
+	NumericCode    string                 `protobuf:"bytes,3,opt,name=numeric_code,json=numericCode,proto3" json:"numeric_code,omitempty"`          // ISO 4217 numeric code
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                           // Official name
+	MinorUnits     int32                  `protobuf:"varint,5,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`            // Decimal places
+	Symbol         string                 `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`                                       // Currency symbol
+	SymbolPosition string                 `protobuf:"bytes,7,opt,name=symbol_position,json=symbolPosition,proto3" json:"symbol_position,omitempty"` // before/after
+	CountryCodes   []string               `protobuf:"bytes,8,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`       // ISO 3166 codes
+	IsActive       bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                  // Active status
+	IsCrypto       bool                   `protobuf:"varint,10,opt,name=is_crypto,json=isCrypto,proto3" json:"is_crypto,omitempty"`                 // Cryptocurrency flag
+	Status         CurrencyStatus         `protobuf:"varint,11,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"`        // Current status
+	ActivatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	DeactivatedAt  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,17,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version        int32                  `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"` // Optimistic locking
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Currency) Reset() {
+	*x = Currency{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Currency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Currency) ProtoMessage() {}
+
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Currency) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Currency) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Currency) GetNumericCode() string {
+	if x != nil {
+		return x.NumericCode
+	}
+	return ""
+}
+
+func (x *Currency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Currency) GetMinorUnits() int32 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *Currency) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Currency) GetSymbolPosition() string {
+	if x != nil {
+		return x.SymbolPosition
+	}
+	return ""
+}
+
+func (x *Currency) GetCountryCodes() []string {
+	if x != nil {
+		return x.CountryCodes
+	}
+	return nil
+}
+
+func (x *Currency) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Currency) GetIsCrypto() bool {
+	if x != nil {
+		return x.IsCrypto
+	}
+	return false
+}
+
+func (x *Currency) GetStatus() CurrencyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+}
+
+func (x *Currency) GetActivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return nil
+}
+
+func (x *Currency) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *Currency) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Currency) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Currency) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Currency) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Currency) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Request/Response messages
+type CreateCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                  // Required
+	NumericCode   string                 `protobuf:"bytes,2,opt,name=numeric_code,json=numericCode,proto3" json:"numeric_code,omitempty"` // Optional
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                  // Required
+	MinorUnits    int32                  `protobuf:"varint,4,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`   // Default 2
+	Symbol        string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	CountryCodes  []string               `protobuf:"bytes,6,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`
+	IsCrypto      bool                   `protobuf:"varint,7,opt,name=is_crypto,json=isCrypto,proto3" json:"is_crypto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCurrencyRequest) Reset() {
+	*x = CreateCurrencyRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCurrencyRequest) ProtoMessage() {}
+
+func (x *CreateCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateCurrencyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetNumericCode() string {
+	if x != nil {
+		return x.NumericCode
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetMinorUnits() int32 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *CreateCurrencyRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetCountryCodes() []string {
+	if x != nil {
+		return x.CountryCodes
+	}
+	return nil
+}
+
+func (x *CreateCurrencyRequest) GetIsCrypto() bool {
+	if x != nil {
+		return x.IsCrypto
+	}
+	return false
+}
+
+type CreateCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCurrencyResponse) Reset() {
+	*x = CreateCurrencyResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCurrencyResponse) ProtoMessage() {}
+
+func (x *CreateCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*CreateCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateCurrencyResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+type GetCurrencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetCurrencyRequest_Code
+	//	*GetCurrencyRequest_NumericCode
+	//	*GetCurrencyRequest_Id
+	Identifier    isGetCurrencyRequest_Identifier `protobuf_oneof:"identifier"`
+	AsOf          *timestamppb.Timestamp          `protobuf:"bytes,4,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // Return the currency as it applied at this time, default now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyRequest) Reset() {
+	*x = GetCurrencyRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyRequest) ProtoMessage() {}
+
+func (x *GetCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCurrencyRequest) GetIdentifier() isGetCurrencyRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetCurrencyRequest) GetCode() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetCurrencyRequest_Code); ok {
+			return x.Code
+		}
+	}
+	return ""
+}
+
+func (x *GetCurrencyRequest) GetNumericCode() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetCurrencyRequest_NumericCode); ok {
+			return x.NumericCode
+		}
+	}
+	return ""
+}
+
+func (x *GetCurrencyRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetCurrencyRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetCurrencyRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type isGetCurrencyRequest_Identifier interface {
+	isGetCurrencyRequest_Identifier()
+}
+
+type GetCurrencyRequest_Code struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3,oneof"` // Primary lookup
+}
+
+type GetCurrencyRequest_NumericCode struct {
+	NumericCode string `protobuf:"bytes,2,opt,name=numeric_code,json=numericCode,proto3,oneof"` // Alternative lookup
+}
+
+type GetCurrencyRequest_Id struct {
+	Id string `protobuf:"bytes,3,opt,name=id,proto3,oneof"` // UUID lookup
+}
+
+func (*GetCurrencyRequest_Code) isGetCurrencyRequest_Identifier() {}
+
+func (*GetCurrencyRequest_NumericCode) isGetCurrencyRequest_Identifier() {}
+
+func (*GetCurrencyRequest_Id) isGetCurrencyRequest_Identifier() {}
+
+type GetCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyResponse) Reset() {
+	*x = GetCurrencyResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyResponse) ProtoMessage() {}
+
+func (x *GetCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCurrencyResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+type UpdateCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                               // Required (identifies currency)
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields to update
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MinorUnits    int32                  `protobuf:"varint,4,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	Symbol        string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	CountryCodes  []string               `protobuf:"bytes,6,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`
+	Status        CurrencyStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"`
+	Version       int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"` // For optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCurrencyRequest) Reset() {
+	*x = UpdateCurrencyRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCurrencyRequest) ProtoMessage() {}
+
+func (x *UpdateCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateCurrencyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateCurrencyRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateCurrencyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCurrencyRequest) GetMinorUnits() int32 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *UpdateCurrencyRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *UpdateCurrencyRequest) GetCountryCodes() []string {
+	if x != nil {
+		return x.CountryCodes
+	}
+	return nil
+}
+
+func (x *UpdateCurrencyRequest) GetStatus() CurrencyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateCurrencyRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCurrencyResponse) Reset() {
+	*x = UpdateCurrencyResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCurrencyResponse) ProtoMessage() {}
+
+func (x *UpdateCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateCurrencyResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+type DeactivateCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                   // Required
+	Status        CurrencyStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"` // New status (inactive/deprecated/deleted)
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`        // User making the change
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                            // For optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateCurrencyRequest) Reset() {
+	*x = DeactivateCurrencyRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateCurrencyRequest) ProtoMessage() {}
+
+func (x *DeactivateCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeactivateCurrencyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DeactivateCurrencyRequest) GetStatus() CurrencyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+}
+
+func (x *DeactivateCurrencyRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *DeactivateCurrencyRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeactivateCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Currency      *Currency              `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // Updated currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateCurrencyResponse) Reset() {
+	*x = DeactivateCurrencyResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateCurrencyResponse) ProtoMessage() {}
+
+func (x *DeactivateCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeactivateCurrencyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeactivateCurrencyResponse) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+type ListCurrenciesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         CurrencyStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"`            // Filter by status
+	IsActive       bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                     // Filter by active flag
+	IsCrypto       bool                   `protobuf:"varint,3,opt,name=is_crypto,json=isCrypto,proto3" json:"is_crypto,omitempty"`                     // Filter cryptocurrencies
+	CountryCode    string                 `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`             // Filter by country
+	PageSize       int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                     // Pagination
+	PageToken      string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                   // Pagination token
+	SkipTotalCount bool                   `protobuf:"varint,7,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"` // Leave total_count unset to skip counting matches
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCurrenciesRequest) Reset() {
+	*x = ListCurrenciesRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrenciesRequest) ProtoMessage() {}
+
+func (x *ListCurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCurrenciesRequest) GetStatus() CurrencyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+}
+
+func (x *ListCurrenciesRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ListCurrenciesRequest) GetIsCrypto() bool {
+	if x != nil {
+		return x.IsCrypto
+	}
+	return false
+}
+
+func (x *ListCurrenciesRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *ListCurrenciesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCurrenciesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCurrenciesRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+type ListCurrenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currencies    []*Currency            `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCurrenciesResponse) Reset() {
+	*x = ListCurrenciesResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCurrenciesResponse) ProtoMessage() {}
+
+func (x *ListCurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListCurrenciesResponse) GetCurrencies() []*Currency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *ListCurrenciesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCurrenciesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type BulkCreateCurrenciesRequest struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	Currencies     []*CreateCurrencyRequest `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	SkipDuplicates bool                     `protobuf:"varint,2,opt,name=skip_duplicates,json=skipDuplicates,proto3" json:"skip_duplicates,omitempty"` // Skip existing currencies
+	UpdateExisting bool                     `protobuf:"varint,3,opt,name=update_existing,json=updateExisting,proto3" json:"update_existing,omitempty"` // Update if exists
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkCreateCurrenciesRequest) Reset() {
+	*x = BulkCreateCurrenciesRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateCurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateCurrenciesRequest) ProtoMessage() {}
+
+func (x *BulkCreateCurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateCurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BulkCreateCurrenciesRequest) GetCurrencies() []*CreateCurrencyRequest {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *BulkCreateCurrenciesRequest) GetSkipDuplicates() bool {
+	if x != nil {
+		return x.SkipDuplicates
+	}
+	return false
+}
+
+func (x *BulkCreateCurrenciesRequest) GetUpdateExisting() bool {
+	if x != nil {
+		return x.UpdateExisting
+	}
+	return false
+}
+
+type BulkCreateCurrenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkCreateCurrenciesResponse) Reset() {
+	*x = BulkCreateCurrenciesResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkCreateCurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCreateCurrenciesResponse) ProtoMessage() {}
+
+func (x *BulkCreateCurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCreateCurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BulkCreateCurrenciesResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateCurrenciesResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateCurrenciesResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *BulkCreateCurrenciesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// CurrencyVersion is a currency as it was between valid_from and valid_to
+// Spec: docs/specs/006-currency-history.md#history-table
+type CurrencyVersion struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Currency          *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`                                                         // Attributes of this version
+	ChangeType        CurrencyChangeType     `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=treasury.CurrencyChangeType" json:"change_type,omitempty"` // What produced this version
+	ValidFrom         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`       // Unset for the current version
+	ChangedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"` // When the row was written
+	ChangedBy         string                 `protobuf:"bytes,6,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ScheduledChangeId string                 `protobuf:"bytes,7,opt,name=scheduled_change_id,json=scheduledChangeId,proto3" json:"scheduled_change_id,omitempty"` // Set when change_type is scheduled
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CurrencyVersion) Reset() {
+	*x = CurrencyVersion{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyVersion) ProtoMessage() {}
+
+func (x *CurrencyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyVersion.ProtoReflect.Descriptor instead.
+func (*CurrencyVersion) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CurrencyVersion) GetCurrency() *Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+func (x *CurrencyVersion) GetChangeType() CurrencyChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return CurrencyChangeType_CURRENCY_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *CurrencyVersion) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CurrencyVersion) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *CurrencyVersion) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *CurrencyVersion) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *CurrencyVersion) GetScheduledChangeId() string {
+	if x != nil {
+		return x.ScheduledChangeId
+	}
+	return ""
+}
+
+// CurrencyChange is a change to a currency that takes effect at effective_at
+// Spec: docs/specs/006-currency-history.md#scheduled-changes
+type CurrencyChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // UUID
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // Currency code
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields the change sets
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	MinorUnits    int32                  `protobuf:"varint,6,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	Symbol        string                 `protobuf:"bytes,7,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Status        CurrencyStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"` // e.g. "ISO 4217 amendment 176"
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	AppliedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"` // Set once the change is applied
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancelledBy   string                 `protobuf:"bytes,14,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyChange) Reset() {
+	*x = CurrencyChange{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyChange) ProtoMessage() {}
+
+func (x *CurrencyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyChange.ProtoReflect.Descriptor instead.
+func (*CurrencyChange) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CurrencyChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CurrencyChange) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyChange) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *CurrencyChange) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *CurrencyChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CurrencyChange) GetMinorUnits() int32 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *CurrencyChange) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CurrencyChange) GetStatus() CurrencyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+}
+
+func (x *CurrencyChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CurrencyChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CurrencyChange) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CurrencyChange) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
+}
+
+func (x *CurrencyChange) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *CurrencyChange) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+type GetCurrencyHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Required
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyHistoryRequest) Reset() {
+	*x = GetCurrencyHistoryRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyHistoryRequest) ProtoMessage() {}
+
+func (x *GetCurrencyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetCurrencyHistoryRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetCurrencyHistoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Versions       []*CurrencyVersion     `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`                                   // Newest first
+	PendingChanges []*CurrencyChange      `protobuf:"bytes,2,rep,name=pending_changes,json=pendingChanges,proto3" json:"pending_changes,omitempty"` // Soonest first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCurrencyHistoryResponse) Reset() {
+	*x = GetCurrencyHistoryResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyHistoryResponse) ProtoMessage() {}
+
+func (x *GetCurrencyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetCurrencyHistoryResponse) GetVersions() []*CurrencyVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *GetCurrencyHistoryResponse) GetPendingChanges() []*CurrencyChange {
+	if x != nil {
+		return x.PendingChanges
+	}
+	return nil
+}
+
+type ScheduleCurrencyChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                  // Required
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"` // Required, in the future
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`    // name, minor_units, symbol, status
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	MinorUnits    int32                  `protobuf:"varint,5,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
+	Symbol        string                 `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Status        CurrencyStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"` // active, inactive or deprecated
+	Reason        string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleCurrencyChangeRequest) Reset() {
+	*x = ScheduleCurrencyChangeRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCurrencyChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCurrencyChangeRequest) ProtoMessage() {}
+
+func (x *ScheduleCurrencyChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCurrencyChangeRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCurrencyChangeRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetMinorUnits() int32 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetStatus() CurrencyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScheduleCurrencyChangeRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type ScheduleCurrencyChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *CurrencyChange        `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleCurrencyChangeResponse) Reset() {
+	*x = ScheduleCurrencyChangeResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleCurrencyChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleCurrencyChangeResponse) ProtoMessage() {}
+
+func (x *ScheduleCurrencyChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleCurrencyChangeResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleCurrencyChangeResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ScheduleCurrencyChangeResponse) GetChange() *CurrencyChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type CancelCurrencyChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Required
+	CancelledBy   string                 `protobuf:"bytes,2,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCurrencyChangeRequest) Reset() {
+	*x = CancelCurrencyChangeRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCurrencyChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCurrencyChangeRequest) ProtoMessage() {}
+
+func (x *CancelCurrencyChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCurrencyChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelCurrencyChangeRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CancelCurrencyChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelCurrencyChangeRequest) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+type CancelCurrencyChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *CurrencyChange        `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCurrencyChangeResponse) Reset() {
+	*x = CancelCurrencyChangeResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCurrencyChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCurrencyChangeResponse) ProtoMessage() {}
+
+func (x *CancelCurrencyChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCurrencyChangeResponse.ProtoReflect.Descriptor instead.
+func (*CancelCurrencyChangeResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CancelCurrencyChangeResponse) GetChange() *CurrencyChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// RoutingNumber represents a routing number for an institution
+type RoutingNumber struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                            // UUID
+	RoutingNumber          string                 `protobuf:"bytes,2,opt,name=routing_number,json=routingNumber,proto3" json:"routing_number,omitempty"` // 9-digit routing number
+	RoutingType            string                 `protobuf:"bytes,3,opt,name=routing_type,json=routingType,proto3" json:"routing_type,omitempty"`       // standard, wire, ach, fedwire, other
+	IsPrimary              bool                   `protobuf:"varint,4,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`            // Primary routing number flag
+	Description            string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                          // Optional description
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RemovedFromDirectoryAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=removed_from_directory_at,json=removedFromDirectoryAt,proto3" json:"removed_from_directory_at,omitempty"` // Set when missing from the latest Federal Reserve directory
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RoutingNumber) Reset() {
+	*x = RoutingNumber{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutingNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutingNumber) ProtoMessage() {}
+
+func (x *RoutingNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutingNumber.ProtoReflect.Descriptor instead.
+func (*RoutingNumber) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RoutingNumber) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoutingNumber) GetRoutingNumber() string {
+	if x != nil {
+		return x.RoutingNumber
+	}
+	return ""
+}
+
+func (x *RoutingNumber) GetRoutingType() string {
+	if x != nil {
+		return x.RoutingType
+	}
+	return ""
+}
+
+func (x *RoutingNumber) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+func (x *RoutingNumber) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoutingNumber) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RoutingNumber) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RoutingNumber) GetRemovedFromDirectoryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemovedFromDirectoryAt
+	}
+	return nil
+}
+
+// FinancialInstitution represents a banking institution
+type FinancialInstitution struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // UUID
+	Code      string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                            // Unique institution code
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // Official name
+	ShortName string                 `protobuf:"bytes,4,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"` // Display name
+	// US Banking identifiers (supports multiple routing numbers)
+	RoutingNumbers []*RoutingNumber `protobuf:"bytes,5,rep,name=routing_numbers,json=routingNumbers,proto3" json:"routing_numbers,omitempty"` // List of routing numbers
+	// International identifiers
+	SwiftCode  string `protobuf:"bytes,6,opt,name=swift_code,json=swiftCode,proto3" json:"swift_code,omitempty"`    // SWIFT/BIC code
+	IbanPrefix string `protobuf:"bytes,7,opt,name=iban_prefix,json=ibanPrefix,proto3" json:"iban_prefix,omitempty"` // IBAN prefix
+	BankCode   string `protobuf:"bytes,8,opt,name=bank_code,json=bankCode,proto3" json:"bank_code,omitempty"`       // National bank code
+	BranchCode string `protobuf:"bytes,9,opt,name=branch_code,json=branchCode,proto3" json:"branch_code,omitempty"` // Branch code
+	// Institution details
+	InstitutionType InstitutionType `protobuf:"varint,10,opt,name=institution_type,json=institutionType,proto3,enum=treasury.InstitutionType" json:"institution_type,omitempty"`
+	CountryCode     string          `protobuf:"bytes,11,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`             // ISO 3166-1 alpha-2
+	PrimaryCurrency string          `protobuf:"bytes,12,opt,name=primary_currency,json=primaryCurrency,proto3" json:"primary_currency,omitempty"` // ISO 4217 code
+	// Address
+	Address *Address `protobuf:"bytes,13,opt,name=address,proto3" json:"address,omitempty"`
+	// Contact
+	Contact *ContactInfo `protobuf:"bytes,14,opt,name=contact,proto3" json:"contact,omitempty"`
+	// Operational
+	TimeZone        string           `protobuf:"bytes,15,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	BusinessHours   *structpb.Struct `protobuf:"bytes,16,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
+	HolidayCalendar string           `protobuf:"bytes,17,opt,name=holiday_calendar,json=holidayCalendar,proto3" json:"holiday_calendar,omitempty"`
+	// Regulatory
+	RegulatoryId string           `protobuf:"bytes,18,opt,name=regulatory_id,json=regulatoryId,proto3" json:"regulatory_id,omitempty"`
+	TaxId        string           `protobuf:"bytes,19,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	Licenses     *structpb.Struct `protobuf:"bytes,20,opt,name=licenses,proto3" json:"licenses,omitempty"`
+	// Status
+	Status           InstitutionStatus      `protobuf:"varint,21,opt,name=status,proto3,enum=treasury.InstitutionStatus" json:"status,omitempty"`
+	IsActive         bool                   `protobuf:"varint,22,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ActivatedAt      *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	DeactivatedAt    *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+	SuspensionReason string                 `protobuf:"bytes,25,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason,omitempty"`
+	// Metadata
+	Capabilities       *structpb.Struct `protobuf:"bytes,26,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Notes              string           `protobuf:"bytes,27,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExternalReferences *structpb.Struct `protobuf:"bytes,28,opt,name=external_references,json=externalReferences,proto3" json:"external_references,omitempty"`
+	// Audit
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,31,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,32,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,33,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinancialInstitution) Reset() {
+	*x = FinancialInstitution{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinancialInstitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinancialInstitution) ProtoMessage() {}
+
+func (x *FinancialInstitution) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinancialInstitution.ProtoReflect.Descriptor instead.
+func (*FinancialInstitution) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FinancialInstitution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetRoutingNumbers() []*RoutingNumber {
+	if x != nil {
+		return x.RoutingNumbers
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetSwiftCode() string {
+	if x != nil {
+		return x.SwiftCode
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetIbanPrefix() string {
+	if x != nil {
+		return x.IbanPrefix
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetBankCode() string {
+	if x != nil {
+		return x.BankCode
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetBranchCode() string {
+	if x != nil {
+		return x.BranchCode
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetInstitutionType() InstitutionType {
+	if x != nil {
+		return x.InstitutionType
+	}
+	return InstitutionType_INSTITUTION_TYPE_UNSPECIFIED
+}
+
+func (x *FinancialInstitution) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetPrimaryCurrency() string {
+	if x != nil {
+		return x.PrimaryCurrency
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetContact() *ContactInfo {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetBusinessHours() *structpb.Struct {
+	if x != nil {
+		return x.BusinessHours
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetHolidayCalendar() string {
+	if x != nil {
+		return x.HolidayCalendar
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetRegulatoryId() string {
+	if x != nil {
+		return x.RegulatoryId
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetLicenses() *structpb.Struct {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetStatus() InstitutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InstitutionStatus_INSTITUTION_STATUS_UNSPECIFIED
+}
+
+func (x *FinancialInstitution) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *FinancialInstitution) GetActivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetSuspensionReason() string {
+	if x != nil {
+		return x.SuspensionReason
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetCapabilities() *structpb.Struct {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetExternalReferences() *structpb.Struct {
+	if x != nil {
+		return x.ExternalReferences
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *FinancialInstitution) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *FinancialInstitution) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Address struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StreetAddress_1 string                 `protobuf:"bytes,1,opt,name=street_address_1,json=streetAddress1,proto3" json:"street_address_1,omitempty"`
+	StreetAddress_2 string                 `protobuf:"bytes,2,opt,name=street_address_2,json=streetAddress2,proto3" json:"street_address_2,omitempty"`
+	City            string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	StateProvince   string                 `protobuf:"bytes,4,opt,name=state_province,json=stateProvince,proto3" json:"state_province,omitempty"`
+	PostalCode      string                 `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	CountryCode     string                 `protobuf:"bytes,6,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Address) GetStreetAddress_1() string {
+	if x != nil {
+		return x.StreetAddress_1
+	}
+	return ""
+}
+
+func (x *Address) GetStreetAddress_2() string {
+	if x != nil {
+		return x.StreetAddress_2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetStateProvince() string {
+	if x != nil {
+		return x.StateProvince
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type ContactInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	FaxNumber     string                 `protobuf:"bytes,2,opt,name=fax_number,json=faxNumber,proto3" json:"fax_number,omitempty"`
+	EmailAddress  string                 `protobuf:"bytes,3,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	WebsiteUrl    string                 `protobuf:"bytes,4,opt,name=website_url,json=websiteUrl,proto3" json:"website_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactInfo) Reset() {
+	*x = ContactInfo{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactInfo) ProtoMessage() {}
+
+func (x *ContactInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactInfo.ProtoReflect.Descriptor instead.
+func (*ContactInfo) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ContactInfo) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ContactInfo) GetFaxNumber() string {
+	if x != nil {
+		return x.FaxNumber
+	}
+	return ""
+}
+
+func (x *ContactInfo) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *ContactInfo) GetWebsiteUrl() string {
+	if x != nil {
+		return x.WebsiteUrl
+	}
+	return ""
+}
+
+// Request/Response messages for FinancialInstitutionService
+type CreateInstitutionRequest struct {
+	state           protoimpl.MessageState                         `protogen:"open.v1"`
+	Code            string                                         `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Required, unique
+	Name            string                                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Required
+	ShortName       string                                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	RoutingNumbers  []*CreateInstitutionRequest_RoutingNumberInput `protobuf:"bytes,4,rep,name=routing_numbers,json=routingNumbers,proto3" json:"routing_numbers,omitempty"` // US banks routing numbers
+	SwiftCode       string                                         `protobuf:"bytes,5,opt,name=swift_code,json=swiftCode,proto3" json:"swift_code,omitempty"`                // Required for international
+	BankCode        string                                         `protobuf:"bytes,6,opt,name=bank_code,json=bankCode,proto3" json:"bank_code,omitempty"`
+	BranchCode      string                                         `protobuf:"bytes,7,opt,name=branch_code,json=branchCode,proto3" json:"branch_code,omitempty"`
+	InstitutionType InstitutionType                                `protobuf:"varint,8,opt,name=institution_type,json=institutionType,proto3,enum=treasury.InstitutionType" json:"institution_type,omitempty"` // Required
+	CountryCode     string                                         `protobuf:"bytes,9,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`                                            // Required
+	PrimaryCurrency string                                         `protobuf:"bytes,10,opt,name=primary_currency,json=primaryCurrency,proto3" json:"primary_currency,omitempty"`
+	Address         *Address                                       `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	Contact         *ContactInfo                                   `protobuf:"bytes,12,opt,name=contact,proto3" json:"contact,omitempty"`
+	TimeZone        string                                         `protobuf:"bytes,13,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Capabilities    *structpb.Struct                               `protobuf:"bytes,14,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Notes           string                                         `protobuf:"bytes,15,opt,name=notes,proto3" json:"notes,omitempty"`
+	HolidayCalendar string                                         `protobuf:"bytes,16,opt,name=holiday_calendar,json=holidayCalendar,proto3" json:"holiday_calendar,omitempty"` // Code of a holiday calendar, e.g. US-FED
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateInstitutionRequest) Reset() {
+	*x = CreateInstitutionRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInstitutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstitutionRequest) ProtoMessage() {}
+
+func (x *CreateInstitutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstitutionRequest.ProtoReflect.Descriptor instead.
+func (*CreateInstitutionRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateInstitutionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetRoutingNumbers() []*CreateInstitutionRequest_RoutingNumberInput {
+	if x != nil {
+		return x.RoutingNumbers
+	}
+	return nil
+}
+
+func (x *CreateInstitutionRequest) GetSwiftCode() string {
+	if x != nil {
+		return x.SwiftCode
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetBankCode() string {
+	if x != nil {
+		return x.BankCode
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetBranchCode() string {
+	if x != nil {
+		return x.BranchCode
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetInstitutionType() InstitutionType {
+	if x != nil {
+		return x.InstitutionType
+	}
+	return InstitutionType_INSTITUTION_TYPE_UNSPECIFIED
+}
+
+func (x *CreateInstitutionRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetPrimaryCurrency() string {
+	if x != nil {
+		return x.PrimaryCurrency
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateInstitutionRequest) GetContact() *ContactInfo {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *CreateInstitutionRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetCapabilities() *structpb.Struct {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *CreateInstitutionRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateInstitutionRequest) GetHolidayCalendar() string {
+	if x != nil {
+		return x.HolidayCalendar
+	}
+	return ""
+}
+
+type CreateInstitutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Institution   *FinancialInstitution  `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInstitutionResponse) Reset() {
+	*x = CreateInstitutionResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInstitutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstitutionResponse) ProtoMessage() {}
+
+func (x *CreateInstitutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstitutionResponse.ProtoReflect.Descriptor instead.
+func (*CreateInstitutionResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateInstitutionResponse) GetInstitution() *FinancialInstitution {
+	if x != nil {
+		return x.Institution
+	}
+	return nil
+}
+
+type GetInstitutionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetInstitutionRequest_Code
+	//	*GetInstitutionRequest_RoutingNumber
+	//	*GetInstitutionRequest_SwiftCode
+	//	*GetInstitutionRequest_Id
+	//	*GetInstitutionRequest_Iban
+	Identifier    isGetInstitutionRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstitutionRequest) Reset() {
+	*x = GetInstitutionRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstitutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstitutionRequest) ProtoMessage() {}
+
+func (x *GetInstitutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstitutionRequest.ProtoReflect.Descriptor instead.
+func (*GetInstitutionRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetInstitutionRequest) GetIdentifier() isGetInstitutionRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetInstitutionRequest) GetCode() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetInstitutionRequest_Code); ok {
+			return x.Code
+		}
+	}
+	return ""
+}
+
+func (x *GetInstitutionRequest) GetRoutingNumber() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetInstitutionRequest_RoutingNumber); ok {
+			return x.RoutingNumber
+		}
+	}
+	return ""
+}
+
+func (x *GetInstitutionRequest) GetSwiftCode() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetInstitutionRequest_SwiftCode); ok {
+			return x.SwiftCode
+		}
+	}
+	return ""
+}
+
+func (x *GetInstitutionRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetInstitutionRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetInstitutionRequest) GetIban() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetInstitutionRequest_Iban); ok {
+			return x.Iban
+		}
+	}
+	return ""
+}
+
+type isGetInstitutionRequest_Identifier interface {
+	isGetInstitutionRequest_Identifier()
+}
+
+type GetInstitutionRequest_Code struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3,oneof"` // Primary lookup
+}
+
+type GetInstitutionRequest_RoutingNumber struct {
+	RoutingNumber string `protobuf:"bytes,2,opt,name=routing_number,json=routingNumber,proto3,oneof"` // US routing lookup
+}
+
+type GetInstitutionRequest_SwiftCode struct {
+	SwiftCode string `protobuf:"bytes,3,opt,name=swift_code,json=swiftCode,proto3,oneof"` // International lookup, branch BICs fall back to the head office
+}
+
+type GetInstitutionRequest_Id struct {
+	Id string `protobuf:"bytes,4,opt,name=id,proto3,oneof"` // UUID lookup
+}
+
+type GetInstitutionRequest_Iban struct {
+	Iban string `protobuf:"bytes,5,opt,name=iban,proto3,oneof"` // Owning institution of an IBAN
+}
+
+func (*GetInstitutionRequest_Code) isGetInstitutionRequest_Identifier() {}
+
+func (*GetInstitutionRequest_RoutingNumber) isGetInstitutionRequest_Identifier() {}
+
+func (*GetInstitutionRequest_SwiftCode) isGetInstitutionRequest_Identifier() {}
+
+func (*GetInstitutionRequest_Id) isGetInstitutionRequest_Identifier() {}
+
+func (*GetInstitutionRequest_Iban) isGetInstitutionRequest_Identifier() {}
+
+type GetInstitutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Institution   *FinancialInstitution  `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstitutionResponse) Reset() {
+	*x = GetInstitutionResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstitutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstitutionResponse) ProtoMessage() {}
+
+func (x *GetInstitutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstitutionResponse.ProtoReflect.Descriptor instead.
+func (*GetInstitutionResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *BuildInfo) GetCommitHash() string {
+func (x *GetInstitutionResponse) GetInstitution() *FinancialInstitution {
 	if x != nil {
-		return x.CommitHash
+		return x.Institution
+	}
+	return nil
+}
+
+type UpdateInstitutionRequest struct {
+	state           protoimpl.MessageState                          `protogen:"open.v1"`
+	Code            string                                          `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                               // Required (identifies institution)
+	UpdateMask      *fieldmaskpb.FieldMask                          `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields to update
+	Name            string                                          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName       string                                          `protobuf:"bytes,4,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	RoutingNumbers  []*UpdateInstitutionRequest_RoutingNumberUpdate `protobuf:"bytes,5,rep,name=routing_numbers,json=routingNumbers,proto3" json:"routing_numbers,omitempty"` // Replace all routing numbers
+	SwiftCode       string                                          `protobuf:"bytes,6,opt,name=swift_code,json=swiftCode,proto3" json:"swift_code,omitempty"`
+	Address         *Address                                        `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Contact         *ContactInfo                                    `protobuf:"bytes,8,opt,name=contact,proto3" json:"contact,omitempty"`
+	Status          InstitutionStatus                               `protobuf:"varint,9,opt,name=status,proto3,enum=treasury.InstitutionStatus" json:"status,omitempty"`
+	Capabilities    *structpb.Struct                                `protobuf:"bytes,10,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Notes           string                                          `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
+	Version         int32                                           `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`                                       // For optimistic locking
+	HolidayCalendar string                                          `protobuf:"bytes,13,opt,name=holiday_calendar,json=holidayCalendar,proto3" json:"holiday_calendar,omitempty"` // Code of a holiday calendar, empty clears it
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateInstitutionRequest) Reset() {
+	*x = UpdateInstitutionRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInstitutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstitutionRequest) ProtoMessage() {}
+
+func (x *UpdateInstitutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstitutionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstitutionRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateInstitutionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-func (x *BuildInfo) GetBranch() string {
+func (x *UpdateInstitutionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Branch
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateInstitutionRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BuildInfo) GetBuildTime() string {
+func (x *UpdateInstitutionRequest) GetShortName() string {
 	if x != nil {
-		return x.BuildTime
+		return x.ShortName
 	}
 	return ""
 }
 
-func (x *BuildInfo) GetBuilder() string {
+func (x *UpdateInstitutionRequest) GetRoutingNumbers() []*UpdateInstitutionRequest_RoutingNumberUpdate {
 	if x != nil {
-		return x.Builder
+		return x.RoutingNumbers
+	}
+	return nil
+}
+
+func (x *UpdateInstitutionRequest) GetSwiftCode() string {
+	if x != nil {
+		return x.SwiftCode
 	}
 	return ""
 }
 
-func (x *BuildInfo) GetIsDirty() bool {
+func (x *UpdateInstitutionRequest) GetAddress() *Address {
 	if x != nil {
-		return x.IsDirty
+		return x.Address
 	}
-	return false
+	return nil
 }
 
-type RuntimeInfo struct {
+func (x *UpdateInstitutionRequest) GetContact() *ContactInfo {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *UpdateInstitutionRequest) GetStatus() InstitutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InstitutionStatus_INSTITUTION_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateInstitutionRequest) GetCapabilities() *structpb.Struct {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *UpdateInstitutionRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateInstitutionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateInstitutionRequest) GetHolidayCalendar() string {
+	if x != nil {
+		return x.HolidayCalendar
+	}
+	return ""
+}
+
+type UpdateInstitutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`           // Unique instance identifier
-	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`                                 // Host machine name
-	StartedAt     string                 `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`              // RFC3339 timestamp
-	Environment   string                 `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`                           // Environment (dev/staging/prod)
-	Region        string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                                     // Deployment region/zone
-	UptimeSeconds int64                  `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"` // Seconds since start
+	Institution   *FinancialInstitution  `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuntimeInfo) Reset() {
-	*x = RuntimeInfo{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[4]
+func (x *UpdateInstitutionResponse) Reset() {
+	*x = UpdateInstitutionResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuntimeInfo) String() string {
+func (x *UpdateInstitutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuntimeInfo) ProtoMessage() {}
+func (*UpdateInstitutionResponse) ProtoMessage() {}
 
-func (x *RuntimeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[4]
+func (x *UpdateInstitutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,79 +4928,101 @@ func (x *RuntimeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuntimeInfo.ProtoReflect.Descriptor instead.
-func (*RuntimeInfo) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UpdateInstitutionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInstitutionResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RuntimeInfo) GetInstanceId() string {
+func (x *UpdateInstitutionResponse) GetInstitution() *FinancialInstitution {
 	if x != nil {
-		return x.InstanceId
+		return x.Institution
 	}
-	return ""
+	return nil
+}
+
+type DeleteInstitutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                            // Required
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`                         // Force deletion despite references
+	DeletedBy     string                 `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"` // User making deletion
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInstitutionRequest) Reset() {
+	*x = DeleteInstitutionRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInstitutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuntimeInfo) GetHostname() string {
+func (*DeleteInstitutionRequest) ProtoMessage() {}
+
+func (x *DeleteInstitutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[48]
 	if x != nil {
-		return x.Hostname
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RuntimeInfo) GetStartedAt() string {
-	if x != nil {
-		return x.StartedAt
-	}
-	return ""
+// Deprecated: Use DeleteInstitutionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInstitutionRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *RuntimeInfo) GetEnvironment() string {
+func (x *DeleteInstitutionRequest) GetCode() string {
 	if x != nil {
-		return x.Environment
+		return x.Code
 	}
 	return ""
 }
 
-func (x *RuntimeInfo) GetRegion() string {
+func (x *DeleteInstitutionRequest) GetForce() bool {
 	if x != nil {
-		return x.Region
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-func (x *RuntimeInfo) GetUptimeSeconds() int64 {
+func (x *DeleteInstitutionRequest) GetDeletedBy() string {
 	if x != nil {
-		return x.UptimeSeconds
+		return x.DeletedBy
 	}
-	return 0
+	return ""
 }
 
-type ServiceMetadata struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Owner            string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`                                                                             // Team or owner email
-	RepositoryUrl    string                 `protobuf:"bytes,2,opt,name=repository_url,json=repositoryUrl,proto3" json:"repository_url,omitempty"`                                        // Source code repository
-	DocumentationUrl string                 `protobuf:"bytes,3,opt,name=documentation_url,json=documentationUrl,proto3" json:"documentation_url,omitempty"`                               // Service documentation
-	SupportContact   string                 `protobuf:"bytes,4,opt,name=support_contact,json=supportContact,proto3" json:"support_contact,omitempty"`                                     // Support contact info
-	Labels           map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional labels/tags
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type DeleteInstitutionResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Success            bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BlockingReferences []string               `protobuf:"bytes,2,rep,name=blocking_references,json=blockingReferences,proto3" json:"blocking_references,omitempty"` // What prevents deletion
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ServiceMetadata) Reset() {
-	*x = ServiceMetadata{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[5]
+func (x *DeleteInstitutionResponse) Reset() {
+	*x = DeleteInstitutionResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceMetadata) String() string {
+func (x *DeleteInstitutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceMetadata) ProtoMessage() {}
+func (*DeleteInstitutionResponse) ProtoMessage() {}
 
-func (x *ServiceMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[5]
+func (x *DeleteInstitutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,71 +5033,53 @@ func (x *ServiceMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceMetadata.ProtoReflect.Descriptor instead.
-func (*ServiceMetadata) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ServiceMetadata) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
-}
-
-func (x *ServiceMetadata) GetRepositoryUrl() string {
-	if x != nil {
-		return x.RepositoryUrl
-	}
-	return ""
-}
-
-func (x *ServiceMetadata) GetDocumentationUrl() string {
-	if x != nil {
-		return x.DocumentationUrl
-	}
-	return ""
+// Deprecated: Use DeleteInstitutionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInstitutionResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ServiceMetadata) GetSupportContact() string {
+func (x *DeleteInstitutionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.SupportContact
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ServiceMetadata) GetLabels() map[string]string {
+func (x *DeleteInstitutionResponse) GetBlockingReferences() []string {
 	if x != nil {
-		return x.Labels
+		return x.BlockingReferences
 	}
 	return nil
 }
 
-type ServiceCapabilities struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApiVersions   []string               `protobuf:"bytes,1,rep,name=api_versions,json=apiVersions,proto3" json:"api_versions,omitempty"` // Supported API versions
-	Protocols     []string               `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`                        // Supported protocols (grpc, http)
-	Features      []string               `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`                          // Enabled feature flags
-	Dependencies  []*ServiceDependency   `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`                  // Required services
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListInstitutionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          InstitutionStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=treasury.InstitutionStatus" json:"status,omitempty"`                                        // Filter by status
+	InstitutionType InstitutionType        `protobuf:"varint,2,opt,name=institution_type,json=institutionType,proto3,enum=treasury.InstitutionType" json:"institution_type,omitempty"` // Filter by type
+	CountryCode     string                 `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`                                            // Filter by country
+	IsActive        bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                                                    // Filter by active flag
+	PageSize        int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                                    // Pagination
+	PageToken       string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                                  // Pagination token
+	SkipTotalCount  bool                   `protobuf:"varint,7,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"`                                // Leave total_count unset to skip counting matches
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ServiceCapabilities) Reset() {
-	*x = ServiceCapabilities{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[6]
+func (x *ListInstitutionsRequest) Reset() {
+	*x = ListInstitutionsRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceCapabilities) String() string {
+func (x *ListInstitutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceCapabilities) ProtoMessage() {}
+func (*ListInstitutionsRequest) ProtoMessage() {}
 
-func (x *ServiceCapabilities) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[6]
+func (x *ListInstitutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -767,63 +5090,84 @@ func (x *ServiceCapabilities) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceCapabilities.ProtoReflect.Descriptor instead.
-func (*ServiceCapabilities) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListInstitutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListInstitutionsRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ServiceCapabilities) GetApiVersions() []string {
+func (x *ListInstitutionsRequest) GetStatus() InstitutionStatus {
 	if x != nil {
-		return x.ApiVersions
+		return x.Status
 	}
-	return nil
+	return InstitutionStatus_INSTITUTION_STATUS_UNSPECIFIED
 }
 
-func (x *ServiceCapabilities) GetProtocols() []string {
+func (x *ListInstitutionsRequest) GetInstitutionType() InstitutionType {
 	if x != nil {
-		return x.Protocols
+		return x.InstitutionType
 	}
-	return nil
+	return InstitutionType_INSTITUTION_TYPE_UNSPECIFIED
 }
 
-func (x *ServiceCapabilities) GetFeatures() []string {
+func (x *ListInstitutionsRequest) GetCountryCode() string {
 	if x != nil {
-		return x.Features
+		return x.CountryCode
 	}
-	return nil
+	return ""
 }
 
-func (x *ServiceCapabilities) GetDependencies() []*ServiceDependency {
+func (x *ListInstitutionsRequest) GetIsActive() bool {
 	if x != nil {
-		return x.Dependencies
+		return x.IsActive
 	}
-	return nil
+	return false
 }
 
-type ServiceDependency struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                // Dependency service name
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                          // Required version (semver range)
-	IsOptional    bool                   `protobuf:"varint,3,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"` // Whether dependency is optional
+func (x *ListInstitutionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInstitutionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListInstitutionsRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+type ListInstitutionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Institutions  []*FinancialInstitution `protobuf:"bytes,1,rep,name=institutions,proto3" json:"institutions,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceDependency) Reset() {
-	*x = ServiceDependency{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[7]
+func (x *ListInstitutionsResponse) Reset() {
+	*x = ListInstitutionsResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceDependency) String() string {
+func (x *ListInstitutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceDependency) ProtoMessage() {}
+func (*ListInstitutionsResponse) ProtoMessage() {}
 
-func (x *ServiceDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[7]
+func (x *ListInstitutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,53 +5178,54 @@ func (x *ServiceDependency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceDependency.ProtoReflect.Descriptor instead.
-func (*ServiceDependency) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListInstitutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListInstitutionsResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ServiceDependency) GetName() string {
+func (x *ListInstitutionsResponse) GetInstitutions() []*FinancialInstitution {
 	if x != nil {
-		return x.Name
+		return x.Institutions
 	}
-	return ""
+	return nil
 }
 
-func (x *ServiceDependency) GetVersion() string {
+func (x *ListInstitutionsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Version
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ServiceDependency) GetIsOptional() bool {
+func (x *ListInstitutionsResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.IsOptional
+		return x.TotalCount
 	}
-	return false
+	return 0
 }
 
-type LivenessRequest struct {
+type CheckInstitutionReferencesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Institution to check
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LivenessRequest) Reset() {
-	*x = LivenessRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[8]
+func (x *CheckInstitutionReferencesRequest) Reset() {
+	*x = CheckInstitutionReferencesRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LivenessRequest) String() string {
+func (x *CheckInstitutionReferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LivenessRequest) ProtoMessage() {}
+func (*CheckInstitutionReferencesRequest) ProtoMessage() {}
 
-func (x *LivenessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[8]
+func (x *CheckInstitutionReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,40 +5236,41 @@ func (x *LivenessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LivenessRequest.ProtoReflect.Descriptor instead.
-func (*LivenessRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{8}
-}
-
-type LivenessResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Overall liveness status
-	Status ServiceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=treasury.ServiceStatus" json:"status,omitempty"`
-	// Human-readable message
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Component readiness checks
-	Checks []*ComponentCheck `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
-	// Timestamp of the check
-	CheckedAt     string `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // RFC3339 format
+// Deprecated: Use CheckInstitutionReferencesRequest.ProtoReflect.Descriptor instead.
+func (*CheckInstitutionReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CheckInstitutionReferencesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CheckInstitutionReferencesResponse struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	References    []*CheckInstitutionReferencesResponse_Reference `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	CanDelete     bool                                            `protobuf:"varint,2,opt,name=can_delete,json=canDelete,proto3" json:"can_delete,omitempty"` // True if no references
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LivenessResponse) Reset() {
-	*x = LivenessResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[9]
+func (x *CheckInstitutionReferencesResponse) Reset() {
+	*x = CheckInstitutionReferencesResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LivenessResponse) String() string {
+func (x *CheckInstitutionReferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LivenessResponse) ProtoMessage() {}
+func (*CheckInstitutionReferencesResponse) ProtoMessage() {}
 
-func (x *LivenessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[9]
+func (x *CheckInstitutionReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,64 +5281,49 @@ func (x *LivenessResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LivenessResponse.ProtoReflect.Descriptor instead.
-func (*LivenessResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *LivenessResponse) GetStatus() ServiceStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ServiceStatus_HEALTHY
-}
-
-func (x *LivenessResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use CheckInstitutionReferencesResponse.ProtoReflect.Descriptor instead.
+func (*CheckInstitutionReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *LivenessResponse) GetChecks() []*ComponentCheck {
+func (x *CheckInstitutionReferencesResponse) GetReferences() []*CheckInstitutionReferencesResponse_Reference {
 	if x != nil {
-		return x.Checks
+		return x.References
 	}
 	return nil
 }
 
-func (x *LivenessResponse) GetCheckedAt() string {
+func (x *CheckInstitutionReferencesResponse) GetCanDelete() bool {
 	if x != nil {
-		return x.CheckedAt
+		return x.CanDelete
 	}
-	return ""
+	return false
 }
 
-type HealthRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Include detailed dependency info
-	IncludeDetails bool `protobuf:"varint,1,opt,name=include_details,json=includeDetails,proto3" json:"include_details,omitempty"`
-	// Check specific dependencies only
-	DependencyFilter []string `protobuf:"bytes,2,rep,name=dependency_filter,json=dependencyFilter,proto3" json:"dependency_filter,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type BulkCreateInstitutionsRequest struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	Institutions   []*CreateInstitutionRequest `protobuf:"bytes,1,rep,name=institutions,proto3" json:"institutions,omitempty"`
+	SkipDuplicates bool                        `protobuf:"varint,2,opt,name=skip_duplicates,json=skipDuplicates,proto3" json:"skip_duplicates,omitempty"` // Skip existing institutions
+	UpdateExisting bool                        `protobuf:"varint,3,opt,name=update_existing,json=updateExisting,proto3" json:"update_existing,omitempty"` // Update if exists
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *HealthRequest) Reset() {
-	*x = HealthRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[10]
+func (x *BulkCreateInstitutionsRequest) Reset() {
+	*x = BulkCreateInstitutionsRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthRequest) String() string {
+func (x *BulkCreateInstitutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthRequest) ProtoMessage() {}
+func (*BulkCreateInstitutionsRequest) ProtoMessage() {}
 
-func (x *HealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[10]
+func (x *BulkCreateInstitutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,58 +5334,57 @@ func (x *HealthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthRequest.ProtoReflect.Descriptor instead.
-func (*HealthRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BulkCreateInstitutionsRequest.ProtoReflect.Descriptor instead.
+func (*BulkCreateInstitutionsRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *HealthRequest) GetIncludeDetails() bool {
+func (x *BulkCreateInstitutionsRequest) GetInstitutions() []*CreateInstitutionRequest {
 	if x != nil {
-		return x.IncludeDetails
+		return x.Institutions
+	}
+	return nil
+}
+
+func (x *BulkCreateInstitutionsRequest) GetSkipDuplicates() bool {
+	if x != nil {
+		return x.SkipDuplicates
 	}
 	return false
 }
 
-func (x *HealthRequest) GetDependencyFilter() []string {
+func (x *BulkCreateInstitutionsRequest) GetUpdateExisting() bool {
 	if x != nil {
-		return x.DependencyFilter
+		return x.UpdateExisting
 	}
-	return nil
+	return false
 }
 
-type HealthResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Overall health status
-	Status ServiceStatus `protobuf:"varint,1,opt,name=status,proto3,enum=treasury.ServiceStatus" json:"status,omitempty"`
-	// Human-readable message
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Service liveness (internal readiness)
-	Liveness *LivenessInfo `protobuf:"bytes,3,opt,name=liveness,proto3" json:"liveness,omitempty"`
-	// Dependency health checks
-	Dependencies []*DependencyHealth `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
-	// Timestamp of the check
-	CheckedAt string `protobuf:"bytes,5,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // RFC3339 format
-	// Time taken to perform all checks (milliseconds)
-	CheckDurationMs int64 `protobuf:"varint,6,opt,name=check_duration_ms,json=checkDurationMs,proto3" json:"check_duration_ms,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type BulkCreateInstitutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthResponse) Reset() {
-	*x = HealthResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[11]
+func (x *BulkCreateInstitutionsResponse) Reset() {
+	*x = BulkCreateInstitutionsResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthResponse) String() string {
+func (x *BulkCreateInstitutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthResponse) ProtoMessage() {}
+func (*BulkCreateInstitutionsResponse) ProtoMessage() {}
 
-func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[11]
+func (x *BulkCreateInstitutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,80 +5395,65 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
-func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use BulkCreateInstitutionsResponse.ProtoReflect.Descriptor instead.
+func (*BulkCreateInstitutionsResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *HealthResponse) GetStatus() ServiceStatus {
+func (x *BulkCreateInstitutionsResponse) GetCreatedCount() int32 {
 	if x != nil {
-		return x.Status
+		return x.CreatedCount
 	}
-	return ServiceStatus_HEALTHY
+	return 0
 }
 
-func (x *HealthResponse) GetMessage() string {
+func (x *BulkCreateInstitutionsResponse) GetUpdatedCount() int32 {
 	if x != nil {
-		return x.Message
+		return x.UpdatedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *HealthResponse) GetLiveness() *LivenessInfo {
+func (x *BulkCreateInstitutionsResponse) GetSkippedCount() int32 {
 	if x != nil {
-		return x.Liveness
+		return x.SkippedCount
 	}
-	return nil
+	return 0
 }
 
-func (x *HealthResponse) GetDependencies() []*DependencyHealth {
+func (x *BulkCreateInstitutionsResponse) GetErrors() []string {
 	if x != nil {
-		return x.Dependencies
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *HealthResponse) GetCheckedAt() string {
-	if x != nil {
-		return x.CheckedAt
-	}
-	return ""
-}
-
-func (x *HealthResponse) GetCheckDurationMs() int64 {
-	if x != nil {
-		return x.CheckDurationMs
-	}
-	return 0
-}
-
-type ComponentCheck struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Component name (e.g., "config", "database_pool", "cache")
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Component status
-	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
-	// Optional message
-	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+type ImportRoutingDirectoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        RoutingDirectoryFormat `protobuf:"varint,1,opt,name=format,proto3,enum=treasury.RoutingDirectoryFormat" json:"format,omitempty"` // Optional: detected when unspecified
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                                     // Required: File content
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Report the changes without applying them
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`                   // Optional: Name of the file, for logs
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ComponentCheck) Reset() {
-	*x = ComponentCheck{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[12]
+func (x *ImportRoutingDirectoryRequest) Reset() {
+	*x = ImportRoutingDirectoryRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComponentCheck) String() string {
+func (x *ImportRoutingDirectoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComponentCheck) ProtoMessage() {}
+func (*ImportRoutingDirectoryRequest) ProtoMessage() {}
 
-func (x *ComponentCheck) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[12]
+func (x *ImportRoutingDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1149,61 +5464,71 @@ func (x *ComponentCheck) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComponentCheck.ProtoReflect.Descriptor instead.
-func (*ComponentCheck) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ImportRoutingDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*ImportRoutingDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ComponentCheck) GetName() string {
+func (x *ImportRoutingDirectoryRequest) GetFormat() RoutingDirectoryFormat {
 	if x != nil {
-		return x.Name
+		return x.Format
 	}
-	return ""
+	return RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_UNSPECIFIED
 }
 
-func (x *ComponentCheck) GetReady() bool {
+func (x *ImportRoutingDirectoryRequest) GetContent() []byte {
 	if x != nil {
-		return x.Ready
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportRoutingDirectoryRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
 	return false
 }
 
-func (x *ComponentCheck) GetMessage() string {
+func (x *ImportRoutingDirectoryRequest) GetFileName() string {
 	if x != nil {
-		return x.Message
+		return x.FileName
 	}
 	return ""
 }
 
-type LivenessInfo struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Is service ready to accept traffic
-	IsAlive bool `protobuf:"varint,1,opt,name=is_alive,json=isAlive,proto3" json:"is_alive,omitempty"`
-	// Service initialization status
-	ConfigLoaded bool `protobuf:"varint,2,opt,name=config_loaded,json=configLoaded,proto3" json:"config_loaded,omitempty"`
-	PoolsReady   bool `protobuf:"varint,3,opt,name=pools_ready,json=poolsReady,proto3" json:"pools_ready,omitempty"`
-	CacheWarmed  bool `protobuf:"varint,4,opt,name=cache_warmed,json=cacheWarmed,proto3" json:"cache_warmed,omitempty"`
-	// Custom readiness checks
-	Components    []*ComponentCheck `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportRoutingDirectoryRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
 }
 
-func (x *LivenessInfo) Reset() {
-	*x = LivenessInfo{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[13]
+type RoutingDirectoryChange struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	Type            RoutingDirectoryChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=treasury.RoutingDirectoryChangeType" json:"type,omitempty"`
+	RoutingNumber   string                     `protobuf:"bytes,2,opt,name=routing_number,json=routingNumber,proto3" json:"routing_number,omitempty"`
+	InstitutionCode string                     `protobuf:"bytes,3,opt,name=institution_code,json=institutionCode,proto3" json:"institution_code,omitempty"`
+	Detail          string                     `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"` // e.g. "city NEW YORK -> BROOKLYN"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RoutingDirectoryChange) Reset() {
+	*x = RoutingDirectoryChange{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LivenessInfo) String() string {
+func (x *RoutingDirectoryChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LivenessInfo) ProtoMessage() {}
+func (*RoutingDirectoryChange) ProtoMessage() {}
 
-func (x *LivenessInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[13]
+func (x *RoutingDirectoryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,88 +5538,73 @@ func (x *LivenessInfo) ProtoReflect() protoreflect.Message {
 	}
 	return mi.MessageOf(x)
 }
-
-// Deprecated: Use LivenessInfo.ProtoReflect.Descriptor instead.
-func (*LivenessInfo) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *LivenessInfo) GetIsAlive() bool {
-	if x != nil {
-		return x.IsAlive
-	}
-	return false
-}
-
-func (x *LivenessInfo) GetConfigLoaded() bool {
-	if x != nil {
-		return x.ConfigLoaded
-	}
-	return false
+
+// Deprecated: Use RoutingDirectoryChange.ProtoReflect.Descriptor instead.
+func (*RoutingDirectoryChange) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *LivenessInfo) GetPoolsReady() bool {
+func (x *RoutingDirectoryChange) GetType() RoutingDirectoryChangeType {
 	if x != nil {
-		return x.PoolsReady
+		return x.Type
 	}
-	return false
+	return RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (x *LivenessInfo) GetCacheWarmed() bool {
+func (x *RoutingDirectoryChange) GetRoutingNumber() string {
 	if x != nil {
-		return x.CacheWarmed
+		return x.RoutingNumber
 	}
-	return false
+	return ""
 }
 
-func (x *LivenessInfo) GetComponents() []*ComponentCheck {
+func (x *RoutingDirectoryChange) GetInstitutionCode() string {
 	if x != nil {
-		return x.Components
+		return x.InstitutionCode
 	}
-	return nil
+	return ""
 }
 
-type DependencyHealth struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Dependency name (e.g., "postgres", "redis", "user-service")
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Dependency type
-	Type DependencyType `protobuf:"varint,2,opt,name=type,proto3,enum=treasury.DependencyType" json:"type,omitempty"`
-	// Health status
-	Status ServiceStatus `protobuf:"varint,3,opt,name=status,proto3,enum=treasury.ServiceStatus" json:"status,omitempty"`
-	// Is this dependency critical for service operation
-	IsCritical bool `protobuf:"varint,4,opt,name=is_critical,json=isCritical,proto3" json:"is_critical,omitempty"`
-	// Human-readable status message
-	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	// Configuration details (non-sensitive)
-	Config *DependencyConfig `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
-	// Last successful check timestamp
-	LastSuccess string `protobuf:"bytes,7,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"` // RFC3339 format
-	// Last check timestamp
-	LastCheck string `protobuf:"bytes,8,opt,name=last_check,json=lastCheck,proto3" json:"last_check,omitempty"` // RFC3339 format
-	// Response time of health check (milliseconds)
-	ResponseTimeMs int64 `protobuf:"varint,9,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
-	// Error details if unhealthy
-	Error         string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RoutingDirectoryChange) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
 }
 
-func (x *DependencyHealth) Reset() {
-	*x = DependencyHealth{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[14]
+type ImportRoutingDirectoryResponse struct {
+	state                  protoimpl.MessageState    `protogen:"open.v1"`
+	Format                 RoutingDirectoryFormat    `protobuf:"varint,1,opt,name=format,proto3,enum=treasury.RoutingDirectoryFormat" json:"format,omitempty"`
+	RecordCount            int32                     `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"` // Valid records in the file
+	InstitutionsCreated    int32                     `protobuf:"varint,3,opt,name=institutions_created,json=institutionsCreated,proto3" json:"institutions_created,omitempty"`
+	InstitutionsUpdated    int32                     `protobuf:"varint,4,opt,name=institutions_updated,json=institutionsUpdated,proto3" json:"institutions_updated,omitempty"`
+	RoutingNumbersAdded    int32                     `protobuf:"varint,5,opt,name=routing_numbers_added,json=routingNumbersAdded,proto3" json:"routing_numbers_added,omitempty"`
+	RoutingNumbersRemoved  int32                     `protobuf:"varint,6,opt,name=routing_numbers_removed,json=routingNumbersRemoved,proto3" json:"routing_numbers_removed,omitempty"` // Flagged as removed from the directory
+	RoutingNumbersRestored int32                     `protobuf:"varint,7,opt,name=routing_numbers_restored,json=routingNumbersRestored,proto3" json:"routing_numbers_restored,omitempty"`
+	UnchangedCount         int32                     `protobuf:"varint,8,opt,name=unchanged_count,json=unchangedCount,proto3" json:"unchanged_count,omitempty"` // Records that changed nothing
+	SkippedCount           int32                     `protobuf:"varint,9,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`       // Fedwire records not eligible for funds transfers
+	Errors                 []string                  `protobuf:"bytes,10,rep,name=errors,proto3" json:"errors,omitempty"`                                       // Rejected lines, e.g. "line 3: invalid routing number check digit"
+	Changes                []*RoutingDirectoryChange `protobuf:"bytes,11,rep,name=changes,proto3" json:"changes,omitempty"`
+	DryRun                 bool                      `protobuf:"varint,12,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // True when nothing was applied
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ImportRoutingDirectoryResponse) Reset() {
+	*x = ImportRoutingDirectoryResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DependencyHealth) String() string {
+func (x *ImportRoutingDirectoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DependencyHealth) ProtoMessage() {}
+func (*ImportRoutingDirectoryResponse) ProtoMessage() {}
 
-func (x *DependencyHealth) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[14]
+func (x *ImportRoutingDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,116 +5615,121 @@ func (x *DependencyHealth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DependencyHealth.ProtoReflect.Descriptor instead.
-func (*DependencyHealth) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ImportRoutingDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*ImportRoutingDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DependencyHealth) GetName() string {
+func (x *ImportRoutingDirectoryResponse) GetFormat() RoutingDirectoryFormat {
 	if x != nil {
-		return x.Name
+		return x.Format
 	}
-	return ""
+	return RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_UNSPECIFIED
 }
 
-func (x *DependencyHealth) GetType() DependencyType {
+func (x *ImportRoutingDirectoryResponse) GetRecordCount() int32 {
 	if x != nil {
-		return x.Type
+		return x.RecordCount
 	}
-	return DependencyType_DATABASE
+	return 0
 }
 
-func (x *DependencyHealth) GetStatus() ServiceStatus {
+func (x *ImportRoutingDirectoryResponse) GetInstitutionsCreated() int32 {
 	if x != nil {
-		return x.Status
+		return x.InstitutionsCreated
 	}
-	return ServiceStatus_HEALTHY
+	return 0
 }
 
-func (x *DependencyHealth) GetIsCritical() bool {
+func (x *ImportRoutingDirectoryResponse) GetInstitutionsUpdated() int32 {
 	if x != nil {
-		return x.IsCritical
+		return x.InstitutionsUpdated
 	}
-	return false
+	return 0
 }
 
-func (x *DependencyHealth) GetMessage() string {
+func (x *ImportRoutingDirectoryResponse) GetRoutingNumbersAdded() int32 {
 	if x != nil {
-		return x.Message
+		return x.RoutingNumbersAdded
 	}
-	return ""
+	return 0
 }
 
-func (x *DependencyHealth) GetConfig() *DependencyConfig {
+func (x *ImportRoutingDirectoryResponse) GetRoutingNumbersRemoved() int32 {
 	if x != nil {
-		return x.Config
+		return x.RoutingNumbersRemoved
 	}
-	return nil
+	return 0
 }
 
-func (x *DependencyHealth) GetLastSuccess() string {
+func (x *ImportRoutingDirectoryResponse) GetRoutingNumbersRestored() int32 {
 	if x != nil {
-		return x.LastSuccess
+		return x.RoutingNumbersRestored
 	}
-	return ""
+	return 0
 }
 
-func (x *DependencyHealth) GetLastCheck() string {
+func (x *ImportRoutingDirectoryResponse) GetUnchangedCount() int32 {
 	if x != nil {
-		return x.LastCheck
+		return x.UnchangedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *DependencyHealth) GetResponseTimeMs() int64 {
+func (x *ImportRoutingDirectoryResponse) GetSkippedCount() int32 {
 	if x != nil {
-		return x.ResponseTimeMs
+		return x.SkippedCount
 	}
 	return 0
 }
 
-func (x *DependencyHealth) GetError() string {
+func (x *ImportRoutingDirectoryResponse) GetErrors() []string {
 	if x != nil {
-		return x.Error
+		return x.Errors
 	}
-	return ""
+	return nil
 }
 
-type DependencyConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Connection information (non-sensitive)
-	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Port     int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Protocol string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"` // e.g., "grpc", "http", "postgres"
-	// Database/schema/topic information
-	DatabaseName string `protobuf:"bytes,4,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
-	SchemaName   string `protobuf:"bytes,5,opt,name=schema_name,json=schemaName,proto3" json:"schema_name,omitempty"`
-	TopicName    string `protobuf:"bytes,6,opt,name=topic_name,json=topicName,proto3" json:"topic_name,omitempty"`
-	// Connection pool information
-	PoolInfo *ConnectionPoolInfo `protobuf:"bytes,7,opt,name=pool_info,json=poolInfo,proto3" json:"pool_info,omitempty"`
-	// Service version if applicable
-	Version string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
-	// Additional metadata
-	Metadata      map[string]string `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *ImportRoutingDirectoryResponse) GetChanges() []*RoutingDirectoryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ImportRoutingDirectoryResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportBicDirectoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        BicDirectoryFormat     `protobuf:"varint,1,opt,name=format,proto3,enum=treasury.BicDirectoryFormat" json:"format,omitempty"` // Optional: detected when unspecified
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                                 // Required: File content
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                    // Report the changes without applying them
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`               // Optional: Name of the file, for logs
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DependencyConfig) Reset() {
-	*x = DependencyConfig{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[15]
+func (x *ImportBicDirectoryRequest) Reset() {
+	*x = ImportBicDirectoryRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DependencyConfig) String() string {
+func (x *ImportBicDirectoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DependencyConfig) ProtoMessage() {}
+func (*ImportBicDirectoryRequest) ProtoMessage() {}
 
-func (x *DependencyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[15]
+func (x *ImportBicDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,100 +5740,144 @@ func (x *DependencyConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DependencyConfig.ProtoReflect.Descriptor instead.
-func (*DependencyConfig) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ImportBicDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*ImportBicDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *DependencyConfig) GetHostname() string {
+func (x *ImportBicDirectoryRequest) GetFormat() BicDirectoryFormat {
 	if x != nil {
-		return x.Hostname
+		return x.Format
 	}
-	return ""
+	return BicDirectoryFormat_BIC_DIRECTORY_FORMAT_UNSPECIFIED
 }
 
-func (x *DependencyConfig) GetPort() int32 {
+func (x *ImportBicDirectoryRequest) GetContent() []byte {
 	if x != nil {
-		return x.Port
+		return x.Content
 	}
-	return 0
+	return nil
 }
 
-func (x *DependencyConfig) GetProtocol() string {
+func (x *ImportBicDirectoryRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Protocol
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *DependencyConfig) GetDatabaseName() string {
+func (x *ImportBicDirectoryRequest) GetFileName() string {
 	if x != nil {
-		return x.DatabaseName
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *DependencyConfig) GetSchemaName() string {
+func (x *ImportBicDirectoryRequest) GetUpdatedBy() string {
 	if x != nil {
-		return x.SchemaName
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *DependencyConfig) GetTopicName() string {
+type BicDirectoryChange struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            BicDirectoryChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=treasury.BicDirectoryChangeType" json:"type,omitempty"`
+	SwiftCode       string                 `protobuf:"bytes,2,opt,name=swift_code,json=swiftCode,proto3" json:"swift_code,omitempty"`
+	InstitutionCode string                 `protobuf:"bytes,3,opt,name=institution_code,json=institutionCode,proto3" json:"institution_code,omitempty"`
+	Detail          string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"` // e.g. "city FRANKFURT -> FRANKFURT AM MAIN"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BicDirectoryChange) Reset() {
+	*x = BicDirectoryChange{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BicDirectoryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BicDirectoryChange) ProtoMessage() {}
+
+func (x *BicDirectoryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[60]
 	if x != nil {
-		return x.TopicName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DependencyConfig) GetPoolInfo() *ConnectionPoolInfo {
+// Deprecated: Use BicDirectoryChange.ProtoReflect.Descriptor instead.
+func (*BicDirectoryChange) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BicDirectoryChange) GetType() BicDirectoryChangeType {
 	if x != nil {
-		return x.PoolInfo
+		return x.Type
 	}
-	return nil
+	return BicDirectoryChangeType_BIC_DIRECTORY_CHANGE_TYPE_UNSPECIFIED
 }
 
-func (x *DependencyConfig) GetVersion() string {
+func (x *BicDirectoryChange) GetSwiftCode() string {
 	if x != nil {
-		return x.Version
+		return x.SwiftCode
 	}
 	return ""
 }
 
-func (x *DependencyConfig) GetMetadata() map[string]string {
+func (x *BicDirectoryChange) GetInstitutionCode() string {
 	if x != nil {
-		return x.Metadata
+		return x.InstitutionCode
 	}
-	return nil
+	return ""
 }
 
-type ConnectionPoolInfo struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	MaxConnections    int32                  `protobuf:"varint,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
-	ActiveConnections int32                  `protobuf:"varint,2,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
-	IdleConnections   int32                  `protobuf:"varint,3,opt,name=idle_connections,json=idleConnections,proto3" json:"idle_connections,omitempty"`
-	WaitCount         int32                  `protobuf:"varint,4,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
-	WaitDurationMs    int64                  `protobuf:"varint,5,opt,name=wait_duration_ms,json=waitDurationMs,proto3" json:"wait_duration_ms,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *BicDirectoryChange) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
 }
 
-func (x *ConnectionPoolInfo) Reset() {
-	*x = ConnectionPoolInfo{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[16]
+type ImportBicDirectoryResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Format              BicDirectoryFormat     `protobuf:"varint,1,opt,name=format,proto3,enum=treasury.BicDirectoryFormat" json:"format,omitempty"`
+	RecordCount         int32                  `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"` // Valid records in the file
+	InstitutionsCreated int32                  `protobuf:"varint,3,opt,name=institutions_created,json=institutionsCreated,proto3" json:"institutions_created,omitempty"`
+	InstitutionsUpdated int32                  `protobuf:"varint,4,opt,name=institutions_updated,json=institutionsUpdated,proto3" json:"institutions_updated,omitempty"`
+	UnchangedCount      int32                  `protobuf:"varint,5,opt,name=unchanged_count,json=unchangedCount,proto3" json:"unchanged_count,omitempty"` // Head offices that changed nothing
+	BranchCount         int32                  `protobuf:"varint,6,opt,name=branch_count,json=branchCount,proto3" json:"branch_count,omitempty"`          // Branch BICs resolved to their head office
+	Errors              []string               `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`                                        // Rejected lines, e.g. "line 3: invalid BIC ..."
+	Changes             []*BicDirectoryChange  `protobuf:"bytes,8,rep,name=changes,proto3" json:"changes,omitempty"`
+	DryRun              bool                   `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // True when nothing was applied
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ImportBicDirectoryResponse) Reset() {
+	*x = ImportBicDirectoryResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectionPoolInfo) String() string {
+func (x *ImportBicDirectoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionPoolInfo) ProtoMessage() {}
+func (*ImportBicDirectoryResponse) ProtoMessage() {}
 
-func (x *ConnectionPoolInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[16]
+func (x *ImportBicDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1529,86 +5888,108 @@ func (x *ConnectionPoolInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionPoolInfo.ProtoReflect.Descriptor instead.
-func (*ConnectionPoolInfo) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ImportBicDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*ImportBicDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ConnectionPoolInfo) GetMaxConnections() int32 {
+func (x *ImportBicDirectoryResponse) GetFormat() BicDirectoryFormat {
 	if x != nil {
-		return x.MaxConnections
+		return x.Format
+	}
+	return BicDirectoryFormat_BIC_DIRECTORY_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportBicDirectoryResponse) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
 	}
 	return 0
 }
 
-func (x *ConnectionPoolInfo) GetActiveConnections() int32 {
+func (x *ImportBicDirectoryResponse) GetInstitutionsCreated() int32 {
 	if x != nil {
-		return x.ActiveConnections
+		return x.InstitutionsCreated
 	}
 	return 0
 }
 
-func (x *ConnectionPoolInfo) GetIdleConnections() int32 {
+func (x *ImportBicDirectoryResponse) GetInstitutionsUpdated() int32 {
 	if x != nil {
-		return x.IdleConnections
+		return x.InstitutionsUpdated
 	}
 	return 0
 }
 
-func (x *ConnectionPoolInfo) GetWaitCount() int32 {
+func (x *ImportBicDirectoryResponse) GetUnchangedCount() int32 {
 	if x != nil {
-		return x.WaitCount
+		return x.UnchangedCount
 	}
 	return 0
 }
 
-func (x *ConnectionPoolInfo) GetWaitDurationMs() int64 {
+func (x *ImportBicDirectoryResponse) GetBranchCount() int32 {
 	if x != nil {
-		return x.WaitDurationMs
+		return x.BranchCount
 	}
 	return 0
 }
 
-// Currency represents an ISO 4217 compliant currency
-type Currency struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // UUID
-	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                                           // ISO 4217 code (USD, EUR)
-	NumericCode    string                 `protobuf:"bytes,3,opt,name=numeric_code,json=numericCode,proto3" json:"numeric_code,omitempty"`          // ISO 4217 numeric code
-	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                           // Official name
-	MinorUnits     int32                  `protobuf:"varint,5,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`            // Decimal places
-	Symbol         string                 `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`                                       // Currency symbol
-	SymbolPosition string                 `protobuf:"bytes,7,opt,name=symbol_position,json=symbolPosition,proto3" json:"symbol_position,omitempty"` // before/after
-	CountryCodes   []string               `protobuf:"bytes,8,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`       // ISO 3166 codes
-	IsActive       bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                  // Active status
-	IsCrypto       bool                   `protobuf:"varint,10,opt,name=is_crypto,json=isCrypto,proto3" json:"is_crypto,omitempty"`                 // Cryptocurrency flag
-	Status         CurrencyStatus         `protobuf:"varint,11,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"`        // Current status
-	ActivatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
-	DeactivatedAt  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy      string                 `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy      string                 `protobuf:"bytes,17,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	Version        int32                  `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"` // Optimistic locking
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ImportBicDirectoryResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportBicDirectoryResponse) GetChanges() []*BicDirectoryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ImportBicDirectoryResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ExchangeRate is a stored rate: 1 base_currency = rate quote_currency
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                            // UUID
+	BaseCurrency  string                 `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`    // ISO 4217 code
+	QuoteCurrency string                 `protobuf:"bytes,3,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"` // ISO 4217 code
+	Rate          string                 `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`                                        // Exact decimal string, up to 12 decimal places
+	RateType      RateType               `protobuf:"varint,5,opt,name=rate_type,json=rateType,proto3,enum=treasury.RateType" json:"rate_type,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"` // Rate provider (ECB, FED)
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Currency) Reset() {
-	*x = Currency{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[17]
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Currency) String() string {
+func (x *ExchangeRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Currency) ProtoMessage() {}
+func (*ExchangeRate) ProtoMessage() {}
 
-func (x *Currency) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[17]
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,166 +6000,122 @@ func (x *Currency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
-func (*Currency) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *Currency) GetId() string {
+func (x *ExchangeRate) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Currency) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *Currency) GetNumericCode() string {
-	if x != nil {
-		return x.NumericCode
-	}
-	return ""
-}
-
-func (x *Currency) GetName() string {
+func (x *ExchangeRate) GetBaseCurrency() string {
 	if x != nil {
-		return x.Name
+		return x.BaseCurrency
 	}
 	return ""
 }
 
-func (x *Currency) GetMinorUnits() int32 {
-	if x != nil {
-		return x.MinorUnits
-	}
-	return 0
-}
-
-func (x *Currency) GetSymbol() string {
+func (x *ExchangeRate) GetQuoteCurrency() string {
 	if x != nil {
-		return x.Symbol
+		return x.QuoteCurrency
 	}
 	return ""
 }
 
-func (x *Currency) GetSymbolPosition() string {
+func (x *ExchangeRate) GetRate() string {
 	if x != nil {
-		return x.SymbolPosition
+		return x.Rate
 	}
 	return ""
 }
 
-func (x *Currency) GetCountryCodes() []string {
-	if x != nil {
-		return x.CountryCodes
-	}
-	return nil
-}
-
-func (x *Currency) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
-}
-
-func (x *Currency) GetIsCrypto() bool {
-	if x != nil {
-		return x.IsCrypto
-	}
-	return false
-}
-
-func (x *Currency) GetStatus() CurrencyStatus {
+func (x *ExchangeRate) GetRateType() RateType {
 	if x != nil {
-		return x.Status
+		return x.RateType
 	}
-	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+	return RateType_RATE_TYPE_UNSPECIFIED
 }
 
-func (x *Currency) GetActivatedAt() *timestamppb.Timestamp {
+func (x *ExchangeRate) GetEffectiveAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ActivatedAt
+		return x.EffectiveAt
 	}
 	return nil
 }
 
-func (x *Currency) GetDeactivatedAt() *timestamppb.Timestamp {
+func (x *ExchangeRate) GetSource() string {
 	if x != nil {
-		return x.DeactivatedAt
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *Currency) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ExchangeRate) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Currency) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *Currency) GetCreatedBy() string {
+func (x *ExchangeRate) GetCreatedBy() string {
 	if x != nil {
 		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *Currency) GetUpdatedBy() string {
+func (x *ExchangeRate) GetUpdatedBy() string {
 	if x != nil {
 		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *Currency) GetVersion() int32 {
+func (x *ExchangeRate) GetVersion() int32 {
 	if x != nil {
 		return x.Version
 	}
 	return 0
 }
 
-// Request/Response messages
-type CreateCurrencyRequest struct {
+type ExchangeRateInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                  // Required
-	NumericCode   string                 `protobuf:"bytes,2,opt,name=numeric_code,json=numericCode,proto3" json:"numeric_code,omitempty"` // Optional
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                  // Required
-	MinorUnits    int32                  `protobuf:"varint,4,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`   // Default 2
-	Symbol        string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	CountryCodes  []string               `protobuf:"bytes,6,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`
-	IsCrypto      bool                   `protobuf:"varint,7,opt,name=is_crypto,json=isCrypto,proto3" json:"is_crypto,omitempty"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`             // Required
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`          // Required
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`                                                 // Required, positive
+	RateType      RateType               `protobuf:"varint,4,opt,name=rate_type,json=rateType,proto3,enum=treasury.RateType" json:"rate_type,omitempty"` // Required
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`                // Required
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`                                             // Required
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCurrencyRequest) Reset() {
-	*x = CreateCurrencyRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[18]
+func (x *ExchangeRateInput) Reset() {
+	*x = ExchangeRateInput{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCurrencyRequest) String() string {
+func (x *ExchangeRateInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCurrencyRequest) ProtoMessage() {}
+func (*ExchangeRateInput) ProtoMessage() {}
 
-func (x *CreateCurrencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[18]
+func (x *ExchangeRateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,82 +6126,129 @@ func (x *CreateCurrencyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCurrencyRequest.ProtoReflect.Descriptor instead.
-func (*CreateCurrencyRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ExchangeRateInput.ProtoReflect.Descriptor instead.
+func (*ExchangeRateInput) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *CreateCurrencyRequest) GetCode() string {
+func (x *ExchangeRateInput) GetBaseCurrency() string {
 	if x != nil {
-		return x.Code
+		return x.BaseCurrency
 	}
 	return ""
 }
 
-func (x *CreateCurrencyRequest) GetNumericCode() string {
+func (x *ExchangeRateInput) GetQuoteCurrency() string {
 	if x != nil {
-		return x.NumericCode
+		return x.QuoteCurrency
 	}
 	return ""
 }
 
-func (x *CreateCurrencyRequest) GetName() string {
+func (x *ExchangeRateInput) GetRate() string {
 	if x != nil {
-		return x.Name
+		return x.Rate
 	}
 	return ""
 }
 
-func (x *CreateCurrencyRequest) GetMinorUnits() int32 {
+func (x *ExchangeRateInput) GetRateType() RateType {
 	if x != nil {
-		return x.MinorUnits
+		return x.RateType
 	}
-	return 0
+	return RateType_RATE_TYPE_UNSPECIFIED
 }
 
-func (x *CreateCurrencyRequest) GetSymbol() string {
+func (x *ExchangeRateInput) GetEffectiveAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Symbol
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *ExchangeRateInput) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *CreateCurrencyRequest) GetCountryCodes() []string {
+type UpsertRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRateInput   `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`                          // Written in one transaction
+	UpdatedBy     string                 `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"` // User making the change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertRatesRequest) Reset() {
+	*x = UpsertRatesRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertRatesRequest) ProtoMessage() {}
+
+func (x *UpsertRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[64]
 	if x != nil {
-		return x.CountryCodes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertRatesRequest.ProtoReflect.Descriptor instead.
+func (*UpsertRatesRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpsertRatesRequest) GetRates() []*ExchangeRateInput {
+	if x != nil {
+		return x.Rates
 	}
 	return nil
 }
 
-func (x *CreateCurrencyRequest) GetIsCrypto() bool {
+func (x *UpsertRatesRequest) GetUpdatedBy() string {
 	if x != nil {
-		return x.IsCrypto
+		return x.UpdatedBy
 	}
-	return false
+	return ""
 }
 
-type CreateCurrencyResponse struct {
+type UpsertRatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates,omitempty"` // Stored rates in request order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCurrencyResponse) Reset() {
-	*x = CreateCurrencyResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[19]
+func (x *UpsertRatesResponse) Reset() {
+	*x = UpsertRatesResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCurrencyResponse) String() string {
+func (x *UpsertRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCurrencyResponse) ProtoMessage() {}
+func (*UpsertRatesResponse) ProtoMessage() {}
 
-func (x *CreateCurrencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[19]
+func (x *UpsertRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,45 +6259,58 @@ func (x *CreateCurrencyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCurrencyResponse.ProtoReflect.Descriptor instead.
-func (*CreateCurrencyResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use UpsertRatesResponse.ProtoReflect.Descriptor instead.
+func (*UpsertRatesResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CreateCurrencyResponse) GetCurrency() *Currency {
+func (x *UpsertRatesResponse) GetCreatedCount() int32 {
 	if x != nil {
-		return x.Currency
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *UpsertRatesResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *UpsertRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
 	}
 	return nil
 }
 
-type GetCurrencyRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Identifier:
-	//
-	//	*GetCurrencyRequest_Code
-	//	*GetCurrencyRequest_NumericCode
-	//	*GetCurrencyRequest_Id
-	Identifier    isGetCurrencyRequest_Identifier `protobuf_oneof:"identifier"`
+type GetRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`             // Required
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`          // Required
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`                                     // Latest rate effective at or before, defaults to now
+	RateType      RateType               `protobuf:"varint,4,opt,name=rate_type,json=rateType,proto3,enum=treasury.RateType" json:"rate_type,omitempty"` // Defaults to spot
+	Source        string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                                             // Optional: only rates from this source
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyRequest) Reset() {
-	*x = GetCurrencyRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[20]
+func (x *GetRateRequest) Reset() {
+	*x = GetRateRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyRequest) String() string {
+func (x *GetRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyRequest) ProtoMessage() {}
+func (*GetRateRequest) ProtoMessage() {}
 
-func (x *GetCurrencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[20]
+func (x *GetRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,140 +6321,176 @@ func (x *GetCurrencyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrencyRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetRateRequest.ProtoReflect.Descriptor instead.
+func (*GetRateRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetCurrencyRequest) GetIdentifier() isGetCurrencyRequest_Identifier {
+func (x *GetRateRequest) GetBaseCurrency() string {
 	if x != nil {
-		return x.Identifier
+		return x.BaseCurrency
 	}
-	return nil
+	return ""
 }
 
-func (x *GetCurrencyRequest) GetCode() string {
+func (x *GetRateRequest) GetQuoteCurrency() string {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetCurrencyRequest_Code); ok {
-			return x.Code
-		}
+		return x.QuoteCurrency
 	}
 	return ""
 }
 
-func (x *GetCurrencyRequest) GetNumericCode() string {
+func (x *GetRateRequest) GetAsOf() *timestamppb.Timestamp {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetCurrencyRequest_NumericCode); ok {
-			return x.NumericCode
-		}
+		return x.AsOf
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCurrencyRequest) GetId() string {
+func (x *GetRateRequest) GetRateType() RateType {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetCurrencyRequest_Id); ok {
-			return x.Id
-		}
+		return x.RateType
 	}
-	return ""
+	return RateType_RATE_TYPE_UNSPECIFIED
 }
 
-type isGetCurrencyRequest_Identifier interface {
-	isGetCurrencyRequest_Identifier()
+func (x *GetRateRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
 }
 
-type GetCurrencyRequest_Code struct {
-	Code string `protobuf:"bytes,1,opt,name=code,proto3,oneof"` // Primary lookup
+type GetRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"` // Rounded to 12 decimal places when derived
+	RateType      RateType               `protobuf:"varint,4,opt,name=rate_type,json=rateType,proto3,enum=treasury.RateType" json:"rate_type,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"` // Earliest effective_at of the legs used
+	Derivation    RateDerivation         `protobuf:"varint,6,opt,name=derivation,proto3,enum=treasury.RateDerivation" json:"derivation,omitempty"`
+	PivotCurrency string                 `protobuf:"bytes,7,opt,name=pivot_currency,json=pivotCurrency,proto3" json:"pivot_currency,omitempty"` // Set when triangulated
+	Legs          []*ExchangeRate        `protobuf:"bytes,8,rep,name=legs,proto3" json:"legs,omitempty"`                                        // Stored rates the result was derived from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type GetCurrencyRequest_NumericCode struct {
-	NumericCode string `protobuf:"bytes,2,opt,name=numeric_code,json=numericCode,proto3,oneof"` // Alternative lookup
+func (x *GetRateResponse) Reset() {
+	*x = GetRateResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GetCurrencyRequest_Id struct {
-	Id string `protobuf:"bytes,3,opt,name=id,proto3,oneof"` // UUID lookup
+func (x *GetRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyRequest_Code) isGetCurrencyRequest_Identifier() {}
+func (*GetRateResponse) ProtoMessage() {}
 
-func (*GetCurrencyRequest_NumericCode) isGetCurrencyRequest_Identifier() {}
+func (x *GetRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*GetCurrencyRequest_Id) isGetCurrencyRequest_Identifier() {}
+// Deprecated: Use GetRateResponse.ProtoReflect.Descriptor instead.
+func (*GetRateResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{67}
+}
 
-type GetCurrencyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetRateResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
 }
 
-func (x *GetCurrencyResponse) Reset() {
-	*x = GetCurrencyResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetRateResponse) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *GetRateResponse) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
 }
 
-func (x *GetCurrencyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetRateResponse) GetRateType() RateType {
+	if x != nil {
+		return x.RateType
+	}
+	return RateType_RATE_TYPE_UNSPECIFIED
 }
 
-func (*GetCurrencyResponse) ProtoMessage() {}
+func (x *GetRateResponse) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
 
-func (x *GetCurrencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[21]
+func (x *GetRateResponse) GetDerivation() RateDerivation {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Derivation
 	}
-	return mi.MessageOf(x)
+	return RateDerivation_RATE_DERIVATION_UNSPECIFIED
 }
 
-// Deprecated: Use GetCurrencyResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrencyResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{21}
+func (x *GetRateResponse) GetPivotCurrency() string {
+	if x != nil {
+		return x.PivotCurrency
+	}
+	return ""
 }
 
-func (x *GetCurrencyResponse) GetCurrency() *Currency {
+func (x *GetRateResponse) GetLegs() []*ExchangeRate {
 	if x != nil {
-		return x.Currency
+		return x.Legs
 	}
 	return nil
 }
 
-type UpdateCurrencyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                               // Required (identifies currency)
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields to update
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	MinorUnits    int32                  `protobuf:"varint,4,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units,omitempty"`
-	Symbol        string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	CountryCodes  []string               `protobuf:"bytes,6,rep,name=country_codes,json=countryCodes,proto3" json:"country_codes,omitempty"`
-	Status        CurrencyStatus         `protobuf:"varint,7,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"`
-	Version       int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"` // For optimistic locking
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListRatesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency   string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`             // Filter by base currency
+	QuoteCurrency  string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`          // Filter by quote currency
+	RateType       RateType               `protobuf:"varint,3,opt,name=rate_type,json=rateType,proto3,enum=treasury.RateType" json:"rate_type,omitempty"` // Filter by rate type
+	Source         string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`                                             // Filter by source
+	EffectiveFrom  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`          // Rates effective at or after
+	EffectiveTo    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`                // Rates effective before
+	PageSize       int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                        // Pagination
+	PageToken      string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                      // Pagination token
+	SkipTotalCount bool                   `protobuf:"varint,9,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"`    // Leave total_count unset to skip counting matches
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateCurrencyRequest) Reset() {
-	*x = UpdateCurrencyRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[22]
+func (x *ListRatesRequest) Reset() {
+	*x = ListRatesRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCurrencyRequest) String() string {
+func (x *ListRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCurrencyRequest) ProtoMessage() {}
+func (*ListRatesRequest) ProtoMessage() {}
 
-func (x *UpdateCurrencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[22]
+func (x *ListRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2068,89 +6501,98 @@ func (x *UpdateCurrencyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCurrencyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCurrencyRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListRatesRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UpdateCurrencyRequest) GetCode() string {
+func (x *ListRatesRequest) GetBaseCurrency() string {
 	if x != nil {
-		return x.Code
+		return x.BaseCurrency
 	}
 	return ""
 }
 
-func (x *UpdateCurrencyRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *ListRatesRequest) GetQuoteCurrency() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.QuoteCurrency
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateCurrencyRequest) GetName() string {
+func (x *ListRatesRequest) GetRateType() RateType {
 	if x != nil {
-		return x.Name
+		return x.RateType
 	}
-	return ""
+	return RateType_RATE_TYPE_UNSPECIFIED
 }
 
-func (x *UpdateCurrencyRequest) GetMinorUnits() int32 {
+func (x *ListRatesRequest) GetSource() string {
 	if x != nil {
-		return x.MinorUnits
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateCurrencyRequest) GetSymbol() string {
+func (x *ListRatesRequest) GetEffectiveFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Symbol
+		return x.EffectiveFrom
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateCurrencyRequest) GetCountryCodes() []string {
+func (x *ListRatesRequest) GetEffectiveTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CountryCodes
+		return x.EffectiveTo
 	}
 	return nil
 }
 
-func (x *UpdateCurrencyRequest) GetStatus() CurrencyStatus {
+func (x *ListRatesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Status
+		return x.PageSize
 	}
-	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateCurrencyRequest) GetVersion() int32 {
+func (x *ListRatesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Version
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
-type UpdateCurrencyResponse struct {
+func (x *ListRatesRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+type ListRatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currency      *Currency              `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"` // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCurrencyResponse) Reset() {
-	*x = UpdateCurrencyResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[23]
+func (x *ListRatesResponse) Reset() {
+	*x = ListRatesResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCurrencyResponse) String() string {
+func (x *ListRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCurrencyResponse) ProtoMessage() {}
+func (*ListRatesResponse) ProtoMessage() {}
 
-func (x *UpdateCurrencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[23]
+func (x *ListRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2161,43 +6603,59 @@ func (x *UpdateCurrencyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCurrencyResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCurrencyResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListRatesResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateCurrencyResponse) GetCurrency() *Currency {
+func (x *ListRatesResponse) GetRates() []*ExchangeRate {
 	if x != nil {
-		return x.Currency
+		return x.Rates
 	}
 	return nil
 }
 
-type DeactivateCurrencyRequest struct {
+func (x *ListRatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListRatesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ImportRatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                   // Required
-	Status        CurrencyStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"` // New status (inactive/deprecated/deleted)
-	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`        // User making the change
-	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`                            // For optimistic locking
+	Format        RateFileFormat         `protobuf:"varint,1,opt,name=format,proto3,enum=treasury.RateFileFormat" json:"format,omitempty"`               // Required
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                                           // Required: File content
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`                                             // Required: Rate provider recorded on every rate (ECB, bank name)
+	RateType      RateType               `protobuf:"varint,4,opt,name=rate_type,json=rateType,proto3,enum=treasury.RateType" json:"rate_type,omitempty"` // Defaults to spot
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`                         // Optional: Name of the file, for logs
+	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateCurrencyRequest) Reset() {
-	*x = DeactivateCurrencyRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[24]
+func (x *ImportRatesRequest) Reset() {
+	*x = ImportRatesRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateCurrencyRequest) String() string {
+func (x *ImportRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateCurrencyRequest) ProtoMessage() {}
+func (*ImportRatesRequest) ProtoMessage() {}
 
-func (x *DeactivateCurrencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[24]
+func (x *ImportRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2208,62 +6666,79 @@ func (x *DeactivateCurrencyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateCurrencyRequest.ProtoReflect.Descriptor instead.
-func (*DeactivateCurrencyRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ImportRatesRequest.ProtoReflect.Descriptor instead.
+func (*ImportRatesRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeactivateCurrencyRequest) GetCode() string {
+func (x *ImportRatesRequest) GetFormat() RateFileFormat {
 	if x != nil {
-		return x.Code
+		return x.Format
+	}
+	return RateFileFormat_RATE_FILE_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportRatesRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportRatesRequest) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *DeactivateCurrencyRequest) GetStatus() CurrencyStatus {
+func (x *ImportRatesRequest) GetRateType() RateType {
 	if x != nil {
-		return x.Status
+		return x.RateType
 	}
-	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+	return RateType_RATE_TYPE_UNSPECIFIED
 }
 
-func (x *DeactivateCurrencyRequest) GetUpdatedBy() string {
+func (x *ImportRatesRequest) GetFileName() string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *DeactivateCurrencyRequest) GetVersion() int32 {
+func (x *ImportRatesRequest) GetUpdatedBy() string {
 	if x != nil {
-		return x.Version
+		return x.UpdatedBy
 	}
-	return 0
+	return ""
 }
 
-type DeactivateCurrencyResponse struct {
+type ImportRatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Currency      *Currency              `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // Updated currency
+	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"` // Rates not stored before
+	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"` // Stored rates whose value changed
+	SkippedCount  int32                  `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"` // Rates already stored with the same value
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`                                  // Rejected rows, e.g. "line 3: currency XAU is not active"
+	Dates         []string               `protobuf:"bytes,5,rep,name=dates,proto3" json:"dates,omitempty"`                                    // Distinct dates in the file (YYYY-MM-DD)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateCurrencyResponse) Reset() {
-	*x = DeactivateCurrencyResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[25]
+func (x *ImportRatesResponse) Reset() {
+	*x = ImportRatesResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateCurrencyResponse) String() string {
+func (x *ImportRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateCurrencyResponse) ProtoMessage() {}
+func (*ImportRatesResponse) ProtoMessage() {}
 
-func (x *DeactivateCurrencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[25]
+func (x *ImportRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,52 +6749,86 @@ func (x *DeactivateCurrencyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateCurrencyResponse.ProtoReflect.Descriptor instead.
-func (*DeactivateCurrencyResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ImportRatesResponse.ProtoReflect.Descriptor instead.
+func (*ImportRatesResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *DeactivateCurrencyResponse) GetSuccess() bool {
+func (x *ImportRatesResponse) GetCreatedCount() int32 {
 	if x != nil {
-		return x.Success
+		return x.CreatedCount
 	}
-	return false
+	return 0
 }
 
-func (x *DeactivateCurrencyResponse) GetCurrency() *Currency {
+func (x *ImportRatesResponse) GetUpdatedCount() int32 {
 	if x != nil {
-		return x.Currency
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ImportRatesResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportRatesResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
 	}
 	return nil
 }
 
-type ListCurrenciesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        CurrencyStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=treasury.CurrencyStatus" json:"status,omitempty"` // Filter by status
-	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`          // Filter by active flag
-	IsCrypto      bool                   `protobuf:"varint,3,opt,name=is_crypto,json=isCrypto,proto3" json:"is_crypto,omitempty"`          // Filter cryptocurrencies
-	CountryCode   string                 `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`  // Filter by country
-	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`          // Pagination
-	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`        // Pagination token
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportRatesResponse) GetDates() []string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
 }
 
-func (x *ListCurrenciesRequest) Reset() {
-	*x = ListCurrenciesRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[26]
+// BankAccount is an account the company holds at a financial institution
+type BankAccount struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // UUID
+	InstitutionCode         string                 `protobuf:"bytes,2,opt,name=institution_code,json=institutionCode,proto3" json:"institution_code,omitempty"` // FinancialInstitution.code
+	AccountName             string                 `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountNumber           string                 `protobuf:"bytes,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"` // Masked, e.g. "****6789"
+	Iban                    string                 `protobuf:"bytes,5,opt,name=iban,proto3" json:"iban,omitempty"`                                        // Masked, e.g. "GB29**************6819"
+	CurrencyCode            string                 `protobuf:"bytes,6,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`    // ISO 4217 code from treasury.currencies
+	Purpose                 BankAccountPurpose     `protobuf:"varint,7,opt,name=purpose,proto3,enum=treasury.BankAccountPurpose" json:"purpose,omitempty"`
+	Signatories             []*Signatory           `protobuf:"bytes,8,rep,name=signatories,proto3" json:"signatories,omitempty"`
+	LedgerAccountExternalId string                 `protobuf:"bytes,9,opt,name=ledger_account_external_id,json=ledgerAccountExternalId,proto3" json:"ledger_account_external_id,omitempty"` // external_id of the ledger cash account
+	Status                  BankAccountStatus      `protobuf:"varint,10,opt,name=status,proto3,enum=treasury.BankAccountStatus" json:"status,omitempty"`
+	OpenedAt                *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosedAt                *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	CloseReason             string                 `protobuf:"bytes,13,opt,name=close_reason,json=closeReason,proto3" json:"close_reason,omitempty"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy               string                 `protobuf:"bytes,16,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy               string                 `protobuf:"bytes,17,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version                 int32                  `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"` // Optimistic locking
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *BankAccount) Reset() {
+	*x = BankAccount{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCurrenciesRequest) String() string {
+func (x *BankAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCurrenciesRequest) ProtoMessage() {}
+func (*BankAccount) ProtoMessage() {}
 
-func (x *ListCurrenciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[26]
+func (x *BankAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2330,77 +6839,162 @@ func (x *ListCurrenciesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCurrenciesRequest.ProtoReflect.Descriptor instead.
-func (*ListCurrenciesRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use BankAccount.ProtoReflect.Descriptor instead.
+func (*BankAccount) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BankAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BankAccount) GetInstitutionCode() string {
+	if x != nil {
+		return x.InstitutionCode
+	}
+	return ""
+}
+
+func (x *BankAccount) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *BankAccount) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *BankAccount) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+func (x *BankAccount) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *BankAccount) GetPurpose() BankAccountPurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED
+}
+
+func (x *BankAccount) GetSignatories() []*Signatory {
+	if x != nil {
+		return x.Signatories
+	}
+	return nil
+}
+
+func (x *BankAccount) GetLedgerAccountExternalId() string {
+	if x != nil {
+		return x.LedgerAccountExternalId
+	}
+	return ""
+}
+
+func (x *BankAccount) GetStatus() BankAccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BankAccountStatus_BANK_ACCOUNT_STATUS_UNSPECIFIED
+}
+
+func (x *BankAccount) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *BankAccount) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
 }
 
-func (x *ListCurrenciesRequest) GetStatus() CurrencyStatus {
+func (x *BankAccount) GetCloseReason() string {
 	if x != nil {
-		return x.Status
+		return x.CloseReason
 	}
-	return CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *ListCurrenciesRequest) GetIsActive() bool {
+func (x *BankAccount) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsActive
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *ListCurrenciesRequest) GetIsCrypto() bool {
+func (x *BankAccount) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsCrypto
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *ListCurrenciesRequest) GetCountryCode() string {
+func (x *BankAccount) GetCreatedBy() string {
 	if x != nil {
-		return x.CountryCode
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *ListCurrenciesRequest) GetPageSize() int32 {
+func (x *BankAccount) GetUpdatedBy() string {
 	if x != nil {
-		return x.PageSize
+		return x.UpdatedBy
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCurrenciesRequest) GetPageToken() string {
+func (x *BankAccount) GetVersion() int32 {
 	if x != nil {
-		return x.PageToken
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type ListCurrenciesResponse struct {
+// Signatory is a person authorised to sign for a bank account
+type Signatory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currencies    []*Currency            `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // Required
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"` // Required
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"` // e.g. "CFO"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCurrenciesResponse) Reset() {
-	*x = ListCurrenciesResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[27]
+func (x *Signatory) Reset() {
+	*x = Signatory{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCurrenciesResponse) String() string {
+func (x *Signatory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCurrenciesResponse) ProtoMessage() {}
+func (*Signatory) ProtoMessage() {}
 
-func (x *ListCurrenciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[27]
+func (x *Signatory) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2411,56 +7005,63 @@ func (x *ListCurrenciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCurrenciesResponse.ProtoReflect.Descriptor instead.
-func (*ListCurrenciesResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use Signatory.ProtoReflect.Descriptor instead.
+func (*Signatory) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ListCurrenciesResponse) GetCurrencies() []*Currency {
+func (x *Signatory) GetName() string {
 	if x != nil {
-		return x.Currencies
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCurrenciesResponse) GetNextPageToken() string {
+func (x *Signatory) GetEmail() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Email
 	}
 	return ""
 }
 
-func (x *ListCurrenciesResponse) GetTotalCount() int32 {
+func (x *Signatory) GetTitle() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Title
 	}
-	return 0
-}
-
-type BulkCreateCurrenciesRequest struct {
-	state          protoimpl.MessageState   `protogen:"open.v1"`
-	Currencies     []*CreateCurrencyRequest `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
-	SkipDuplicates bool                     `protobuf:"varint,2,opt,name=skip_duplicates,json=skipDuplicates,proto3" json:"skip_duplicates,omitempty"` // Skip existing currencies
-	UpdateExisting bool                     `protobuf:"varint,3,opt,name=update_existing,json=updateExisting,proto3" json:"update_existing,omitempty"` // Update if exists
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	return ""
 }
 
-func (x *BulkCreateCurrenciesRequest) Reset() {
-	*x = BulkCreateCurrenciesRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[28]
+type CreateBankAccountRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	InstitutionCode         string                 `protobuf:"bytes,1,opt,name=institution_code,json=institutionCode,proto3" json:"institution_code,omitempty"` // Required, active institution
+	AccountName             string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`             // Required
+	AccountNumber           string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`       // Required, 4-34 letters and digits
+	Iban                    string                 `protobuf:"bytes,4,opt,name=iban,proto3" json:"iban,omitempty"`                                              // Optional
+	CurrencyCode            string                 `protobuf:"bytes,5,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`          // Required, active currency
+	Purpose                 BankAccountPurpose     `protobuf:"varint,6,opt,name=purpose,proto3,enum=treasury.BankAccountPurpose" json:"purpose,omitempty"`      // Required
+	Signatories             []*Signatory           `protobuf:"bytes,7,rep,name=signatories,proto3" json:"signatories,omitempty"`
+	LedgerAccountExternalId string                 `protobuf:"bytes,8,opt,name=ledger_account_external_id,json=ledgerAccountExternalId,proto3" json:"ledger_account_external_id,omitempty"` // Required
+	OpenedAt                *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`                                                  // Default now
+	CreatedBy               string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreateBankAccountRequest) Reset() {
+	*x = CreateBankAccountRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateCurrenciesRequest) String() string {
+func (x *CreateBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateCurrenciesRequest) ProtoMessage() {}
+func (*CreateBankAccountRequest) ProtoMessage() {}
 
-func (x *BulkCreateCurrenciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[28]
+func (x *CreateBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,57 +7072,103 @@ func (x *BulkCreateCurrenciesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateCurrenciesRequest.ProtoReflect.Descriptor instead.
-func (*BulkCreateCurrenciesRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CreateBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *BulkCreateCurrenciesRequest) GetCurrencies() []*CreateCurrencyRequest {
+func (x *CreateBankAccountRequest) GetInstitutionCode() string {
 	if x != nil {
-		return x.Currencies
+		return x.InstitutionCode
+	}
+	return ""
+}
+
+func (x *CreateBankAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *CreateBankAccountRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CreateBankAccountRequest) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+func (x *CreateBankAccountRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreateBankAccountRequest) GetPurpose() BankAccountPurpose {
+	if x != nil {
+		return x.Purpose
+	}
+	return BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED
+}
+
+func (x *CreateBankAccountRequest) GetSignatories() []*Signatory {
+	if x != nil {
+		return x.Signatories
 	}
 	return nil
 }
 
-func (x *BulkCreateCurrenciesRequest) GetSkipDuplicates() bool {
+func (x *CreateBankAccountRequest) GetLedgerAccountExternalId() string {
 	if x != nil {
-		return x.SkipDuplicates
+		return x.LedgerAccountExternalId
 	}
-	return false
+	return ""
 }
 
-func (x *BulkCreateCurrenciesRequest) GetUpdateExisting() bool {
+func (x *CreateBankAccountRequest) GetOpenedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateExisting
+		return x.OpenedAt
 	}
-	return false
+	return nil
 }
 
-type BulkCreateCurrenciesResponse struct {
+func (x *CreateBankAccountRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateBankAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
-	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
-	SkippedCount  int32                  `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
-	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	BankAccount   *BankAccount           `protobuf:"bytes,1,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkCreateCurrenciesResponse) Reset() {
-	*x = BulkCreateCurrenciesResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[29]
+func (x *CreateBankAccountResponse) Reset() {
+	*x = CreateBankAccountResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateCurrenciesResponse) String() string {
+func (x *CreateBankAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateCurrenciesResponse) ProtoMessage() {}
+func (*CreateBankAccountResponse) ProtoMessage() {}
 
-func (x *BulkCreateCurrenciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[29]
+func (x *CreateBankAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,68 +7179,44 @@ func (x *BulkCreateCurrenciesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateCurrenciesResponse.ProtoReflect.Descriptor instead.
-func (*BulkCreateCurrenciesResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *BulkCreateCurrenciesResponse) GetCreatedCount() int32 {
-	if x != nil {
-		return x.CreatedCount
-	}
-	return 0
-}
-
-func (x *BulkCreateCurrenciesResponse) GetUpdatedCount() int32 {
-	if x != nil {
-		return x.UpdatedCount
-	}
-	return 0
-}
-
-func (x *BulkCreateCurrenciesResponse) GetSkippedCount() int32 {
-	if x != nil {
-		return x.SkippedCount
-	}
-	return 0
+// Deprecated: Use CreateBankAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateBankAccountResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *BulkCreateCurrenciesResponse) GetErrors() []string {
+func (x *CreateBankAccountResponse) GetBankAccount() *BankAccount {
 	if x != nil {
-		return x.Errors
+		return x.BankAccount
 	}
 	return nil
 }
 
-// RoutingNumber represents a routing number for an institution
-type RoutingNumber struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                            // UUID
-	RoutingNumber string                 `protobuf:"bytes,2,opt,name=routing_number,json=routingNumber,proto3" json:"routing_number,omitempty"` // 9-digit routing number
-	RoutingType   string                 `protobuf:"bytes,3,opt,name=routing_type,json=routingType,proto3" json:"routing_type,omitempty"`       // standard, wire, ach, fedwire, other
-	IsPrimary     bool                   `protobuf:"varint,4,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`            // Primary routing number flag
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                          // Optional description
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+type GetBankAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetBankAccountRequest_Id
+	//	*GetBankAccountRequest_LedgerAccountExternalId
+	Identifier    isGetBankAccountRequest_Identifier `protobuf_oneof:"identifier"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoutingNumber) Reset() {
-	*x = RoutingNumber{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[30]
+func (x *GetBankAccountRequest) Reset() {
+	*x = GetBankAccountRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoutingNumber) String() string {
+func (x *GetBankAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoutingNumber) ProtoMessage() {}
+func (*GetBankAccountRequest) ProtoMessage() {}
 
-func (x *RoutingNumber) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[30]
+func (x *GetBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2604,125 +7227,74 @@ func (x *RoutingNumber) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoutingNumber.ProtoReflect.Descriptor instead.
-func (*RoutingNumber) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *RoutingNumber) GetId() string {
+func (x *GetBankAccountRequest) GetIdentifier() isGetBankAccountRequest_Identifier {
 	if x != nil {
-		return x.Id
+		return x.Identifier
 	}
-	return ""
+	return nil
 }
 
-func (x *RoutingNumber) GetRoutingNumber() string {
+func (x *GetBankAccountRequest) GetId() string {
 	if x != nil {
-		return x.RoutingNumber
+		if x, ok := x.Identifier.(*GetBankAccountRequest_Id); ok {
+			return x.Id
+		}
 	}
 	return ""
 }
 
-func (x *RoutingNumber) GetRoutingType() string {
+func (x *GetBankAccountRequest) GetLedgerAccountExternalId() string {
 	if x != nil {
-		return x.RoutingType
+		if x, ok := x.Identifier.(*GetBankAccountRequest_LedgerAccountExternalId); ok {
+			return x.LedgerAccountExternalId
+		}
 	}
 	return ""
 }
 
-func (x *RoutingNumber) GetIsPrimary() bool {
-	if x != nil {
-		return x.IsPrimary
-	}
-	return false
+type isGetBankAccountRequest_Identifier interface {
+	isGetBankAccountRequest_Identifier()
 }
 
-func (x *RoutingNumber) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type GetBankAccountRequest_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"` // UUID lookup
 }
 
-func (x *RoutingNumber) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+type GetBankAccountRequest_LedgerAccountExternalId struct {
+	LedgerAccountExternalId string `protobuf:"bytes,2,opt,name=ledger_account_external_id,json=ledgerAccountExternalId,proto3,oneof"` // Open account linked to a ledger account
 }
 
-func (x *RoutingNumber) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
+func (*GetBankAccountRequest_Id) isGetBankAccountRequest_Identifier() {}
 
-// FinancialInstitution represents a banking institution
-type FinancialInstitution struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // UUID
-	Code      string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                            // Unique institution code
-	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // Official name
-	ShortName string                 `protobuf:"bytes,4,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"` // Display name
-	// US Banking identifiers (supports multiple routing numbers)
-	RoutingNumbers []*RoutingNumber `protobuf:"bytes,5,rep,name=routing_numbers,json=routingNumbers,proto3" json:"routing_numbers,omitempty"` // List of routing numbers
-	// International identifiers
-	SwiftCode  string `protobuf:"bytes,6,opt,name=swift_code,json=swiftCode,proto3" json:"swift_code,omitempty"`    // SWIFT/BIC code
-	IbanPrefix string `protobuf:"bytes,7,opt,name=iban_prefix,json=ibanPrefix,proto3" json:"iban_prefix,omitempty"` // IBAN prefix
-	BankCode   string `protobuf:"bytes,8,opt,name=bank_code,json=bankCode,proto3" json:"bank_code,omitempty"`       // National bank code
-	BranchCode string `protobuf:"bytes,9,opt,name=branch_code,json=branchCode,proto3" json:"branch_code,omitempty"` // Branch code
-	// Institution details
-	InstitutionType InstitutionType `protobuf:"varint,10,opt,name=institution_type,json=institutionType,proto3,enum=treasury.InstitutionType" json:"institution_type,omitempty"`
-	CountryCode     string          `protobuf:"bytes,11,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`             // ISO 3166-1 alpha-2
-	PrimaryCurrency string          `protobuf:"bytes,12,opt,name=primary_currency,json=primaryCurrency,proto3" json:"primary_currency,omitempty"` // ISO 4217 code
-	// Address
-	Address *Address `protobuf:"bytes,13,opt,name=address,proto3" json:"address,omitempty"`
-	// Contact
-	Contact *ContactInfo `protobuf:"bytes,14,opt,name=contact,proto3" json:"contact,omitempty"`
-	// Operational
-	TimeZone        string           `protobuf:"bytes,15,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
-	BusinessHours   *structpb.Struct `protobuf:"bytes,16,opt,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
-	HolidayCalendar string           `protobuf:"bytes,17,opt,name=holiday_calendar,json=holidayCalendar,proto3" json:"holiday_calendar,omitempty"`
-	// Regulatory
-	RegulatoryId string           `protobuf:"bytes,18,opt,name=regulatory_id,json=regulatoryId,proto3" json:"regulatory_id,omitempty"`
-	TaxId        string           `protobuf:"bytes,19,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
-	Licenses     *structpb.Struct `protobuf:"bytes,20,opt,name=licenses,proto3" json:"licenses,omitempty"`
-	// Status
-	Status           InstitutionStatus      `protobuf:"varint,21,opt,name=status,proto3,enum=treasury.InstitutionStatus" json:"status,omitempty"`
-	IsActive         bool                   `protobuf:"varint,22,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	ActivatedAt      *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
-	DeactivatedAt    *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
-	SuspensionReason string                 `protobuf:"bytes,25,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason,omitempty"`
-	// Metadata
-	Capabilities       *structpb.Struct `protobuf:"bytes,26,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	Notes              string           `protobuf:"bytes,27,opt,name=notes,proto3" json:"notes,omitempty"`
-	ExternalReferences *structpb.Struct `protobuf:"bytes,28,opt,name=external_references,json=externalReferences,proto3" json:"external_references,omitempty"`
-	// Audit
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,31,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedBy     string                 `protobuf:"bytes,32,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	Version       int32                  `protobuf:"varint,33,opt,name=version,proto3" json:"version,omitempty"`
+func (*GetBankAccountRequest_LedgerAccountExternalId) isGetBankAccountRequest_Identifier() {}
+
+type GetBankAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankAccount   *BankAccount           `protobuf:"bytes,1,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FinancialInstitution) Reset() {
-	*x = FinancialInstitution{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[31]
+func (x *GetBankAccountResponse) Reset() {
+	*x = GetBankAccountResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FinancialInstitution) String() string {
+func (x *GetBankAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinancialInstitution) ProtoMessage() {}
+func (*GetBankAccountResponse) ProtoMessage() {}
 
-func (x *FinancialInstitution) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[31]
+func (x *GetBankAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2733,269 +7305,459 @@ func (x *FinancialInstitution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinancialInstitution.ProtoReflect.Descriptor instead.
-func (*FinancialInstitution) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetBankAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetBankAccountResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *FinancialInstitution) GetId() string {
+func (x *GetBankAccountResponse) GetBankAccount() *BankAccount {
 	if x != nil {
-		return x.Id
+		return x.BankAccount
 	}
-	return ""
+	return nil
 }
 
-func (x *FinancialInstitution) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+type UpdateBankAccountRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // Required
+	UpdateMask              *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // account_name, purpose, signatories, ledger_account_external_id
+	AccountName             string                 `protobuf:"bytes,3,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Purpose                 BankAccountPurpose     `protobuf:"varint,4,opt,name=purpose,proto3,enum=treasury.BankAccountPurpose" json:"purpose,omitempty"`
+	Signatories             []*Signatory           `protobuf:"bytes,5,rep,name=signatories,proto3" json:"signatories,omitempty"` // Replaces all signatories
+	LedgerAccountExternalId string                 `protobuf:"bytes,6,opt,name=ledger_account_external_id,json=ledgerAccountExternalId,proto3" json:"ledger_account_external_id,omitempty"`
+	UpdatedBy               string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version                 int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"` // For optimistic locking
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *FinancialInstitution) GetName() string {
+func (x *UpdateBankAccountRequest) Reset() {
+	*x = UpdateBankAccountRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBankAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBankAccountRequest) ProtoMessage() {}
+
+func (x *UpdateBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[78]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FinancialInstitution) GetShortName() string {
+// Deprecated: Use UpdateBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateBankAccountRequest) GetId() string {
 	if x != nil {
-		return x.ShortName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FinancialInstitution) GetRoutingNumbers() []*RoutingNumber {
+func (x *UpdateBankAccountRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.RoutingNumbers
+		return x.UpdateMask
 	}
 	return nil
 }
 
-func (x *FinancialInstitution) GetSwiftCode() string {
+func (x *UpdateBankAccountRequest) GetAccountName() string {
 	if x != nil {
-		return x.SwiftCode
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *FinancialInstitution) GetIbanPrefix() string {
+func (x *UpdateBankAccountRequest) GetPurpose() BankAccountPurpose {
 	if x != nil {
-		return x.IbanPrefix
+		return x.Purpose
 	}
-	return ""
+	return BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED
 }
 
-func (x *FinancialInstitution) GetBankCode() string {
+func (x *UpdateBankAccountRequest) GetSignatories() []*Signatory {
 	if x != nil {
-		return x.BankCode
+		return x.Signatories
 	}
-	return ""
+	return nil
 }
 
-func (x *FinancialInstitution) GetBranchCode() string {
+func (x *UpdateBankAccountRequest) GetLedgerAccountExternalId() string {
 	if x != nil {
-		return x.BranchCode
+		return x.LedgerAccountExternalId
 	}
 	return ""
 }
 
-func (x *FinancialInstitution) GetInstitutionType() InstitutionType {
+func (x *UpdateBankAccountRequest) GetUpdatedBy() string {
 	if x != nil {
-		return x.InstitutionType
+		return x.UpdatedBy
 	}
-	return InstitutionType_INSTITUTION_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *FinancialInstitution) GetCountryCode() string {
+func (x *UpdateBankAccountRequest) GetVersion() int32 {
 	if x != nil {
-		return x.CountryCode
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *FinancialInstitution) GetPrimaryCurrency() string {
+type UpdateBankAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankAccount   *BankAccount           `protobuf:"bytes,1,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBankAccountResponse) Reset() {
+	*x = UpdateBankAccountResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBankAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBankAccountResponse) ProtoMessage() {}
+
+func (x *UpdateBankAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[79]
 	if x != nil {
-		return x.PrimaryCurrency
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FinancialInstitution) GetAddress() *Address {
+// Deprecated: Use UpdateBankAccountResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBankAccountResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateBankAccountResponse) GetBankAccount() *BankAccount {
 	if x != nil {
-		return x.Address
+		return x.BankAccount
 	}
 	return nil
 }
 
-func (x *FinancialInstitution) GetContact() *ContactInfo {
+type CloseBankAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // Required
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Required
+	ClosedBy      string                 `protobuf:"bytes,3,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	Version       int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // For optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseBankAccountRequest) Reset() {
+	*x = CloseBankAccountRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseBankAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseBankAccountRequest) ProtoMessage() {}
+
+func (x *CloseBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[80]
 	if x != nil {
-		return x.Contact
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FinancialInstitution) GetTimeZone() string {
+// Deprecated: Use CloseBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*CloseBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CloseBankAccountRequest) GetId() string {
 	if x != nil {
-		return x.TimeZone
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FinancialInstitution) GetBusinessHours() *structpb.Struct {
+func (x *CloseBankAccountRequest) GetReason() string {
 	if x != nil {
-		return x.BusinessHours
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *FinancialInstitution) GetHolidayCalendar() string {
+func (x *CloseBankAccountRequest) GetClosedBy() string {
 	if x != nil {
-		return x.HolidayCalendar
+		return x.ClosedBy
 	}
 	return ""
 }
 
-func (x *FinancialInstitution) GetRegulatoryId() string {
+func (x *CloseBankAccountRequest) GetVersion() int32 {
 	if x != nil {
-		return x.RegulatoryId
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *FinancialInstitution) GetTaxId() string {
+type CloseBankAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankAccount   *BankAccount           `protobuf:"bytes,1,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseBankAccountResponse) Reset() {
+	*x = CloseBankAccountResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseBankAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseBankAccountResponse) ProtoMessage() {}
+
+func (x *CloseBankAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[81]
 	if x != nil {
-		return x.TaxId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FinancialInstitution) GetLicenses() *structpb.Struct {
+// Deprecated: Use CloseBankAccountResponse.ProtoReflect.Descriptor instead.
+func (*CloseBankAccountResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CloseBankAccountResponse) GetBankAccount() *BankAccount {
 	if x != nil {
-		return x.Licenses
+		return x.BankAccount
 	}
 	return nil
 }
 
-func (x *FinancialInstitution) GetStatus() InstitutionStatus {
+type ListBankAccountsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InstitutionCode string                 `protobuf:"bytes,1,opt,name=institution_code,json=institutionCode,proto3" json:"institution_code,omitempty"` // Filter by institution
+	CurrencyCode    string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`          // Filter by currency
+	Purpose         BankAccountPurpose     `protobuf:"varint,3,opt,name=purpose,proto3,enum=treasury.BankAccountPurpose" json:"purpose,omitempty"`      // Filter by purpose
+	Status          BankAccountStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=treasury.BankAccountStatus" json:"status,omitempty"`         // Filter by status
+	PageSize        int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SkipTotalCount  bool                   `protobuf:"varint,7,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"` // Leave total_count unset to skip counting matches
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListBankAccountsRequest) Reset() {
+	*x = ListBankAccountsRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankAccountsRequest) ProtoMessage() {}
+
+func (x *ListBankAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[82]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return InstitutionStatus_INSTITUTION_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *FinancialInstitution) GetIsActive() bool {
+// Deprecated: Use ListBankAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListBankAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListBankAccountsRequest) GetInstitutionCode() string {
 	if x != nil {
-		return x.IsActive
+		return x.InstitutionCode
 	}
-	return false
+	return ""
 }
 
-func (x *FinancialInstitution) GetActivatedAt() *timestamppb.Timestamp {
+func (x *ListBankAccountsRequest) GetCurrencyCode() string {
 	if x != nil {
-		return x.ActivatedAt
+		return x.CurrencyCode
 	}
-	return nil
+	return ""
 }
 
-func (x *FinancialInstitution) GetDeactivatedAt() *timestamppb.Timestamp {
+func (x *ListBankAccountsRequest) GetPurpose() BankAccountPurpose {
 	if x != nil {
-		return x.DeactivatedAt
+		return x.Purpose
 	}
-	return nil
+	return BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED
 }
 
-func (x *FinancialInstitution) GetSuspensionReason() string {
+func (x *ListBankAccountsRequest) GetStatus() BankAccountStatus {
 	if x != nil {
-		return x.SuspensionReason
+		return x.Status
 	}
-	return ""
+	return BankAccountStatus_BANK_ACCOUNT_STATUS_UNSPECIFIED
 }
 
-func (x *FinancialInstitution) GetCapabilities() *structpb.Struct {
+func (x *ListBankAccountsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Capabilities
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *FinancialInstitution) GetNotes() string {
+func (x *ListBankAccountsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Notes
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *FinancialInstitution) GetExternalReferences() *structpb.Struct {
+func (x *ListBankAccountsRequest) GetSkipTotalCount() bool {
 	if x != nil {
-		return x.ExternalReferences
+		return x.SkipTotalCount
 	}
-	return nil
+	return false
 }
 
-func (x *FinancialInstitution) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+type ListBankAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankAccounts  []*BankAccount         `protobuf:"bytes,1,rep,name=bank_accounts,json=bankAccounts,proto3" json:"bank_accounts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FinancialInstitution) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ListBankAccountsResponse) Reset() {
+	*x = ListBankAccountsResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankAccountsResponse) ProtoMessage() {}
+
+func (x *ListBankAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[83]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FinancialInstitution) GetCreatedBy() string {
+// Deprecated: Use ListBankAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListBankAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListBankAccountsResponse) GetBankAccounts() []*BankAccount {
 	if x != nil {
-		return x.CreatedBy
+		return x.BankAccounts
 	}
-	return ""
+	return nil
 }
 
-func (x *FinancialInstitution) GetUpdatedBy() string {
+func (x *ListBankAccountsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *FinancialInstitution) GetVersion() int32 {
+func (x *ListBankAccountsResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Version
+		return x.TotalCount
 	}
 	return 0
 }
-
-type Address struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	StreetAddress_1 string                 `protobuf:"bytes,1,opt,name=street_address_1,json=streetAddress1,proto3" json:"street_address_1,omitempty"`
-	StreetAddress_2 string                 `protobuf:"bytes,2,opt,name=street_address_2,json=streetAddress2,proto3" json:"street_address_2,omitempty"`
-	City            string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	StateProvince   string                 `protobuf:"bytes,4,opt,name=state_province,json=stateProvince,proto3" json:"state_province,omitempty"`
-	PostalCode      string                 `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
-	CountryCode     string                 `protobuf:"bytes,6,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+
+// HolidayRule finds a holiday in each year
+type HolidayRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                // Required, e.g. "Independence Day"
+	Type          HolidayRuleType        `protobuf:"varint,2,opt,name=type,proto3,enum=treasury.HolidayRuleType" json:"type,omitempty"` // Required
+	Month         int32                  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`                             // 1-12, FIXED and NTH_WEEKDAY
+	Day           int32                  `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`                                 // Day of month, FIXED
+	Weekday       int32                  `protobuf:"varint,5,opt,name=weekday,proto3" json:"weekday,omitempty"`                         // 0 Sunday to 6 Saturday, NTH_WEEKDAY
+	Nth           int32                  `protobuf:"varint,6,opt,name=nth,proto3" json:"nth,omitempty"`                                 // 1-5, or -1 for the last, NTH_WEEKDAY
+	OffsetDays    int32                  `protobuf:"varint,7,opt,name=offset_days,json=offsetDays,proto3" json:"offset_days,omitempty"` // Days from Easter Sunday, EASTER
+	Date          string                 `protobuf:"bytes,8,opt,name=date,proto3" json:"date,omitempty"`                                // YYYY-MM-DD, DATE
+	Observance    HolidayObservance      `protobuf:"varint,9,opt,name=observance,proto3,enum=treasury.HolidayObservance" json:"observance,omitempty"`
+	FromYear      int32                  `protobuf:"varint,10,opt,name=from_year,json=fromYear,proto3" json:"from_year,omitempty"` // First year the rule applies, 0 for no limit
+	ToYear        int32                  `protobuf:"varint,11,opt,name=to_year,json=toYear,proto3" json:"to_year,omitempty"`       // Last year the rule applies, 0 for no limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Address) Reset() {
-	*x = Address{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[32]
+func (x *HolidayRule) Reset() {
+	*x = HolidayRule{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Address) String() string {
+func (x *HolidayRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Address) ProtoMessage() {}
+func (*HolidayRule) ProtoMessage() {}
 
-func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[32]
+func (x *HolidayRule) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3006,158 +7768,123 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Address.ProtoReflect.Descriptor instead.
-func (*Address) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use HolidayRule.ProtoReflect.Descriptor instead.
+func (*HolidayRule) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *Address) GetStreetAddress_1() string {
+func (x *HolidayRule) GetName() string {
 	if x != nil {
-		return x.StreetAddress_1
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Address) GetStreetAddress_2() string {
+func (x *HolidayRule) GetType() HolidayRuleType {
 	if x != nil {
-		return x.StreetAddress_2
+		return x.Type
 	}
-	return ""
+	return HolidayRuleType_HOLIDAY_RULE_TYPE_UNSPECIFIED
 }
 
-func (x *Address) GetCity() string {
+func (x *HolidayRule) GetMonth() int32 {
 	if x != nil {
-		return x.City
+		return x.Month
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetStateProvince() string {
+func (x *HolidayRule) GetDay() int32 {
 	if x != nil {
-		return x.StateProvince
+		return x.Day
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetPostalCode() string {
+func (x *HolidayRule) GetWeekday() int32 {
 	if x != nil {
-		return x.PostalCode
+		return x.Weekday
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetCountryCode() string {
+func (x *HolidayRule) GetNth() int32 {
 	if x != nil {
-		return x.CountryCode
+		return x.Nth
 	}
-	return ""
-}
-
-type ContactInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PhoneNumber   string                 `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	FaxNumber     string                 `protobuf:"bytes,2,opt,name=fax_number,json=faxNumber,proto3" json:"fax_number,omitempty"`
-	EmailAddress  string                 `protobuf:"bytes,3,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
-	WebsiteUrl    string                 `protobuf:"bytes,4,opt,name=website_url,json=websiteUrl,proto3" json:"website_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ContactInfo) Reset() {
-	*x = ContactInfo{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ContactInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ContactInfo) ProtoMessage() {}
-
-func (x *ContactInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[33]
+func (x *HolidayRule) GetOffsetDays() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OffsetDays
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ContactInfo.ProtoReflect.Descriptor instead.
-func (*ContactInfo) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{33}
+	return 0
 }
 
-func (x *ContactInfo) GetPhoneNumber() string {
+func (x *HolidayRule) GetDate() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Date
 	}
 	return ""
 }
 
-func (x *ContactInfo) GetFaxNumber() string {
+func (x *HolidayRule) GetObservance() HolidayObservance {
 	if x != nil {
-		return x.FaxNumber
+		return x.Observance
 	}
-	return ""
+	return HolidayObservance_HOLIDAY_OBSERVANCE_UNSPECIFIED
 }
 
-func (x *ContactInfo) GetEmailAddress() string {
+func (x *HolidayRule) GetFromYear() int32 {
 	if x != nil {
-		return x.EmailAddress
+		return x.FromYear
 	}
-	return ""
+	return 0
 }
 
-func (x *ContactInfo) GetWebsiteUrl() string {
+func (x *HolidayRule) GetToYear() int32 {
 	if x != nil {
-		return x.WebsiteUrl
+		return x.ToYear
 	}
-	return ""
-}
-
-// Request/Response messages for FinancialInstitutionService
-type CreateInstitutionRequest struct {
-	state           protoimpl.MessageState                         `protogen:"open.v1"`
-	Code            string                                         `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Required, unique
-	Name            string                                         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Required
-	ShortName       string                                         `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
-	RoutingNumbers  []*CreateInstitutionRequest_RoutingNumberInput `protobuf:"bytes,4,rep,name=routing_numbers,json=routingNumbers,proto3" json:"routing_numbers,omitempty"` // US banks routing numbers
-	SwiftCode       string                                         `protobuf:"bytes,5,opt,name=swift_code,json=swiftCode,proto3" json:"swift_code,omitempty"`                // Required for international
-	BankCode        string                                         `protobuf:"bytes,6,opt,name=bank_code,json=bankCode,proto3" json:"bank_code,omitempty"`
-	BranchCode      string                                         `protobuf:"bytes,7,opt,name=branch_code,json=branchCode,proto3" json:"branch_code,omitempty"`
-	InstitutionType InstitutionType                                `protobuf:"varint,8,opt,name=institution_type,json=institutionType,proto3,enum=treasury.InstitutionType" json:"institution_type,omitempty"` // Required
-	CountryCode     string                                         `protobuf:"bytes,9,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`                                            // Required
-	PrimaryCurrency string                                         `protobuf:"bytes,10,opt,name=primary_currency,json=primaryCurrency,proto3" json:"primary_currency,omitempty"`
-	Address         *Address                                       `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
-	Contact         *ContactInfo                                   `protobuf:"bytes,12,opt,name=contact,proto3" json:"contact,omitempty"`
-	TimeZone        string                                         `protobuf:"bytes,13,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
-	Capabilities    *structpb.Struct                               `protobuf:"bytes,14,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	Notes           string                                         `protobuf:"bytes,15,opt,name=notes,proto3" json:"notes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	return 0
 }
 
-func (x *CreateInstitutionRequest) Reset() {
-	*x = CreateInstitutionRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[34]
+// HolidayCalendar is a named set of weekend days and holiday rules
+type HolidayCalendar struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // UUID
+	Code                 string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // e.g. US-FED, TARGET2, UK-BACS
+	Name                 string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	WeekendDays          []int32                `protobuf:"varint,5,rep,packed,name=weekend_days,json=weekendDays,proto3" json:"weekend_days,omitempty"` // 0 Sunday to 6 Saturday
+	Rules                []*HolidayRule         `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"`
+	SettlementCurrencies []string               `protobuf:"bytes,7,rep,name=settlement_currencies,json=settlementCurrencies,proto3" json:"settlement_currencies,omitempty"` // Currencies that settle on this calendar
+	IsActive             bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy            string                 `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy            string                 `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version              int32                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"` // Optimistic locking
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *HolidayCalendar) Reset() {
+	*x = HolidayCalendar{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateInstitutionRequest) String() string {
+func (x *HolidayCalendar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInstitutionRequest) ProtoMessage() {}
+func (*HolidayCalendar) ProtoMessage() {}
 
-func (x *CreateInstitutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[34]
+func (x *HolidayCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3168,138 +7895,190 @@ func (x *CreateInstitutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInstitutionRequest.ProtoReflect.Descriptor instead.
-func (*CreateInstitutionRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use HolidayCalendar.ProtoReflect.Descriptor instead.
+func (*HolidayCalendar) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateInstitutionRequest) GetCode() string {
+func (x *HolidayCalendar) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HolidayCalendar) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *CreateInstitutionRequest) GetName() string {
+func (x *HolidayCalendar) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateInstitutionRequest) GetShortName() string {
+func (x *HolidayCalendar) GetDescription() string {
 	if x != nil {
-		return x.ShortName
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateInstitutionRequest) GetRoutingNumbers() []*CreateInstitutionRequest_RoutingNumberInput {
+func (x *HolidayCalendar) GetWeekendDays() []int32 {
 	if x != nil {
-		return x.RoutingNumbers
+		return x.WeekendDays
 	}
 	return nil
 }
 
-func (x *CreateInstitutionRequest) GetSwiftCode() string {
+func (x *HolidayCalendar) GetRules() []*HolidayRule {
 	if x != nil {
-		return x.SwiftCode
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateInstitutionRequest) GetBankCode() string {
+func (x *HolidayCalendar) GetSettlementCurrencies() []string {
 	if x != nil {
-		return x.BankCode
+		return x.SettlementCurrencies
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateInstitutionRequest) GetBranchCode() string {
+func (x *HolidayCalendar) GetIsActive() bool {
 	if x != nil {
-		return x.BranchCode
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *CreateInstitutionRequest) GetInstitutionType() InstitutionType {
+func (x *HolidayCalendar) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.InstitutionType
+		return x.CreatedAt
 	}
-	return InstitutionType_INSTITUTION_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *CreateInstitutionRequest) GetCountryCode() string {
+func (x *HolidayCalendar) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CountryCode
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *HolidayCalendar) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *CreateInstitutionRequest) GetPrimaryCurrency() string {
+func (x *HolidayCalendar) GetUpdatedBy() string {
 	if x != nil {
-		return x.PrimaryCurrency
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *CreateInstitutionRequest) GetAddress() *Address {
+func (x *HolidayCalendar) GetVersion() int32 {
 	if x != nil {
-		return x.Address
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateInstitutionRequest) GetContact() *ContactInfo {
+// Holiday is an observed holiday of a calendar
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // "(observed)" is appended when moved
+	CalendarCode  string                 `protobuf:"bytes,3,opt,name=calendar_code,json=calendarCode,proto3" json:"calendar_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[86]
 	if x != nil {
-		return x.Contact
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateInstitutionRequest) GetTimeZone() string {
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Holiday) GetDate() string {
 	if x != nil {
-		return x.TimeZone
+		return x.Date
 	}
 	return ""
 }
 
-func (x *CreateInstitutionRequest) GetCapabilities() *structpb.Struct {
+func (x *Holiday) GetName() string {
 	if x != nil {
-		return x.Capabilities
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateInstitutionRequest) GetNotes() string {
+func (x *Holiday) GetCalendarCode() string {
 	if x != nil {
-		return x.Notes
+		return x.CalendarCode
 	}
 	return ""
 }
 
-type CreateInstitutionResponse struct {
+type CreateCalendarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Institution   *FinancialInstitution  `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Required, unique
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Required
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	WeekendDays   []int32                `protobuf:"varint,4,rep,packed,name=weekend_days,json=weekendDays,proto3" json:"weekend_days,omitempty"` // Default Saturday and Sunday
+	Rules         []*HolidayRule         `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateInstitutionResponse) Reset() {
-	*x = CreateInstitutionResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[35]
+func (x *CreateCalendarRequest) Reset() {
+	*x = CreateCalendarRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateInstitutionResponse) String() string {
+func (x *CreateCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInstitutionResponse) ProtoMessage() {}
+func (*CreateCalendarRequest) ProtoMessage() {}
 
-func (x *CreateInstitutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[35]
+func (x *CreateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3310,46 +8089,75 @@ func (x *CreateInstitutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInstitutionResponse.ProtoReflect.Descriptor instead.
-func (*CreateInstitutionResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *CreateInstitutionResponse) GetInstitution() *FinancialInstitution {
+func (x *CreateCalendarRequest) GetCode() string {
 	if x != nil {
-		return x.Institution
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCalendarRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCalendarRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCalendarRequest) GetWeekendDays() []int32 {
+	if x != nil {
+		return x.WeekendDays
 	}
 	return nil
 }
 
-type GetInstitutionRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Identifier:
-	//
-	//	*GetInstitutionRequest_Code
-	//	*GetInstitutionRequest_RoutingNumber
-	//	*GetInstitutionRequest_SwiftCode
-	//	*GetInstitutionRequest_Id
-	Identifier    isGetInstitutionRequest_Identifier `protobuf_oneof:"identifier"`
+func (x *CreateCalendarRequest) GetRules() []*HolidayRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CreateCalendarRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calendar      *HolidayCalendar       `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInstitutionRequest) Reset() {
-	*x = GetInstitutionRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[36]
+func (x *CreateCalendarResponse) Reset() {
+	*x = CreateCalendarResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInstitutionRequest) String() string {
+func (x *CreateCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstitutionRequest) ProtoMessage() {}
+func (*CreateCalendarResponse) ProtoMessage() {}
 
-func (x *GetInstitutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[36]
+func (x *CreateCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3360,104 +8168,93 @@ func (x *GetInstitutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstitutionRequest.ProtoReflect.Descriptor instead.
-func (*GetInstitutionRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use CreateCalendarResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetInstitutionRequest) GetIdentifier() isGetInstitutionRequest_Identifier {
+func (x *CreateCalendarResponse) GetCalendar() *HolidayCalendar {
 	if x != nil {
-		return x.Identifier
+		return x.Calendar
 	}
 	return nil
 }
 
-func (x *GetInstitutionRequest) GetCode() string {
-	if x != nil {
-		if x, ok := x.Identifier.(*GetInstitutionRequest_Code); ok {
-			return x.Code
-		}
-	}
-	return ""
+type GetCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`  // Required
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"` // Optional: list the holidays of the year
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInstitutionRequest) GetRoutingNumber() string {
-	if x != nil {
-		if x, ok := x.Identifier.(*GetInstitutionRequest_RoutingNumber); ok {
-			return x.RoutingNumber
-		}
-	}
-	return ""
+func (x *GetCalendarRequest) Reset() {
+	*x = GetCalendarRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInstitutionRequest) GetSwiftCode() string {
-	if x != nil {
-		if x, ok := x.Identifier.(*GetInstitutionRequest_SwiftCode); ok {
-			return x.SwiftCode
-		}
-	}
-	return ""
+func (x *GetCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetInstitutionRequest) GetId() string {
+func (*GetCalendarRequest) ProtoMessage() {}
+
+func (x *GetCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[89]
 	if x != nil {
-		if x, ok := x.Identifier.(*GetInstitutionRequest_Id); ok {
-			return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return ""
-}
-
-type isGetInstitutionRequest_Identifier interface {
-	isGetInstitutionRequest_Identifier()
-}
-
-type GetInstitutionRequest_Code struct {
-	Code string `protobuf:"bytes,1,opt,name=code,proto3,oneof"` // Primary lookup
+	return mi.MessageOf(x)
 }
 
-type GetInstitutionRequest_RoutingNumber struct {
-	RoutingNumber string `protobuf:"bytes,2,opt,name=routing_number,json=routingNumber,proto3,oneof"` // US routing lookup
+// Deprecated: Use GetCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{89}
 }
 
-type GetInstitutionRequest_SwiftCode struct {
-	SwiftCode string `protobuf:"bytes,3,opt,name=swift_code,json=swiftCode,proto3,oneof"` // International lookup
+func (x *GetCalendarRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
 }
 
-type GetInstitutionRequest_Id struct {
-	Id string `protobuf:"bytes,4,opt,name=id,proto3,oneof"` // UUID lookup
+func (x *GetCalendarRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
 }
 
-func (*GetInstitutionRequest_Code) isGetInstitutionRequest_Identifier() {}
-
-func (*GetInstitutionRequest_RoutingNumber) isGetInstitutionRequest_Identifier() {}
-
-func (*GetInstitutionRequest_SwiftCode) isGetInstitutionRequest_Identifier() {}
-
-func (*GetInstitutionRequest_Id) isGetInstitutionRequest_Identifier() {}
-
-type GetInstitutionResponse struct {
+type GetCalendarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Institution   *FinancialInstitution  `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	Calendar      *HolidayCalendar       `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	Holidays      []*Holiday             `protobuf:"bytes,2,rep,name=holidays,proto3" json:"holidays,omitempty"` // Set when year is given
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInstitutionResponse) Reset() {
-	*x = GetInstitutionResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[37]
+func (x *GetCalendarResponse) Reset() {
+	*x = GetCalendarResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInstitutionResponse) String() string {
+func (x *GetCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInstitutionResponse) ProtoMessage() {}
+func (*GetCalendarResponse) ProtoMessage() {}
 
-func (x *GetInstitutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[37]
+func (x *GetCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3468,51 +8265,55 @@ func (x *GetInstitutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInstitutionResponse.ProtoReflect.Descriptor instead.
-func (*GetInstitutionResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetInstitutionResponse) GetInstitution() *FinancialInstitution {
+func (x *GetCalendarResponse) GetCalendar() *HolidayCalendar {
 	if x != nil {
-		return x.Institution
+		return x.Calendar
 	}
 	return nil
 }
 
-type UpdateInstitutionRequest struct {
-	state          protoimpl.MessageState                          `protogen:"open.v1"`
-	Code           string                                          `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                               // Required (identifies institution)
-	UpdateMask     *fieldmaskpb.FieldMask                          `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // Fields to update
-	Name           string                                          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ShortName      string                                          `protobuf:"bytes,4,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
-	RoutingNumbers []*UpdateInstitutionRequest_RoutingNumberUpdate `protobuf:"bytes,5,rep,name=routing_numbers,json=routingNumbers,proto3" json:"routing_numbers,omitempty"` // Replace all routing numbers
-	SwiftCode      string                                          `protobuf:"bytes,6,opt,name=swift_code,json=swiftCode,proto3" json:"swift_code,omitempty"`
-	Address        *Address                                        `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
-	Contact        *ContactInfo                                    `protobuf:"bytes,8,opt,name=contact,proto3" json:"contact,omitempty"`
-	Status         InstitutionStatus                               `protobuf:"varint,9,opt,name=status,proto3,enum=treasury.InstitutionStatus" json:"status,omitempty"`
-	Capabilities   *structpb.Struct                                `protobuf:"bytes,10,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	Notes          string                                          `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
-	Version        int32                                           `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"` // For optimistic locking
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCalendarResponse) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
 }
 
-func (x *UpdateInstitutionRequest) Reset() {
-	*x = UpdateInstitutionRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[38]
+type UpdateCalendarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                               // Required (identifies calendar)
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // name, description, weekend_days, rules, is_active
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	WeekendDays   []int32                `protobuf:"varint,5,rep,packed,name=weekend_days,json=weekendDays,proto3" json:"weekend_days,omitempty"`
+	Rules         []*HolidayRule         `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"` // Replaces all rules
+	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Version       int32                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"` // For optimistic locking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCalendarRequest) Reset() {
+	*x = UpdateCalendarRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInstitutionRequest) String() string {
+func (x *UpdateCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInstitutionRequest) ProtoMessage() {}
+func (*UpdateCalendarRequest) ProtoMessage() {}
 
-func (x *UpdateInstitutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[38]
+func (x *UpdateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3523,117 +8324,96 @@ func (x *UpdateInstitutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInstitutionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInstitutionRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UpdateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *UpdateInstitutionRequest) GetCode() string {
+func (x *UpdateCalendarRequest) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateInstitutionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UpdateCalendarRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.UpdateMask
 	}
 	return nil
 }
 
-func (x *UpdateInstitutionRequest) GetName() string {
+func (x *UpdateCalendarRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateInstitutionRequest) GetShortName() string {
-	if x != nil {
-		return x.ShortName
-	}
-	return ""
-}
-
-func (x *UpdateInstitutionRequest) GetRoutingNumbers() []*UpdateInstitutionRequest_RoutingNumberUpdate {
-	if x != nil {
-		return x.RoutingNumbers
-	}
-	return nil
-}
-
-func (x *UpdateInstitutionRequest) GetSwiftCode() string {
+func (x *UpdateCalendarRequest) GetDescription() string {
 	if x != nil {
-		return x.SwiftCode
+		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdateInstitutionRequest) GetAddress() *Address {
+func (x *UpdateCalendarRequest) GetWeekendDays() []int32 {
 	if x != nil {
-		return x.Address
+		return x.WeekendDays
 	}
 	return nil
 }
 
-func (x *UpdateInstitutionRequest) GetContact() *ContactInfo {
+func (x *UpdateCalendarRequest) GetRules() []*HolidayRule {
 	if x != nil {
-		return x.Contact
+		return x.Rules
 	}
 	return nil
 }
 
-func (x *UpdateInstitutionRequest) GetStatus() InstitutionStatus {
-	if x != nil {
-		return x.Status
-	}
-	return InstitutionStatus_INSTITUTION_STATUS_UNSPECIFIED
-}
-
-func (x *UpdateInstitutionRequest) GetCapabilities() *structpb.Struct {
+func (x *UpdateCalendarRequest) GetIsActive() bool {
 	if x != nil {
-		return x.Capabilities
+		return x.IsActive
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateInstitutionRequest) GetNotes() string {
+func (x *UpdateCalendarRequest) GetUpdatedBy() string {
 	if x != nil {
-		return x.Notes
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-func (x *UpdateInstitutionRequest) GetVersion() int32 {
+func (x *UpdateCalendarRequest) GetVersion() int32 {
 	if x != nil {
 		return x.Version
 	}
 	return 0
 }
 
-type UpdateInstitutionResponse struct {
+type UpdateCalendarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Institution   *FinancialInstitution  `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	Calendar      *HolidayCalendar       `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInstitutionResponse) Reset() {
-	*x = UpdateInstitutionResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[39]
+func (x *UpdateCalendarResponse) Reset() {
+	*x = UpdateCalendarResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInstitutionResponse) String() string {
+func (x *UpdateCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInstitutionResponse) ProtoMessage() {}
+func (*UpdateCalendarResponse) ProtoMessage() {}
 
-func (x *UpdateInstitutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[39]
+func (x *UpdateCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3644,42 +8424,42 @@ func (x *UpdateInstitutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInstitutionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateInstitutionResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateCalendarResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *UpdateInstitutionResponse) GetInstitution() *FinancialInstitution {
+func (x *UpdateCalendarResponse) GetCalendar() *HolidayCalendar {
 	if x != nil {
-		return x.Institution
+		return x.Calendar
 	}
 	return nil
 }
 
-type DeleteInstitutionRequest struct {
+type SetSettlementCalendarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                            // Required
-	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`                         // Force deletion despite references
-	DeletedBy     string                 `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"` // User making deletion
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // Required
+	CalendarCode  string                 `protobuf:"bytes,2,opt,name=calendar_code,json=calendarCode,proto3" json:"calendar_code,omitempty"` // Empty clears the settlement calendar
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteInstitutionRequest) Reset() {
-	*x = DeleteInstitutionRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[40]
+func (x *SetSettlementCalendarRequest) Reset() {
+	*x = SetSettlementCalendarRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteInstitutionRequest) String() string {
+func (x *SetSettlementCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInstitutionRequest) ProtoMessage() {}
+func (*SetSettlementCalendarRequest) ProtoMessage() {}
 
-func (x *DeleteInstitutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[40]
+func (x *SetSettlementCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3690,55 +8470,55 @@ func (x *DeleteInstitutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInstitutionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteInstitutionRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use SetSettlementCalendarRequest.ProtoReflect.Descriptor instead.
+func (*SetSettlementCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *DeleteInstitutionRequest) GetCode() string {
+func (x *SetSettlementCalendarRequest) GetCurrencyCode() string {
 	if x != nil {
-		return x.Code
+		return x.CurrencyCode
 	}
 	return ""
 }
 
-func (x *DeleteInstitutionRequest) GetForce() bool {
+func (x *SetSettlementCalendarRequest) GetCalendarCode() string {
 	if x != nil {
-		return x.Force
+		return x.CalendarCode
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteInstitutionRequest) GetDeletedBy() string {
+func (x *SetSettlementCalendarRequest) GetUpdatedBy() string {
 	if x != nil {
-		return x.DeletedBy
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-type DeleteInstitutionResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Success            bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	BlockingReferences []string               `protobuf:"bytes,2,rep,name=blocking_references,json=blockingReferences,proto3" json:"blocking_references,omitempty"` // What prevents deletion
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type SetSettlementCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	CalendarCode  string                 `protobuf:"bytes,2,opt,name=calendar_code,json=calendarCode,proto3" json:"calendar_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteInstitutionResponse) Reset() {
-	*x = DeleteInstitutionResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[41]
+func (x *SetSettlementCalendarResponse) Reset() {
+	*x = SetSettlementCalendarResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteInstitutionResponse) String() string {
+func (x *SetSettlementCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInstitutionResponse) ProtoMessage() {}
+func (*SetSettlementCalendarResponse) ProtoMessage() {}
 
-func (x *DeleteInstitutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[41]
+func (x *SetSettlementCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3749,52 +8529,50 @@ func (x *DeleteInstitutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInstitutionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteInstitutionResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use SetSettlementCalendarResponse.ProtoReflect.Descriptor instead.
+func (*SetSettlementCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *DeleteInstitutionResponse) GetSuccess() bool {
+func (x *SetSettlementCalendarResponse) GetCurrencyCode() string {
 	if x != nil {
-		return x.Success
+		return x.CurrencyCode
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteInstitutionResponse) GetBlockingReferences() []string {
+func (x *SetSettlementCalendarResponse) GetCalendarCode() string {
 	if x != nil {
-		return x.BlockingReferences
+		return x.CalendarCode
 	}
-	return nil
+	return ""
 }
 
-type ListInstitutionsRequest struct {
+type IsBusinessDayRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	Status          InstitutionStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=treasury.InstitutionStatus" json:"status,omitempty"`                                        // Filter by status
-	InstitutionType InstitutionType        `protobuf:"varint,2,opt,name=institution_type,json=institutionType,proto3,enum=treasury.InstitutionType" json:"institution_type,omitempty"` // Filter by type
-	CountryCode     string                 `protobuf:"bytes,3,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`                                            // Filter by country
-	IsActive        bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                                                    // Filter by active flag
-	PageSize        int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                                    // Pagination
-	PageToken       string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                                  // Pagination token
+	Date            string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // Required, YYYY-MM-DD
+	InstitutionCode string                 `protobuf:"bytes,2,opt,name=institution_code,json=institutionCode,proto3" json:"institution_code,omitempty"`
+	CurrencyCode    string                 `protobuf:"bytes,3,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	CalendarCodes   []string               `protobuf:"bytes,4,rep,name=calendar_codes,json=calendarCodes,proto3" json:"calendar_codes,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListInstitutionsRequest) Reset() {
-	*x = ListInstitutionsRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[42]
+func (x *IsBusinessDayRequest) Reset() {
+	*x = IsBusinessDayRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInstitutionsRequest) String() string {
+func (x *IsBusinessDayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInstitutionsRequest) ProtoMessage() {}
+func (*IsBusinessDayRequest) ProtoMessage() {}
 
-func (x *ListInstitutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[42]
+func (x *IsBusinessDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3805,77 +8583,64 @@ func (x *ListInstitutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInstitutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListInstitutionsRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *ListInstitutionsRequest) GetStatus() InstitutionStatus {
-	if x != nil {
-		return x.Status
-	}
-	return InstitutionStatus_INSTITUTION_STATUS_UNSPECIFIED
-}
-
-func (x *ListInstitutionsRequest) GetInstitutionType() InstitutionType {
-	if x != nil {
-		return x.InstitutionType
-	}
-	return InstitutionType_INSTITUTION_TYPE_UNSPECIFIED
+// Deprecated: Use IsBusinessDayRequest.ProtoReflect.Descriptor instead.
+func (*IsBusinessDayRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *ListInstitutionsRequest) GetCountryCode() string {
+func (x *IsBusinessDayRequest) GetDate() string {
 	if x != nil {
-		return x.CountryCode
+		return x.Date
 	}
 	return ""
 }
 
-func (x *ListInstitutionsRequest) GetIsActive() bool {
+func (x *IsBusinessDayRequest) GetInstitutionCode() string {
 	if x != nil {
-		return x.IsActive
+		return x.InstitutionCode
 	}
-	return false
+	return ""
 }
 
-func (x *ListInstitutionsRequest) GetPageSize() int32 {
+func (x *IsBusinessDayRequest) GetCurrencyCode() string {
 	if x != nil {
-		return x.PageSize
+		return x.CurrencyCode
 	}
-	return 0
+	return ""
 }
 
-func (x *ListInstitutionsRequest) GetPageToken() string {
+func (x *IsBusinessDayRequest) GetCalendarCodes() []string {
 	if x != nil {
-		return x.PageToken
+		return x.CalendarCodes
 	}
-	return ""
+	return nil
 }
 
-type ListInstitutionsResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Institutions  []*FinancialInstitution `protobuf:"bytes,1,rep,name=institutions,proto3" json:"institutions,omitempty"`
-	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                   `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type IsBusinessDayResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IsBusinessDay   bool                   `protobuf:"varint,1,opt,name=is_business_day,json=isBusinessDay,proto3" json:"is_business_day,omitempty"`
+	Reason          string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                            // e.g. "weekend (US-FED)" or "US-FED: Independence Day"
+	NextBusinessDay string                 `protobuf:"bytes,3,opt,name=next_business_day,json=nextBusinessDay,proto3" json:"next_business_day,omitempty"` // The date itself when it is a business day
+	CalendarCodes   []string               `protobuf:"bytes,4,rep,name=calendar_codes,json=calendarCodes,proto3" json:"calendar_codes,omitempty"`         // Calendars applied
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListInstitutionsResponse) Reset() {
-	*x = ListInstitutionsResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[43]
+func (x *IsBusinessDayResponse) Reset() {
+	*x = IsBusinessDayResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInstitutionsResponse) String() string {
+func (x *IsBusinessDayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInstitutionsResponse) ProtoMessage() {}
+func (*IsBusinessDayResponse) ProtoMessage() {}
 
-func (x *ListInstitutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[43]
+func (x *IsBusinessDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3886,54 +8651,65 @@ func (x *ListInstitutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInstitutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListInstitutionsResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use IsBusinessDayResponse.ProtoReflect.Descriptor instead.
+func (*IsBusinessDayResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *ListInstitutionsResponse) GetInstitutions() []*FinancialInstitution {
+func (x *IsBusinessDayResponse) GetIsBusinessDay() bool {
 	if x != nil {
-		return x.Institutions
+		return x.IsBusinessDay
 	}
-	return nil
+	return false
 }
 
-func (x *ListInstitutionsResponse) GetNextPageToken() string {
+func (x *IsBusinessDayResponse) GetReason() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *ListInstitutionsResponse) GetTotalCount() int32 {
+func (x *IsBusinessDayResponse) GetNextBusinessDay() string {
 	if x != nil {
-		return x.TotalCount
+		return x.NextBusinessDay
 	}
-	return 0
+	return ""
 }
 
-type CheckInstitutionReferencesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Institution to check
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *IsBusinessDayResponse) GetCalendarCodes() []string {
+	if x != nil {
+		return x.CalendarCodes
+	}
+	return nil
 }
 
-func (x *CheckInstitutionReferencesRequest) Reset() {
-	*x = CheckInstitutionReferencesRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[44]
+type AddBusinessDaysRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Date            string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`  // Required, YYYY-MM-DD
+	Days            int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // Negative moves back; 0 rolls forward to a business day
+	InstitutionCode string                 `protobuf:"bytes,3,opt,name=institution_code,json=institutionCode,proto3" json:"institution_code,omitempty"`
+	CurrencyCode    string                 `protobuf:"bytes,4,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	CalendarCodes   []string               `protobuf:"bytes,5,rep,name=calendar_codes,json=calendarCodes,proto3" json:"calendar_codes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddBusinessDaysRequest) Reset() {
+	*x = AddBusinessDaysRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInstitutionReferencesRequest) String() string {
+func (x *AddBusinessDaysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInstitutionReferencesRequest) ProtoMessage() {}
+func (*AddBusinessDaysRequest) ProtoMessage() {}
 
-func (x *CheckInstitutionReferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[44]
+func (x *AddBusinessDaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3944,41 +8720,69 @@ func (x *CheckInstitutionReferencesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInstitutionReferencesRequest.ProtoReflect.Descriptor instead.
-func (*CheckInstitutionReferencesRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AddBusinessDaysRequest.ProtoReflect.Descriptor instead.
+func (*AddBusinessDaysRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *CheckInstitutionReferencesRequest) GetCode() string {
+func (x *AddBusinessDaysRequest) GetDate() string {
 	if x != nil {
-		return x.Code
+		return x.Date
 	}
 	return ""
 }
 
-type CheckInstitutionReferencesResponse struct {
-	state         protoimpl.MessageState                          `protogen:"open.v1"`
-	References    []*CheckInstitutionReferencesResponse_Reference `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
-	CanDelete     bool                                            `protobuf:"varint,2,opt,name=can_delete,json=canDelete,proto3" json:"can_delete,omitempty"` // True if no references
+func (x *AddBusinessDaysRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *AddBusinessDaysRequest) GetInstitutionCode() string {
+	if x != nil {
+		return x.InstitutionCode
+	}
+	return ""
+}
+
+func (x *AddBusinessDaysRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *AddBusinessDaysRequest) GetCalendarCodes() []string {
+	if x != nil {
+		return x.CalendarCodes
+	}
+	return nil
+}
+
+type AddBusinessDaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	CalendarCodes []string               `protobuf:"bytes,2,rep,name=calendar_codes,json=calendarCodes,proto3" json:"calendar_codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckInstitutionReferencesResponse) Reset() {
-	*x = CheckInstitutionReferencesResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[45]
+func (x *AddBusinessDaysResponse) Reset() {
+	*x = AddBusinessDaysResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInstitutionReferencesResponse) String() string {
+func (x *AddBusinessDaysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInstitutionReferencesResponse) ProtoMessage() {}
+func (*AddBusinessDaysResponse) ProtoMessage() {}
 
-func (x *CheckInstitutionReferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[45]
+func (x *AddBusinessDaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3989,49 +8793,51 @@ func (x *CheckInstitutionReferencesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInstitutionReferencesResponse.ProtoReflect.Descriptor instead.
-func (*CheckInstitutionReferencesResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use AddBusinessDaysResponse.ProtoReflect.Descriptor instead.
+func (*AddBusinessDaysResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *CheckInstitutionReferencesResponse) GetReferences() []*CheckInstitutionReferencesResponse_Reference {
+func (x *AddBusinessDaysResponse) GetDate() string {
 	if x != nil {
-		return x.References
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-func (x *CheckInstitutionReferencesResponse) GetCanDelete() bool {
+func (x *AddBusinessDaysResponse) GetCalendarCodes() []string {
 	if x != nil {
-		return x.CanDelete
+		return x.CalendarCodes
 	}
-	return false
+	return nil
 }
 
-type BulkCreateInstitutionsRequest struct {
-	state          protoimpl.MessageState      `protogen:"open.v1"`
-	Institutions   []*CreateInstitutionRequest `protobuf:"bytes,1,rep,name=institutions,proto3" json:"institutions,omitempty"`
-	SkipDuplicates bool                        `protobuf:"varint,2,opt,name=skip_duplicates,json=skipDuplicates,proto3" json:"skip_duplicates,omitempty"` // Skip existing institutions
-	UpdateExisting bool                        `protobuf:"varint,3,opt,name=update_existing,json=updateExisting,proto3" json:"update_existing,omitempty"` // Update if exists
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type NextSettlementDateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InstitutionCode string                 `protobuf:"bytes,1,opt,name=institution_code,json=institutionCode,proto3" json:"institution_code,omitempty"`
+	CurrencyCode    string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	TradeDate       string                 `protobuf:"bytes,3,opt,name=trade_date,json=tradeDate,proto3" json:"trade_date,omitempty"`                 // YYYY-MM-DD, default today (UTC)
+	SettlementDays  int32                  `protobuf:"varint,4,opt,name=settlement_days,json=settlementDays,proto3" json:"settlement_days,omitempty"` // T+n, default 0
+	CalendarCodes   []string               `protobuf:"bytes,5,rep,name=calendar_codes,json=calendarCodes,proto3" json:"calendar_codes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *BulkCreateInstitutionsRequest) Reset() {
-	*x = BulkCreateInstitutionsRequest{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[46]
+func (x *NextSettlementDateRequest) Reset() {
+	*x = NextSettlementDateRequest{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateInstitutionsRequest) String() string {
+func (x *NextSettlementDateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateInstitutionsRequest) ProtoMessage() {}
+func (*NextSettlementDateRequest) ProtoMessage() {}
 
-func (x *BulkCreateInstitutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[46]
+func (x *NextSettlementDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4042,57 +8848,69 @@ func (x *BulkCreateInstitutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateInstitutionsRequest.ProtoReflect.Descriptor instead.
-func (*BulkCreateInstitutionsRequest) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use NextSettlementDateRequest.ProtoReflect.Descriptor instead.
+func (*NextSettlementDateRequest) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *BulkCreateInstitutionsRequest) GetInstitutions() []*CreateInstitutionRequest {
+func (x *NextSettlementDateRequest) GetInstitutionCode() string {
 	if x != nil {
-		return x.Institutions
+		return x.InstitutionCode
 	}
-	return nil
+	return ""
 }
 
-func (x *BulkCreateInstitutionsRequest) GetSkipDuplicates() bool {
+func (x *NextSettlementDateRequest) GetCurrencyCode() string {
 	if x != nil {
-		return x.SkipDuplicates
+		return x.CurrencyCode
 	}
-	return false
+	return ""
 }
 
-func (x *BulkCreateInstitutionsRequest) GetUpdateExisting() bool {
+func (x *NextSettlementDateRequest) GetTradeDate() string {
 	if x != nil {
-		return x.UpdateExisting
+		return x.TradeDate
 	}
-	return false
+	return ""
 }
 
-type BulkCreateInstitutionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatedCount  int32                  `protobuf:"varint,1,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
-	UpdatedCount  int32                  `protobuf:"varint,2,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
-	SkippedCount  int32                  `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
-	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NextSettlementDateRequest) GetSettlementDays() int32 {
+	if x != nil {
+		return x.SettlementDays
+	}
+	return 0
 }
 
-func (x *BulkCreateInstitutionsResponse) Reset() {
-	*x = BulkCreateInstitutionsResponse{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[47]
+func (x *NextSettlementDateRequest) GetCalendarCodes() []string {
+	if x != nil {
+		return x.CalendarCodes
+	}
+	return nil
+}
+
+type NextSettlementDateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SettlementDate string                 `protobuf:"bytes,1,opt,name=settlement_date,json=settlementDate,proto3" json:"settlement_date,omitempty"`
+	CalendarCodes  []string               `protobuf:"bytes,2,rep,name=calendar_codes,json=calendarCodes,proto3" json:"calendar_codes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NextSettlementDateResponse) Reset() {
+	*x = NextSettlementDateResponse{}
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkCreateInstitutionsResponse) String() string {
+func (x *NextSettlementDateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkCreateInstitutionsResponse) ProtoMessage() {}
+func (*NextSettlementDateResponse) ProtoMessage() {}
 
-func (x *BulkCreateInstitutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[47]
+func (x *NextSettlementDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4103,35 +8921,21 @@ func (x *BulkCreateInstitutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkCreateInstitutionsResponse.ProtoReflect.Descriptor instead.
-func (*BulkCreateInstitutionsResponse) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *BulkCreateInstitutionsResponse) GetCreatedCount() int32 {
-	if x != nil {
-		return x.CreatedCount
-	}
-	return 0
-}
-
-func (x *BulkCreateInstitutionsResponse) GetUpdatedCount() int32 {
-	if x != nil {
-		return x.UpdatedCount
-	}
-	return 0
+// Deprecated: Use NextSettlementDateResponse.ProtoReflect.Descriptor instead.
+func (*NextSettlementDateResponse) Descriptor() ([]byte, []int) {
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *BulkCreateInstitutionsResponse) GetSkippedCount() int32 {
+func (x *NextSettlementDateResponse) GetSettlementDate() string {
 	if x != nil {
-		return x.SkippedCount
+		return x.SettlementDate
 	}
-	return 0
+	return ""
 }
 
-func (x *BulkCreateInstitutionsResponse) GetErrors() []string {
+func (x *NextSettlementDateResponse) GetCalendarCodes() []string {
 	if x != nil {
-		return x.Errors
+		return x.CalendarCodes
 	}
 	return nil
 }
@@ -4149,7 +8953,7 @@ type CreateInstitutionRequest_RoutingNumberInput struct {
 
 func (x *CreateInstitutionRequest_RoutingNumberInput) Reset() {
 	*x = CreateInstitutionRequest_RoutingNumberInput{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[50]
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4161,7 +8965,7 @@ func (x *CreateInstitutionRequest_RoutingNumberInput) String() string {
 func (*CreateInstitutionRequest_RoutingNumberInput) ProtoMessage() {}
 
 func (x *CreateInstitutionRequest_RoutingNumberInput) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[50]
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4174,7 +8978,7 @@ func (x *CreateInstitutionRequest_RoutingNumberInput) ProtoReflect() protoreflec
 
 // Deprecated: Use CreateInstitutionRequest_RoutingNumberInput.ProtoReflect.Descriptor instead.
 func (*CreateInstitutionRequest_RoutingNumberInput) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{34, 0}
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{42, 0}
 }
 
 func (x *CreateInstitutionRequest_RoutingNumberInput) GetRoutingNumber() string {
@@ -4218,7 +9022,7 @@ type UpdateInstitutionRequest_RoutingNumberUpdate struct {
 
 func (x *UpdateInstitutionRequest_RoutingNumberUpdate) Reset() {
 	*x = UpdateInstitutionRequest_RoutingNumberUpdate{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[51]
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4230,7 +9034,7 @@ func (x *UpdateInstitutionRequest_RoutingNumberUpdate) String() string {
 func (*UpdateInstitutionRequest_RoutingNumberUpdate) ProtoMessage() {}
 
 func (x *UpdateInstitutionRequest_RoutingNumberUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[51]
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4243,7 +9047,7 @@ func (x *UpdateInstitutionRequest_RoutingNumberUpdate) ProtoReflect() protorefle
 
 // Deprecated: Use UpdateInstitutionRequest_RoutingNumberUpdate.ProtoReflect.Descriptor instead.
 func (*UpdateInstitutionRequest_RoutingNumberUpdate) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{38, 0}
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{46, 0}
 }
 
 func (x *UpdateInstitutionRequest_RoutingNumberUpdate) GetRoutingNumber() string {
@@ -4285,7 +9089,7 @@ type CheckInstitutionReferencesResponse_Reference struct {
 
 func (x *CheckInstitutionReferencesResponse_Reference) Reset() {
 	*x = CheckInstitutionReferencesResponse_Reference{}
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[52]
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4297,7 +9101,7 @@ func (x *CheckInstitutionReferencesResponse_Reference) String() string {
 func (*CheckInstitutionReferencesResponse_Reference) ProtoMessage() {}
 
 func (x *CheckInstitutionReferencesResponse_Reference) ProtoReflect() protoreflect.Message {
-	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[52]
+	mi := &file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4310,7 +9114,7 @@ func (x *CheckInstitutionReferencesResponse_Reference) ProtoReflect() protorefle
 
 // Deprecated: Use CheckInstitutionReferencesResponse_Reference.ProtoReflect.Descriptor instead.
 func (*CheckInstitutionReferencesResponse_Reference) Descriptor() ([]byte, []int) {
-	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{45, 0}
+	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescGZIP(), []int{53, 0}
 }
 
 func (x *CheckInstitutionReferencesResponse_Reference) GetTableName() string {
@@ -4491,11 +9295,12 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\rcountry_codes\x18\x06 \x03(\tR\fcountryCodes\x12\x1b\n" +
 	"\tis_crypto\x18\a \x01(\bR\bisCrypto\"H\n" +
 	"\x16CreateCurrencyResponse\x12.\n" +
-	"\bcurrency\x18\x01 \x01(\v2\x12.treasury.CurrencyR\bcurrency\"o\n" +
+	"\bcurrency\x18\x01 \x01(\v2\x12.treasury.CurrencyR\bcurrency\"\xa0\x01\n" +
 	"\x12GetCurrencyRequest\x12\x14\n" +
 	"\x04code\x18\x01 \x01(\tH\x00R\x04code\x12#\n" +
 	"\fnumeric_code\x18\x02 \x01(\tH\x00R\vnumericCode\x12\x10\n" +
-	"\x02id\x18\x03 \x01(\tH\x00R\x02idB\f\n" +
+	"\x02id\x18\x03 \x01(\tH\x00R\x02id\x12/\n" +
+	"\x05as_of\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOfB\f\n" +
 	"\n" +
 	"identifier\"E\n" +
 	"\x13GetCurrencyResponse\x12.\n" +
@@ -4521,7 +9326,7 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\aversion\x18\x04 \x01(\x05R\aversion\"f\n" +
 	"\x1aDeactivateCurrencyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12.\n" +
-	"\bcurrency\x18\x02 \x01(\v2\x12.treasury.CurrencyR\bcurrency\"\xe2\x01\n" +
+	"\bcurrency\x18\x02 \x01(\v2\x12.treasury.CurrencyR\bcurrency\"\x8c\x02\n" +
 	"\x15ListCurrenciesRequest\x120\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x18.treasury.CurrencyStatusR\x06status\x12\x1b\n" +
 	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x1b\n" +
@@ -4529,7 +9334,8 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\x12\x1b\n" +
 	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x06 \x01(\tR\tpageToken\"\x95\x01\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12(\n" +
+	"\x10skip_total_count\x18\a \x01(\bR\x0eskipTotalCount\"\x95\x01\n" +
 	"\x16ListCurrenciesResponse\x122\n" +
 	"\n" +
 	"currencies\x18\x01 \x03(\v2\x12.treasury.CurrencyR\n" +
@@ -4547,7 +9353,65 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\x12#\n" +
 	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\x12#\n" +
 	"\rskipped_count\x18\x03 \x01(\x05R\fskippedCount\x12\x16\n" +
-	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xa0\x02\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xfc\x02\n" +
+	"\x0fCurrencyVersion\x12.\n" +
+	"\bcurrency\x18\x01 \x01(\v2\x12.treasury.CurrencyR\bcurrency\x12=\n" +
+	"\vchange_type\x18\x02 \x01(\x0e2\x1c.treasury.CurrencyChangeTypeR\n" +
+	"changeType\x129\n" +
+	"\n" +
+	"valid_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x06 \x01(\tR\tchangedBy\x12.\n" +
+	"\x13scheduled_change_id\x18\a \x01(\tR\x11scheduledChangeId\"\xbe\x04\n" +
+	"\x0eCurrencyChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12=\n" +
+	"\feffective_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1f\n" +
+	"\vminor_units\x18\x06 \x01(\x05R\n" +
+	"minorUnits\x12\x16\n" +
+	"\x06symbol\x18\a \x01(\tR\x06symbol\x120\n" +
+	"\x06status\x18\b \x01(\x0e2\x18.treasury.CurrencyStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"applied_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tappliedAt\x12=\n" +
+	"\fcancelled_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12!\n" +
+	"\fcancelled_by\x18\x0e \x01(\tR\vcancelledBy\"/\n" +
+	"\x19GetCurrencyHistoryRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x96\x01\n" +
+	"\x1aGetCurrencyHistoryResponse\x125\n" +
+	"\bversions\x18\x01 \x03(\v2\x19.treasury.CurrencyVersionR\bversions\x12A\n" +
+	"\x0fpending_changes\x18\x02 \x03(\v2\x18.treasury.CurrencyChangeR\x0ependingChanges\"\xe5\x02\n" +
+	"\x1dScheduleCurrencyChangeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12=\n" +
+	"\feffective_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1f\n" +
+	"\vminor_units\x18\x05 \x01(\x05R\n" +
+	"minorUnits\x12\x16\n" +
+	"\x06symbol\x18\x06 \x01(\tR\x06symbol\x120\n" +
+	"\x06status\x18\a \x01(\x0e2\x18.treasury.CurrencyStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\"R\n" +
+	"\x1eScheduleCurrencyChangeResponse\x120\n" +
+	"\x06change\x18\x01 \x01(\v2\x18.treasury.CurrencyChangeR\x06change\"P\n" +
+	"\x1bCancelCurrencyChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fcancelled_by\x18\x02 \x01(\tR\vcancelledBy\"P\n" +
+	"\x1cCancelCurrencyChangeResponse\x120\n" +
+	"\x06change\x18\x01 \x01(\v2\x18.treasury.CurrencyChangeR\x06change\"\xf7\x02\n" +
 	"\rRoutingNumber\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
 	"\x0erouting_number\x18\x02 \x01(\tR\rroutingNumber\x12!\n" +
@@ -4558,7 +9422,8 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x84\v\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12U\n" +
+	"\x19removed_from_directory_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x16removedFromDirectoryAt\"\x84\v\n" +
 	"\x14FinancialInstitution\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
@@ -4616,7 +9481,7 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"fax_number\x18\x02 \x01(\tR\tfaxNumber\x12#\n" +
 	"\remail_address\x18\x03 \x01(\tR\femailAddress\x12\x1f\n" +
 	"\vwebsite_url\x18\x04 \x01(\tR\n" +
-	"websiteUrl\"\xa2\x06\n" +
+	"websiteUrl\"\xcd\x06\n" +
 	"\x18CreateInstitutionRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
@@ -4636,7 +9501,8 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\acontact\x18\f \x01(\v2\x15.treasury.ContactInfoR\acontact\x12\x1b\n" +
 	"\ttime_zone\x18\r \x01(\tR\btimeZone\x12;\n" +
 	"\fcapabilities\x18\x0e \x01(\v2\x17.google.protobuf.StructR\fcapabilities\x12\x14\n" +
-	"\x05notes\x18\x0f \x01(\tR\x05notes\x1a\x9f\x01\n" +
+	"\x05notes\x18\x0f \x01(\tR\x05notes\x12)\n" +
+	"\x10holiday_calendar\x18\x10 \x01(\tR\x0fholidayCalendar\x1a\x9f\x01\n" +
 	"\x12RoutingNumberInput\x12%\n" +
 	"\x0erouting_number\x18\x01 \x01(\tR\rroutingNumber\x12!\n" +
 	"\frouting_type\x18\x02 \x01(\tR\vroutingType\x12\x1d\n" +
@@ -4644,17 +9510,18 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"is_primary\x18\x03 \x01(\bR\tisPrimary\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\"]\n" +
 	"\x19CreateInstitutionResponse\x12@\n" +
-	"\vinstitution\x18\x01 \x01(\v2\x1e.treasury.FinancialInstitutionR\vinstitution\"\x97\x01\n" +
+	"\vinstitution\x18\x01 \x01(\v2\x1e.treasury.FinancialInstitutionR\vinstitution\"\xad\x01\n" +
 	"\x15GetInstitutionRequest\x12\x14\n" +
 	"\x04code\x18\x01 \x01(\tH\x00R\x04code\x12'\n" +
 	"\x0erouting_number\x18\x02 \x01(\tH\x00R\rroutingNumber\x12\x1f\n" +
 	"\n" +
 	"swift_code\x18\x03 \x01(\tH\x00R\tswiftCode\x12\x10\n" +
-	"\x02id\x18\x04 \x01(\tH\x00R\x02idB\f\n" +
+	"\x02id\x18\x04 \x01(\tH\x00R\x02id\x12\x14\n" +
+	"\x04iban\x18\x05 \x01(\tH\x00R\x04ibanB\f\n" +
 	"\n" +
 	"identifier\"Z\n" +
 	"\x16GetInstitutionResponse\x12@\n" +
-	"\vinstitution\x18\x01 \x01(\v2\x1e.treasury.FinancialInstitutionR\vinstitution\"\xc1\x05\n" +
+	"\vinstitution\x18\x01 \x01(\v2\x1e.treasury.FinancialInstitutionR\vinstitution\"\xec\x05\n" +
 	"\x18UpdateInstitutionRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
@@ -4671,7 +9538,8 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\fcapabilities\x18\n" +
 	" \x01(\v2\x17.google.protobuf.StructR\fcapabilities\x12\x14\n" +
 	"\x05notes\x18\v \x01(\tR\x05notes\x12\x18\n" +
-	"\aversion\x18\f \x01(\x05R\aversion\x1a\xa0\x01\n" +
+	"\aversion\x18\f \x01(\x05R\aversion\x12)\n" +
+	"\x10holiday_calendar\x18\r \x01(\tR\x0fholidayCalendar\x1a\xa0\x01\n" +
 	"\x13RoutingNumberUpdate\x12%\n" +
 	"\x0erouting_number\x18\x01 \x01(\tR\rroutingNumber\x12!\n" +
 	"\frouting_type\x18\x02 \x01(\tR\vroutingType\x12\x1d\n" +
@@ -4687,7 +9555,7 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"deleted_by\x18\x03 \x01(\tR\tdeletedBy\"f\n" +
 	"\x19DeleteInstitutionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12/\n" +
-	"\x13blocking_references\x18\x02 \x03(\tR\x12blockingReferences\"\x90\x02\n" +
+	"\x13blocking_references\x18\x02 \x03(\tR\x12blockingReferences\"\xba\x02\n" +
 	"\x17ListInstitutionsRequest\x123\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1b.treasury.InstitutionStatusR\x06status\x12D\n" +
 	"\x10institution_type\x18\x02 \x01(\x0e2\x19.treasury.InstitutionTypeR\x0finstitutionType\x12!\n" +
@@ -4695,7 +9563,8 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1b\n" +
 	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x06 \x01(\tR\tpageToken\"\xa7\x01\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12(\n" +
+	"\x10skip_total_count\x18\a \x01(\bR\x0eskipTotalCount\"\xa7\x01\n" +
 	"\x18ListInstitutionsResponse\x12B\n" +
 	"\finstitutions\x18\x01 \x03(\v2\x1e.treasury.FinancialInstitutionR\finstitutions\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
@@ -4723,7 +9592,326 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\x12#\n" +
 	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\x12#\n" +
 	"\rskipped_count\x18\x03 \x01(\x05R\fskippedCount\x12\x16\n" +
-	"\x06errors\x18\x04 \x03(\tR\x06errors*9\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xc8\x01\n" +
+	"\x1dImportRoutingDirectoryRequest\x128\n" +
+	"\x06format\x18\x01 \x01(\x0e2 .treasury.RoutingDirectoryFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\"\xbc\x01\n" +
+	"\x16RoutingDirectoryChange\x128\n" +
+	"\x04type\x18\x01 \x01(\x0e2$.treasury.RoutingDirectoryChangeTypeR\x04type\x12%\n" +
+	"\x0erouting_number\x18\x02 \x01(\tR\rroutingNumber\x12)\n" +
+	"\x10institution_code\x18\x03 \x01(\tR\x0finstitutionCode\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\"\xc4\x04\n" +
+	"\x1eImportRoutingDirectoryResponse\x128\n" +
+	"\x06format\x18\x01 \x01(\x0e2 .treasury.RoutingDirectoryFormatR\x06format\x12!\n" +
+	"\frecord_count\x18\x02 \x01(\x05R\vrecordCount\x121\n" +
+	"\x14institutions_created\x18\x03 \x01(\x05R\x13institutionsCreated\x121\n" +
+	"\x14institutions_updated\x18\x04 \x01(\x05R\x13institutionsUpdated\x122\n" +
+	"\x15routing_numbers_added\x18\x05 \x01(\x05R\x13routingNumbersAdded\x126\n" +
+	"\x17routing_numbers_removed\x18\x06 \x01(\x05R\x15routingNumbersRemoved\x128\n" +
+	"\x18routing_numbers_restored\x18\a \x01(\x05R\x16routingNumbersRestored\x12'\n" +
+	"\x0funchanged_count\x18\b \x01(\x05R\x0eunchangedCount\x12#\n" +
+	"\rskipped_count\x18\t \x01(\x05R\fskippedCount\x12\x16\n" +
+	"\x06errors\x18\n" +
+	" \x03(\tR\x06errors\x12:\n" +
+	"\achanges\x18\v \x03(\v2 .treasury.RoutingDirectoryChangeR\achanges\x12\x17\n" +
+	"\adry_run\x18\f \x01(\bR\x06dryRun\"\xc0\x01\n" +
+	"\x19ImportBicDirectoryRequest\x124\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1c.treasury.BicDirectoryFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\"\xac\x01\n" +
+	"\x12BicDirectoryChange\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .treasury.BicDirectoryChangeTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"swift_code\x18\x02 \x01(\tR\tswiftCode\x12)\n" +
+	"\x10institution_code\x18\x03 \x01(\tR\x0finstitutionCode\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\"\x90\x03\n" +
+	"\x1aImportBicDirectoryResponse\x124\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1c.treasury.BicDirectoryFormatR\x06format\x12!\n" +
+	"\frecord_count\x18\x02 \x01(\x05R\vrecordCount\x121\n" +
+	"\x14institutions_created\x18\x03 \x01(\x05R\x13institutionsCreated\x121\n" +
+	"\x14institutions_updated\x18\x04 \x01(\x05R\x13institutionsUpdated\x12'\n" +
+	"\x0funchanged_count\x18\x05 \x01(\x05R\x0eunchangedCount\x12!\n" +
+	"\fbranch_count\x18\x06 \x01(\x05R\vbranchCount\x12\x16\n" +
+	"\x06errors\x18\a \x03(\tR\x06errors\x126\n" +
+	"\achanges\x18\b \x03(\v2\x1c.treasury.BicDirectoryChangeR\achanges\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\"\xd4\x03\n" +
+	"\fExchangeRate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rbase_currency\x18\x02 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x03 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x12/\n" +
+	"\trate_type\x18\x05 \x01(\x0e2\x12.treasury.RateTypeR\brateType\x12=\n" +
+	"\feffective_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\f \x01(\x05R\aversion\"\xfb\x01\n" +
+	"\x11ExchangeRateInput\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12/\n" +
+	"\trate_type\x18\x04 \x01(\x0e2\x12.treasury.RateTypeR\brateType\x12=\n" +
+	"\feffective_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\"f\n" +
+	"\x12UpsertRatesRequest\x121\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1b.treasury.ExchangeRateInputR\x05rates\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\tR\tupdatedBy\"\x8d\x01\n" +
+	"\x13UpsertRatesResponse\x12#\n" +
+	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\x12#\n" +
+	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\x12,\n" +
+	"\x05rates\x18\x03 \x03(\v2\x16.treasury.ExchangeRateR\x05rates\"\xd6\x01\n" +
+	"\x0eGetRateRequest\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12/\n" +
+	"\x05as_of\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12/\n" +
+	"\trate_type\x18\x04 \x01(\x0e2\x12.treasury.RateTypeR\brateType\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\"\xee\x02\n" +
+	"\x0fGetRateResponse\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12/\n" +
+	"\trate_type\x18\x04 \x01(\x0e2\x12.treasury.RateTypeR\brateType\x12=\n" +
+	"\feffective_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x128\n" +
+	"\n" +
+	"derivation\x18\x06 \x01(\x0e2\x18.treasury.RateDerivationR\n" +
+	"derivation\x12%\n" +
+	"\x0epivot_currency\x18\a \x01(\tR\rpivotCurrency\x12*\n" +
+	"\x04legs\x18\b \x03(\v2\x16.treasury.ExchangeRateR\x04legs\"\x8f\x03\n" +
+	"\x10ListRatesRequest\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12/\n" +
+	"\trate_type\x18\x03 \x01(\x0e2\x12.treasury.RateTypeR\brateType\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12A\n" +
+	"\x0eeffective_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12=\n" +
+	"\feffective_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveTo\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\x12(\n" +
+	"\x10skip_total_count\x18\t \x01(\bR\x0eskipTotalCount\"\x8a\x01\n" +
+	"\x11ListRatesResponse\x12,\n" +
+	"\x05rates\x18\x01 \x03(\v2\x16.treasury.ExchangeRateR\x05rates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xe5\x01\n" +
+	"\x12ImportRatesRequest\x120\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x18.treasury.RateFileFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12/\n" +
+	"\trate_type\x18\x04 \x01(\x0e2\x12.treasury.RateTypeR\brateType\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\"\xb2\x01\n" +
+	"\x13ImportRatesResponse\x12#\n" +
+	"\rcreated_count\x18\x01 \x01(\x05R\fcreatedCount\x12#\n" +
+	"\rupdated_count\x18\x02 \x01(\x05R\fupdatedCount\x12#\n" +
+	"\rskipped_count\x18\x03 \x01(\x05R\fskippedCount\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\x12\x14\n" +
+	"\x05dates\x18\x05 \x03(\tR\x05dates\"\x8f\x06\n" +
+	"\vBankAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
+	"\x10institution_code\x18\x02 \x01(\tR\x0finstitutionCode\x12!\n" +
+	"\faccount_name\x18\x03 \x01(\tR\vaccountName\x12%\n" +
+	"\x0eaccount_number\x18\x04 \x01(\tR\raccountNumber\x12\x12\n" +
+	"\x04iban\x18\x05 \x01(\tR\x04iban\x12#\n" +
+	"\rcurrency_code\x18\x06 \x01(\tR\fcurrencyCode\x126\n" +
+	"\apurpose\x18\a \x01(\x0e2\x1c.treasury.BankAccountPurposeR\apurpose\x125\n" +
+	"\vsignatories\x18\b \x03(\v2\x13.treasury.SignatoryR\vsignatories\x12;\n" +
+	"\x1aledger_account_external_id\x18\t \x01(\tR\x17ledgerAccountExternalId\x123\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x1b.treasury.BankAccountStatusR\x06status\x127\n" +
+	"\topened_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x127\n" +
+	"\tclosed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12!\n" +
+	"\fclose_reason\x18\r \x01(\tR\vcloseReason\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x10 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x11 \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\x12 \x01(\x05R\aversion\"K\n" +
+	"\tSignatory\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\"\xcc\x03\n" +
+	"\x18CreateBankAccountRequest\x12)\n" +
+	"\x10institution_code\x18\x01 \x01(\tR\x0finstitutionCode\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12%\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x12\x12\n" +
+	"\x04iban\x18\x04 \x01(\tR\x04iban\x12#\n" +
+	"\rcurrency_code\x18\x05 \x01(\tR\fcurrencyCode\x126\n" +
+	"\apurpose\x18\x06 \x01(\x0e2\x1c.treasury.BankAccountPurposeR\apurpose\x125\n" +
+	"\vsignatories\x18\a \x03(\v2\x13.treasury.SignatoryR\vsignatories\x12;\n" +
+	"\x1aledger_account_external_id\x18\b \x01(\tR\x17ledgerAccountExternalId\x127\n" +
+	"\topened_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\"U\n" +
+	"\x19CreateBankAccountResponse\x128\n" +
+	"\fbank_account\x18\x01 \x01(\v2\x15.treasury.BankAccountR\vbankAccount\"v\n" +
+	"\x15GetBankAccountRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12=\n" +
+	"\x1aledger_account_external_id\x18\x02 \x01(\tH\x00R\x17ledgerAccountExternalIdB\f\n" +
+	"\n" +
+	"identifier\"R\n" +
+	"\x16GetBankAccountResponse\x128\n" +
+	"\fbank_account\x18\x01 \x01(\v2\x15.treasury.BankAccountR\vbankAccount\"\xef\x02\n" +
+	"\x18UpdateBankAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12!\n" +
+	"\faccount_name\x18\x03 \x01(\tR\vaccountName\x126\n" +
+	"\apurpose\x18\x04 \x01(\x0e2\x1c.treasury.BankAccountPurposeR\apurpose\x125\n" +
+	"\vsignatories\x18\x05 \x03(\v2\x13.treasury.SignatoryR\vsignatories\x12;\n" +
+	"\x1aledger_account_external_id\x18\x06 \x01(\tR\x17ledgerAccountExternalId\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\"U\n" +
+	"\x19UpdateBankAccountResponse\x128\n" +
+	"\fbank_account\x18\x01 \x01(\v2\x15.treasury.BankAccountR\vbankAccount\"x\n" +
+	"\x17CloseBankAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tclosed_by\x18\x03 \x01(\tR\bclosedBy\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\"T\n" +
+	"\x18CloseBankAccountResponse\x128\n" +
+	"\fbank_account\x18\x01 \x01(\v2\x15.treasury.BankAccountR\vbankAccount\"\xbc\x02\n" +
+	"\x17ListBankAccountsRequest\x12)\n" +
+	"\x10institution_code\x18\x01 \x01(\tR\x0finstitutionCode\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x126\n" +
+	"\apurpose\x18\x03 \x01(\x0e2\x1c.treasury.BankAccountPurposeR\apurpose\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1b.treasury.BankAccountStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12(\n" +
+	"\x10skip_total_count\x18\a \x01(\bR\x0eskipTotalCount\"\x9f\x01\n" +
+	"\x18ListBankAccountsResponse\x12:\n" +
+	"\rbank_accounts\x18\x01 \x03(\v2\x15.treasury.BankAccountR\fbankAccounts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xcc\x02\n" +
+	"\vHolidayRule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.treasury.HolidayRuleTypeR\x04type\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x04 \x01(\x05R\x03day\x12\x18\n" +
+	"\aweekday\x18\x05 \x01(\x05R\aweekday\x12\x10\n" +
+	"\x03nth\x18\x06 \x01(\x05R\x03nth\x12\x1f\n" +
+	"\voffset_days\x18\a \x01(\x05R\n" +
+	"offsetDays\x12\x12\n" +
+	"\x04date\x18\b \x01(\tR\x04date\x12;\n" +
+	"\n" +
+	"observance\x18\t \x01(\x0e2\x1b.treasury.HolidayObservanceR\n" +
+	"observance\x12\x1b\n" +
+	"\tfrom_year\x18\n" +
+	" \x01(\x05R\bfromYear\x12\x17\n" +
+	"\ato_year\x18\v \x01(\x05R\x06toYear\"\xdb\x03\n" +
+	"\x0fHolidayCalendar\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12!\n" +
+	"\fweekend_days\x18\x05 \x03(\x05R\vweekendDays\x12+\n" +
+	"\x05rules\x18\x06 \x03(\v2\x15.treasury.HolidayRuleR\x05rules\x123\n" +
+	"\x15settlement_currencies\x18\a \x03(\tR\x14settlementCurrencies\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\r \x01(\x05R\aversion\"V\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rcalendar_code\x18\x03 \x01(\tR\fcalendarCode\"\xd0\x01\n" +
+	"\x15CreateCalendarRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\fweekend_days\x18\x04 \x03(\x05R\vweekendDays\x12+\n" +
+	"\x05rules\x18\x05 \x03(\v2\x15.treasury.HolidayRuleR\x05rules\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\"O\n" +
+	"\x16CreateCalendarResponse\x125\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x19.treasury.HolidayCalendarR\bcalendar\"<\n" +
+	"\x12GetCalendarRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"{\n" +
+	"\x13GetCalendarResponse\x125\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x19.treasury.HolidayCalendarR\bcalendar\x12-\n" +
+	"\bholidays\x18\x02 \x03(\v2\x11.treasury.HolidayR\bholidays\"\xc4\x02\n" +
+	"\x15UpdateCalendarRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12!\n" +
+	"\fweekend_days\x18\x05 \x03(\x05R\vweekendDays\x12+\n" +
+	"\x05rules\x18\x06 \x03(\v2\x15.treasury.HolidayRuleR\x05rules\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12\x18\n" +
+	"\aversion\x18\t \x01(\x05R\aversion\"O\n" +
+	"\x16UpdateCalendarResponse\x125\n" +
+	"\bcalendar\x18\x01 \x01(\v2\x19.treasury.HolidayCalendarR\bcalendar\"\x87\x01\n" +
+	"\x1cSetSettlementCalendarRequest\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12#\n" +
+	"\rcalendar_code\x18\x02 \x01(\tR\fcalendarCode\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\"i\n" +
+	"\x1dSetSettlementCalendarResponse\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12#\n" +
+	"\rcalendar_code\x18\x02 \x01(\tR\fcalendarCode\"\xa1\x01\n" +
+	"\x14IsBusinessDayRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12)\n" +
+	"\x10institution_code\x18\x02 \x01(\tR\x0finstitutionCode\x12#\n" +
+	"\rcurrency_code\x18\x03 \x01(\tR\fcurrencyCode\x12%\n" +
+	"\x0ecalendar_codes\x18\x04 \x03(\tR\rcalendarCodes\"\xaa\x01\n" +
+	"\x15IsBusinessDayResponse\x12&\n" +
+	"\x0fis_business_day\x18\x01 \x01(\bR\risBusinessDay\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12*\n" +
+	"\x11next_business_day\x18\x03 \x01(\tR\x0fnextBusinessDay\x12%\n" +
+	"\x0ecalendar_codes\x18\x04 \x03(\tR\rcalendarCodes\"\xb7\x01\n" +
+	"\x16AddBusinessDaysRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12)\n" +
+	"\x10institution_code\x18\x03 \x01(\tR\x0finstitutionCode\x12#\n" +
+	"\rcurrency_code\x18\x04 \x01(\tR\fcurrencyCode\x12%\n" +
+	"\x0ecalendar_codes\x18\x05 \x03(\tR\rcalendarCodes\"T\n" +
+	"\x17AddBusinessDaysResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12%\n" +
+	"\x0ecalendar_codes\x18\x02 \x03(\tR\rcalendarCodes\"\xda\x01\n" +
+	"\x19NextSettlementDateRequest\x12)\n" +
+	"\x10institution_code\x18\x01 \x01(\tR\x0finstitutionCode\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12\x1d\n" +
+	"\n" +
+	"trade_date\x18\x03 \x01(\tR\ttradeDate\x12'\n" +
+	"\x0fsettlement_days\x18\x04 \x01(\x05R\x0esettlementDays\x12%\n" +
+	"\x0ecalendar_codes\x18\x05 \x03(\tR\rcalendarCodes\"l\n" +
+	"\x1aNextSettlementDateResponse\x12'\n" +
+	"\x0fsettlement_date\x18\x01 \x01(\tR\x0esettlementDate\x12%\n" +
+	"\x0ecalendar_codes\x18\x02 \x03(\tR\rcalendarCodes*9\n" +
 	"\rServiceStatus\x12\v\n" +
 	"\aHEALTHY\x10\x00\x12\f\n" +
 	"\bDEGRADED\x10\x01\x12\r\n" +
@@ -4741,7 +9929,13 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\x16CURRENCY_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
 	"\x18CURRENCY_STATUS_INACTIVE\x10\x02\x12\x1e\n" +
 	"\x1aCURRENCY_STATUS_DEPRECATED\x10\x03\x12\x1b\n" +
-	"\x17CURRENCY_STATUS_DELETED\x10\x04*\x9b\x02\n" +
+	"\x17CURRENCY_STATUS_DELETED\x10\x04*\xc8\x01\n" +
+	"\x12CurrencyChangeType\x12$\n" +
+	" CURRENCY_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCURRENCY_CHANGE_TYPE_CREATED\x10\x01\x12 \n" +
+	"\x1cCURRENCY_CHANGE_TYPE_UPDATED\x10\x02\x12$\n" +
+	" CURRENCY_CHANGE_TYPE_DEACTIVATED\x10\x03\x12\"\n" +
+	"\x1eCURRENCY_CHANGE_TYPE_SCHEDULED\x10\x04*\x9b\x02\n" +
 	"\x0fInstitutionType\x12 \n" +
 	"\x1cINSTITUTION_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15INSTITUTION_TYPE_BANK\x10\x01\x12!\n" +
@@ -4756,19 +9950,80 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\x19INSTITUTION_STATUS_ACTIVE\x10\x01\x12\x1f\n" +
 	"\x1bINSTITUTION_STATUS_INACTIVE\x10\x02\x12 \n" +
 	"\x1cINSTITUTION_STATUS_SUSPENDED\x10\x03\x12\x1e\n" +
-	"\x1aINSTITUTION_STATUS_DELETED\x10\x042R\n" +
+	"\x1aINSTITUTION_STATUS_DELETED\x10\x04*\x8d\x01\n" +
+	"\x16RoutingDirectoryFormat\x12(\n" +
+	"$ROUTING_DIRECTORY_FORMAT_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fROUTING_DIRECTORY_FORMAT_FEDACH\x10\x01\x12$\n" +
+	" ROUTING_DIRECTORY_FORMAT_FEDWIRE\x10\x02*\xc8\x02\n" +
+	"\x1aRoutingDirectoryChangeType\x12-\n" +
+	")ROUTING_DIRECTORY_CHANGE_TYPE_UNSPECIFIED\x10\x00\x124\n" +
+	"0ROUTING_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION\x10\x01\x124\n" +
+	"0ROUTING_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION\x10\x02\x124\n" +
+	"0ROUTING_DIRECTORY_CHANGE_TYPE_ADD_ROUTING_NUMBER\x10\x03\x12.\n" +
+	"*ROUTING_DIRECTORY_CHANGE_TYPE_FLAG_REMOVED\x10\x04\x12)\n" +
+	"%ROUTING_DIRECTORY_CHANGE_TYPE_RESTORE\x10\x05*w\n" +
+	"\x12BicDirectoryFormat\x12$\n" +
+	" BIC_DIRECTORY_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18BIC_DIRECTORY_FORMAT_CSV\x10\x01\x12\x1d\n" +
+	"\x19BIC_DIRECTORY_FORMAT_FLAT\x10\x02*\xa7\x01\n" +
+	"\x16BicDirectoryChangeType\x12)\n" +
+	"%BIC_DIRECTORY_CHANGE_TYPE_UNSPECIFIED\x10\x00\x120\n" +
+	",BIC_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION\x10\x01\x120\n" +
+	",BIC_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION\x10\x02*g\n" +
+	"\bRateType\x12\x19\n" +
+	"\x15RATE_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eRATE_TYPE_SPOT\x10\x01\x12\x15\n" +
+	"\x11RATE_TYPE_AVERAGE\x10\x02\x12\x15\n" +
+	"\x11RATE_TYPE_CLOSING\x10\x03*\xaa\x01\n" +
+	"\x0eRateDerivation\x12\x1f\n" +
+	"\x1bRATE_DERIVATION_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RATE_DERIVATION_IDENTITY\x10\x01\x12\x1a\n" +
+	"\x16RATE_DERIVATION_DIRECT\x10\x02\x12\x1b\n" +
+	"\x17RATE_DERIVATION_INVERSE\x10\x03\x12 \n" +
+	"\x1cRATE_DERIVATION_TRIANGULATED\x10\x04*j\n" +
+	"\x0eRateFileFormat\x12 \n" +
+	"\x1cRATE_FILE_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RATE_FILE_FORMAT_ECB_XML\x10\x01\x12\x18\n" +
+	"\x14RATE_FILE_FORMAT_CSV\x10\x02*\x90\x02\n" +
+	"\x12BankAccountPurpose\x12$\n" +
+	" BANK_ACCOUNT_PURPOSE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eBANK_ACCOUNT_PURPOSE_OPERATING\x10\x01\x12 \n" +
+	"\x1cBANK_ACCOUNT_PURPOSE_PAYROLL\x10\x02\x12$\n" +
+	" BANK_ACCOUNT_PURPOSE_COLLECTIONS\x10\x03\x12%\n" +
+	"!BANK_ACCOUNT_PURPOSE_DISBURSEMENT\x10\x04\x12 \n" +
+	"\x1cBANK_ACCOUNT_PURPOSE_RESERVE\x10\x05\x12\x1f\n" +
+	"\x1bBANK_ACCOUNT_PURPOSE_ESCROW\x10\x06*x\n" +
+	"\x11BankAccountStatus\x12#\n" +
+	"\x1fBANK_ACCOUNT_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aBANK_ACCOUNT_STATUS_ACTIVE\x10\x01\x12\x1e\n" +
+	"\x1aBANK_ACCOUNT_STATUS_CLOSED\x10\x02*\xae\x01\n" +
+	"\x0fHolidayRuleType\x12!\n" +
+	"\x1dHOLIDAY_RULE_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17HOLIDAY_RULE_TYPE_FIXED\x10\x01\x12!\n" +
+	"\x1dHOLIDAY_RULE_TYPE_NTH_WEEKDAY\x10\x02\x12\x1c\n" +
+	"\x18HOLIDAY_RULE_TYPE_EASTER\x10\x03\x12\x1a\n" +
+	"\x16HOLIDAY_RULE_TYPE_DATE\x10\x04*\xca\x01\n" +
+	"\x11HolidayObservance\x12\"\n" +
+	"\x1eHOLIDAY_OBSERVANCE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17HOLIDAY_OBSERVANCE_NONE\x10\x01\x12'\n" +
+	"#HOLIDAY_OBSERVANCE_SUNDAY_TO_MONDAY\x10\x02\x12&\n" +
+	"\"HOLIDAY_OBSERVANCE_NEAREST_WEEKDAY\x10\x03\x12#\n" +
+	"\x1fHOLIDAY_OBSERVANCE_NEXT_WEEKDAY\x10\x042R\n" +
 	"\bManifest\x12F\n" +
 	"\vGetManifest\x12\x19.treasury.ManifestRequest\x1a\x1a.treasury.ManifestResponse\"\x002\x92\x01\n" +
 	"\x06Health\x12F\n" +
 	"\vGetLiveness\x12\x19.treasury.LivenessRequest\x1a\x1a.treasury.LivenessResponse\"\x00\x12@\n" +
-	"\tGetHealth\x12\x17.treasury.HealthRequest\x1a\x18.treasury.HealthResponse\"\x002\xa4\x04\n" +
+	"\tGetHealth\x12\x17.treasury.HealthRequest\x1a\x18.treasury.HealthResponse\"\x002\xd9\x06\n" +
 	"\x0fCurrencyService\x12S\n" +
 	"\x0eCreateCurrency\x12\x1f.treasury.CreateCurrencyRequest\x1a .treasury.CreateCurrencyResponse\x12J\n" +
 	"\vGetCurrency\x12\x1c.treasury.GetCurrencyRequest\x1a\x1d.treasury.GetCurrencyResponse\x12S\n" +
 	"\x0eUpdateCurrency\x12\x1f.treasury.UpdateCurrencyRequest\x1a .treasury.UpdateCurrencyResponse\x12_\n" +
 	"\x12DeactivateCurrency\x12#.treasury.DeactivateCurrencyRequest\x1a$.treasury.DeactivateCurrencyResponse\x12S\n" +
 	"\x0eListCurrencies\x12\x1f.treasury.ListCurrenciesRequest\x1a .treasury.ListCurrenciesResponse\x12e\n" +
-	"\x14BulkCreateCurrencies\x12%.treasury.BulkCreateCurrenciesRequest\x1a&.treasury.BulkCreateCurrenciesResponse2\xcd\x05\n" +
+	"\x14BulkCreateCurrencies\x12%.treasury.BulkCreateCurrenciesRequest\x1a&.treasury.BulkCreateCurrenciesResponse\x12_\n" +
+	"\x12GetCurrencyHistory\x12#.treasury.GetCurrencyHistoryRequest\x1a$.treasury.GetCurrencyHistoryResponse\x12k\n" +
+	"\x16ScheduleCurrencyChange\x12'.treasury.ScheduleCurrencyChangeRequest\x1a(.treasury.ScheduleCurrencyChangeResponse\x12e\n" +
+	"\x14CancelCurrencyChange\x12%.treasury.CancelCurrencyChangeRequest\x1a&.treasury.CancelCurrencyChangeResponse2\x9b\a\n" +
 	"\x1bFinancialInstitutionService\x12\\\n" +
 	"\x11CreateInstitution\x12\".treasury.CreateInstitutionRequest\x1a#.treasury.CreateInstitutionResponse\x12S\n" +
 	"\x0eGetInstitution\x12\x1f.treasury.GetInstitutionRequest\x1a .treasury.GetInstitutionResponse\x12\\\n" +
@@ -4776,7 +10031,28 @@ const file_services_treasury_services_treasury_service_proto_treasury_service_pr
 	"\x11DeleteInstitution\x12\".treasury.DeleteInstitutionRequest\x1a#.treasury.DeleteInstitutionResponse\x12Y\n" +
 	"\x10ListInstitutions\x12!.treasury.ListInstitutionsRequest\x1a\".treasury.ListInstitutionsResponse\x12w\n" +
 	"\x1aCheckInstitutionReferences\x12+.treasury.CheckInstitutionReferencesRequest\x1a,.treasury.CheckInstitutionReferencesResponse\x12k\n" +
-	"\x16BulkCreateInstitutions\x12'.treasury.BulkCreateInstitutionsRequest\x1a(.treasury.BulkCreateInstitutionsResponseB)Z'example.com/go-mono-repo/proto/treasuryb\x06proto3"
+	"\x16BulkCreateInstitutions\x12'.treasury.BulkCreateInstitutionsRequest\x1a(.treasury.BulkCreateInstitutionsResponse\x12k\n" +
+	"\x16ImportRoutingDirectory\x12'.treasury.ImportRoutingDirectoryRequest\x1a(.treasury.ImportRoutingDirectoryResponse\x12_\n" +
+	"\x12ImportBicDirectory\x12#.treasury.ImportBicDirectoryRequest\x1a$.treasury.ImportBicDirectoryResponse2\xb3\x02\n" +
+	"\x13ExchangeRateService\x12J\n" +
+	"\vUpsertRates\x12\x1c.treasury.UpsertRatesRequest\x1a\x1d.treasury.UpsertRatesResponse\x12>\n" +
+	"\aGetRate\x12\x18.treasury.GetRateRequest\x1a\x19.treasury.GetRateResponse\x12D\n" +
+	"\tListRates\x12\x1a.treasury.ListRatesRequest\x1a\x1b.treasury.ListRatesResponse\x12J\n" +
+	"\vImportRates\x12\x1c.treasury.ImportRatesRequest\x1a\x1d.treasury.ImportRatesResponse2\xdb\x03\n" +
+	"\x12BankAccountService\x12\\\n" +
+	"\x11CreateBankAccount\x12\".treasury.CreateBankAccountRequest\x1a#.treasury.CreateBankAccountResponse\x12S\n" +
+	"\x0eGetBankAccount\x12\x1f.treasury.GetBankAccountRequest\x1a .treasury.GetBankAccountResponse\x12\\\n" +
+	"\x11UpdateBankAccount\x12\".treasury.UpdateBankAccountRequest\x1a#.treasury.UpdateBankAccountResponse\x12Y\n" +
+	"\x10CloseBankAccount\x12!.treasury.CloseBankAccountRequest\x1a\".treasury.CloseBankAccountResponse\x12Y\n" +
+	"\x10ListBankAccounts\x12!.treasury.ListBankAccountsRequest\x1a\".treasury.ListBankAccountsResponse2\xfc\x04\n" +
+	"\x0fCalendarService\x12S\n" +
+	"\x0eCreateCalendar\x12\x1f.treasury.CreateCalendarRequest\x1a .treasury.CreateCalendarResponse\x12J\n" +
+	"\vGetCalendar\x12\x1c.treasury.GetCalendarRequest\x1a\x1d.treasury.GetCalendarResponse\x12S\n" +
+	"\x0eUpdateCalendar\x12\x1f.treasury.UpdateCalendarRequest\x1a .treasury.UpdateCalendarResponse\x12h\n" +
+	"\x15SetSettlementCalendar\x12&.treasury.SetSettlementCalendarRequest\x1a'.treasury.SetSettlementCalendarResponse\x12P\n" +
+	"\rIsBusinessDay\x12\x1e.treasury.IsBusinessDayRequest\x1a\x1f.treasury.IsBusinessDayResponse\x12V\n" +
+	"\x0fAddBusinessDays\x12 .treasury.AddBusinessDaysRequest\x1a!.treasury.AddBusinessDaysResponse\x12_\n" +
+	"\x12NextSettlementDate\x12#.treasury.NextSettlementDateRequest\x1a$.treasury.NextSettlementDateResponseB)Z'example.com/go-mono-repo/proto/treasuryb\x06proto3"
 
 var (
 	file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescOnce sync.Once
@@ -4790,176 +10066,363 @@ func file_services_treasury_services_treasury_service_proto_treasury_service_pro
 	return file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDescData
 }
 
-var file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_services_treasury_services_treasury_service_proto_treasury_service_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
 var file_services_treasury_services_treasury_service_proto_treasury_service_proto_goTypes = []any{
 	(ServiceStatus)(0),                                   // 0: treasury.ServiceStatus
 	(DependencyType)(0),                                  // 1: treasury.DependencyType
 	(CurrencyStatus)(0),                                  // 2: treasury.CurrencyStatus
-	(InstitutionType)(0),                                 // 3: treasury.InstitutionType
-	(InstitutionStatus)(0),                               // 4: treasury.InstitutionStatus
-	(*ManifestRequest)(nil),                              // 5: treasury.ManifestRequest
-	(*ManifestResponse)(nil),                             // 6: treasury.ManifestResponse
-	(*ServiceIdentity)(nil),                              // 7: treasury.ServiceIdentity
-	(*BuildInfo)(nil),                                    // 8: treasury.BuildInfo
-	(*RuntimeInfo)(nil),                                  // 9: treasury.RuntimeInfo
-	(*ServiceMetadata)(nil),                              // 10: treasury.ServiceMetadata
-	(*ServiceCapabilities)(nil),                          // 11: treasury.ServiceCapabilities
-	(*ServiceDependency)(nil),                            // 12: treasury.ServiceDependency
-	(*LivenessRequest)(nil),                              // 13: treasury.LivenessRequest
-	(*LivenessResponse)(nil),                             // 14: treasury.LivenessResponse
-	(*HealthRequest)(nil),                                // 15: treasury.HealthRequest
-	(*HealthResponse)(nil),                               // 16: treasury.HealthResponse
-	(*ComponentCheck)(nil),                               // 17: treasury.ComponentCheck
-	(*LivenessInfo)(nil),                                 // 18: treasury.LivenessInfo
-	(*DependencyHealth)(nil),                             // 19: treasury.DependencyHealth
-	(*DependencyConfig)(nil),                             // 20: treasury.DependencyConfig
-	(*ConnectionPoolInfo)(nil),                           // 21: treasury.ConnectionPoolInfo
-	(*Currency)(nil),                                     // 22: treasury.Currency
-	(*CreateCurrencyRequest)(nil),                        // 23: treasury.CreateCurrencyRequest
-	(*CreateCurrencyResponse)(nil),                       // 24: treasury.CreateCurrencyResponse
-	(*GetCurrencyRequest)(nil),                           // 25: treasury.GetCurrencyRequest
-	(*GetCurrencyResponse)(nil),                          // 26: treasury.GetCurrencyResponse
-	(*UpdateCurrencyRequest)(nil),                        // 27: treasury.UpdateCurrencyRequest
-	(*UpdateCurrencyResponse)(nil),                       // 28: treasury.UpdateCurrencyResponse
-	(*DeactivateCurrencyRequest)(nil),                    // 29: treasury.DeactivateCurrencyRequest
-	(*DeactivateCurrencyResponse)(nil),                   // 30: treasury.DeactivateCurrencyResponse
-	(*ListCurrenciesRequest)(nil),                        // 31: treasury.ListCurrenciesRequest
-	(*ListCurrenciesResponse)(nil),                       // 32: treasury.ListCurrenciesResponse
-	(*BulkCreateCurrenciesRequest)(nil),                  // 33: treasury.BulkCreateCurrenciesRequest
-	(*BulkCreateCurrenciesResponse)(nil),                 // 34: treasury.BulkCreateCurrenciesResponse
-	(*RoutingNumber)(nil),                                // 35: treasury.RoutingNumber
-	(*FinancialInstitution)(nil),                         // 36: treasury.FinancialInstitution
-	(*Address)(nil),                                      // 37: treasury.Address
-	(*ContactInfo)(nil),                                  // 38: treasury.ContactInfo
-	(*CreateInstitutionRequest)(nil),                     // 39: treasury.CreateInstitutionRequest
-	(*CreateInstitutionResponse)(nil),                    // 40: treasury.CreateInstitutionResponse
-	(*GetInstitutionRequest)(nil),                        // 41: treasury.GetInstitutionRequest
-	(*GetInstitutionResponse)(nil),                       // 42: treasury.GetInstitutionResponse
-	(*UpdateInstitutionRequest)(nil),                     // 43: treasury.UpdateInstitutionRequest
-	(*UpdateInstitutionResponse)(nil),                    // 44: treasury.UpdateInstitutionResponse
-	(*DeleteInstitutionRequest)(nil),                     // 45: treasury.DeleteInstitutionRequest
-	(*DeleteInstitutionResponse)(nil),                    // 46: treasury.DeleteInstitutionResponse
-	(*ListInstitutionsRequest)(nil),                      // 47: treasury.ListInstitutionsRequest
-	(*ListInstitutionsResponse)(nil),                     // 48: treasury.ListInstitutionsResponse
-	(*CheckInstitutionReferencesRequest)(nil),            // 49: treasury.CheckInstitutionReferencesRequest
-	(*CheckInstitutionReferencesResponse)(nil),           // 50: treasury.CheckInstitutionReferencesResponse
-	(*BulkCreateInstitutionsRequest)(nil),                // 51: treasury.BulkCreateInstitutionsRequest
-	(*BulkCreateInstitutionsResponse)(nil),               // 52: treasury.BulkCreateInstitutionsResponse
-	nil,                                                  // 53: treasury.ServiceMetadata.LabelsEntry
-	nil,                                                  // 54: treasury.DependencyConfig.MetadataEntry
-	(*CreateInstitutionRequest_RoutingNumberInput)(nil),  // 55: treasury.CreateInstitutionRequest.RoutingNumberInput
-	(*UpdateInstitutionRequest_RoutingNumberUpdate)(nil), // 56: treasury.UpdateInstitutionRequest.RoutingNumberUpdate
-	(*CheckInstitutionReferencesResponse_Reference)(nil), // 57: treasury.CheckInstitutionReferencesResponse.Reference
-	(*timestamppb.Timestamp)(nil),                        // 58: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),                        // 59: google.protobuf.FieldMask
-	(*structpb.Struct)(nil),                              // 60: google.protobuf.Struct
+	(CurrencyChangeType)(0),                              // 3: treasury.CurrencyChangeType
+	(InstitutionType)(0),                                 // 4: treasury.InstitutionType
+	(InstitutionStatus)(0),                               // 5: treasury.InstitutionStatus
+	(RoutingDirectoryFormat)(0),                          // 6: treasury.RoutingDirectoryFormat
+	(RoutingDirectoryChangeType)(0),                      // 7: treasury.RoutingDirectoryChangeType
+	(BicDirectoryFormat)(0),                              // 8: treasury.BicDirectoryFormat
+	(BicDirectoryChangeType)(0),                          // 9: treasury.BicDirectoryChangeType
+	(RateType)(0),                                        // 10: treasury.RateType
+	(RateDerivation)(0),                                  // 11: treasury.RateDerivation
+	(RateFileFormat)(0),                                  // 12: treasury.RateFileFormat
+	(BankAccountPurpose)(0),                              // 13: treasury.BankAccountPurpose
+	(BankAccountStatus)(0),                               // 14: treasury.BankAccountStatus
+	(HolidayRuleType)(0),                                 // 15: treasury.HolidayRuleType
+	(HolidayObservance)(0),                               // 16: treasury.HolidayObservance
+	(*ManifestRequest)(nil),                              // 17: treasury.ManifestRequest
+	(*ManifestResponse)(nil),                             // 18: treasury.ManifestResponse
+	(*ServiceIdentity)(nil),                              // 19: treasury.ServiceIdentity
+	(*BuildInfo)(nil),                                    // 20: treasury.BuildInfo
+	(*RuntimeInfo)(nil),                                  // 21: treasury.RuntimeInfo
+	(*ServiceMetadata)(nil),                              // 22: treasury.ServiceMetadata
+	(*ServiceCapabilities)(nil),                          // 23: treasury.ServiceCapabilities
+	(*ServiceDependency)(nil),                            // 24: treasury.ServiceDependency
+	(*LivenessRequest)(nil),                              // 25: treasury.LivenessRequest
+	(*LivenessResponse)(nil),                             // 26: treasury.LivenessResponse
+	(*HealthRequest)(nil),                                // 27: treasury.HealthRequest
+	(*HealthResponse)(nil),                               // 28: treasury.HealthResponse
+	(*ComponentCheck)(nil),                               // 29: treasury.ComponentCheck
+	(*LivenessInfo)(nil),                                 // 30: treasury.LivenessInfo
+	(*DependencyHealth)(nil),                             // 31: treasury.DependencyHealth
+	(*DependencyConfig)(nil),                             // 32: treasury.DependencyConfig
+	(*ConnectionPoolInfo)(nil),                           // 33: treasury.ConnectionPoolInfo
+	(*Currency)(nil),                                     // 34: treasury.Currency
+	(*CreateCurrencyRequest)(nil),                        // 35: treasury.CreateCurrencyRequest
+	(*CreateCurrencyResponse)(nil),                       // 36: treasury.CreateCurrencyResponse
+	(*GetCurrencyRequest)(nil),                           // 37: treasury.GetCurrencyRequest
+	(*GetCurrencyResponse)(nil),                          // 38: treasury.GetCurrencyResponse
+	(*UpdateCurrencyRequest)(nil),                        // 39: treasury.UpdateCurrencyRequest
+	(*UpdateCurrencyResponse)(nil),                       // 40: treasury.UpdateCurrencyResponse
+	(*DeactivateCurrencyRequest)(nil),                    // 41: treasury.DeactivateCurrencyRequest
+	(*DeactivateCurrencyResponse)(nil),                   // 42: treasury.DeactivateCurrencyResponse
+	(*ListCurrenciesRequest)(nil),                        // 43: treasury.ListCurrenciesRequest
+	(*ListCurrenciesResponse)(nil),                       // 44: treasury.ListCurrenciesResponse
+	(*BulkCreateCurrenciesRequest)(nil),                  // 45: treasury.BulkCreateCurrenciesRequest
+	(*BulkCreateCurrenciesResponse)(nil),                 // 46: treasury.BulkCreateCurrenciesResponse
+	(*CurrencyVersion)(nil),                              // 47: treasury.CurrencyVersion
+	(*CurrencyChange)(nil),                               // 48: treasury.CurrencyChange
+	(*GetCurrencyHistoryRequest)(nil),                    // 49: treasury.GetCurrencyHistoryRequest
+	(*GetCurrencyHistoryResponse)(nil),                   // 50: treasury.GetCurrencyHistoryResponse
+	(*ScheduleCurrencyChangeRequest)(nil),                // 51: treasury.ScheduleCurrencyChangeRequest
+	(*ScheduleCurrencyChangeResponse)(nil),               // 52: treasury.ScheduleCurrencyChangeResponse
+	(*CancelCurrencyChangeRequest)(nil),                  // 53: treasury.CancelCurrencyChangeRequest
+	(*CancelCurrencyChangeResponse)(nil),                 // 54: treasury.CancelCurrencyChangeResponse
+	(*RoutingNumber)(nil),                                // 55: treasury.RoutingNumber
+	(*FinancialInstitution)(nil),                         // 56: treasury.FinancialInstitution
+	(*Address)(nil),                                      // 57: treasury.Address
+	(*ContactInfo)(nil),                                  // 58: treasury.ContactInfo
+	(*CreateInstitutionRequest)(nil),                     // 59: treasury.CreateInstitutionRequest
+	(*CreateInstitutionResponse)(nil),                    // 60: treasury.CreateInstitutionResponse
+	(*GetInstitutionRequest)(nil),                        // 61: treasury.GetInstitutionRequest
+	(*GetInstitutionResponse)(nil),                       // 62: treasury.GetInstitutionResponse
+	(*UpdateInstitutionRequest)(nil),                     // 63: treasury.UpdateInstitutionRequest
+	(*UpdateInstitutionResponse)(nil),                    // 64: treasury.UpdateInstitutionResponse
+	(*DeleteInstitutionRequest)(nil),                     // 65: treasury.DeleteInstitutionRequest
+	(*DeleteInstitutionResponse)(nil),                    // 66: treasury.DeleteInstitutionResponse
+	(*ListInstitutionsRequest)(nil),                      // 67: treasury.ListInstitutionsRequest
+	(*ListInstitutionsResponse)(nil),                     // 68: treasury.ListInstitutionsResponse
+	(*CheckInstitutionReferencesRequest)(nil),            // 69: treasury.CheckInstitutionReferencesRequest
+	(*CheckInstitutionReferencesResponse)(nil),           // 70: treasury.CheckInstitutionReferencesResponse
+	(*BulkCreateInstitutionsRequest)(nil),                // 71: treasury.BulkCreateInstitutionsRequest
+	(*BulkCreateInstitutionsResponse)(nil),               // 72: treasury.BulkCreateInstitutionsResponse
+	(*ImportRoutingDirectoryRequest)(nil),                // 73: treasury.ImportRoutingDirectoryRequest
+	(*RoutingDirectoryChange)(nil),                       // 74: treasury.RoutingDirectoryChange
+	(*ImportRoutingDirectoryResponse)(nil),               // 75: treasury.ImportRoutingDirectoryResponse
+	(*ImportBicDirectoryRequest)(nil),                    // 76: treasury.ImportBicDirectoryRequest
+	(*BicDirectoryChange)(nil),                           // 77: treasury.BicDirectoryChange
+	(*ImportBicDirectoryResponse)(nil),                   // 78: treasury.ImportBicDirectoryResponse
+	(*ExchangeRate)(nil),                                 // 79: treasury.ExchangeRate
+	(*ExchangeRateInput)(nil),                            // 80: treasury.ExchangeRateInput
+	(*UpsertRatesRequest)(nil),                           // 81: treasury.UpsertRatesRequest
+	(*UpsertRatesResponse)(nil),                          // 82: treasury.UpsertRatesResponse
+	(*GetRateRequest)(nil),                               // 83: treasury.GetRateRequest
+	(*GetRateResponse)(nil),                              // 84: treasury.GetRateResponse
+	(*ListRatesRequest)(nil),                             // 85: treasury.ListRatesRequest
+	(*ListRatesResponse)(nil),                            // 86: treasury.ListRatesResponse
+	(*ImportRatesRequest)(nil),                           // 87: treasury.ImportRatesRequest
+	(*ImportRatesResponse)(nil),                          // 88: treasury.ImportRatesResponse
+	(*BankAccount)(nil),                                  // 89: treasury.BankAccount
+	(*Signatory)(nil),                                    // 90: treasury.Signatory
+	(*CreateBankAccountRequest)(nil),                     // 91: treasury.CreateBankAccountRequest
+	(*CreateBankAccountResponse)(nil),                    // 92: treasury.CreateBankAccountResponse
+	(*GetBankAccountRequest)(nil),                        // 93: treasury.GetBankAccountRequest
+	(*GetBankAccountResponse)(nil),                       // 94: treasury.GetBankAccountResponse
+	(*UpdateBankAccountRequest)(nil),                     // 95: treasury.UpdateBankAccountRequest
+	(*UpdateBankAccountResponse)(nil),                    // 96: treasury.UpdateBankAccountResponse
+	(*CloseBankAccountRequest)(nil),                      // 97: treasury.CloseBankAccountRequest
+	(*CloseBankAccountResponse)(nil),                     // 98: treasury.CloseBankAccountResponse
+	(*ListBankAccountsRequest)(nil),                      // 99: treasury.ListBankAccountsRequest
+	(*ListBankAccountsResponse)(nil),                     // 100: treasury.ListBankAccountsResponse
+	(*HolidayRule)(nil),                                  // 101: treasury.HolidayRule
+	(*HolidayCalendar)(nil),                              // 102: treasury.HolidayCalendar
+	(*Holiday)(nil),                                      // 103: treasury.Holiday
+	(*CreateCalendarRequest)(nil),                        // 104: treasury.CreateCalendarRequest
+	(*CreateCalendarResponse)(nil),                       // 105: treasury.CreateCalendarResponse
+	(*GetCalendarRequest)(nil),                           // 106: treasury.GetCalendarRequest
+	(*GetCalendarResponse)(nil),                          // 107: treasury.GetCalendarResponse
+	(*UpdateCalendarRequest)(nil),                        // 108: treasury.UpdateCalendarRequest
+	(*UpdateCalendarResponse)(nil),                       // 109: treasury.UpdateCalendarResponse
+	(*SetSettlementCalendarRequest)(nil),                 // 110: treasury.SetSettlementCalendarRequest
+	(*SetSettlementCalendarResponse)(nil),                // 111: treasury.SetSettlementCalendarResponse
+	(*IsBusinessDayRequest)(nil),                         // 112: treasury.IsBusinessDayRequest
+	(*IsBusinessDayResponse)(nil),                        // 113: treasury.IsBusinessDayResponse
+	(*AddBusinessDaysRequest)(nil),                       // 114: treasury.AddBusinessDaysRequest
+	(*AddBusinessDaysResponse)(nil),                      // 115: treasury.AddBusinessDaysResponse
+	(*NextSettlementDateRequest)(nil),                    // 116: treasury.NextSettlementDateRequest
+	(*NextSettlementDateResponse)(nil),                   // 117: treasury.NextSettlementDateResponse
+	nil,                                                  // 118: treasury.ServiceMetadata.LabelsEntry
+	nil,                                                  // 119: treasury.DependencyConfig.MetadataEntry
+	(*CreateInstitutionRequest_RoutingNumberInput)(nil),  // 120: treasury.CreateInstitutionRequest.RoutingNumberInput
+	(*UpdateInstitutionRequest_RoutingNumberUpdate)(nil), // 121: treasury.UpdateInstitutionRequest.RoutingNumberUpdate
+	(*CheckInstitutionReferencesResponse_Reference)(nil), // 122: treasury.CheckInstitutionReferencesResponse.Reference
+	(*timestamppb.Timestamp)(nil),                        // 123: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                        // 124: google.protobuf.FieldMask
+	(*structpb.Struct)(nil),                              // 125: google.protobuf.Struct
 }
 var file_services_treasury_services_treasury_service_proto_treasury_service_proto_depIdxs = []int32{
-	7,  // 0: treasury.ManifestResponse.identity:type_name -> treasury.ServiceIdentity
-	8,  // 1: treasury.ManifestResponse.build_info:type_name -> treasury.BuildInfo
-	9,  // 2: treasury.ManifestResponse.runtime_info:type_name -> treasury.RuntimeInfo
-	10, // 3: treasury.ManifestResponse.metadata:type_name -> treasury.ServiceMetadata
-	11, // 4: treasury.ManifestResponse.capabilities:type_name -> treasury.ServiceCapabilities
-	53, // 5: treasury.ServiceMetadata.labels:type_name -> treasury.ServiceMetadata.LabelsEntry
-	12, // 6: treasury.ServiceCapabilities.dependencies:type_name -> treasury.ServiceDependency
-	0,  // 7: treasury.LivenessResponse.status:type_name -> treasury.ServiceStatus
-	17, // 8: treasury.LivenessResponse.checks:type_name -> treasury.ComponentCheck
-	0,  // 9: treasury.HealthResponse.status:type_name -> treasury.ServiceStatus
-	18, // 10: treasury.HealthResponse.liveness:type_name -> treasury.LivenessInfo
-	19, // 11: treasury.HealthResponse.dependencies:type_name -> treasury.DependencyHealth
-	17, // 12: treasury.LivenessInfo.components:type_name -> treasury.ComponentCheck
-	1,  // 13: treasury.DependencyHealth.type:type_name -> treasury.DependencyType
-	0,  // 14: treasury.DependencyHealth.status:type_name -> treasury.ServiceStatus
-	20, // 15: treasury.DependencyHealth.config:type_name -> treasury.DependencyConfig
-	21, // 16: treasury.DependencyConfig.pool_info:type_name -> treasury.ConnectionPoolInfo
-	54, // 17: treasury.DependencyConfig.metadata:type_name -> treasury.DependencyConfig.MetadataEntry
-	2,  // 18: treasury.Currency.status:type_name -> treasury.CurrencyStatus
-	58, // 19: treasury.Currency.activated_at:type_name -> google.protobuf.Timestamp
-	58, // 20: treasury.Currency.deactivated_at:type_name -> google.protobuf.Timestamp
-	58, // 21: treasury.Currency.created_at:type_name -> google.protobuf.Timestamp
-	58, // 22: treasury.Currency.updated_at:type_name -> google.protobuf.Timestamp
-	22, // 23: treasury.CreateCurrencyResponse.currency:type_name -> treasury.Currency
-	22, // 24: treasury.GetCurrencyResponse.currency:type_name -> treasury.Currency
-	59, // 25: treasury.UpdateCurrencyRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 26: treasury.UpdateCurrencyRequest.status:type_name -> treasury.CurrencyStatus
-	22, // 27: treasury.UpdateCurrencyResponse.currency:type_name -> treasury.Currency
-	2,  // 28: treasury.DeactivateCurrencyRequest.status:type_name -> treasury.CurrencyStatus
-	22, // 29: treasury.DeactivateCurrencyResponse.currency:type_name -> treasury.Currency
-	2,  // 30: treasury.ListCurrenciesRequest.status:type_name -> treasury.CurrencyStatus
-	22, // 31: treasury.ListCurrenciesResponse.currencies:type_name -> treasury.Currency
-	23, // 32: treasury.BulkCreateCurrenciesRequest.currencies:type_name -> treasury.CreateCurrencyRequest
-	58, // 33: treasury.RoutingNumber.created_at:type_name -> google.protobuf.Timestamp
-	58, // 34: treasury.RoutingNumber.updated_at:type_name -> google.protobuf.Timestamp
-	35, // 35: treasury.FinancialInstitution.routing_numbers:type_name -> treasury.RoutingNumber
-	3,  // 36: treasury.FinancialInstitution.institution_type:type_name -> treasury.InstitutionType
-	37, // 37: treasury.FinancialInstitution.address:type_name -> treasury.Address
-	38, // 38: treasury.FinancialInstitution.contact:type_name -> treasury.ContactInfo
-	60, // 39: treasury.FinancialInstitution.business_hours:type_name -> google.protobuf.Struct
-	60, // 40: treasury.FinancialInstitution.licenses:type_name -> google.protobuf.Struct
-	4,  // 41: treasury.FinancialInstitution.status:type_name -> treasury.InstitutionStatus
-	58, // 42: treasury.FinancialInstitution.activated_at:type_name -> google.protobuf.Timestamp
-	58, // 43: treasury.FinancialInstitution.deactivated_at:type_name -> google.protobuf.Timestamp
-	60, // 44: treasury.FinancialInstitution.capabilities:type_name -> google.protobuf.Struct
-	60, // 45: treasury.FinancialInstitution.external_references:type_name -> google.protobuf.Struct
-	58, // 46: treasury.FinancialInstitution.created_at:type_name -> google.protobuf.Timestamp
-	58, // 47: treasury.FinancialInstitution.updated_at:type_name -> google.protobuf.Timestamp
-	55, // 48: treasury.CreateInstitutionRequest.routing_numbers:type_name -> treasury.CreateInstitutionRequest.RoutingNumberInput
-	3,  // 49: treasury.CreateInstitutionRequest.institution_type:type_name -> treasury.InstitutionType
-	37, // 50: treasury.CreateInstitutionRequest.address:type_name -> treasury.Address
-	38, // 51: treasury.CreateInstitutionRequest.contact:type_name -> treasury.ContactInfo
-	60, // 52: treasury.CreateInstitutionRequest.capabilities:type_name -> google.protobuf.Struct
-	36, // 53: treasury.CreateInstitutionResponse.institution:type_name -> treasury.FinancialInstitution
-	36, // 54: treasury.GetInstitutionResponse.institution:type_name -> treasury.FinancialInstitution
-	59, // 55: treasury.UpdateInstitutionRequest.update_mask:type_name -> google.protobuf.FieldMask
-	56, // 56: treasury.UpdateInstitutionRequest.routing_numbers:type_name -> treasury.UpdateInstitutionRequest.RoutingNumberUpdate
-	37, // 57: treasury.UpdateInstitutionRequest.address:type_name -> treasury.Address
-	38, // 58: treasury.UpdateInstitutionRequest.contact:type_name -> treasury.ContactInfo
-	4,  // 59: treasury.UpdateInstitutionRequest.status:type_name -> treasury.InstitutionStatus
-	60, // 60: treasury.UpdateInstitutionRequest.capabilities:type_name -> google.protobuf.Struct
-	36, // 61: treasury.UpdateInstitutionResponse.institution:type_name -> treasury.FinancialInstitution
-	4,  // 62: treasury.ListInstitutionsRequest.status:type_name -> treasury.InstitutionStatus
-	3,  // 63: treasury.ListInstitutionsRequest.institution_type:type_name -> treasury.InstitutionType
-	36, // 64: treasury.ListInstitutionsResponse.institutions:type_name -> treasury.FinancialInstitution
-	57, // 65: treasury.CheckInstitutionReferencesResponse.references:type_name -> treasury.CheckInstitutionReferencesResponse.Reference
-	39, // 66: treasury.BulkCreateInstitutionsRequest.institutions:type_name -> treasury.CreateInstitutionRequest
-	5,  // 67: treasury.Manifest.GetManifest:input_type -> treasury.ManifestRequest
-	13, // 68: treasury.Health.GetLiveness:input_type -> treasury.LivenessRequest
-	15, // 69: treasury.Health.GetHealth:input_type -> treasury.HealthRequest
-	23, // 70: treasury.CurrencyService.CreateCurrency:input_type -> treasury.CreateCurrencyRequest
-	25, // 71: treasury.CurrencyService.GetCurrency:input_type -> treasury.GetCurrencyRequest
-	27, // 72: treasury.CurrencyService.UpdateCurrency:input_type -> treasury.UpdateCurrencyRequest
-	29, // 73: treasury.CurrencyService.DeactivateCurrency:input_type -> treasury.DeactivateCurrencyRequest
-	31, // 74: treasury.CurrencyService.ListCurrencies:input_type -> treasury.ListCurrenciesRequest
-	33, // 75: treasury.CurrencyService.BulkCreateCurrencies:input_type -> treasury.BulkCreateCurrenciesRequest
-	39, // 76: treasury.FinancialInstitutionService.CreateInstitution:input_type -> treasury.CreateInstitutionRequest
-	41, // 77: treasury.FinancialInstitutionService.GetInstitution:input_type -> treasury.GetInstitutionRequest
-	43, // 78: treasury.FinancialInstitutionService.UpdateInstitution:input_type -> treasury.UpdateInstitutionRequest
-	45, // 79: treasury.FinancialInstitutionService.DeleteInstitution:input_type -> treasury.DeleteInstitutionRequest
-	47, // 80: treasury.FinancialInstitutionService.ListInstitutions:input_type -> treasury.ListInstitutionsRequest
-	49, // 81: treasury.FinancialInstitutionService.CheckInstitutionReferences:input_type -> treasury.CheckInstitutionReferencesRequest
-	51, // 82: treasury.FinancialInstitutionService.BulkCreateInstitutions:input_type -> treasury.BulkCreateInstitutionsRequest
-	6,  // 83: treasury.Manifest.GetManifest:output_type -> treasury.ManifestResponse
-	14, // 84: treasury.Health.GetLiveness:output_type -> treasury.LivenessResponse
-	16, // 85: treasury.Health.GetHealth:output_type -> treasury.HealthResponse
-	24, // 86: treasury.CurrencyService.CreateCurrency:output_type -> treasury.CreateCurrencyResponse
-	26, // 87: treasury.CurrencyService.GetCurrency:output_type -> treasury.GetCurrencyResponse
-	28, // 88: treasury.CurrencyService.UpdateCurrency:output_type -> treasury.UpdateCurrencyResponse
-	30, // 89: treasury.CurrencyService.DeactivateCurrency:output_type -> treasury.DeactivateCurrencyResponse
-	32, // 90: treasury.CurrencyService.ListCurrencies:output_type -> treasury.ListCurrenciesResponse
-	34, // 91: treasury.CurrencyService.BulkCreateCurrencies:output_type -> treasury.BulkCreateCurrenciesResponse
-	40, // 92: treasury.FinancialInstitutionService.CreateInstitution:output_type -> treasury.CreateInstitutionResponse
-	42, // 93: treasury.FinancialInstitutionService.GetInstitution:output_type -> treasury.GetInstitutionResponse
-	44, // 94: treasury.FinancialInstitutionService.UpdateInstitution:output_type -> treasury.UpdateInstitutionResponse
-	46, // 95: treasury.FinancialInstitutionService.DeleteInstitution:output_type -> treasury.DeleteInstitutionResponse
-	48, // 96: treasury.FinancialInstitutionService.ListInstitutions:output_type -> treasury.ListInstitutionsResponse
-	50, // 97: treasury.FinancialInstitutionService.CheckInstitutionReferences:output_type -> treasury.CheckInstitutionReferencesResponse
-	52, // 98: treasury.FinancialInstitutionService.BulkCreateInstitutions:output_type -> treasury.BulkCreateInstitutionsResponse
-	83, // [83:99] is the sub-list for method output_type
-	67, // [67:83] is the sub-list for method input_type
-	67, // [67:67] is the sub-list for extension type_name
-	67, // [67:67] is the sub-list for extension extendee
-	0,  // [0:67] is the sub-list for field type_name
+	19,  // 0: treasury.ManifestResponse.identity:type_name -> treasury.ServiceIdentity
+	20,  // 1: treasury.ManifestResponse.build_info:type_name -> treasury.BuildInfo
+	21,  // 2: treasury.ManifestResponse.runtime_info:type_name -> treasury.RuntimeInfo
+	22,  // 3: treasury.ManifestResponse.metadata:type_name -> treasury.ServiceMetadata
+	23,  // 4: treasury.ManifestResponse.capabilities:type_name -> treasury.ServiceCapabilities
+	118, // 5: treasury.ServiceMetadata.labels:type_name -> treasury.ServiceMetadata.LabelsEntry
+	24,  // 6: treasury.ServiceCapabilities.dependencies:type_name -> treasury.ServiceDependency
+	0,   // 7: treasury.LivenessResponse.status:type_name -> treasury.ServiceStatus
+	29,  // 8: treasury.LivenessResponse.checks:type_name -> treasury.ComponentCheck
+	0,   // 9: treasury.HealthResponse.status:type_name -> treasury.ServiceStatus
+	30,  // 10: treasury.HealthResponse.liveness:type_name -> treasury.LivenessInfo
+	31,  // 11: treasury.HealthResponse.dependencies:type_name -> treasury.DependencyHealth
+	29,  // 12: treasury.LivenessInfo.components:type_name -> treasury.ComponentCheck
+	1,   // 13: treasury.DependencyHealth.type:type_name -> treasury.DependencyType
+	0,   // 14: treasury.DependencyHealth.status:type_name -> treasury.ServiceStatus
+	32,  // 15: treasury.DependencyHealth.config:type_name -> treasury.DependencyConfig
+	33,  // 16: treasury.DependencyConfig.pool_info:type_name -> treasury.ConnectionPoolInfo
+	119, // 17: treasury.DependencyConfig.metadata:type_name -> treasury.DependencyConfig.MetadataEntry
+	2,   // 18: treasury.Currency.status:type_name -> treasury.CurrencyStatus
+	123, // 19: treasury.Currency.activated_at:type_name -> google.protobuf.Timestamp
+	123, // 20: treasury.Currency.deactivated_at:type_name -> google.protobuf.Timestamp
+	123, // 21: treasury.Currency.created_at:type_name -> google.protobuf.Timestamp
+	123, // 22: treasury.Currency.updated_at:type_name -> google.protobuf.Timestamp
+	34,  // 23: treasury.CreateCurrencyResponse.currency:type_name -> treasury.Currency
+	123, // 24: treasury.GetCurrencyRequest.as_of:type_name -> google.protobuf.Timestamp
+	34,  // 25: treasury.GetCurrencyResponse.currency:type_name -> treasury.Currency
+	124, // 26: treasury.UpdateCurrencyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,   // 27: treasury.UpdateCurrencyRequest.status:type_name -> treasury.CurrencyStatus
+	34,  // 28: treasury.UpdateCurrencyResponse.currency:type_name -> treasury.Currency
+	2,   // 29: treasury.DeactivateCurrencyRequest.status:type_name -> treasury.CurrencyStatus
+	34,  // 30: treasury.DeactivateCurrencyResponse.currency:type_name -> treasury.Currency
+	2,   // 31: treasury.ListCurrenciesRequest.status:type_name -> treasury.CurrencyStatus
+	34,  // 32: treasury.ListCurrenciesResponse.currencies:type_name -> treasury.Currency
+	35,  // 33: treasury.BulkCreateCurrenciesRequest.currencies:type_name -> treasury.CreateCurrencyRequest
+	34,  // 34: treasury.CurrencyVersion.currency:type_name -> treasury.Currency
+	3,   // 35: treasury.CurrencyVersion.change_type:type_name -> treasury.CurrencyChangeType
+	123, // 36: treasury.CurrencyVersion.valid_from:type_name -> google.protobuf.Timestamp
+	123, // 37: treasury.CurrencyVersion.valid_to:type_name -> google.protobuf.Timestamp
+	123, // 38: treasury.CurrencyVersion.changed_at:type_name -> google.protobuf.Timestamp
+	123, // 39: treasury.CurrencyChange.effective_at:type_name -> google.protobuf.Timestamp
+	124, // 40: treasury.CurrencyChange.update_mask:type_name -> google.protobuf.FieldMask
+	2,   // 41: treasury.CurrencyChange.status:type_name -> treasury.CurrencyStatus
+	123, // 42: treasury.CurrencyChange.created_at:type_name -> google.protobuf.Timestamp
+	123, // 43: treasury.CurrencyChange.applied_at:type_name -> google.protobuf.Timestamp
+	123, // 44: treasury.CurrencyChange.cancelled_at:type_name -> google.protobuf.Timestamp
+	47,  // 45: treasury.GetCurrencyHistoryResponse.versions:type_name -> treasury.CurrencyVersion
+	48,  // 46: treasury.GetCurrencyHistoryResponse.pending_changes:type_name -> treasury.CurrencyChange
+	123, // 47: treasury.ScheduleCurrencyChangeRequest.effective_at:type_name -> google.protobuf.Timestamp
+	124, // 48: treasury.ScheduleCurrencyChangeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,   // 49: treasury.ScheduleCurrencyChangeRequest.status:type_name -> treasury.CurrencyStatus
+	48,  // 50: treasury.ScheduleCurrencyChangeResponse.change:type_name -> treasury.CurrencyChange
+	48,  // 51: treasury.CancelCurrencyChangeResponse.change:type_name -> treasury.CurrencyChange
+	123, // 52: treasury.RoutingNumber.created_at:type_name -> google.protobuf.Timestamp
+	123, // 53: treasury.RoutingNumber.updated_at:type_name -> google.protobuf.Timestamp
+	123, // 54: treasury.RoutingNumber.removed_from_directory_at:type_name -> google.protobuf.Timestamp
+	55,  // 55: treasury.FinancialInstitution.routing_numbers:type_name -> treasury.RoutingNumber
+	4,   // 56: treasury.FinancialInstitution.institution_type:type_name -> treasury.InstitutionType
+	57,  // 57: treasury.FinancialInstitution.address:type_name -> treasury.Address
+	58,  // 58: treasury.FinancialInstitution.contact:type_name -> treasury.ContactInfo
+	125, // 59: treasury.FinancialInstitution.business_hours:type_name -> google.protobuf.Struct
+	125, // 60: treasury.FinancialInstitution.licenses:type_name -> google.protobuf.Struct
+	5,   // 61: treasury.FinancialInstitution.status:type_name -> treasury.InstitutionStatus
+	123, // 62: treasury.FinancialInstitution.activated_at:type_name -> google.protobuf.Timestamp
+	123, // 63: treasury.FinancialInstitution.deactivated_at:type_name -> google.protobuf.Timestamp
+	125, // 64: treasury.FinancialInstitution.capabilities:type_name -> google.protobuf.Struct
+	125, // 65: treasury.FinancialInstitution.external_references:type_name -> google.protobuf.Struct
+	123, // 66: treasury.FinancialInstitution.created_at:type_name -> google.protobuf.Timestamp
+	123, // 67: treasury.FinancialInstitution.updated_at:type_name -> google.protobuf.Timestamp
+	120, // 68: treasury.CreateInstitutionRequest.routing_numbers:type_name -> treasury.CreateInstitutionRequest.RoutingNumberInput
+	4,   // 69: treasury.CreateInstitutionRequest.institution_type:type_name -> treasury.InstitutionType
+	57,  // 70: treasury.CreateInstitutionRequest.address:type_name -> treasury.Address
+	58,  // 71: treasury.CreateInstitutionRequest.contact:type_name -> treasury.ContactInfo
+	125, // 72: treasury.CreateInstitutionRequest.capabilities:type_name -> google.protobuf.Struct
+	56,  // 73: treasury.CreateInstitutionResponse.institution:type_name -> treasury.FinancialInstitution
+	56,  // 74: treasury.GetInstitutionResponse.institution:type_name -> treasury.FinancialInstitution
+	124, // 75: treasury.UpdateInstitutionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	121, // 76: treasury.UpdateInstitutionRequest.routing_numbers:type_name -> treasury.UpdateInstitutionRequest.RoutingNumberUpdate
+	57,  // 77: treasury.UpdateInstitutionRequest.address:type_name -> treasury.Address
+	58,  // 78: treasury.UpdateInstitutionRequest.contact:type_name -> treasury.ContactInfo
+	5,   // 79: treasury.UpdateInstitutionRequest.status:type_name -> treasury.InstitutionStatus
+	125, // 80: treasury.UpdateInstitutionRequest.capabilities:type_name -> google.protobuf.Struct
+	56,  // 81: treasury.UpdateInstitutionResponse.institution:type_name -> treasury.FinancialInstitution
+	5,   // 82: treasury.ListInstitutionsRequest.status:type_name -> treasury.InstitutionStatus
+	4,   // 83: treasury.ListInstitutionsRequest.institution_type:type_name -> treasury.InstitutionType
+	56,  // 84: treasury.ListInstitutionsResponse.institutions:type_name -> treasury.FinancialInstitution
+	122, // 85: treasury.CheckInstitutionReferencesResponse.references:type_name -> treasury.CheckInstitutionReferencesResponse.Reference
+	59,  // 86: treasury.BulkCreateInstitutionsRequest.institutions:type_name -> treasury.CreateInstitutionRequest
+	6,   // 87: treasury.ImportRoutingDirectoryRequest.format:type_name -> treasury.RoutingDirectoryFormat
+	7,   // 88: treasury.RoutingDirectoryChange.type:type_name -> treasury.RoutingDirectoryChangeType
+	6,   // 89: treasury.ImportRoutingDirectoryResponse.format:type_name -> treasury.RoutingDirectoryFormat
+	74,  // 90: treasury.ImportRoutingDirectoryResponse.changes:type_name -> treasury.RoutingDirectoryChange
+	8,   // 91: treasury.ImportBicDirectoryRequest.format:type_name -> treasury.BicDirectoryFormat
+	9,   // 92: treasury.BicDirectoryChange.type:type_name -> treasury.BicDirectoryChangeType
+	8,   // 93: treasury.ImportBicDirectoryResponse.format:type_name -> treasury.BicDirectoryFormat
+	77,  // 94: treasury.ImportBicDirectoryResponse.changes:type_name -> treasury.BicDirectoryChange
+	10,  // 95: treasury.ExchangeRate.rate_type:type_name -> treasury.RateType
+	123, // 96: treasury.ExchangeRate.effective_at:type_name -> google.protobuf.Timestamp
+	123, // 97: treasury.ExchangeRate.created_at:type_name -> google.protobuf.Timestamp
+	123, // 98: treasury.ExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 99: treasury.ExchangeRateInput.rate_type:type_name -> treasury.RateType
+	123, // 100: treasury.ExchangeRateInput.effective_at:type_name -> google.protobuf.Timestamp
+	80,  // 101: treasury.UpsertRatesRequest.rates:type_name -> treasury.ExchangeRateInput
+	79,  // 102: treasury.UpsertRatesResponse.rates:type_name -> treasury.ExchangeRate
+	123, // 103: treasury.GetRateRequest.as_of:type_name -> google.protobuf.Timestamp
+	10,  // 104: treasury.GetRateRequest.rate_type:type_name -> treasury.RateType
+	10,  // 105: treasury.GetRateResponse.rate_type:type_name -> treasury.RateType
+	123, // 106: treasury.GetRateResponse.effective_at:type_name -> google.protobuf.Timestamp
+	11,  // 107: treasury.GetRateResponse.derivation:type_name -> treasury.RateDerivation
+	79,  // 108: treasury.GetRateResponse.legs:type_name -> treasury.ExchangeRate
+	10,  // 109: treasury.ListRatesRequest.rate_type:type_name -> treasury.RateType
+	123, // 110: treasury.ListRatesRequest.effective_from:type_name -> google.protobuf.Timestamp
+	123, // 111: treasury.ListRatesRequest.effective_to:type_name -> google.protobuf.Timestamp
+	79,  // 112: treasury.ListRatesResponse.rates:type_name -> treasury.ExchangeRate
+	12,  // 113: treasury.ImportRatesRequest.format:type_name -> treasury.RateFileFormat
+	10,  // 114: treasury.ImportRatesRequest.rate_type:type_name -> treasury.RateType
+	13,  // 115: treasury.BankAccount.purpose:type_name -> treasury.BankAccountPurpose
+	90,  // 116: treasury.BankAccount.signatories:type_name -> treasury.Signatory
+	14,  // 117: treasury.BankAccount.status:type_name -> treasury.BankAccountStatus
+	123, // 118: treasury.BankAccount.opened_at:type_name -> google.protobuf.Timestamp
+	123, // 119: treasury.BankAccount.closed_at:type_name -> google.protobuf.Timestamp
+	123, // 120: treasury.BankAccount.created_at:type_name -> google.protobuf.Timestamp
+	123, // 121: treasury.BankAccount.updated_at:type_name -> google.protobuf.Timestamp
+	13,  // 122: treasury.CreateBankAccountRequest.purpose:type_name -> treasury.BankAccountPurpose
+	90,  // 123: treasury.CreateBankAccountRequest.signatories:type_name -> treasury.Signatory
+	123, // 124: treasury.CreateBankAccountRequest.opened_at:type_name -> google.protobuf.Timestamp
+	89,  // 125: treasury.CreateBankAccountResponse.bank_account:type_name -> treasury.BankAccount
+	89,  // 126: treasury.GetBankAccountResponse.bank_account:type_name -> treasury.BankAccount
+	124, // 127: treasury.UpdateBankAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
+	13,  // 128: treasury.UpdateBankAccountRequest.purpose:type_name -> treasury.BankAccountPurpose
+	90,  // 129: treasury.UpdateBankAccountRequest.signatories:type_name -> treasury.Signatory
+	89,  // 130: treasury.UpdateBankAccountResponse.bank_account:type_name -> treasury.BankAccount
+	89,  // 131: treasury.CloseBankAccountResponse.bank_account:type_name -> treasury.BankAccount
+	13,  // 132: treasury.ListBankAccountsRequest.purpose:type_name -> treasury.BankAccountPurpose
+	14,  // 133: treasury.ListBankAccountsRequest.status:type_name -> treasury.BankAccountStatus
+	89,  // 134: treasury.ListBankAccountsResponse.bank_accounts:type_name -> treasury.BankAccount
+	15,  // 135: treasury.HolidayRule.type:type_name -> treasury.HolidayRuleType
+	16,  // 136: treasury.HolidayRule.observance:type_name -> treasury.HolidayObservance
+	101, // 137: treasury.HolidayCalendar.rules:type_name -> treasury.HolidayRule
+	123, // 138: treasury.HolidayCalendar.created_at:type_name -> google.protobuf.Timestamp
+	123, // 139: treasury.HolidayCalendar.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 140: treasury.CreateCalendarRequest.rules:type_name -> treasury.HolidayRule
+	102, // 141: treasury.CreateCalendarResponse.calendar:type_name -> treasury.HolidayCalendar
+	102, // 142: treasury.GetCalendarResponse.calendar:type_name -> treasury.HolidayCalendar
+	103, // 143: treasury.GetCalendarResponse.holidays:type_name -> treasury.Holiday
+	124, // 144: treasury.UpdateCalendarRequest.update_mask:type_name -> google.protobuf.FieldMask
+	101, // 145: treasury.UpdateCalendarRequest.rules:type_name -> treasury.HolidayRule
+	102, // 146: treasury.UpdateCalendarResponse.calendar:type_name -> treasury.HolidayCalendar
+	17,  // 147: treasury.Manifest.GetManifest:input_type -> treasury.ManifestRequest
+	25,  // 148: treasury.Health.GetLiveness:input_type -> treasury.LivenessRequest
+	27,  // 149: treasury.Health.GetHealth:input_type -> treasury.HealthRequest
+	35,  // 150: treasury.CurrencyService.CreateCurrency:input_type -> treasury.CreateCurrencyRequest
+	37,  // 151: treasury.CurrencyService.GetCurrency:input_type -> treasury.GetCurrencyRequest
+	39,  // 152: treasury.CurrencyService.UpdateCurrency:input_type -> treasury.UpdateCurrencyRequest
+	41,  // 153: treasury.CurrencyService.DeactivateCurrency:input_type -> treasury.DeactivateCurrencyRequest
+	43,  // 154: treasury.CurrencyService.ListCurrencies:input_type -> treasury.ListCurrenciesRequest
+	45,  // 155: treasury.CurrencyService.BulkCreateCurrencies:input_type -> treasury.BulkCreateCurrenciesRequest
+	49,  // 156: treasury.CurrencyService.GetCurrencyHistory:input_type -> treasury.GetCurrencyHistoryRequest
+	51,  // 157: treasury.CurrencyService.ScheduleCurrencyChange:input_type -> treasury.ScheduleCurrencyChangeRequest
+	53,  // 158: treasury.CurrencyService.CancelCurrencyChange:input_type -> treasury.CancelCurrencyChangeRequest
+	59,  // 159: treasury.FinancialInstitutionService.CreateInstitution:input_type -> treasury.CreateInstitutionRequest
+	61,  // 160: treasury.FinancialInstitutionService.GetInstitution:input_type -> treasury.GetInstitutionRequest
+	63,  // 161: treasury.FinancialInstitutionService.UpdateInstitution:input_type -> treasury.UpdateInstitutionRequest
+	65,  // 162: treasury.FinancialInstitutionService.DeleteInstitution:input_type -> treasury.DeleteInstitutionRequest
+	67,  // 163: treasury.FinancialInstitutionService.ListInstitutions:input_type -> treasury.ListInstitutionsRequest
+	69,  // 164: treasury.FinancialInstitutionService.CheckInstitutionReferences:input_type -> treasury.CheckInstitutionReferencesRequest
+	71,  // 165: treasury.FinancialInstitutionService.BulkCreateInstitutions:input_type -> treasury.BulkCreateInstitutionsRequest
+	73,  // 166: treasury.FinancialInstitutionService.ImportRoutingDirectory:input_type -> treasury.ImportRoutingDirectoryRequest
+	76,  // 167: treasury.FinancialInstitutionService.ImportBicDirectory:input_type -> treasury.ImportBicDirectoryRequest
+	81,  // 168: treasury.ExchangeRateService.UpsertRates:input_type -> treasury.UpsertRatesRequest
+	83,  // 169: treasury.ExchangeRateService.GetRate:input_type -> treasury.GetRateRequest
+	85,  // 170: treasury.ExchangeRateService.ListRates:input_type -> treasury.ListRatesRequest
+	87,  // 171: treasury.ExchangeRateService.ImportRates:input_type -> treasury.ImportRatesRequest
+	91,  // 172: treasury.BankAccountService.CreateBankAccount:input_type -> treasury.CreateBankAccountRequest
+	93,  // 173: treasury.BankAccountService.GetBankAccount:input_type -> treasury.GetBankAccountRequest
+	95,  // 174: treasury.BankAccountService.UpdateBankAccount:input_type -> treasury.UpdateBankAccountRequest
+	97,  // 175: treasury.BankAccountService.CloseBankAccount:input_type -> treasury.CloseBankAccountRequest
+	99,  // 176: treasury.BankAccountService.ListBankAccounts:input_type -> treasury.ListBankAccountsRequest
+	104, // 177: treasury.CalendarService.CreateCalendar:input_type -> treasury.CreateCalendarRequest
+	106, // 178: treasury.CalendarService.GetCalendar:input_type -> treasury.GetCalendarRequest
+	108, // 179: treasury.CalendarService.UpdateCalendar:input_type -> treasury.UpdateCalendarRequest
+	110, // 180: treasury.CalendarService.SetSettlementCalendar:input_type -> treasury.SetSettlementCalendarRequest
+	112, // 181: treasury.CalendarService.IsBusinessDay:input_type -> treasury.IsBusinessDayRequest
+	114, // 182: treasury.CalendarService.AddBusinessDays:input_type -> treasury.AddBusinessDaysRequest
+	116, // 183: treasury.CalendarService.NextSettlementDate:input_type -> treasury.NextSettlementDateRequest
+	18,  // 184: treasury.Manifest.GetManifest:output_type -> treasury.ManifestResponse
+	26,  // 185: treasury.Health.GetLiveness:output_type -> treasury.LivenessResponse
+	28,  // 186: treasury.Health.GetHealth:output_type -> treasury.HealthResponse
+	36,  // 187: treasury.CurrencyService.CreateCurrency:output_type -> treasury.CreateCurrencyResponse
+	38,  // 188: treasury.CurrencyService.GetCurrency:output_type -> treasury.GetCurrencyResponse
+	40,  // 189: treasury.CurrencyService.UpdateCurrency:output_type -> treasury.UpdateCurrencyResponse
+	42,  // 190: treasury.CurrencyService.DeactivateCurrency:output_type -> treasury.DeactivateCurrencyResponse
+	44,  // 191: treasury.CurrencyService.ListCurrencies:output_type -> treasury.ListCurrenciesResponse
+	46,  // 192: treasury.CurrencyService.BulkCreateCurrencies:output_type -> treasury.BulkCreateCurrenciesResponse
+	50,  // 193: treasury.CurrencyService.GetCurrencyHistory:output_type -> treasury.GetCurrencyHistoryResponse
+	52,  // 194: treasury.CurrencyService.ScheduleCurrencyChange:output_type -> treasury.ScheduleCurrencyChangeResponse
+	54,  // 195: treasury.CurrencyService.CancelCurrencyChange:output_type -> treasury.CancelCurrencyChangeResponse
+	60,  // 196: treasury.FinancialInstitutionService.CreateInstitution:output_type -> treasury.CreateInstitutionResponse
+	62,  // 197: treasury.FinancialInstitutionService.GetInstitution:output_type -> treasury.GetInstitutionResponse
+	64,  // 198: treasury.FinancialInstitutionService.UpdateInstitution:output_type -> treasury.UpdateInstitutionResponse
+	66,  // 199: treasury.FinancialInstitutionService.DeleteInstitution:output_type -> treasury.DeleteInstitutionResponse
+	68,  // 200: treasury.FinancialInstitutionService.ListInstitutions:output_type -> treasury.ListInstitutionsResponse
+	70,  // 201: treasury.FinancialInstitutionService.CheckInstitutionReferences:output_type -> treasury.CheckInstitutionReferencesResponse
+	72,  // 202: treasury.FinancialInstitutionService.BulkCreateInstitutions:output_type -> treasury.BulkCreateInstitutionsResponse
+	75,  // 203: treasury.FinancialInstitutionService.ImportRoutingDirectory:output_type -> treasury.ImportRoutingDirectoryResponse
+	78,  // 204: treasury.FinancialInstitutionService.ImportBicDirectory:output_type -> treasury.ImportBicDirectoryResponse
+	82,  // 205: treasury.ExchangeRateService.UpsertRates:output_type -> treasury.UpsertRatesResponse
+	84,  // 206: treasury.ExchangeRateService.GetRate:output_type -> treasury.GetRateResponse
+	86,  // 207: treasury.ExchangeRateService.ListRates:output_type -> treasury.ListRatesResponse
+	88,  // 208: treasury.ExchangeRateService.ImportRates:output_type -> treasury.ImportRatesResponse
+	92,  // 209: treasury.BankAccountService.CreateBankAccount:output_type -> treasury.CreateBankAccountResponse
+	94,  // 210: treasury.BankAccountService.GetBankAccount:output_type -> treasury.GetBankAccountResponse
+	96,  // 211: treasury.BankAccountService.UpdateBankAccount:output_type -> treasury.UpdateBankAccountResponse
+	98,  // 212: treasury.BankAccountService.CloseBankAccount:output_type -> treasury.CloseBankAccountResponse
+	100, // 213: treasury.BankAccountService.ListBankAccounts:output_type -> treasury.ListBankAccountsResponse
+	105, // 214: treasury.CalendarService.CreateCalendar:output_type -> treasury.CreateCalendarResponse
+	107, // 215: treasury.CalendarService.GetCalendar:output_type -> treasury.GetCalendarResponse
+	109, // 216: treasury.CalendarService.UpdateCalendar:output_type -> treasury.UpdateCalendarResponse
+	111, // 217: treasury.CalendarService.SetSettlementCalendar:output_type -> treasury.SetSettlementCalendarResponse
+	113, // 218: treasury.CalendarService.IsBusinessDay:output_type -> treasury.IsBusinessDayResponse
+	115, // 219: treasury.CalendarService.AddBusinessDays:output_type -> treasury.AddBusinessDaysResponse
+	117, // 220: treasury.CalendarService.NextSettlementDate:output_type -> treasury.NextSettlementDateResponse
+	184, // [184:221] is the sub-list for method output_type
+	147, // [147:184] is the sub-list for method input_type
+	147, // [147:147] is the sub-list for extension type_name
+	147, // [147:147] is the sub-list for extension extendee
+	0,   // [0:147] is the sub-list for field type_name
 }
 
 func init() { file_services_treasury_services_treasury_service_proto_treasury_service_proto_init() }
@@ -4972,21 +10435,26 @@ func file_services_treasury_services_treasury_service_proto_treasury_service_pro
 		(*GetCurrencyRequest_NumericCode)(nil),
 		(*GetCurrencyRequest_Id)(nil),
 	}
-	file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[36].OneofWrappers = []any{
+	file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[44].OneofWrappers = []any{
 		(*GetInstitutionRequest_Code)(nil),
 		(*GetInstitutionRequest_RoutingNumber)(nil),
 		(*GetInstitutionRequest_SwiftCode)(nil),
 		(*GetInstitutionRequest_Id)(nil),
+		(*GetInstitutionRequest_Iban)(nil),
+	}
+	file_services_treasury_services_treasury_service_proto_treasury_service_proto_msgTypes[76].OneofWrappers = []any{
+		(*GetBankAccountRequest_Id)(nil),
+		(*GetBankAccountRequest_LedgerAccountExternalId)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDesc), len(file_services_treasury_services_treasury_service_proto_treasury_service_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   53,
+			NumEnums:      17,
+			NumMessages:   106,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   7,
 		},
 		GoTypes:           file_services_treasury_services_treasury_service_proto_treasury_service_proto_goTypes,
 		DependencyIndexes: file_services_treasury_services_treasury_service_proto_treasury_service_proto_depIdxs,
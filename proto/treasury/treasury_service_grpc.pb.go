@@ -279,12 +279,15 @@ var Health_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CurrencyService_CreateCurrency_FullMethodName       = "/treasury.CurrencyService/CreateCurrency"
-	CurrencyService_GetCurrency_FullMethodName          = "/treasury.CurrencyService/GetCurrency"
-	CurrencyService_UpdateCurrency_FullMethodName       = "/treasury.CurrencyService/UpdateCurrency"
-	CurrencyService_DeactivateCurrency_FullMethodName   = "/treasury.CurrencyService/DeactivateCurrency"
-	CurrencyService_ListCurrencies_FullMethodName       = "/treasury.CurrencyService/ListCurrencies"
-	CurrencyService_BulkCreateCurrencies_FullMethodName = "/treasury.CurrencyService/BulkCreateCurrencies"
+	CurrencyService_CreateCurrency_FullMethodName         = "/treasury.CurrencyService/CreateCurrency"
+	CurrencyService_GetCurrency_FullMethodName            = "/treasury.CurrencyService/GetCurrency"
+	CurrencyService_UpdateCurrency_FullMethodName         = "/treasury.CurrencyService/UpdateCurrency"
+	CurrencyService_DeactivateCurrency_FullMethodName     = "/treasury.CurrencyService/DeactivateCurrency"
+	CurrencyService_ListCurrencies_FullMethodName         = "/treasury.CurrencyService/ListCurrencies"
+	CurrencyService_BulkCreateCurrencies_FullMethodName   = "/treasury.CurrencyService/BulkCreateCurrencies"
+	CurrencyService_GetCurrencyHistory_FullMethodName     = "/treasury.CurrencyService/GetCurrencyHistory"
+	CurrencyService_ScheduleCurrencyChange_FullMethodName = "/treasury.CurrencyService/ScheduleCurrencyChange"
+	CurrencyService_CancelCurrencyChange_FullMethodName   = "/treasury.CurrencyService/CancelCurrencyChange"
 )
 
 // CurrencyServiceClient is the client API for CurrencyService service.
@@ -311,6 +314,15 @@ type CurrencyServiceClient interface {
 	// Bulk create currencies
 	// Spec: docs/specs/003-currency-management.md#story-5-bulk-currency-operations
 	BulkCreateCurrencies(ctx context.Context, in *BulkCreateCurrenciesRequest, opts ...grpc.CallOption) (*BulkCreateCurrenciesResponse, error)
+	// Get every version of a currency and its pending scheduled changes
+	// Spec: docs/specs/006-currency-history.md#story-1-currency-history
+	GetCurrencyHistory(ctx context.Context, in *GetCurrencyHistoryRequest, opts ...grpc.CallOption) (*GetCurrencyHistoryResponse, error)
+	// Schedule a change to a currency at a future time
+	// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+	ScheduleCurrencyChange(ctx context.Context, in *ScheduleCurrencyChangeRequest, opts ...grpc.CallOption) (*ScheduleCurrencyChangeResponse, error)
+	// Cancel a scheduled change that has not been applied
+	// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+	CancelCurrencyChange(ctx context.Context, in *CancelCurrencyChangeRequest, opts ...grpc.CallOption) (*CancelCurrencyChangeResponse, error)
 }
 
 type currencyServiceClient struct {
@@ -381,6 +393,36 @@ func (c *currencyServiceClient) BulkCreateCurrencies(ctx context.Context, in *Bu
 	return out, nil
 }
 
+func (c *currencyServiceClient) GetCurrencyHistory(ctx context.Context, in *GetCurrencyHistoryRequest, opts ...grpc.CallOption) (*GetCurrencyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrencyHistoryResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_GetCurrencyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) ScheduleCurrencyChange(ctx context.Context, in *ScheduleCurrencyChangeRequest, opts ...grpc.CallOption) (*ScheduleCurrencyChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleCurrencyChangeResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_ScheduleCurrencyChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyServiceClient) CancelCurrencyChange(ctx context.Context, in *CancelCurrencyChangeRequest, opts ...grpc.CallOption) (*CancelCurrencyChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelCurrencyChangeResponse)
+	err := c.cc.Invoke(ctx, CurrencyService_CancelCurrencyChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CurrencyServiceServer is the server API for CurrencyService service.
 // All implementations must embed UnimplementedCurrencyServiceServer
 // for forward compatibility.
@@ -405,6 +447,15 @@ type CurrencyServiceServer interface {
 	// Bulk create currencies
 	// Spec: docs/specs/003-currency-management.md#story-5-bulk-currency-operations
 	BulkCreateCurrencies(context.Context, *BulkCreateCurrenciesRequest) (*BulkCreateCurrenciesResponse, error)
+	// Get every version of a currency and its pending scheduled changes
+	// Spec: docs/specs/006-currency-history.md#story-1-currency-history
+	GetCurrencyHistory(context.Context, *GetCurrencyHistoryRequest) (*GetCurrencyHistoryResponse, error)
+	// Schedule a change to a currency at a future time
+	// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+	ScheduleCurrencyChange(context.Context, *ScheduleCurrencyChangeRequest) (*ScheduleCurrencyChangeResponse, error)
+	// Cancel a scheduled change that has not been applied
+	// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+	CancelCurrencyChange(context.Context, *CancelCurrencyChangeRequest) (*CancelCurrencyChangeResponse, error)
 	mustEmbedUnimplementedCurrencyServiceServer()
 }
 
@@ -433,6 +484,15 @@ func (UnimplementedCurrencyServiceServer) ListCurrencies(context.Context, *ListC
 func (UnimplementedCurrencyServiceServer) BulkCreateCurrencies(context.Context, *BulkCreateCurrenciesRequest) (*BulkCreateCurrenciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateCurrencies not implemented")
 }
+func (UnimplementedCurrencyServiceServer) GetCurrencyHistory(context.Context, *GetCurrencyHistoryRequest) (*GetCurrencyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyHistory not implemented")
+}
+func (UnimplementedCurrencyServiceServer) ScheduleCurrencyChange(context.Context, *ScheduleCurrencyChangeRequest) (*ScheduleCurrencyChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCurrencyChange not implemented")
+}
+func (UnimplementedCurrencyServiceServer) CancelCurrencyChange(context.Context, *CancelCurrencyChangeRequest) (*CancelCurrencyChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCurrencyChange not implemented")
+}
 func (UnimplementedCurrencyServiceServer) mustEmbedUnimplementedCurrencyServiceServer() {}
 func (UnimplementedCurrencyServiceServer) testEmbeddedByValue()                         {}
 
@@ -562,6 +622,60 @@ func _CurrencyService_BulkCreateCurrencies_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CurrencyService_GetCurrencyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).GetCurrencyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_GetCurrencyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).GetCurrencyHistory(ctx, req.(*GetCurrencyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_ScheduleCurrencyChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCurrencyChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).ScheduleCurrencyChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_ScheduleCurrencyChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).ScheduleCurrencyChange(ctx, req.(*ScheduleCurrencyChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyService_CancelCurrencyChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCurrencyChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyServiceServer).CancelCurrencyChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CurrencyService_CancelCurrencyChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyServiceServer).CancelCurrencyChange(ctx, req.(*CancelCurrencyChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CurrencyService_ServiceDesc is the grpc.ServiceDesc for CurrencyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -593,6 +707,18 @@ var CurrencyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkCreateCurrencies",
 			Handler:    _CurrencyService_BulkCreateCurrencies_Handler,
 		},
+		{
+			MethodName: "GetCurrencyHistory",
+			Handler:    _CurrencyService_GetCurrencyHistory_Handler,
+		},
+		{
+			MethodName: "ScheduleCurrencyChange",
+			Handler:    _CurrencyService_ScheduleCurrencyChange_Handler,
+		},
+		{
+			MethodName: "CancelCurrencyChange",
+			Handler:    _CurrencyService_CancelCurrencyChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/treasury-services/treasury-service/proto/treasury_service.proto",
@@ -606,6 +732,8 @@ const (
 	FinancialInstitutionService_ListInstitutions_FullMethodName           = "/treasury.FinancialInstitutionService/ListInstitutions"
 	FinancialInstitutionService_CheckInstitutionReferences_FullMethodName = "/treasury.FinancialInstitutionService/CheckInstitutionReferences"
 	FinancialInstitutionService_BulkCreateInstitutions_FullMethodName     = "/treasury.FinancialInstitutionService/BulkCreateInstitutions"
+	FinancialInstitutionService_ImportRoutingDirectory_FullMethodName     = "/treasury.FinancialInstitutionService/ImportRoutingDirectory"
+	FinancialInstitutionService_ImportBicDirectory_FullMethodName         = "/treasury.FinancialInstitutionService/ImportBicDirectory"
 )
 
 // FinancialInstitutionServiceClient is the client API for FinancialInstitutionService service.
@@ -635,6 +763,12 @@ type FinancialInstitutionServiceClient interface {
 	// Bulk create institutions
 	// Spec: docs/specs/004-financial-institutions.md#story-5-bulk-institution-operations
 	BulkCreateInstitutions(ctx context.Context, in *BulkCreateInstitutionsRequest, opts ...grpc.CallOption) (*BulkCreateInstitutionsResponse, error)
+	// Import a Federal Reserve FedACH or Fedwire routing directory file
+	// Spec: docs/specs/010-routing-directory.md
+	ImportRoutingDirectory(ctx context.Context, in *ImportRoutingDirectoryRequest, opts ...grpc.CallOption) (*ImportRoutingDirectoryResponse, error)
+	// Import a SWIFT BIC directory file (CSV or tab-delimited flat file)
+	// Spec: docs/specs/011-bic-directory.md
+	ImportBicDirectory(ctx context.Context, in *ImportBicDirectoryRequest, opts ...grpc.CallOption) (*ImportBicDirectoryResponse, error)
 }
 
 type financialInstitutionServiceClient struct {
@@ -715,6 +849,26 @@ func (c *financialInstitutionServiceClient) BulkCreateInstitutions(ctx context.C
 	return out, nil
 }
 
+func (c *financialInstitutionServiceClient) ImportRoutingDirectory(ctx context.Context, in *ImportRoutingDirectoryRequest, opts ...grpc.CallOption) (*ImportRoutingDirectoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRoutingDirectoryResponse)
+	err := c.cc.Invoke(ctx, FinancialInstitutionService_ImportRoutingDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financialInstitutionServiceClient) ImportBicDirectory(ctx context.Context, in *ImportBicDirectoryRequest, opts ...grpc.CallOption) (*ImportBicDirectoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBicDirectoryResponse)
+	err := c.cc.Invoke(ctx, FinancialInstitutionService_ImportBicDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FinancialInstitutionServiceServer is the server API for FinancialInstitutionService service.
 // All implementations must embed UnimplementedFinancialInstitutionServiceServer
 // for forward compatibility.
@@ -742,6 +896,12 @@ type FinancialInstitutionServiceServer interface {
 	// Bulk create institutions
 	// Spec: docs/specs/004-financial-institutions.md#story-5-bulk-institution-operations
 	BulkCreateInstitutions(context.Context, *BulkCreateInstitutionsRequest) (*BulkCreateInstitutionsResponse, error)
+	// Import a Federal Reserve FedACH or Fedwire routing directory file
+	// Spec: docs/specs/010-routing-directory.md
+	ImportRoutingDirectory(context.Context, *ImportRoutingDirectoryRequest) (*ImportRoutingDirectoryResponse, error)
+	// Import a SWIFT BIC directory file (CSV or tab-delimited flat file)
+	// Spec: docs/specs/011-bic-directory.md
+	ImportBicDirectory(context.Context, *ImportBicDirectoryRequest) (*ImportBicDirectoryResponse, error)
 	mustEmbedUnimplementedFinancialInstitutionServiceServer()
 }
 
@@ -773,6 +933,12 @@ func (UnimplementedFinancialInstitutionServiceServer) CheckInstitutionReferences
 func (UnimplementedFinancialInstitutionServiceServer) BulkCreateInstitutions(context.Context, *BulkCreateInstitutionsRequest) (*BulkCreateInstitutionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkCreateInstitutions not implemented")
 }
+func (UnimplementedFinancialInstitutionServiceServer) ImportRoutingDirectory(context.Context, *ImportRoutingDirectoryRequest) (*ImportRoutingDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRoutingDirectory not implemented")
+}
+func (UnimplementedFinancialInstitutionServiceServer) ImportBicDirectory(context.Context, *ImportBicDirectoryRequest) (*ImportBicDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBicDirectory not implemented")
+}
 func (UnimplementedFinancialInstitutionServiceServer) mustEmbedUnimplementedFinancialInstitutionServiceServer() {
 }
 func (UnimplementedFinancialInstitutionServiceServer) testEmbeddedByValue() {}
@@ -921,6 +1087,42 @@ func _FinancialInstitutionService_BulkCreateInstitutions_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FinancialInstitutionService_ImportRoutingDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRoutingDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinancialInstitutionServiceServer).ImportRoutingDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinancialInstitutionService_ImportRoutingDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinancialInstitutionServiceServer).ImportRoutingDirectory(ctx, req.(*ImportRoutingDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinancialInstitutionService_ImportBicDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBicDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinancialInstitutionServiceServer).ImportBicDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinancialInstitutionService_ImportBicDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinancialInstitutionServiceServer).ImportBicDirectory(ctx, req.(*ImportBicDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FinancialInstitutionService_ServiceDesc is the grpc.ServiceDesc for FinancialInstitutionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -956,6 +1158,890 @@ var FinancialInstitutionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkCreateInstitutions",
 			Handler:    _FinancialInstitutionService_BulkCreateInstitutions_Handler,
 		},
+		{
+			MethodName: "ImportRoutingDirectory",
+			Handler:    _FinancialInstitutionService_ImportRoutingDirectory_Handler,
+		},
+		{
+			MethodName: "ImportBicDirectory",
+			Handler:    _FinancialInstitutionService_ImportBicDirectory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/treasury-service/proto/treasury_service.proto",
+}
+
+const (
+	ExchangeRateService_UpsertRates_FullMethodName = "/treasury.ExchangeRateService/UpsertRates"
+	ExchangeRateService_GetRate_FullMethodName     = "/treasury.ExchangeRateService/GetRate"
+	ExchangeRateService_ListRates_FullMethodName   = "/treasury.ExchangeRateService/ListRates"
+	ExchangeRateService_ImportRates_FullMethodName = "/treasury.ExchangeRateService/ImportRates"
+)
+
+// ExchangeRateServiceClient is the client API for ExchangeRateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Exchange rate service for authoritative rates between active currencies
+type ExchangeRateServiceClient interface {
+	// Create or correct a batch of rates
+	// Spec: docs/specs/005-exchange-rates.md#story-1-upsert-rates
+	UpsertRates(ctx context.Context, in *UpsertRatesRequest, opts ...grpc.CallOption) (*UpsertRatesResponse, error)
+	// Get the rate between two currencies at a point in time
+	// Spec: docs/specs/005-exchange-rates.md#story-2-get-rate
+	GetRate(ctx context.Context, in *GetRateRequest, opts ...grpc.CallOption) (*GetRateResponse, error)
+	// List stored rates with filters
+	// Spec: docs/specs/005-exchange-rates.md#story-3-list-rates
+	ListRates(ctx context.Context, in *ListRatesRequest, opts ...grpc.CallOption) (*ListRatesResponse, error)
+	// Import a rate file (ECB reference-rate XML or CSV)
+	// Spec: docs/specs/005-exchange-rates.md#story-4-import-rate-files
+	ImportRates(ctx context.Context, in *ImportRatesRequest, opts ...grpc.CallOption) (*ImportRatesResponse, error)
+}
+
+type exchangeRateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExchangeRateServiceClient(cc grpc.ClientConnInterface) ExchangeRateServiceClient {
+	return &exchangeRateServiceClient{cc}
+}
+
+func (c *exchangeRateServiceClient) UpsertRates(ctx context.Context, in *UpsertRatesRequest, opts ...grpc.CallOption) (*UpsertRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertRatesResponse)
+	err := c.cc.Invoke(ctx, ExchangeRateService_UpsertRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) GetRate(ctx context.Context, in *GetRateRequest, opts ...grpc.CallOption) (*GetRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateResponse)
+	err := c.cc.Invoke(ctx, ExchangeRateService_GetRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) ListRates(ctx context.Context, in *ListRatesRequest, opts ...grpc.CallOption) (*ListRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRatesResponse)
+	err := c.cc.Invoke(ctx, ExchangeRateService_ListRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) ImportRates(ctx context.Context, in *ImportRatesRequest, opts ...grpc.CallOption) (*ImportRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRatesResponse)
+	err := c.cc.Invoke(ctx, ExchangeRateService_ImportRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExchangeRateServiceServer is the server API for ExchangeRateService service.
+// All implementations must embed UnimplementedExchangeRateServiceServer
+// for forward compatibility.
+//
+// Exchange rate service for authoritative rates between active currencies
+type ExchangeRateServiceServer interface {
+	// Create or correct a batch of rates
+	// Spec: docs/specs/005-exchange-rates.md#story-1-upsert-rates
+	UpsertRates(context.Context, *UpsertRatesRequest) (*UpsertRatesResponse, error)
+	// Get the rate between two currencies at a point in time
+	// Spec: docs/specs/005-exchange-rates.md#story-2-get-rate
+	GetRate(context.Context, *GetRateRequest) (*GetRateResponse, error)
+	// List stored rates with filters
+	// Spec: docs/specs/005-exchange-rates.md#story-3-list-rates
+	ListRates(context.Context, *ListRatesRequest) (*ListRatesResponse, error)
+	// Import a rate file (ECB reference-rate XML or CSV)
+	// Spec: docs/specs/005-exchange-rates.md#story-4-import-rate-files
+	ImportRates(context.Context, *ImportRatesRequest) (*ImportRatesResponse, error)
+	mustEmbedUnimplementedExchangeRateServiceServer()
+}
+
+// UnimplementedExchangeRateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExchangeRateServiceServer struct{}
+
+func (UnimplementedExchangeRateServiceServer) UpsertRates(context.Context, *UpsertRatesRequest) (*UpsertRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) GetRate(context.Context, *GetRateRequest) (*GetRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRate not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) ListRates(context.Context, *ListRatesRequest) (*ListRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) ImportRates(context.Context, *ImportRatesRequest) (*ImportRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) mustEmbedUnimplementedExchangeRateServiceServer() {}
+func (UnimplementedExchangeRateServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeExchangeRateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExchangeRateServiceServer will
+// result in compilation errors.
+type UnsafeExchangeRateServiceServer interface {
+	mustEmbedUnimplementedExchangeRateServiceServer()
+}
+
+func RegisterExchangeRateServiceServer(s grpc.ServiceRegistrar, srv ExchangeRateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExchangeRateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExchangeRateService_ServiceDesc, srv)
+}
+
+func _ExchangeRateService_UpsertRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).UpsertRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRateService_UpsertRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).UpsertRates(ctx, req.(*UpsertRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_GetRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).GetRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRateService_GetRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).GetRate(ctx, req.(*GetRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_ListRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).ListRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRateService_ListRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).ListRates(ctx, req.(*ListRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_ImportRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).ImportRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRateService_ImportRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).ImportRates(ctx, req.(*ImportRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExchangeRateService_ServiceDesc is the grpc.ServiceDesc for ExchangeRateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExchangeRateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "treasury.ExchangeRateService",
+	HandlerType: (*ExchangeRateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpsertRates",
+			Handler:    _ExchangeRateService_UpsertRates_Handler,
+		},
+		{
+			MethodName: "GetRate",
+			Handler:    _ExchangeRateService_GetRate_Handler,
+		},
+		{
+			MethodName: "ListRates",
+			Handler:    _ExchangeRateService_ListRates_Handler,
+		},
+		{
+			MethodName: "ImportRates",
+			Handler:    _ExchangeRateService_ImportRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/treasury-service/proto/treasury_service.proto",
+}
+
+const (
+	BankAccountService_CreateBankAccount_FullMethodName = "/treasury.BankAccountService/CreateBankAccount"
+	BankAccountService_GetBankAccount_FullMethodName    = "/treasury.BankAccountService/GetBankAccount"
+	BankAccountService_UpdateBankAccount_FullMethodName = "/treasury.BankAccountService/UpdateBankAccount"
+	BankAccountService_CloseBankAccount_FullMethodName  = "/treasury.BankAccountService/CloseBankAccount"
+	BankAccountService_ListBankAccounts_FullMethodName  = "/treasury.BankAccountService/ListBankAccounts"
+)
+
+// BankAccountServiceClient is the client API for BankAccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Bank account service for the company's own accounts at financial institutions
+type BankAccountServiceClient interface {
+	// Open a bank account record
+	// Spec: docs/specs/008-bank-accounts.md#story-1-register-bank-account
+	CreateBankAccount(ctx context.Context, in *CreateBankAccountRequest, opts ...grpc.CallOption) (*CreateBankAccountResponse, error)
+	// Get a bank account by ID or ledger account
+	// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+	GetBankAccount(ctx context.Context, in *GetBankAccountRequest, opts ...grpc.CallOption) (*GetBankAccountResponse, error)
+	// Update name, purpose, signatories or ledger link
+	// Spec: docs/specs/008-bank-accounts.md#story-3-update-bank-account
+	UpdateBankAccount(ctx context.Context, in *UpdateBankAccountRequest, opts ...grpc.CallOption) (*UpdateBankAccountResponse, error)
+	// Close a bank account (records are never deleted)
+	// Spec: docs/specs/008-bank-accounts.md#story-4-close-bank-account
+	CloseBankAccount(ctx context.Context, in *CloseBankAccountRequest, opts ...grpc.CallOption) (*CloseBankAccountResponse, error)
+	// List bank accounts with filters
+	// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+	ListBankAccounts(ctx context.Context, in *ListBankAccountsRequest, opts ...grpc.CallOption) (*ListBankAccountsResponse, error)
+}
+
+type bankAccountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBankAccountServiceClient(cc grpc.ClientConnInterface) BankAccountServiceClient {
+	return &bankAccountServiceClient{cc}
+}
+
+func (c *bankAccountServiceClient) CreateBankAccount(ctx context.Context, in *CreateBankAccountRequest, opts ...grpc.CallOption) (*CreateBankAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBankAccountResponse)
+	err := c.cc.Invoke(ctx, BankAccountService_CreateBankAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAccountServiceClient) GetBankAccount(ctx context.Context, in *GetBankAccountRequest, opts ...grpc.CallOption) (*GetBankAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBankAccountResponse)
+	err := c.cc.Invoke(ctx, BankAccountService_GetBankAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAccountServiceClient) UpdateBankAccount(ctx context.Context, in *UpdateBankAccountRequest, opts ...grpc.CallOption) (*UpdateBankAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBankAccountResponse)
+	err := c.cc.Invoke(ctx, BankAccountService_UpdateBankAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAccountServiceClient) CloseBankAccount(ctx context.Context, in *CloseBankAccountRequest, opts ...grpc.CallOption) (*CloseBankAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseBankAccountResponse)
+	err := c.cc.Invoke(ctx, BankAccountService_CloseBankAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAccountServiceClient) ListBankAccounts(ctx context.Context, in *ListBankAccountsRequest, opts ...grpc.CallOption) (*ListBankAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBankAccountsResponse)
+	err := c.cc.Invoke(ctx, BankAccountService_ListBankAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BankAccountServiceServer is the server API for BankAccountService service.
+// All implementations must embed UnimplementedBankAccountServiceServer
+// for forward compatibility.
+//
+// Bank account service for the company's own accounts at financial institutions
+type BankAccountServiceServer interface {
+	// Open a bank account record
+	// Spec: docs/specs/008-bank-accounts.md#story-1-register-bank-account
+	CreateBankAccount(context.Context, *CreateBankAccountRequest) (*CreateBankAccountResponse, error)
+	// Get a bank account by ID or ledger account
+	// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+	GetBankAccount(context.Context, *GetBankAccountRequest) (*GetBankAccountResponse, error)
+	// Update name, purpose, signatories or ledger link
+	// Spec: docs/specs/008-bank-accounts.md#story-3-update-bank-account
+	UpdateBankAccount(context.Context, *UpdateBankAccountRequest) (*UpdateBankAccountResponse, error)
+	// Close a bank account (records are never deleted)
+	// Spec: docs/specs/008-bank-accounts.md#story-4-close-bank-account
+	CloseBankAccount(context.Context, *CloseBankAccountRequest) (*CloseBankAccountResponse, error)
+	// List bank accounts with filters
+	// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+	ListBankAccounts(context.Context, *ListBankAccountsRequest) (*ListBankAccountsResponse, error)
+	mustEmbedUnimplementedBankAccountServiceServer()
+}
+
+// UnimplementedBankAccountServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBankAccountServiceServer struct{}
+
+func (UnimplementedBankAccountServiceServer) CreateBankAccount(context.Context, *CreateBankAccountRequest) (*CreateBankAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBankAccount not implemented")
+}
+func (UnimplementedBankAccountServiceServer) GetBankAccount(context.Context, *GetBankAccountRequest) (*GetBankAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBankAccount not implemented")
+}
+func (UnimplementedBankAccountServiceServer) UpdateBankAccount(context.Context, *UpdateBankAccountRequest) (*UpdateBankAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBankAccount not implemented")
+}
+func (UnimplementedBankAccountServiceServer) CloseBankAccount(context.Context, *CloseBankAccountRequest) (*CloseBankAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseBankAccount not implemented")
+}
+func (UnimplementedBankAccountServiceServer) ListBankAccounts(context.Context, *ListBankAccountsRequest) (*ListBankAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankAccounts not implemented")
+}
+func (UnimplementedBankAccountServiceServer) mustEmbedUnimplementedBankAccountServiceServer() {}
+func (UnimplementedBankAccountServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeBankAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BankAccountServiceServer will
+// result in compilation errors.
+type UnsafeBankAccountServiceServer interface {
+	mustEmbedUnimplementedBankAccountServiceServer()
+}
+
+func RegisterBankAccountServiceServer(s grpc.ServiceRegistrar, srv BankAccountServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBankAccountServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BankAccountService_ServiceDesc, srv)
+}
+
+func _BankAccountService_CreateBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAccountServiceServer).CreateBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankAccountService_CreateBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAccountServiceServer).CreateBankAccount(ctx, req.(*CreateBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankAccountService_GetBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAccountServiceServer).GetBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankAccountService_GetBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAccountServiceServer).GetBankAccount(ctx, req.(*GetBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankAccountService_UpdateBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAccountServiceServer).UpdateBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankAccountService_UpdateBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAccountServiceServer).UpdateBankAccount(ctx, req.(*UpdateBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankAccountService_CloseBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAccountServiceServer).CloseBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankAccountService_CloseBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAccountServiceServer).CloseBankAccount(ctx, req.(*CloseBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankAccountService_ListBankAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAccountServiceServer).ListBankAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankAccountService_ListBankAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAccountServiceServer).ListBankAccounts(ctx, req.(*ListBankAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BankAccountService_ServiceDesc is the grpc.ServiceDesc for BankAccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BankAccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "treasury.BankAccountService",
+	HandlerType: (*BankAccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBankAccount",
+			Handler:    _BankAccountService_CreateBankAccount_Handler,
+		},
+		{
+			MethodName: "GetBankAccount",
+			Handler:    _BankAccountService_GetBankAccount_Handler,
+		},
+		{
+			MethodName: "UpdateBankAccount",
+			Handler:    _BankAccountService_UpdateBankAccount_Handler,
+		},
+		{
+			MethodName: "CloseBankAccount",
+			Handler:    _BankAccountService_CloseBankAccount_Handler,
+		},
+		{
+			MethodName: "ListBankAccounts",
+			Handler:    _BankAccountService_ListBankAccounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/treasury-services/treasury-service/proto/treasury_service.proto",
+}
+
+const (
+	CalendarService_CreateCalendar_FullMethodName        = "/treasury.CalendarService/CreateCalendar"
+	CalendarService_GetCalendar_FullMethodName           = "/treasury.CalendarService/GetCalendar"
+	CalendarService_UpdateCalendar_FullMethodName        = "/treasury.CalendarService/UpdateCalendar"
+	CalendarService_SetSettlementCalendar_FullMethodName = "/treasury.CalendarService/SetSettlementCalendar"
+	CalendarService_IsBusinessDay_FullMethodName         = "/treasury.CalendarService/IsBusinessDay"
+	CalendarService_AddBusinessDays_FullMethodName       = "/treasury.CalendarService/AddBusinessDays"
+	CalendarService_NextSettlementDate_FullMethodName    = "/treasury.CalendarService/NextSettlementDate"
+)
+
+// CalendarServiceClient is the client API for CalendarService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Calendar service for holiday calendars and business-day arithmetic
+type CalendarServiceClient interface {
+	// Create a holiday calendar with its rules
+	// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+	CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*CreateCalendarResponse, error)
+	// Get a calendar, optionally with the holidays of a year
+	// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+	GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*GetCalendarResponse, error)
+	// Update a calendar's name, weekend, rules or active flag
+	// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+	UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*UpdateCalendarResponse, error)
+	// Set or clear the settlement calendar of a currency
+	// Spec: docs/specs/012-holiday-calendars.md#story-2-currency-settlement-calendars
+	SetSettlementCalendar(ctx context.Context, in *SetSettlementCalendarRequest, opts ...grpc.CallOption) (*SetSettlementCalendarResponse, error)
+	// Check whether a date is a business day
+	// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+	IsBusinessDay(ctx context.Context, in *IsBusinessDayRequest, opts ...grpc.CallOption) (*IsBusinessDayResponse, error)
+	// Move a date by a number of business days
+	// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+	AddBusinessDays(ctx context.Context, in *AddBusinessDaysRequest, opts ...grpc.CallOption) (*AddBusinessDaysResponse, error)
+	// Settlement date of a payment through an institution in a currency
+	// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+	NextSettlementDate(ctx context.Context, in *NextSettlementDateRequest, opts ...grpc.CallOption) (*NextSettlementDateResponse, error)
+}
+
+type calendarServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalendarServiceClient(cc grpc.ClientConnInterface) CalendarServiceClient {
+	return &calendarServiceClient{cc}
+}
+
+func (c *calendarServiceClient) CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*CreateCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCalendarResponse)
+	err := c.cc.Invoke(ctx, CalendarService_CreateCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*GetCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarResponse)
+	err := c.cc.Invoke(ctx, CalendarService_GetCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*UpdateCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCalendarResponse)
+	err := c.cc.Invoke(ctx, CalendarService_UpdateCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) SetSettlementCalendar(ctx context.Context, in *SetSettlementCalendarRequest, opts ...grpc.CallOption) (*SetSettlementCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSettlementCalendarResponse)
+	err := c.cc.Invoke(ctx, CalendarService_SetSettlementCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) IsBusinessDay(ctx context.Context, in *IsBusinessDayRequest, opts ...grpc.CallOption) (*IsBusinessDayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsBusinessDayResponse)
+	err := c.cc.Invoke(ctx, CalendarService_IsBusinessDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) AddBusinessDays(ctx context.Context, in *AddBusinessDaysRequest, opts ...grpc.CallOption) (*AddBusinessDaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBusinessDaysResponse)
+	err := c.cc.Invoke(ctx, CalendarService_AddBusinessDays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) NextSettlementDate(ctx context.Context, in *NextSettlementDateRequest, opts ...grpc.CallOption) (*NextSettlementDateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NextSettlementDateResponse)
+	err := c.cc.Invoke(ctx, CalendarService_NextSettlementDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalendarServiceServer is the server API for CalendarService service.
+// All implementations must embed UnimplementedCalendarServiceServer
+// for forward compatibility.
+//
+// Calendar service for holiday calendars and business-day arithmetic
+type CalendarServiceServer interface {
+	// Create a holiday calendar with its rules
+	// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+	CreateCalendar(context.Context, *CreateCalendarRequest) (*CreateCalendarResponse, error)
+	// Get a calendar, optionally with the holidays of a year
+	// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+	GetCalendar(context.Context, *GetCalendarRequest) (*GetCalendarResponse, error)
+	// Update a calendar's name, weekend, rules or active flag
+	// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+	UpdateCalendar(context.Context, *UpdateCalendarRequest) (*UpdateCalendarResponse, error)
+	// Set or clear the settlement calendar of a currency
+	// Spec: docs/specs/012-holiday-calendars.md#story-2-currency-settlement-calendars
+	SetSettlementCalendar(context.Context, *SetSettlementCalendarRequest) (*SetSettlementCalendarResponse, error)
+	// Check whether a date is a business day
+	// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+	IsBusinessDay(context.Context, *IsBusinessDayRequest) (*IsBusinessDayResponse, error)
+	// Move a date by a number of business days
+	// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+	AddBusinessDays(context.Context, *AddBusinessDaysRequest) (*AddBusinessDaysResponse, error)
+	// Settlement date of a payment through an institution in a currency
+	// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+	NextSettlementDate(context.Context, *NextSettlementDateRequest) (*NextSettlementDateResponse, error)
+	mustEmbedUnimplementedCalendarServiceServer()
+}
+
+// UnimplementedCalendarServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCalendarServiceServer struct{}
+
+func (UnimplementedCalendarServiceServer) CreateCalendar(context.Context, *CreateCalendarRequest) (*CreateCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendar not implemented")
+}
+func (UnimplementedCalendarServiceServer) GetCalendar(context.Context, *GetCalendarRequest) (*GetCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedCalendarServiceServer) UpdateCalendar(context.Context, *UpdateCalendarRequest) (*UpdateCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCalendar not implemented")
+}
+func (UnimplementedCalendarServiceServer) SetSettlementCalendar(context.Context, *SetSettlementCalendarRequest) (*SetSettlementCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSettlementCalendar not implemented")
+}
+func (UnimplementedCalendarServiceServer) IsBusinessDay(context.Context, *IsBusinessDayRequest) (*IsBusinessDayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBusinessDay not implemented")
+}
+func (UnimplementedCalendarServiceServer) AddBusinessDays(context.Context, *AddBusinessDaysRequest) (*AddBusinessDaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBusinessDays not implemented")
+}
+func (UnimplementedCalendarServiceServer) NextSettlementDate(context.Context, *NextSettlementDateRequest) (*NextSettlementDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextSettlementDate not implemented")
+}
+func (UnimplementedCalendarServiceServer) mustEmbedUnimplementedCalendarServiceServer() {}
+func (UnimplementedCalendarServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCalendarServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalendarServiceServer will
+// result in compilation errors.
+type UnsafeCalendarServiceServer interface {
+	mustEmbedUnimplementedCalendarServiceServer()
+}
+
+func RegisterCalendarServiceServer(s grpc.ServiceRegistrar, srv CalendarServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCalendarServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CalendarService_ServiceDesc, srv)
+}
+
+func _CalendarService_CreateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).CreateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_CreateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).CreateCalendar(ctx, req.(*CreateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).GetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_GetCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).GetCalendar(ctx, req.(*GetCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_UpdateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).UpdateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_UpdateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).UpdateCalendar(ctx, req.(*UpdateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_SetSettlementCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSettlementCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).SetSettlementCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_SetSettlementCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).SetSettlementCalendar(ctx, req.(*SetSettlementCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_IsBusinessDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBusinessDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).IsBusinessDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_IsBusinessDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).IsBusinessDay(ctx, req.(*IsBusinessDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_AddBusinessDays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBusinessDaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).AddBusinessDays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_AddBusinessDays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).AddBusinessDays(ctx, req.(*AddBusinessDaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_NextSettlementDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextSettlementDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).NextSettlementDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_NextSettlementDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).NextSettlementDate(ctx, req.(*NextSettlementDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalendarService_ServiceDesc is the grpc.ServiceDesc for CalendarService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalendarService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "treasury.CalendarService",
+	HandlerType: (*CalendarServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCalendar",
+			Handler:    _CalendarService_CreateCalendar_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _CalendarService_GetCalendar_Handler,
+		},
+		{
+			MethodName: "UpdateCalendar",
+			Handler:    _CalendarService_UpdateCalendar_Handler,
+		},
+		{
+			MethodName: "SetSettlementCalendar",
+			Handler:    _CalendarService_SetSettlementCalendar_Handler,
+		},
+		{
+			MethodName: "IsBusinessDay",
+			Handler:    _CalendarService_IsBusinessDay_Handler,
+		},
+		{
+			MethodName: "AddBusinessDays",
+			Handler:    _CalendarService_AddBusinessDays_Handler,
+		},
+		{
+			MethodName: "NextSettlementDate",
+			Handler:    _CalendarService_NextSettlementDate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/treasury-services/treasury-service/proto/treasury_service.proto",
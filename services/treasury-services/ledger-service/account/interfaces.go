@@ -2,6 +2,7 @@ package account
 
 import (
 	"context"
+	"time"
 
 	pb "example.com/go-mono-repo/proto/ledger"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -13,14 +14,31 @@ type RepositoryInterface interface {
 	GetAccountByID(ctx context.Context, accountID string) (*AccountRow, error)
 	GetAccountByExternalID(ctx context.Context, externalID string) (*AccountRow, error)
 	UpdateAccount(ctx context.Context, accountID string, updates map[string]interface{}, currentVersion int64) (*AccountRow, error)
+	UpdateAccountStatus(ctx context.Context, accountID string, change *StatusChange, currentVersion int64) (*AccountRow, error)
 	ListAccounts(ctx context.Context, filters ListAccountFilters) ([]*AccountRow, string, int32, error)
+	GetPostedTotals(ctx context.Context, accountID string, query BalanceQuery) (*BalanceRow, error)
+	GetHeldTotal(ctx context.Context, accountID string, query BalanceQuery) (int64, error)
+	GetNormalBalances(ctx context.Context) (map[string]string, error)
+	GetAccountStatuses(ctx context.Context) (map[string]bool, error)
+	GetVerifiedAccountByID(ctx context.Context, accountID string) (*AccountRow, *pb.VerificationProof, error)
+	VerifyLedgerState(ctx context.Context) (*pb.VerificationProof, error)
+	GetAccountAsOf(ctx context.Context, accountID string, asOfTx uint64, asOfTime *time.Time) (*AccountRow, error)
+	GetAccountHistory(ctx context.Context, accountID string) ([]*AccountRevisionRow, error)
 }
 
 // ManagerInterface defines the interface for account manager operations
 type ManagerInterface interface {
 	CreateAccount(ctx context.Context, req *pb.CreateAccountRequest) (*pb.Account, error)
 	GetAccount(ctx context.Context, accountID string) (*pb.Account, error)
+	GetVerifiedAccount(ctx context.Context, accountID string) (*pb.Account, *pb.VerificationProof, error)
+	GetAccountAsOf(ctx context.Context, req *pb.GetAccountRequest) (*pb.Account, error)
+	GetAccountHistory(ctx context.Context, accountID string) ([]*pb.AccountRevision, error)
 	GetAccountByExternalID(ctx context.Context, externalID string) (*pb.Account, error)
 	UpdateAccount(ctx context.Context, accountID string, account *pb.Account, updateMask *fieldmaskpb.FieldMask) (*pb.Account, error)
 	ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error)
+	GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error)
+	GetAccountBalances(ctx context.Context, req *pb.GetAccountBalancesRequest) (*pb.GetAccountBalancesResponse, error)
+	FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.Account, error)
+	CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.Account, error)
+	ReopenAccount(ctx context.Context, req *pb.ReopenAccountRequest) (*pb.Account, error)
 }
\ No newline at end of file
@@ -5,7 +5,11 @@ import (
 	"database/sql"
 	"log"
 	"strings"
+	"sync"
+	"time"
 
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/pkg/amount"
 	pb "example.com/go-mono-repo/proto/ledger"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,11 +17,25 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Account statuses as stored in accounts.status and account_statuses.code
+// Spec: docs/specs/009-account-lifecycle.md#data-models
+const (
+	StatusActive   = "ACTIVE"
+	StatusInactive = "INACTIVE"
+	StatusClosed   = "CLOSED"
+	StatusFrozen   = "FROZEN"
+	StatusPending  = "PENDING"
+)
+
 // Manager handles account business logic
 // Spec: docs/specs/003-account-management.md
 type Manager struct {
 	repo      RepositoryInterface
 	validator *Validator
+
+	// Normal balance per account type, loaded from account_types
+	normalBalances  map[string]string
+	normalBalanceMu sync.RWMutex
 }
 
 // NewManager creates a new account manager
@@ -87,6 +105,88 @@ func (m *Manager) GetAccount(ctx context.Context, accountID string) (*pb.Account
 	return accountRowToProto(accountRow), nil
 }
 
+// GetVerifiedAccount retrieves account by ID and verifies it against the
+// trusted ledger state
+// Spec: docs/specs/006-verified-reads.md#story-1-verified-account-read
+func (m *Manager) GetVerifiedAccount(ctx context.Context, accountID string) (*pb.Account, *pb.VerificationProof, error) {
+	if accountID == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+
+	accountRow, proof, err := m.repo.GetVerifiedAccountByID(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return accountRowToProto(accountRow), proof, nil
+}
+
+// GetAccountAsOf retrieves an account as it was committed at an ImmuDB
+// transaction or at a point in time
+// Spec: docs/specs/007-account-history.md#story-2-point-in-time-account
+func (m *Manager) GetAccountAsOf(ctx context.Context, req *pb.GetAccountRequest) (*pb.Account, error) {
+	if req.AccountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+	if req.AsOfTx > 0 && req.AsOfTime != nil {
+		return nil, status.Error(codes.InvalidArgument, "only one of as_of_tx and as_of_time may be set")
+	}
+	if req.Verified {
+		return nil, status.Error(codes.InvalidArgument, "verified reads cannot be combined with as_of_tx or as_of_time")
+	}
+
+	var asOfTime *time.Time
+	if req.AsOfTime != nil {
+		if err := req.AsOfTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of_time: %v", err)
+		}
+		t := req.AsOfTime.AsTime()
+		asOfTime = &t
+	}
+
+	accountRow, err := m.repo.GetAccountAsOf(ctx, req.AccountId, req.AsOfTx, asOfTime)
+	if err != nil {
+		return nil, err
+	}
+	if accountRow == nil {
+		if asOfTime != nil {
+			return nil, status.Errorf(codes.NotFound, "account %s not found as of %s", req.AccountId, asOfTime.Format(time.RFC3339))
+		}
+		return nil, status.Errorf(codes.NotFound, "account %s not found as of tx %d", req.AccountId, req.AsOfTx)
+	}
+
+	return accountRowToProto(accountRow), nil
+}
+
+// GetAccountHistory returns every committed revision of an account, oldest
+// first, with the fields changed by each revision
+// Spec: docs/specs/007-account-history.md#story-1-account-revision-history
+func (m *Manager) GetAccountHistory(ctx context.Context, accountID string) ([]*pb.AccountRevision, error) {
+	if accountID == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+
+	rows, err := m.repo.GetAccountHistory(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*pb.AccountRevision, len(rows))
+	var previous *AccountRow
+	for i, row := range rows {
+		revisions[i] = &pb.AccountRevision{
+			Revision:      row.Account.Version,
+			TxId:          row.TxID,
+			CommittedAt:   timestamppb.New(row.CommittedAt),
+			Account:       accountRowToProto(row.Account),
+			ChangedFields: changedAccountFields(previous, row.Account),
+		}
+		previous = row.Account
+	}
+
+	return revisions, nil
+}
+
 // GetAccountByExternalID retrieves account by external ID
 // Spec: docs/specs/003-account-management.md#story-5-retrieve-account-by-external-id
 func (m *Manager) GetAccountByExternalID(ctx context.Context, externalID string) (*pb.Account, error) {
@@ -197,6 +297,8 @@ func (m *Manager) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest)
 		CurrencyCode:    req.CurrencyCode,
 		ExternalGroupID: req.ExternalGroupId,
 		NameSearch:      req.NameSearch,
+		Status:          accountStatusProtoToString(req.Status),
+		SkipTotalCount:  req.SkipTotalCount,
 	}
 
 	// Validate page size
@@ -229,8 +331,319 @@ func (m *Manager) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest)
 	}, nil
 }
 
+// FreezeAccount places a hold on an account so it can no longer transact
+// Spec: docs/specs/009-account-lifecycle.md#story-1-freeze-account
+func (m *Manager) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.Account, error) {
+	return m.changeStatus(ctx, req.AccountId, req.Reason, req.Actor, freezeTransition)
+}
+
+// CloseAccount permanently closes an account with a zero balance
+// Spec: docs/specs/009-account-lifecycle.md#story-2-close-account
+func (m *Manager) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.Account, error) {
+	return m.changeStatus(ctx, req.AccountId, req.Reason, req.Actor, closeTransition)
+}
+
+// ReopenAccount returns a frozen, inactive or closed account to active
+// Spec: docs/specs/009-account-lifecycle.md#story-3-reopen-account
+func (m *Manager) ReopenAccount(ctx context.Context, req *pb.ReopenAccountRequest) (*pb.Account, error) {
+	return m.changeStatus(ctx, req.AccountId, req.Reason, req.Actor, reopenTransition)
+}
+
+// changeStatus validates and applies a lifecycle transition. The actor
+// defaults to the caller identity from the x-user-id metadata.
+// Spec: docs/specs/009-account-lifecycle.md#status-transitions
+func (m *Manager) changeStatus(ctx context.Context, accountID, reason, actor string, transition statusTransition) (*pb.Account, error) {
+	if accountID == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+	if err := m.validator.ValidateStatusReason(reason); err != nil {
+		return nil, err
+	}
+	if actor == "" {
+		actor = audit.UserFromContext(ctx)
+	}
+	if err := m.validator.ValidateActor(actor); err != nil {
+		return nil, err
+	}
+
+	existingAccount, err := m.repo.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existingAccount.Status == transition.to {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %s is already %s",
+			accountID, strings.ToLower(transition.to))
+	}
+	if !transition.from[existingAccount.Status] {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot %s account %s in status %s",
+			transition.verb, accountID, existingAccount.Status)
+	}
+
+	change := &StatusChange{
+		Status:             transition.to,
+		Reason:             reason,
+		ChangedBy:          actor,
+		Action:             transition.action,
+		RequireZeroBalance: transition.requireZeroBalance,
+	}
+
+	updatedAccount, err := m.repo.UpdateAccountStatus(ctx, accountID, change, existingAccount.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountRowToProto(updatedAccount), nil
+}
+
+// GetAccountBalance returns the posted and available balance of a single
+// account
+// Spec: docs/specs/005-account-balances.md#story-1-current-account-balance
+func (m *Manager) GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error) {
+	if req.AccountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+
+	query, err := balanceQueryFromProto(req.AsOfTime, req.AsOfTx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetAccountBalanceResponse{}
+	if req.Verified {
+		if resp.Verification, err = m.verifyBalanceQuery(ctx, &query); err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.Balance, err = m.accountBalance(ctx, req.AccountId, query); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetAccountBalances returns posted balances for several accounts at the
+// same point in time
+// Spec: docs/specs/005-account-balances.md#story-3-batch-balances
+func (m *Manager) GetAccountBalances(ctx context.Context, req *pb.GetAccountBalancesRequest) (*pb.GetAccountBalancesResponse, error) {
+	if len(req.AccountIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "account_ids is required")
+	}
+	if len(req.AccountIds) > maxBalanceBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d account_ids allowed per request", maxBalanceBatchSize)
+	}
+
+	query, err := balanceQueryFromProto(req.AsOfTime, req.AsOfTx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, accountID := range req.AccountIds {
+		if accountID == "" {
+			return nil, status.Error(codes.InvalidArgument, "account_ids must not contain empty values")
+		}
+	}
+
+	resp := &pb.GetAccountBalancesResponse{
+		Balances: make([]*pb.AccountBalance, 0, len(req.AccountIds)),
+	}
+	if req.Verified {
+		if resp.Verification, err = m.verifyBalanceQuery(ctx, &query); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, accountID := range req.AccountIds {
+		balance, err := m.accountBalance(ctx, accountID, query)
+		if err != nil {
+			return nil, err
+		}
+		resp.Balances = append(resp.Balances, balance)
+	}
+
+	return resp, nil
+}
+
+// verifyBalanceQuery verifies the latest ledger state and pins a balance
+// query without as_of_tx to the verified transaction, so the totals are
+// computed from exactly the state covered by the proof
+// Spec: docs/specs/006-verified-reads.md#story-2-verified-balances
+func (m *Manager) verifyBalanceQuery(ctx context.Context, query *BalanceQuery) (*pb.VerificationProof, error) {
+	proof, err := m.repo.VerifyLedgerState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.AsOfTx == 0 {
+		query.AsOfTx = proof.TxId
+	} else if query.AsOfTx > proof.TxId {
+		return nil, status.Errorf(codes.InvalidArgument, "as_of_tx %d is beyond the latest verified tx %d", query.AsOfTx, proof.TxId)
+	}
+
+	return proof, nil
+}
+
+// accountBalance computes the balance of one account for a query
+func (m *Manager) accountBalance(ctx context.Context, accountID string, query BalanceQuery) (*pb.AccountBalance, error) {
+	accountRow, err := m.repo.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := m.repo.GetPostedTotals(ctx, accountID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Spec: docs/specs/013-holds.md#available-balance
+	held, err := m.repo.GetHeldTotal(ctx, accountID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	normalBalance := m.NormalBalanceFor(ctx, accountRow.AccountType)
+
+	// Net balance is positive when the account carries its normal balance
+	net := totals.DebitTotal - totals.CreditTotal
+	if normalBalance == NormalBalanceCredit {
+		net = totals.CreditTotal - totals.DebitTotal
+	}
+
+	balance := &pb.AccountBalance{
+		AccountId:        accountRow.ID,
+		CurrencyCode:     accountRow.CurrencyCode,
+		AccountType:      stringToAccountTypeProto(accountRow.AccountType),
+		NormalBalance:    stringToNormalBalanceProto(normalBalance),
+		DebitTotal:       amount.Format(totals.DebitTotal),
+		CreditTotal:      amount.Format(totals.CreditTotal),
+		Balance:          amount.Format(net),
+		LineCount:        totals.LineCount,
+		AsOfTx:           query.AsOfTx,
+		PostedBalance:    amount.Format(net),
+		HeldTotal:        amount.Format(held),
+		AvailableBalance: amount.Format(net - held),
+	}
+	if query.AsOfTime != nil {
+		balance.AsOfTime = timestamppb.New(*query.AsOfTime)
+	}
+
+	return balance, nil
+}
+
+// NormalBalanceFor resolves the normal balance of an account type from the
+// account_types table, falling back to standard accounting defaults when
+// the table cannot be read
+// Spec: docs/specs/005-account-balances.md#normal-balance-resolution
+func (m *Manager) NormalBalanceFor(ctx context.Context, accountType string) string {
+	accountType = strings.ToUpper(accountType)
+
+	m.normalBalanceMu.RLock()
+	normalBalance, found := m.normalBalances[accountType]
+	loaded := m.normalBalances != nil
+	m.normalBalanceMu.RUnlock()
+
+	if found {
+		return normalBalance
+	}
+
+	if !loaded {
+		normalBalances, err := m.repo.GetNormalBalances(ctx)
+		if err != nil {
+			log.Printf("Failed to load account types, using default normal balances: %v", err)
+		} else {
+			m.normalBalanceMu.Lock()
+			m.normalBalances = normalBalances
+			m.normalBalanceMu.Unlock()
+
+			if normalBalance, found := normalBalances[accountType]; found {
+				return normalBalance
+			}
+		}
+	}
+
+	return defaultNormalBalances[accountType]
+}
+
 // Helper functions
 
+// Normal balance sides as stored in account_types
+const (
+	NormalBalanceDebit  = "DEBIT"
+	NormalBalanceCredit = "CREDIT"
+)
+
+// maxBalanceBatchSize limits GetAccountBalances requests
+const maxBalanceBatchSize = 100
+
+// defaultNormalBalances mirrors the seed data in 002_add_account_constraints.sql
+var defaultNormalBalances = map[string]string{
+	"ASSET":     NormalBalanceDebit,
+	"EXPENSE":   NormalBalanceDebit,
+	"LIABILITY": NormalBalanceCredit,
+	"EQUITY":    NormalBalanceCredit,
+	"REVENUE":   NormalBalanceCredit,
+}
+
+// statusTransition describes the status change made by a lifecycle RPC
+type statusTransition struct {
+	verb               string          // Used in error messages
+	action             string          // Audit action
+	to                 string          // Target status
+	from               map[string]bool // Statuses the account may move from
+	requireZeroBalance bool
+}
+
+// Lifecycle transitions. Frozen accounts must be reopened before they can
+// be closed so that a compliance hold is never lifted as a side effect.
+// Spec: docs/specs/009-account-lifecycle.md#status-transitions
+var (
+	freezeTransition = statusTransition{
+		verb:   "freeze",
+		action: audit.ActionFreeze,
+		to:     StatusFrozen,
+		from:   map[string]bool{StatusActive: true, StatusInactive: true, StatusPending: true},
+	}
+	closeTransition = statusTransition{
+		verb:               "close",
+		action:             audit.ActionClose,
+		to:                 StatusClosed,
+		from:               map[string]bool{StatusActive: true, StatusInactive: true, StatusPending: true},
+		requireZeroBalance: true,
+	}
+	reopenTransition = statusTransition{
+		verb:   "reopen",
+		action: audit.ActionReopen,
+		to:     StatusActive,
+		from:   map[string]bool{StatusFrozen: true, StatusInactive: true, StatusClosed: true},
+	}
+)
+
+// balanceQueryFromProto builds a BalanceQuery from request fields
+func balanceQueryFromProto(asOfTime *timestamppb.Timestamp, asOfTx uint64) (BalanceQuery, error) {
+	query := BalanceQuery{AsOfTx: asOfTx}
+	if asOfTime != nil {
+		if err := asOfTime.CheckValid(); err != nil {
+			return query, status.Errorf(codes.InvalidArgument, "invalid as_of_time: %v", err)
+		}
+		t := asOfTime.AsTime()
+		query.AsOfTime = &t
+	}
+	return query, nil
+}
+
+// stringToNormalBalanceProto converts string to proto enum
+func stringToNormalBalanceProto(normalBalance string) pb.NormalBalance {
+	switch normalBalance {
+	case NormalBalanceDebit:
+		return pb.NormalBalance_NORMAL_BALANCE_DEBIT
+	case NormalBalanceCredit:
+		return pb.NormalBalance_NORMAL_BALANCE_CREDIT
+	default:
+		return pb.NormalBalance_NORMAL_BALANCE_UNSPECIFIED
+	}
+}
+
 // accountRowToProto converts database row to proto message
 func accountRowToProto(row *AccountRow) *pb.Account {
 	account := &pb.Account{
@@ -249,9 +662,54 @@ func accountRowToProto(row *AccountRow) *pb.Account {
 		account.ExternalGroupId = row.ExternalGroupID.String
 	}
 
+	// Status change details are only set once a lifecycle RPC has run
+	account.Status = stringToAccountStatusProto(row.Status)
+	if row.StatusReason.Valid {
+		account.StatusReason = row.StatusReason.String
+	}
+	if row.StatusChangedBy.Valid {
+		account.StatusChangedBy = row.StatusChangedBy.String
+	}
+	if row.StatusChangedAt.Valid {
+		account.StatusChangedAt = timestamppb.New(row.StatusChangedAt.Time)
+	}
+
 	return account
 }
 
+// changedAccountFields lists the proto field names that differ between two
+// revisions of an account. The first revision has no previous revision and
+// reports no changes.
+func changedAccountFields(previous, current *AccountRow) []string {
+	if previous == nil {
+		return nil
+	}
+
+	var changed []string
+	if previous.Name != current.Name {
+		changed = append(changed, "name")
+	}
+	if previous.ExternalID != current.ExternalID {
+		changed = append(changed, "external_id")
+	}
+	if previous.ExternalGroupID != current.ExternalGroupID {
+		changed = append(changed, "external_group_id")
+	}
+	if previous.CurrencyCode != current.CurrencyCode {
+		changed = append(changed, "currency_code")
+	}
+	if previous.AccountType != current.AccountType {
+		changed = append(changed, "account_type")
+	}
+	if previous.Status != current.Status {
+		changed = append(changed, "status")
+	}
+	if previous.StatusReason != current.StatusReason {
+		changed = append(changed, "status_reason")
+	}
+	return changed
+}
+
 // accountTypeProtoToString converts proto enum to string
 func accountTypeProtoToString(accountType pb.AccountType) string {
 	switch accountType {
@@ -286,4 +744,40 @@ func stringToAccountTypeProto(accountType string) pb.AccountType {
 	default:
 		return pb.AccountType_ACCOUNT_TYPE_UNSPECIFIED
 	}
-}
\ No newline at end of file
+}
+
+// accountStatusProtoToString converts proto enum to string
+func accountStatusProtoToString(accountStatus pb.AccountStatus) string {
+	switch accountStatus {
+	case pb.AccountStatus_ACCOUNT_STATUS_ACTIVE:
+		return StatusActive
+	case pb.AccountStatus_ACCOUNT_STATUS_INACTIVE:
+		return StatusInactive
+	case pb.AccountStatus_ACCOUNT_STATUS_CLOSED:
+		return StatusClosed
+	case pb.AccountStatus_ACCOUNT_STATUS_FROZEN:
+		return StatusFrozen
+	case pb.AccountStatus_ACCOUNT_STATUS_PENDING:
+		return StatusPending
+	default:
+		return ""
+	}
+}
+
+// stringToAccountStatusProto converts string to proto enum
+func stringToAccountStatusProto(accountStatus string) pb.AccountStatus {
+	switch strings.ToUpper(accountStatus) {
+	case StatusActive:
+		return pb.AccountStatus_ACCOUNT_STATUS_ACTIVE
+	case StatusInactive:
+		return pb.AccountStatus_ACCOUNT_STATUS_INACTIVE
+	case StatusClosed:
+		return pb.AccountStatus_ACCOUNT_STATUS_CLOSED
+	case StatusFrozen:
+		return pb.AccountStatus_ACCOUNT_STATUS_FROZEN
+	case StatusPending:
+		return pb.AccountStatus_ACCOUNT_STATUS_PENDING
+	default:
+		return pb.AccountStatus_ACCOUNT_STATUS_UNSPECIFIED
+	}
+}
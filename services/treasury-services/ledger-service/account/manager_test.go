@@ -4,13 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
+	"clarity/treasury-services/ledger-service/audit"
 	pb "example.com/go-mono-repo/proto/ledger"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MockRepository is a mock implementation of AccountRepository
@@ -47,6 +51,14 @@ func (m *MockRepository) UpdateAccount(ctx context.Context, accountID string, up
 	return args.Get(0).(*AccountRow), args.Error(1)
 }
 
+func (m *MockRepository) UpdateAccountStatus(ctx context.Context, accountID string, change *StatusChange, currentVersion int64) (*AccountRow, error) {
+	args := m.Called(ctx, accountID, change, currentVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AccountRow), args.Error(1)
+}
+
 func (m *MockRepository) ListAccounts(ctx context.Context, filters ListAccountFilters) ([]*AccountRow, string, int32, error) {
 	args := m.Called(ctx, filters)
 	if args.Get(0) == nil {
@@ -55,6 +67,67 @@ func (m *MockRepository) ListAccounts(ctx context.Context, filters ListAccountFi
 	return args.Get(0).([]*AccountRow), args.String(1), args.Get(2).(int32), args.Error(3)
 }
 
+func (m *MockRepository) GetPostedTotals(ctx context.Context, accountID string, query BalanceQuery) (*BalanceRow, error) {
+	args := m.Called(ctx, accountID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*BalanceRow), args.Error(1)
+}
+
+func (m *MockRepository) GetHeldTotal(ctx context.Context, accountID string, query BalanceQuery) (int64, error) {
+	args := m.Called(ctx, accountID, query)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) GetNormalBalances(ctx context.Context) (map[string]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+func (m *MockRepository) GetAccountStatuses(ctx context.Context) (map[string]bool, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func (m *MockRepository) GetVerifiedAccountByID(ctx context.Context, accountID string) (*AccountRow, *pb.VerificationProof, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*AccountRow), args.Get(1).(*pb.VerificationProof), args.Error(2)
+}
+
+func (m *MockRepository) VerifyLedgerState(ctx context.Context) (*pb.VerificationProof, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.VerificationProof), args.Error(1)
+}
+
+func (m *MockRepository) GetAccountAsOf(ctx context.Context, accountID string, asOfTx uint64, asOfTime *time.Time) (*AccountRow, error) {
+	args := m.Called(ctx, accountID, asOfTx, asOfTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*AccountRow), args.Error(1)
+}
+
+func (m *MockRepository) GetAccountHistory(ctx context.Context, accountID string) ([]*AccountRevisionRow, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*AccountRevisionRow), args.Error(1)
+}
+
 // TestCreateAccount tests the CreateAccount method
 // Spec: docs/specs/003-account-management.md#story-1-create-account
 func TestCreateAccount(t *testing.T) {
@@ -401,6 +474,31 @@ func TestListAccounts(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("skip total count", func(t *testing.T) {
+		req := &pb.ListAccountsRequest{
+			CurrencyCode:   "EUR",
+			SkipTotalCount: true,
+		}
+
+		expectedFilters := ListAccountFilters{
+			PageSize:       50,
+			AccountType:    "ACCOUNT_TYPE_UNSPECIFIED",
+			CurrencyCode:   "EUR",
+			SkipTotalCount: true,
+		}
+
+		mockRepo.On("ListAccounts", ctx, expectedFilters).
+			Return([]*AccountRow{{ID: "a1", CurrencyCode: "EUR", AccountType: "ASSET"}}, "token", int32(0), nil).Once()
+
+		result, err := manager.ListAccounts(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Accounts, 1)
+		assert.Equal(t, "token", result.NextPageToken)
+		assert.Equal(t, int32(0), result.TotalCount)
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("invalid page size", func(t *testing.T) {
 		req := &pb.ListAccountsRequest{
 			PageSize: -1,
@@ -465,4 +563,620 @@ func TestGetAccountByExternalID(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 		mockRepo.AssertNotCalled(t, "GetAccountByExternalID")
 	})
-}
\ No newline at end of file
+}
+
+// TestGetAccountBalance tests the GetAccountBalance method
+// Spec: docs/specs/005-account-balances.md#story-1-current-account-balance
+func TestGetAccountBalance(t *testing.T) {
+	ctx := context.Background()
+	normalBalances := map[string]string{"ASSET": "DEBIT", "LIABILITY": "CREDIT"}
+
+	t.Run("debit normal account", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-cash").
+			Return(&AccountRow{ID: "acc-cash", CurrencyCode: "USD", AccountType: "ASSET"}, nil).Once()
+		mockRepo.On("GetPostedTotals", ctx, "acc-cash", BalanceQuery{}).
+			Return(&BalanceRow{AccountID: "acc-cash", DebitTotal: 1500000, CreditTotal: 250000, LineCount: 3}, nil).Once()
+		mockRepo.On("GetHeldTotal", ctx, "acc-cash", BalanceQuery{}).Return(int64(400000), nil).Once()
+		mockRepo.On("GetNormalBalances", ctx).Return(normalBalances, nil).Once()
+
+		result, err := manager.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{AccountId: "acc-cash"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "150.0000", result.Balance.DebitTotal)
+		assert.Equal(t, "25.0000", result.Balance.CreditTotal)
+		assert.Equal(t, "125.0000", result.Balance.Balance)
+		assert.Equal(t, "125.0000", result.Balance.PostedBalance)
+		assert.Equal(t, "40.0000", result.Balance.HeldTotal)
+		assert.Equal(t, "85.0000", result.Balance.AvailableBalance)
+		assert.Equal(t, pb.NormalBalance_NORMAL_BALANCE_DEBIT, result.Balance.NormalBalance)
+		assert.Equal(t, int64(3), result.Balance.LineCount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("credit normal account as of tx", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-loan").
+			Return(&AccountRow{ID: "acc-loan", CurrencyCode: "USD", AccountType: "LIABILITY"}, nil).Once()
+		mockRepo.On("GetPostedTotals", ctx, "acc-loan", BalanceQuery{AsOfTx: 42}).
+			Return(&BalanceRow{AccountID: "acc-loan", DebitTotal: 100000, CreditTotal: 500000}, nil).Once()
+		mockRepo.On("GetHeldTotal", ctx, "acc-loan", BalanceQuery{AsOfTx: 42}).Return(int64(0), nil).Once()
+		mockRepo.On("GetNormalBalances", ctx).Return(normalBalances, nil).Once()
+
+		result, err := manager.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{AccountId: "acc-loan", AsOfTx: 42})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "40.0000", result.Balance.Balance)
+		assert.Equal(t, pb.NormalBalance_NORMAL_BALANCE_CREDIT, result.Balance.NormalBalance)
+		assert.Equal(t, uint64(42), result.Balance.AsOfTx)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("as of time", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+		asOf := time.Date(2025, 8, 19, 23, 59, 59, 0, time.UTC)
+
+		mockRepo.On("GetAccountByID", ctx, "acc-cash").
+			Return(&AccountRow{ID: "acc-cash", CurrencyCode: "USD", AccountType: "ASSET"}, nil).Once()
+		mockRepo.On("GetPostedTotals", ctx, "acc-cash", mock.MatchedBy(func(q BalanceQuery) bool {
+			return q.AsOfTime != nil && q.AsOfTime.Equal(asOf)
+		})).Return(&BalanceRow{AccountID: "acc-cash"}, nil).Once()
+		mockRepo.On("GetHeldTotal", ctx, "acc-cash", mock.AnythingOfType("account.BalanceQuery")).Return(int64(0), nil).Once()
+		mockRepo.On("GetNormalBalances", ctx).Return(normalBalances, nil).Once()
+
+		result, err := manager.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{
+			AccountId: "acc-cash",
+			AsOfTime:  timestamppb.New(asOf),
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0.0000", result.Balance.Balance)
+		assert.True(t, result.Balance.AsOfTime.AsTime().Equal(asOf))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("falls back to default normal balances", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-rev").
+			Return(&AccountRow{ID: "acc-rev", CurrencyCode: "USD", AccountType: "REVENUE"}, nil).Once()
+		mockRepo.On("GetPostedTotals", ctx, "acc-rev", BalanceQuery{}).
+			Return(&BalanceRow{AccountID: "acc-rev", CreditTotal: 10000}, nil).Once()
+		mockRepo.On("GetHeldTotal", ctx, "acc-rev", BalanceQuery{}).Return(int64(0), nil).Once()
+		mockRepo.On("GetNormalBalances", ctx).
+			Return(nil, status.Error(codes.Internal, "table does not exist")).Once()
+
+		result, err := manager.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{AccountId: "acc-rev"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0000", result.Balance.Balance)
+		assert.Equal(t, pb.NormalBalance_NORMAL_BALANCE_CREDIT, result.Balance.NormalBalance)
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "missing").
+			Return(nil, status.Error(codes.NotFound, "account missing not found")).Once()
+
+		result, err := manager.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{AccountId: "missing"})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+// TestGetAccountBalances tests the GetAccountBalances method
+// Spec: docs/specs/005-account-balances.md#story-3-batch-balances
+func TestGetAccountBalances(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("loads normal balances once", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetNormalBalances", ctx).
+			Return(map[string]string{"ASSET": "DEBIT", "EQUITY": "CREDIT"}, nil).Once()
+		for _, row := range []*AccountRow{
+			{ID: "a1", CurrencyCode: "USD", AccountType: "ASSET"},
+			{ID: "a2", CurrencyCode: "USD", AccountType: "EQUITY"},
+		} {
+			mockRepo.On("GetAccountByID", ctx, row.ID).Return(row, nil).Once()
+			mockRepo.On("GetPostedTotals", ctx, row.ID, BalanceQuery{}).
+				Return(&BalanceRow{AccountID: row.ID, DebitTotal: 20000, CreditTotal: 50000}, nil).Once()
+			mockRepo.On("GetHeldTotal", ctx, row.ID, BalanceQuery{}).Return(int64(0), nil).Once()
+		}
+
+		result, err := manager.GetAccountBalances(ctx, &pb.GetAccountBalancesRequest{AccountIds: []string{"a1", "a2"}})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Balances, 2)
+		assert.Equal(t, "-3.0000", result.Balances[0].Balance)
+		assert.Equal(t, "3.0000", result.Balances[1].Balance)
+		assert.Nil(t, result.Verification)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("empty request", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), NewValidator())
+
+		result, err := manager.GetAccountBalances(ctx, &pb.GetAccountBalancesRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("too many accounts", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), NewValidator())
+		ids := make([]string, maxBalanceBatchSize+1)
+		for i := range ids {
+			ids[i] = "acc"
+		}
+
+		result, err := manager.GetAccountBalances(ctx, &pb.GetAccountBalancesRequest{AccountIds: ids})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestGetVerifiedAccount tests the GetVerifiedAccount method
+// Spec: docs/specs/006-verified-reads.md#story-1-verified-account-read
+func TestGetVerifiedAccount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns account with proof", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+		proof := &pb.VerificationProof{TxId: 7, TrustedTxId: 9}
+
+		mockRepo.On("GetVerifiedAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Name: "Cash", CurrencyCode: "USD", AccountType: "ASSET"}, proof, nil).Once()
+
+		account, result, err := manager.GetVerifiedAccount(ctx, "acc-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "acc-1", account.Id)
+		assert.Equal(t, proof, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("verification failure", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetVerifiedAccountByID", ctx, "acc-1").
+			Return(nil, nil, status.Error(codes.DataLoss, "verification failed for accounts row")).Once()
+
+		account, result, err := manager.GetVerifiedAccount(ctx, "acc-1")
+
+		assert.Error(t, err)
+		assert.Nil(t, account)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.DataLoss, status.Code(err))
+	})
+
+	t.Run("empty ID", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), NewValidator())
+
+		_, _, err := manager.GetVerifiedAccount(ctx, "")
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestGetAccountBalanceVerified tests verified balance reads
+// Spec: docs/specs/006-verified-reads.md#story-2-verified-balances
+func TestGetAccountBalanceVerified(t *testing.T) {
+	ctx := context.Background()
+	normalBalances := map[string]string{"ASSET": "DEBIT"}
+
+	t.Run("pins query to verified tx", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+		proof := &pb.VerificationProof{TxId: 120, TrustedTxId: 120}
+
+		mockRepo.On("VerifyLedgerState", ctx).Return(proof, nil).Once()
+		mockRepo.On("GetAccountByID", ctx, "acc-cash").
+			Return(&AccountRow{ID: "acc-cash", CurrencyCode: "USD", AccountType: "ASSET"}, nil).Once()
+		mockRepo.On("GetPostedTotals", ctx, "acc-cash", BalanceQuery{AsOfTx: 120}).
+			Return(&BalanceRow{AccountID: "acc-cash", DebitTotal: 10000}, nil).Once()
+		mockRepo.On("GetHeldTotal", ctx, "acc-cash", BalanceQuery{AsOfTx: 120}).Return(int64(0), nil).Once()
+		mockRepo.On("GetNormalBalances", ctx).Return(normalBalances, nil).Once()
+
+		result, err := manager.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{AccountId: "acc-cash", Verified: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0000", result.Balance.Balance)
+		assert.Equal(t, uint64(120), result.Balance.AsOfTx)
+		assert.Equal(t, proof, result.Verification)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("as_of_tx beyond verified state", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("VerifyLedgerState", ctx).Return(&pb.VerificationProof{TxId: 10}, nil).Once()
+
+		result, err := manager.GetAccountBalance(ctx, &pb.GetAccountBalanceRequest{AccountId: "acc-cash", AsOfTx: 11, Verified: true})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("batch shares one verification", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("VerifyLedgerState", ctx).Return(&pb.VerificationProof{TxId: 55}, nil).Once()
+		mockRepo.On("GetNormalBalances", ctx).Return(normalBalances, nil).Once()
+		for _, id := range []string{"a1", "a2"} {
+			mockRepo.On("GetAccountByID", ctx, id).
+				Return(&AccountRow{ID: id, CurrencyCode: "USD", AccountType: "ASSET"}, nil).Once()
+			mockRepo.On("GetPostedTotals", ctx, id, BalanceQuery{AsOfTx: 55}).
+				Return(&BalanceRow{AccountID: id}, nil).Once()
+			mockRepo.On("GetHeldTotal", ctx, id, BalanceQuery{AsOfTx: 55}).Return(int64(0), nil).Once()
+		}
+
+		result, err := manager.GetAccountBalances(ctx, &pb.GetAccountBalancesRequest{AccountIds: []string{"a1", "a2"}, Verified: true})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Balances, 2)
+		assert.Equal(t, uint64(55), result.Verification.TxId)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+// TestGetAccountAsOf tests point-in-time account reads
+// Spec: docs/specs/007-account-history.md#story-2-point-in-time-account
+func TestGetAccountAsOf(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("as of tx", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountAsOf", ctx, "acc-1", uint64(8), (*time.Time)(nil)).
+			Return(&AccountRow{ID: "acc-1", Name: "Old Name", AccountType: "ASSET", Version: 1}, nil).Once()
+
+		result, err := manager.GetAccountAsOf(ctx, &pb.GetAccountRequest{AccountId: "acc-1", AsOfTx: 8})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Old Name", result.Name)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("as of time", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+		asOf := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+		mockRepo.On("GetAccountAsOf", ctx, "acc-1", uint64(0), mock.MatchedBy(func(t *time.Time) bool {
+			return t != nil && t.Equal(asOf)
+		})).Return(&AccountRow{ID: "acc-1", Version: 2}, nil).Once()
+
+		result, err := manager.GetAccountAsOf(ctx, &pb.GetAccountRequest{AccountId: "acc-1", AsOfTime: timestamppb.New(asOf)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), result.Version)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("did not exist yet", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountAsOf", ctx, "acc-1", uint64(2), (*time.Time)(nil)).Return(nil, nil).Once()
+
+		result, err := manager.GetAccountAsOf(ctx, &pb.GetAccountRequest{AccountId: "acc-1", AsOfTx: 2})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Contains(t, err.Error(), "as of tx 2")
+	})
+
+	t.Run("both selectors", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), NewValidator())
+
+		_, err := manager.GetAccountAsOf(ctx, &pb.GetAccountRequest{AccountId: "acc-1", AsOfTx: 2, AsOfTime: timestamppb.Now()})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("combined with verified", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), NewValidator())
+
+		_, err := manager.GetAccountAsOf(ctx, &pb.GetAccountRequest{AccountId: "acc-1", AsOfTx: 2, Verified: true})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestGetAccountHistory tests the account revision history
+// Spec: docs/specs/007-account-history.md#story-1-account-revision-history
+func TestGetAccountHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports changed fields per revision", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+		created := time.Date(2025, 8, 1, 9, 0, 0, 0, time.UTC)
+
+		mockRepo.On("GetAccountHistory", ctx, "acc-1").Return([]*AccountRevisionRow{
+			{
+				Account:     &AccountRow{ID: "acc-1", Name: "Cash", ExternalID: "EXT-1", AccountType: "ASSET", Version: 1},
+				TxID:        10,
+				CommittedAt: created,
+			},
+			{
+				Account:     &AccountRow{ID: "acc-1", Name: "Operating Cash", ExternalID: "EXT-1", AccountType: "ASSET", Version: 2},
+				TxID:        25,
+				CommittedAt: created.Add(time.Hour),
+			},
+			{
+				Account: &AccountRow{ID: "acc-1", Name: "Operating Cash", ExternalID: "EXT-1", AccountType: "EXPENSE",
+					ExternalGroupID: sql.NullString{String: "GRP", Valid: true}, Version: 3},
+				TxID:        31,
+				CommittedAt: created.Add(2 * time.Hour),
+			},
+		}, nil).Once()
+
+		result, err := manager.GetAccountHistory(ctx, "acc-1")
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 3)
+		assert.Equal(t, int64(1), result[0].Revision)
+		assert.Equal(t, uint64(10), result[0].TxId)
+		assert.Empty(t, result[0].ChangedFields)
+		assert.Equal(t, []string{"name"}, result[1].ChangedFields)
+		assert.Equal(t, []string{"external_group_id", "account_type"}, result[2].ChangedFields)
+		assert.Equal(t, pb.AccountType_ACCOUNT_TYPE_EXPENSE, result[2].Account.AccountType)
+		assert.True(t, result[2].CommittedAt.AsTime().Equal(created.Add(2*time.Hour)))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountHistory", ctx, "missing").
+			Return(nil, status.Error(codes.NotFound, "account missing not found")).Once()
+
+		result, err := manager.GetAccountHistory(ctx, "missing")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("empty ID", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), NewValidator())
+
+		_, err := manager.GetAccountHistory(ctx, "")
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestFreezeAccount tests freezing an account
+// Spec: docs/specs/009-account-lifecycle.md#story-1-freeze-account
+func TestFreezeAccount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("freezes an active account", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+		changedAt := time.Date(2025, 8, 30, 14, 0, 0, 0, time.UTC)
+
+		mockRepo.On("GetAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusActive, Version: 4}, nil).Once()
+		mockRepo.On("UpdateAccountStatus", ctx, "acc-1", &StatusChange{
+			Status:    StatusFrozen,
+			Reason:    "Sanctions screening hit",
+			ChangedBy: "compliance-bot",
+			Action:    audit.ActionFreeze,
+		}, int64(4)).Return(&AccountRow{
+			ID:              "acc-1",
+			Status:          StatusFrozen,
+			StatusReason:    sql.NullString{String: "Sanctions screening hit", Valid: true},
+			StatusChangedBy: sql.NullString{String: "compliance-bot", Valid: true},
+			StatusChangedAt: sql.NullTime{Time: changedAt, Valid: true},
+			Version:         5,
+		}, nil).Once()
+
+		result, err := manager.FreezeAccount(ctx, &pb.FreezeAccountRequest{
+			AccountId: "acc-1",
+			Reason:    "Sanctions screening hit",
+			Actor:     "compliance-bot",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.AccountStatus_ACCOUNT_STATUS_FROZEN, result.Status)
+		assert.Equal(t, "Sanctions screening hit", result.StatusReason)
+		assert.Equal(t, "compliance-bot", result.StatusChangedBy)
+		assert.True(t, result.StatusChangedAt.AsTime().Equal(changedAt))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("actor defaults to caller identity", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+		callerCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(audit.UserIDMetadataKey, "alice"))
+
+		mockRepo.On("GetAccountByID", callerCtx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusActive, Version: 1}, nil).Once()
+		mockRepo.On("UpdateAccountStatus", callerCtx, "acc-1", mock.MatchedBy(func(change *StatusChange) bool {
+			return change.ChangedBy == "alice"
+		}), int64(1)).Return(&AccountRow{ID: "acc-1", Status: StatusFrozen, Version: 2}, nil).Once()
+
+		_, err := manager.FreezeAccount(callerCtx, &pb.FreezeAccountRequest{AccountId: "acc-1", Reason: "Fraud review"})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already frozen", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusFrozen, Version: 2}, nil).Once()
+
+		_, err := manager.FreezeAccount(ctx, &pb.FreezeAccountRequest{AccountId: "acc-1", Reason: "Fraud review"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "already frozen")
+		mockRepo.AssertNotCalled(t, "UpdateAccountStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("closed account", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusClosed, Version: 2}, nil).Once()
+
+		_, err := manager.FreezeAccount(ctx, &pb.FreezeAccountRequest{AccountId: "acc-1", Reason: "Fraud review"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		mockRepo.AssertNotCalled(t, "UpdateAccountStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing reason", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), NewValidator())
+
+		_, err := manager.FreezeAccount(ctx, &pb.FreezeAccountRequest{AccountId: "acc-1", Reason: "  "})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("empty ID", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), NewValidator())
+
+		_, err := manager.FreezeAccount(ctx, &pb.FreezeAccountRequest{Reason: "Fraud review"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestCloseAccount tests closing an account
+// Spec: docs/specs/009-account-lifecycle.md#story-2-close-account
+func TestCloseAccount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("requires a zero balance", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusActive, Version: 7}, nil).Once()
+		mockRepo.On("UpdateAccountStatus", ctx, "acc-1", &StatusChange{
+			Status:             StatusClosed,
+			Reason:             "Customer request",
+			ChangedBy:          audit.AnonymousUser,
+			Action:             audit.ActionClose,
+			RequireZeroBalance: true,
+		}, int64(7)).Return(&AccountRow{ID: "acc-1", Status: StatusClosed, Version: 8}, nil).Once()
+
+		result, err := manager.CloseAccount(ctx, &pb.CloseAccountRequest{AccountId: "acc-1", Reason: "Customer request"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.AccountStatus_ACCOUNT_STATUS_CLOSED, result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("non-zero balance", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusActive, Version: 7}, nil).Once()
+		mockRepo.On("UpdateAccountStatus", ctx, "acc-1", mock.Anything, int64(7)).
+			Return(nil, status.Error(codes.FailedPrecondition, "account acc-1 has a non-zero balance (debits 10.0000, credits 0.0000)")).Once()
+
+		result, err := manager.CloseAccount(ctx, &pb.CloseAccountRequest{AccountId: "acc-1", Reason: "Customer request"})
+
+		assert.Nil(t, result)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("frozen account", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusFrozen, Version: 3}, nil).Once()
+
+		_, err := manager.CloseAccount(ctx, &pb.CloseAccountRequest{AccountId: "acc-1", Reason: "Customer request"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "cannot close account acc-1 in status FROZEN")
+		mockRepo.AssertNotCalled(t, "UpdateAccountStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestReopenAccount tests reopening an account
+// Spec: docs/specs/009-account-lifecycle.md#story-3-reopen-account
+func TestReopenAccount(t *testing.T) {
+	ctx := context.Background()
+
+	for _, from := range []string{StatusFrozen, StatusInactive, StatusClosed} {
+		t.Run("from "+from, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			manager := NewManager(mockRepo, NewValidator())
+
+			mockRepo.On("GetAccountByID", ctx, "acc-1").
+				Return(&AccountRow{ID: "acc-1", Status: from, Version: 2}, nil).Once()
+			mockRepo.On("UpdateAccountStatus", ctx, "acc-1", &StatusChange{
+				Status:    StatusActive,
+				Reason:    "Review cleared",
+				ChangedBy: "bob",
+				Action:    audit.ActionReopen,
+			}, int64(2)).Return(&AccountRow{ID: "acc-1", Status: StatusActive, Version: 3}, nil).Once()
+
+			result, err := manager.ReopenAccount(ctx, &pb.ReopenAccountRequest{AccountId: "acc-1", Reason: "Review cleared", Actor: "bob"})
+
+			assert.NoError(t, err)
+			assert.Equal(t, pb.AccountStatus_ACCOUNT_STATUS_ACTIVE, result.Status)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("already active", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusActive, Version: 1}, nil).Once()
+
+		_, err := manager.ReopenAccount(ctx, &pb.ReopenAccountRequest{AccountId: "acc-1", Reason: "Review cleared"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "already active")
+	})
+
+	t.Run("pending account", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		manager := NewManager(mockRepo, NewValidator())
+
+		mockRepo.On("GetAccountByID", ctx, "acc-1").
+			Return(&AccountRow{ID: "acc-1", Status: StatusPending, Version: 1}, nil).Once()
+
+		_, err := manager.ReopenAccount(ctx, &pb.ReopenAccountRequest{AccountId: "acc-1", Reason: "Review cleared"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -2,30 +2,46 @@ package account
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
 
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	"clarity/treasury-services/ledger-service/pkg/verification"
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/pkg/api/schema"
 	"github.com/codenotary/immudb/pkg/client"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // AccountRepository handles database operations for accounts
 // Spec: docs/specs/003-account-management.md
 type AccountRepository struct {
-	db client.ImmuClient
+	db       client.ImmuClient
+	verifier *verification.Verifier
+	cursors  *pagination.Codec
 }
 
 // NewAccountRepository creates a new account repository
-func NewAccountRepository(db client.ImmuClient) *AccountRepository {
+func NewAccountRepository(db client.ImmuClient, cursors *pagination.Codec) *AccountRepository {
 	return &AccountRepository{
-		db: db,
+		db:       db,
+		verifier: verification.NewVerifier(db),
+		cursors:  cursors,
 	}
 }
 
+// accountsCursorScope binds ListAccounts page tokens to that RPC
+const accountsCursorScope = "ledger.accounts"
+
 // AccountRow represents a database row for an account
 type AccountRow struct {
 	ID              string
@@ -37,6 +53,10 @@ type AccountRow struct {
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	Version         int64
+	Status          string
+	StatusReason    sql.NullString
+	StatusChangedBy sql.NullString
+	StatusChangedAt sql.NullTime
 }
 
 // CreateAccount creates a new account in the database
@@ -52,15 +72,20 @@ func (r *AccountRepository) CreateAccount(ctx context.Context, account *AccountR
 	account.CreatedAt = now
 	account.UpdatedAt = now
 	account.Version = 1
+	if account.Status == "" {
+		account.Status = StatusActive
+	}
 
 	// Prepare SQL statement
 	query := `
 		INSERT INTO accounts (
 			id, name, external_id, external_group_id, 
-			currency_code, account_type, created_at, updated_at, version
+			currency_code, account_type, created_at, updated_at, version,
+			status, status_reason, status_changed_by, status_changed_at
 		) VALUES (
 			@id, @name, @external_id, @external_group_id,
-			@currency_code, @account_type, @created_at, @updated_at, @version
+			@currency_code, @account_type, @created_at, @updated_at, @version,
+			@status, @status_reason, @status_changed_by, @status_changed_at
 		)`
 
 	params := map[string]interface{}{
@@ -72,6 +97,7 @@ func (r *AccountRepository) CreateAccount(ctx context.Context, account *AccountR
 		"created_at":    account.CreatedAt,
 		"updated_at":    account.UpdatedAt,
 		"version":       account.Version,
+		"status":        account.Status,
 	}
 	
 	// Handle nullable external_group_id
@@ -81,25 +107,128 @@ func (r *AccountRepository) CreateAccount(ctx context.Context, account *AccountR
 		params["external_group_id"] = nil
 	}
 
-	_, err := r.db.SQLExec(ctx, query, params)
+	// Status change details are only set by lifecycle RPCs
+	params["status_reason"] = nullableString(account.StatusReason)
+	params["status_changed_by"] = nullableString(account.StatusChangedBy)
+	params["status_changed_at"] = nil
+	if account.StatusChangedAt.Valid {
+		params["status_changed_at"] = account.StatusChangedAt.Time
+	}
+
+	// Accounts created before external IDs were reserved have no key row
+	if _, err := r.GetAccountByExternalID(ctx, account.ExternalID); err == nil {
+		return externalIDExistsError(account.ExternalID)
+	} else if status.Code(err) != codes.NotFound {
+		return err
+	}
+
+	// Write the external ID key, the account and its audit record atomically
+	// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+	tx, err := r.db.NewTx(ctx)
 	if err != nil {
-		// Check for unique constraint violation
-		if strings.Contains(err.Error(), "unique") || strings.Contains(err.Error(), "duplicate") {
-			return status.Errorf(codes.AlreadyExists, "account with external_id %s already exists", account.ExternalID)
-		}
-		return status.Errorf(codes.Internal, "failed to create account: %v", err)
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	// The key row makes a concurrent create with the same external ID fail
+	// Spec: docs/specs/003-account-management.md#external-id-uniqueness
+	keyParams := map[string]interface{}{
+		"external_id_hash": externalIDHash(account.ExternalID),
+		"external_id":      account.ExternalID,
+		"account_id":       account.ID,
+		"created_at":       account.CreatedAt,
+	}
+	if err := tx.SQLExec(ctx, insertExternalIDQuery, keyParams); err != nil {
+		tx.Rollback(ctx)
+		return r.createAccountError(ctx, account, err)
+	}
+
+	if err := tx.SQLExec(ctx, query, params); err != nil {
+		tx.Rollback(ctx)
+		return r.createAccountError(ctx, account, err)
+	}
+
+	event := &audit.Event{
+		EntityType: audit.EntityAccount,
+		EntityID:   account.ID,
+		Action:     audit.ActionCreate,
+		NewValues:  accountAuditValues(account),
+	}
+	if err := audit.Write(ctx, tx, event); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	if _, err := tx.Commit(ctx); err != nil {
+		return r.createAccountError(ctx, account, err)
 	}
 
 	return nil
 }
 
+const insertExternalIDQuery = `
+	INSERT INTO account_external_ids (
+		external_id_hash, external_id, account_id, created_at
+	) VALUES (
+		@external_id_hash, @external_id, @account_id, @created_at
+	)`
+
+// createAccountError maps an insert or commit error to a gRPC status
+// Spec: docs/specs/003-account-management.md#external-id-uniqueness
+func (r *AccountRepository) createAccountError(ctx context.Context, account *AccountRow, err error) error {
+	// The key row was committed before this transaction began
+	if strings.Contains(err.Error(), "key already exists") {
+		return externalIDExistsError(account.ExternalID)
+	}
+
+	// Another transaction committed first. It may have taken the same
+	// external ID or only touched the same keys.
+	if strings.Contains(err.Error(), "conflict") {
+		taken, lookupErr := r.externalIDTaken(ctx, account.ExternalID)
+		if lookupErr != nil {
+			return lookupErr
+		}
+		if taken {
+			return externalIDExistsError(account.ExternalID)
+		}
+		return status.Errorf(codes.Aborted, "account creation conflicted with a concurrent write, retry")
+	}
+
+	return status.Errorf(codes.Internal, "failed to create account: %v", err)
+}
+
+// externalIDTaken reports whether an account holds the external ID key
+func (r *AccountRepository) externalIDTaken(ctx context.Context, externalID string) (bool, error) {
+	result, err := r.db.SQLQuery(ctx, `
+		SELECT account_id
+		FROM account_external_ids
+		WHERE external_id_hash = @external_id_hash`,
+		map[string]interface{}{"external_id_hash": externalIDHash(externalID)}, false)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to query external id: %v", err)
+	}
+	return len(result.Rows) > 0, nil
+}
+
+// externalIDHash returns the primary key of an external ID's key row.
+// ImmuDB primary keys cannot be as long as the longest external ID.
+func externalIDHash(externalID string) string {
+	sum := sha256.Sum256([]byte(externalID))
+	return hex.EncodeToString(sum[:])
+}
+
+// externalIDExistsError is returned when an external ID is already used
+func externalIDExistsError(externalID string) error {
+	return status.Errorf(codes.AlreadyExists, "account with external_id %s already exists", externalID)
+}
+
 // GetAccountByID retrieves an account by its system ID
 // Spec: docs/specs/003-account-management.md#story-2-retrieve-account
 func (r *AccountRepository) GetAccountByID(ctx context.Context, accountID string) (*AccountRow, error) {
 	query := `
 		SELECT 
 			id, name, external_id, external_group_id,
-			currency_code, account_type, created_at, updated_at, version
+			currency_code, account_type, created_at, updated_at, version,
+			status, status_reason, status_changed_by, status_changed_at
 		FROM accounts
 		WHERE id = @id`
 
@@ -117,28 +246,7 @@ func (r *AccountRepository) GetAccountByID(ctx context.Context, accountID string
 		return nil, status.Errorf(codes.NotFound, "account %s not found", accountID)
 	}
 
-	// Parse the first row
-	row := result.Rows[0]
-	account := &AccountRow{
-		ID:           string(row.Values[0].GetS()),
-		Name:         string(row.Values[1].GetS()),
-		ExternalID:   string(row.Values[2].GetS()),
-		CurrencyCode: string(row.Values[4].GetS()),
-		AccountType:  string(row.Values[5].GetS()),
-		CreatedAt:    time.UnixMicro(row.Values[6].GetTs()),
-		UpdatedAt:    time.UnixMicro(row.Values[7].GetTs()),
-		Version:      row.Values[8].GetN(),
-	}
-	
-	// Handle optional external_group_id (index 3)
-	if row.Values[3] != nil && len(row.Values[3].GetS()) > 0 {
-		account.ExternalGroupID = sql.NullString{
-			String: string(row.Values[3].GetS()),
-			Valid:  true,
-		}
-	}
-
-	return account, nil
+	return parseAccountRow(result.Rows[0]), nil
 }
 
 // GetAccountByExternalID retrieves an account by its external ID
@@ -147,7 +255,8 @@ func (r *AccountRepository) GetAccountByExternalID(ctx context.Context, external
 	query := `
 		SELECT 
 			id, name, external_id, external_group_id,
-			currency_code, account_type, created_at, updated_at, version
+			currency_code, account_type, created_at, updated_at, version,
+			status, status_reason, status_changed_by, status_changed_at
 		FROM accounts
 		WHERE external_id = @external_id`
 
@@ -165,28 +274,7 @@ func (r *AccountRepository) GetAccountByExternalID(ctx context.Context, external
 		return nil, status.Errorf(codes.NotFound, "account with external_id %s not found", externalID)
 	}
 
-	// Parse the first row
-	row := result.Rows[0]
-	account := &AccountRow{
-		ID:           string(row.Values[0].GetS()),
-		Name:         string(row.Values[1].GetS()),
-		ExternalID:   string(row.Values[2].GetS()),
-		CurrencyCode: string(row.Values[4].GetS()),
-		AccountType:  string(row.Values[5].GetS()),
-		CreatedAt:    time.UnixMicro(row.Values[6].GetTs()),
-		UpdatedAt:    time.UnixMicro(row.Values[7].GetTs()),
-		Version:      row.Values[8].GetN(),
-	}
-	
-	// Handle optional external_group_id (index 3)
-	if row.Values[3] != nil && len(row.Values[3].GetS()) > 0 {
-		account.ExternalGroupID = sql.NullString{
-			String: string(row.Values[3].GetS()),
-			Valid:  true,
-		}
-	}
-
-	return account, nil
+	return parseAccountRow(result.Rows[0]), nil
 }
 
 // UpdateAccount updates an existing account with optimistic locking
@@ -225,16 +313,57 @@ func (r *AccountRepository) UpdateAccount(ctx context.Context, accountID string,
 		WHERE id = @id AND version = @version`,
 		strings.Join(setClauses, ", "))
 
-	_, err := r.db.SQLExec(ctx, query, params)
+	// Update the account and write its audit record atomically. Reading the
+	// current row inside the transaction also gives the audit diff its old
+	// values and lets ImmuDB detect concurrent writers at commit.
+	// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+	tx, err := r.db.NewTx(ctx)
 	if err != nil {
-		// Check if it's a version mismatch
-		if strings.Contains(err.Error(), "version") {
-			// Check if account exists
-			_, err := r.GetAccountByID(ctx, accountID)
-			if err != nil {
-				return nil, err // Will return NotFound if account doesn't exist
-			}
-			// Account exists but version mismatch
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	current, err := getAccountForUpdate(ctx, tx, accountID, currentVersion)
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if err := tx.SQLExec(ctx, query, params); err != nil {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to update account: %v", err)
+	}
+
+	updated := *current
+	updated.Version = currentVersion + 1
+	updated.UpdatedAt = params["updated_at"].(time.Time)
+	if name, ok := params["name"].(string); ok {
+		updated.Name = name
+	}
+	switch groupID := params["external_group_id"].(type) {
+	case string:
+		updated.ExternalGroupID = sql.NullString{String: groupID, Valid: groupID != ""}
+	case sql.NullString:
+		updated.ExternalGroupID = groupID
+	}
+	if accountType, ok := params["account_type"].(string); ok {
+		updated.AccountType = accountType
+	}
+
+	event := &audit.Event{
+		EntityType: audit.EntityAccount,
+		EntityID:   accountID,
+		Action:     audit.ActionUpdate,
+		OldValues:  accountAuditValues(current),
+		NewValues:  accountAuditValues(&updated),
+	}
+	if err := audit.Write(ctx, tx, event); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if _, err := tx.Commit(ctx); err != nil {
+		// A concurrent update of the same row fails the commit
+		if strings.Contains(err.Error(), "conflict") || strings.Contains(err.Error(), "version") {
 			return nil, status.Errorf(codes.Aborted, "account was modified, retry update")
 		}
 		return nil, status.Errorf(codes.Internal, "failed to update account: %v", err)
@@ -257,6 +386,152 @@ func (r *AccountRepository) UpdateAccount(ctx context.Context, accountID string,
 	return updatedAccount, nil
 }
 
+// getAccountForUpdate reads the current account row inside a transaction
+// and checks it still has the version the caller based its change on
+func getAccountForUpdate(ctx context.Context, tx client.Tx, accountID string, currentVersion int64) (*AccountRow, error) {
+	query := `
+		SELECT 
+			id, name, external_id, external_group_id,
+			currency_code, account_type, created_at, updated_at, version,
+			status, status_reason, status_changed_by, status_changed_at
+		FROM accounts
+		WHERE id = @id`
+
+	result, err := tx.SQLQuery(ctx, query, map[string]interface{}{"id": accountID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query account: %v", err)
+	}
+	if len(result.Rows) == 0 {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", accountID)
+	}
+
+	current := parseAccountRow(result.Rows[0])
+	if current.Version != currentVersion {
+		return nil, status.Errorf(codes.Aborted, "account was modified, retry update")
+	}
+
+	return current, nil
+}
+
+// StatusChange describes a lifecycle transition applied by UpdateAccountStatus
+type StatusChange struct {
+	Status             string // Target status
+	Reason             string // Why the status changed
+	ChangedBy          string // Actor requesting the change
+	Action             string // Audit action recorded for the change
+	RequireZeroBalance bool   // Reject the change unless posted debits equal credits
+}
+
+// UpdateAccountStatus moves an account to a new lifecycle status with
+// optimistic locking and records the change in the audit log. When a zero
+// balance is required, the posted totals are read inside the transaction so
+// that a posting committed to the account in the meantime aborts the change.
+// Spec: docs/specs/009-account-lifecycle.md#status-transitions
+func (r *AccountRepository) UpdateAccountStatus(ctx context.Context, accountID string, change *StatusChange, currentVersion int64) (*AccountRow, error) {
+	tx, err := r.db.NewTx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	current, err := getAccountForUpdate(ctx, tx, accountID, currentVersion)
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if change.RequireZeroBalance {
+		totalsQuery := `
+			SELECT SUM(debit_amount), SUM(credit_amount)
+			FROM journal_entry_lines
+			WHERE account_id = @account_id`
+
+		totals, err := tx.SQLQuery(ctx, totalsQuery, map[string]interface{}{"account_id": accountID})
+		if err != nil {
+			tx.Rollback(ctx)
+			return nil, status.Errorf(codes.Internal, "failed to query account balance: %v", err)
+		}
+		if len(totals.Rows) > 0 {
+			debits, credits := totals.Rows[0].Values[0].GetN(), totals.Rows[0].Values[1].GetN()
+			if debits != credits {
+				tx.Rollback(ctx)
+				return nil, status.Errorf(codes.FailedPrecondition,
+					"account %s has a non-zero balance (debits %s, credits %s)",
+					accountID, amount.Format(debits), amount.Format(credits))
+			}
+		}
+
+		// Spec: docs/specs/013-holds.md#story-4-hold-expiry-and-account-close
+		holds, err := tx.SQLQuery(ctx, `
+			SELECT COUNT(*) FROM holds
+			WHERE account_id = @account_id AND status = 'ACTIVE' AND expires_at > @now`,
+			map[string]interface{}{"account_id": accountID, "now": time.Now()})
+		if err != nil {
+			tx.Rollback(ctx)
+			return nil, status.Errorf(codes.Internal, "failed to query holds: %v", err)
+		}
+		if len(holds.Rows) > 0 && holds.Rows[0].Values[0].GetN() > 0 {
+			tx.Rollback(ctx)
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"account %s has %d active holds", accountID, holds.Rows[0].Values[0].GetN())
+		}
+	}
+
+	now := time.Now()
+	query := `
+		UPDATE accounts
+		SET status = @status, status_reason = @status_reason,
+			status_changed_by = @status_changed_by, status_changed_at = @status_changed_at,
+			version = @new_version, updated_at = @updated_at
+		WHERE id = @id AND version = @version`
+
+	params := map[string]interface{}{
+		"id":                accountID,
+		"version":           currentVersion,
+		"new_version":       currentVersion + 1,
+		"updated_at":        now,
+		"status":            change.Status,
+		"status_reason":     change.Reason,
+		"status_changed_by": change.ChangedBy,
+		"status_changed_at": now,
+	}
+
+	if err := tx.SQLExec(ctx, query, params); err != nil {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to update account status: %v", err)
+	}
+
+	updated := *current
+	updated.Version = currentVersion + 1
+	updated.UpdatedAt = now
+	updated.Status = change.Status
+	updated.StatusReason = sql.NullString{String: change.Reason, Valid: true}
+	updated.StatusChangedBy = sql.NullString{String: change.ChangedBy, Valid: true}
+	updated.StatusChangedAt = sql.NullTime{Time: now, Valid: true}
+
+	// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+	event := &audit.Event{
+		EntityType: audit.EntityAccount,
+		EntityID:   accountID,
+		Action:     change.Action,
+		OldValues:  accountAuditValues(current),
+		NewValues:  accountAuditValues(&updated),
+	}
+	if err := audit.Write(ctx, tx, event); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if _, err := tx.Commit(ctx); err != nil {
+		// A concurrent update of the row or posting to the account fails the commit
+		if strings.Contains(err.Error(), "conflict") || strings.Contains(err.Error(), "version") {
+			return nil, status.Errorf(codes.Aborted, "account was modified, retry update")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update account status: %v", err)
+	}
+
+	return r.GetAccountByID(ctx, accountID)
+}
+
 // ListAccounts lists accounts with filtering and pagination
 // Spec: docs/specs/003-account-management.md#story-4-list-accounts
 func (r *AccountRepository) ListAccounts(ctx context.Context, filters ListAccountFilters) ([]*AccountRow, string, int32, error) {
@@ -284,21 +559,56 @@ func (r *AccountRepository) ListAccounts(ctx context.Context, filters ListAccoun
 		params["name_search"] = "%" + strings.ToLower(filters.NameSearch) + "%"
 	}
 
+	// Accounts created before migration 007 have a NULL status and are active
+	// Spec: docs/specs/009-account-lifecycle.md#data-models
+	if filters.Status != "" {
+		if filters.Status == StatusActive {
+			whereClauses = append(whereClauses, "(status = @status OR status IS NULL)")
+		} else {
+			whereClauses = append(whereClauses, "status = @status")
+		}
+		params["status"] = filters.Status
+	}
+
 	whereClause := ""
 	if len(whereClauses) > 0 {
 		whereClause = "WHERE " + strings.Join(whereClauses, " AND ")
 	}
 
-	// Count total matching accounts
-	countQuery := fmt.Sprintf("SELECT COUNT(*) as total FROM accounts %s", whereClause)
-	countResult, err := r.db.SQLQuery(ctx, countQuery, params, false)
-	if err != nil {
-		return nil, "", 0, status.Errorf(codes.Internal, "failed to count accounts: %v", err)
+	// Resume after the last account of the previous page. Accounts are
+	// ordered newest first, so accounts created while paging do not shift
+	// later pages.
+	// Spec: docs/specs/005-cursor-pagination.md
+	pageClause := whereClause
+	cursorFilters := filters.cursorFilters()
+	if filters.PageToken != "" {
+		keys, err := r.cursors.Decode(filters.PageToken, accountsCursorScope, cursorFilters, 2)
+		if err != nil {
+			return nil, "", 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		afterCreatedAt, err := time.Parse(time.RFC3339Nano, keys[0])
+		if err != nil {
+			return nil, "", 0, status.Error(codes.InvalidArgument, pagination.ErrInvalidToken.Error())
+		}
+
+		keyset := "(created_at < @after_created_at OR (created_at = @after_created_at AND id > @after_id))"
+		pageClause = "WHERE " + strings.Join(append(whereClauses, keyset), " AND ")
+		params["after_created_at"] = afterCreatedAt
+		params["after_id"] = keys[1]
 	}
 
+	// Count total matching accounts with the same filters as the page
+	// Spec: docs/specs/005-cursor-pagination.md#total-count
 	totalCount := int32(0)
-	if len(countResult.Rows) > 0 {
-		totalCount = int32(countResult.Rows[0].Values[0].GetN())
+	if !filters.SkipTotalCount {
+		countQuery := fmt.Sprintf("SELECT COUNT(*) as total FROM accounts %s", whereClause)
+		countResult, err := r.db.SQLQuery(ctx, countQuery, params, false)
+		if err != nil {
+			return nil, "", 0, status.Errorf(codes.Internal, "failed to count accounts: %v", err)
+		}
+		if len(countResult.Rows) > 0 {
+			totalCount = int32(countResult.Rows[0].Values[0].GetN())
+		}
 	}
 
 	// Build main query with pagination
@@ -310,22 +620,17 @@ func (r *AccountRepository) ListAccounts(ctx context.Context, filters ListAccoun
 		limit = 200
 	}
 
-	offset := int32(0)
-	if filters.PageToken != "" {
-		// Simple offset-based pagination for now
-		// In production, use cursor-based pagination
-		fmt.Sscanf(filters.PageToken, "%d", &offset)
-	}
-
+	// Fetch one extra row to tell whether another page follows
 	query := fmt.Sprintf(`
 		SELECT 
 			id, name, external_id, external_group_id,
-			currency_code, account_type, created_at, updated_at, version
+			currency_code, account_type, created_at, updated_at, version,
+			status, status_reason, status_changed_by, status_changed_at
 		FROM accounts
 		%s
 		ORDER BY created_at DESC, id
-		LIMIT %d OFFSET %d`,
-		whereClause, limit, offset)
+		LIMIT %d`,
+		pageClause, limit+1)
 
 	result, err := r.db.SQLQuery(ctx, query, params, false)
 	if err != nil {
@@ -335,32 +640,16 @@ func (r *AccountRepository) ListAccounts(ctx context.Context, filters ListAccoun
 	// Parse results
 	accounts := make([]*AccountRow, 0, len(result.Rows))
 	for _, row := range result.Rows {
-		account := &AccountRow{
-			ID:           string(row.Values[0].GetS()),
-			Name:         string(row.Values[1].GetS()),
-			ExternalID:   string(row.Values[2].GetS()),
-			CurrencyCode: string(row.Values[4].GetS()),
-			AccountType:  string(row.Values[5].GetS()),
-			CreatedAt:    time.UnixMicro(row.Values[6].GetTs()),
-			UpdatedAt:    time.UnixMicro(row.Values[7].GetTs()),
-			Version:      row.Values[8].GetN(),
-		}
-		
-		// Handle optional external_group_id (index 3)
-		if row.Values[3] != nil && len(row.Values[3].GetS()) > 0 {
-			account.ExternalGroupID = sql.NullString{
-				String: string(row.Values[3].GetS()),
-				Valid:  true,
-			}
-		}
-		
-		accounts = append(accounts, account)
+		accounts = append(accounts, parseAccountRow(row))
 	}
 
 	// Calculate next page token
 	nextPageToken := ""
-	if offset+limit < totalCount {
-		nextPageToken = fmt.Sprintf("%d", offset+limit)
+	if len(accounts) > int(limit) {
+		accounts = accounts[:limit]
+		last := accounts[len(accounts)-1]
+		nextPageToken = r.cursors.Encode(accountsCursorScope, cursorFilters,
+			last.CreatedAt.UTC().Format(time.RFC3339Nano), last.ID)
 	}
 
 	return accounts, nextPageToken, totalCount, nil
@@ -374,4 +663,464 @@ type ListAccountFilters struct {
 	CurrencyCode    string
 	ExternalGroupID string
 	NameSearch      string
-}
\ No newline at end of file
+	Status          string
+	SkipTotalCount  bool
+}
+
+// cursorFilters returns the filters a ListAccounts page token is bound to
+func (f ListAccountFilters) cursorFilters() pagination.Filters {
+	accountType := f.AccountType
+	if accountType == "ACCOUNT_TYPE_UNSPECIFIED" {
+		accountType = ""
+	}
+
+	return pagination.Filters{
+		"account_type":      accountType,
+		"currency_code":     f.CurrencyCode,
+		"external_group_id": f.ExternalGroupID,
+		"name_search":       f.NameSearch,
+		"status":            f.Status,
+	}
+}
+
+// BalanceRow contains posted journal totals for an account.
+// Amounts are scaled by 10^amount.Scale.
+type BalanceRow struct {
+	AccountID   string
+	DebitTotal  int64
+	CreditTotal int64
+	LineCount   int64
+}
+
+// BalanceQuery selects the point in time for a balance read
+type BalanceQuery struct {
+	AsOfTime *time.Time // Only include entries dated on or before this time
+	AsOfTx   uint64     // Read the ledger as committed at this ImmuDB tx (0 = latest)
+}
+
+// GetPostedTotals sums posted journal lines for an account. The latest
+// balance snapshot of a closed period is used as a checkpoint, so only the
+// lines dated on or after it are summed.
+// Spec: docs/specs/012-accounting-periods.md#balance-snapshots
+func (r *AccountRepository) GetPostedTotals(ctx context.Context, accountID string, query BalanceQuery) (*BalanceRow, error) {
+	params := map[string]interface{}{
+		"account_id": accountID,
+	}
+
+	// ImmuDB time travel: read each table as it was at the requested tx
+	period := ""
+	if query.AsOfTx > 0 {
+		period = "UNTIL TX @as_of_tx"
+		params["as_of_tx"] = query.AsOfTx
+	}
+
+	conditions := []string{"l.account_id = @account_id"}
+	if query.AsOfTime != nil {
+		params["as_of_time"] = *query.AsOfTime
+		conditions = append(conditions, "e.entry_date <= @as_of_time")
+	}
+
+	balance := &BalanceRow{AccountID: accountID}
+
+	snapshot, err := r.getLatestSnapshot(ctx, params, period, query.AsOfTime != nil)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot != nil {
+		balance.LineCount = snapshot.LineCount
+		balance.DebitTotal = snapshot.DebitTotal
+		balance.CreditTotal = snapshot.CreditTotal
+		params["snapshot_date"] = snapshot.SnapshotDate
+		conditions = append(conditions, "e.entry_date >= @snapshot_date")
+	}
+
+	var sqlQuery string
+	if len(conditions) > 1 {
+		sqlQuery = fmt.Sprintf(`
+			SELECT COUNT(*), SUM(l.debit_amount), SUM(l.credit_amount)
+			FROM journal_entry_lines %s AS l
+			INNER JOIN journal_entries %s AS e ON l.journal_entry_id = e.id
+			WHERE %s`,
+			period, period, strings.Join(conditions, " AND "))
+	} else {
+		sqlQuery = fmt.Sprintf(`
+			SELECT COUNT(*), SUM(debit_amount), SUM(credit_amount)
+			FROM journal_entry_lines %s
+			WHERE account_id = @account_id`,
+			period)
+	}
+
+	result, err := r.db.SQLQuery(ctx, sqlQuery, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query account balance: %v", err)
+	}
+
+	if len(result.Rows) > 0 {
+		row := result.Rows[0]
+		balance.LineCount += row.Values[0].GetN()
+		balance.DebitTotal += row.Values[1].GetN()
+		balance.CreditTotal += row.Values[2].GetN()
+	}
+
+	return balance, nil
+}
+
+// GetHeldTotal sums the holds that reserve funds on an account. Holds are
+// counted at as_of_time, or now, if they were created by then, had not
+// expired and had not been captured or released.
+// Spec: docs/specs/013-holds.md#available-balance
+func (r *AccountRepository) GetHeldTotal(ctx context.Context, accountID string, query BalanceQuery) (int64, error) {
+	params := map[string]interface{}{
+		"account_id": accountID,
+	}
+
+	period := ""
+	if query.AsOfTx > 0 {
+		period = "UNTIL TX @as_of_tx"
+		params["as_of_tx"] = query.AsOfTx
+	}
+
+	// Holds settled after as_of_time were still active at that time
+	cutoff := time.Now()
+	statusFilter := " AND status = 'ACTIVE'"
+	if query.AsOfTime != nil {
+		cutoff = *query.AsOfTime
+		statusFilter = ""
+	}
+
+	sqlQuery := fmt.Sprintf(`
+		SELECT amount, status, created_at, expires_at, settled_at
+		FROM holds %s
+		WHERE account_id = @account_id%s`,
+		period, statusFilter)
+
+	result, err := r.db.SQLQuery(ctx, sqlQuery, params, false)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to query holds: %v", err)
+	}
+
+	var held int64
+	for _, row := range result.Rows {
+		createdAt := time.UnixMicro(row.Values[2].GetTs())
+		expiresAt := time.UnixMicro(row.Values[3].GetTs())
+		if createdAt.After(cutoff) || !expiresAt.After(cutoff) {
+			continue
+		}
+		if row.Values[1].GetS() != "ACTIVE" {
+			settledAt := row.Values[4].GetTs()
+			if settledAt == 0 || !time.UnixMicro(settledAt).After(cutoff) {
+				continue
+			}
+		}
+		held += row.Values[0].GetN()
+	}
+
+	return held, nil
+}
+
+// snapshotRow holds the cumulative totals of an account at a period end
+type snapshotRow struct {
+	SnapshotDate time.Time
+	LineCount    int64
+	DebitTotal   int64
+	CreditTotal  int64
+}
+
+// getLatestSnapshot returns the latest snapshot of the account written for a
+// period that is still closed, or nil. Snapshots of reopened periods are
+// ignored because postings may have been added to them.
+// Spec: docs/specs/012-accounting-periods.md#balance-snapshots
+func (r *AccountRepository) getLatestSnapshot(ctx context.Context, params map[string]interface{}, period string, asOfTime bool) (*snapshotRow, error) {
+	sqlQuery := fmt.Sprintf(`
+		SELECT s.snapshot_date, s.transaction_count, s.debit_total, s.credit_total
+		FROM balance_snapshots %s AS s
+		INNER JOIN accounting_periods %s AS p ON s.period_id = p.id
+		WHERE s.account_id = @account_id AND p.status = 'CLOSED'`,
+		period, period)
+	if asOfTime {
+		sqlQuery += " AND s.snapshot_date <= @as_of_time"
+	}
+
+	result, err := r.db.SQLQuery(ctx, sqlQuery, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query balance snapshots: %v", err)
+	}
+
+	// An account has at most one snapshot per closed period, so the latest
+	// is picked here instead of sorting in ImmuDB
+	var latest *snapshotRow
+	for _, row := range result.Rows {
+		snapshotDate := time.UnixMicro(row.Values[0].GetTs()).UTC()
+		if latest != nil && !snapshotDate.After(latest.SnapshotDate) {
+			continue
+		}
+		latest = &snapshotRow{
+			SnapshotDate: snapshotDate,
+			LineCount:    row.Values[1].GetN(),
+			DebitTotal:   row.Values[2].GetN(),
+			CreditTotal:  row.Values[3].GetN(),
+		}
+	}
+
+	return latest, nil
+}
+
+// GetNormalBalances loads the normal balance side for each account type
+// Spec: docs/specs/005-account-balances.md#normal-balance-resolution
+func (r *AccountRepository) GetNormalBalances(ctx context.Context) (map[string]string, error) {
+	result, err := r.db.SQLQuery(ctx, "SELECT code, normal_balance FROM account_types", nil, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query account types: %v", err)
+	}
+
+	normalBalances := make(map[string]string, len(result.Rows))
+	for _, row := range result.Rows {
+		normalBalances[strings.ToUpper(row.Values[0].GetS())] = strings.ToUpper(row.Values[1].GetS())
+	}
+
+	return normalBalances, nil
+}
+
+// GetAccountStatuses loads whether each account status can transact
+// Spec: docs/specs/009-account-lifecycle.md#story-4-reject-postings
+func (r *AccountRepository) GetAccountStatuses(ctx context.Context) (map[string]bool, error) {
+	result, err := r.db.SQLQuery(ctx, "SELECT code, can_transact FROM account_statuses", nil, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query account statuses: %v", err)
+	}
+
+	canTransact := make(map[string]bool, len(result.Rows))
+	for _, row := range result.Rows {
+		canTransact[strings.ToUpper(row.Values[0].GetS())] = row.Values[1].GetB()
+	}
+
+	return canTransact, nil
+}
+
+// GetVerifiedAccountByID retrieves an account and verifies the row against
+// the trusted ledger state
+// Spec: docs/specs/006-verified-reads.md#story-1-verified-account-read
+func (r *AccountRepository) GetVerifiedAccountByID(ctx context.Context, accountID string) (*AccountRow, *pb.VerificationProof, error) {
+	columns := []string{
+		"id", "name", "external_id", "external_group_id",
+		"currency_code", "account_type", "created_at", "updated_at", "version",
+		"status", "status_reason", "status_changed_by", "status_changed_at",
+	}
+
+	row, proof, err := r.verifier.VerifyRow(ctx, "accounts", verification.StringKey(accountID), columns)
+	if err != nil {
+		return nil, nil, err
+	}
+	if row == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "account %s not found", accountID)
+	}
+
+	return parseAccountRow(row), proof, nil
+}
+
+// VerifyLedgerState verifies the latest ledger state against the trusted state
+// Spec: docs/specs/006-verified-reads.md#story-2-verified-balances
+func (r *AccountRepository) VerifyLedgerState(ctx context.Context) (*pb.VerificationProof, error) {
+	return r.verifier.VerifyState(ctx)
+}
+
+// accountAuditValues returns the audited fields of an account keyed by
+// proto field name
+// Spec: docs/specs/008-audit-log.md#json-diffs
+func accountAuditValues(account *AccountRow) map[string]interface{} {
+	values := map[string]interface{}{
+		"name":              account.Name,
+		"external_id":       account.ExternalID,
+		"external_group_id": nil,
+		"currency_code":     account.CurrencyCode,
+		"account_type":      account.AccountType,
+		"version":           account.Version,
+		"status":            account.Status,
+		"status_reason":     nullableString(account.StatusReason),
+		"status_changed_by": nullableString(account.StatusChangedBy),
+	}
+	if account.ExternalGroupID.Valid {
+		values["external_group_id"] = account.ExternalGroupID.String
+	}
+	return values
+}
+
+// parseAccountRow converts a selected accounts row into an AccountRow
+func parseAccountRow(row *schema.Row) *AccountRow {
+	account := &AccountRow{
+		ID:           row.Values[0].GetS(),
+		Name:         row.Values[1].GetS(),
+		ExternalID:   row.Values[2].GetS(),
+		CurrencyCode: row.Values[4].GetS(),
+		AccountType:  row.Values[5].GetS(),
+		CreatedAt:    time.UnixMicro(row.Values[6].GetTs()),
+		UpdatedAt:    time.UnixMicro(row.Values[7].GetTs()),
+		Version:      row.Values[8].GetN(),
+	}
+
+	// Handle optional external_group_id (index 3)
+	if row.Values[3] != nil && len(row.Values[3].GetS()) > 0 {
+		account.ExternalGroupID = sql.NullString{
+			String: row.Values[3].GetS(),
+			Valid:  true,
+		}
+	}
+
+	// Status columns (indexes 9-12) were added by migration 007. Rows
+	// written before it have NULL status and are active.
+	account.Status = StatusActive
+	if s := row.Values[9].GetS(); s != "" {
+		account.Status = s
+	}
+	account.StatusReason = nullStringValue(row.Values[10])
+	account.StatusChangedBy = nullStringValue(row.Values[11])
+	if ts := row.Values[12].GetTs(); ts != 0 {
+		account.StatusChangedAt = sql.NullTime{Time: time.UnixMicro(ts), Valid: true}
+	}
+
+	return account
+}
+
+// nullStringValue converts an optional VARCHAR column into sql.NullString
+func nullStringValue(v *schema.SQLValue) sql.NullString {
+	if v != nil && len(v.GetS()) > 0 {
+		return sql.NullString{String: v.GetS(), Valid: true}
+	}
+	return sql.NullString{}
+}
+
+// nullableString converts sql.NullString into a query parameter
+func nullableString(s sql.NullString) interface{} {
+	if s.Valid {
+		return s.String
+	}
+	return nil
+}
+
+// AccountRevisionRow is one committed revision of an account
+type AccountRevisionRow struct {
+	Account     *AccountRow
+	TxID        uint64
+	CommittedAt time.Time
+}
+
+// GetAccountAsOf retrieves an account as it was committed at a transaction
+// or at a point in time. Exactly one selector must be set.
+// Spec: docs/specs/007-account-history.md#story-2-point-in-time-account
+func (r *AccountRepository) GetAccountAsOf(ctx context.Context, accountID string, asOfTx uint64, asOfTime *time.Time) (*AccountRow, error) {
+	params := map[string]interface{}{
+		"id": accountID,
+	}
+
+	// ImmuDB time travel by commit time or by tx
+	var period string
+	if asOfTime != nil {
+		period = "UNTIL @as_of_time"
+		params["as_of_time"] = *asOfTime
+	} else {
+		period = "UNTIL TX @as_of_tx"
+		params["as_of_tx"] = asOfTx
+	}
+
+	query := fmt.Sprintf(`
+		SELECT 
+			id, name, external_id, external_group_id,
+			currency_code, account_type, created_at, updated_at, version,
+			status, status_reason, status_changed_by, status_changed_at
+		FROM accounts %s
+		WHERE id = @id`, period)
+
+	result, err := r.db.SQLQuery(ctx, query, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query account: %v", err)
+	}
+
+	// The account did not exist yet at that point
+	if len(result.Rows) == 0 {
+		return nil, nil
+	}
+
+	return parseAccountRow(result.Rows[0]), nil
+}
+
+// GetAccountHistory returns every committed revision of an account, oldest
+// first. ImmuDB SQL does not expose the tx that wrote a row revision, so
+// each revision is located by binary search over time travel reads on the
+// version column, which UpdateAccount increments on every change.
+// Spec: docs/specs/007-account-history.md#story-1-account-revision-history
+func (r *AccountRepository) GetAccountHistory(ctx context.Context, accountID string) ([]*AccountRevisionRow, error) {
+	current, err := r.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := r.db.GetServiceClient().CurrentState(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read ledger state: %v", err)
+	}
+
+	revisions := make([]*AccountRevisionRow, 0, current.Version)
+	fromTx := uint64(1)
+	for nextVersion := int64(1); nextVersion <= current.Version; {
+		var found *AccountRow
+		txID, err := searchFirstTx(fromTx, state.TxId, func(txID uint64) (bool, error) {
+			row, err := r.GetAccountAsOf(ctx, accountID, txID, nil)
+			if err != nil {
+				return false, err
+			}
+			if row == nil || row.Version < nextVersion {
+				return false, nil
+			}
+			found = row
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if txID == 0 {
+			break // Revision committed after the state was read
+		}
+
+		// found holds the row from the last matching probe, which is txID
+		revisions = append(revisions, &AccountRevisionRow{
+			Account: found,
+			TxID:    txID,
+		})
+		nextVersion = found.Version + 1
+		fromTx = txID + 1
+	}
+
+	// Resolve commit times from the transaction headers
+	for _, revision := range revisions {
+		tx, err := r.db.TxByID(ctx, revision.TxID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to read tx %d: %v", revision.TxID, err)
+		}
+		if tx.Header != nil {
+			revision.CommittedAt = time.Unix(tx.Header.Ts, 0)
+		}
+	}
+
+	return revisions, nil
+}
+
+// searchFirstTx returns the smallest tx in [lo, hi] for which match returns
+// true, or 0 when there is none. match must be monotonic over tx ids and
+// lo must be at least 1.
+func searchFirstTx(lo, hi uint64, match func(txID uint64) (bool, error)) (uint64, error) {
+	var first uint64
+	for lo <= hi {
+		mid := lo + (hi-lo)/2
+		ok, err := match(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			first = mid
+			hi = mid - 1
+		} else {
+			lo = mid + 1
+		}
+	}
+	return first, nil
+}
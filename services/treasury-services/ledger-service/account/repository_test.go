@@ -0,0 +1,215 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"example.com/go-mono-repo/common/pagination"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestSearchFirstTx tests locating the tx that committed a revision
+// Spec: docs/specs/007-account-history.md#revision-lookup
+func TestSearchFirstTx(t *testing.T) {
+	tests := []struct {
+		name    string
+		lo, hi  uint64
+		firstOK uint64 // smallest tx for which the probe matches, 0 = never
+		want    uint64
+	}{
+		{name: "match in middle", lo: 1, hi: 100, firstOK: 42, want: 42},
+		{name: "match at lower bound", lo: 5, hi: 100, firstOK: 5, want: 5},
+		{name: "match at upper bound", lo: 1, hi: 100, firstOK: 100, want: 100},
+		{name: "match before range", lo: 10, hi: 20, firstOK: 3, want: 10},
+		{name: "no match", lo: 1, hi: 100, firstOK: 0, want: 0},
+		{name: "single tx", lo: 1, hi: 1, firstOK: 1, want: 1},
+		{name: "empty range", lo: 8, hi: 7, firstOK: 1, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probes := 0
+			got, err := searchFirstTx(tt.lo, tt.hi, func(txID uint64) (bool, error) {
+				probes++
+				return tt.firstOK != 0 && txID >= tt.firstOK, nil
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, probes, 8)
+		})
+	}
+
+	t.Run("propagates probe errors", func(t *testing.T) {
+		_, err := searchFirstTx(1, 10, func(uint64) (bool, error) {
+			return false, errors.New("query failed")
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+// TestListAccountsPageToken tests that page tokens are checked before the
+// accounts are queried
+// Spec: docs/specs/005-cursor-pagination.md#validation
+func TestListAccountsPageToken(t *testing.T) {
+	cursors := pagination.NewCodec("secret")
+	repo := &AccountRepository{cursors: cursors}
+	ctx := context.Background()
+
+	issued := ListAccountFilters{CurrencyCode: "USD", AccountType: "ACCOUNT_TYPE_ASSET"}
+	token := cursors.Encode(accountsCursorScope, issued.cursorFilters(), "2025-09-02T10:00:00Z", "acc-1")
+
+	tests := []struct {
+		name    string
+		filters ListAccountFilters
+		message string
+	}{
+		{
+			name:    "offset token",
+			filters: ListAccountFilters{PageToken: "50"},
+			message: "invalid page_token",
+		},
+		{
+			name:    "changed filters",
+			filters: ListAccountFilters{PageToken: token, CurrencyCode: "EUR", AccountType: "ACCOUNT_TYPE_ASSET"},
+			message: "page_token does not match the request filters",
+		},
+		{
+			name:    "token from another list",
+			filters: ListAccountFilters{PageToken: cursors.Encode("treasury.currencies", issued.cursorFilters(), "USD")},
+			message: "invalid page_token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, err := repo.ListAccounts(ctx, tt.filters)
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
+
+// testSchema creates the tables written by CreateAccount
+var testSchema = []string{
+	`CREATE TABLE accounts (
+		id VARCHAR(36), name VARCHAR(255), external_id VARCHAR(255), external_group_id VARCHAR(255),
+		currency_code VARCHAR(3), account_type VARCHAR(20), created_at TIMESTAMP, updated_at TIMESTAMP,
+		version INTEGER, status VARCHAR(20), status_reason VARCHAR(512), status_changed_by VARCHAR(100),
+		status_changed_at TIMESTAMP,
+		PRIMARY KEY (id))`,
+	`CREATE TABLE account_external_ids (
+		external_id_hash VARCHAR(64), external_id VARCHAR(255), account_id VARCHAR(36), created_at TIMESTAMP,
+		PRIMARY KEY (external_id_hash))`,
+	`CREATE TABLE audit_log (
+		id VARCHAR(36), entity_type VARCHAR(50), entity_id VARCHAR(36), action VARCHAR(20),
+		old_values VARCHAR, new_values VARCHAR, user_id VARCHAR(100), created_at TIMESTAMP, metadata VARCHAR,
+		PRIMARY KEY (id))`,
+}
+
+// newTestRepository returns a repository backed by an in-process ImmuDB
+func newTestRepository(t *testing.T) (*AccountRepository, client.ImmuClient) {
+	t.Helper()
+
+	opts := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPgsqlServer(false).
+		WithMetricsServer(false).
+		WithWebServer(false)
+	bs := servertest.NewBufconnServer(opts)
+	require.NoError(t, bs.Start())
+	t.Cleanup(func() { bs.Stop() })
+
+	db, err := bs.NewAuthenticatedClient(client.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.CloseSession(context.Background()) })
+
+	for _, stmt := range testSchema {
+		_, err := db.SQLExec(context.Background(), stmt, nil)
+		require.NoError(t, err)
+	}
+
+	return NewAccountRepository(db, pagination.NewCodec("secret")), db
+}
+
+func testAccountRow(externalID string) *AccountRow {
+	return &AccountRow{
+		Name:         "Operating Cash",
+		ExternalID:   externalID,
+		CurrencyCode: "USD",
+		AccountType:  "ACCOUNT_TYPE_ASSET",
+	}
+}
+
+// TestCreateAccountExternalIDUniqueness tests that an external ID can only
+// be used by one account
+// Spec: docs/specs/003-account-management.md#external-id-uniqueness
+func TestCreateAccountExternalIDUniqueness(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("duplicate create", func(t *testing.T) {
+		repo, _ := newTestRepository(t)
+		require.NoError(t, repo.CreateAccount(ctx, testAccountRow("EXT-001")))
+
+		err := repo.CreateAccount(ctx, testAccountRow("EXT-001"))
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.NoError(t, repo.CreateAccount(ctx, testAccountRow("EXT-002")))
+	})
+
+	t.Run("account without key row", func(t *testing.T) {
+		repo, db := newTestRepository(t)
+		_, err := db.SQLExec(ctx, `
+			INSERT INTO accounts (id, name, external_id, currency_code, account_type, created_at, updated_at, version)
+			VALUES ('legacy-1', 'Legacy', 'EXT-LEGACY', 'USD', 'ACCOUNT_TYPE_ASSET', NOW(), NOW(), 1)`, nil)
+		require.NoError(t, err)
+
+		err = repo.CreateAccount(ctx, testAccountRow("EXT-LEGACY"))
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("concurrent creates", func(t *testing.T) {
+		repo, _ := newTestRepository(t)
+		const creators = 8
+
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		errs := make([]error, creators)
+		for i := 0; i < creators; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				<-start
+				errs[i] = repo.CreateAccount(ctx, testAccountRow("EXT-RACE"))
+			}(i)
+		}
+		close(start)
+		wg.Wait()
+
+		created := 0
+		for _, err := range errs {
+			if err == nil {
+				created++
+				continue
+			}
+			assert.Equal(t, codes.AlreadyExists, status.Code(err), fmt.Sprint(err))
+		}
+		assert.Equal(t, 1, created)
+
+		rows, err := repo.db.SQLQuery(ctx, `SELECT id FROM accounts WHERE external_id = 'EXT-RACE'`, nil, false)
+		require.NoError(t, err)
+		assert.Len(t, rows.Rows, 1)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"example.com/go-mono-repo/common/pagination"
 	pb "example.com/go-mono-repo/proto/ledger"
 	"github.com/codenotary/immudb/pkg/client"
 )
@@ -16,9 +17,8 @@ type Server struct {
 }
 
 // NewServer creates a new account server
-func NewServer(db client.ImmuClient) *Server {
-	repo := NewAccountRepository(db)
-	validator := NewValidator()
+func NewServer(db client.ImmuClient, validator *Validator, cursors *pagination.Codec) *Server {
+	repo := NewAccountRepository(db, cursors)
 	manager := NewManager(repo, validator)
 	
 	return &Server{
@@ -48,6 +48,32 @@ func (s *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRequest
 func (s *Server) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
 	log.Printf("Getting account: id=%s", req.AccountId)
 	
+	if req.AsOfTx > 0 || req.AsOfTime != nil {
+		account, err := s.manager.GetAccountAsOf(ctx, req)
+		if err != nil {
+			log.Printf("Failed to get account as of tx=%d: %v", req.AsOfTx, err)
+			return nil, err
+		}
+
+		return &pb.GetAccountResponse{
+			Account: account,
+		}, nil
+	}
+
+	if req.Verified {
+		account, proof, err := s.manager.GetVerifiedAccount(ctx, req.AccountId)
+		if err != nil {
+			log.Printf("Failed to get verified account: %v", err)
+			return nil, err
+		}
+
+		log.Printf("Account verified: id=%s, tx=%d", account.Id, proof.TxId)
+		return &pb.GetAccountResponse{
+			Account:      account,
+			Verification: proof,
+		}, nil
+	}
+
 	account, err := s.manager.GetAccount(ctx, req.AccountId)
 	if err != nil {
 		log.Printf("Failed to get account: %v", err)
@@ -105,4 +131,100 @@ func (s *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest)
 	
 	log.Printf("Listed %d accounts, total=%d", len(resp.Accounts), resp.TotalCount)
 	return resp, nil
-}
\ No newline at end of file
+}
+
+// GetAccountBalance returns the posted balance of an account
+// Spec: docs/specs/005-account-balances.md#story-1-current-account-balance
+func (s *Server) GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error) {
+	log.Printf("Getting account balance: id=%s, as_of_tx=%d, verified=%t", req.AccountId, req.AsOfTx, req.Verified)
+
+	resp, err := s.manager.GetAccountBalance(ctx, req)
+	if err != nil {
+		log.Printf("Failed to get account balance: %v", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetAccountBalances returns posted balances for multiple accounts
+// Spec: docs/specs/005-account-balances.md#story-3-batch-balances
+func (s *Server) GetAccountBalances(ctx context.Context, req *pb.GetAccountBalancesRequest) (*pb.GetAccountBalancesResponse, error) {
+	log.Printf("Getting account balances: count=%d, as_of_tx=%d, verified=%t", len(req.AccountIds), req.AsOfTx, req.Verified)
+
+	resp, err := s.manager.GetAccountBalances(ctx, req)
+	if err != nil {
+		log.Printf("Failed to get account balances: %v", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetAccountHistory returns every committed revision of an account
+// Spec: docs/specs/007-account-history.md#story-1-account-revision-history
+func (s *Server) GetAccountHistory(ctx context.Context, req *pb.GetAccountHistoryRequest) (*pb.GetAccountHistoryResponse, error) {
+	log.Printf("Getting account history: id=%s", req.AccountId)
+
+	revisions, err := s.manager.GetAccountHistory(ctx, req.AccountId)
+	if err != nil {
+		log.Printf("Failed to get account history: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Account history loaded: id=%s, revisions=%d", req.AccountId, len(revisions))
+	return &pb.GetAccountHistoryResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+// FreezeAccount freezes an account so it can no longer transact
+// Spec: docs/specs/009-account-lifecycle.md#story-1-freeze-account
+func (s *Server) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	log.Printf("Freezing account: id=%s, actor=%s", req.AccountId, req.Actor)
+
+	account, err := s.manager.FreezeAccount(ctx, req)
+	if err != nil {
+		log.Printf("Failed to freeze account: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Account frozen: id=%s, by=%s", account.Id, account.StatusChangedBy)
+	return &pb.FreezeAccountResponse{
+		Account: account,
+	}, nil
+}
+
+// CloseAccount closes an account with a zero balance
+// Spec: docs/specs/009-account-lifecycle.md#story-2-close-account
+func (s *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	log.Printf("Closing account: id=%s, actor=%s", req.AccountId, req.Actor)
+
+	account, err := s.manager.CloseAccount(ctx, req)
+	if err != nil {
+		log.Printf("Failed to close account: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Account closed: id=%s, by=%s", account.Id, account.StatusChangedBy)
+	return &pb.CloseAccountResponse{
+		Account: account,
+	}, nil
+}
+
+// ReopenAccount reopens a frozen, inactive or closed account
+// Spec: docs/specs/009-account-lifecycle.md#story-3-reopen-account
+func (s *Server) ReopenAccount(ctx context.Context, req *pb.ReopenAccountRequest) (*pb.ReopenAccountResponse, error) {
+	log.Printf("Reopening account: id=%s, actor=%s", req.AccountId, req.Actor)
+
+	account, err := s.manager.ReopenAccount(ctx, req)
+	if err != nil {
+		log.Printf("Failed to reopen account: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Account reopened: id=%s, by=%s", account.Id, account.StatusChangedBy)
+	return &pb.ReopenAccountResponse{
+		Account: account,
+	}, nil
+}
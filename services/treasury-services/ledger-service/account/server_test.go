@@ -57,6 +57,70 @@ func (m *MockManager) ListAccounts(ctx context.Context, req *pb.ListAccountsRequ
 	return args.Get(0).(*pb.ListAccountsResponse), args.Error(1)
 }
 
+func (m *MockManager) GetVerifiedAccount(ctx context.Context, accountID string) (*pb.Account, *pb.VerificationProof, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*pb.Account), args.Get(1).(*pb.VerificationProof), args.Error(2)
+}
+
+func (m *MockManager) GetAccountAsOf(ctx context.Context, req *pb.GetAccountRequest) (*pb.Account, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Account), args.Error(1)
+}
+
+func (m *MockManager) GetAccountHistory(ctx context.Context, accountID string) ([]*pb.AccountRevision, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pb.AccountRevision), args.Error(1)
+}
+
+func (m *MockManager) GetAccountBalance(ctx context.Context, req *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetAccountBalanceResponse), args.Error(1)
+}
+
+func (m *MockManager) GetAccountBalances(ctx context.Context, req *pb.GetAccountBalancesRequest) (*pb.GetAccountBalancesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetAccountBalancesResponse), args.Error(1)
+}
+
+func (m *MockManager) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.Account, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Account), args.Error(1)
+}
+
+func (m *MockManager) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.Account, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Account), args.Error(1)
+}
+
+func (m *MockManager) ReopenAccount(ctx context.Context, req *pb.ReopenAccountRequest) (*pb.Account, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.Account), args.Error(1)
+}
+
 // TestServerCreateAccount tests the gRPC CreateAccount endpoint
 // Spec: docs/specs/003-account-management.md#story-1-create-account
 func TestServerCreateAccount(t *testing.T) {
@@ -188,6 +252,44 @@ func TestServerGetAccount(t *testing.T) {
 		assert.Equal(t, codes.NotFound, st.Code())
 		mockManager.AssertExpectations(t)
 	})
+
+	t.Run("verified retrieval", func(t *testing.T) {
+		req := &pb.GetAccountRequest{
+			AccountId: "test-uuid",
+			Verified:  true,
+		}
+
+		expectedAccount := &pb.Account{Id: req.AccountId, Name: "Test Account"}
+		expectedProof := &pb.VerificationProof{TxId: 12, TrustedTxId: 15}
+
+		mockManager.On("GetVerifiedAccount", ctx, req.AccountId).
+			Return(expectedAccount, expectedProof, nil).Once()
+
+		resp, err := server.GetAccount(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAccount, resp.Account)
+		assert.Equal(t, expectedProof, resp.Verification)
+		mockManager.AssertExpectations(t)
+	})
+	t.Run("as of tx", func(t *testing.T) {
+		req := &pb.GetAccountRequest{
+			AccountId: "test-uuid",
+			AsOfTx:    5,
+		}
+
+		expectedAccount := &pb.Account{Id: req.AccountId, Name: "Old Name", Version: 1}
+
+		mockManager.On("GetAccountAsOf", ctx, req).
+			Return(expectedAccount, nil).Once()
+
+		resp, err := server.GetAccount(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAccount, resp.Account)
+		assert.Nil(t, resp.Verification)
+		mockManager.AssertExpectations(t)
+	})
 }
 
 // TestServerUpdateAccount tests the gRPC UpdateAccount endpoint
@@ -377,4 +479,53 @@ func TestServerGetAccountByExternalId(t *testing.T) {
 		assert.Equal(t, codes.NotFound, st.Code())
 		mockManager.AssertExpectations(t)
 	})
-}
\ No newline at end of file
+}
+
+// TestServerAccountLifecycle tests the gRPC FreezeAccount, CloseAccount and
+// ReopenAccount endpoints
+// Spec: docs/specs/009-account-lifecycle.md
+func TestServerAccountLifecycle(t *testing.T) {
+	ctx := context.Background()
+	mockManager := new(MockManager)
+	server := &Server{
+		manager: mockManager,
+	}
+
+	t.Run("freeze", func(t *testing.T) {
+		req := &pb.FreezeAccountRequest{AccountId: "acc-1", Reason: "Sanctions screening hit"}
+		frozen := &pb.Account{Id: "acc-1", Status: pb.AccountStatus_ACCOUNT_STATUS_FROZEN}
+
+		mockManager.On("FreezeAccount", ctx, req).Return(frozen, nil).Once()
+
+		resp, err := server.FreezeAccount(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, frozen, resp.Account)
+	})
+
+	t.Run("close with balance", func(t *testing.T) {
+		req := &pb.CloseAccountRequest{AccountId: "acc-1", Reason: "Customer request"}
+
+		mockManager.On("CloseAccount", ctx, req).
+			Return(nil, status.Error(codes.FailedPrecondition, "account acc-1 has a non-zero balance")).Once()
+
+		resp, err := server.CloseAccount(ctx, req)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		req := &pb.ReopenAccountRequest{AccountId: "acc-1", Reason: "Review cleared"}
+		active := &pb.Account{Id: "acc-1", Status: pb.AccountStatus_ACCOUNT_STATUS_ACTIVE}
+
+		mockManager.On("ReopenAccount", ctx, req).Return(active, nil).Once()
+
+		resp, err := server.ReopenAccount(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, active, resp.Account)
+	})
+
+	mockManager.AssertExpectations(t)
+}
@@ -2,12 +2,16 @@ package account
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"example.com/go-mono-repo/common/money"
 	pb "example.com/go-mono-repo/proto/ledger"
+	treasurypb "example.com/go-mono-repo/proto/treasury"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -15,18 +19,59 @@ import (
 // Validator handles input validation for account operations
 // Spec: docs/specs/003-account-management.md
 type Validator struct {
+	// Treasury Service client used to resolve currency codes.
+	// When nil, codes are checked against the built-in common currencies.
+	// Spec: docs/specs/010-treasury-currency-validation.md
+	currencyClient treasurypb.CurrencyServiceClient
+	lookupTimeout  time.Duration
+
 	// Currency cache for validation
-	// In production, this would connect to Treasury Service
-	currencyCache map[string]bool
+	currencyCache map[string]currencyCacheEntry
+	cacheTTL      time.Duration
 	cacheMutex    sync.RWMutex
-	cacheExpiry   time.Time
+
+	// Outcome of the most recent Treasury Service lookup
+	lastLookup CurrencyLookupStatus
+}
+
+// currencyCacheEntry is a cached currency validation result
+type currencyCacheEntry struct {
+	valid     bool
+	known     bool           // The currency exists, whatever its status
+	currency  money.Currency // Minor units and symbol of a known currency
+	reason    string
+	fetchedAt time.Time
+}
+
+// CurrencyLookupStatus describes the most recent Treasury Service lookup
+// Spec: docs/specs/010-treasury-currency-validation.md#story-3-health-dependency
+type CurrencyLookupStatus struct {
+	LastCheck   time.Time
+	LastSuccess time.Time
+	LastError   error
+	CachedCodes int
 }
 
-// NewValidator creates a new validator
+// NewValidator creates a new validator that checks currency codes against
+// the built-in common currencies
 func NewValidator() *Validator {
 	return &Validator{
-		currencyCache: initDefaultCurrencies(),
-		cacheExpiry:   time.Now().Add(5 * time.Minute),
+		currencyCache: initDefaultCurrencies(time.Now()),
+		cacheTTL:      5 * time.Minute,
+	}
+}
+
+// NewTreasuryValidator creates a validator that resolves currency codes
+// through the Treasury Service CurrencyService
+// Spec: docs/specs/010-treasury-currency-validation.md#story-1-resolve-currencies-through-treasury
+func NewTreasuryValidator(currencyClient treasurypb.CurrencyServiceClient, cacheTTL, lookupTimeout time.Duration) *Validator {
+	return &Validator{
+		currencyClient: currencyClient,
+		lookupTimeout:  lookupTimeout,
+		// Seed entries are already stale, so they are only used when
+		// the Treasury Service cannot be reached
+		currencyCache: initDefaultCurrencies(time.Time{}),
+		cacheTTL:      cacheTTL,
 	}
 }
 
@@ -113,50 +158,206 @@ func (v *Validator) ValidateExternalGroupID(groupID string) error {
 	return nil
 }
 
+// ValidateStatusReason validates the reason given for a status change
+// Spec: docs/specs/009-account-lifecycle.md#validation
+func (v *Validator) ValidateStatusReason(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return status.Error(codes.InvalidArgument, "field reason is required")
+	}
+
+	if len(reason) > 512 {
+		return status.Error(codes.InvalidArgument, "reason must be 512 characters or less")
+	}
+
+	return nil
+}
+
+// ValidateActor validates the actor recorded for a status change
+// Spec: docs/specs/009-account-lifecycle.md#validation
+func (v *Validator) ValidateActor(actor string) error {
+	if len(actor) > 100 {
+		return status.Error(codes.InvalidArgument, "actor must be 100 characters or less")
+	}
+
+	return nil
+}
+
 // ValidateCurrencyCode validates ISO 4217 currency code
 // Spec: docs/specs/003-account-management.md - Currency validation
 func (v *Validator) ValidateCurrencyCode(ctx context.Context, code string) error {
+	entry, err := v.resolveCurrency(ctx, code)
+	if err != nil {
+		return err
+	}
+	return entry.err()
+}
+
+// MoneyCurrency returns the minor units and symbol of a currency for
+// checking and rounding amounts with common/money. Any currency that
+// exists is returned, whatever its status.
+// Spec: docs/specs/007-money.md#ledger-amounts
+func (v *Validator) MoneyCurrency(ctx context.Context, code string) (money.Currency, error) {
+	entry, err := v.resolveCurrency(ctx, code)
+	if err != nil {
+		return money.Currency{}, err
+	}
+	if !entry.known {
+		return money.Currency{}, status.Errorf(codes.InvalidArgument, "invalid currency code: %s", code)
+	}
+	return entry.currency, nil
+}
+
+// resolveCurrency checks the format of a currency code and returns its
+// cached or freshly looked up entry
+func (v *Validator) resolveCurrency(ctx context.Context, code string) (currencyCacheEntry, error) {
 	if code == "" {
-		return status.Error(codes.InvalidArgument, "field currency_code is required")
+		return currencyCacheEntry{}, status.Error(codes.InvalidArgument, "field currency_code is required")
 	}
 
 	if len(code) != 3 {
-		return status.Error(codes.InvalidArgument, "currency_code must be exactly 3 characters")
+		return currencyCacheEntry{}, status.Error(codes.InvalidArgument, "currency_code must be exactly 3 characters")
 	}
 
 	// Check if code is uppercase
 	if code != strings.ToUpper(code) {
-		return status.Error(codes.InvalidArgument, "currency_code must be uppercase")
+		return currencyCacheEntry{}, status.Error(codes.InvalidArgument, "currency_code must be uppercase")
 	}
 
 	// Check cache
 	v.cacheMutex.RLock()
-	isValid, found := v.currencyCache[code]
-	needsRefresh := time.Now().After(v.cacheExpiry)
+	entry, found := v.currencyCache[code]
 	v.cacheMutex.RUnlock()
 
-	if found && !needsRefresh {
-		if !isValid {
-			return status.Errorf(codes.InvalidArgument, "invalid currency code: %s", code)
+	if found && time.Since(entry.fetchedAt) < v.cacheTTL {
+		return entry, nil
+	}
+
+	if v.currencyClient == nil {
+		entry = currencyCacheEntry{reason: fmt.Sprintf("invalid currency code: %s", code), fetchedAt: time.Now()}
+		if v.isCommonCurrency(code) {
+			entry = commonCurrencyEntry(code, entry.fetchedAt)
 		}
-		return nil
+		v.storeCurrency(code, entry)
+		return entry, nil
 	}
 
-	// In production, this would call Treasury Service
-	// For now, check against common currencies
-	if !v.isCommonCurrency(code) {
-		return status.Errorf(codes.InvalidArgument, "invalid currency code: %s", code)
+	fetched, err := v.lookupCurrency(ctx, code)
+	if err != nil {
+		// Fall back to the stale cache when the Treasury Service is unreachable
+		// Spec: docs/specs/010-treasury-currency-validation.md#story-2-stale-cache-fallback
+		if found {
+			log.Printf("Treasury currency lookup failed for %s, using cached result: %v", code, err)
+			return entry, nil
+		}
+		return currencyCacheEntry{}, status.Errorf(codes.Unavailable, "cannot validate currency %s: treasury service unavailable", code)
 	}
 
-	// Update cache
-	v.cacheMutex.Lock()
-	v.currencyCache[code] = true
-	if needsRefresh {
-		v.cacheExpiry = time.Now().Add(5 * time.Minute)
+	v.storeCurrency(code, fetched)
+	return fetched, nil
+}
+
+// CurrencyLookupStatus returns the outcome of the most recent Treasury
+// Service lookup
+// Spec: docs/specs/010-treasury-currency-validation.md#story-3-health-dependency
+func (v *Validator) CurrencyLookupStatus() CurrencyLookupStatus {
+	v.cacheMutex.RLock()
+	defer v.cacheMutex.RUnlock()
+
+	lookup := v.lastLookup
+	lookup.CachedCodes = len(v.currencyCache)
+	return lookup
+}
+
+// lookupCurrency resolves a currency code through the Treasury Service.
+// Only transport and server failures are returned as errors; unknown and
+// unusable currencies are returned as invalid cache entries.
+func (v *Validator) lookupCurrency(ctx context.Context, code string) (currencyCacheEntry, error) {
+	if v.lookupTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.lookupTimeout)
+		defer cancel()
+	}
+
+	resp, err := v.currencyClient.GetCurrency(ctx, &treasurypb.GetCurrencyRequest{
+		Identifier: &treasurypb.GetCurrencyRequest_Code{Code: code},
+	})
+	now := time.Now()
+
+	if status.Code(err) == codes.NotFound {
+		v.recordLookup(now, nil)
+		return currencyCacheEntry{reason: fmt.Sprintf("invalid currency code: %s", code), fetchedAt: now}, nil
+	}
+	if err != nil {
+		v.recordLookup(now, err)
+		return currencyCacheEntry{}, err
+	}
+
+	v.recordLookup(now, nil)
+	currency := resp.GetCurrency()
+	entry := currencyCacheEntry{
+		valid: true,
+		known: true,
+		currency: money.Currency{
+			Code:           code,
+			MinorUnits:     currency.GetMinorUnits(),
+			Symbol:         currency.GetSymbol(),
+			SymbolPosition: currency.GetSymbolPosition(),
+		},
+		fetchedAt: now,
 	}
+	if state := currencyState(currency); state != "" {
+		entry.valid = false
+		entry.reason = fmt.Sprintf("currency %s is %s", code, state)
+	}
+	return entry, nil
+}
+
+// currencyState returns why a treasury currency cannot be used for new
+// accounts, or an empty string when it can
+func currencyState(currency *treasurypb.Currency) string {
+	switch currency.GetStatus() {
+	case treasurypb.CurrencyStatus_CURRENCY_STATUS_ACTIVE:
+		return ""
+	case treasurypb.CurrencyStatus_CURRENCY_STATUS_INACTIVE:
+		return "inactive"
+	case treasurypb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED:
+		return "deprecated"
+	case treasurypb.CurrencyStatus_CURRENCY_STATUS_DELETED:
+		return "deleted"
+	}
+
+	// Older treasury rows may carry only the is_active flag
+	if !currency.GetIsActive() {
+		return "inactive"
+	}
+	return ""
+}
+
+// storeCurrency caches a currency validation result
+func (v *Validator) storeCurrency(code string, entry currencyCacheEntry) {
+	v.cacheMutex.Lock()
+	v.currencyCache[code] = entry
 	v.cacheMutex.Unlock()
+}
 
-	return nil
+// recordLookup records the outcome of a Treasury Service lookup
+func (v *Validator) recordLookup(at time.Time, err error) {
+	v.cacheMutex.Lock()
+	defer v.cacheMutex.Unlock()
+
+	v.lastLookup.LastCheck = at
+	v.lastLookup.LastError = err
+	if err == nil {
+		v.lastLookup.LastSuccess = at
+	}
+}
+
+// err returns the validation error for a cached result
+func (e currencyCacheEntry) err() error {
+	if e.valid {
+		return nil
+	}
+	return status.Error(codes.InvalidArgument, e.reason)
 }
 
 // ValidateAccountTypeProto validates account type proto enum
@@ -226,11 +427,31 @@ func (v *Validator) isCommonCurrency(code string) bool {
 }
 
 // initDefaultCurrencies initializes the currency cache with common currencies
-func initDefaultCurrencies() map[string]bool {
-	return map[string]bool{
-		"USD": true, "EUR": true, "GBP": true, "JPY": true,
-		"CHF": true, "CAD": true, "AUD": true, "NZD": true,
-		"CNY": true, "INR": true, "KRW": true, "SGD": true,
-		"HKD": true, "NOK": true, "SEK": true, "DKK": true,
+func initDefaultCurrencies(fetchedAt time.Time) map[string]currencyCacheEntry {
+	cache := make(map[string]currencyCacheEntry)
+	for _, code := range []string{
+		"USD", "EUR", "GBP", "JPY",
+		"CHF", "CAD", "AUD", "NZD",
+		"CNY", "INR", "KRW", "SGD",
+		"HKD", "NOK", "SEK", "DKK",
+	} {
+		cache[code] = commonCurrencyEntry(code, fetchedAt)
 	}
-}
\ No newline at end of file
+	return cache
+}
+
+// commonCurrencyEntry returns a valid cache entry for a common currency.
+// Common currencies have two minor units except JPY, KRW and CLP.
+func commonCurrencyEntry(code string, fetchedAt time.Time) currencyCacheEntry {
+	minorUnits := int32(2)
+	switch code {
+	case "JPY", "KRW", "CLP":
+		minorUnits = 0
+	}
+	return currencyCacheEntry{
+		valid:     true,
+		known:     true,
+		currency:  money.Currency{Code: code, MinorUnits: minorUnits},
+		fetchedAt: fetchedAt,
+	}
+}
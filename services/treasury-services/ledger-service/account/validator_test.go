@@ -2,13 +2,44 @@ package account
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	pb "example.com/go-mono-repo/proto/ledger"
+	treasurypb "example.com/go-mono-repo/proto/treasury"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// MockCurrencyClient is a mock Treasury Service CurrencyService client.
+// Only GetCurrency is used by the validator.
+type MockCurrencyClient struct {
+	treasurypb.CurrencyServiceClient
+	mock.Mock
+}
+
+func (m *MockCurrencyClient) GetCurrency(ctx context.Context, req *treasurypb.GetCurrencyRequest, opts ...grpc.CallOption) (*treasurypb.GetCurrencyResponse, error) {
+	args := m.Called(req.GetCode())
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*treasurypb.GetCurrencyResponse), args.Error(1)
+}
+
+func treasuryCurrency(code string, currencyStatus treasurypb.CurrencyStatus) *treasurypb.GetCurrencyResponse {
+	return &treasurypb.GetCurrencyResponse{
+		Currency: &treasurypb.Currency{
+			Code:     code,
+			IsActive: currencyStatus == treasurypb.CurrencyStatus_CURRENCY_STATUS_ACTIVE,
+			Status:   currencyStatus,
+		},
+	}
+}
+
 // TestValidateCreateAccount tests account creation validation
 // Spec: docs/specs/003-account-management.md#story-1-create-account
 func TestValidateCreateAccount(t *testing.T) {
@@ -138,6 +169,154 @@ func TestValidateCurrencyCode(t *testing.T) {
 	}
 }
 
+// TestValidateCurrencyCodeTreasury tests currency code validation through the
+// Treasury Service
+// Spec: docs/specs/010-treasury-currency-validation.md
+func TestValidateCurrencyCodeTreasury(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("treasury currencies are accepted", func(t *testing.T) {
+		client := new(MockCurrencyClient)
+		v := NewTreasuryValidator(client, 5*time.Minute, time.Second)
+
+		client.On("GetCurrency", "XAU").
+			Return(treasuryCurrency("XAU", treasurypb.CurrencyStatus_CURRENCY_STATUS_ACTIVE), nil).Once()
+
+		assert.NoError(t, v.ValidateCurrencyCode(ctx, "XAU"))
+		// Second call is served from the cache
+		assert.NoError(t, v.ValidateCurrencyCode(ctx, "XAU"))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("unusable currencies are rejected", func(t *testing.T) {
+		tests := []struct {
+			status  treasurypb.CurrencyStatus
+			message string
+		}{
+			{treasurypb.CurrencyStatus_CURRENCY_STATUS_INACTIVE, "currency FRF is inactive"},
+			{treasurypb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED, "currency FRF is deprecated"},
+			{treasurypb.CurrencyStatus_CURRENCY_STATUS_DELETED, "currency FRF is deleted"},
+		}
+
+		for _, tt := range tests {
+			client := new(MockCurrencyClient)
+			v := NewTreasuryValidator(client, 5*time.Minute, time.Second)
+
+			client.On("GetCurrency", "FRF").Return(treasuryCurrency("FRF", tt.status), nil).Once()
+
+			err := v.ValidateCurrencyCode(ctx, "FRF")
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.message)
+		}
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		client := new(MockCurrencyClient)
+		v := NewTreasuryValidator(client, 5*time.Minute, time.Second)
+
+		client.On("GetCurrency", "XXX").Return(nil, status.Error(codes.NotFound, "currency not found")).Once()
+
+		err := v.ValidateCurrencyCode(ctx, "XXX")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "invalid currency code: XXX")
+		assert.NoError(t, v.CurrencyLookupStatus().LastError)
+	})
+
+	t.Run("seeded currencies are refreshed from treasury", func(t *testing.T) {
+		client := new(MockCurrencyClient)
+		v := NewTreasuryValidator(client, 5*time.Minute, time.Second)
+
+		client.On("GetCurrency", "SEK").
+			Return(treasuryCurrency("SEK", treasurypb.CurrencyStatus_CURRENCY_STATUS_INACTIVE), nil).Once()
+
+		assert.Error(t, v.ValidateCurrencyCode(ctx, "SEK"))
+		client.AssertExpectations(t)
+	})
+
+	t.Run("stale cache is used when treasury is unreachable", func(t *testing.T) {
+		client := new(MockCurrencyClient)
+		v := NewTreasuryValidator(client, time.Nanosecond, time.Second)
+
+		client.On("GetCurrency", "BTC").
+			Return(treasuryCurrency("BTC", treasurypb.CurrencyStatus_CURRENCY_STATUS_ACTIVE), nil).Once()
+		client.On("GetCurrency", "BTC").
+			Return(nil, status.Error(codes.Unavailable, "connection refused")).Once()
+
+		assert.NoError(t, v.ValidateCurrencyCode(ctx, "BTC"))
+		time.Sleep(time.Millisecond)
+		assert.NoError(t, v.ValidateCurrencyCode(ctx, "BTC"))
+
+		lookup := v.CurrencyLookupStatus()
+		assert.Error(t, lookup.LastError)
+		assert.False(t, lookup.LastSuccess.IsZero())
+		client.AssertExpectations(t)
+	})
+
+	t.Run("seeded currencies are used when treasury is unreachable", func(t *testing.T) {
+		client := new(MockCurrencyClient)
+		v := NewTreasuryValidator(client, 5*time.Minute, time.Second)
+
+		client.On("GetCurrency", "USD").Return(nil, errors.New("dial tcp: connection refused")).Once()
+
+		assert.NoError(t, v.ValidateCurrencyCode(ctx, "USD"))
+	})
+
+	t.Run("uncached currency when treasury is unreachable", func(t *testing.T) {
+		client := new(MockCurrencyClient)
+		v := NewTreasuryValidator(client, 5*time.Minute, time.Second)
+
+		client.On("GetCurrency", "XAU").Return(nil, status.Error(codes.Unavailable, "connection refused")).Once()
+
+		err := v.ValidateCurrencyCode(ctx, "XAU")
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Contains(t, err.Error(), "treasury service unavailable")
+	})
+}
+
+// TestMoneyCurrency tests reading a currency's minor units for amount
+// checks
+// Spec: docs/specs/007-money.md#ledger-amounts
+func TestMoneyCurrency(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("common currencies", func(t *testing.T) {
+		v := NewValidator()
+
+		usd, err := v.MoneyCurrency(ctx, "USD")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), usd.MinorUnits)
+
+		jpy, err := v.MoneyCurrency(ctx, "JPY")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), jpy.MinorUnits)
+
+		_, err = v.MoneyCurrency(ctx, "XXX")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("treasury currencies whatever their status", func(t *testing.T) {
+		client := new(MockCurrencyClient)
+		v := NewTreasuryValidator(client, 5*time.Minute, time.Second)
+
+		btc := treasuryCurrency("BTC", treasurypb.CurrencyStatus_CURRENCY_STATUS_ACTIVE)
+		btc.Currency.MinorUnits = 8
+		frf := treasuryCurrency("FRF", treasurypb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED)
+		frf.Currency.MinorUnits = 2
+		client.On("GetCurrency", "BTC").Return(btc, nil).Once()
+		client.On("GetCurrency", "FRF").Return(frf, nil).Once()
+
+		currency, err := v.MoneyCurrency(ctx, "BTC")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(8), currency.MinorUnits)
+
+		currency, err = v.MoneyCurrency(ctx, "FRF")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), currency.MinorUnits)
+		assert.Error(t, v.ValidateCurrencyCode(ctx, "FRF"))
+		client.AssertExpectations(t)
+	})
+}
+
 // TestValidateAccountType tests account type validation
 // Spec: docs/specs/003-account-management.md#data-models
 func TestValidateAccountType(t *testing.T) {
@@ -0,0 +1,163 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Audited entity types as stored in audit_log.entity_type
+const (
+	EntityAccount          = "accounts"
+	EntityJournalEntry     = "journal_entries"
+	EntityAccountingPeriod = "accounting_periods"
+	EntityHold             = "holds"
+)
+
+// Audited actions as stored in audit_log.action
+const (
+	ActionCreate    = "CREATE"
+	ActionUpdate    = "UPDATE"
+	ActionFreeze    = "FREEZE"
+	ActionClose     = "CLOSE"
+	ActionReopen    = "REOPEN"
+	ActionSoftClose = "SOFT_CLOSE"
+	ActionCapture   = "CAPTURE"
+	ActionRelease   = "RELEASE"
+)
+
+// UserIDMetadataKey is the gRPC metadata key carrying the caller identity
+const UserIDMetadataKey = "x-user-id"
+
+// AnonymousUser is recorded when the caller sent no identity
+const AnonymousUser = "anonymous"
+
+// Event describes one change to be recorded in the audit log. OldValues is
+// nil for creations. Values are keyed by proto field name.
+type Event struct {
+	EntityType string
+	EntityID   string
+	Action     string
+	OldValues  map[string]interface{}
+	NewValues  map[string]interface{}
+}
+
+// Write inserts an audit row inside the caller's ImmuDB transaction so the
+// audit record commits or rolls back together with the change
+// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+func Write(ctx context.Context, tx client.Tx, event *Event) error {
+	oldValues, newValues := Diff(event.OldValues, event.NewValues)
+
+	params := map[string]interface{}{
+		"id":          uuid.New().String(),
+		"entity_type": event.EntityType,
+		"entity_id":   event.EntityID,
+		"action":      event.Action,
+		"old_values":  nil,
+		"new_values":  nil,
+		"user_id":     UserFromContext(ctx),
+		"created_at":  time.Now(),
+		"metadata":    nil,
+	}
+
+	if oldValues != nil {
+		data, err := json.Marshal(oldValues)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to encode audit old values: %v", err)
+		}
+		params["old_values"] = string(data)
+	}
+	if newValues != nil {
+		data, err := json.Marshal(newValues)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to encode audit new values: %v", err)
+		}
+		params["new_values"] = string(data)
+	}
+	if callMetadata := callMetadataFromContext(ctx); len(callMetadata) > 0 {
+		data, err := json.Marshal(callMetadata)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to encode audit metadata: %v", err)
+		}
+		params["metadata"] = string(data)
+	}
+
+	query := `
+		INSERT INTO audit_log (
+			id, entity_type, entity_id, action, old_values,
+			new_values, user_id, created_at, metadata
+		) VALUES (
+			@id, @entity_type, @entity_id, @action, @old_values,
+			@new_values, @user_id, @created_at, @metadata
+		)`
+
+	if err := tx.SQLExec(ctx, query, params); err != nil {
+		return status.Errorf(codes.Internal, "failed to write audit event: %v", err)
+	}
+
+	return nil
+}
+
+// Diff reduces old and new values to the fields that changed. For creations
+// (old is nil) every new value is kept and the old side stays nil.
+// Spec: docs/specs/008-audit-log.md#json-diffs
+func Diff(old, new map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	if old == nil {
+		return nil, new
+	}
+
+	oldChanged := map[string]interface{}{}
+	newChanged := map[string]interface{}{}
+	for field, newValue := range new {
+		oldValue, found := old[field]
+		if found && reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		oldChanged[field] = oldValue
+		newChanged[field] = newValue
+	}
+	for field, oldValue := range old {
+		if _, found := new[field]; !found {
+			oldChanged[field] = oldValue
+			newChanged[field] = nil
+		}
+	}
+
+	return oldChanged, newChanged
+}
+
+// UserFromContext returns the caller identity from incoming gRPC metadata
+// Spec: docs/specs/008-audit-log.md#caller-identity
+func UserFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return AnonymousUser
+	}
+	for _, value := range md.Get(UserIDMetadataKey) {
+		if value != "" {
+			return value
+		}
+	}
+	return AnonymousUser
+}
+
+// callMetadataFromContext collects the RPC method and peer address
+func callMetadataFromContext(ctx context.Context) map[string]string {
+	callMetadata := map[string]string{}
+	if method, ok := grpc.Method(ctx); ok {
+		callMetadata["method"] = method
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		callMetadata["peer"] = p.Addr.String()
+	}
+	return callMetadata
+}
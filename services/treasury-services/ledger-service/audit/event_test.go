@@ -0,0 +1,122 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// mockTx mocks the ImmuDB interactive transaction used by Write.
+// Calling any other method panics through the nil embedded interface.
+type mockTx struct {
+	client.Tx
+	mock.Mock
+}
+
+func (m *mockTx) SQLExec(ctx context.Context, sql string, params map[string]interface{}) error {
+	args := m.Called(ctx, sql, params)
+	return args.Error(0)
+}
+
+// TestWrite tests writing audit rows inside a transaction
+// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+func TestWrite(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(UserIDMetadataKey, "alice"))
+
+	t.Run("writes diff and caller", func(t *testing.T) {
+		tx := new(mockTx)
+		var params map[string]interface{}
+		tx.On("SQLExec", ctx, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { params = args.Get(2).(map[string]interface{}) }).
+			Return(nil).Once()
+
+		err := Write(ctx, tx, &Event{
+			EntityType: EntityAccount,
+			EntityID:   "acc-1",
+			Action:     ActionUpdate,
+			OldValues:  map[string]interface{}{"name": "Cash", "account_type": "ASSET"},
+			NewValues:  map[string]interface{}{"name": "Operating Cash", "account_type": "ASSET"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, EntityAccount, params["entity_type"])
+		assert.Equal(t, "acc-1", params["entity_id"])
+		assert.Equal(t, ActionUpdate, params["action"])
+		assert.Equal(t, "alice", params["user_id"])
+		assert.JSONEq(t, `{"name":"Cash"}`, params["old_values"].(string))
+		assert.JSONEq(t, `{"name":"Operating Cash"}`, params["new_values"].(string))
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("creation has no old values", func(t *testing.T) {
+		tx := new(mockTx)
+		var params map[string]interface{}
+		tx.On("SQLExec", ctx, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { params = args.Get(2).(map[string]interface{}) }).
+			Return(nil).Once()
+
+		err := Write(ctx, tx, &Event{
+			EntityType: EntityJournalEntry,
+			EntityID:   "je-1",
+			Action:     ActionCreate,
+			NewValues:  map[string]interface{}{"status": "POSTED"},
+		})
+
+		assert.NoError(t, err)
+		assert.Nil(t, params["old_values"])
+		newValues := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal([]byte(params["new_values"].(string)), &newValues))
+		assert.Equal(t, "POSTED", newValues["status"])
+	})
+
+	t.Run("insert fails", func(t *testing.T) {
+		tx := new(mockTx)
+		tx.On("SQLExec", ctx, mock.Anything, mock.Anything).Return(errors.New("connection lost")).Once()
+
+		err := Write(ctx, tx, &Event{EntityType: EntityAccount, EntityID: "acc-1", Action: ActionCreate})
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+// TestDiff tests reducing audit values to changed fields
+// Spec: docs/specs/008-audit-log.md#json-diffs
+func TestDiff(t *testing.T) {
+	t.Run("keeps only changed fields", func(t *testing.T) {
+		oldValues, newValues := Diff(
+			map[string]interface{}{"name": "Cash", "version": int64(1), "external_group_id": "grp-1"},
+			map[string]interface{}{"name": "Cash", "version": int64(2), "external_group_id": nil},
+		)
+
+		assert.Equal(t, map[string]interface{}{"version": int64(1), "external_group_id": "grp-1"}, oldValues)
+		assert.Equal(t, map[string]interface{}{"version": int64(2), "external_group_id": nil}, newValues)
+	})
+
+	t.Run("creation keeps all new values", func(t *testing.T) {
+		oldValues, newValues := Diff(nil, map[string]interface{}{"name": "Cash"})
+
+		assert.Nil(t, oldValues)
+		assert.Equal(t, map[string]interface{}{"name": "Cash"}, newValues)
+	})
+}
+
+// TestUserFromContext tests reading the caller identity
+// Spec: docs/specs/008-audit-log.md#caller-identity
+func TestUserFromContext(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(UserIDMetadataKey, "alice"))
+	assert.Equal(t, "alice", UserFromContext(ctx))
+
+	assert.Equal(t, AnonymousUser, UserFromContext(context.Background()))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer x"))
+	assert.Equal(t, AnonymousUser, UserFromContext(ctx))
+}
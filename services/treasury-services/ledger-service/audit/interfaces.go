@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"context"
+
+	pb "example.com/go-mono-repo/proto/ledger"
+)
+
+// RepositoryInterface defines the interface for audit repository operations
+type RepositoryInterface interface {
+	ListAuditEvents(ctx context.Context, filters ListAuditEventFilters) ([]*AuditEventRow, string, int32, error)
+}
+
+// ManagerInterface defines the interface for audit manager operations
+type ManagerInterface interface {
+	ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error)
+}
@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	pb "example.com/go-mono-repo/proto/ledger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Manager handles audit log business logic
+// Spec: docs/specs/008-audit-log.md
+type Manager struct {
+	repo RepositoryInterface
+}
+
+// NewManager creates a new audit manager
+func NewManager(repo RepositoryInterface) *Manager {
+	return &Manager{
+		repo: repo,
+	}
+}
+
+// ListAuditEvents lists audit events with filtering
+// Spec: docs/specs/008-audit-log.md#story-2-list-audit-events
+func (m *Manager) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	filters := ListAuditEventFilters{
+		PageSize:       req.PageSize,
+		PageToken:      req.PageToken,
+		EntityType:     req.EntityType,
+		EntityID:       req.EntityId,
+		UserID:         req.UserId,
+		SkipTotalCount: req.SkipTotalCount,
+	}
+
+	// Validate page size
+	if filters.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	}
+	if filters.PageSize == 0 {
+		filters.PageSize = 50 // Default
+	}
+	if filters.PageSize > 200 {
+		filters.PageSize = 200 // Max
+	}
+
+	if req.StartTime != nil {
+		start := req.StartTime.AsTime()
+		filters.StartTime = &start
+	}
+	if req.EndTime != nil {
+		end := req.EndTime.AsTime()
+		filters.EndTime = &end
+	}
+	if filters.StartTime != nil && filters.EndTime != nil && !filters.StartTime.Before(*filters.EndTime) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+
+	rows, nextPageToken, totalCount, err := m.repo.ListAuditEvents(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*pb.AuditEvent, len(rows))
+	for i, row := range rows {
+		events[i] = auditEventRowToProto(row)
+	}
+
+	return &pb.ListAuditEventsResponse{
+		Events:        events,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// auditEventRowToProto converts a database row to proto message
+func auditEventRowToProto(row *AuditEventRow) *pb.AuditEvent {
+	event := &pb.AuditEvent{
+		Id:         row.ID,
+		EntityType: row.EntityType,
+		EntityId:   row.EntityID,
+		Action:     row.Action,
+		CreatedAt:  timestamppb.New(row.CreatedAt),
+	}
+
+	if row.OldValues.Valid {
+		event.OldValues = row.OldValues.String
+	}
+	if row.NewValues.Valid {
+		event.NewValues = row.NewValues.String
+	}
+	if row.UserID.Valid {
+		event.UserId = row.UserID.String
+	}
+	if row.Metadata.Valid {
+		metadata := map[string]string{}
+		if err := json.Unmarshal([]byte(row.Metadata.String), &metadata); err == nil {
+			event.Metadata = metadata
+		}
+	}
+
+	return event
+}
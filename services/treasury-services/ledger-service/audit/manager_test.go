@@ -0,0 +1,134 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockRepository is a mock implementation of AuditRepository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListAuditEvents(ctx context.Context, filters ListAuditEventFilters) ([]*AuditEventRow, string, int32, error) {
+	args := m.Called(ctx, filters)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Get(2).(int32), args.Error(3)
+	}
+	return args.Get(0).([]*AuditEventRow), args.String(1), args.Get(2).(int32), args.Error(3)
+}
+
+// TestListAuditEvents tests listing audit events
+// Spec: docs/specs/008-audit-log.md#story-2-list-audit-events
+func TestListAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 8, 24, 10, 0, 0, 0, time.UTC)
+
+	t.Run("returns events with filters", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo)
+
+		start := createdAt.Add(-time.Hour)
+		end := createdAt.Add(time.Hour)
+		repo.On("ListAuditEvents", ctx, ListAuditEventFilters{
+			PageSize:   50,
+			EntityType: EntityAccount,
+			EntityID:   "acc-1",
+			UserID:     "alice",
+			StartTime:  &start,
+			EndTime:    &end,
+		}).Return([]*AuditEventRow{
+			{
+				ID:         "evt-1",
+				EntityType: EntityAccount,
+				EntityID:   "acc-1",
+				Action:     ActionUpdate,
+				OldValues:  sql.NullString{String: `{"name":"Cash"}`, Valid: true},
+				NewValues:  sql.NullString{String: `{"name":"Operating Cash"}`, Valid: true},
+				UserID:     sql.NullString{String: "alice", Valid: true},
+				CreatedAt:  createdAt,
+				Metadata:   sql.NullString{String: `{"method":"/ledger.AccountService/UpdateAccount"}`, Valid: true},
+			},
+		}, "", int32(1), nil).Once()
+
+		resp, err := manager.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{
+			EntityType: EntityAccount,
+			EntityId:   "acc-1",
+			UserId:     "alice",
+			StartTime:  timestamppb.New(start),
+			EndTime:    timestamppb.New(end),
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Events, 1)
+		assert.Equal(t, int32(1), resp.TotalCount)
+		event := resp.Events[0]
+		assert.Equal(t, "evt-1", event.Id)
+		assert.Equal(t, ActionUpdate, event.Action)
+		assert.Equal(t, `{"name":"Cash"}`, event.OldValues)
+		assert.Equal(t, `{"name":"Operating Cash"}`, event.NewValues)
+		assert.Equal(t, "alice", event.UserId)
+		assert.Equal(t, "/ledger.AccountService/UpdateAccount", event.Metadata["method"])
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("caps page size", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo)
+
+		repo.On("ListAuditEvents", ctx, ListAuditEventFilters{PageSize: 200}).
+			Return([]*AuditEventRow{}, "", int32(0), nil).Once()
+
+		resp, err := manager.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{PageSize: 1000})
+
+		assert.NoError(t, err)
+		assert.Empty(t, resp.Events)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("skip total count", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo)
+
+		repo.On("ListAuditEvents", ctx, ListAuditEventFilters{PageSize: 50, UserID: "alice", SkipTotalCount: true}).
+			Return([]*AuditEventRow{}, "", int32(0), nil).Once()
+
+		resp, err := manager.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{UserId: "alice", SkipTotalCount: true})
+
+		assert.NoError(t, err)
+		assert.Zero(t, resp.TotalCount)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("negative page size", func(t *testing.T) {
+		manager := NewManager(new(MockRepository))
+
+		resp, err := manager.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{PageSize: -1})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("start after end", func(t *testing.T) {
+		manager := NewManager(new(MockRepository))
+
+		resp, err := manager.ListAuditEvents(ctx, &pb.ListAuditEventsRequest{
+			StartTime: timestamppb.New(createdAt),
+			EndTime:   timestamppb.New(createdAt.Add(-time.Hour)),
+		})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
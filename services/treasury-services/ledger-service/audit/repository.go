@@ -0,0 +1,170 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuditRepository handles database reads of the audit log
+// Spec: docs/specs/008-audit-log.md
+type AuditRepository struct {
+	db client.ImmuClient
+}
+
+// NewAuditRepository creates a new audit repository
+func NewAuditRepository(db client.ImmuClient) *AuditRepository {
+	return &AuditRepository{
+		db: db,
+	}
+}
+
+// AuditEventRow represents a database row of the audit log
+type AuditEventRow struct {
+	ID         string
+	EntityType string
+	EntityID   string
+	Action     string
+	OldValues  sql.NullString
+	NewValues  sql.NullString
+	UserID     sql.NullString
+	CreatedAt  time.Time
+	Metadata   sql.NullString
+}
+
+// ListAuditEventFilters contains filters for listing audit events
+type ListAuditEventFilters struct {
+	PageSize       int32
+	PageToken      string
+	EntityType     string
+	EntityID       string
+	UserID         string
+	StartTime      *time.Time
+	EndTime        *time.Time
+	SkipTotalCount bool
+}
+
+// ListAuditEvents lists audit events with filtering and pagination
+// Spec: docs/specs/008-audit-log.md#story-2-list-audit-events
+func (r *AuditRepository) ListAuditEvents(ctx context.Context, filters ListAuditEventFilters) ([]*AuditEventRow, string, int32, error) {
+	// Build WHERE clause
+	whereClauses := []string{}
+	params := map[string]interface{}{}
+
+	if filters.EntityType != "" {
+		whereClauses = append(whereClauses, "entity_type = @entity_type")
+		params["entity_type"] = filters.EntityType
+	}
+
+	if filters.EntityID != "" {
+		whereClauses = append(whereClauses, "entity_id = @entity_id")
+		params["entity_id"] = filters.EntityID
+	}
+
+	if filters.UserID != "" {
+		whereClauses = append(whereClauses, "user_id = @user_id")
+		params["user_id"] = filters.UserID
+	}
+
+	if filters.StartTime != nil {
+		whereClauses = append(whereClauses, "created_at >= @start_time")
+		params["start_time"] = *filters.StartTime
+	}
+
+	if filters.EndTime != nil {
+		whereClauses = append(whereClauses, "created_at < @end_time")
+		params["end_time"] = *filters.EndTime
+	}
+
+	whereClause := ""
+	if len(whereClauses) > 0 {
+		whereClause = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	// Count total matching events with the same filters as the page
+	// Spec: docs/specs/005-cursor-pagination.md#total-count
+	totalCount := int32(0)
+	if !filters.SkipTotalCount {
+		countQuery := fmt.Sprintf("SELECT COUNT(*) as total FROM audit_log %s", whereClause)
+		countResult, err := r.db.SQLQuery(ctx, countQuery, params, false)
+		if err != nil {
+			return nil, "", 0, status.Errorf(codes.Internal, "failed to count audit events: %v", err)
+		}
+		if len(countResult.Rows) > 0 {
+			totalCount = int32(countResult.Rows[0].Values[0].GetN())
+		}
+	}
+
+	limit := filters.PageSize
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 200 {
+		limit = 200
+	}
+
+	offset := int32(0)
+	if filters.PageToken != "" {
+		fmt.Sscanf(filters.PageToken, "%d", &offset)
+	}
+
+	// Fetch one extra row to tell whether another page follows
+	query := fmt.Sprintf(`
+		SELECT
+			id, entity_type, entity_id, action, old_values,
+			new_values, user_id, created_at, metadata
+		FROM audit_log
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT %d OFFSET %d`,
+		whereClause, limit+1, offset)
+
+	result, err := r.db.SQLQuery(ctx, query, params, false)
+	if err != nil {
+		return nil, "", 0, status.Errorf(codes.Internal, "failed to list audit events: %v", err)
+	}
+
+	events := make([]*AuditEventRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		events = append(events, parseAuditEventRow(row))
+	}
+
+	// Calculate next page token
+	nextPageToken := ""
+	if len(events) > int(limit) {
+		events = events[:limit]
+		nextPageToken = fmt.Sprintf("%d", offset+limit)
+	}
+
+	return events, nextPageToken, totalCount, nil
+}
+
+// parseAuditEventRow converts a query row into an AuditEventRow
+func parseAuditEventRow(row *schema.Row) *AuditEventRow {
+	return &AuditEventRow{
+		ID:         row.Values[0].GetS(),
+		EntityType: row.Values[1].GetS(),
+		EntityID:   row.Values[2].GetS(),
+		Action:     row.Values[3].GetS(),
+		OldValues:  nullStringValue(row.Values[4]),
+		NewValues:  nullStringValue(row.Values[5]),
+		UserID:     nullStringValue(row.Values[6]),
+		CreatedAt:  time.UnixMicro(row.Values[7].GetTs()),
+		Metadata:   nullStringValue(row.Values[8]),
+	}
+}
+
+// nullStringValue converts an optional VARCHAR column into sql.NullString
+func nullStringValue(v *schema.SQLValue) sql.NullString {
+	if v != nil && len(v.GetS()) > 0 {
+		return sql.NullString{String: v.GetS(), Valid: true}
+	}
+	return sql.NullString{}
+}
@@ -0,0 +1,42 @@
+package audit
+
+import (
+	"context"
+	"log"
+
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/pkg/client"
+)
+
+// Server implements the AuditService gRPC interface
+// Spec: docs/specs/008-audit-log.md
+type Server struct {
+	pb.UnimplementedAuditServiceServer
+	manager ManagerInterface
+}
+
+// NewServer creates a new audit server
+func NewServer(db client.ImmuClient) *Server {
+	repo := NewAuditRepository(db)
+	manager := NewManager(repo)
+
+	return &Server{
+		manager: manager,
+	}
+}
+
+// ListAuditEvents lists audit events with filtering
+// Spec: docs/specs/008-audit-log.md#story-2-list-audit-events
+func (s *Server) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	log.Printf("Listing audit events: page_size=%d, entity_type=%s, entity_id=%s, user_id=%s",
+		req.PageSize, req.EntityType, req.EntityId, req.UserId)
+
+	resp, err := s.manager.ListAuditEvents(ctx, req)
+	if err != nil {
+		log.Printf("Failed to list audit events: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Listed %d audit events, total=%d", len(resp.Events), resp.TotalCount)
+	return resp, nil
+}
@@ -37,6 +37,18 @@ type Config struct {
 	// Features
 	EnabledFeatures []string `envconfig:"ENABLED_FEATURES" default:"base,manifest"`
 
+	// Secret used to sign list page tokens. Must be shared by all replicas.
+	// Spec: docs/specs/005-cursor-pagination.md#configuration
+	PageTokenSecret string `envconfig:"PAGE_TOKEN_SECRET"`
+
+	// How long an idempotency key is kept after its first request
+	// Spec: docs/specs/006-idempotency-keys.md#configuration
+	IdempotencyKeyTTLHours int `envconfig:"IDEMPOTENCY_KEY_TTL_HOURS" default:"24"`
+
+	// Currency every journal line is also recorded in
+	// Spec: docs/specs/014-multi-currency.md#functional-currency
+	FunctionalCurrency string `envconfig:"FUNCTIONAL_CURRENCY" default:"USD"`
+
 	// Logging
 	LogLevel  string `envconfig:"LOG_LEVEL" default:"info"`
 	LogFormat string `envconfig:"LOG_FORMAT" default:"json"`
@@ -56,6 +68,10 @@ type Config struct {
 	// Tracing Configuration
 	// Spec: docs/specs/004-opentelemetry-tracing.md
 	Tracing *TracingConfig `envconfig:"-"`
+
+	// Treasury Service Configuration
+	// Spec: docs/specs/010-treasury-currency-validation.md
+	TreasuryService *TreasuryServiceConfig `envconfig:"-"`
 	
 	// Internal - not from env
 	EnvFilePath string `envconfig:"-"`
@@ -72,6 +88,21 @@ type TracingConfig struct {
 	ServiceVersion string  
 }
 
+// TreasuryServiceConfig holds the Treasury Service connection used for
+// currency validation
+// Spec: docs/specs/010-treasury-currency-validation.md#configuration
+type TreasuryServiceConfig struct {
+	Host             string
+	Port             int
+	LookupTimeout    time.Duration
+	CurrencyCacheTTL time.Duration
+}
+
+// Address returns the host:port of the Treasury Service
+func (c *TreasuryServiceConfig) Address() string {
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
+
 // ImmuDBConfig holds ImmuDB connection parameters
 // Spec: docs/specs/001-immudb-connection.md
 type ImmuDBConfig struct {
@@ -86,6 +117,7 @@ type ImmuDBConfig struct {
 	ConnectionMaxIdleTime time.Duration
 	VerifyTransactions    bool
 	ServerSigningPubKey   string
+	StateDir              string
 	ClientKeyPath         string
 	ClientCertPath        string
 	HealthCheckInterval   time.Duration
@@ -147,6 +179,14 @@ func LoadConfig() (*Config, error) {
 	tracingConfig := LoadTracingConfig(&cfg)
 	cfg.Tracing = tracingConfig
 
+	// Load Treasury Service configuration
+	// Spec: docs/specs/010-treasury-currency-validation.md#configuration
+	treasuryConfig, err := LoadTreasuryServiceConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load treasury service config: %w", err)
+	}
+	cfg.TreasuryService = treasuryConfig
+
 	// Store the loaded path for later logging if needed
 	if loadedPath != "" {
 		cfg.EnvFilePath = loadedPath
@@ -194,6 +234,14 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("service version is required")
 	}
 
+	if c.IdempotencyKeyTTLHours < 1 {
+		return fmt.Errorf("invalid idempotency key TTL: %d hours (must be at least 1)", c.IdempotencyKeyTTLHours)
+	}
+
+	if !isCurrencyCode(c.FunctionalCurrency) {
+		return fmt.Errorf("invalid functional currency: %q (must be a 3-letter uppercase ISO 4217 code)", c.FunctionalCurrency)
+	}
+
 	validEnvironments := map[string]bool{
 		"dev":     true,
 		"staging": true,
@@ -217,6 +265,19 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// isCurrencyCode reports whether s has the shape of an ISO 4217 code
+func isCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
 // String returns a string representation of the config (for debugging)
 // Spec: docs/specs/002-configuration-management.md#helper-functions
 func (c *Config) String() string {
@@ -250,6 +311,7 @@ func LoadImmuDBConfig() (*ImmuDBConfig, error) {
 		MaxIdleConnections: getEnvInt("IMMUDB_MAX_IDLE_CONNECTIONS", 5),
 		VerifyTransactions: getEnvBool("IMMUDB_VERIFY_TRANSACTIONS", true),
 		ServerSigningPubKey: getEnvString("IMMUDB_SERVER_SIGNING_PUB_KEY", ""),
+		StateDir:           getEnvString("IMMUDB_STATE_DIR", ".immudb-state"),
 		ClientKeyPath:      getEnvString("IMMUDB_CLIENT_KEY_PATH", ""),
 		ClientCertPath:     getEnvString("IMMUDB_CLIENT_CERT_PATH", ""),
 		ChunkSize:          getEnvInt("IMMUDB_CHUNK_SIZE", 64),
@@ -334,6 +396,31 @@ func LoadTracingConfig(cfg *Config) *TracingConfig {
 	}
 }
 
+// LoadTreasuryServiceConfig loads the Treasury Service connection from environment
+// Spec: docs/specs/010-treasury-currency-validation.md#configuration
+func LoadTreasuryServiceConfig() (*TreasuryServiceConfig, error) {
+	cfg := &TreasuryServiceConfig{
+		// Note: Default to localhost where treasury runs in the devcontainer
+		// Override with TREASURY_SERVICE_HOST when running as separate containers
+		Host:             getEnvString("TREASURY_SERVICE_HOST", "localhost"),
+		Port:             getEnvInt("TREASURY_SERVICE_PORT", 50052),
+		LookupTimeout:    time.Duration(getEnvInt("TREASURY_LOOKUP_TIMEOUT", 2)) * time.Second,
+		CurrencyCacheTTL: time.Duration(getEnvInt("CURRENCY_CACHE_TTL", 300)) * time.Second,
+	}
+
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		return nil, fmt.Errorf("invalid TREASURY_SERVICE_PORT: %d", cfg.Port)
+	}
+	if cfg.LookupTimeout <= 0 {
+		return nil, fmt.Errorf("TREASURY_LOOKUP_TIMEOUT must be positive")
+	}
+	if cfg.CurrencyCacheTTL <= 0 {
+		return nil, fmt.Errorf("CURRENCY_CACHE_TTL must be positive")
+	}
+
+	return cfg, nil
+}
+
 // getEnvFloat gets a float64 value from environment or returns default
 func getEnvFloat(key string, defaultValue float64) float64 {
 	if value := os.Getenv(key); value != "" {
@@ -0,0 +1,46 @@
+package hold
+
+import (
+	"context"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/journal"
+	pb "example.com/go-mono-repo/proto/ledger"
+)
+
+// RepositoryInterface defines the interface for hold repository operations
+type RepositoryInterface interface {
+	CreateHold(ctx context.Context, hold *HoldRow, accountVersion int64, creditNormal bool) error
+	GetHoldByID(ctx context.Context, holdID string) (*HoldRow, error)
+	SettleHold(ctx context.Context, hold *HoldRow, settlement *Settlement, entry *journal.PreparedEntry) (*HoldRow, error)
+	ListHolds(ctx context.Context, filters ListHoldFilters) ([]*HoldRow, string, int32, error)
+}
+
+// ManagerInterface defines the interface for hold manager operations
+type ManagerInterface interface {
+	CreateHold(ctx context.Context, req *pb.CreateHoldRequest) (*pb.Hold, error)
+	CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.Hold, *pb.JournalEntry, error)
+	ReleaseHold(ctx context.Context, req *pb.ReleaseHoldRequest) (*pb.Hold, error)
+	GetHold(ctx context.Context, holdID string) (*pb.Hold, error)
+	ListHolds(ctx context.Context, req *pb.ListHoldsRequest) (*pb.ListHoldsResponse, error)
+}
+
+// AccountReaderInterface reads the account a hold is placed on
+type AccountReaderInterface interface {
+	GetAccountByID(ctx context.Context, accountID string) (*account.AccountRow, error)
+}
+
+// BalanceReaderInterface resolves the normal balance side of an account type
+// Spec: docs/specs/013-holds.md#available-balance
+type BalanceReaderInterface interface {
+	NormalBalanceFor(ctx context.Context, accountType string) string
+}
+
+// EntryPreparerInterface validates the journal entry of a capture without
+// writing it, and decides which account statuses can transact the same way
+// journal posting does
+// Spec: docs/specs/013-holds.md#story-2-capture-hold
+type EntryPreparerInterface interface {
+	PrepareJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*journal.PreparedEntry, error)
+	StatusCanTransact(ctx context.Context, accountStatus string) bool
+}
@@ -0,0 +1,407 @@
+package hold
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultHoldTTL is how long a hold reserves funds when the request sets
+// no expires_at
+const defaultHoldTTL = 7 * 24 * time.Hour
+
+// Manager handles hold business logic
+// Spec: docs/specs/013-holds.md
+type Manager struct {
+	repo      RepositoryInterface
+	accounts  AccountReaderInterface
+	balances  BalanceReaderInterface
+	entries   EntryPreparerInterface
+	validator *account.Validator
+}
+
+// NewManager creates a new hold manager
+func NewManager(repo RepositoryInterface, accounts AccountReaderInterface, balances BalanceReaderInterface, entries EntryPreparerInterface, validator *account.Validator) *Manager {
+	return &Manager{
+		repo:      repo,
+		accounts:  accounts,
+		balances:  balances,
+		entries:   entries,
+		validator: validator,
+	}
+}
+
+// CreateHold reserves an amount on an account. The amount must not exceed
+// the account's available balance.
+// Spec: docs/specs/013-holds.md#story-1-create-hold
+func (m *Manager) CreateHold(ctx context.Context, req *pb.CreateHoldRequest) (*pb.Hold, error) {
+	if req.AccountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+	holdAmount, err := parseHoldAmount(req.Amount, "amount")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Reference) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "reference must be 100 characters or less")
+	}
+	if len(req.Description) > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "description must be 1024 characters or less")
+	}
+	actor, err := m.actor(ctx, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(defaultHoldTTL)
+	if req.ExpiresAt != nil {
+		if err := req.ExpiresAt.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expires_at: %v", err)
+		}
+		expiresAt = req.ExpiresAt.AsTime()
+		if !expiresAt.After(now) {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+	}
+
+	acc, err := m.accounts.GetAccountByID(ctx, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Spec: docs/specs/009-account-lifecycle.md#story-4-reject-postings
+	if !m.entries.StatusCanTransact(ctx, acc.Status) {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %s is %s and cannot transact",
+			acc.ID, strings.ToLower(acc.Status))
+	}
+	// Spec: docs/specs/007-money.md#ledger-amounts
+	currency, err := m.validator.MoneyCurrency(ctx, acc.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+	if err := amount.CheckMinorUnits(holdAmount, currency); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+	creditNormal := m.balances.NormalBalanceFor(ctx, acc.AccountType) == account.NormalBalanceCredit
+
+	row := &HoldRow{
+		ID:           uuid.New().String(),
+		AccountID:    acc.ID,
+		CurrencyCode: acc.CurrencyCode,
+		Amount:       holdAmount,
+		Status:       StatusActive,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    now,
+		CreatedBy:    sql.NullString{String: actor, Valid: true},
+		UpdatedAt:    now,
+		Version:      1,
+	}
+	if req.Reference != "" {
+		row.Reference = sql.NullString{String: req.Reference, Valid: true}
+	}
+	if req.Description != "" {
+		row.Description = sql.NullString{String: req.Description, Valid: true}
+	}
+
+	if err := m.repo.CreateHold(ctx, row, acc.Version, creditNormal); err != nil {
+		return nil, err
+	}
+
+	return holdRowToProto(row, now), nil
+}
+
+// CaptureHold posts all or part of an active hold as a journal entry
+// between the hold's account and an offset account. The hold is settled by
+// the capture; any amount not captured stops being reserved.
+// Spec: docs/specs/013-holds.md#story-2-capture-hold
+func (m *Manager) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.Hold, *pb.JournalEntry, error) {
+	if req.HoldId == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "hold_id is required")
+	}
+	if req.OffsetAccountId == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "offset_account_id is required")
+	}
+	actor, err := m.actor(ctx, req.Actor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hold, err := m.repo.GetHoldByID(ctx, req.HoldId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkSettleable(hold, time.Now(), "capture"); err != nil {
+		return nil, nil, err
+	}
+	if req.OffsetAccountId == hold.AccountID {
+		return nil, nil, status.Error(codes.InvalidArgument, "offset_account_id must differ from the hold's account")
+	}
+
+	captured := hold.Amount
+	if req.Amount != "" {
+		if captured, err = parseHoldAmount(req.Amount, "amount"); err != nil {
+			return nil, nil, err
+		}
+		if captured > hold.Amount {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "amount %s exceeds the hold amount %s",
+				amount.Format(captured), amount.Format(hold.Amount))
+		}
+	}
+
+	// Funds leave the hold's account, so it is posted on the side that
+	// reduces its normal balance
+	acc, err := m.accounts.GetAccountByID(ctx, hold.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	holdLine := &pb.JournalEntryLine{AccountId: hold.AccountID, CreditAmount: amount.Format(captured)}
+	offsetLine := &pb.JournalEntryLine{AccountId: req.OffsetAccountId, DebitAmount: amount.Format(captured)}
+	if m.balances.NormalBalanceFor(ctx, acc.AccountType) == account.NormalBalanceCredit {
+		holdLine.CreditAmount, holdLine.DebitAmount = "", holdLine.CreditAmount
+		offsetLine.DebitAmount, offsetLine.CreditAmount = "", offsetLine.DebitAmount
+	}
+
+	description := req.Description
+	if description == "" {
+		description = hold.Description.String
+	}
+	entry, err := m.entries.PrepareJournalEntry(ctx, &pb.PostJournalEntryRequest{
+		EntryDate:        req.EntryDate,
+		Description:      description,
+		Reference:        hold.Reference.String,
+		CurrencyCode:     hold.CurrencyCode,
+		Lines:            []*pb.JournalEntryLine{holdLine, offsetLine},
+		Metadata:         map[string]string{"hold_id": hold.ID},
+		PeriodAdjustment: req.PeriodAdjustment,
+		ExchangeRate:     req.ExchangeRate,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	entry.Entry.CreatedBy = sql.NullString{String: actor, Valid: true}
+
+	settlement := &Settlement{
+		Status:         StatusCaptured,
+		CapturedAmount: captured,
+		SettledBy:      actor,
+		Action:         audit.ActionCapture,
+	}
+	updated, err := m.repo.SettleHold(ctx, hold, settlement, entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return holdRowToProto(updated, time.Now()), entry.Proto(), nil
+}
+
+// ReleaseHold releases an active or expired hold without posting it
+// Spec: docs/specs/013-holds.md#story-3-release-hold
+func (m *Manager) ReleaseHold(ctx context.Context, req *pb.ReleaseHoldRequest) (*pb.Hold, error) {
+	if req.HoldId == "" {
+		return nil, status.Error(codes.InvalidArgument, "hold_id is required")
+	}
+	if err := m.validator.ValidateStatusReason(req.Reason); err != nil {
+		return nil, err
+	}
+	actor, err := m.actor(ctx, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	hold, err := m.repo.GetHoldByID(ctx, req.HoldId)
+	if err != nil {
+		return nil, err
+	}
+	if hold.Status != StatusActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "hold %s is already %s",
+			hold.ID, strings.ToLower(hold.Status))
+	}
+
+	settlement := &Settlement{
+		Status:    StatusReleased,
+		Reason:    req.Reason,
+		SettledBy: actor,
+		Action:    audit.ActionRelease,
+	}
+	updated, err := m.repo.SettleHold(ctx, hold, settlement, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return holdRowToProto(updated, time.Now()), nil
+}
+
+// GetHold retrieves a hold by ID
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+func (m *Manager) GetHold(ctx context.Context, holdID string) (*pb.Hold, error) {
+	if holdID == "" {
+		return nil, status.Error(codes.InvalidArgument, "hold_id is required")
+	}
+
+	hold, err := m.repo.GetHoldByID(ctx, holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	return holdRowToProto(hold, time.Now()), nil
+}
+
+// ListHolds lists the holds of an account, newest first
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+func (m *Manager) ListHolds(ctx context.Context, req *pb.ListHoldsRequest) (*pb.ListHoldsResponse, error) {
+	if req.AccountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "account_id is required")
+	}
+
+	filters := ListHoldFilters{
+		AccountID:      req.AccountId,
+		Status:         holdStatusProtoToString(req.Status),
+		PageSize:       req.PageSize,
+		PageToken:      req.PageToken,
+		SkipTotalCount: req.SkipTotalCount,
+	}
+
+	if filters.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	}
+	if filters.PageSize == 0 {
+		filters.PageSize = 50 // Default
+	}
+	if filters.PageSize > 200 {
+		filters.PageSize = 200 // Max
+	}
+
+	rows, nextPageToken, totalCount, err := m.repo.ListHolds(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	resp := &pb.ListHoldsResponse{
+		Holds:         make([]*pb.Hold, len(rows)),
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}
+	for i, row := range rows {
+		resp.Holds[i] = holdRowToProto(row, now)
+	}
+
+	return resp, nil
+}
+
+// actor returns the requested actor, defaulting to the caller identity
+// from the x-user-id metadata
+func (m *Manager) actor(ctx context.Context, actor string) (string, error) {
+	if actor == "" {
+		actor = audit.UserFromContext(ctx)
+	}
+	if err := m.validator.ValidateActor(actor); err != nil {
+		return "", err
+	}
+	return actor, nil
+}
+
+// Helper functions
+
+// checkSettleable returns an error unless the hold is active and unexpired
+func checkSettleable(hold *HoldRow, now time.Time, verb string) error {
+	if hold.Status != StatusActive {
+		return status.Errorf(codes.FailedPrecondition, "cannot %s hold %s in status %s", verb, hold.ID, hold.Status)
+	}
+	if !hold.ExpiresAt.After(now) {
+		return status.Errorf(codes.FailedPrecondition, "hold %s expired at %s",
+			hold.ID, hold.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// parseHoldAmount parses a required positive decimal amount
+func parseHoldAmount(s, field string) (int64, error) {
+	if s == "" {
+		return 0, status.Errorf(codes.InvalidArgument, "field %s is required", field)
+	}
+	v, err := amount.Parse(s)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+	}
+	if v <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "%s must be positive", field)
+	}
+	return v, nil
+}
+
+// holdStatus returns the reported status of a hold at a point in time
+func holdStatus(row *HoldRow, now time.Time) string {
+	if row.Status == StatusActive && !row.ExpiresAt.After(now) {
+		return StatusExpired
+	}
+	return row.Status
+}
+
+// holdRowToProto converts database row to proto message
+func holdRowToProto(row *HoldRow, now time.Time) *pb.Hold {
+	hold := &pb.Hold{
+		Id:             row.ID,
+		AccountId:      row.AccountID,
+		CurrencyCode:   row.CurrencyCode,
+		Amount:         amount.Format(row.Amount),
+		CapturedAmount: amount.Format(row.CapturedAmount),
+		Status:         stringToHoldStatusProto(holdStatus(row, now)),
+		Reference:      row.Reference.String,
+		Description:    row.Description.String,
+		ExpiresAt:      timestamppb.New(row.ExpiresAt),
+		JournalEntryId: row.JournalEntryID.String,
+		StatusReason:   row.StatusReason.String,
+		CreatedAt:      timestamppb.New(row.CreatedAt),
+		CreatedBy:      row.CreatedBy.String,
+		SettledBy:      row.SettledBy.String,
+		Version:        row.Version,
+	}
+	if row.SettledAt.Valid {
+		hold.SettledAt = timestamppb.New(row.SettledAt.Time)
+	}
+	return hold
+}
+
+// stringToHoldStatusProto converts string to proto enum
+func stringToHoldStatusProto(s string) pb.HoldStatus {
+	switch s {
+	case StatusActive:
+		return pb.HoldStatus_HOLD_STATUS_ACTIVE
+	case StatusCaptured:
+		return pb.HoldStatus_HOLD_STATUS_CAPTURED
+	case StatusReleased:
+		return pb.HoldStatus_HOLD_STATUS_RELEASED
+	case StatusExpired:
+		return pb.HoldStatus_HOLD_STATUS_EXPIRED
+	default:
+		return pb.HoldStatus_HOLD_STATUS_UNSPECIFIED
+	}
+}
+
+// holdStatusProtoToString converts proto enum to string, "" for unspecified
+func holdStatusProtoToString(s pb.HoldStatus) string {
+	switch s {
+	case pb.HoldStatus_HOLD_STATUS_ACTIVE:
+		return StatusActive
+	case pb.HoldStatus_HOLD_STATUS_CAPTURED:
+		return StatusCaptured
+	case pb.HoldStatus_HOLD_STATUS_RELEASED:
+		return StatusReleased
+	case pb.HoldStatus_HOLD_STATUS_EXPIRED:
+		return StatusExpired
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,536 @@
+package hold
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/journal"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockRepository is a mock implementation of HoldRepository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CreateHold(ctx context.Context, hold *HoldRow, accountVersion int64, creditNormal bool) error {
+	args := m.Called(ctx, hold, accountVersion, creditNormal)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetHoldByID(ctx context.Context, holdID string) (*HoldRow, error) {
+	args := m.Called(ctx, holdID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*HoldRow), args.Error(1)
+}
+
+func (m *MockRepository) SettleHold(ctx context.Context, hold *HoldRow, settlement *Settlement, entry *journal.PreparedEntry) (*HoldRow, error) {
+	args := m.Called(ctx, hold, settlement, entry)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*HoldRow), args.Error(1)
+}
+
+func (m *MockRepository) ListHolds(ctx context.Context, filters ListHoldFilters) ([]*HoldRow, string, int32, error) {
+	args := m.Called(ctx, filters)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Get(2).(int32), args.Error(3)
+	}
+	return args.Get(0).([]*HoldRow), args.String(1), args.Get(2).(int32), args.Error(3)
+}
+
+// MockAccountReader is a mock implementation of AccountReaderInterface
+type MockAccountReader struct {
+	mock.Mock
+}
+
+func (m *MockAccountReader) GetAccountByID(ctx context.Context, accountID string) (*account.AccountRow, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.AccountRow), args.Error(1)
+}
+
+// MockBalanceReader is a mock implementation of BalanceReaderInterface
+type MockBalanceReader struct {
+	mock.Mock
+}
+
+func (m *MockBalanceReader) NormalBalanceFor(ctx context.Context, accountType string) string {
+	args := m.Called(ctx, accountType)
+	return args.String(0)
+}
+
+// MockEntryPreparer is a mock implementation of EntryPreparerInterface
+type MockEntryPreparer struct {
+	mock.Mock
+}
+
+func (m *MockEntryPreparer) PrepareJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*journal.PreparedEntry, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*journal.PreparedEntry), args.Error(1)
+}
+
+func (m *MockEntryPreparer) StatusCanTransact(ctx context.Context, accountStatus string) bool {
+	args := m.Called(ctx, accountStatus)
+	return args.Bool(0)
+}
+
+type testMocks struct {
+	repo     *MockRepository
+	accounts *MockAccountReader
+	balances *MockBalanceReader
+	entries  *MockEntryPreparer
+}
+
+// newTestManager wires a manager with fresh mocks
+func newTestManager() (*Manager, *testMocks) {
+	mocks := &testMocks{
+		repo:     new(MockRepository),
+		accounts: new(MockAccountReader),
+		balances: new(MockBalanceReader),
+		entries:  new(MockEntryPreparer),
+	}
+	return NewManager(mocks.repo, mocks.accounts, mocks.balances, mocks.entries, account.NewValidator()), mocks
+}
+
+// testHold returns an active hold of 100.0000 USD expiring in a day
+func testHold() *HoldRow {
+	now := time.Now().UTC()
+	return &HoldRow{
+		ID:           "hold-1",
+		AccountID:    "acc-1",
+		CurrencyCode: "USD",
+		Amount:       1000000,
+		Status:       StatusActive,
+		Reference:    sql.NullString{String: "AUTH-1", Valid: true},
+		Description:  sql.NullString{String: "Card authorization", Valid: true},
+		ExpiresAt:    now.Add(24 * time.Hour),
+		CreatedAt:    now,
+		CreatedBy:    sql.NullString{String: "system", Valid: true},
+		UpdatedAt:    now,
+		Version:      1,
+	}
+}
+
+// settled returns a copy of the hold after the settlement
+func settled(hold *HoldRow, settlement *Settlement) *HoldRow {
+	row := *hold
+	row.Status = settlement.Status
+	row.CapturedAmount = settlement.CapturedAmount
+	row.SettledAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	row.SettledBy = sql.NullString{String: settlement.SettledBy, Valid: true}
+	if settlement.Reason != "" {
+		row.StatusReason = sql.NullString{String: settlement.Reason, Valid: true}
+	}
+	row.Version++
+	return &row
+}
+
+// TestCreateHold tests reserving funds on an account
+// Spec: docs/specs/013-holds.md#story-1-create-hold
+func TestCreateHold(t *testing.T) {
+	ctx := context.Background()
+	activeAccount := &account.AccountRow{ID: "acc-1", CurrencyCode: "USD", AccountType: "ASSET", Status: account.StatusActive, Version: 3}
+
+	t.Run("create hold with default expiry", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-1").Return(activeAccount, nil).Once()
+		mocks.entries.On("StatusCanTransact", ctx, account.StatusActive).Return(true).Once()
+		mocks.balances.On("NormalBalanceFor", ctx, "ASSET").Return(account.NormalBalanceDebit).Once()
+		mocks.repo.On("CreateHold", ctx, mock.AnythingOfType("*hold.HoldRow"), int64(3), false).
+			Return(nil).Once()
+
+		result, err := manager.CreateHold(ctx, &pb.CreateHoldRequest{
+			AccountId: "acc-1",
+			Amount:    "100.50",
+			Reference: "AUTH-1",
+			Actor:     "card-gateway",
+		})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.Id)
+		assert.Equal(t, "100.5000", result.Amount)
+		assert.Equal(t, "USD", result.CurrencyCode)
+		assert.Equal(t, pb.HoldStatus_HOLD_STATUS_ACTIVE, result.Status)
+		assert.Equal(t, "card-gateway", result.CreatedBy)
+		assert.WithinDuration(t, time.Now().Add(defaultHoldTTL), result.ExpiresAt.AsTime(), time.Minute)
+		mocks.repo.AssertExpectations(t)
+	})
+
+	t.Run("insufficient available balance", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-1").Return(activeAccount, nil).Once()
+		mocks.entries.On("StatusCanTransact", ctx, account.StatusActive).Return(true).Once()
+		mocks.balances.On("NormalBalanceFor", ctx, "ASSET").Return(account.NormalBalanceDebit).Once()
+		mocks.repo.On("CreateHold", ctx, mock.Anything, int64(3), false).
+			Return(status.Error(codes.FailedPrecondition,
+				"insufficient available balance on account acc-1: available 50.0000, requested 100.0000")).Once()
+
+		result, err := manager.CreateHold(ctx, &pb.CreateHoldRequest{AccountId: "acc-1", Amount: "100"})
+
+		assert.Nil(t, result)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("frozen account", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		frozen := *activeAccount
+		frozen.Status = account.StatusFrozen
+		mocks.accounts.On("GetAccountByID", ctx, "acc-1").Return(&frozen, nil).Once()
+		mocks.entries.On("StatusCanTransact", ctx, account.StatusFrozen).Return(false).Once()
+
+		result, err := manager.CreateHold(ctx, &pb.CreateHoldRequest{AccountId: "acc-1", Amount: "10"})
+
+		assert.Nil(t, result)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, "account acc-1 is frozen and cannot transact", st.Message())
+		mocks.repo.AssertNotCalled(t, "CreateHold", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("amount finer than the account currency", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-1").Return(activeAccount, nil).Once()
+		mocks.entries.On("StatusCanTransact", ctx, account.StatusActive).Return(true).Once()
+
+		result, err := manager.CreateHold(ctx, &pb.CreateHoldRequest{AccountId: "acc-1", Amount: "10.001"})
+
+		assert.Nil(t, result)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "invalid amount: amount 10.0010 has more than 2 decimal places for USD", st.Message())
+		mocks.repo.AssertNotCalled(t, "CreateHold", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("status allowed to transact by account_statuses", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		restricted := *activeAccount
+		restricted.Status = "RESTRICTED"
+		restricted.AccountType = "LIABILITY"
+		mocks.accounts.On("GetAccountByID", ctx, "acc-1").Return(&restricted, nil).Once()
+		mocks.entries.On("StatusCanTransact", ctx, "RESTRICTED").Return(true).Once()
+		mocks.balances.On("NormalBalanceFor", ctx, "LIABILITY").Return(account.NormalBalanceCredit).Once()
+		mocks.repo.On("CreateHold", ctx, mock.Anything, int64(3), true).Return(nil).Once()
+
+		result, err := manager.CreateHold(ctx, &pb.CreateHoldRequest{AccountId: "acc-1", Amount: "10"})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		mocks.repo.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name    string
+		req     *pb.CreateHoldRequest
+		message string
+	}{
+		{"missing account", &pb.CreateHoldRequest{Amount: "10"}, "account_id is required"},
+		{"missing amount", &pb.CreateHoldRequest{AccountId: "acc-1"}, "field amount is required"},
+		{"zero amount", &pb.CreateHoldRequest{AccountId: "acc-1", Amount: "0"}, "amount must be positive"},
+		{"negative amount", &pb.CreateHoldRequest{AccountId: "acc-1", Amount: "-5"}, "invalid amount: amount must not be negative"},
+		{
+			"past expiry",
+			&pb.CreateHoldRequest{AccountId: "acc-1", Amount: "10", ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour))},
+			"expires_at must be in the future",
+		},
+	}
+
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, mocks := newTestManager()
+
+			result, err := manager.CreateHold(ctx, tt.req)
+
+			assert.Nil(t, result)
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+			mocks.repo.AssertNotCalled(t, "CreateHold", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestCaptureHold tests posting a hold as a journal entry
+// Spec: docs/specs/013-holds.md#story-2-capture-hold
+func TestCaptureHold(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		amount      string
+		accountType string
+		normal      string
+		captured    int64
+		holdSide    func(*pb.JournalEntryLine) string
+		offsetSide  func(*pb.JournalEntryLine) string
+		lineAmount  string
+	}{
+		{
+			name:        "full capture on debit-normal account",
+			accountType: "ASSET",
+			normal:      account.NormalBalanceDebit,
+			captured:    1000000,
+			holdSide:    (*pb.JournalEntryLine).GetCreditAmount,
+			offsetSide:  (*pb.JournalEntryLine).GetDebitAmount,
+			lineAmount:  "100.0000",
+		},
+		{
+			name:        "partial capture on credit-normal account",
+			amount:      "40",
+			accountType: "LIABILITY",
+			normal:      account.NormalBalanceCredit,
+			captured:    400000,
+			holdSide:    (*pb.JournalEntryLine).GetDebitAmount,
+			offsetSide:  (*pb.JournalEntryLine).GetCreditAmount,
+			lineAmount:  "40.0000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, mocks := newTestManager()
+			hold := testHold()
+			entry := &journal.PreparedEntry{
+				Entry: &journal.JournalEntryRow{ID: "je-1", CurrencyCode: "USD", Status: journal.StatusPosted},
+			}
+
+			mocks.repo.On("GetHoldByID", ctx, "hold-1").Return(hold, nil).Once()
+			mocks.accounts.On("GetAccountByID", ctx, "acc-1").
+				Return(&account.AccountRow{ID: "acc-1", CurrencyCode: "USD", AccountType: tt.accountType}, nil).Once()
+			mocks.balances.On("NormalBalanceFor", ctx, tt.accountType).Return(tt.normal).Once()
+			mocks.entries.On("PrepareJournalEntry", ctx, mock.AnythingOfType("*ledger.PostJournalEntryRequest")).
+				Run(func(args mock.Arguments) {
+					req := args.Get(1).(*pb.PostJournalEntryRequest)
+					assert.Equal(t, "USD", req.CurrencyCode)
+					assert.Equal(t, "AUTH-1", req.Reference)
+					assert.Equal(t, "Card authorization", req.Description)
+					assert.Equal(t, map[string]string{"hold_id": "hold-1"}, req.Metadata)
+					assert.Len(t, req.Lines, 2)
+					assert.Equal(t, "acc-1", req.Lines[0].AccountId)
+					assert.Equal(t, tt.lineAmount, tt.holdSide(req.Lines[0]))
+					assert.Equal(t, "acc-2", req.Lines[1].AccountId)
+					assert.Equal(t, tt.lineAmount, tt.offsetSide(req.Lines[1]))
+				}).
+				Return(entry, nil).Once()
+			mocks.repo.On("SettleHold", ctx, hold, mock.AnythingOfType("*hold.Settlement"), entry).
+				Run(func(args mock.Arguments) {
+					settlement := args.Get(2).(*Settlement)
+					assert.Equal(t, StatusCaptured, settlement.Status)
+					assert.Equal(t, tt.captured, settlement.CapturedAmount)
+					assert.Equal(t, audit.ActionCapture, settlement.Action)
+					assert.Equal(t, "clerk", settlement.SettledBy)
+				}).
+				Return(settled(hold, &Settlement{Status: StatusCaptured, CapturedAmount: tt.captured, SettledBy: "clerk"}), nil).Once()
+
+			result, journalEntry, err := manager.CaptureHold(ctx, &pb.CaptureHoldRequest{
+				HoldId:          "hold-1",
+				Amount:          tt.amount,
+				OffsetAccountId: "acc-2",
+				Actor:           "clerk",
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, pb.HoldStatus_HOLD_STATUS_CAPTURED, result.Status)
+			assert.Equal(t, tt.lineAmount, result.CapturedAmount)
+			assert.Equal(t, "je-1", journalEntry.Id)
+			assert.Equal(t, "clerk", entry.Entry.CreatedBy.String)
+			mocks.repo.AssertExpectations(t)
+			mocks.entries.AssertExpectations(t)
+			mocks.accounts.AssertExpectations(t)
+			mocks.balances.AssertExpectations(t)
+		})
+	}
+
+	rejected := []struct {
+		name    string
+		hold    func() *HoldRow
+		req     *pb.CaptureHoldRequest
+		code    codes.Code
+		message string
+	}{
+		{
+			name:    "amount exceeds hold",
+			hold:    testHold,
+			req:     &pb.CaptureHoldRequest{HoldId: "hold-1", Amount: "100.01", OffsetAccountId: "acc-2"},
+			code:    codes.InvalidArgument,
+			message: "amount 100.0100 exceeds the hold amount 100.0000",
+		},
+		{
+			name:    "offset is the hold account",
+			hold:    testHold,
+			req:     &pb.CaptureHoldRequest{HoldId: "hold-1", OffsetAccountId: "acc-1"},
+			code:    codes.InvalidArgument,
+			message: "offset_account_id must differ from the hold's account",
+		},
+		{
+			name: "released hold",
+			hold: func() *HoldRow {
+				h := testHold()
+				h.Status = StatusReleased
+				return h
+			},
+			req:     &pb.CaptureHoldRequest{HoldId: "hold-1", OffsetAccountId: "acc-2"},
+			code:    codes.FailedPrecondition,
+			message: "cannot capture hold hold-1 in status RELEASED",
+		},
+		{
+			name: "expired hold",
+			hold: func() *HoldRow {
+				h := testHold()
+				h.ExpiresAt = time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+				return h
+			},
+			req:     &pb.CaptureHoldRequest{HoldId: "hold-1", OffsetAccountId: "acc-2"},
+			code:    codes.FailedPrecondition,
+			message: "hold hold-1 expired at 2025-09-01T00:00:00Z",
+		},
+	}
+
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, mocks := newTestManager()
+			mocks.repo.On("GetHoldByID", ctx, "hold-1").Return(tt.hold(), nil).Once()
+
+			result, entry, err := manager.CaptureHold(ctx, tt.req)
+
+			assert.Nil(t, result)
+			assert.Nil(t, entry)
+			st, _ := status.FromError(err)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+			mocks.repo.AssertNotCalled(t, "SettleHold", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("closed period", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.repo.On("GetHoldByID", ctx, "hold-1").Return(testHold(), nil).Once()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-1").
+			Return(&account.AccountRow{ID: "acc-1", CurrencyCode: "USD", AccountType: "ASSET"}, nil).Once()
+		mocks.balances.On("NormalBalanceFor", ctx, "ASSET").Return(account.NormalBalanceDebit).Once()
+		mocks.entries.On("PrepareJournalEntry", ctx, mock.Anything).
+			Return(nil, status.Error(codes.FailedPrecondition, "period 2025-08 is closed")).Once()
+
+		_, _, err := manager.CaptureHold(ctx, &pb.CaptureHoldRequest{HoldId: "hold-1", OffsetAccountId: "acc-2"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		mocks.repo.AssertNotCalled(t, "SettleHold", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestReleaseHold tests releasing a hold
+// Spec: docs/specs/013-holds.md#story-3-release-hold
+func TestReleaseHold(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("release expired hold", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		hold := testHold()
+		hold.ExpiresAt = time.Now().Add(-time.Hour)
+		mocks.repo.On("GetHoldByID", ctx, "hold-1").Return(hold, nil).Once()
+		mocks.repo.On("SettleHold", ctx, hold, &Settlement{
+			Status:    StatusReleased,
+			Reason:    "Authorization reversed",
+			SettledBy: "clerk",
+			Action:    audit.ActionRelease,
+		}, (*journal.PreparedEntry)(nil)).
+			Return(settled(hold, &Settlement{Status: StatusReleased, Reason: "Authorization reversed", SettledBy: "clerk"}), nil).Once()
+
+		result, err := manager.ReleaseHold(ctx, &pb.ReleaseHoldRequest{
+			HoldId: "hold-1",
+			Reason: "Authorization reversed",
+			Actor:  "clerk",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.HoldStatus_HOLD_STATUS_RELEASED, result.Status)
+		assert.Equal(t, "Authorization reversed", result.StatusReason)
+		assert.Equal(t, "0.0000", result.CapturedAmount)
+		mocks.repo.AssertExpectations(t)
+	})
+
+	t.Run("missing reason", func(t *testing.T) {
+		manager, mocks := newTestManager()
+
+		_, err := manager.ReleaseHold(ctx, &pb.ReleaseHoldRequest{HoldId: "hold-1"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		mocks.repo.AssertNotCalled(t, "GetHoldByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("captured hold", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		hold := testHold()
+		hold.Status = StatusCaptured
+		mocks.repo.On("GetHoldByID", ctx, "hold-1").Return(hold, nil).Once()
+
+		_, err := manager.ReleaseHold(ctx, &pb.ReleaseHoldRequest{HoldId: "hold-1", Reason: "duplicate"})
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, "hold hold-1 is already captured", st.Message())
+	})
+}
+
+// TestListHolds tests listing the holds of an account
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+func TestListHolds(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("expired holds are reported as expired", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		active := testHold()
+		expired := testHold()
+		expired.ID = "hold-2"
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		mocks.repo.On("ListHolds", ctx, ListHoldFilters{AccountID: "acc-1", PageSize: 50}).
+			Return([]*HoldRow{active, expired}, "", int32(2), nil).Once()
+
+		resp, err := manager.ListHolds(ctx, &pb.ListHoldsRequest{AccountId: "acc-1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), resp.TotalCount)
+		assert.Equal(t, pb.HoldStatus_HOLD_STATUS_ACTIVE, resp.Holds[0].Status)
+		assert.Equal(t, pb.HoldStatus_HOLD_STATUS_EXPIRED, resp.Holds[1].Status)
+	})
+
+	t.Run("status filter and page size cap", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.repo.On("ListHolds", ctx, ListHoldFilters{AccountID: "acc-1", Status: StatusExpired, PageSize: 200}).
+			Return([]*HoldRow{}, "", int32(0), nil).Once()
+
+		_, err := manager.ListHolds(ctx, &pb.ListHoldsRequest{
+			AccountId: "acc-1",
+			Status:    pb.HoldStatus_HOLD_STATUS_EXPIRED,
+			PageSize:  1000,
+		})
+
+		assert.NoError(t, err)
+		mocks.repo.AssertExpectations(t)
+	})
+
+	t.Run("missing account", func(t *testing.T) {
+		manager, _ := newTestManager()
+
+		_, err := manager.ListHolds(ctx, &pb.ListHoldsRequest{})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,463 @@
+package hold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/journal"
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	"example.com/go-mono-repo/common/pagination"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Hold statuses as stored in holds.status. EXPIRED is never stored; an
+// ACTIVE hold past its expires_at is reported as EXPIRED.
+const (
+	StatusActive   = "ACTIVE"
+	StatusCaptured = "CAPTURED"
+	StatusReleased = "RELEASED"
+	StatusExpired  = "EXPIRED"
+)
+
+// holdsCursorScope binds ListHolds page tokens to that RPC
+const holdsCursorScope = "ledger.holds"
+
+// HoldRepository handles database operations for holds
+// Spec: docs/specs/013-holds.md
+type HoldRepository struct {
+	db      client.ImmuClient
+	cursors *pagination.Codec
+}
+
+// NewHoldRepository creates a new hold repository
+func NewHoldRepository(db client.ImmuClient, cursors *pagination.Codec) *HoldRepository {
+	return &HoldRepository{
+		db:      db,
+		cursors: cursors,
+	}
+}
+
+// HoldRow represents a database row for a hold.
+// Amounts are scaled by 10^amount.Scale.
+type HoldRow struct {
+	ID             string
+	AccountID      string
+	CurrencyCode   string
+	Amount         int64
+	CapturedAmount int64
+	Status         string
+	Reference      sql.NullString
+	Description    sql.NullString
+	ExpiresAt      time.Time
+	JournalEntryID sql.NullString
+	StatusReason   sql.NullString
+	CreatedAt      time.Time
+	CreatedBy      sql.NullString
+	SettledAt      sql.NullTime
+	SettledBy      sql.NullString
+	UpdatedAt      time.Time
+	Version        int64
+}
+
+// Settlement describes the capture or release applied by SettleHold
+type Settlement struct {
+	Status         string // StatusCaptured or StatusReleased
+	CapturedAmount int64  // Amount posted by a capture
+	Reason         string // Why a hold was released
+	SettledBy      string // Actor settling the hold
+	Action         string // Audit action recorded for the change
+}
+
+// ListHoldFilters contains filters for listing holds
+type ListHoldFilters struct {
+	AccountID      string
+	Status         string
+	PageSize       int32
+	PageToken      string
+	SkipTotalCount bool
+}
+
+// CreateHold inserts a hold if the account still has enough available
+// funds. The account version, the posted totals and the account's active
+// holds are read inside the transaction, so a concurrent status change,
+// posting or hold on the same account fails the commit instead of
+// over-reserving. creditNormal signs the posted balance for accounts with
+// a credit normal balance.
+// Spec: docs/specs/013-holds.md#story-1-create-hold
+func (r *HoldRepository) CreateHold(ctx context.Context, hold *HoldRow, accountVersion int64, creditNormal bool) error {
+	tx, err := r.db.NewTx(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	// Spec: docs/specs/009-account-lifecycle.md#story-4-reject-postings
+	result, err := tx.SQLQuery(ctx, "SELECT version FROM accounts WHERE id = @id", map[string]interface{}{"id": hold.AccountID})
+	if err != nil {
+		tx.Rollback(ctx)
+		return status.Errorf(codes.Internal, "failed to query account: %v", err)
+	}
+	if len(result.Rows) == 0 || result.Rows[0].Values[0].GetN() != accountVersion {
+		tx.Rollback(ctx)
+		return status.Errorf(codes.Aborted, "account %s was modified, retry", hold.AccountID)
+	}
+
+	// Spec: docs/specs/013-holds.md#available-balance
+	result, err = tx.SQLQuery(ctx, `
+		SELECT SUM(debit_amount), SUM(credit_amount)
+		FROM journal_entry_lines
+		WHERE account_id = @account_id`,
+		map[string]interface{}{"account_id": hold.AccountID})
+	if err != nil {
+		tx.Rollback(ctx)
+		return status.Errorf(codes.Internal, "failed to query account balance: %v", err)
+	}
+
+	var posted int64
+	if len(result.Rows) > 0 {
+		posted = result.Rows[0].Values[0].GetN() - result.Rows[0].Values[1].GetN()
+		if creditNormal {
+			posted = -posted
+		}
+	}
+
+	result, err = tx.SQLQuery(ctx, `
+		SELECT amount, expires_at FROM holds
+		WHERE account_id = @account_id AND status = 'ACTIVE'`,
+		map[string]interface{}{"account_id": hold.AccountID})
+	if err != nil {
+		tx.Rollback(ctx)
+		return status.Errorf(codes.Internal, "failed to query holds: %v", err)
+	}
+
+	var held int64
+	for _, row := range result.Rows {
+		if time.UnixMicro(row.Values[1].GetTs()).After(hold.CreatedAt) {
+			held += row.Values[0].GetN()
+		}
+	}
+	if available := posted - held; hold.Amount > available {
+		tx.Rollback(ctx)
+		return status.Errorf(codes.FailedPrecondition,
+			"insufficient available balance on account %s: available %s, requested %s",
+			hold.AccountID, amount.Format(available), amount.Format(hold.Amount))
+	}
+
+	query := `
+		INSERT INTO holds (
+			id, account_id, currency_code, amount, captured_amount, status,
+			reference, description, expires_at, journal_entry_id, status_reason,
+			created_at, created_by, settled_at, settled_by, updated_at, version
+		) VALUES (
+			@id, @account_id, @currency_code, @amount, @captured_amount, @status,
+			@reference, @description, @expires_at, NULL, NULL,
+			@created_at, @created_by, NULL, NULL, @updated_at, @version
+		)`
+
+	params := map[string]interface{}{
+		"id":              hold.ID,
+		"account_id":      hold.AccountID,
+		"currency_code":   hold.CurrencyCode,
+		"amount":          hold.Amount,
+		"captured_amount": hold.CapturedAmount,
+		"status":          hold.Status,
+		"reference":       nullableString(hold.Reference),
+		"description":     nullableString(hold.Description),
+		"expires_at":      hold.ExpiresAt,
+		"created_at":      hold.CreatedAt,
+		"created_by":      nullableString(hold.CreatedBy),
+		"updated_at":      hold.UpdatedAt,
+		"version":         hold.Version,
+	}
+
+	if err := tx.SQLExec(ctx, query, params); err != nil {
+		tx.Rollback(ctx)
+		return status.Errorf(codes.Internal, "failed to insert hold: %v", err)
+	}
+
+	// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+	event := &audit.Event{
+		EntityType: audit.EntityHold,
+		EntityID:   hold.ID,
+		Action:     audit.ActionCreate,
+		NewValues:  holdAuditValues(hold),
+	}
+	if err := audit.Write(ctx, tx, event); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	if _, err := tx.Commit(ctx); err != nil {
+		// A concurrent hold or status change on the account fails the commit
+		if strings.Contains(err.Error(), "conflict") {
+			return status.Errorf(codes.Aborted, "account %s was modified, retry", hold.AccountID)
+		}
+		return status.Errorf(codes.Internal, "failed to create hold: %v", err)
+	}
+
+	return nil
+}
+
+// GetHoldByID retrieves a hold by its system ID
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+func (r *HoldRepository) GetHoldByID(ctx context.Context, holdID string) (*HoldRow, error) {
+	query := fmt.Sprintf("SELECT %s FROM holds WHERE id = @id", holdColumns)
+
+	result, err := r.db.SQLQuery(ctx, query, map[string]interface{}{"id": holdID}, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query hold: %v", err)
+	}
+	if len(result.Rows) == 0 {
+		return nil, status.Errorf(codes.NotFound, "hold %s not found", holdID)
+	}
+
+	return parseHoldRow(result.Rows[0]), nil
+}
+
+// SettleHold captures or releases an active hold. A capture writes the
+// prepared journal entry in the same transaction, so the hold and the
+// posting commit together. The hold must still have the version it was
+// checked at.
+// Spec: docs/specs/013-holds.md#story-2-capture-hold
+func (r *HoldRepository) SettleHold(ctx context.Context, hold *HoldRow, settlement *Settlement, entry *journal.PreparedEntry) (*HoldRow, error) {
+	tx, err := r.db.NewTx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	result, err := tx.SQLQuery(ctx, "SELECT version FROM holds WHERE id = @id", map[string]interface{}{"id": hold.ID})
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to query hold: %v", err)
+	}
+	if len(result.Rows) == 0 || result.Rows[0].Values[0].GetN() != hold.Version {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Aborted, "hold %s was modified, retry", hold.ID)
+	}
+
+	now := time.Now()
+	updated := *hold
+	updated.Status = settlement.Status
+	updated.CapturedAmount = settlement.CapturedAmount
+	updated.SettledAt = sql.NullTime{Time: now, Valid: true}
+	updated.SettledBy = sql.NullString{String: settlement.SettledBy, Valid: true}
+	updated.UpdatedAt = now
+	updated.Version = hold.Version + 1
+	if settlement.Reason != "" {
+		updated.StatusReason = sql.NullString{String: settlement.Reason, Valid: true}
+	}
+
+	if entry != nil {
+		if err := journal.WriteJournalEntry(ctx, tx, entry.Entry, entry.Lines, entry.AccountVersions, entry.EntityIDs); err != nil {
+			tx.Rollback(ctx)
+			return nil, err
+		}
+		updated.JournalEntryID = sql.NullString{String: entry.Entry.ID, Valid: true}
+	}
+
+	query := `
+		UPDATE holds
+		SET status = @status, captured_amount = @captured_amount,
+			journal_entry_id = @journal_entry_id, status_reason = @status_reason,
+			settled_at = @settled_at, settled_by = @settled_by,
+			updated_at = @updated_at, version = @new_version
+		WHERE id = @id AND version = @version`
+
+	params := map[string]interface{}{
+		"id":               hold.ID,
+		"version":          hold.Version,
+		"new_version":      updated.Version,
+		"status":           updated.Status,
+		"captured_amount":  updated.CapturedAmount,
+		"journal_entry_id": nullableString(updated.JournalEntryID),
+		"status_reason":    nullableString(updated.StatusReason),
+		"settled_at":       now,
+		"settled_by":       settlement.SettledBy,
+		"updated_at":       now,
+	}
+
+	if err := tx.SQLExec(ctx, query, params); err != nil {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to update hold: %v", err)
+	}
+
+	// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+	event := &audit.Event{
+		EntityType: audit.EntityHold,
+		EntityID:   hold.ID,
+		Action:     settlement.Action,
+		OldValues:  holdAuditValues(hold),
+		NewValues:  holdAuditValues(&updated),
+	}
+	if err := audit.Write(ctx, tx, event); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if _, err := tx.Commit(ctx); err != nil {
+		// A concurrent settlement of the hold or change to a posted
+		// account fails the commit
+		if strings.Contains(err.Error(), "conflict") {
+			return nil, status.Errorf(codes.Aborted, "hold %s was modified, retry", hold.ID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update hold: %v", err)
+	}
+
+	return &updated, nil
+}
+
+// ListHolds lists the holds of an account, newest first
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+func (r *HoldRepository) ListHolds(ctx context.Context, filters ListHoldFilters) ([]*HoldRow, string, int32, error) {
+	whereClauses := []string{"account_id = @account_id"}
+	params := map[string]interface{}{
+		"account_id": filters.AccountID,
+	}
+
+	// Expired holds are stored as ACTIVE
+	switch filters.Status {
+	case "":
+	case StatusActive:
+		whereClauses = append(whereClauses, "status = @status", "expires_at > @now")
+		params["status"] = StatusActive
+		params["now"] = time.Now()
+	case StatusExpired:
+		whereClauses = append(whereClauses, "status = @status", "expires_at <= @now")
+		params["status"] = StatusActive
+		params["now"] = time.Now()
+	default:
+		whereClauses = append(whereClauses, "status = @status")
+		params["status"] = filters.Status
+	}
+	whereClause := "WHERE " + strings.Join(whereClauses, " AND ")
+
+	// Spec: docs/specs/005-cursor-pagination.md
+	pageClause := whereClause
+	cursorFilters := pagination.Filters{"account_id": filters.AccountID, "status": filters.Status}
+	if filters.PageToken != "" {
+		keys, err := r.cursors.Decode(filters.PageToken, holdsCursorScope, cursorFilters, 2)
+		if err != nil {
+			return nil, "", 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		afterCreatedAt, err := time.Parse(time.RFC3339Nano, keys[0])
+		if err != nil {
+			return nil, "", 0, status.Error(codes.InvalidArgument, pagination.ErrInvalidToken.Error())
+		}
+
+		keyset := "(created_at < @after_created_at OR (created_at = @after_created_at AND id > @after_id))"
+		pageClause = "WHERE " + strings.Join(append(whereClauses, keyset), " AND ")
+		params["after_created_at"] = afterCreatedAt
+		params["after_id"] = keys[1]
+	}
+
+	// Spec: docs/specs/005-cursor-pagination.md#total-count
+	totalCount := int32(0)
+	if !filters.SkipTotalCount {
+		countQuery := fmt.Sprintf("SELECT COUNT(*) FROM holds %s", whereClause)
+		countResult, err := r.db.SQLQuery(ctx, countQuery, params, false)
+		if err != nil {
+			return nil, "", 0, status.Errorf(codes.Internal, "failed to count holds: %v", err)
+		}
+		if len(countResult.Rows) > 0 {
+			totalCount = int32(countResult.Rows[0].Values[0].GetN())
+		}
+	}
+
+	// Fetch one extra row to tell whether another page follows
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM holds
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT %d`,
+		holdColumns, pageClause, filters.PageSize+1)
+
+	result, err := r.db.SQLQuery(ctx, query, params, false)
+	if err != nil {
+		return nil, "", 0, status.Errorf(codes.Internal, "failed to list holds: %v", err)
+	}
+
+	holds := make([]*HoldRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		holds = append(holds, parseHoldRow(row))
+	}
+
+	nextPageToken := ""
+	if len(holds) > int(filters.PageSize) {
+		holds = holds[:filters.PageSize]
+		last := holds[len(holds)-1]
+		nextPageToken = r.cursors.Encode(holdsCursorScope, cursorFilters,
+			last.CreatedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
+	return holds, nextPageToken, totalCount, nil
+}
+
+// holdColumns are the columns read by parseHoldRow, in order
+const holdColumns = `id, account_id, currency_code, amount, captured_amount, status,
+	reference, description, expires_at, journal_entry_id, status_reason,
+	created_at, created_by, settled_at, settled_by, updated_at, version`
+
+// parseHoldRow converts a row selected with holdColumns
+func parseHoldRow(row *schema.Row) *HoldRow {
+	hold := &HoldRow{
+		ID:             row.Values[0].GetS(),
+		AccountID:      row.Values[1].GetS(),
+		CurrencyCode:   row.Values[2].GetS(),
+		Amount:         row.Values[3].GetN(),
+		CapturedAmount: row.Values[4].GetN(),
+		Status:         row.Values[5].GetS(),
+		Reference:      nullStringValue(row.Values[6]),
+		Description:    nullStringValue(row.Values[7]),
+		ExpiresAt:      time.UnixMicro(row.Values[8].GetTs()).UTC(),
+		JournalEntryID: nullStringValue(row.Values[9]),
+		StatusReason:   nullStringValue(row.Values[10]),
+		CreatedAt:      time.UnixMicro(row.Values[11].GetTs()).UTC(),
+		CreatedBy:      nullStringValue(row.Values[12]),
+		SettledBy:      nullStringValue(row.Values[14]),
+		UpdatedAt:      time.UnixMicro(row.Values[15].GetTs()).UTC(),
+		Version:        row.Values[16].GetN(),
+	}
+	if ts := row.Values[13].GetTs(); ts != 0 {
+		hold.SettledAt = sql.NullTime{Time: time.UnixMicro(ts).UTC(), Valid: true}
+	}
+	return hold
+}
+
+// holdAuditValues returns the audited fields of a hold keyed by proto field
+// name. Amounts are recorded as decimal strings.
+// Spec: docs/specs/008-audit-log.md#json-diffs
+func holdAuditValues(hold *HoldRow) map[string]interface{} {
+	return map[string]interface{}{
+		"account_id":       hold.AccountID,
+		"amount":           amount.Format(hold.Amount),
+		"captured_amount":  amount.Format(hold.CapturedAmount),
+		"status":           hold.Status,
+		"reference":        nullableString(hold.Reference),
+		"expires_at":       hold.ExpiresAt.UTC().Format(time.RFC3339),
+		"journal_entry_id": nullableString(hold.JournalEntryID),
+		"status_reason":    nullableString(hold.StatusReason),
+	}
+}
+
+// nullStringValue converts an optional VARCHAR column into sql.NullString
+func nullStringValue(v *schema.SQLValue) sql.NullString {
+	if v != nil && len(v.GetS()) > 0 {
+		return sql.NullString{String: v.GetS(), Valid: true}
+	}
+	return sql.NullString{}
+}
+
+// nullableString converts sql.NullString into a query parameter
+func nullableString(s sql.NullString) interface{} {
+	if s.Valid {
+		return s.String
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package hold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"clarity/treasury-services/ledger-service/audit"
+	"example.com/go-mono-repo/common/pagination"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testSchema creates the tables written by CreateHold
+var testSchema = []string{
+	`CREATE TABLE accounts (id VARCHAR(36), version INTEGER, PRIMARY KEY (id))`,
+	`CREATE TABLE journal_entry_lines (
+		id VARCHAR(36), account_id VARCHAR(36), debit_amount INTEGER, credit_amount INTEGER,
+		PRIMARY KEY (id))`,
+	`CREATE TABLE holds (
+		id VARCHAR(36), account_id VARCHAR(36), currency_code VARCHAR(3), amount INTEGER,
+		captured_amount INTEGER, status VARCHAR(20), reference VARCHAR(100), description VARCHAR(1024),
+		expires_at TIMESTAMP, journal_entry_id VARCHAR(36), status_reason VARCHAR(512),
+		created_at TIMESTAMP, created_by VARCHAR(100), settled_at TIMESTAMP, settled_by VARCHAR(100),
+		updated_at TIMESTAMP, version INTEGER,
+		PRIMARY KEY (id))`,
+	`CREATE INDEX ON holds(account_id)`,
+	`CREATE TABLE audit_log (
+		id VARCHAR(36), entity_type VARCHAR(50), entity_id VARCHAR(36), action VARCHAR(20),
+		old_values VARCHAR, new_values VARCHAR, user_id VARCHAR(100), created_at TIMESTAMP, metadata VARCHAR,
+		PRIMARY KEY (id))`,
+	`INSERT INTO accounts (id, version) VALUES ('acc-1', 1)`,
+	`INSERT INTO journal_entry_lines (id, account_id, debit_amount, credit_amount) VALUES ('line-1', 'acc-1', 1200000, 0)`,
+	`INSERT INTO journal_entry_lines (id, account_id, debit_amount, credit_amount) VALUES ('line-2', 'acc-1', 0, 200000)`,
+}
+
+// newTestRepository returns a repository backed by an in-process ImmuDB.
+// Account acc-1 has a posted debit balance of 100.0000.
+func newTestRepository(t *testing.T) *HoldRepository {
+	t.Helper()
+
+	opts := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPgsqlServer(false).
+		WithMetricsServer(false).
+		WithWebServer(false)
+	bs := servertest.NewBufconnServer(opts)
+	require.NoError(t, bs.Start())
+	t.Cleanup(func() { bs.Stop() })
+
+	db, err := bs.NewAuthenticatedClient(client.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.CloseSession(context.Background()) })
+
+	for _, stmt := range testSchema {
+		_, err := db.SQLExec(context.Background(), stmt, nil)
+		require.NoError(t, err)
+	}
+
+	return NewHoldRepository(db, pagination.NewCodec("secret"))
+}
+
+// postTestLine posts a journal line to acc-1 through the repository's client
+func postTestLine(t *testing.T, repo *HoldRepository, id string, debit, credit int64) {
+	t.Helper()
+	_, err := repo.db.SQLExec(context.Background(),
+		`INSERT INTO journal_entry_lines (id, account_id, debit_amount, credit_amount) VALUES (@id, 'acc-1', @debit, @credit)`,
+		map[string]interface{}{"id": id, "debit": debit, "credit": credit})
+	require.NoError(t, err)
+}
+
+func testHoldRow(id string, holdAmount int64, expiresAt time.Time) *HoldRow {
+	now := time.Now().UTC()
+	return &HoldRow{
+		ID:           id,
+		AccountID:    "acc-1",
+		CurrencyCode: "USD",
+		Amount:       holdAmount,
+		Status:       StatusActive,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    now,
+		CreatedBy:    sql.NullString{String: "system", Valid: true},
+		UpdatedAt:    now,
+		Version:      1,
+	}
+}
+
+// TestCreateHoldAvailableBalance tests that holds never reserve more than
+// the posted balance
+// Spec: docs/specs/013-holds.md#available-balance
+func TestCreateHoldAvailableBalance(t *testing.T) {
+	ctx := context.Background()
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	t.Run("second hold exceeds available", func(t *testing.T) {
+		repo := newTestRepository(t)
+		require.NoError(t, repo.CreateHold(ctx, testHoldRow("hold-1", 600000, tomorrow), 1, false))
+
+		err := repo.CreateHold(ctx, testHoldRow("hold-2", 600000, tomorrow), 1, false)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, "insufficient available balance on account acc-1: available 40.0000, requested 60.0000", st.Message())
+		assert.NoError(t, repo.CreateHold(ctx, testHoldRow("hold-3", 400000, tomorrow), 1, false))
+	})
+
+	t.Run("posting committed before the hold", func(t *testing.T) {
+		repo := newTestRepository(t)
+		postTestLine(t, repo, "line-3", 0, 500000)
+
+		err := repo.CreateHold(ctx, testHoldRow("hold-1", 600000, tomorrow), 1, false)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, "insufficient available balance on account acc-1: available 50.0000, requested 60.0000", st.Message())
+	})
+
+	t.Run("credit normal account", func(t *testing.T) {
+		repo := newTestRepository(t)
+		postTestLine(t, repo, "line-3", 0, 1300000)
+
+		assert.NoError(t, repo.CreateHold(ctx, testHoldRow("hold-1", 300000, tomorrow), 1, true))
+		assert.Error(t, repo.CreateHold(ctx, testHoldRow("hold-2", 100, tomorrow), 1, true))
+	})
+
+	t.Run("expired hold does not reserve", func(t *testing.T) {
+		repo := newTestRepository(t)
+		require.NoError(t, repo.CreateHold(ctx, testHoldRow("hold-1", 900000, time.Now().Add(time.Second)), 1, false))
+		time.Sleep(1100 * time.Millisecond)
+
+		assert.NoError(t, repo.CreateHold(ctx, testHoldRow("hold-2", 900000, tomorrow), 1, false))
+	})
+
+	t.Run("stale account version", func(t *testing.T) {
+		repo := newTestRepository(t)
+
+		err := repo.CreateHold(ctx, testHoldRow("hold-1", 100, tomorrow), 2, false)
+
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("concurrent holds", func(t *testing.T) {
+		repo := newTestRepository(t)
+
+		var wg sync.WaitGroup
+		errs := make([]error, 8)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = repo.CreateHold(ctx, testHoldRow(fmt.Sprintf("hold-%d", i), 400000, tomorrow), 1, false)
+			}(i)
+		}
+		wg.Wait()
+
+		created := 0
+		for _, err := range errs {
+			switch status.Code(err) {
+			case codes.OK:
+				created++
+			case codes.Aborted, codes.FailedPrecondition:
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}
+		assert.GreaterOrEqual(t, created, 1)
+		assert.LessOrEqual(t, created, 2)
+	})
+}
+
+// TestListHoldsStatus tests that expired holds are listed as expired and
+// settled holds by their stored status
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+func TestListHoldsStatus(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t)
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	require.NoError(t, repo.CreateHold(ctx, testHoldRow("hold-active", 100, tomorrow), 1, false))
+	require.NoError(t, repo.CreateHold(ctx, testHoldRow("hold-expired", 100, time.Now().Add(time.Second)), 1, false))
+	require.NoError(t, repo.CreateHold(ctx, testHoldRow("hold-released", 100, tomorrow), 1, false))
+	time.Sleep(1100 * time.Millisecond)
+
+	released, err := repo.GetHoldByID(ctx, "hold-released")
+	require.NoError(t, err)
+	settledRow, err := repo.SettleHold(ctx, released, &Settlement{
+		Status:    StatusReleased,
+		Reason:    "Payment cancelled",
+		SettledBy: "clerk",
+		Action:    audit.ActionRelease,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), settledRow.Version)
+
+	_, err = repo.SettleHold(ctx, released, &Settlement{Status: StatusReleased, SettledBy: "clerk", Action: audit.ActionRelease}, nil)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	tests := []struct {
+		status string
+		want   []string
+	}{
+		{StatusActive, []string{"hold-active"}},
+		{StatusExpired, []string{"hold-expired"}},
+		{StatusReleased, []string{"hold-released"}},
+		{"", []string{"hold-released", "hold-expired", "hold-active"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("status "+tt.status, func(t *testing.T) {
+			var got []string
+			filters := ListHoldFilters{AccountID: "acc-1", Status: tt.status, PageSize: 1}
+			for {
+				rows, next, total, err := repo.ListHolds(ctx, filters)
+				require.NoError(t, err)
+				assert.Equal(t, int32(len(tt.want)), total)
+				for _, row := range rows {
+					got = append(got, row.ID)
+				}
+				if next == "" {
+					break
+				}
+				filters.PageToken = next
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package hold
+
+import (
+	"context"
+	"log"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/journal"
+	"clarity/treasury-services/ledger-service/period"
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/pkg/client"
+)
+
+// Server implements the HoldService gRPC interface
+// Spec: docs/specs/013-holds.md
+type Server struct {
+	pb.UnimplementedHoldServiceServer
+	manager ManagerInterface
+}
+
+// NewServer creates a new hold server
+func NewServer(db client.ImmuClient, currencies *account.Validator, cursors *pagination.Codec, functionalCurrency string) *Server {
+	repo := NewHoldRepository(db, cursors)
+	accountRepo := account.NewAccountRepository(db, cursors)
+	balances := account.NewManager(accountRepo, currencies)
+	periods := period.NewManager(period.NewPeriodRepository(db), currencies)
+	entries := journal.NewManager(journal.NewJournalRepository(db, cursors), accountRepo, journal.NewValidator(), currencies, periods, functionalCurrency)
+	manager := NewManager(repo, accountRepo, balances, entries, currencies)
+
+	return &Server{
+		manager: manager,
+	}
+}
+
+// CreateHold reserves an amount on an account
+// Spec: docs/specs/013-holds.md#story-1-create-hold
+func (s *Server) CreateHold(ctx context.Context, req *pb.CreateHoldRequest) (*pb.CreateHoldResponse, error) {
+	log.Printf("Creating hold: account_id=%s, amount=%s", req.AccountId, req.Amount)
+
+	hold, err := s.manager.CreateHold(ctx, req)
+	if err != nil {
+		log.Printf("Failed to create hold: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Hold created successfully: %s", hold.Id)
+	return &pb.CreateHoldResponse{
+		Hold: hold,
+	}, nil
+}
+
+// CaptureHold posts all or part of a hold as a journal entry
+// Spec: docs/specs/013-holds.md#story-2-capture-hold
+func (s *Server) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.CaptureHoldResponse, error) {
+	log.Printf("Capturing hold: hold_id=%s, amount=%s", req.HoldId, req.Amount)
+
+	hold, entry, err := s.manager.CaptureHold(ctx, req)
+	if err != nil {
+		log.Printf("Failed to capture hold: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Hold %s captured %s in journal entry %s", hold.Id, hold.CapturedAmount, entry.Id)
+	return &pb.CaptureHoldResponse{
+		Hold:         hold,
+		JournalEntry: entry,
+	}, nil
+}
+
+// ReleaseHold releases a hold without posting it
+// Spec: docs/specs/013-holds.md#story-3-release-hold
+func (s *Server) ReleaseHold(ctx context.Context, req *pb.ReleaseHoldRequest) (*pb.ReleaseHoldResponse, error) {
+	log.Printf("Releasing hold: hold_id=%s", req.HoldId)
+
+	hold, err := s.manager.ReleaseHold(ctx, req)
+	if err != nil {
+		log.Printf("Failed to release hold: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Hold %s released", hold.Id)
+	return &pb.ReleaseHoldResponse{
+		Hold: hold,
+	}, nil
+}
+
+// GetHold retrieves a hold by ID
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+func (s *Server) GetHold(ctx context.Context, req *pb.GetHoldRequest) (*pb.GetHoldResponse, error) {
+	log.Printf("Getting hold: %s", req.HoldId)
+
+	hold, err := s.manager.GetHold(ctx, req.HoldId)
+	if err != nil {
+		log.Printf("Failed to get hold: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetHoldResponse{
+		Hold: hold,
+	}, nil
+}
+
+// ListHolds lists the holds of an account
+// Spec: docs/specs/013-holds.md#story-5-list-holds
+func (s *Server) ListHolds(ctx context.Context, req *pb.ListHoldsRequest) (*pb.ListHoldsResponse, error) {
+	log.Printf("Listing holds: account_id=%s, status=%s", req.AccountId, req.Status)
+
+	resp, err := s.manager.ListHolds(ctx, req)
+	if err != nil {
+		log.Printf("Failed to list holds: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Found %d holds", len(resp.Holds))
+	return resp, nil
+}
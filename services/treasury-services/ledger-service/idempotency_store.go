@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"example.com/go-mono-repo/common/idempotency"
+	pb "example.com/go-mono-repo/proto/ledger"
+	immudb "github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// idempotentMethods are the mutating RPCs that honour the idempotency-key
+// header
+// Spec: docs/specs/006-idempotency-keys.md#covered-rpcs
+var idempotentMethods = []string{
+	pb.AccountService_CreateAccount_FullMethodName,
+	pb.AccountService_UpdateAccount_FullMethodName,
+	pb.AccountService_FreezeAccount_FullMethodName,
+	pb.AccountService_CloseAccount_FullMethodName,
+	pb.AccountService_ReopenAccount_FullMethodName,
+	pb.JournalService_PostJournalEntry_FullMethodName,
+	pb.PeriodService_ClosePeriod_FullMethodName,
+	pb.PeriodService_ReopenPeriod_FullMethodName,
+	pb.HoldService_CreateHold_FullMethodName,
+	pb.HoldService_CaptureHold_FullMethodName,
+	pb.HoldService_ReleaseHold_FullMethodName,
+	pb.RevaluationService_RunRevaluation_FullMethodName,
+}
+
+// IdempotencyStore stores idempotency keys in ImmuDB
+// Spec: docs/specs/006-idempotency-keys.md#store-interface
+type IdempotencyStore struct {
+	db immudb.ImmuClient
+}
+
+// NewIdempotencyStore creates a new idempotency store
+func NewIdempotencyStore(db immudb.ImmuClient) *IdempotencyStore {
+	return &IdempotencyStore{db: db}
+}
+
+// Reserve claims a caller's key, replacing it if it has expired. PENDING
+// records are never replaced. The read and the write share a transaction,
+// so of two requests racing for a key only one commits.
+func (s *IdempotencyStore) Reserve(ctx context.Context, rec *idempotency.Record) (*idempotency.Record, error) {
+	tx, err := s.db.NewTx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	result, err := tx.SQLQuery(ctx, `
+		SELECT method, request_hash, status, response, created_at, expires_at
+		FROM idempotency_keys
+		WHERE key_hash = @key_hash`,
+		map[string]interface{}{"key_hash": keyHash(rec.Caller, rec.Key)})
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to get idempotency key: %v", err)
+	}
+
+	if len(result.Rows) > 0 {
+		row := result.Rows[0]
+		existing := &idempotency.Record{
+			Caller:      rec.Caller,
+			Key:         rec.Key,
+			Method:      row.Values[0].GetS(),
+			RequestHash: row.Values[1].GetS(),
+			Status:      row.Values[2].GetS(),
+			Response:    row.Values[3].GetBs(),
+			CreatedAt:   time.UnixMicro(row.Values[4].GetTs()).UTC(),
+			ExpiresAt:   time.UnixMicro(row.Values[5].GetTs()).UTC(),
+		}
+		if existing.Status == idempotency.StatusPending || existing.ExpiresAt.After(rec.CreatedAt) {
+			tx.Rollback(ctx)
+			return existing, nil
+		}
+	}
+
+	if err := tx.SQLExec(ctx, upsertIdempotencyKeyQuery, idempotencyKeyParams(rec)); err != nil {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to reserve idempotency key: %v", err)
+	}
+
+	if _, err := tx.Commit(ctx); err != nil {
+		if strings.Contains(err.Error(), "conflict") {
+			return nil, status.Errorf(codes.Aborted, "request with %s %q is still in progress", idempotency.MetadataKey, rec.Key)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reserve idempotency key: %v", err)
+	}
+
+	return nil, nil
+}
+
+// Complete stores the response of a reserved request
+func (s *IdempotencyStore) Complete(ctx context.Context, rec *idempotency.Record) error {
+	if _, err := s.db.SQLExec(ctx, upsertIdempotencyKeyQuery, idempotencyKeyParams(rec)); err != nil {
+		return status.Errorf(codes.Internal, "failed to store idempotent response: %v", err)
+	}
+	return nil
+}
+
+// Release removes the reservation of a failed request
+func (s *IdempotencyStore) Release(ctx context.Context, rec *idempotency.Record) error {
+	_, err := s.db.SQLExec(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE key_hash = @key_hash AND status = @status`,
+		map[string]interface{}{"key_hash": keyHash(rec.Caller, rec.Key), "status": idempotency.StatusPending})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to release idempotency key: %v", err)
+	}
+	return nil
+}
+
+const upsertIdempotencyKeyQuery = `
+	UPSERT INTO idempotency_keys (
+		key_hash, caller, idempotency_key, method, request_hash, status, response, created_at, expires_at
+	) VALUES (
+		@key_hash, @caller, @idempotency_key, @method, @request_hash, @status, @response, @created_at, @expires_at
+	)`
+
+// idempotencyKeyParams returns the query parameters of a record
+func idempotencyKeyParams(rec *idempotency.Record) map[string]interface{} {
+	var response interface{}
+	if len(rec.Response) > 0 {
+		response = rec.Response
+	}
+	return map[string]interface{}{
+		"key_hash":        keyHash(rec.Caller, rec.Key),
+		"caller":          rec.Caller,
+		"idempotency_key": rec.Key,
+		"method":          rec.Method,
+		"request_hash":    rec.RequestHash,
+		"status":          rec.Status,
+		"response":        response,
+		"created_at":      rec.CreatedAt,
+		"expires_at":      rec.ExpiresAt,
+	}
+}
+
+// keyHash returns the primary key of a caller's idempotency key row.
+// ImmuDB primary keys cannot be as long as the longest idempotency key.
+func keyHash(caller, key string) string {
+	h := sha256.New()
+	h.Write([]byte(caller))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -11,6 +11,7 @@ import (
 
 	immudb "github.com/codenotary/immudb/pkg/client"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/signer"
 	pb "example.com/go-mono-repo/proto/ledger"
 )
 
@@ -69,6 +70,17 @@ func (im *ImmuDBManager) Connect(ctx context.Context) error {
 		opts = opts.WithMaxRecvMsgSize(im.config.MaxRecvMsgSize)
 	}
 
+	// Persist the trusted state locally so verified reads survive restarts
+	// Spec: docs/specs/006-verified-reads.md#trusted-state
+	if im.config.StateDir != "" {
+		opts = opts.WithDir(im.config.StateDir)
+	}
+
+	// Require signed server states when a signing key is configured
+	if im.config.ServerSigningPubKey != "" {
+		opts = opts.WithServerSigningPubKey(im.config.ServerSigningPubKey)
+	}
+
 	// Create client
 	var err error
 	im.client = immudb.NewClient().WithOptions(opts)
@@ -152,22 +164,28 @@ func (im *ImmuDBManager) VerifyTransaction(ctx context.Context, txID uint64) err
 		return nil // Verification disabled
 	}
 
-	// Get transaction by ID and verify
-	_, err := im.client.TxByID(ctx, txID)
+	// Get transaction by ID and verify its proof against the trusted state
+	_, err := im.client.VerifiedTxByID(ctx, txID)
 	if err != nil {
-		return fmt.Errorf("failed to get transaction %d: %w", txID, err)
+		return fmt.Errorf("failed to verify transaction %d: %w", txID, err)
 	}
 
-	// Verify the transaction proof
+	// Trusted state after verification
 	state, err := im.client.CurrentState(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get current state: %w", err)
 	}
 
-	// Signature checking requires proper key setup - skip for now if not configured
+	// Check the server signature of the trusted state
+	// Spec: docs/specs/006-verified-reads.md#server-signatures
 	if im.config.ServerSigningPubKey != "" {
-		// TODO: Parse public key and verify signature
-		log.Printf("Server signature verification not yet implemented")
+		pubKey, err := signer.ParsePublicKeyFile(im.config.ServerSigningPubKey)
+		if err != nil {
+			return fmt.Errorf("failed to load server signing public key: %w", err)
+		}
+		if err := state.CheckSignature(pubKey); err != nil {
+			return fmt.Errorf("server signature verification failed for transaction %d: %w", txID, err)
+		}
 	}
 
 	// Update metrics
@@ -0,0 +1,32 @@
+package journal
+
+import (
+	"context"
+	"time"
+
+	pb "example.com/go-mono-repo/proto/ledger"
+)
+
+// RepositoryInterface defines the interface for journal repository operations
+type RepositoryInterface interface {
+	CreateJournalEntry(ctx context.Context, entry *JournalEntryRow, lines []*JournalEntryLineRow, accountVersions map[string]int64, entityIDs []string) error
+	GetJournalEntryByID(ctx context.Context, entryID string) (*JournalEntryRow, error)
+	GetJournalEntryLines(ctx context.Context, entryID string) ([]*JournalEntryLineRow, error)
+	GetVerifiedJournalEntry(ctx context.Context, entryID string) (*JournalEntryRow, []*JournalEntryLineRow, *pb.VerificationProof, error)
+	ListJournalEntries(ctx context.Context, filters ListJournalEntryFilters) ([]*JournalEntryRow, string, int32, error)
+}
+
+// ManagerInterface defines the interface for journal manager operations
+type ManagerInterface interface {
+	PostJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*pb.JournalEntry, error)
+	PrepareJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*PreparedEntry, error)
+	GetJournalEntry(ctx context.Context, entryID string) (*pb.JournalEntry, error)
+	GetVerifiedJournalEntry(ctx context.Context, entryID string) (*pb.JournalEntry, *pb.VerificationProof, error)
+	ListJournalEntries(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error)
+}
+
+// PeriodCheckerInterface checks entry dates against accounting periods
+// Spec: docs/specs/012-accounting-periods.md#story-2-block-postings
+type PeriodCheckerInterface interface {
+	CheckPostingDate(ctx context.Context, entityID string, entryDate time.Time, adjustment bool) error
+}
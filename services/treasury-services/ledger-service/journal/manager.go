@@ -0,0 +1,514 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	"example.com/go-mono-repo/common/money"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Journal entry statuses as stored in the database
+const (
+	StatusPending   = "PENDING"
+	StatusPosted    = "POSTED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Manager handles journal entry business logic
+// Spec: docs/specs/004-journal-entries.md
+type Manager struct {
+	repo        RepositoryInterface
+	accountRepo account.RepositoryInterface
+	validator   *Validator
+	currencies  *account.Validator
+	periods     PeriodCheckerInterface
+
+	// Currency that every line is also recorded in
+	// Spec: docs/specs/014-multi-currency.md#functional-currency
+	functionalCurrency string
+
+	// Whether each account status can transact, loaded from account_statuses
+	canTransact   map[string]bool
+	canTransactMu sync.RWMutex
+}
+
+// NewManager creates a new journal manager
+func NewManager(repo RepositoryInterface, accountRepo account.RepositoryInterface, validator *Validator, currencies *account.Validator, periods PeriodCheckerInterface, functionalCurrency string) *Manager {
+	return &Manager{
+		repo:               repo,
+		accountRepo:        accountRepo,
+		validator:          validator,
+		currencies:         currencies,
+		periods:            periods,
+		functionalCurrency: functionalCurrency,
+	}
+}
+
+// PostJournalEntry validates and posts a balanced journal entry
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func (m *Manager) PostJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*pb.JournalEntry, error) {
+	prepared, err := m.PrepareJournalEntry(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Write header and lines atomically, provided no account changed since
+	// it was checked
+	if err := m.repo.CreateJournalEntry(ctx, prepared.Entry, prepared.Lines, prepared.AccountVersions, prepared.EntityIDs); err != nil {
+		log.Printf("Failed to post journal entry: %v", err)
+		return nil, err
+	}
+
+	return prepared.Proto(), nil
+}
+
+// PreparedEntry is a validated journal entry that has not been written.
+// It is written with CreateJournalEntry or WriteJournalEntry.
+type PreparedEntry struct {
+	Entry           *JournalEntryRow
+	Lines           []*JournalEntryLineRow
+	AccountVersions map[string]int64 // Version of each account when it was checked
+	EntityIDs       []string         // Entities whose closed periods were checked
+}
+
+// Proto converts the entry to a proto message
+func (p *PreparedEntry) Proto() *pb.JournalEntry {
+	return journalEntryRowToProto(p.Entry, p.Lines)
+}
+
+// PrepareJournalEntry validates a posting request against the accounts,
+// currencies and accounting periods it references and builds the rows to
+// write
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func (m *Manager) PrepareJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*PreparedEntry, error) {
+	// Validate request shape and parse amounts
+	lines, err := m.validator.ValidatePostJournalEntry(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only account creation requires an active currency. Accounts in a
+	// deprecated or inactive currency must stay postable so that they can
+	// be brought to zero and closed.
+	// Spec: docs/specs/010-treasury-currency-validation.md#story-1-resolve-currencies-through-treasury
+	if _, err := m.currencies.MoneyCurrency(ctx, req.CurrencyCode); err != nil {
+		return nil, err
+	}
+
+	// Resolve every referenced account and check its currency
+	accounts := make(map[string]*account.AccountRow)
+	for _, line := range lines {
+		acc, ok := accounts[line.AccountID]
+		if !ok {
+			acc, err = m.accountRepo.GetAccountByID(ctx, line.AccountID)
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					return nil, status.Errorf(codes.FailedPrecondition, "line %d: account %s not found", line.LineNumber, line.AccountID)
+				}
+				return nil, err
+			}
+
+			// Spec: docs/specs/009-account-lifecycle.md#story-4-reject-postings
+			if !m.StatusCanTransact(ctx, acc.Status) {
+				return nil, status.Errorf(codes.FailedPrecondition,
+					"line %d: account %s is %s and cannot transact",
+					line.LineNumber, line.AccountID, strings.ToLower(acc.Status))
+			}
+			accounts[line.AccountID] = acc
+		}
+
+		// A line is in its account's currency. Lines that do not name a
+		// currency must be in the entry currency.
+		// Spec: docs/specs/014-multi-currency.md#story-1-post-multi-currency-entries
+		if line.CurrencyCode == "" && acc.CurrencyCode != req.CurrencyCode {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"line %d: account %s currency %s does not match entry currency %s",
+				line.LineNumber, line.AccountID, acc.CurrencyCode, req.CurrencyCode)
+		}
+		if line.CurrencyCode != "" && acc.CurrencyCode != line.CurrencyCode {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"line %d: account %s currency %s does not match line currency %s",
+				line.LineNumber, line.AccountID, acc.CurrencyCode, line.CurrencyCode)
+		}
+		line.CurrencyCode = acc.CurrencyCode
+	}
+
+	// Amounts must fit the minor units of their currency
+	// Spec: docs/specs/007-money.md#ledger-amounts
+	currencies := make(map[string]money.Currency)
+	for _, line := range lines {
+		if _, ok := currencies[line.CurrencyCode]; ok {
+			continue
+		}
+		currency, err := m.currencies.MoneyCurrency(ctx, line.CurrencyCode)
+		if err != nil {
+			return nil, err
+		}
+		currencies[line.CurrencyCode] = currency
+	}
+	if err := m.validator.ValidateMinorUnits(lines, currencies); err != nil {
+		return nil, err
+	}
+
+	// Record every line in the functional currency
+	if err := m.validator.ConvertToFunctional(lines, req.CurrencyCode, req.ExchangeRate, m.functionalCurrency); err != nil {
+		return nil, err
+	}
+
+	// Debits must equal credits in the transaction currency when all lines
+	// share one, and always in the functional currency
+	if singleCurrency(lines) {
+		if err := m.validator.ValidateBalanced(lines); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.validator.ValidateFunctionalBalance(lines, m.functionalCurrency); err != nil {
+		return nil, err
+	}
+
+	// Spec: docs/specs/008-audit-log.md#caller-identity
+	createdBy := audit.UserFromContext(ctx)
+	if err := m.currencies.ValidateActor(createdBy); err != nil {
+		return nil, err
+	}
+
+	entry := &JournalEntryRow{
+		EntryDate:          time.Now(),
+		CurrencyCode:       req.CurrencyCode,
+		FunctionalCurrency: sql.NullString{String: m.functionalCurrency, Valid: true},
+		Status:             StatusPosted,
+		CreatedBy:          sql.NullString{String: createdBy, Valid: true},
+	}
+	if req.EntryDate != nil {
+		entry.EntryDate = req.EntryDate.AsTime()
+	}
+
+	// The entry must not be dated into a closed period of any entity it
+	// posts to
+	// Spec: docs/specs/012-accounting-periods.md#story-2-block-postings
+	entityIDs := accountEntityIDs(accounts)
+	for _, entityID := range entityIDs {
+		if err := m.periods.CheckPostingDate(ctx, entityID, entry.EntryDate, req.PeriodAdjustment); err != nil {
+			return nil, err
+		}
+	}
+	if req.Description != "" {
+		entry.Description = sql.NullString{String: req.Description, Valid: true}
+	}
+	if req.Reference != "" {
+		entry.Reference = sql.NullString{String: req.Reference, Valid: true}
+	}
+	if len(req.Metadata) > 0 {
+		data, err := json.Marshal(req.Metadata)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+		}
+		entry.Metadata = sql.NullString{String: string(data), Valid: true}
+	}
+
+	accountVersions := make(map[string]int64, len(accounts))
+	for accountID, acc := range accounts {
+		accountVersions[accountID] = acc.Version
+	}
+
+	return &PreparedEntry{
+		Entry:           entry,
+		Lines:           lines,
+		AccountVersions: accountVersions,
+		EntityIDs:       entityIDs,
+	}, nil
+}
+
+// GetJournalEntry retrieves a journal entry with its lines
+// Spec: docs/specs/004-journal-entries.md#story-2-retrieve-journal-entry
+func (m *Manager) GetJournalEntry(ctx context.Context, entryID string) (*pb.JournalEntry, error) {
+	if entryID == "" {
+		return nil, status.Error(codes.InvalidArgument, "journal_entry_id is required")
+	}
+
+	entry, err := m.repo.GetJournalEntryByID(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := m.repo.GetJournalEntryLines(ctx, entryID)
+	if err != nil {
+		return nil, err
+	}
+	m.fillLegacyFunctional(entry, lines)
+
+	return journalEntryRowToProto(entry, lines), nil
+}
+
+// GetVerifiedJournalEntry retrieves a journal entry with its lines, verified
+// against the trusted ledger state
+// Spec: docs/specs/006-verified-reads.md#story-3-verified-journal-entries
+func (m *Manager) GetVerifiedJournalEntry(ctx context.Context, entryID string) (*pb.JournalEntry, *pb.VerificationProof, error) {
+	if entryID == "" {
+		return nil, nil, status.Error(codes.InvalidArgument, "journal_entry_id is required")
+	}
+
+	entry, lines, proof, err := m.repo.GetVerifiedJournalEntry(ctx, entryID)
+	if err != nil {
+		return nil, nil, err
+	}
+	m.fillLegacyFunctional(entry, lines)
+
+	return journalEntryRowToProto(entry, lines), proof, nil
+}
+
+// ListJournalEntries lists journal entries with filtering
+// Spec: docs/specs/004-journal-entries.md#story-3-list-journal-entries
+func (m *Manager) ListJournalEntries(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
+	filters := ListJournalEntryFilters{
+		PageSize:       req.PageSize,
+		PageToken:      req.PageToken,
+		CurrencyCode:   req.CurrencyCode,
+		Reference:      req.Reference,
+		SkipTotalCount: req.SkipTotalCount,
+	}
+
+	// Validate page size
+	if filters.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	}
+	if filters.PageSize == 0 {
+		filters.PageSize = 50 // Default
+	}
+	if filters.PageSize > 200 {
+		filters.PageSize = 200 // Max
+	}
+
+	if req.StartDate != nil {
+		start := req.StartDate.AsTime()
+		filters.StartDate = &start
+	}
+	if req.EndDate != nil {
+		end := req.EndDate.AsTime()
+		filters.EndDate = &end
+	}
+	if filters.StartDate != nil && filters.EndDate != nil && !filters.StartDate.Before(*filters.EndDate) {
+		return nil, status.Error(codes.InvalidArgument, "start_date must be before end_date")
+	}
+
+	entryRows, nextPageToken, totalCount, err := m.repo.ListJournalEntries(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListJournalEntriesResponse{
+		JournalEntries: make([]*pb.JournalEntry, len(entryRows)),
+		NextPageToken:  nextPageToken,
+		TotalCount:     totalCount,
+	}
+	if req.Verified {
+		resp.Verifications = make([]*pb.VerificationProof, len(entryRows))
+	}
+
+	for i, row := range entryRows {
+		if req.Verified {
+			// Spec: docs/specs/006-verified-reads.md#story-3-verified-journal-entries
+			entry, proof, err := m.GetVerifiedJournalEntry(ctx, row.ID)
+			if err != nil {
+				return nil, err
+			}
+			resp.JournalEntries[i] = entry
+			resp.Verifications[i] = proof
+			continue
+		}
+
+		lines, err := m.repo.GetJournalEntryLines(ctx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		m.fillLegacyFunctional(row, lines)
+		resp.JournalEntries[i] = journalEntryRowToProto(row, lines)
+	}
+
+	return resp, nil
+}
+
+// StatusCanTransact resolves whether accounts in a status may be posted to
+// from the account_statuses table. Only active accounts may transact when
+// the table cannot be read.
+// Spec: docs/specs/009-account-lifecycle.md#story-4-reject-postings
+func (m *Manager) StatusCanTransact(ctx context.Context, accountStatus string) bool {
+	accountStatus = strings.ToUpper(accountStatus)
+
+	m.canTransactMu.RLock()
+	canTransact, found := m.canTransact[accountStatus]
+	loaded := m.canTransact != nil
+	m.canTransactMu.RUnlock()
+
+	if found {
+		return canTransact
+	}
+
+	if !loaded {
+		statuses, err := m.accountRepo.GetAccountStatuses(ctx)
+		if err != nil {
+			log.Printf("Failed to load account statuses, allowing only active accounts: %v", err)
+		} else {
+			m.canTransactMu.Lock()
+			m.canTransact = statuses
+			m.canTransactMu.Unlock()
+
+			if canTransact, found := statuses[accountStatus]; found {
+				return canTransact
+			}
+		}
+	}
+
+	return accountStatus == account.StatusActive
+}
+
+// Helper functions
+
+// accountEntityIDs returns the distinct entities of the accounts in sorted
+// order. An entity is an account's external_group_id, empty when unset.
+func accountEntityIDs(accounts map[string]*account.AccountRow) []string {
+	seen := make(map[string]bool)
+	var entityIDs []string
+	for _, acc := range accounts {
+		if !seen[acc.ExternalGroupID.String] {
+			seen[acc.ExternalGroupID.String] = true
+			entityIDs = append(entityIDs, acc.ExternalGroupID.String)
+		}
+	}
+	sort.Strings(entityIDs)
+	return entityIDs
+}
+
+// singleCurrency reports whether all lines are in the same currency
+func singleCurrency(lines []*JournalEntryLineRow) bool {
+	for _, line := range lines[1:] {
+		if line.CurrencyCode != lines[0].CurrencyCode {
+			return false
+		}
+	}
+	return true
+}
+
+// fillLegacyFunctional sets the functional amounts of an entry posted
+// before multi-currency support, whose functional columns are NULL. Lines
+// in the functional currency get a rate of 1 and their own amounts; lines
+// in other currencies cannot be converted and are left without. The entry
+// reports the functional currency when every line is in it.
+// Spec: docs/specs/014-multi-currency.md#legacy-entries
+func (m *Manager) fillLegacyFunctional(entry *JournalEntryRow, lines []*JournalEntryLineRow) {
+	if entry.FunctionalCurrency.Valid {
+		return
+	}
+
+	converted := 0
+	for _, line := range lines {
+		if line.CurrencyCode != m.functionalCurrency {
+			continue
+		}
+		line.ExchangeRate = sql.NullString{String: "1", Valid: true}
+		line.FunctionalDebitAmount = line.DebitAmount
+		line.FunctionalCreditAmount = line.CreditAmount
+		converted++
+	}
+	if converted > 0 && converted == len(lines) {
+		entry.FunctionalCurrency = sql.NullString{String: m.functionalCurrency, Valid: true}
+	}
+}
+
+// journalEntryRowToProto converts database rows to proto message
+func journalEntryRowToProto(row *JournalEntryRow, lines []*JournalEntryLineRow) *pb.JournalEntry {
+	entry := &pb.JournalEntry{
+		Id:           row.ID,
+		EntryDate:    timestamppb.New(row.EntryDate),
+		CurrencyCode: row.CurrencyCode,
+		Status:       stringToStatusProto(row.Status),
+		CreatedAt:    timestamppb.New(row.CreatedAt),
+		Lines:        make([]*pb.JournalEntryLine, len(lines)),
+	}
+
+	if row.Description.Valid {
+		entry.Description = row.Description.String
+	}
+	if row.Reference.Valid {
+		entry.Reference = row.Reference.String
+	}
+	if row.CreatedBy.Valid {
+		entry.CreatedBy = row.CreatedBy.String
+	}
+	if row.FunctionalCurrency.Valid {
+		entry.FunctionalCurrencyCode = row.FunctionalCurrency.String
+	}
+	if row.Metadata.Valid {
+		metadata := map[string]string{}
+		if err := json.Unmarshal([]byte(row.Metadata.String), &metadata); err == nil {
+			entry.Metadata = metadata
+		}
+	}
+
+	for i, line := range lines {
+		entry.Lines[i] = journalEntryLineRowToProto(line)
+	}
+
+	return entry
+}
+
+// journalEntryLineRowToProto converts a line row to proto message
+func journalEntryLineRowToProto(row *JournalEntryLineRow) *pb.JournalEntryLine {
+	line := &pb.JournalEntryLine{
+		Id:           row.ID,
+		LineNumber:   int32(row.LineNumber),
+		AccountId:    row.AccountID,
+		CurrencyCode: row.CurrencyCode,
+	}
+
+	if row.DebitAmount != 0 {
+		line.DebitAmount = amount.Format(row.DebitAmount)
+	}
+	if row.CreditAmount != 0 {
+		line.CreditAmount = amount.Format(row.CreditAmount)
+	}
+	if row.Description.Valid {
+		line.Description = row.Description.String
+	}
+
+	// Foreign-currency lines posted before multi-currency support have no
+	// functional amounts
+	if row.ExchangeRate.Valid {
+		line.ExchangeRate = row.ExchangeRate.String
+	}
+	if row.FunctionalDebitAmount != 0 {
+		line.FunctionalDebitAmount = amount.Format(row.FunctionalDebitAmount)
+	}
+	if row.FunctionalCreditAmount != 0 {
+		line.FunctionalCreditAmount = amount.Format(row.FunctionalCreditAmount)
+	}
+
+	return line
+}
+
+// stringToStatusProto converts string to proto enum
+func stringToStatusProto(s string) pb.JournalEntryStatus {
+	switch s {
+	case StatusPending:
+		return pb.JournalEntryStatus_JOURNAL_ENTRY_STATUS_PENDING
+	case StatusPosted:
+		return pb.JournalEntryStatus_JOURNAL_ENTRY_STATUS_POSTED
+	case StatusCancelled:
+		return pb.JournalEntryStatus_JOURNAL_ENTRY_STATUS_CANCELLED
+	default:
+		return pb.JournalEntryStatus_JOURNAL_ENTRY_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,761 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	pb "example.com/go-mono-repo/proto/ledger"
+	treasurypb "example.com/go-mono-repo/proto/treasury"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockRepository is a mock implementation of JournalRepository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CreateJournalEntry(ctx context.Context, entry *JournalEntryRow, lines []*JournalEntryLineRow, accountVersions map[string]int64, entityIDs []string) error {
+	args := m.Called(ctx, entry, lines, accountVersions, entityIDs)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetJournalEntryByID(ctx context.Context, entryID string) (*JournalEntryRow, error) {
+	args := m.Called(ctx, entryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*JournalEntryRow), args.Error(1)
+}
+
+func (m *MockRepository) GetJournalEntryLines(ctx context.Context, entryID string) ([]*JournalEntryLineRow, error) {
+	args := m.Called(ctx, entryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*JournalEntryLineRow), args.Error(1)
+}
+
+func (m *MockRepository) ListJournalEntries(ctx context.Context, filters ListJournalEntryFilters) ([]*JournalEntryRow, string, int32, error) {
+	args := m.Called(ctx, filters)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Get(2).(int32), args.Error(3)
+	}
+	return args.Get(0).([]*JournalEntryRow), args.String(1), args.Get(2).(int32), args.Error(3)
+}
+
+func (m *MockRepository) GetVerifiedJournalEntry(ctx context.Context, entryID string) (*JournalEntryRow, []*JournalEntryLineRow, *pb.VerificationProof, error) {
+	args := m.Called(ctx, entryID)
+	if args.Get(0) == nil {
+		return nil, nil, nil, args.Error(3)
+	}
+	return args.Get(0).(*JournalEntryRow), args.Get(1).([]*JournalEntryLineRow), args.Get(2).(*pb.VerificationProof), args.Error(3)
+}
+
+// MockAccountRepository is a mock implementation of account.RepositoryInterface
+type MockAccountRepository struct {
+	mock.Mock
+}
+
+func (m *MockAccountRepository) CreateAccount(ctx context.Context, acc *account.AccountRow) error {
+	args := m.Called(ctx, acc)
+	return args.Error(0)
+}
+
+func (m *MockAccountRepository) GetAccountByID(ctx context.Context, accountID string) (*account.AccountRow, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.AccountRow), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetAccountByExternalID(ctx context.Context, externalID string) (*account.AccountRow, error) {
+	args := m.Called(ctx, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.AccountRow), args.Error(1)
+}
+
+func (m *MockAccountRepository) UpdateAccount(ctx context.Context, accountID string, updates map[string]interface{}, currentVersion int64) (*account.AccountRow, error) {
+	args := m.Called(ctx, accountID, updates, currentVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.AccountRow), args.Error(1)
+}
+
+func (m *MockAccountRepository) UpdateAccountStatus(ctx context.Context, accountID string, change *account.StatusChange, currentVersion int64) (*account.AccountRow, error) {
+	args := m.Called(ctx, accountID, change, currentVersion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.AccountRow), args.Error(1)
+}
+
+func (m *MockAccountRepository) ListAccounts(ctx context.Context, filters account.ListAccountFilters) ([]*account.AccountRow, string, int32, error) {
+	args := m.Called(ctx, filters)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Get(2).(int32), args.Error(3)
+	}
+	return args.Get(0).([]*account.AccountRow), args.String(1), args.Get(2).(int32), args.Error(3)
+}
+
+func (m *MockAccountRepository) GetPostedTotals(ctx context.Context, accountID string, query account.BalanceQuery) (*account.BalanceRow, error) {
+	args := m.Called(ctx, accountID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.BalanceRow), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetHeldTotal(ctx context.Context, accountID string, query account.BalanceQuery) (int64, error) {
+	args := m.Called(ctx, accountID, query)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetNormalBalances(ctx context.Context) (map[string]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetAccountStatuses(ctx context.Context) (map[string]bool, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]bool), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetVerifiedAccountByID(ctx context.Context, accountID string) (*account.AccountRow, *pb.VerificationProof, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*account.AccountRow), args.Get(1).(*pb.VerificationProof), args.Error(2)
+}
+
+func (m *MockAccountRepository) VerifyLedgerState(ctx context.Context) (*pb.VerificationProof, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.VerificationProof), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetAccountAsOf(ctx context.Context, accountID string, asOfTx uint64, asOfTime *time.Time) (*account.AccountRow, error) {
+	args := m.Called(ctx, accountID, asOfTx, asOfTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.AccountRow), args.Error(1)
+}
+
+func (m *MockAccountRepository) GetAccountHistory(ctx context.Context, accountID string) ([]*account.AccountRevisionRow, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*account.AccountRevisionRow), args.Error(1)
+}
+
+// newTestManager wires a manager with fresh mocks
+// MockPeriodChecker is a mock implementation of PeriodCheckerInterface
+type MockPeriodChecker struct {
+	mock.Mock
+}
+
+func (m *MockPeriodChecker) CheckPostingDate(ctx context.Context, entityID string, entryDate time.Time, adjustment bool) error {
+	args := m.Called(ctx, entityID, entryDate, adjustment)
+	return args.Error(0)
+}
+
+// deprecatedCurrencyClient is a Treasury Service client that returns
+// every currency as deprecated
+type deprecatedCurrencyClient struct {
+	treasurypb.CurrencyServiceClient
+}
+
+func (deprecatedCurrencyClient) GetCurrency(ctx context.Context, req *treasurypb.GetCurrencyRequest, opts ...grpc.CallOption) (*treasurypb.GetCurrencyResponse, error) {
+	return &treasurypb.GetCurrencyResponse{Currency: &treasurypb.Currency{
+		Code:       req.GetCode(),
+		MinorUnits: 2,
+		Status:     treasurypb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED,
+	}}, nil
+}
+
+func newTestManager() (*Manager, *MockRepository, *MockAccountRepository) {
+	mockRepo := new(MockRepository)
+	mockAccounts := new(MockAccountRepository)
+	mockAccounts.On("GetAccountStatuses", mock.Anything).Return(map[string]bool{
+		account.StatusActive:   true,
+		account.StatusInactive: false,
+		account.StatusClosed:   false,
+		account.StatusFrozen:   false,
+		account.StatusPending:  false,
+	}, nil).Maybe()
+	mockPeriods := new(MockPeriodChecker)
+	mockPeriods.On("CheckPostingDate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return NewManager(mockRepo, mockAccounts, NewValidator(), account.NewValidator(), mockPeriods, "USD"), mockRepo, mockAccounts
+}
+
+// TestPostJournalEntry tests the PostJournalEntry method
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func TestPostJournalEntry(t *testing.T) {
+	ctx := context.Background()
+	cash := &account.AccountRow{ID: "acc-cash", CurrencyCode: "USD", AccountType: "ASSET", Status: account.StatusActive, Version: 3}
+	revenue := &account.AccountRow{ID: "acc-rev", CurrencyCode: "USD", AccountType: "REVENUE", Status: account.StatusActive, Version: 1}
+	euro := &account.AccountRow{ID: "acc-eur", CurrencyCode: "EUR", AccountType: "ASSET", Status: account.StatusActive, Version: 1}
+	frozen := &account.AccountRow{ID: "acc-frozen", CurrencyCode: "USD", AccountType: "ASSET", Status: account.StatusFrozen, Version: 2}
+
+	t.Run("successful posting", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs("x-user-id", "alice"))
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Reference:    "INV-001",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "100.50"},
+				{AccountId: "acc-rev", CreditAmount: "100.5"},
+			},
+			Metadata: map[string]string{"source": "billing"},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-rev").Return(revenue, nil).Once()
+		mockRepo.On("CreateJournalEntry", ctx, mock.AnythingOfType("*journal.JournalEntryRow"), mock.AnythingOfType("[]*journal.JournalEntryLineRow"),
+			map[string]int64{"acc-cash": 3, "acc-rev": 1}, []string{""}).
+			Run(func(args mock.Arguments) {
+				entry := args.Get(1).(*JournalEntryRow)
+				entry.ID = "entry-1"
+				assert.Equal(t, "alice", entry.CreatedBy.String)
+				lines := args.Get(2).([]*JournalEntryLineRow)
+				assert.Len(t, lines, 2)
+				assert.Equal(t, int64(1005000), lines[0].DebitAmount)
+				assert.Equal(t, int64(1005000), lines[1].CreditAmount)
+				assert.Equal(t, "USD", lines[1].CurrencyCode)
+			}).
+			Return(nil).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "entry-1", result.Id)
+		assert.Equal(t, pb.JournalEntryStatus_JOURNAL_ENTRY_STATUS_POSTED, result.Status)
+		assert.Equal(t, "100.5000", result.Lines[0].DebitAmount)
+		assert.Equal(t, "", result.Lines[0].CreditAmount)
+		assert.Equal(t, int32(2), result.Lines[1].LineNumber)
+		assert.Equal(t, "billing", result.Metadata["source"])
+		mockRepo.AssertExpectations(t)
+		mockAccounts.AssertExpectations(t)
+	})
+
+	t.Run("unbalanced entry", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "100.00"},
+				{AccountId: "acc-rev", CreditAmount: "99.99"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-rev").Return(revenue, nil).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Contains(t, st.Message(), "unbalanced for USD")
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("amount finer than the currency", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		yen := &account.AccountRow{ID: "acc-jpy", CurrencyCode: "JPY", AccountType: "ASSET", Status: account.StatusActive, Version: 1}
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "0.001"},
+				{AccountId: "acc-rev", CreditAmount: "0.001"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-rev").Return(revenue, nil).Once()
+
+		_, err := manager.PostJournalEntry(ctx, req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "line 1: invalid debit_amount: amount 0.0010 has more than 2 decimal places for USD", st.Message())
+
+		req = &pb.PostJournalEntryRequest{
+			CurrencyCode: "JPY",
+			ExchangeRate: "0.0067",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-jpy", DebitAmount: "1.5"},
+				{AccountId: "acc-jpy", CreditAmount: "1.5"},
+			},
+		}
+		mockAccounts.On("GetAccountByID", ctx, "acc-jpy").Return(yen, nil).Once()
+
+		_, err = manager.PostJournalEntry(ctx, req)
+
+		st, _ = status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "line 1: invalid debit_amount: amount 1.5000 has more than 0 decimal places for JPY", st.Message())
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-missing", DebitAmount: "10"},
+				{AccountId: "acc-rev", CreditAmount: "10"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-missing").
+			Return(nil, status.Error(codes.NotFound, "account acc-missing not found")).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Contains(t, st.Message(), "line 1")
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("account currency mismatch", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "10"},
+				{AccountId: "acc-eur", CreditAmount: "10"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-eur").Return(euro, nil).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Contains(t, st.Message(), "does not match entry currency USD")
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	// Spec: docs/specs/014-multi-currency.md#story-1-post-multi-currency-entries
+	t.Run("multi-currency posting", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-eur", CurrencyCode: "EUR", ExchangeRate: "1.0850", DebitAmount: "100"},
+				{AccountId: "acc-cash", CreditAmount: "108.50"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-eur").Return(euro, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockRepo.On("CreateJournalEntry", ctx, mock.AnythingOfType("*journal.JournalEntryRow"), mock.AnythingOfType("[]*journal.JournalEntryLineRow"),
+			map[string]int64{"acc-eur": 1, "acc-cash": 3}, []string{""}).
+			Return(nil).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", result.FunctionalCurrencyCode)
+		assert.Equal(t, "EUR", result.Lines[0].CurrencyCode)
+		assert.Equal(t, "1.085", result.Lines[0].ExchangeRate)
+		assert.Equal(t, "100.0000", result.Lines[0].DebitAmount)
+		assert.Equal(t, "108.5000", result.Lines[0].FunctionalDebitAmount)
+		assert.Equal(t, "1", result.Lines[1].ExchangeRate)
+		assert.Equal(t, "108.5000", result.Lines[1].FunctionalCreditAmount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("multi-currency posting unbalanced in functional currency", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-eur", CurrencyCode: "EUR", ExchangeRate: "1.0850", DebitAmount: "100"},
+				{AccountId: "acc-cash", CreditAmount: "100"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-eur").Return(euro, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+
+		_, err := manager.PostJournalEntry(ctx, req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "journal entry is unbalanced in functional currency USD: debits 108.5000 != credits 100.0000", st.Message())
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("line currency does not match account", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", CurrencyCode: "EUR", ExchangeRate: "1.1", DebitAmount: "10"},
+				{AccountId: "acc-rev", CreditAmount: "11"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+
+		_, err := manager.PostJournalEntry(ctx, req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, "line 1: account acc-cash currency USD does not match line currency EUR", st.Message())
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("account cannot transact", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "10"},
+				{AccountId: "acc-frozen", CreditAmount: "10"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-frozen").Return(frozen, nil).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Contains(t, st.Message(), "line 2: account acc-frozen is frozen and cannot transact")
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("deprecated currency", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAccounts := new(MockAccountRepository)
+		mockAccounts.On("GetAccountStatuses", mock.Anything).Return(map[string]bool{account.StatusActive: true}, nil).Maybe()
+		mockPeriods := new(MockPeriodChecker)
+		mockPeriods.On("CheckPostingDate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+		currencies := account.NewTreasuryValidator(deprecatedCurrencyClient{}, time.Minute, time.Second)
+		manager := NewManager(mockRepo, mockAccounts, NewValidator(), currencies, mockPeriods, "USD")
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", CreditAmount: "10"},
+				{AccountId: "acc-rev", DebitAmount: "10"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-rev").Return(revenue, nil).Once()
+		mockRepo.On("CreateJournalEntry", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		// Accounts in a retired currency can still be brought to zero
+		_, err := manager.PostJournalEntry(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Error(t, currencies.ValidateCurrencyCode(ctx, "USD"))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("account statuses unavailable", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAccounts := new(MockAccountRepository)
+		manager := NewManager(mockRepo, mockAccounts, NewValidator(), account.NewValidator(), new(MockPeriodChecker), "USD")
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "10"},
+				{AccountId: "acc-frozen", CreditAmount: "10"},
+			},
+		}
+
+		mockAccounts.On("GetAccountStatuses", ctx).Return(nil, status.Error(codes.Internal, "boom"))
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-frozen").Return(frozen, nil).Once()
+
+		_, err := manager.PostJournalEntry(ctx, req)
+
+		// Only active accounts transact when account_statuses cannot be read
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("account changed during posting", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "10"},
+				{AccountId: "acc-rev", CreditAmount: "10"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-rev").Return(revenue, nil).Once()
+		mockRepo.On("CreateJournalEntry", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(status.Error(codes.Aborted, "account acc-cash was modified, retry posting")).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		manager, mockRepo, mockAccounts := newTestManager()
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode: "USD",
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "10"},
+				{AccountId: "acc-rev", CreditAmount: "10"},
+			},
+		}
+
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-rev").Return(revenue, nil).Once()
+		mockRepo.On("CreateJournalEntry", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(status.Error(codes.Internal, "failed to post journal entry: boom")).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("entry dated into closed period", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAccounts := new(MockAccountRepository)
+		mockPeriods := new(MockPeriodChecker)
+		manager := NewManager(mockRepo, mockAccounts, NewValidator(), account.NewValidator(), mockPeriods, "USD")
+		grouped := &account.AccountRow{ID: "acc-grouped", CurrencyCode: "USD", AccountType: "ASSET", Status: account.StatusActive,
+			ExternalGroupID: sql.NullString{String: "entity-1", Valid: true}, Version: 1}
+		entryDate := time.Date(2025, 8, 31, 12, 0, 0, 0, time.UTC)
+		req := &pb.PostJournalEntryRequest{
+			CurrencyCode:     "USD",
+			EntryDate:        timestamppb.New(entryDate),
+			PeriodAdjustment: true,
+			Lines: []*pb.JournalEntryLine{
+				{AccountId: "acc-cash", DebitAmount: "10"},
+				{AccountId: "acc-grouped", CreditAmount: "10"},
+			},
+		}
+
+		mockAccounts.On("GetAccountStatuses", ctx).Return(map[string]bool{account.StatusActive: true}, nil)
+		mockAccounts.On("GetAccountByID", ctx, "acc-cash").Return(cash, nil).Once()
+		mockAccounts.On("GetAccountByID", ctx, "acc-grouped").Return(grouped, nil).Once()
+		mockPeriods.On("CheckPostingDate", ctx, "", entryDate, true).Return(nil).Once()
+		mockPeriods.On("CheckPostingDate", ctx, "entity-1", entryDate, true).
+			Return(status.Error(codes.FailedPrecondition, "entry date 2025-08-31T12:00:00Z is before the end of closed period 2025-08 (id p-8, 2025-08-01T00:00:00Z to 2025-09-01T00:00:00Z)")).Once()
+
+		result, err := manager.PostJournalEntry(ctx, req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		mockPeriods.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestGetJournalEntry tests the GetJournalEntry method
+// Spec: docs/specs/004-journal-entries.md#story-2-retrieve-journal-entry
+func TestGetJournalEntry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("existing entry", func(t *testing.T) {
+		manager, mockRepo, _ := newTestManager()
+		mockRepo.On("GetJournalEntryByID", ctx, "entry-1").
+			Return(&JournalEntryRow{ID: "entry-1", CurrencyCode: "USD", Status: StatusPosted}, nil).Once()
+		mockRepo.On("GetJournalEntryLines", ctx, "entry-1").
+			Return([]*JournalEntryLineRow{
+				{ID: "l1", LineNumber: 1, AccountID: "acc-cash", CurrencyCode: "USD", DebitAmount: 10000},
+				{ID: "l2", LineNumber: 2, AccountID: "acc-rev", CurrencyCode: "USD", CreditAmount: 10000},
+			}, nil).Once()
+
+		result, err := manager.GetJournalEntry(ctx, "entry-1")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Lines, 2)
+		assert.Equal(t, "1.0000", result.Lines[1].CreditAmount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("legacy entries read functional amounts in the functional currency", func(t *testing.T) {
+		manager, mockRepo, _ := newTestManager()
+		mockRepo.On("GetJournalEntryByID", ctx, "legacy-usd").
+			Return(&JournalEntryRow{ID: "legacy-usd", CurrencyCode: "USD", Status: StatusPosted}, nil).Once()
+		mockRepo.On("GetJournalEntryLines", ctx, "legacy-usd").
+			Return([]*JournalEntryLineRow{
+				{ID: "l1", LineNumber: 1, AccountID: "acc-cash", CurrencyCode: "USD", DebitAmount: 10000},
+				{ID: "l2", LineNumber: 2, AccountID: "acc-rev", CurrencyCode: "USD", CreditAmount: 10000},
+			}, nil).Once()
+		mockRepo.On("GetJournalEntryByID", ctx, "legacy-eur").
+			Return(&JournalEntryRow{ID: "legacy-eur", CurrencyCode: "EUR", Status: StatusPosted}, nil).Once()
+		mockRepo.On("GetJournalEntryLines", ctx, "legacy-eur").
+			Return([]*JournalEntryLineRow{
+				{ID: "l3", LineNumber: 1, AccountID: "acc-eur", CurrencyCode: "EUR", DebitAmount: 10000},
+				{ID: "l4", LineNumber: 2, AccountID: "acc-eur-2", CurrencyCode: "EUR", CreditAmount: 10000},
+			}, nil).Once()
+
+		result, err := manager.GetJournalEntry(ctx, "legacy-usd")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", result.FunctionalCurrencyCode)
+		assert.Equal(t, "1", result.Lines[0].ExchangeRate)
+		assert.Equal(t, "1.0000", result.Lines[0].FunctionalDebitAmount)
+		assert.Equal(t, "1.0000", result.Lines[1].FunctionalCreditAmount)
+
+		result, err = manager.GetJournalEntry(ctx, "legacy-eur")
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.FunctionalCurrencyCode)
+		assert.Empty(t, result.Lines[0].ExchangeRate)
+		assert.Empty(t, result.Lines[0].FunctionalDebitAmount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		manager, mockRepo, _ := newTestManager()
+		mockRepo.On("GetJournalEntryByID", ctx, "missing").
+			Return(nil, status.Error(codes.NotFound, "journal entry missing not found")).Once()
+
+		result, err := manager.GetJournalEntry(ctx, "missing")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("empty id", func(t *testing.T) {
+		manager, _, _ := newTestManager()
+
+		result, err := manager.GetJournalEntry(ctx, "")
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestListJournalEntries tests the ListJournalEntries method
+// Spec: docs/specs/004-journal-entries.md#story-3-list-journal-entries
+func TestListJournalEntries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("defaults page size and loads lines", func(t *testing.T) {
+		manager, mockRepo, _ := newTestManager()
+		mockRepo.On("ListJournalEntries", ctx, ListJournalEntryFilters{PageSize: 50, CurrencyCode: "USD"}).
+			Return([]*JournalEntryRow{{ID: "entry-1", Status: StatusPosted}}, "", int32(1), nil).Once()
+		mockRepo.On("GetJournalEntryLines", ctx, "entry-1").
+			Return([]*JournalEntryLineRow{{ID: "l1", LineNumber: 1}}, nil).Once()
+
+		resp, err := manager.ListJournalEntries(ctx, &pb.ListJournalEntriesRequest{CurrencyCode: "USD"})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.JournalEntries, 1)
+		assert.Len(t, resp.JournalEntries[0].Lines, 1)
+		assert.Equal(t, int32(1), resp.TotalCount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("negative page size", func(t *testing.T) {
+		manager, _, _ := newTestManager()
+
+		resp, err := manager.ListJournalEntries(ctx, &pb.ListJournalEntriesRequest{PageSize: -1})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestGetVerifiedJournalEntry tests verified journal entry reads
+// Spec: docs/specs/006-verified-reads.md#story-3-verified-journal-entries
+func TestGetVerifiedJournalEntry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns entry with proof", func(t *testing.T) {
+		manager, mockRepo, _ := newTestManager()
+		proof := &pb.VerificationProof{TxId: 31, TrustedTxId: 40}
+		mockRepo.On("GetVerifiedJournalEntry", ctx, "entry-1").
+			Return(&JournalEntryRow{ID: "entry-1", CurrencyCode: "USD", Status: StatusPosted},
+				[]*JournalEntryLineRow{
+					{ID: "l1", LineNumber: 1, AccountID: "acc-cash", DebitAmount: 10000},
+					{ID: "l2", LineNumber: 2, AccountID: "acc-rev", CreditAmount: 10000},
+				}, proof, nil).Once()
+
+		entry, result, err := manager.GetVerifiedJournalEntry(ctx, "entry-1")
+
+		assert.NoError(t, err)
+		assert.Len(t, entry.Lines, 2)
+		assert.Equal(t, proof, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("tampered row", func(t *testing.T) {
+		manager, mockRepo, _ := newTestManager()
+		mockRepo.On("GetVerifiedJournalEntry", ctx, "entry-1").
+			Return(nil, nil, nil, status.Error(codes.DataLoss, "verification failed for journal_entry_lines row")).Once()
+
+		entry, result, err := manager.GetVerifiedJournalEntry(ctx, "entry-1")
+
+		assert.Error(t, err)
+		assert.Nil(t, entry)
+		assert.Nil(t, result)
+		assert.Equal(t, codes.DataLoss, status.Code(err))
+	})
+
+	t.Run("verified list returns one proof per entry", func(t *testing.T) {
+		manager, mockRepo, _ := newTestManager()
+		mockRepo.On("ListJournalEntries", ctx, ListJournalEntryFilters{PageSize: 50}).
+			Return([]*JournalEntryRow{{ID: "entry-1"}, {ID: "entry-2"}}, "", int32(2), nil).Once()
+		for i, id := range []string{"entry-1", "entry-2"} {
+			mockRepo.On("GetVerifiedJournalEntry", ctx, id).
+				Return(&JournalEntryRow{ID: id, Status: StatusPosted}, []*JournalEntryLineRow{},
+					&pb.VerificationProof{TxId: uint64(i + 1)}, nil).Once()
+		}
+
+		resp, err := manager.ListJournalEntries(ctx, &pb.ListJournalEntriesRequest{Verified: true})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.JournalEntries, 2)
+		assert.Len(t, resp.Verifications, 2)
+		assert.Equal(t, uint64(2), resp.Verifications[1].TxId)
+		mockRepo.AssertNotCalled(t, "GetJournalEntryLines", ctx, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,584 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	"clarity/treasury-services/ledger-service/pkg/verification"
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// journalEntriesCursorScope binds ListJournalEntries page tokens to that RPC
+const journalEntriesCursorScope = "ledger.journal_entries"
+
+// JournalRepository handles database operations for journal entries
+// Spec: docs/specs/004-journal-entries.md
+type JournalRepository struct {
+	db       client.ImmuClient
+	verifier *verification.Verifier
+	cursors  *pagination.Codec
+}
+
+// NewJournalRepository creates a new journal repository
+func NewJournalRepository(db client.ImmuClient, cursors *pagination.Codec) *JournalRepository {
+	return &JournalRepository{
+		db:       db,
+		verifier: verification.NewVerifier(db),
+		cursors:  cursors,
+	}
+}
+
+// JournalEntryRow represents a database row for a journal entry header
+type JournalEntryRow struct {
+	ID                 string
+	EntryDate          time.Time
+	Description        sql.NullString
+	Reference          sql.NullString
+	CurrencyCode       string
+	Status             string
+	Metadata           sql.NullString
+	CreatedAt          time.Time
+	CreatedBy          sql.NullString
+	FunctionalCurrency sql.NullString // NULL on entries posted before migration 012
+	LineCount          sql.NullInt64  // NULL on entries posted before migration 014
+}
+
+// JournalEntryLineRow represents a database row for a journal entry line.
+// Amounts are scaled by 10^amount.Scale. Debit and credit amounts are in
+// the line currency, functional amounts in the entry's functional currency.
+type JournalEntryLineRow struct {
+	ID                     string
+	JournalEntryID         string
+	LineNumber             int64
+	AccountID              string
+	CurrencyCode           string
+	DebitAmount            int64
+	CreditAmount           int64
+	Description            sql.NullString
+	CreatedAt              time.Time
+	ExchangeRate           sql.NullString // NULL on functional adjustment lines
+	FunctionalDebitAmount  int64
+	FunctionalCreditAmount int64
+}
+
+// ListJournalEntryFilters contains filters for listing journal entries
+type ListJournalEntryFilters struct {
+	PageSize       int32
+	PageToken      string
+	CurrencyCode   string
+	Reference      string
+	StartDate      *time.Time
+	EndDate        *time.Time
+	SkipTotalCount bool
+}
+
+// CreateJournalEntry writes the entry header and all of its lines in a
+// single ImmuDB transaction so a partially posted entry is never visible.
+// accountVersions holds the version of each referenced account that the
+// caller validated; the posting is aborted if any of them has changed.
+// entityIDs are the entities of those accounts, whose closed periods are
+// checked again inside the transaction.
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func (r *JournalRepository) CreateJournalEntry(ctx context.Context, entry *JournalEntryRow, lines []*JournalEntryLineRow, accountVersions map[string]int64, entityIDs []string) error {
+	tx, err := r.db.NewTx(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	if err := WriteJournalEntry(ctx, tx, entry, lines, accountVersions, entityIDs); err != nil {
+		tx.Rollback(ctx)
+		return err
+	}
+
+	if _, err := tx.Commit(ctx); err != nil {
+		// A concurrent change to a referenced account fails the commit
+		if strings.Contains(err.Error(), "conflict") {
+			return status.Errorf(codes.Aborted, "account was modified, retry posting")
+		}
+		return status.Errorf(codes.Internal, "failed to post journal entry: %v", err)
+	}
+
+	return nil
+}
+
+// WriteJournalEntry checks the referenced accounts and closed periods and
+// writes the entry, its lines and its audit record in the caller's
+// transaction. It lets other writes, such as a hold capture, commit
+// atomically with a posting. The caller rolls back on error.
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func WriteJournalEntry(ctx context.Context, tx client.Tx, entry *JournalEntryRow, lines []*JournalEntryLineRow, accountVersions map[string]int64, entityIDs []string) error {
+	// Generate UUID if not provided
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	entry.CreatedAt = now
+	entry.LineCount = sql.NullInt64{Int64: int64(len(lines)), Valid: true}
+
+	// Re-read the accounts inside the transaction so a freeze or close that
+	// commits before or during the posting cannot be bypassed
+	// Spec: docs/specs/009-account-lifecycle.md#story-4-reject-postings
+	for accountID, version := range accountVersions {
+		result, err := tx.SQLQuery(ctx, "SELECT version FROM accounts WHERE id = @id", map[string]interface{}{"id": accountID})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to query account: %v", err)
+		}
+		if len(result.Rows) == 0 || result.Rows[0].Values[0].GetN() != version {
+			return status.Errorf(codes.Aborted, "account %s was modified, retry posting", accountID)
+		}
+	}
+
+	// A period close that commits after the manager's check must still
+	// block the posting. The latest closed period is reported, since it
+	// locks every date before its end.
+	// Spec: docs/specs/012-accounting-periods.md#story-2-block-postings
+	for _, entityID := range entityIDs {
+		result, err := tx.SQLQuery(ctx, `
+			SELECT id, period, start_date, end_date FROM accounting_periods
+			WHERE entity_id = @entity_id AND status = 'CLOSED' AND end_date > @entry_date`,
+			map[string]interface{}{"entity_id": entityID, "entry_date": entry.EntryDate})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to query accounting periods: %v", err)
+		}
+		var blocking *schema.Row
+		for _, row := range result.Rows {
+			if blocking == nil || row.Values[3].GetTs() > blocking.Values[3].GetTs() {
+				blocking = row
+			}
+		}
+		if blocking != nil {
+			return status.Errorf(codes.FailedPrecondition, "entry date %s is before the end of closed period %s (id %s, %s to %s)",
+				entry.EntryDate.UTC().Format(time.RFC3339), blocking.Values[1].GetS(), blocking.Values[0].GetS(),
+				time.UnixMicro(blocking.Values[2].GetTs()).UTC().Format(time.RFC3339),
+				time.UnixMicro(blocking.Values[3].GetTs()).UTC().Format(time.RFC3339))
+		}
+	}
+
+	headerQuery := `
+		INSERT INTO journal_entries (
+			id, entry_date, description, reference, currency_code,
+			status, metadata, created_at, created_by, functional_currency,
+			line_count
+		) VALUES (
+			@id, @entry_date, @description, @reference, @currency_code,
+			@status, @metadata, @created_at, @created_by, @functional_currency,
+			@line_count
+		)`
+
+	headerParams := map[string]interface{}{
+		"id":                  entry.ID,
+		"entry_date":          entry.EntryDate,
+		"description":         nullableString(entry.Description),
+		"reference":           nullableString(entry.Reference),
+		"currency_code":       entry.CurrencyCode,
+		"status":              entry.Status,
+		"metadata":            nullableString(entry.Metadata),
+		"created_at":          entry.CreatedAt,
+		"created_by":          nullableString(entry.CreatedBy),
+		"functional_currency": nullableString(entry.FunctionalCurrency),
+		"line_count":          entry.LineCount.Int64,
+	}
+
+	if err := tx.SQLExec(ctx, headerQuery, headerParams); err != nil {
+		return status.Errorf(codes.Internal, "failed to insert journal entry: %v", err)
+	}
+
+	lineQuery := `
+		INSERT INTO journal_entry_lines (
+			id, journal_entry_id, line_number, account_id, currency_code,
+			debit_amount, credit_amount, description, created_at,
+			exchange_rate, functional_debit_amount, functional_credit_amount
+		) VALUES (
+			@id, @journal_entry_id, @line_number, @account_id, @currency_code,
+			@debit_amount, @credit_amount, @description, @created_at,
+			@exchange_rate, @functional_debit_amount, @functional_credit_amount
+		)`
+
+	for _, line := range lines {
+		if line.ID == "" {
+			line.ID = uuid.New().String()
+		}
+		line.JournalEntryID = entry.ID
+		line.CreatedAt = now
+
+		lineParams := map[string]interface{}{
+			"id":                       line.ID,
+			"journal_entry_id":         line.JournalEntryID,
+			"line_number":              line.LineNumber,
+			"account_id":               line.AccountID,
+			"currency_code":            line.CurrencyCode,
+			"debit_amount":             line.DebitAmount,
+			"credit_amount":            line.CreditAmount,
+			"description":              nullableString(line.Description),
+			"created_at":               line.CreatedAt,
+			"exchange_rate":            nullableString(line.ExchangeRate),
+			"functional_debit_amount":  line.FunctionalDebitAmount,
+			"functional_credit_amount": line.FunctionalCreditAmount,
+		}
+
+		if err := tx.SQLExec(ctx, lineQuery, lineParams); err != nil {
+			return status.Errorf(codes.Internal, "failed to insert journal entry line %d: %v", line.LineNumber, err)
+		}
+	}
+
+	// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+	event := &audit.Event{
+		EntityType: audit.EntityJournalEntry,
+		EntityID:   entry.ID,
+		Action:     audit.ActionCreate,
+		NewValues:  journalEntryAuditValues(entry, lines),
+	}
+	return audit.Write(ctx, tx, event)
+}
+
+// journalEntryAuditValues returns the audited fields of a posted entry keyed
+// by proto field name. Amounts are recorded as decimal strings.
+// Spec: docs/specs/008-audit-log.md#json-diffs
+func journalEntryAuditValues(entry *JournalEntryRow, lines []*JournalEntryLineRow) map[string]interface{} {
+	auditLines := make([]map[string]interface{}, 0, len(lines))
+	for _, line := range lines {
+		auditLines = append(auditLines, map[string]interface{}{
+			"line_number":              line.LineNumber,
+			"account_id":               line.AccountID,
+			"currency_code":            line.CurrencyCode,
+			"debit_amount":             amount.Format(line.DebitAmount),
+			"credit_amount":            amount.Format(line.CreditAmount),
+			"exchange_rate":            nullableString(line.ExchangeRate),
+			"functional_debit_amount":  amount.Format(line.FunctionalDebitAmount),
+			"functional_credit_amount": amount.Format(line.FunctionalCreditAmount),
+		})
+	}
+
+	return map[string]interface{}{
+		"entry_date":               entry.EntryDate.UTC().Format(time.RFC3339Nano),
+		"description":              nullableString(entry.Description),
+		"reference":                nullableString(entry.Reference),
+		"currency_code":            entry.CurrencyCode,
+		"status":                   entry.Status,
+		"lines":                    auditLines,
+		"functional_currency_code": nullableString(entry.FunctionalCurrency),
+	}
+}
+
+// GetJournalEntryByID retrieves a journal entry header by its system ID
+// Spec: docs/specs/004-journal-entries.md#story-2-retrieve-journal-entry
+func (r *JournalRepository) GetJournalEntryByID(ctx context.Context, entryID string) (*JournalEntryRow, error) {
+	query := `
+		SELECT
+			id, entry_date, description, reference, currency_code,
+			status, metadata, created_at, created_by, functional_currency,
+			line_count
+		FROM journal_entries
+		WHERE id = @id`
+
+	params := map[string]interface{}{
+		"id": entryID,
+	}
+
+	result, err := r.db.SQLQuery(ctx, query, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query journal entry: %v", err)
+	}
+
+	if len(result.Rows) == 0 {
+		return nil, status.Errorf(codes.NotFound, "journal entry %s not found", entryID)
+	}
+
+	return parseJournalEntryRow(result.Rows[0]), nil
+}
+
+// GetJournalEntryLines retrieves all lines of a journal entry ordered by line number
+// Spec: docs/specs/004-journal-entries.md#story-2-retrieve-journal-entry
+func (r *JournalRepository) GetJournalEntryLines(ctx context.Context, entryID string) ([]*JournalEntryLineRow, error) {
+	query := `
+		SELECT
+			id, journal_entry_id, line_number, account_id, currency_code,
+			debit_amount, credit_amount, description, created_at,
+			exchange_rate, functional_debit_amount, functional_credit_amount
+		FROM journal_entry_lines
+		WHERE journal_entry_id = @journal_entry_id
+		ORDER BY line_number`
+
+	params := map[string]interface{}{
+		"journal_entry_id": entryID,
+	}
+
+	result, err := r.db.SQLQuery(ctx, query, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query journal entry lines: %v", err)
+	}
+
+	lines := make([]*JournalEntryLineRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		lines = append(lines, parseJournalEntryLineRow(row))
+	}
+
+	return lines, nil
+}
+
+// GetVerifiedJournalEntry retrieves a journal entry with its lines and
+// verifies the header and every line row against the trusted ledger state.
+// The lines are found with an unverified query, so the line count stored
+// on the verified header proves that none is missing. The returned proof
+// covers the header row.
+// Spec: docs/specs/006-verified-reads.md#story-3-verified-journal-entries
+func (r *JournalRepository) GetVerifiedJournalEntry(ctx context.Context, entryID string) (*JournalEntryRow, []*JournalEntryLineRow, *pb.VerificationProof, error) {
+	columns := []string{
+		"id", "entry_date", "description", "reference", "currency_code",
+		"status", "metadata", "created_at", "created_by", "functional_currency",
+		"line_count",
+	}
+
+	row, proof, err := r.verifier.VerifyRow(ctx, "journal_entries", verification.StringKey(entryID), columns)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if row == nil {
+		return nil, nil, nil, status.Errorf(codes.NotFound, "journal entry %s not found", entryID)
+	}
+
+	lines, err := r.GetJournalEntryLines(ctx, entryID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	lineColumns := []string{
+		"id", "journal_entry_id", "line_number", "account_id", "currency_code",
+		"debit_amount", "credit_amount", "description", "created_at",
+		"exchange_rate", "functional_debit_amount", "functional_credit_amount",
+	}
+
+	for i, line := range lines {
+		lineRow, _, err := r.verifier.VerifyRow(ctx, "journal_entry_lines", verification.StringKey(line.ID), lineColumns)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if lineRow == nil {
+			return nil, nil, nil, status.Errorf(codes.DataLoss, "journal entry line %s disappeared during verification", line.ID)
+		}
+		lines[i] = parseJournalEntryLineRow(lineRow)
+	}
+
+	entry := parseJournalEntryRow(row)
+	if err := checkLineSet(entry, lines); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return entry, lines, proof, nil
+}
+
+// checkLineSet checks verified lines against their verified header: every
+// line belongs to the entry, none is repeated and, when the header records
+// a line count, none is missing. Entries posted before migration 014 have
+// no line count, so only their returned lines are proven.
+// Spec: docs/specs/006-verified-reads.md#line-set
+func checkLineSet(entry *JournalEntryRow, lines []*JournalEntryLineRow) error {
+	seen := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		if line.JournalEntryID != entry.ID {
+			return status.Errorf(codes.DataLoss, "journal entry line %s does not belong to entry %s", line.ID, entry.ID)
+		}
+		if seen[line.ID] {
+			return status.Errorf(codes.DataLoss, "journal entry line %s was returned more than once", line.ID)
+		}
+		seen[line.ID] = true
+	}
+
+	if entry.LineCount.Valid && entry.LineCount.Int64 != int64(len(lines)) {
+		return status.Errorf(codes.DataLoss, "journal entry %s has %d lines but %d were found", entry.ID, entry.LineCount.Int64, len(lines))
+	}
+	return nil
+}
+
+// ListJournalEntries lists journal entry headers with filtering and pagination
+// Spec: docs/specs/004-journal-entries.md#story-3-list-journal-entries
+func (r *JournalRepository) ListJournalEntries(ctx context.Context, filters ListJournalEntryFilters) ([]*JournalEntryRow, string, int32, error) {
+	// Build WHERE clause
+	whereClauses := []string{}
+	params := map[string]interface{}{}
+
+	if filters.CurrencyCode != "" {
+		whereClauses = append(whereClauses, "currency_code = @currency_code")
+		params["currency_code"] = filters.CurrencyCode
+	}
+
+	if filters.Reference != "" {
+		whereClauses = append(whereClauses, "reference = @reference")
+		params["reference"] = filters.Reference
+	}
+
+	if filters.StartDate != nil {
+		whereClauses = append(whereClauses, "entry_date >= @start_date")
+		params["start_date"] = *filters.StartDate
+	}
+
+	if filters.EndDate != nil {
+		whereClauses = append(whereClauses, "entry_date < @end_date")
+		params["end_date"] = *filters.EndDate
+	}
+
+	whereClause := ""
+	if len(whereClauses) > 0 {
+		whereClause = "WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	// Resume after the last entry of the previous page
+	// Spec: docs/specs/005-cursor-pagination.md
+	pageClause := whereClause
+	cursorFilters := filters.cursorFilters()
+	if filters.PageToken != "" {
+		keys, err := r.cursors.Decode(filters.PageToken, journalEntriesCursorScope, cursorFilters, 2)
+		if err != nil {
+			return nil, "", 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		afterEntryDate, err := time.Parse(time.RFC3339Nano, keys[0])
+		if err != nil {
+			return nil, "", 0, status.Error(codes.InvalidArgument, pagination.ErrInvalidToken.Error())
+		}
+
+		keyset := "(entry_date < @after_entry_date OR (entry_date = @after_entry_date AND id > @after_id))"
+		pageClause = "WHERE " + strings.Join(append(whereClauses, keyset), " AND ")
+		params["after_entry_date"] = afterEntryDate
+		params["after_id"] = keys[1]
+	}
+
+	// Count total matching entries with the same filters as the page
+	// Spec: docs/specs/005-cursor-pagination.md#total-count
+	totalCount := int32(0)
+	if !filters.SkipTotalCount {
+		countQuery := fmt.Sprintf("SELECT COUNT(*) as total FROM journal_entries %s", whereClause)
+		countResult, err := r.db.SQLQuery(ctx, countQuery, params, false)
+		if err != nil {
+			return nil, "", 0, status.Errorf(codes.Internal, "failed to count journal entries: %v", err)
+		}
+		if len(countResult.Rows) > 0 {
+			totalCount = int32(countResult.Rows[0].Values[0].GetN())
+		}
+	}
+
+	limit := filters.PageSize
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 200 {
+		limit = 200
+	}
+
+	// Fetch one extra row to tell whether another page follows
+	query := fmt.Sprintf(`
+		SELECT
+			id, entry_date, description, reference, currency_code,
+			status, metadata, created_at, created_by, functional_currency,
+			line_count
+		FROM journal_entries
+		%s
+		ORDER BY entry_date DESC, id
+		LIMIT %d`,
+		pageClause, limit+1)
+
+	result, err := r.db.SQLQuery(ctx, query, params, false)
+	if err != nil {
+		return nil, "", 0, status.Errorf(codes.Internal, "failed to list journal entries: %v", err)
+	}
+
+	entries := make([]*JournalEntryRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		entries = append(entries, parseJournalEntryRow(row))
+	}
+
+	nextPageToken := ""
+	if len(entries) > int(limit) {
+		entries = entries[:limit]
+		last := entries[len(entries)-1]
+		nextPageToken = r.cursors.Encode(journalEntriesCursorScope, cursorFilters,
+			last.EntryDate.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
+	return entries, nextPageToken, totalCount, nil
+}
+
+// cursorFilters returns the filters a page token is bound to
+func (f ListJournalEntryFilters) cursorFilters() pagination.Filters {
+	filters := pagination.Filters{
+		"currency_code": f.CurrencyCode,
+		"reference":     f.Reference,
+	}
+	if f.StartDate != nil {
+		filters["start_date"] = f.StartDate.UTC().Format(time.RFC3339Nano)
+	}
+	if f.EndDate != nil {
+		filters["end_date"] = f.EndDate.UTC().Format(time.RFC3339Nano)
+	}
+	return filters
+}
+
+// parseJournalEntryRow converts a query row into a JournalEntryRow
+func parseJournalEntryRow(row *schema.Row) *JournalEntryRow {
+	return &JournalEntryRow{
+		ID:                 string(row.Values[0].GetS()),
+		EntryDate:          time.UnixMicro(row.Values[1].GetTs()),
+		Description:        nullStringValue(row.Values[2]),
+		Reference:          nullStringValue(row.Values[3]),
+		CurrencyCode:       string(row.Values[4].GetS()),
+		Status:             string(row.Values[5].GetS()),
+		Metadata:           nullStringValue(row.Values[6]),
+		CreatedAt:          time.UnixMicro(row.Values[7].GetTs()),
+		CreatedBy:          nullStringValue(row.Values[8]),
+		FunctionalCurrency: nullStringValue(row.Values[9]),
+		LineCount:          nullIntValue(row.Values[10]),
+	}
+}
+
+// parseJournalEntryLineRow converts a query row into a JournalEntryLineRow
+func parseJournalEntryLineRow(row *schema.Row) *JournalEntryLineRow {
+	return &JournalEntryLineRow{
+		ID:                     string(row.Values[0].GetS()),
+		JournalEntryID:         string(row.Values[1].GetS()),
+		LineNumber:             row.Values[2].GetN(),
+		AccountID:              string(row.Values[3].GetS()),
+		CurrencyCode:           string(row.Values[4].GetS()),
+		DebitAmount:            row.Values[5].GetN(),
+		CreditAmount:           row.Values[6].GetN(),
+		Description:            nullStringValue(row.Values[7]),
+		CreatedAt:              time.UnixMicro(row.Values[8].GetTs()),
+		ExchangeRate:           nullStringValue(row.Values[9]),
+		FunctionalDebitAmount:  row.Values[10].GetN(),
+		FunctionalCreditAmount: row.Values[11].GetN(),
+	}
+}
+
+// nullIntValue converts an optional INTEGER column into sql.NullInt64
+func nullIntValue(v *schema.SQLValue) sql.NullInt64 {
+	if n, ok := v.GetValue().(*schema.SQLValue_N); ok {
+		return sql.NullInt64{Int64: n.N, Valid: true}
+	}
+	return sql.NullInt64{}
+}
+
+// nullStringValue converts an optional VARCHAR column into sql.NullString
+func nullStringValue(v *schema.SQLValue) sql.NullString {
+	if v != nil && len(v.GetS()) > 0 {
+		return sql.NullString{String: v.GetS(), Valid: true}
+	}
+	return sql.NullString{}
+}
+
+// nullableString converts sql.NullString into a query parameter
+func nullableString(s sql.NullString) interface{} {
+	if s.Valid {
+		return s.String
+	}
+	return nil
+}
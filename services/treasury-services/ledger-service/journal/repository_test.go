@@ -0,0 +1,195 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"example.com/go-mono-repo/common/pagination"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestListJournalEntriesPageToken tests that page tokens are checked before
+// the entries are queried
+// Spec: docs/specs/005-cursor-pagination.md#validation
+func TestListJournalEntriesPageToken(t *testing.T) {
+	cursors := pagination.NewCodec("secret")
+	repo := &JournalRepository{cursors: cursors}
+	ctx := context.Background()
+
+	issued := ListJournalEntryFilters{CurrencyCode: "USD", Reference: "INV-1"}
+	token := cursors.Encode(journalEntriesCursorScope, issued.cursorFilters(), "2025-09-02T00:00:00Z", "je-1")
+
+	tests := []struct {
+		name    string
+		filters ListJournalEntryFilters
+		message string
+	}{
+		{
+			name:    "offset token",
+			filters: ListJournalEntryFilters{PageToken: "50"},
+			message: "invalid page_token",
+		},
+		{
+			name:    "changed filters",
+			filters: ListJournalEntryFilters{PageToken: token, CurrencyCode: "EUR", Reference: "INV-1"},
+			message: "page_token does not match the request filters",
+		},
+		{
+			name:    "token from another list",
+			filters: ListJournalEntryFilters{PageToken: cursors.Encode("ledger.accounts", issued.cursorFilters(), "2025-09-02T00:00:00Z", "je-1")},
+			message: "invalid page_token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, err := repo.ListJournalEntries(ctx, tt.filters)
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
+
+// TestListJournalEntriesPaging tests that entries posted while a client
+// pages do not shift later pages
+// Spec: docs/specs/005-cursor-pagination.md#keyset-queries
+func TestListJournalEntriesPaging(t *testing.T) {
+	ctx := context.Background()
+
+	opts := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPgsqlServer(false).
+		WithMetricsServer(false).
+		WithWebServer(false)
+	bs := servertest.NewBufconnServer(opts)
+	require.NoError(t, bs.Start())
+	t.Cleanup(func() { bs.Stop() })
+
+	db, err := bs.NewAuthenticatedClient(client.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.CloseSession(context.Background()) })
+
+	_, err = db.SQLExec(ctx, `
+		CREATE TABLE journal_entries (
+			id VARCHAR(36), entry_date TIMESTAMP, description VARCHAR(512), reference VARCHAR(100),
+			currency_code VARCHAR(3), status VARCHAR(20), metadata VARCHAR, created_at TIMESTAMP,
+			created_by VARCHAR(100), functional_currency VARCHAR(3), line_count INTEGER,
+			PRIMARY KEY (id))`, nil)
+	require.NoError(t, err)
+
+	insert := func(id string, entryDate time.Time) {
+		_, err := db.SQLExec(ctx, `
+			INSERT INTO journal_entries (id, entry_date, currency_code, status, created_at)
+			VALUES (@id, @entry_date, 'USD', 'POSTED', NOW())`,
+			map[string]interface{}{"id": id, "entry_date": entryDate})
+		require.NoError(t, err)
+	}
+	sept := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	for i := 1; i <= 5; i++ {
+		insert(fmt.Sprintf("je-%d", i), sept.AddDate(0, 0, i/2))
+	}
+
+	repo := NewJournalRepository(db, pagination.NewCodec("secret"))
+	filters := ListJournalEntryFilters{PageSize: 2, CurrencyCode: "USD", SkipTotalCount: true}
+
+	first, token, _, err := repo.ListJournalEntries(ctx, filters)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	// Posted after the first page was read and sorted ahead of it. An
+	// offset token would return je-5 again.
+	insert("je-9", sept.AddDate(0, 0, 9))
+
+	var ids []string
+	for _, entry := range first {
+		ids = append(ids, entry.ID)
+	}
+	for token != "" {
+		filters.PageToken = token
+		var page []*JournalEntryRow
+		page, token, _, err = repo.ListJournalEntries(ctx, filters)
+		require.NoError(t, err)
+		for _, entry := range page {
+			ids = append(ids, entry.ID)
+		}
+	}
+
+	assert.Equal(t, []string{"je-4", "je-5", "je-2", "je-3", "je-1"}, ids)
+}
+
+// TestJournalEntryAuditValues tests that the audit row keeps the full entry
+// timestamp
+// Spec: docs/specs/008-audit-log.md#json-diffs
+func TestJournalEntryAuditValues(t *testing.T) {
+	entry := &JournalEntryRow{
+		EntryDate:    time.Date(2025, 9, 1, 14, 30, 5, 250000000, time.FixedZone("CEST", 2*60*60)),
+		CurrencyCode: "USD",
+		Status:       StatusPosted,
+	}
+
+	values := journalEntryAuditValues(entry, nil)
+
+	assert.Equal(t, "2025-09-01T12:30:05.25Z", values["entry_date"])
+}
+
+// TestCheckLineSet tests that verified lines must be the entry's full line
+// set
+// Spec: docs/specs/006-verified-reads.md#line-set
+func TestCheckLineSet(t *testing.T) {
+	counted := &JournalEntryRow{ID: "je-1", LineCount: sql.NullInt64{Int64: 2, Valid: true}}
+	legacy := &JournalEntryRow{ID: "je-1"}
+	first := &JournalEntryLineRow{ID: "l1", JournalEntryID: "je-1", LineNumber: 1}
+	second := &JournalEntryLineRow{ID: "l2", JournalEntryID: "je-1", LineNumber: 2}
+	foreign := &JournalEntryLineRow{ID: "l9", JournalEntryID: "je-9", LineNumber: 2}
+
+	tests := []struct {
+		name    string
+		entry   *JournalEntryRow
+		lines   []*JournalEntryLineRow
+		message string
+	}{
+		{name: "all lines", entry: counted, lines: []*JournalEntryLineRow{first, second}},
+		{name: "legacy entry", entry: legacy, lines: []*JournalEntryLineRow{first}},
+		{
+			name:    "missing line",
+			entry:   counted,
+			lines:   []*JournalEntryLineRow{first},
+			message: "journal entry je-1 has 2 lines but 1 were found",
+		},
+		{
+			name:    "line of another entry",
+			entry:   counted,
+			lines:   []*JournalEntryLineRow{first, foreign},
+			message: "journal entry line l9 does not belong to entry je-1",
+		},
+		{
+			name:    "repeated line",
+			entry:   counted,
+			lines:   []*JournalEntryLineRow{first, first},
+			message: "journal entry line l1 was returned more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkLineSet(tt.entry, tt.lines)
+
+			if tt.message == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.DataLoss, status.Code(err))
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package journal
+
+import (
+	"context"
+	"log"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/period"
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/pkg/client"
+)
+
+// Server implements the JournalService gRPC interface
+// Spec: docs/specs/004-journal-entries.md
+type Server struct {
+	pb.UnimplementedJournalServiceServer
+	manager ManagerInterface
+}
+
+// NewServer creates a new journal server
+func NewServer(db client.ImmuClient, currencies *account.Validator, cursors *pagination.Codec, functionalCurrency string) *Server {
+	repo := NewJournalRepository(db, cursors)
+	accountRepo := account.NewAccountRepository(db, cursors)
+	validator := NewValidator()
+	periods := period.NewManager(period.NewPeriodRepository(db), currencies)
+	manager := NewManager(repo, accountRepo, validator, currencies, periods, functionalCurrency)
+
+	return &Server{
+		manager: manager,
+	}
+}
+
+// PostJournalEntry posts a balanced journal entry
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func (s *Server) PostJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*pb.PostJournalEntryResponse, error) {
+	log.Printf("Posting journal entry: currency=%s, reference=%s, lines=%d", req.CurrencyCode, req.Reference, len(req.Lines))
+
+	entry, err := s.manager.PostJournalEntry(ctx, req)
+	if err != nil {
+		log.Printf("Failed to post journal entry: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Journal entry posted successfully: id=%s", entry.Id)
+	return &pb.PostJournalEntryResponse{
+		JournalEntry: entry,
+	}, nil
+}
+
+// GetJournalEntry retrieves a journal entry by ID
+// Spec: docs/specs/004-journal-entries.md#story-2-retrieve-journal-entry
+func (s *Server) GetJournalEntry(ctx context.Context, req *pb.GetJournalEntryRequest) (*pb.GetJournalEntryResponse, error) {
+	log.Printf("Getting journal entry: id=%s, verified=%t", req.JournalEntryId, req.Verified)
+
+	if req.Verified {
+		entry, proof, err := s.manager.GetVerifiedJournalEntry(ctx, req.JournalEntryId)
+		if err != nil {
+			log.Printf("Failed to get verified journal entry: %v", err)
+			return nil, err
+		}
+
+		log.Printf("Journal entry verified: id=%s, tx=%d", entry.Id, proof.TxId)
+		return &pb.GetJournalEntryResponse{
+			JournalEntry: entry,
+			Verification: proof,
+		}, nil
+	}
+
+	entry, err := s.manager.GetJournalEntry(ctx, req.JournalEntryId)
+	if err != nil {
+		log.Printf("Failed to get journal entry: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetJournalEntryResponse{
+		JournalEntry: entry,
+	}, nil
+}
+
+// ListJournalEntries lists journal entries with filtering
+// Spec: docs/specs/004-journal-entries.md#story-3-list-journal-entries
+func (s *Server) ListJournalEntries(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
+	log.Printf("Listing journal entries: page_size=%d, currency=%s", req.PageSize, req.CurrencyCode)
+
+	resp, err := s.manager.ListJournalEntries(ctx, req)
+	if err != nil {
+		log.Printf("Failed to list journal entries: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Listed %d journal entries, total=%d", len(resp.JournalEntries), resp.TotalCount)
+	return resp, nil
+}
@@ -0,0 +1,307 @@
+package journal
+
+import (
+	"math/big"
+
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	"example.com/go-mono-repo/common/money"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Validator handles input validation for journal operations
+// Spec: docs/specs/004-journal-entries.md
+type Validator struct{}
+
+// NewValidator creates a new validator
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// ValidatePostJournalEntry validates the shape of a posting request and
+// returns the parsed line amounts. Account and currency checks that need
+// the database are done by the manager.
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func (v *Validator) ValidatePostJournalEntry(req *pb.PostJournalEntryRequest) ([]*JournalEntryLineRow, error) {
+	if req.CurrencyCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "field currency_code is required")
+	}
+
+	if len(req.Description) > 1024 {
+		return nil, status.Error(codes.InvalidArgument, "description must be 1024 characters or less")
+	}
+
+	if len(req.Reference) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "reference must be 100 characters or less")
+	}
+
+	if len(req.Lines) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "journal entry requires at least two lines")
+	}
+
+	// Spec: docs/specs/014-multi-currency.md#story-1-post-multi-currency-entries
+	if req.ExchangeRate != "" {
+		if _, err := amount.ParseRate(req.ExchangeRate); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid exchange_rate: %v", err)
+		}
+	}
+
+	lines := make([]*JournalEntryLineRow, 0, len(req.Lines))
+	for i, line := range req.Lines {
+		lineNumber := i + 1
+
+		if line.AccountId == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "line %d: field account_id is required", lineNumber)
+		}
+
+		if len(line.Description) > 1024 {
+			return nil, status.Errorf(codes.InvalidArgument, "line %d: description must be 1024 characters or less", lineNumber)
+		}
+
+		debit, err := parseLineAmount(line.DebitAmount)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "line %d: invalid debit_amount: %v", lineNumber, err)
+		}
+
+		credit, err := parseLineAmount(line.CreditAmount)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "line %d: invalid credit_amount: %v", lineNumber, err)
+		}
+
+		functionalDebit, err := parseLineAmount(line.FunctionalDebitAmount)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "line %d: invalid functional_debit_amount: %v", lineNumber, err)
+		}
+
+		functionalCredit, err := parseLineAmount(line.FunctionalCreditAmount)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "line %d: invalid functional_credit_amount: %v", lineNumber, err)
+		}
+
+		// Functional amounts are computed by the ledger, except on
+		// adjustment lines that only change the functional value of a
+		// foreign-currency account
+		// Spec: docs/specs/014-multi-currency.md#functional-adjustment-lines
+		if functionalDebit > 0 || functionalCredit > 0 {
+			if debit > 0 || credit > 0 || (functionalDebit > 0) == (functionalCredit > 0) {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"line %d: a functional adjustment line sets exactly one of functional_debit_amount or functional_credit_amount and no debit_amount or credit_amount", lineNumber)
+			}
+		} else if (debit > 0) == (credit > 0) {
+			return nil, status.Errorf(codes.InvalidArgument, "line %d: exactly one of debit_amount or credit_amount must be set", lineNumber)
+		}
+
+		row := &JournalEntryLineRow{
+			LineNumber:             int64(lineNumber),
+			AccountID:              line.AccountId,
+			CurrencyCode:           line.CurrencyCode,
+			DebitAmount:            debit,
+			CreditAmount:           credit,
+			FunctionalDebitAmount:  functionalDebit,
+			FunctionalCreditAmount: functionalCredit,
+		}
+		if line.Description != "" {
+			row.Description.String = line.Description
+			row.Description.Valid = true
+		}
+		if line.ExchangeRate != "" {
+			if _, err := amount.ParseRate(line.ExchangeRate); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "line %d: invalid exchange_rate: %v", lineNumber, err)
+			}
+			row.ExchangeRate.String = line.ExchangeRate
+			row.ExchangeRate.Valid = true
+		}
+		lines = append(lines, row)
+	}
+
+	return lines, nil
+}
+
+// ValidateMinorUnits checks that no debit or credit amount has more
+// decimal places than the minor units of its line's currency. Line
+// currencies must already be resolved from the accounts.
+// Spec: docs/specs/007-money.md#ledger-amounts
+func (v *Validator) ValidateMinorUnits(lines []*JournalEntryLineRow, currencies map[string]money.Currency) error {
+	for _, line := range lines {
+		currency := currencies[line.CurrencyCode]
+		if err := amount.CheckMinorUnits(line.DebitAmount, currency); err != nil {
+			return status.Errorf(codes.InvalidArgument, "line %d: invalid debit_amount: %v", line.LineNumber, err)
+		}
+		if err := amount.CheckMinorUnits(line.CreditAmount, currency); err != nil {
+			return status.Errorf(codes.InvalidArgument, "line %d: invalid credit_amount: %v", line.LineNumber, err)
+		}
+	}
+	return nil
+}
+
+// ValidateBalanced checks that debits equal credits for every currency
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func (v *Validator) ValidateBalanced(lines []*JournalEntryLineRow) error {
+	type totals struct{ debits, credits int64 }
+	byCurrency := make(map[string]*totals)
+	order := []string{}
+
+	for _, line := range lines {
+		t, ok := byCurrency[line.CurrencyCode]
+		if !ok {
+			t = &totals{}
+			byCurrency[line.CurrencyCode] = t
+			order = append(order, line.CurrencyCode)
+		}
+		if err := addAmount(&t.debits, line.DebitAmount); err != nil {
+			return err
+		}
+		if err := addAmount(&t.credits, line.CreditAmount); err != nil {
+			return err
+		}
+	}
+
+	for _, currency := range order {
+		t := byCurrency[currency]
+		if t.debits != t.credits {
+			return status.Errorf(codes.InvalidArgument,
+				"journal entry is unbalanced for %s: debits %s != credits %s",
+				currency, amount.Format(t.debits), amount.Format(t.credits))
+		}
+	}
+
+	return nil
+}
+
+// ConvertToFunctional sets the exchange rate and functional amounts of every
+// line. Lines in the functional currency convert at a rate of 1. Other lines
+// use their own rate or, when they are in the entry currency, entryRate.
+// Functional adjustment lines keep the functional amount they were given.
+// Line currencies must already be resolved from the accounts.
+// Spec: docs/specs/014-multi-currency.md#exchange-rates
+func (v *Validator) ConvertToFunctional(lines []*JournalEntryLineRow, entryCurrency, entryRate, functionalCurrency string) error {
+	one := big.NewRat(1, 1)
+
+	for _, line := range lines {
+		rateText := line.ExchangeRate.String
+		if rateText == "" && line.CurrencyCode == entryCurrency {
+			rateText = entryRate
+		}
+		adjustment := line.DebitAmount == 0 && line.CreditAmount == 0
+
+		switch {
+		case line.CurrencyCode == functionalCurrency:
+			if adjustment {
+				return status.Errorf(codes.InvalidArgument,
+					"line %d: functional adjustment lines must be in a currency other than the functional currency %s",
+					line.LineNumber, functionalCurrency)
+			}
+			if rateText != "" {
+				rate, err := amount.ParseRate(rateText)
+				if err != nil {
+					return status.Errorf(codes.InvalidArgument, "line %d: invalid exchange_rate: %v", line.LineNumber, err)
+				}
+				if rate.Cmp(one) != 0 {
+					return status.Errorf(codes.InvalidArgument,
+						"line %d: exchange_rate must be 1 for lines in the functional currency %s",
+						line.LineNumber, functionalCurrency)
+				}
+			}
+			line.ExchangeRate.String, line.ExchangeRate.Valid = "1", true
+			line.FunctionalDebitAmount = line.DebitAmount
+			line.FunctionalCreditAmount = line.CreditAmount
+
+		case adjustment:
+			if line.ExchangeRate.Valid {
+				return status.Errorf(codes.InvalidArgument,
+					"line %d: exchange_rate cannot be set on a functional adjustment line", line.LineNumber)
+			}
+
+		default:
+			if rateText == "" {
+				return status.Errorf(codes.InvalidArgument,
+					"line %d: exchange_rate is required for %s lines, the functional currency is %s",
+					line.LineNumber, line.CurrencyCode, functionalCurrency)
+			}
+			rate, err := amount.ParseRate(rateText)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "line %d: invalid exchange_rate: %v", line.LineNumber, err)
+			}
+			line.ExchangeRate.String, line.ExchangeRate.Valid = amount.FormatRate(rate), true
+			if line.FunctionalDebitAmount, err = amount.Convert(line.DebitAmount, rate); err != nil {
+				return status.Errorf(codes.InvalidArgument, "line %d: %v", line.LineNumber, err)
+			}
+			if line.FunctionalCreditAmount, err = amount.Convert(line.CreditAmount, rate); err != nil {
+				return status.Errorf(codes.InvalidArgument, "line %d: %v", line.LineNumber, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateFunctionalBalance checks that functional debits equal functional
+// credits. Each converted line is rounded by at most half a unit, so a
+// difference of up to half a unit per converted line is treated as rounding
+// and booked on the converted line with the largest functional amount.
+// Spec: docs/specs/014-multi-currency.md#balancing
+func (v *Validator) ValidateFunctionalBalance(lines []*JournalEntryLineRow, functionalCurrency string) error {
+	var debits, credits int64
+	var converted []*JournalEntryLineRow
+	for _, line := range lines {
+		if err := addAmount(&debits, line.FunctionalDebitAmount); err != nil {
+			return err
+		}
+		if err := addAmount(&credits, line.FunctionalCreditAmount); err != nil {
+			return err
+		}
+		if line.CurrencyCode != functionalCurrency && (line.DebitAmount > 0 || line.CreditAmount > 0) {
+			converted = append(converted, line)
+		}
+	}
+
+	diff := debits - credits
+	if diff == 0 {
+		return nil
+	}
+
+	unbalanced := status.Errorf(codes.InvalidArgument,
+		"journal entry is unbalanced in functional currency %s: debits %s != credits %s",
+		functionalCurrency, amount.Format(debits), amount.Format(credits))
+
+	tolerance := int64(len(converted) / 2)
+	if diff > tolerance || -diff > tolerance {
+		return unbalanced
+	}
+
+	largest := converted[0]
+	for _, line := range converted[1:] {
+		if line.FunctionalDebitAmount+line.FunctionalCreditAmount > largest.FunctionalDebitAmount+largest.FunctionalCreditAmount {
+			largest = line
+		}
+	}
+	if largest.FunctionalDebitAmount > 0 {
+		largest.FunctionalDebitAmount -= diff
+	} else {
+		largest.FunctionalCreditAmount += diff
+	}
+	if largest.FunctionalDebitAmount < 0 || largest.FunctionalCreditAmount < 0 {
+		return unbalanced
+	}
+
+	return nil
+}
+
+// parseLineAmount parses an optional line amount; empty means zero
+func parseLineAmount(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return amount.Parse(s)
+}
+
+// addAmount adds v to *total, rejecting int64 overflow
+func addAmount(total *int64, v int64) error {
+	sum := *total + v
+	if (v > 0 && sum < *total) || (v < 0 && sum > *total) {
+		return status.Error(codes.InvalidArgument, "journal entry total is out of range")
+	}
+	*total = sum
+	return nil
+}
@@ -0,0 +1,298 @@
+package journal
+
+import (
+	"database/sql"
+	"testing"
+
+	"example.com/go-mono-repo/common/money"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestValidatePostJournalEntry tests posting request validation
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func TestValidatePostJournalEntry(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name    string
+		req     *pb.PostJournalEntryRequest
+		wantErr string
+	}{
+		{
+			name: "valid request",
+			req: &pb.PostJournalEntryRequest{
+				CurrencyCode: "USD",
+				Lines: []*pb.JournalEntryLine{
+					{AccountId: "a", DebitAmount: "1"},
+					{AccountId: "b", CreditAmount: "1"},
+				},
+			},
+		},
+		{
+			name: "missing currency",
+			req: &pb.PostJournalEntryRequest{
+				Lines: []*pb.JournalEntryLine{
+					{AccountId: "a", DebitAmount: "1"},
+					{AccountId: "b", CreditAmount: "1"},
+				},
+			},
+			wantErr: "field currency_code is required",
+		},
+		{
+			name: "single line",
+			req: &pb.PostJournalEntryRequest{
+				CurrencyCode: "USD",
+				Lines:        []*pb.JournalEntryLine{{AccountId: "a", DebitAmount: "1"}},
+			},
+			wantErr: "at least two lines",
+		},
+		{
+			name: "missing account",
+			req: &pb.PostJournalEntryRequest{
+				CurrencyCode: "USD",
+				Lines: []*pb.JournalEntryLine{
+					{AccountId: "a", DebitAmount: "1"},
+					{CreditAmount: "1"},
+				},
+			},
+			wantErr: "line 2: field account_id is required",
+		},
+		{
+			name: "both sides set",
+			req: &pb.PostJournalEntryRequest{
+				CurrencyCode: "USD",
+				Lines: []*pb.JournalEntryLine{
+					{AccountId: "a", DebitAmount: "1", CreditAmount: "1"},
+					{AccountId: "b", CreditAmount: "1"},
+				},
+			},
+			wantErr: "line 1: exactly one of",
+		},
+		{
+			name: "zero amount",
+			req: &pb.PostJournalEntryRequest{
+				CurrencyCode: "USD",
+				Lines: []*pb.JournalEntryLine{
+					{AccountId: "a", DebitAmount: "0.00"},
+					{AccountId: "b", CreditAmount: "1"},
+				},
+			},
+			wantErr: "line 1: exactly one of",
+		},
+		{
+			name: "negative amount",
+			req: &pb.PostJournalEntryRequest{
+				CurrencyCode: "USD",
+				Lines: []*pb.JournalEntryLine{
+					{AccountId: "a", DebitAmount: "-5"},
+					{AccountId: "b", CreditAmount: "5"},
+				},
+			},
+			wantErr: "line 1: invalid debit_amount",
+		},
+		{
+			name: "too many decimals",
+			req: &pb.PostJournalEntryRequest{
+				CurrencyCode: "USD",
+				Lines: []*pb.JournalEntryLine{
+					{AccountId: "a", DebitAmount: "1"},
+					{AccountId: "b", CreditAmount: "0.12345"},
+				},
+			},
+			wantErr: "line 2: invalid credit_amount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := validator.ValidatePostJournalEntry(tt.req)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.Len(t, lines, len(tt.req.Lines))
+				return
+			}
+			assert.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+// TestValidateMinorUnits tests line amounts against their currency's
+// minor units
+// Spec: docs/specs/007-money.md#ledger-amounts
+func TestValidateMinorUnits(t *testing.T) {
+	validator := NewValidator()
+	currencies := map[string]money.Currency{
+		"USD": {Code: "USD", MinorUnits: 2},
+		"JPY": {Code: "JPY", MinorUnits: 0},
+	}
+
+	valid := []*JournalEntryLineRow{
+		{LineNumber: 1, CurrencyCode: "USD", DebitAmount: 12507500},
+		{LineNumber: 2, CurrencyCode: "JPY", CreditAmount: 150000},
+	}
+	assert.NoError(t, validator.ValidateMinorUnits(valid, currencies))
+
+	err := validator.ValidateMinorUnits([]*JournalEntryLineRow{
+		{LineNumber: 1, CurrencyCode: "USD", DebitAmount: 10000},
+		{LineNumber: 2, CurrencyCode: "JPY", CreditAmount: 15000},
+	}, currencies)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "line 2: invalid credit_amount: amount 1.5000 has more than 0 decimal places for JPY", st.Message())
+}
+
+// TestValidateBalanced tests per-currency balancing
+// Spec: docs/specs/004-journal-entries.md#story-1-post-journal-entry
+func TestValidateBalanced(t *testing.T) {
+	validator := NewValidator()
+
+	t.Run("balanced per currency", func(t *testing.T) {
+		err := validator.ValidateBalanced([]*JournalEntryLineRow{
+			{CurrencyCode: "USD", DebitAmount: 100},
+			{CurrencyCode: "EUR", DebitAmount: 50},
+			{CurrencyCode: "USD", CreditAmount: 100},
+			{CurrencyCode: "EUR", CreditAmount: 50},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("balanced overall but not per currency", func(t *testing.T) {
+		err := validator.ValidateBalanced([]*JournalEntryLineRow{
+			{CurrencyCode: "USD", DebitAmount: 100},
+			{CurrencyCode: "EUR", CreditAmount: 100},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unbalanced for USD")
+	})
+}
+
+// TestConvertToFunctional tests exchange rate resolution and conversion
+// Spec: docs/specs/014-multi-currency.md#exchange-rates
+func TestConvertToFunctional(t *testing.T) {
+	validator := NewValidator()
+	rate := func(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+
+	t.Run("entry rate applies to lines in the entry currency", func(t *testing.T) {
+		lines := []*JournalEntryLineRow{
+			{LineNumber: 1, CurrencyCode: "EUR", DebitAmount: 1000000},
+			{LineNumber: 2, CurrencyCode: "USD", CreditAmount: 1085000},
+		}
+
+		err := validator.ConvertToFunctional(lines, "EUR", "1.085", "USD")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.085", lines[0].ExchangeRate.String)
+		assert.Equal(t, int64(1085000), lines[0].FunctionalDebitAmount)
+		assert.Equal(t, "1", lines[1].ExchangeRate.String)
+		assert.Equal(t, int64(1085000), lines[1].FunctionalCreditAmount)
+	})
+
+	t.Run("line rate overrides entry rate", func(t *testing.T) {
+		lines := []*JournalEntryLineRow{
+			{LineNumber: 1, CurrencyCode: "GBP", DebitAmount: 10000, ExchangeRate: rate("1.2700")},
+		}
+
+		err := validator.ConvertToFunctional(lines, "GBP", "1.25", "USD")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.27", lines[0].ExchangeRate.String)
+		assert.Equal(t, int64(12700), lines[0].FunctionalDebitAmount)
+	})
+
+	t.Run("adjustment line keeps functional amount", func(t *testing.T) {
+		lines := []*JournalEntryLineRow{
+			{LineNumber: 1, CurrencyCode: "EUR", FunctionalDebitAmount: 500},
+		}
+
+		err := validator.ConvertToFunctional(lines, "USD", "", "USD")
+
+		assert.NoError(t, err)
+		assert.False(t, lines[0].ExchangeRate.Valid)
+		assert.Equal(t, int64(500), lines[0].FunctionalDebitAmount)
+	})
+
+	tests := []struct {
+		name    string
+		line    *JournalEntryLineRow
+		rate    string
+		wantErr string
+	}{
+		{
+			name:    "missing rate",
+			line:    &JournalEntryLineRow{LineNumber: 1, CurrencyCode: "EUR", DebitAmount: 100},
+			wantErr: "line 1: exchange_rate is required for EUR lines, the functional currency is USD",
+		},
+		{
+			name:    "functional line rate not one",
+			line:    &JournalEntryLineRow{LineNumber: 1, CurrencyCode: "USD", DebitAmount: 100, ExchangeRate: rate("1.1")},
+			wantErr: "line 1: exchange_rate must be 1 for lines in the functional currency USD",
+		},
+		{
+			name:    "functional adjustment in functional currency",
+			line:    &JournalEntryLineRow{LineNumber: 1, CurrencyCode: "USD", FunctionalDebitAmount: 100},
+			wantErr: "line 1: functional adjustment lines must be in a currency other than the functional currency USD",
+		},
+		{
+			name:    "rate on adjustment line",
+			line:    &JournalEntryLineRow{LineNumber: 1, CurrencyCode: "EUR", FunctionalDebitAmount: 100, ExchangeRate: rate("1.1")},
+			wantErr: "line 1: exchange_rate cannot be set on a functional adjustment line",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ConvertToFunctional([]*JournalEntryLineRow{tt.line}, "USD", tt.rate, "USD")
+
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, tt.wantErr, st.Message())
+		})
+	}
+}
+
+// TestValidateFunctionalBalance tests functional balancing and rounding
+// Spec: docs/specs/014-multi-currency.md#balancing
+func TestValidateFunctionalBalance(t *testing.T) {
+	validator := NewValidator()
+
+	t.Run("rounding booked on largest converted line", func(t *testing.T) {
+		lines := []*JournalEntryLineRow{
+			{CurrencyCode: "USD", DebitAmount: 200, FunctionalDebitAmount: 200},
+			{CurrencyCode: "EUR", CreditAmount: 100, FunctionalCreditAmount: 99},
+			{CurrencyCode: "EUR", CreditAmount: 90, FunctionalCreditAmount: 100},
+		}
+
+		err := validator.ValidateFunctionalBalance(lines, "USD")
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(101), lines[2].FunctionalCreditAmount)
+		assert.Equal(t, int64(99), lines[1].FunctionalCreditAmount)
+	})
+
+	t.Run("difference above rounding", func(t *testing.T) {
+		lines := []*JournalEntryLineRow{
+			{CurrencyCode: "USD", DebitAmount: 1085000, FunctionalDebitAmount: 1085000},
+			{CurrencyCode: "EUR", CreditAmount: 1000000, FunctionalCreditAmount: 1080000},
+		}
+
+		err := validator.ValidateFunctionalBalance(lines, "USD")
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "journal entry is unbalanced in functional currency USD: debits 108.5000 != credits 108.0000", st.Message())
+	})
+
+	t.Run("functional lines are never adjusted", func(t *testing.T) {
+		lines := []*JournalEntryLineRow{
+			{CurrencyCode: "USD", DebitAmount: 101, FunctionalDebitAmount: 101},
+			{CurrencyCode: "USD", CreditAmount: 100, FunctionalCreditAmount: 100},
+		}
+
+		assert.Error(t, validator.ValidateFunctionalBalance(lines, "USD"))
+	})
+}
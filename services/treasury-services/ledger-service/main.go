@@ -10,11 +10,21 @@ import (
 	"syscall"
 	"time"
 
+	"example.com/go-mono-repo/common/pagination"
 	pb "example.com/go-mono-repo/proto/ledger"
+	treasurypb "example.com/go-mono-repo/proto/treasury"
+	"example.com/go-mono-repo/common/idempotency"
 	"example.com/go-mono-repo/common/tracing"
 	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/journal"
+	"clarity/treasury-services/ledger-service/hold"
+	"clarity/treasury-services/ledger-service/period"
 	"clarity/treasury-services/ledger-service/pkg/migration"
+	"clarity/treasury-services/ledger-service/reporting"
+	"clarity/treasury-services/ledger-service/revaluation"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -140,6 +150,30 @@ func main() {
 		log.Println("ImmuDB configuration not found, running in memory-only mode")
 	}
 	
+	// Resolve currency codes through the Treasury Service
+	// The client connects lazily, so treasury being down does not block startup
+	// Spec: docs/specs/010-treasury-currency-validation.md
+	clientOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if cfg.Tracing != nil && cfg.Tracing.Enabled {
+		unaryClientInterceptor, streamClientInterceptor := tracing.NewClientInterceptors()
+		clientOpts = append(clientOpts,
+			grpc.WithUnaryInterceptor(unaryClientInterceptor),
+			grpc.WithStreamInterceptor(streamClientInterceptor),
+		)
+	}
+	treasuryConn, err := grpc.NewClient(cfg.TreasuryService.Address(), clientOpts...)
+	if err != nil {
+		log.Fatalf("Failed to create treasury service client: %v", err)
+	}
+	defer treasuryConn.Close()
+	
+	currencyValidator := account.NewTreasuryValidator(
+		treasurypb.NewCurrencyServiceClient(treasuryConn),
+		cfg.TreasuryService.CurrencyCacheTTL,
+		cfg.TreasuryService.LookupTimeout,
+	)
+	healthServer.AddDependencyChecker(NewTreasuryCurrencyChecker(currencyValidator, cfg.TreasuryService))
+	
 	// Log configuration and manifest info at startup
 	fmt.Println("=================================")
 	fmt.Println("    LEDGER SERVICE STARTING     ")
@@ -160,6 +194,8 @@ func main() {
 	if cfg.ImmuDB != nil {
 		fmt.Printf("ImmuDB: %s:%d/%s\n", cfg.ImmuDB.Host, cfg.ImmuDB.Port, cfg.ImmuDB.Database)
 	}
+	fmt.Printf("Treasury Service: %s\n", cfg.TreasuryService.Address())
+	fmt.Printf("Functional Currency: %s\n", cfg.FunctionalCurrency)
 	fmt.Println("=================================")
 	
 	lis, err := net.Listen("tcp", port)
@@ -178,18 +214,83 @@ func main() {
 		)
 	}
 	
+	// Replay retried mutations that carry an idempotency-key header
+	// Spec: docs/specs/006-idempotency-keys.md
+	if immuDBManager != nil && immuDBManager.GetClient() != nil {
+		idempotencyInterceptor := idempotency.NewInterceptor(
+			NewIdempotencyStore(immuDBManager.GetClient()),
+			time.Duration(cfg.IdempotencyKeyTTLHours)*time.Hour,
+			idempotentMethods...,
+		)
+		grpcOpts = append(grpcOpts, grpc.ChainUnaryInterceptor(idempotencyInterceptor.Unary()))
+	}
+	
 	grpcServer := grpc.NewServer(grpcOpts...)
 	pb.RegisterManifestServer(grpcServer, manifestServer)
 	pb.RegisterHealthServer(grpcServer, healthServer)
 	
+	// Page tokens are signed so clients cannot forge or edit them
+	// Spec: docs/specs/005-cursor-pagination.md
+	if cfg.PageTokenSecret == "" {
+		log.Println("Warning: PAGE_TOKEN_SECRET not set, page tokens will not survive a restart")
+	}
+	cursors := pagination.NewCodec(cfg.PageTokenSecret)
+	
 	// Register Account Service if ImmuDB is connected
 	// Spec: docs/specs/003-account-management.md
 	if immuDBManager != nil && immuDBManager.GetClient() != nil {
-		accountServer := account.NewServer(immuDBManager.GetClient())
+		accountServer := account.NewServer(immuDBManager.GetClient(), currencyValidator, cursors)
 		pb.RegisterAccountServiceServer(grpcServer, accountServer)
 		log.Println("Account management service registered")
+		
+		// Register Journal Service
+		// Spec: docs/specs/004-journal-entries.md
+		journalServer := journal.NewServer(immuDBManager.GetClient(), currencyValidator, cursors, cfg.FunctionalCurrency)
+		pb.RegisterJournalServiceServer(grpcServer, journalServer)
+		log.Println("Journal entry service registered")
+		
+		// Register Audit Service
+		// Spec: docs/specs/008-audit-log.md
+		auditServer := audit.NewServer(immuDBManager.GetClient())
+		pb.RegisterAuditServiceServer(grpcServer, auditServer)
+		log.Println("Audit service registered")
+		
+		// Register Reporting Service
+		// Spec: docs/specs/011-financial-reports.md
+		reportingServer := reporting.NewServer(immuDBManager.GetClient(), currencyValidator)
+		pb.RegisterReportingServiceServer(grpcServer, reportingServer)
+		log.Println("Reporting service registered")
+		
+		// Register Period Service
+		// Spec: docs/specs/012-accounting-periods.md
+		periodServer := period.NewServer(immuDBManager.GetClient(), currencyValidator)
+		pb.RegisterPeriodServiceServer(grpcServer, periodServer)
+		log.Println("Period service registered")
+		
+		// Register Hold Service
+		// Spec: docs/specs/013-holds.md
+		holdServer := hold.NewServer(immuDBManager.GetClient(), currencyValidator, cursors, cfg.FunctionalCurrency)
+		pb.RegisterHoldServiceServer(grpcServer, holdServer)
+		log.Println("Hold service registered")
+		
+		// Register Revaluation Service
+		// Closing rates not passed in the request are read from treasury
+		// Spec: docs/specs/014-multi-currency.md#closing-rates
+		treasuryRates := revaluation.NewTreasuryRates(
+			treasurypb.NewExchangeRateServiceClient(treasuryConn),
+			cfg.TreasuryService.LookupTimeout,
+		)
+		revaluationServer := revaluation.NewServer(immuDBManager.GetClient(), currencyValidator, cursors, treasuryRates, cfg.FunctionalCurrency)
+		pb.RegisterRevaluationServiceServer(grpcServer, revaluationServer)
+		log.Println("Revaluation service registered")
 	} else {
 		log.Println("Account management service not available (ImmuDB not connected)")
+		log.Println("Journal entry service not available (ImmuDB not connected)")
+		log.Println("Audit service not available (ImmuDB not connected)")
+		log.Println("Reporting service not available (ImmuDB not connected)")
+		log.Println("Period service not available (ImmuDB not connected)")
+		log.Println("Hold service not available (ImmuDB not connected)")
+		log.Println("Revaluation service not available (ImmuDB not connected)")
 	}
 	
 	// Mark gRPC as ready after registration
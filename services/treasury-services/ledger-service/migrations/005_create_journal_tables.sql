@@ -0,0 +1,50 @@
+-- Migration: 005_create_journal_tables
+-- Spec: docs/specs/004-journal-entries.md
+-- Description: Create journal tables for double-entry posting
+--
+-- The journal tables of migration 001 are never created, because every
+-- statement of that file begins with a comment line and the migration
+-- runner skips those. They are created here so that indexes can be added
+-- while the tables are empty and so that amounts are stored as INTEGER
+-- scaled by 10^4 (ImmuDB has no DECIMAL type). ImmuDB has no DROP TABLE
+-- IF EXISTS, so nothing is dropped first.
+;
+
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id VARCHAR(36),
+    entry_date TIMESTAMP,
+    description VARCHAR(1024),
+    reference VARCHAR(100),
+    currency_code VARCHAR(3),
+    status VARCHAR(20),
+    metadata VARCHAR,
+    created_at TIMESTAMP,
+    created_by VARCHAR(100),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS ON journal_entries(entry_date);
+
+CREATE INDEX IF NOT EXISTS ON journal_entries(reference);
+
+CREATE TABLE IF NOT EXISTS journal_entry_lines (
+    id VARCHAR(36),
+    journal_entry_id VARCHAR(36),
+    line_number INTEGER,
+    account_id VARCHAR(36),
+    currency_code VARCHAR(3),
+    debit_amount INTEGER,
+    credit_amount INTEGER,
+    description VARCHAR(1024),
+    created_at TIMESTAMP,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS ON journal_entry_lines(journal_entry_id);
+
+CREATE INDEX IF NOT EXISTS ON journal_entry_lines(account_id);
+
+-- Note: ImmuDB limitations:
+-- 1. DEFAULT values not supported - status and timestamps set in application
+-- 2. Indexes only on empty tables - created above immediately after each table
+-- 3. No foreign keys - account references are validated by the journal manager
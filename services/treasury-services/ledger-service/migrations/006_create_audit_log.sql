@@ -0,0 +1,28 @@
+-- Migration: 006_create_audit_log
+-- Spec: docs/specs/008-audit-log.md
+-- Description: Create audit_log for writes from mutating RPCs
+;
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id VARCHAR(36),
+    entity_type VARCHAR(50),
+    entity_id VARCHAR(36),
+    action VARCHAR(20),
+    old_values VARCHAR,
+    new_values VARCHAR,
+    user_id VARCHAR(100),
+    created_at TIMESTAMP,
+    metadata VARCHAR,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS ON audit_log(entity_type, entity_id);
+
+CREATE INDEX IF NOT EXISTS ON audit_log(user_id);
+
+CREATE INDEX IF NOT EXISTS ON audit_log(created_at);
+
+-- Note: ImmuDB limitations:
+-- 1. DEFAULT values not supported - id and created_at set in application
+-- 2. Indexes only on empty tables - created above immediately after the table
+-- 3. old_values and new_values hold JSON documents of the changed fields
@@ -0,0 +1,33 @@
+-- Migration: 007_add_account_status
+-- Spec: docs/specs/009-account-lifecycle.md
+-- Description: Add lifecycle status columns to accounts and seed account_statuses
+;
+
+ALTER TABLE accounts ADD COLUMN status VARCHAR(20);
+
+ALTER TABLE accounts ADD COLUMN status_reason VARCHAR(512);
+
+ALTER TABLE accounts ADD COLUMN status_changed_by VARCHAR(100);
+
+ALTER TABLE accounts ADD COLUMN status_changed_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS account_statuses (
+    code VARCHAR(20),
+    name VARCHAR(100),
+    description VARCHAR,
+    can_transact BOOLEAN,
+    created_at TIMESTAMP,
+    PRIMARY KEY (code)
+);
+
+UPSERT INTO account_statuses (code, name, description, can_transact, created_at) VALUES
+    ('ACTIVE', 'Active', 'Account is active and can receive transactions', TRUE, NOW()),
+    ('INACTIVE', 'Inactive', 'Account is temporarily inactive', FALSE, NOW()),
+    ('CLOSED', 'Closed', 'Account is permanently closed', FALSE, NOW()),
+    ('FROZEN', 'Frozen', 'Account is frozen for compliance reasons', FALSE, NOW()),
+    ('PENDING', 'Pending', 'Account is pending activation', FALSE, NOW());
+
+-- Note: ImmuDB limitations:
+-- 1. Indexes only on empty tables - status filters scan accounts
+-- 2. ALTER TABLE ADD COLUMN leaves existing rows NULL - read as ACTIVE
+-- 3. DEFAULT values not supported - status set in application
@@ -0,0 +1,42 @@
+-- Migration: 008_create_accounting_periods
+-- Spec: docs/specs/012-accounting-periods.md
+-- Description: Add accounting periods and create balance_snapshots for period close
+;
+
+CREATE TABLE IF NOT EXISTS accounting_periods (
+    id VARCHAR(36),
+    entity_id VARCHAR(255),
+    period VARCHAR(7),
+    start_date TIMESTAMP,
+    end_date TIMESTAMP,
+    status VARCHAR(20),
+    status_reason VARCHAR(512),
+    status_changed_by VARCHAR(100),
+    status_changed_at TIMESTAMP,
+    snapshot_count INTEGER,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP,
+    version INTEGER,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS ON accounting_periods(entity_id);
+
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    period_id VARCHAR(36),
+    account_id VARCHAR(36),
+    snapshot_date TIMESTAMP,
+    debit_total INTEGER,
+    credit_total INTEGER,
+    transaction_count INTEGER,
+    created_at TIMESTAMP,
+    PRIMARY KEY (period_id, account_id)
+);
+
+CREATE INDEX IF NOT EXISTS ON balance_snapshots(account_id);
+
+-- Note: ImmuDB limitations:
+-- 1. DEFAULT values not supported - status, version and timestamps set in application
+-- 2. Indexes only on empty tables - created above immediately after each table
+-- 3. debit_total and credit_total are cumulative posted totals scaled by 10^4
+-- 4. Periods without a row are OPEN
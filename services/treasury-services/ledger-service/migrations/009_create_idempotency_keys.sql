@@ -0,0 +1,20 @@
+-- Migration: 009_create_idempotency_keys
+-- Spec: docs/specs/006-idempotency-keys.md
+-- Description: Store client idempotency keys and the responses they replay
+;
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key_hash VARCHAR(64),
+    idempotency_key VARCHAR(255),
+    method VARCHAR(255),
+    request_hash VARCHAR(64),
+    status VARCHAR(20),
+    response BLOB,
+    created_at TIMESTAMP,
+    expires_at TIMESTAMP,
+    PRIMARY KEY (key_hash)
+);
+
+-- Note: ImmuDB limitations:
+-- 1. DEFAULT values not supported - status and timestamps set in application
+-- 2. Deleted rows stay in ImmuDB history, like every other change
@@ -0,0 +1,18 @@
+-- Migration: 010_create_account_external_ids
+-- Spec: docs/specs/003-account-management.md
+-- Description: Reserve external IDs so that they are unique across accounts
+;
+
+CREATE TABLE IF NOT EXISTS account_external_ids (
+    external_id_hash VARCHAR(64),
+    external_id VARCHAR(255),
+    account_id VARCHAR(36),
+    created_at TIMESTAMP,
+    PRIMARY KEY (external_id_hash)
+);
+
+-- Note: ImmuDB limitations:
+-- 1. INSERT ... SELECT not supported - accounts created before this
+--    migration have no row here and are found by CreateAccount's lookup
+--    of accounts.external_id
+-- 2. DEFAULT values not supported - created_at set in application
@@ -0,0 +1,31 @@
+-- Migration: 011_create_holds
+-- Spec: docs/specs/013-holds.md
+-- Description: Add holds that reserve funds on an account until captured or released
+;
+
+CREATE TABLE IF NOT EXISTS holds (
+    id VARCHAR(36),
+    account_id VARCHAR(36),
+    currency_code VARCHAR(3),
+    amount INTEGER,
+    captured_amount INTEGER,
+    status VARCHAR(20),
+    reference VARCHAR(100),
+    description VARCHAR(1024),
+    expires_at TIMESTAMP,
+    journal_entry_id VARCHAR(36),
+    status_reason VARCHAR(512),
+    created_at TIMESTAMP,
+    created_by VARCHAR(100),
+    settled_at TIMESTAMP,
+    settled_by VARCHAR(100),
+    updated_at TIMESTAMP,
+    version INTEGER,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS ON holds(account_id);
+
+-- Note: ImmuDB limitations:
+-- 1. DEFAULT values not supported - status, amounts and timestamps set in application
+-- 2. Indexes only on empty tables - created above immediately after the table
@@ -0,0 +1,18 @@
+-- Migration: 012_add_journal_fx_columns
+-- Spec: docs/specs/014-multi-currency.md
+-- Description: Record the functional currency amounts and exchange rate of journal lines
+;
+
+ALTER TABLE journal_entries ADD COLUMN functional_currency VARCHAR(3);
+
+ALTER TABLE journal_entry_lines ADD COLUMN exchange_rate VARCHAR(32);
+
+ALTER TABLE journal_entry_lines ADD COLUMN functional_debit_amount INTEGER;
+
+ALTER TABLE journal_entry_lines ADD COLUMN functional_credit_amount INTEGER;
+
+-- Note: ImmuDB limitations:
+-- 1. DEFAULT values not supported - rows written before this migration keep
+--    NULL; lines in the functional currency are read at a rate of 1
+-- 2. UPDATE of posted lines is avoided - the journal is append-only, so
+--    existing lines are not backfilled
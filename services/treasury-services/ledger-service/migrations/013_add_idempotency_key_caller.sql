@@ -0,0 +1,10 @@
+-- Migration: 013_add_idempotency_key_caller
+-- Spec: docs/specs/006-idempotency-keys.md
+-- Description: Scope idempotency keys to the caller identity
+;
+
+ALTER TABLE idempotency_keys ADD COLUMN caller VARCHAR(255);
+
+-- Note: ImmuDB limitations:
+-- 1. DEFAULT values not supported - rows written before this migration keep
+--    NULL in caller
@@ -0,0 +1,13 @@
+-- Migration: 014_add_journal_line_count
+-- Spec: docs/specs/006-verified-reads.md
+-- Description: Record the number of lines on each journal entry header
+;
+
+ALTER TABLE journal_entries ADD COLUMN line_count INTEGER;
+
+-- Note: ImmuDB limitations:
+-- 1. DEFAULT values not supported - entries posted before this migration
+--    keep NULL, and verified reads cannot prove that none of their lines
+--    is missing
+-- 2. UPDATE of posted entries is avoided - the journal is append-only, so
+--    existing entries are not backfilled
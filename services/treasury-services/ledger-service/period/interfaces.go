@@ -0,0 +1,23 @@
+package period
+
+import (
+	"context"
+	"time"
+
+	pb "example.com/go-mono-repo/proto/ledger"
+)
+
+// RepositoryInterface defines the interface for period repository operations
+type RepositoryInterface interface {
+	ListPeriods(ctx context.Context, entityID string) ([]*PeriodRow, error)
+	SavePeriodStatus(ctx context.Context, period *PeriodRow, change *StatusChange, periodVersions map[string]int64) (*PeriodRow, error)
+	WriteSnapshots(ctx context.Context, period *PeriodRow, previous *PeriodRow) (int64, error)
+}
+
+// ManagerInterface defines the interface for period manager operations
+type ManagerInterface interface {
+	ClosePeriod(ctx context.Context, req *pb.ClosePeriodRequest) (*pb.AccountingPeriod, error)
+	ReopenPeriod(ctx context.Context, req *pb.ReopenPeriodRequest) (*pb.AccountingPeriod, error)
+	ListPeriods(ctx context.Context, req *pb.ListPeriodsRequest) ([]*pb.AccountingPeriod, error)
+	CheckPostingDate(ctx context.Context, entityID string, entryDate time.Time, adjustment bool) error
+}
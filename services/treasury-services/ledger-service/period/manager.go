@@ -0,0 +1,351 @@
+package period
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/audit"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// periodLayout is the format of period names, one per calendar month
+const periodLayout = "2006-01"
+
+// Manager handles accounting period business logic
+// Spec: docs/specs/012-accounting-periods.md
+type Manager struct {
+	repo      RepositoryInterface
+	validator *account.Validator
+}
+
+// NewManager creates a new period manager
+func NewManager(repo RepositoryInterface, validator *account.Validator) *Manager {
+	return &Manager{
+		repo:      repo,
+		validator: validator,
+	}
+}
+
+// ClosePeriod soft-closes or closes a period. Closing writes a balance
+// snapshot for every account of the entity.
+// Spec: docs/specs/012-accounting-periods.md#story-1-close-period
+func (m *Manager) ClosePeriod(ctx context.Context, req *pb.ClosePeriodRequest) (*pb.AccountingPeriod, error) {
+	actor, err := m.validateChange(ctx, req.EntityId, req.Reason, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	periods, current, err := m.loadPeriod(ctx, req.EntityId, req.Period)
+	if err != nil {
+		return nil, err
+	}
+	latestClosed := latestClosedPeriod(periods)
+
+	target, action, verb := StatusClosed, audit.ActionClose, "close"
+	if req.Soft {
+		target, action, verb = StatusSoftClosed, audit.ActionSoftClose, "soft-close"
+	}
+
+	if current.Status == target {
+		return nil, status.Errorf(codes.FailedPrecondition, "period %s is already %s", current.Period, statusText(target))
+	}
+	if current.Status == StatusClosed {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot %s period %s in status %s", verb, current.Period, current.Status)
+	}
+	if latestClosed != nil && current.StartDate.Before(latestClosed.EndDate) {
+		return nil, status.Errorf(codes.FailedPrecondition, "period %s is before closed period %s", current.Period, latestClosed.Period)
+	}
+
+	// Periods close in order, so a closed period's snapshot can never be
+	// invalidated by a posting into an earlier open period
+	if target == StatusClosed {
+		if latestClosed != nil && !current.StartDate.Equal(latestClosed.EndDate) {
+			return nil, status.Errorf(codes.FailedPrecondition, "period %s must be closed before %s",
+				latestClosed.EndDate.Format(periodLayout), current.Period)
+		}
+		for _, p := range periods {
+			if p.Status == StatusSoftClosed && p.StartDate.Before(current.StartDate) {
+				return nil, status.Errorf(codes.FailedPrecondition, "period %s must be closed before %s", p.Period, current.Period)
+			}
+		}
+	}
+
+	change := &StatusChange{
+		Status:    target,
+		Reason:    req.Reason,
+		ChangedBy: actor,
+		Action:    action,
+	}
+	updated, err := m.repo.SavePeriodStatus(ctx, current, change, periodVersions(periods))
+	if err != nil {
+		return nil, err
+	}
+
+	// Snapshots are written once the period blocks postings. A close whose
+	// snapshots fail is undone so that it can be retried; snapshots of a
+	// period that is not CLOSED are never read.
+	// Spec: docs/specs/012-accounting-periods.md#balance-snapshots
+	if target == StatusClosed {
+		count, err := m.repo.WriteSnapshots(ctx, updated, latestClosed)
+		if err != nil {
+			return nil, m.undoClose(ctx, periods, current, updated, actor, err)
+		}
+		updated.SnapshotCount = count
+	}
+
+	return periodRowToProto(updated), nil
+}
+
+// undoClose returns a closed period whose snapshots could not be written
+// to its status before the close, and returns the snapshot failure
+// Spec: docs/specs/012-accounting-periods.md#balance-snapshots
+func (m *Manager) undoClose(ctx context.Context, periods []*PeriodRow, previous, closed *PeriodRow, actor string, cause error) error {
+	action := audit.ActionReopen
+	if previous.Status == StatusSoftClosed {
+		action = audit.ActionSoftClose
+	}
+	versions := periodVersions(periods)
+	versions[closed.ID] = closed.Version
+
+	change := &StatusChange{
+		Status:    previous.Status,
+		Reason:    "close undone: balance snapshots were not written",
+		ChangedBy: actor,
+		Action:    action,
+	}
+	if _, err := m.repo.SavePeriodStatus(ctx, closed, change, versions); err != nil {
+		return status.Errorf(codes.Internal,
+			"period %s is closed but its balance snapshots were not written (%s) and the close could not be undone: %s",
+			closed.Period, status.Convert(cause).Message(), status.Convert(err).Message())
+	}
+
+	return status.Errorf(status.Code(cause), "period %s was not closed: %s",
+		closed.Period, status.Convert(cause).Message())
+}
+
+// ReopenPeriod returns a soft-closed period, or the latest closed period,
+// to open
+// Spec: docs/specs/012-accounting-periods.md#story-3-reopen-period
+func (m *Manager) ReopenPeriod(ctx context.Context, req *pb.ReopenPeriodRequest) (*pb.AccountingPeriod, error) {
+	actor, err := m.validateChange(ctx, req.EntityId, req.Reason, req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	periods, current, err := m.loadPeriod(ctx, req.EntityId, req.Period)
+	if err != nil {
+		return nil, err
+	}
+	latestClosed := latestClosedPeriod(periods)
+
+	if current.Status == StatusOpen {
+		if latestClosed != nil && current.StartDate.Before(latestClosed.EndDate) {
+			return nil, status.Errorf(codes.FailedPrecondition, "period %s is before closed period %s", current.Period, latestClosed.Period)
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "period %s is already open", current.Period)
+	}
+	if current.Status == StatusClosed && current.ID != latestClosed.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "only the latest closed period %s can be reopened", latestClosed.Period)
+	}
+
+	change := &StatusChange{
+		Status:    StatusOpen,
+		Reason:    req.Reason,
+		ChangedBy: actor,
+		Action:    audit.ActionReopen,
+	}
+	updated, err := m.repo.SavePeriodStatus(ctx, current, change, periodVersions(periods))
+	if err != nil {
+		return nil, err
+	}
+
+	return periodRowToProto(updated), nil
+}
+
+// ListPeriods lists the stored periods of an entity, oldest first
+// Spec: docs/specs/012-accounting-periods.md#story-4-list-periods
+func (m *Manager) ListPeriods(ctx context.Context, req *pb.ListPeriodsRequest) ([]*pb.AccountingPeriod, error) {
+	if err := m.validateEntityID(req.EntityId); err != nil {
+		return nil, err
+	}
+
+	rows, err := m.repo.ListPeriods(ctx, req.EntityId)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make([]*pb.AccountingPeriod, len(rows))
+	for i, row := range rows {
+		periods[i] = periodRowToProto(row)
+	}
+	return periods, nil
+}
+
+// CheckPostingDate rejects entries dated before the end of the entity's
+// latest closed period, and entries dated in a soft-closed period unless
+// they are period adjustments
+// Spec: docs/specs/012-accounting-periods.md#story-2-block-postings
+func (m *Manager) CheckPostingDate(ctx context.Context, entityID string, entryDate time.Time, adjustment bool) error {
+	periods, err := m.repo.ListPeriods(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
+	name := entryDate.UTC().Format(periodLayout)
+	if latestClosed := latestClosedPeriod(periods); latestClosed != nil && entryDate.Before(latestClosed.EndDate) {
+		return status.Errorf(codes.FailedPrecondition, "entry date %s is before the end of closed period %s (id %s, %s to %s)",
+			entryDate.UTC().Format(time.RFC3339), latestClosed.Period, latestClosed.ID,
+			latestClosed.StartDate.UTC().Format(time.RFC3339), latestClosed.EndDate.UTC().Format(time.RFC3339))
+	}
+
+	for _, p := range periods {
+		if p.Period == name && p.Status == StatusSoftClosed && !adjustment {
+			return status.Errorf(codes.FailedPrecondition,
+				"entry date %s is in soft-closed period %s, set period_adjustment to post into it",
+				entryDate.UTC().Format(time.RFC3339), name)
+		}
+	}
+
+	return nil
+}
+
+// validateChange validates the fields shared by close and reopen requests
+// and returns the actor, defaulting to the caller identity
+func (m *Manager) validateChange(ctx context.Context, entityID, reason, actor string) (string, error) {
+	if err := m.validateEntityID(entityID); err != nil {
+		return "", err
+	}
+	if err := m.validator.ValidateStatusReason(reason); err != nil {
+		return "", err
+	}
+	if actor == "" {
+		actor = audit.UserFromContext(ctx)
+	}
+	if err := m.validator.ValidateActor(actor); err != nil {
+		return "", err
+	}
+	return actor, nil
+}
+
+// validateEntityID validates an entity ID. The empty entity holds the
+// accounts without an external_group_id.
+func (m *Manager) validateEntityID(entityID string) error {
+	if entityID == "" {
+		return nil
+	}
+	return m.validator.ValidateExternalGroupID(entityID)
+}
+
+// loadPeriod returns the stored periods of an entity and the requested
+// period. A period without a row is returned as a new OPEN period.
+func (m *Manager) loadPeriod(ctx context.Context, entityID, name string) ([]*PeriodRow, *PeriodRow, error) {
+	start, err := parsePeriod(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	periods, err := m.repo.ListPeriods(ctx, entityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, p := range periods {
+		if p.Period == name {
+			return periods, p, nil
+		}
+	}
+
+	return periods, &PeriodRow{
+		ID:        uuid.New().String(),
+		EntityID:  entityID,
+		Period:    name,
+		StartDate: start,
+		EndDate:   start.AddDate(0, 1, 0),
+		Status:    StatusOpen,
+	}, nil
+}
+
+// Helper functions
+
+// parsePeriod parses a period name into the first instant of the month
+func parsePeriod(name string) (time.Time, error) {
+	if name == "" {
+		return time.Time{}, status.Error(codes.InvalidArgument, "field period is required")
+	}
+	start, err := time.Parse(periodLayout, name)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid period %q: expected YYYY-MM", name)
+	}
+	return start, nil
+}
+
+// latestClosedPeriod returns the closed period that ends last, or nil
+func latestClosedPeriod(periods []*PeriodRow) *PeriodRow {
+	var latest *PeriodRow
+	for _, p := range periods {
+		if p.Status == StatusClosed && (latest == nil || p.EndDate.After(latest.EndDate)) {
+			latest = p
+		}
+	}
+	return latest
+}
+
+// periodVersions maps period IDs to the versions a change was checked against
+func periodVersions(periods []*PeriodRow) map[string]int64 {
+	versions := make(map[string]int64, len(periods))
+	for _, p := range periods {
+		versions[p.ID] = p.Version
+	}
+	return versions
+}
+
+// statusText returns a period status for use in error messages
+func statusText(periodStatus string) string {
+	return strings.ReplaceAll(strings.ToLower(periodStatus), "_", "-")
+}
+
+// periodRowToProto converts database row to proto message
+func periodRowToProto(row *PeriodRow) *pb.AccountingPeriod {
+	period := &pb.AccountingPeriod{
+		Id:            row.ID,
+		EntityId:      row.EntityID,
+		Period:        row.Period,
+		StartDate:     timestamppb.New(row.StartDate),
+		EndDate:       timestamppb.New(row.EndDate),
+		Status:        stringToPeriodStatusProto(row.Status),
+		SnapshotCount: int32(row.SnapshotCount),
+		Version:       row.Version,
+	}
+
+	if row.StatusReason.Valid {
+		period.StatusReason = row.StatusReason.String
+	}
+	if row.StatusChangedBy.Valid {
+		period.StatusChangedBy = row.StatusChangedBy.String
+	}
+	if row.StatusChangedAt.Valid {
+		period.StatusChangedAt = timestamppb.New(row.StatusChangedAt.Time)
+	}
+
+	return period
+}
+
+// stringToPeriodStatusProto converts string to proto enum
+func stringToPeriodStatusProto(periodStatus string) pb.PeriodStatus {
+	switch periodStatus {
+	case StatusOpen:
+		return pb.PeriodStatus_PERIOD_STATUS_OPEN
+	case StatusSoftClosed:
+		return pb.PeriodStatus_PERIOD_STATUS_SOFT_CLOSED
+	case StatusClosed:
+		return pb.PeriodStatus_PERIOD_STATUS_CLOSED
+	default:
+		return pb.PeriodStatus_PERIOD_STATUS_UNSPECIFIED
+	}
+}
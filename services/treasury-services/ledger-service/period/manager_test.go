@@ -0,0 +1,485 @@
+package period
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/audit"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockRepository is a mock implementation of PeriodRepository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListPeriods(ctx context.Context, entityID string) ([]*PeriodRow, error) {
+	args := m.Called(ctx, entityID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*PeriodRow), args.Error(1)
+}
+
+func (m *MockRepository) SavePeriodStatus(ctx context.Context, period *PeriodRow, change *StatusChange, periodVersions map[string]int64) (*PeriodRow, error) {
+	args := m.Called(ctx, period, change, periodVersions)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PeriodRow), args.Error(1)
+}
+
+func (m *MockRepository) WriteSnapshots(ctx context.Context, period *PeriodRow, previous *PeriodRow) (int64, error) {
+	args := m.Called(ctx, period, previous)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// testPeriod returns a stored period of entity-1 starting in the given month
+func testPeriod(id string, month time.Month, periodStatus string) *PeriodRow {
+	start := time.Date(2025, month, 1, 0, 0, 0, 0, time.UTC)
+	return &PeriodRow{
+		ID:        id,
+		EntityID:  "entity-1",
+		Period:    start.Format(periodLayout),
+		StartDate: start,
+		EndDate:   start.AddDate(0, 1, 0),
+		Status:    periodStatus,
+		Version:   1,
+	}
+}
+
+// savedPeriod returns the period with a status change applied
+func savedPeriod(period *PeriodRow, change *StatusChange) *PeriodRow {
+	saved := *period
+	saved.Status = change.Status
+	saved.StatusReason = sql.NullString{String: change.Reason, Valid: true}
+	saved.StatusChangedBy = sql.NullString{String: change.ChangedBy, Valid: true}
+	saved.Version++
+	return &saved
+}
+
+// TestClosePeriod tests closing and soft-closing periods
+// Spec: docs/specs/012-accounting-periods.md#story-1-close-period
+func TestClosePeriod(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("first close writes snapshots", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		repo.On("ListPeriods", ctx, "entity-1").Return([]*PeriodRow{}, nil).Once()
+		repo.On("SavePeriodStatus", ctx, mock.MatchedBy(func(p *PeriodRow) bool {
+			return p.Period == "2025-07" && p.Version == 0 &&
+				p.EndDate.Equal(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC))
+		}), &StatusChange{Status: StatusClosed, Reason: "July close", ChangedBy: "controller", Action: audit.ActionClose},
+			map[string]int64{}).
+			Return(testPeriod("p-7", time.July, StatusClosed), nil).Once()
+		repo.On("WriteSnapshots", ctx, mock.AnythingOfType("*period.PeriodRow"), (*PeriodRow)(nil)).
+			Return(int64(12), nil).Once()
+
+		result, err := manager.ClosePeriod(ctx, &pb.ClosePeriodRequest{
+			EntityId: "entity-1",
+			Period:   "2025-07",
+			Reason:   "July close",
+			Actor:    "controller",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.PeriodStatus_PERIOD_STATUS_CLOSED, result.Status)
+		assert.Equal(t, int32(12), result.SnapshotCount)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("close rolls forward from previous snapshot", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+		july := testPeriod("p-7", time.July, StatusClosed)
+		august := testPeriod("p-8", time.August, StatusSoftClosed)
+
+		repo.On("ListPeriods", ctx, "entity-1").Return([]*PeriodRow{july, august}, nil).Once()
+		repo.On("SavePeriodStatus", ctx, august, mock.AnythingOfType("*period.StatusChange"),
+			map[string]int64{"p-7": 1, "p-8": 1}).
+			Return(testPeriod("p-8", time.August, StatusClosed), nil).Once()
+		repo.On("WriteSnapshots", ctx, mock.AnythingOfType("*period.PeriodRow"), july).
+			Return(int64(3), nil).Once()
+
+		result, err := manager.ClosePeriod(ctx, &pb.ClosePeriodRequest{
+			EntityId: "entity-1",
+			Period:   "2025-08",
+			Reason:   "August close",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.PeriodStatus_PERIOD_STATUS_CLOSED, result.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("snapshot failure undoes the close", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+		july := testPeriod("p-7", time.July, StatusSoftClosed)
+		closed := testPeriod("p-7", time.July, StatusClosed)
+		closed.Version = 2
+
+		repo.On("ListPeriods", ctx, "entity-1").Return([]*PeriodRow{july}, nil).Once()
+		repo.On("SavePeriodStatus", ctx, july, mock.Anything, map[string]int64{"p-7": 1}).
+			Return(closed, nil).Once()
+		repo.On("WriteSnapshots", ctx, closed, (*PeriodRow)(nil)).
+			Return(int64(100), status.Error(codes.Internal, "failed to write balance snapshots")).Once()
+		repo.On("SavePeriodStatus", ctx, closed, &StatusChange{
+			Status:    StatusSoftClosed,
+			Reason:    "close undone: balance snapshots were not written",
+			ChangedBy: "controller",
+			Action:    audit.ActionSoftClose,
+		}, map[string]int64{"p-7": 2}).
+			Return(testPeriod("p-7", time.July, StatusSoftClosed), nil).Once()
+
+		result, err := manager.ClosePeriod(ctx, &pb.ClosePeriodRequest{
+			EntityId: "entity-1",
+			Period:   "2025-07",
+			Reason:   "July close",
+			Actor:    "controller",
+		})
+
+		assert.Nil(t, result)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, "period 2025-07 was not closed: failed to write balance snapshots", st.Message())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("snapshot failure when the close cannot be undone", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+		closed := testPeriod("p-7", time.July, StatusClosed)
+
+		repo.On("ListPeriods", ctx, "entity-1").Return([]*PeriodRow{}, nil).Once()
+		repo.On("SavePeriodStatus", ctx, mock.MatchedBy(func(p *PeriodRow) bool { return p.Version == 0 }), mock.Anything, map[string]int64{}).
+			Return(closed, nil).Once()
+		repo.On("WriteSnapshots", ctx, closed, (*PeriodRow)(nil)).
+			Return(int64(0), status.Error(codes.Internal, "failed to write balance snapshots")).Once()
+		repo.On("SavePeriodStatus", ctx, closed, mock.MatchedBy(func(c *StatusChange) bool {
+			return c.Status == StatusOpen && c.Action == audit.ActionReopen
+		}), map[string]int64{"p-7": 1}).
+			Return(nil, status.Error(codes.Aborted, "accounting periods were modified, retry")).Once()
+
+		result, err := manager.ClosePeriod(ctx, &pb.ClosePeriodRequest{
+			EntityId: "entity-1",
+			Period:   "2025-07",
+			Reason:   "July close",
+		})
+
+		assert.Nil(t, result)
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Contains(t, st.Message(), "period 2025-07 is closed but its balance snapshots were not written")
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("soft close skips snapshots", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		repo.On("ListPeriods", ctx, "").Return([]*PeriodRow{}, nil).Once()
+		repo.On("SavePeriodStatus", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				change := args.Get(2).(*StatusChange)
+				assert.Equal(t, StatusSoftClosed, change.Status)
+				assert.Equal(t, audit.ActionSoftClose, change.Action)
+				assert.Equal(t, audit.AnonymousUser, change.ChangedBy)
+			}).
+			Return(testPeriod("p-9", time.September, StatusSoftClosed), nil).Once()
+
+		result, err := manager.ClosePeriod(ctx, &pb.ClosePeriodRequest{
+			Period: "2025-09",
+			Soft:   true,
+			Reason: "Pending accruals",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.PeriodStatus_PERIOD_STATUS_SOFT_CLOSED, result.Status)
+		repo.AssertNotCalled(t, "WriteSnapshots", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	rejected := []struct {
+		name    string
+		periods []*PeriodRow
+		req     *pb.ClosePeriodRequest
+		code    codes.Code
+		message string
+	}{
+		{
+			name:    "missing period",
+			req:     &pb.ClosePeriodRequest{Reason: "close"},
+			code:    codes.InvalidArgument,
+			message: "field period is required",
+		},
+		{
+			name:    "invalid period",
+			req:     &pb.ClosePeriodRequest{Period: "2025-7", Reason: "close"},
+			code:    codes.InvalidArgument,
+			message: `invalid period "2025-7": expected YYYY-MM`,
+		},
+		{
+			name:    "missing reason",
+			req:     &pb.ClosePeriodRequest{Period: "2025-07"},
+			code:    codes.InvalidArgument,
+			message: "field reason is required",
+		},
+		{
+			name:    "invalid entity",
+			req:     &pb.ClosePeriodRequest{EntityId: "entity 1", Period: "2025-07", Reason: "close"},
+			code:    codes.InvalidArgument,
+			message: "external_group_id contains invalid characters",
+		},
+		{
+			name:    "already closed",
+			periods: []*PeriodRow{testPeriod("p-7", time.July, StatusClosed)},
+			req:     &pb.ClosePeriodRequest{EntityId: "entity-1", Period: "2025-07", Reason: "close"},
+			code:    codes.FailedPrecondition,
+			message: "period 2025-07 is already closed",
+		},
+		{
+			name:    "soft close a closed period",
+			periods: []*PeriodRow{testPeriod("p-7", time.July, StatusClosed)},
+			req:     &pb.ClosePeriodRequest{EntityId: "entity-1", Period: "2025-07", Soft: true, Reason: "close"},
+			code:    codes.FailedPrecondition,
+			message: "cannot soft-close period 2025-07 in status CLOSED",
+		},
+		{
+			name:    "before the latest closed period",
+			periods: []*PeriodRow{testPeriod("p-7", time.July, StatusClosed)},
+			req:     &pb.ClosePeriodRequest{EntityId: "entity-1", Period: "2025-06", Soft: true, Reason: "close"},
+			code:    codes.FailedPrecondition,
+			message: "period 2025-06 is before closed period 2025-07",
+		},
+		{
+			name:    "skips an open period",
+			periods: []*PeriodRow{testPeriod("p-7", time.July, StatusClosed)},
+			req:     &pb.ClosePeriodRequest{EntityId: "entity-1", Period: "2025-09", Reason: "close"},
+			code:    codes.FailedPrecondition,
+			message: "period 2025-08 must be closed before 2025-09",
+		},
+		{
+			name:    "earlier period is soft-closed",
+			periods: []*PeriodRow{testPeriod("p-8", time.August, StatusSoftClosed)},
+			req:     &pb.ClosePeriodRequest{EntityId: "entity-1", Period: "2025-09", Reason: "close"},
+			code:    codes.FailedPrecondition,
+			message: "period 2025-08 must be closed before 2025-09",
+		},
+	}
+
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			manager := NewManager(repo, account.NewValidator())
+			repo.On("ListPeriods", ctx, tt.req.EntityId).Return(tt.periods, nil).Maybe()
+
+			result, err := manager.ClosePeriod(ctx, tt.req)
+
+			assert.Nil(t, result)
+			st, _ := status.FromError(err)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+			repo.AssertNotCalled(t, "SavePeriodStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// TestReopenPeriod tests reopening periods
+// Spec: docs/specs/012-accounting-periods.md#story-3-reopen-period
+func TestReopenPeriod(t *testing.T) {
+	ctx := context.Background()
+	july := testPeriod("p-7", time.July, StatusClosed)
+	august := testPeriod("p-8", time.August, StatusClosed)
+	september := testPeriod("p-9", time.September, StatusSoftClosed)
+	periods := []*PeriodRow{july, august, september}
+
+	t.Run("reopen latest closed period", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		repo.On("ListPeriods", ctx, "entity-1").Return(periods, nil).Once()
+		repo.On("SavePeriodStatus", ctx, august, mock.AnythingOfType("*period.StatusChange"),
+			map[string]int64{"p-7": 1, "p-8": 1, "p-9": 1}).
+			Run(func(args mock.Arguments) {
+				change := args.Get(2).(*StatusChange)
+				assert.Equal(t, StatusOpen, change.Status)
+				assert.Equal(t, audit.ActionReopen, change.Action)
+			}).
+			Return(savedPeriod(august, &StatusChange{Status: StatusOpen, Reason: "Late invoice", ChangedBy: "controller"}), nil).Once()
+
+		result, err := manager.ReopenPeriod(ctx, &pb.ReopenPeriodRequest{
+			EntityId: "entity-1",
+			Period:   "2025-08",
+			Reason:   "Late invoice",
+			Actor:    "controller",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.PeriodStatus_PERIOD_STATUS_OPEN, result.Status)
+		assert.Equal(t, "Late invoice", result.StatusReason)
+		assert.Equal(t, int64(2), result.Version)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("reopen soft-closed period", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		repo.On("ListPeriods", ctx, "entity-1").Return(periods, nil).Once()
+		repo.On("SavePeriodStatus", ctx, september, mock.Anything, mock.Anything).
+			Return(savedPeriod(september, &StatusChange{Status: StatusOpen}), nil).Once()
+
+		result, err := manager.ReopenPeriod(ctx, &pb.ReopenPeriodRequest{
+			EntityId: "entity-1",
+			Period:   "2025-09",
+			Reason:   "Accruals posted",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, pb.PeriodStatus_PERIOD_STATUS_OPEN, result.Status)
+	})
+
+	rejected := []struct {
+		name    string
+		period  string
+		message string
+	}{
+		{"earlier closed period", "2025-07", "only the latest closed period 2025-08 can be reopened"},
+		{"open period", "2025-10", "period 2025-10 is already open"},
+		{"open period before lock date", "2025-06", "period 2025-06 is before closed period 2025-08"},
+	}
+
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			manager := NewManager(repo, account.NewValidator())
+			repo.On("ListPeriods", ctx, "entity-1").Return(periods, nil).Once()
+
+			result, err := manager.ReopenPeriod(ctx, &pb.ReopenPeriodRequest{
+				EntityId: "entity-1",
+				Period:   tt.period,
+				Reason:   "reopen",
+			})
+
+			assert.Nil(t, result)
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.FailedPrecondition, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+			repo.AssertNotCalled(t, "SavePeriodStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("concurrent change", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		repo.On("ListPeriods", ctx, "entity-1").Return(periods, nil).Once()
+		repo.On("SavePeriodStatus", ctx, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, status.Error(codes.Aborted, "accounting periods were modified, retry")).Once()
+
+		_, err := manager.ReopenPeriod(ctx, &pb.ReopenPeriodRequest{
+			EntityId: "entity-1",
+			Period:   "2025-09",
+			Reason:   "reopen",
+		})
+
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+}
+
+// TestListPeriods tests listing the periods of an entity
+// Spec: docs/specs/012-accounting-periods.md#story-4-list-periods
+func TestListPeriods(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lists stored periods", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+		repo.On("ListPeriods", ctx, "entity-1").
+			Return([]*PeriodRow{testPeriod("p-7", time.July, StatusClosed), testPeriod("p-8", time.August, StatusSoftClosed)}, nil).Once()
+
+		result, err := manager.ListPeriods(ctx, &pb.ListPeriodsRequest{EntityId: "entity-1"})
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "2025-07", result[0].Period)
+		assert.Equal(t, pb.PeriodStatus_PERIOD_STATUS_SOFT_CLOSED, result[1].Status)
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+		repo.On("ListPeriods", ctx, "").Return(nil, status.Error(codes.Internal, "failed to query accounting periods")).Once()
+
+		_, err := manager.ListPeriods(ctx, &pb.ListPeriodsRequest{})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+// TestCheckPostingDate tests blocking postings into closed periods
+// Spec: docs/specs/012-accounting-periods.md#story-2-block-postings
+func TestCheckPostingDate(t *testing.T) {
+	ctx := context.Background()
+	periods := []*PeriodRow{
+		testPeriod("p-7", time.July, StatusClosed),
+		testPeriod("p-8", time.August, StatusSoftClosed),
+	}
+
+	tests := []struct {
+		name       string
+		entryDate  time.Time
+		adjustment bool
+		code       codes.Code
+	}{
+		{"open period", time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC), false, codes.OK},
+		{"closed period", time.Date(2025, 7, 31, 23, 59, 59, 0, time.UTC), false, codes.FailedPrecondition},
+		{"adjustment into closed period", time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC), true, codes.FailedPrecondition},
+		{"before first closed period", time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), false, codes.FailedPrecondition},
+		{"soft-closed period", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), false, codes.FailedPrecondition},
+		{"adjustment into soft-closed period", time.Date(2025, 8, 20, 0, 0, 0, 0, time.UTC), true, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			manager := NewManager(repo, account.NewValidator())
+			repo.On("ListPeriods", ctx, "entity-1").Return(periods, nil).Once()
+
+			err := manager.CheckPostingDate(ctx, "entity-1", tt.entryDate, tt.adjustment)
+
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+
+	t.Run("reports the blocking period", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+		repo.On("ListPeriods", ctx, "entity-1").Return(periods, nil).Once()
+
+		err := manager.CheckPostingDate(ctx, "entity-1", time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC), false)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, "entry date 2025-01-15T00:00:00Z is before the end of closed period 2025-07 (id p-7, 2025-07-01T00:00:00Z to 2025-08-01T00:00:00Z)", st.Message())
+	})
+
+	t.Run("repository failure", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+		repo.On("ListPeriods", ctx, "entity-1").Return(nil, errors.New("connection refused")).Once()
+
+		err := manager.CheckPostingDate(ctx, "entity-1", time.Now(), false)
+
+		assert.Error(t, err)
+	})
+}
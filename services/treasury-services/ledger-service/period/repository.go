@@ -0,0 +1,395 @@
+package period
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"time"
+
+	"clarity/treasury-services/ledger-service/audit"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Period statuses as stored in accounting_periods.status
+const (
+	StatusOpen       = "OPEN"
+	StatusSoftClosed = "SOFT_CLOSED"
+	StatusClosed     = "CLOSED"
+)
+
+// snapshotBatchSize limits the snapshot rows written per ImmuDB transaction
+// so that large entities stay below the server's entries per tx limit
+const snapshotBatchSize = 100
+
+// PeriodRepository handles database operations for accounting periods and
+// balance snapshots
+// Spec: docs/specs/012-accounting-periods.md
+type PeriodRepository struct {
+	db client.ImmuClient
+}
+
+// NewPeriodRepository creates a new period repository
+func NewPeriodRepository(db client.ImmuClient) *PeriodRepository {
+	return &PeriodRepository{
+		db: db,
+	}
+}
+
+// PeriodRow represents a database row for an accounting period
+type PeriodRow struct {
+	ID              string
+	EntityID        string
+	Period          string
+	StartDate       time.Time
+	EndDate         time.Time
+	Status          string
+	StatusReason    sql.NullString
+	StatusChangedBy sql.NullString
+	StatusChangedAt sql.NullTime
+	SnapshotCount   int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Version         int64 // 0 for a period that has no row yet
+}
+
+// StatusChange describes a period status change applied by SavePeriodStatus
+type StatusChange struct {
+	Status    string // Target status
+	Reason    string // Why the status changed
+	ChangedBy string // Actor requesting the change
+	Action    string // Audit action recorded for the change
+}
+
+// ListPeriods returns the stored periods of an entity, oldest first
+// Spec: docs/specs/012-accounting-periods.md#story-4-list-periods
+func (r *PeriodRepository) ListPeriods(ctx context.Context, entityID string) ([]*PeriodRow, error) {
+	result, err := r.db.SQLQuery(ctx, selectPeriodsQuery, map[string]interface{}{"entity_id": entityID}, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query accounting periods: %v", err)
+	}
+
+	periods := make([]*PeriodRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		periods = append(periods, parsePeriodRow(row))
+	}
+	sort.Slice(periods, func(i, j int) bool { return periods[i].StartDate.Before(periods[j].StartDate) })
+
+	return periods, nil
+}
+
+// SavePeriodStatus inserts or updates a period with a new status and records
+// the change in the audit log. The entity's periods are re-read inside the
+// transaction and must still have the versions the change was checked
+// against, so two concurrent changes to the same entity cannot both commit.
+// Spec: docs/specs/012-accounting-periods.md#period-rules
+func (r *PeriodRepository) SavePeriodStatus(ctx context.Context, period *PeriodRow, change *StatusChange, periodVersions map[string]int64) (*PeriodRow, error) {
+	tx, err := r.db.NewTx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+
+	current, err := tx.SQLQuery(ctx, selectPeriodsQuery, map[string]interface{}{"entity_id": period.EntityID})
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to query accounting periods: %v", err)
+	}
+	if len(current.Rows) != len(periodVersions) {
+		tx.Rollback(ctx)
+		return nil, status.Error(codes.Aborted, "accounting periods were modified, retry")
+	}
+	for _, row := range current.Rows {
+		stored := parsePeriodRow(row)
+		if version, found := periodVersions[stored.ID]; !found || version != stored.Version {
+			tx.Rollback(ctx)
+			return nil, status.Error(codes.Aborted, "accounting periods were modified, retry")
+		}
+	}
+
+	now := time.Now()
+	updated := *period
+	updated.Status = change.Status
+	updated.StatusReason = sql.NullString{String: change.Reason, Valid: true}
+	updated.StatusChangedBy = sql.NullString{String: change.ChangedBy, Valid: true}
+	updated.StatusChangedAt = sql.NullTime{Time: now, Valid: true}
+	updated.UpdatedAt = now
+	updated.Version = period.Version + 1
+
+	params := map[string]interface{}{
+		"id":                updated.ID,
+		"entity_id":         updated.EntityID,
+		"period":            updated.Period,
+		"start_date":        updated.StartDate,
+		"end_date":          updated.EndDate,
+		"status":            updated.Status,
+		"status_reason":     change.Reason,
+		"status_changed_by": change.ChangedBy,
+		"status_changed_at": now,
+		"snapshot_count":    updated.SnapshotCount,
+		"updated_at":        now,
+		"version":           period.Version,
+		"new_version":       updated.Version,
+	}
+
+	var oldValues map[string]interface{}
+	var query string
+	if period.Version == 0 {
+		updated.CreatedAt = now
+		params["created_at"] = now
+		query = `
+			INSERT INTO accounting_periods (
+				id, entity_id, period, start_date, end_date, status,
+				status_reason, status_changed_by, status_changed_at,
+				snapshot_count, created_at, updated_at, version
+			) VALUES (
+				@id, @entity_id, @period, @start_date, @end_date, @status,
+				@status_reason, @status_changed_by, @status_changed_at,
+				@snapshot_count, @created_at, @updated_at, @new_version
+			)`
+	} else {
+		oldValues = periodAuditValues(period)
+		query = `
+			UPDATE accounting_periods
+			SET status = @status, status_reason = @status_reason,
+				status_changed_by = @status_changed_by, status_changed_at = @status_changed_at,
+				version = @new_version, updated_at = @updated_at
+			WHERE id = @id AND version = @version`
+	}
+
+	if err := tx.SQLExec(ctx, query, params); err != nil {
+		tx.Rollback(ctx)
+		return nil, status.Errorf(codes.Internal, "failed to save accounting period: %v", err)
+	}
+
+	// Spec: docs/specs/008-audit-log.md#story-1-audit-mutating-rpcs
+	event := &audit.Event{
+		EntityType: audit.EntityAccountingPeriod,
+		EntityID:   updated.ID,
+		Action:     change.Action,
+		OldValues:  oldValues,
+		NewValues:  periodAuditValues(&updated),
+	}
+	if err := audit.Write(ctx, tx, event); err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+
+	if _, err := tx.Commit(ctx); err != nil {
+		// A concurrent change to the entity's periods fails the commit
+		if strings.Contains(err.Error(), "conflict") {
+			return nil, status.Error(codes.Aborted, "accounting periods were modified, retry")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to save accounting period: %v", err)
+	}
+
+	return &updated, nil
+}
+
+// snapshotTotals are cumulative posted totals of one account.
+// Amounts are scaled by 10^amount.Scale.
+type snapshotTotals struct {
+	debitTotal  int64
+	creditTotal int64
+	lineCount   int64
+}
+
+// WriteSnapshots writes a balance snapshot for every account of the
+// period's entity holding its posted totals for entries dated before the
+// period end. When the previous period is closed, its snapshots are rolled
+// forward with the period's own lines instead of summing all history.
+// Accounts without a previous snapshot, because that set was only partly
+// written or because the account moved to the entity since, are summed
+// over all history. Snapshots are written in batches after the period is
+// closed. A balance read that finds no snapshot for the period falls back
+// to an earlier one, so a partly written set left by a failed close that
+// could not be undone is never wrong, only slower.
+// Spec: docs/specs/012-accounting-periods.md#balance-snapshots
+func (r *PeriodRepository) WriteSnapshots(ctx context.Context, period *PeriodRow, previous *PeriodRow) (int64, error) {
+	accountQuery := "SELECT id FROM accounts WHERE external_group_id = @entity_id"
+	if period.EntityID == "" {
+		accountQuery = "SELECT id FROM accounts WHERE external_group_id IS NULL"
+	}
+	accountResult, err := r.db.SQLQuery(ctx, accountQuery, map[string]interface{}{"entity_id": period.EntityID}, false)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to query entity accounts: %v", err)
+	}
+
+	totals := make(map[string]*snapshotTotals, len(accountResult.Rows))
+	for _, row := range accountResult.Rows {
+		totals[row.Values[0].GetS()] = &snapshotTotals{}
+	}
+
+	// rolled holds the accounts whose totals start from the previous snapshot
+	rolled := map[string]bool{}
+	params := map[string]interface{}{"end_date": period.EndDate}
+	if previous != nil {
+		snapshotResult, err := r.db.SQLQuery(ctx, `
+			SELECT account_id, debit_total, credit_total, transaction_count
+			FROM balance_snapshots
+			WHERE period_id = @period_id`,
+			map[string]interface{}{"period_id": previous.ID}, false)
+		if err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to query balance snapshots: %v", err)
+		}
+		for _, row := range snapshotResult.Rows {
+			if t, found := totals[row.Values[0].GetS()]; found {
+				t.debitTotal = row.Values[1].GetN()
+				t.creditTotal = row.Values[2].GetN()
+				t.lineCount = row.Values[3].GetN()
+				rolled[row.Values[0].GetS()] = true
+			}
+		}
+
+		params["start_date"] = period.StartDate
+		err = r.addLineTotals(ctx, totals, "e.entry_date >= @start_date AND e.entry_date < @end_date", params,
+			func(accountID string) bool { return rolled[accountID] })
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if len(rolled) < len(totals) {
+		err = r.addLineTotals(ctx, totals, "e.entry_date < @end_date", params,
+			func(accountID string) bool { return !rolled[accountID] })
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	accountIDs := make([]string, 0, len(totals))
+	for accountID := range totals {
+		accountIDs = append(accountIDs, accountID)
+	}
+	sort.Strings(accountIDs)
+
+	now := time.Now()
+	insertQuery := `
+		UPSERT INTO balance_snapshots (
+			period_id, account_id, snapshot_date, debit_total,
+			credit_total, transaction_count, created_at
+		) VALUES (
+			@period_id, @account_id, @snapshot_date, @debit_total,
+			@credit_total, @transaction_count, @created_at
+		)`
+
+	var written int64
+	for start := 0; start < len(accountIDs); start += snapshotBatchSize {
+		end := start + snapshotBatchSize
+		if end > len(accountIDs) {
+			end = len(accountIDs)
+		}
+
+		tx, err := r.db.NewTx(ctx)
+		if err != nil {
+			return written, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+		}
+		for _, accountID := range accountIDs[start:end] {
+			t := totals[accountID]
+			err := tx.SQLExec(ctx, insertQuery, map[string]interface{}{
+				"period_id":         period.ID,
+				"account_id":        accountID,
+				"snapshot_date":     period.EndDate,
+				"debit_total":       t.debitTotal,
+				"credit_total":      t.creditTotal,
+				"transaction_count": t.lineCount,
+				"created_at":        now,
+			})
+			if err != nil {
+				tx.Rollback(ctx)
+				return written, status.Errorf(codes.Internal, "failed to write balance snapshot: %v", err)
+			}
+		}
+		if _, err := tx.Commit(ctx); err != nil {
+			return written, status.Errorf(codes.Internal, "failed to write balance snapshots: %v", err)
+		}
+		written += int64(end - start)
+	}
+
+	// Record the count without a version bump. A reopen in the meantime
+	// changed the version and leaves the count of the new status alone.
+	_, err = r.db.SQLExec(ctx, `
+		UPDATE accounting_periods SET snapshot_count = @snapshot_count
+		WHERE id = @id AND version = @version`,
+		map[string]interface{}{"id": period.ID, "version": period.Version, "snapshot_count": written})
+	if err != nil {
+		return written, status.Errorf(codes.Internal, "failed to record snapshot count: %v", err)
+	}
+
+	return written, nil
+}
+
+// addLineTotals adds the posted lines of entries matching dateClause to
+// the totals of the accounts selected by include
+func (r *PeriodRepository) addLineTotals(ctx context.Context, totals map[string]*snapshotTotals, dateClause string, params map[string]interface{}, include func(accountID string) bool) error {
+	lineResult, err := r.db.SQLQuery(ctx, `
+		SELECT l.account_id, COUNT(*), SUM(l.debit_amount), SUM(l.credit_amount)
+		FROM journal_entry_lines AS l
+		INNER JOIN journal_entries AS e ON l.journal_entry_id = e.id
+		WHERE `+dateClause+`
+		GROUP BY l.account_id`,
+		params, false)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to query period totals: %v", err)
+	}
+	for _, row := range lineResult.Rows {
+		accountID := row.Values[0].GetS()
+		if t, found := totals[accountID]; found && include(accountID) {
+			t.lineCount += row.Values[1].GetN()
+			t.debitTotal += row.Values[2].GetN()
+			t.creditTotal += row.Values[3].GetN()
+		}
+	}
+	return nil
+}
+
+// selectPeriodsQuery selects every period of an entity
+const selectPeriodsQuery = `
+	SELECT
+		id, entity_id, period, start_date, end_date, status,
+		status_reason, status_changed_by, status_changed_at,
+		snapshot_count, created_at, updated_at, version
+	FROM accounting_periods
+	WHERE entity_id = @entity_id`
+
+// parsePeriodRow converts a selected accounting_periods row into a PeriodRow
+func parsePeriodRow(row *schema.Row) *PeriodRow {
+	period := &PeriodRow{
+		ID:            row.Values[0].GetS(),
+		EntityID:      row.Values[1].GetS(),
+		Period:        row.Values[2].GetS(),
+		StartDate:     time.UnixMicro(row.Values[3].GetTs()).UTC(),
+		EndDate:       time.UnixMicro(row.Values[4].GetTs()).UTC(),
+		Status:        row.Values[5].GetS(),
+		SnapshotCount: row.Values[9].GetN(),
+		CreatedAt:     time.UnixMicro(row.Values[10].GetTs()),
+		UpdatedAt:     time.UnixMicro(row.Values[11].GetTs()),
+		Version:       row.Values[12].GetN(),
+	}
+
+	if s := row.Values[6].GetS(); s != "" {
+		period.StatusReason = sql.NullString{String: s, Valid: true}
+	}
+	if s := row.Values[7].GetS(); s != "" {
+		period.StatusChangedBy = sql.NullString{String: s, Valid: true}
+	}
+	if ts := row.Values[8].GetTs(); ts != 0 {
+		period.StatusChangedAt = sql.NullTime{Time: time.UnixMicro(ts), Valid: true}
+	}
+
+	return period
+}
+
+// periodAuditValues returns the audited fields of a period keyed by proto
+// field name
+func periodAuditValues(period *PeriodRow) map[string]interface{} {
+	return map[string]interface{}{
+		"entity_id":         period.EntityID,
+		"period":            period.Period,
+		"status":            period.Status,
+		"status_reason":     period.StatusReason.String,
+		"status_changed_by": period.StatusChangedBy.String,
+	}
+}
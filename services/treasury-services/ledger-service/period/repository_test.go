@@ -0,0 +1,118 @@
+package period
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSchema creates the tables read and written by WriteSnapshots
+var testSchema = []string{
+	`CREATE TABLE accounts (
+		id VARCHAR(36), name VARCHAR(255), external_group_id VARCHAR(255),
+		PRIMARY KEY (id))`,
+	`CREATE TABLE journal_entries (
+		id VARCHAR(36), entry_date TIMESTAMP,
+		PRIMARY KEY (id))`,
+	`CREATE TABLE journal_entry_lines (
+		id VARCHAR(36), journal_entry_id VARCHAR(36), account_id VARCHAR(36),
+		debit_amount INTEGER, credit_amount INTEGER,
+		PRIMARY KEY (id))`,
+	`CREATE TABLE accounting_periods (
+		id VARCHAR(36), entity_id VARCHAR(255), snapshot_count INTEGER, version INTEGER,
+		PRIMARY KEY (id))`,
+	`CREATE TABLE balance_snapshots (
+		period_id VARCHAR(36), account_id VARCHAR(36), snapshot_date TIMESTAMP, debit_total INTEGER,
+		credit_total INTEGER, transaction_count INTEGER, created_at TIMESTAMP,
+		PRIMARY KEY (period_id, account_id))`,
+}
+
+// newTestRepository returns a repository backed by an in-process ImmuDB
+func newTestRepository(t *testing.T) (*PeriodRepository, client.ImmuClient) {
+	t.Helper()
+
+	opts := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPgsqlServer(false).
+		WithMetricsServer(false).
+		WithWebServer(false)
+	bs := servertest.NewBufconnServer(opts)
+	require.NoError(t, bs.Start())
+	t.Cleanup(func() { bs.Stop() })
+
+	db, err := bs.NewAuthenticatedClient(client.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.CloseSession(context.Background()) })
+
+	for _, stmt := range testSchema {
+		_, err := db.SQLExec(context.Background(), stmt, nil)
+		require.NoError(t, err)
+	}
+
+	return NewPeriodRepository(db), db
+}
+
+// TestWriteSnapshotsMissingPreviousSnapshot tests that an account without
+// a snapshot in the previous period is summed over all history instead of
+// starting from zero
+// Spec: docs/specs/012-accounting-periods.md#balance-snapshots
+func TestWriteSnapshotsMissingPreviousSnapshot(t *testing.T) {
+	ctx := context.Background()
+	repo, db := newTestRepository(t)
+
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	exec := func(stmt string, params map[string]interface{}) {
+		t.Helper()
+		_, err := db.SQLExec(ctx, stmt, params)
+		require.NoError(t, err)
+	}
+	exec(`INSERT INTO accounts (id, name, external_group_id) VALUES
+		('acc-rolled', 'Operating Cash', 'entity-1'),
+		('acc-moved', 'Petty Cash', 'entity-1'),
+		('acc-other', 'Other Entity Cash', 'entity-2')`, nil)
+	exec(`INSERT INTO journal_entries (id, entry_date) VALUES ('je-jan', @jan), ('je-feb', @feb)`,
+		map[string]interface{}{"jan": jan.AddDate(0, 0, 14), "feb": feb.AddDate(0, 0, 14)})
+	exec(`INSERT INTO journal_entry_lines (id, journal_entry_id, account_id, debit_amount, credit_amount) VALUES
+		('l1', 'je-jan', 'acc-rolled', 1000000, 0),
+		('l2', 'je-jan', 'acc-moved', 500000, 0),
+		('l3', 'je-jan', 'acc-other', 0, 1500000),
+		('l4', 'je-feb', 'acc-rolled', 100000, 0),
+		('l5', 'je-feb', 'acc-moved', 0, 50000),
+		('l6', 'je-feb', 'acc-other', 0, 50000)`, nil)
+
+	// The January set holds acc-rolled only: acc-moved was in another
+	// entity when January closed
+	exec(`INSERT INTO balance_snapshots (period_id, account_id, snapshot_date, debit_total, credit_total, transaction_count, created_at)
+		VALUES ('period-jan', 'acc-rolled', @feb, 1000000, 0, 1, NOW())`,
+		map[string]interface{}{"feb": feb})
+
+	previous := &PeriodRow{ID: "period-jan", EntityID: "entity-1", StartDate: jan, EndDate: feb, Version: 2}
+	period := &PeriodRow{ID: "period-feb", EntityID: "entity-1", StartDate: feb, EndDate: mar, Version: 2}
+
+	count, err := repo.WriteSnapshots(ctx, period, previous)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	result, err := db.SQLQuery(ctx, `
+		SELECT account_id, debit_total, credit_total, transaction_count
+		FROM balance_snapshots WHERE period_id = 'period-feb'`, nil, false)
+	require.NoError(t, err)
+
+	got := map[string][3]int64{}
+	for _, row := range result.Rows {
+		got[row.Values[0].GetS()] = [3]int64{row.Values[1].GetN(), row.Values[2].GetN(), row.Values[3].GetN()}
+	}
+	assert.Equal(t, map[string][3]int64{
+		"acc-rolled": {1100000, 0, 2},
+		"acc-moved":  {500000, 50000, 2},
+	}, got)
+}
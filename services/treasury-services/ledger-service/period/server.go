@@ -0,0 +1,78 @@
+package period
+
+import (
+	"context"
+	"log"
+
+	"clarity/treasury-services/ledger-service/account"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/pkg/client"
+)
+
+// Server implements the PeriodService gRPC interface
+// Spec: docs/specs/012-accounting-periods.md
+type Server struct {
+	pb.UnimplementedPeriodServiceServer
+	manager ManagerInterface
+}
+
+// NewServer creates a new period server
+func NewServer(db client.ImmuClient, validator *account.Validator) *Server {
+	repo := NewPeriodRepository(db)
+	manager := NewManager(repo, validator)
+
+	return &Server{
+		manager: manager,
+	}
+}
+
+// ClosePeriod soft-closes or closes an accounting period
+// Spec: docs/specs/012-accounting-periods.md#story-1-close-period
+func (s *Server) ClosePeriod(ctx context.Context, req *pb.ClosePeriodRequest) (*pb.ClosePeriodResponse, error) {
+	log.Printf("Closing period: entity_id=%s, period=%s, soft=%t", req.EntityId, req.Period, req.Soft)
+
+	period, err := s.manager.ClosePeriod(ctx, req)
+	if err != nil {
+		log.Printf("Failed to close period: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Period %s is %s with %d balance snapshots", period.Period, period.Status, period.SnapshotCount)
+	return &pb.ClosePeriodResponse{
+		Period: period,
+	}, nil
+}
+
+// ReopenPeriod reopens an accounting period
+// Spec: docs/specs/012-accounting-periods.md#story-3-reopen-period
+func (s *Server) ReopenPeriod(ctx context.Context, req *pb.ReopenPeriodRequest) (*pb.ReopenPeriodResponse, error) {
+	log.Printf("Reopening period: entity_id=%s, period=%s", req.EntityId, req.Period)
+
+	period, err := s.manager.ReopenPeriod(ctx, req)
+	if err != nil {
+		log.Printf("Failed to reopen period: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Period %s reopened", period.Period)
+	return &pb.ReopenPeriodResponse{
+		Period: period,
+	}, nil
+}
+
+// ListPeriods lists the accounting periods of an entity
+// Spec: docs/specs/012-accounting-periods.md#story-4-list-periods
+func (s *Server) ListPeriods(ctx context.Context, req *pb.ListPeriodsRequest) (*pb.ListPeriodsResponse, error) {
+	log.Printf("Listing periods: entity_id=%s", req.EntityId)
+
+	periods, err := s.manager.ListPeriods(ctx, req)
+	if err != nil {
+		log.Printf("Failed to list periods: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Found %d periods", len(periods))
+	return &pb.ListPeriodsResponse{
+		Periods: periods,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package amount
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"example.com/go-mono-repo/common/money"
+)
+
+// Scale is the number of decimal places stored for ledger amounts.
+// Amounts are persisted as int64 values multiplied by 10^Scale because
+// ImmuDB has no DECIMAL column type. Parsing, formatting and conversion
+// go through common/money.
+// Spec: docs/specs/004-journal-entries.md#database-schema
+const Scale = 4
+
+// Parse converts a decimal string such as "1250.75" into a scaled int64.
+// Negative values, exponents and more than Scale decimal places are rejected.
+// Spec: docs/specs/007-money.md#ledger-amounts
+func Parse(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("amount is empty")
+	}
+	if strings.HasPrefix(s, "-") {
+		return 0, fmt.Errorf("amount must not be negative")
+	}
+
+	m, err := money.Parse(s, "")
+	if err != nil {
+		return 0, err
+	}
+	if m.Scale() > Scale {
+		return 0, fmt.Errorf("amount %q has more than %d decimal places", s, Scale)
+	}
+	units, err := m.Units(Scale)
+	if err != nil {
+		return 0, fmt.Errorf("amount %q is out of range", s)
+	}
+	return units, nil
+}
+
+// Format converts a scaled int64 back into a decimal string with exactly
+// Scale decimal places, e.g. 12507500 -> "1250.7500".
+func Format(units int64) string {
+	return money.New(units, Scale, "").Amount()
+}
+
+// CheckMinorUnits returns an error when a scaled amount has more decimal
+// places than the minor units of currency c, e.g. 0.001 USD or 1.5 JPY
+// Spec: docs/specs/007-money.md#ledger-amounts
+func CheckMinorUnits(units int64, c money.Currency) error {
+	if c.MinorUnits >= Scale {
+		return nil
+	}
+	if _, err := money.New(units, Scale, c.Code).Units(c.MinorUnits); err != nil {
+		return fmt.Errorf("amount %s has more than %d decimal places for %s", Format(units), c.MinorUnits, c.Code)
+	}
+	return nil
+}
+
+// RateScale is the maximum number of decimal places of an exchange rate
+// Spec: docs/specs/014-multi-currency.md#exchange-rates
+const RateScale = 12
+
+// ParseRate parses a positive decimal exchange rate such as "1.0834" into
+// an exact rational. Signs, exponents and more than RateScale decimal
+// places are rejected.
+func ParseRate(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("rate is empty")
+	}
+
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if (whole == "" && frac == "") || (hasPoint && frac == "") || !isDigits(whole) || !isDigits(frac) {
+		return nil, fmt.Errorf("rate %q is not a decimal number", s)
+	}
+	if len(frac) > RateScale {
+		return nil, fmt.Errorf("rate %q has more than %d decimal places", s, RateScale)
+	}
+	if len(whole)+len(frac) > 30 {
+		return nil, fmt.Errorf("rate %q is out of range", s)
+	}
+
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("rate %q is not a decimal number", s)
+	}
+	if rate.Sign() <= 0 {
+		return nil, fmt.Errorf("rate must be positive")
+	}
+	return rate, nil
+}
+
+// FormatRate formats an exchange rate with at most RateScale decimal
+// places and no trailing zeros, e.g. 1.08340 -> "1.0834"
+func FormatRate(rate *big.Rat) string {
+	s := rate.FloatString(RateScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// Convert multiplies a scaled amount by an exchange rate and rounds the
+// result half to even at Scale decimal places
+// Spec: docs/specs/014-multi-currency.md#exchange-rates
+func Convert(units int64, rate *big.Rat) (int64, error) {
+	converted, err := money.New(units, Scale, "").Convert(rate, "", Scale, money.HalfEven).Units(Scale)
+	if err != nil {
+		return 0, fmt.Errorf("converted amount is out of range")
+	}
+	return converted, nil
+}
+
+// isDigits reports whether s consists only of ASCII digits
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
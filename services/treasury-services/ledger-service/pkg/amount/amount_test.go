@@ -0,0 +1,151 @@
+package amount
+
+import (
+	"math"
+	"testing"
+
+	"example.com/go-mono-repo/common/money"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParse tests decimal string parsing
+// Spec: docs/specs/004-journal-entries.md#database-schema
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int64
+		wantErr  bool
+	}{
+		{"whole number", "100", 1000000, false},
+		{"two decimals", "1250.75", 12507500, false},
+		{"four decimals", "0.0001", 1, false},
+		{"leading point", ".5", 5000, false},
+		{"plus sign", "+10", 100000, false},
+		{"zero", "0", 0, false},
+		{"empty", "", 0, true},
+		{"negative", "-1", 0, true},
+		{"too many decimals", "1.00001", 0, true},
+		{"trailing point", "1.", 0, true},
+		{"letters", "12a", 0, true},
+		{"exponent", "1e5", 0, true},
+		{"lone point", ".", 0, true},
+		{"out of range", "922337203685478", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+// TestCheckMinorUnits tests amounts against a currency's minor units
+// Spec: docs/specs/007-money.md#ledger-amounts
+func TestCheckMinorUnits(t *testing.T) {
+	usd := money.Currency{Code: "USD", MinorUnits: 2}
+	jpy := money.Currency{Code: "JPY", MinorUnits: 0}
+	btc := money.Currency{Code: "BTC", MinorUnits: 8}
+
+	assert.NoError(t, CheckMinorUnits(12507500, usd))
+	assert.NoError(t, CheckMinorUnits(0, usd))
+	assert.NoError(t, CheckMinorUnits(150000, jpy))
+	assert.NoError(t, CheckMinorUnits(1, btc))
+
+	err := CheckMinorUnits(10, usd)
+	assert.EqualError(t, err, "amount 0.0010 has more than 2 decimal places for USD")
+	assert.Error(t, CheckMinorUnits(15000, jpy))
+}
+
+// TestFormat tests scaled amount formatting
+// Spec: docs/specs/004-journal-entries.md#database-schema
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "0.0000", Format(0))
+	assert.Equal(t, "1250.7500", Format(12507500))
+	assert.Equal(t, "0.0001", Format(1))
+	assert.Equal(t, "-12.5000", Format(-125000))
+	assert.Equal(t, "-922337203685477.5808", Format(math.MinInt64))
+
+	// Round trip
+	units, err := Parse(Format(987654321))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(987654321), units)
+}
+
+// TestParseRate tests exchange rate parsing
+// Spec: docs/specs/014-multi-currency.md#exchange-rates
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{"four decimals", "1.0834", "1.0834", false},
+		{"twelve decimals", "0.000123456789", "0.000123456789", false},
+		{"whole number", "150", "150", false},
+		{"trailing zeros", "1.50000", "1.5", false},
+		{"leading point", ".85", "0.85", false},
+		{"zero", "0", "", true},
+		{"negative", "-1.2", "", true},
+		{"plus sign", "+1.2", "", true},
+		{"too many decimals", "1.0000000000001", "", true},
+		{"exponent", "1e3", "", true},
+		{"fraction", "1/3", "", true},
+		{"empty", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, FormatRate(rate))
+		})
+	}
+}
+
+// TestConvert tests rounding of converted amounts
+// Spec: docs/specs/014-multi-currency.md#exchange-rates
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		units    int64
+		rate     string
+		expected int64
+	}{
+		{"exact", 1000000, "1.0834", 1083400},
+		{"round down", 1, "1.4", 1},
+		{"round up", 1, "1.6", 2},
+		{"half to even down", 5, "0.5", 2},
+		{"half to even up", 15, "0.5", 8},
+		{"negative half to even", -5, "0.5", -2},
+		{"negative round away", -3, "0.5", -2},
+		{"small rate", 1000000, "0.000123456789", 123},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate(tt.rate)
+			assert.NoError(t, err)
+			got, err := Convert(tt.units, rate)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	t.Run("out of range", func(t *testing.T) {
+		rate, _ := ParseRate("2")
+		_, err := Convert(math.MaxInt64, rate)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,283 @@
+// Package verification performs cryptographically verified reads against
+// ImmuDB and packages the proof material for API responses.
+// Spec: docs/specs/006-verified-reads.md
+package verification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Verifier checks ledger reads against the trusted state that the ImmuDB
+// client persists locally. Every successful verification advances the
+// trusted state, so tampering with history already seen is detected.
+// Spec: docs/specs/006-verified-reads.md
+type Verifier struct {
+	db client.ImmuClient
+}
+
+// NewVerifier creates a new verifier
+func NewVerifier(db client.ImmuClient) *Verifier {
+	return &Verifier{
+		db: db,
+	}
+}
+
+// StringKey builds a primary key value for a VARCHAR primary key
+func StringKey(value string) *schema.SQLValue {
+	return &schema.SQLValue{Value: &schema.SQLValue_S{S: value}}
+}
+
+// VerifyRow reads a row by primary key with a single verifiable get and
+// verifies it against the trusted state. The returned row is decoded from
+// the verified entry, so the row, its tx and the proof always describe the
+// same revision. Columns are returned in the given order, NULL when the
+// row has no value for them. It returns nil values without error when no
+// row has the key so that callers can report NOT_FOUND in their own terms.
+// Spec: docs/specs/006-verified-reads.md#row-verification
+func (v *Verifier) VerifyRow(ctx context.Context, table string, pk *schema.SQLValue, columns []string) (*schema.Row, *pb.VerificationProof, error) {
+	state, err := v.db.CurrentState(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to read trusted state: %v", err)
+	}
+
+	entry, err := v.db.GetServiceClient().VerifiableSQLGet(ctx, &schema.VerifiableSQLGetRequest{
+		SqlGetRequest: &schema.SQLGetRequest{
+			Table:    table,
+			PkValues: []*schema.SQLValue{pk},
+		},
+		ProveSinceTx: state.TxId,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), store.ErrKeyNotFound.Error()) {
+			return nil, nil, nil
+		}
+		return nil, nil, status.Errorf(codes.Internal, "failed to get %s row: %v", table, err)
+	}
+	if entry.SqlEntry != nil && entry.SqlEntry.Metadata.GetDeleted() {
+		return nil, nil, nil
+	}
+
+	decoded, err := verifyEntry(ctx, v.db.GetServiceClient(), entry, pk, state)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.DataLoss, "verification failed for %s row: %v", table, err)
+	}
+
+	// Advance the trusted state to the row's tx so that later reads are
+	// checked against it
+	if entry.SqlEntry.Tx > state.TxId {
+		if _, err := v.db.VerifiedTxByID(ctx, entry.SqlEntry.Tx); err != nil {
+			return nil, nil, status.Errorf(codes.DataLoss, "verification failed for tx %d: %v", entry.SqlEntry.Tx, err)
+		}
+	}
+
+	row := &schema.Row{
+		Columns: make([]string, len(columns)),
+		Values:  make([]*schema.SQLValue, len(columns)),
+	}
+	for i, column := range columns {
+		row.Columns[i] = fmt.Sprintf("(%s.%s)", table, column)
+		colID, found := entry.ColIdsByName[row.Columns[i]]
+		if !found {
+			return nil, nil, status.Errorf(codes.Internal, "table %s has no column %s", table, column)
+		}
+		value, found := decoded[colID]
+		if !found {
+			value = &schema.SQLValue{Value: &schema.SQLValue_Null{}}
+		}
+		row.Values[i] = value
+	}
+
+	return row, proofFromEntry(entry, state), nil
+}
+
+// verifyEntry checks that a verifiable SQL entry holds the row with the
+// primary key pk in a tx consistent with the trusted state, and decodes
+// the row's values by column ID. It follows the checks of the ImmuDB
+// client's VerifyRow without reading the row a second time.
+func verifyEntry(ctx context.Context, service schema.ImmuServiceClient, entry *schema.VerifiableSQLEntry, pk *schema.SQLValue, state *schema.ImmutableState) (map[uint32]*schema.SQLValue, error) {
+	if entry.SqlEntry == nil || entry.VerifiableTx == nil || entry.VerifiableTx.Tx == nil ||
+		entry.VerifiableTx.Tx.Header == nil || entry.InclusionProof == nil || len(entry.PKIDs) != 1 {
+		return nil, store.ErrCorruptedData
+	}
+	if dual := entry.VerifiableTx.DualProof; dual == nil || dual.SourceTxHeader == nil ||
+		dual.TargetTxHeader == nil || dual.LinearProof == nil {
+		return nil, store.ErrCorruptedData
+	}
+
+	entrySpecDigest, err := store.EntrySpecDigestFor(int(entry.VerifiableTx.Tx.Header.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	pkType, found := entry.ColTypesById[entry.PKIDs[0]]
+	if !found {
+		return nil, store.ErrCorruptedData
+	}
+	pkEncoded, _, err := sql.EncodeRawValueAsKey(schema.RawValue(pk), pkType, int(entry.ColLenById[entry.PKIDs[0]]))
+	if err != nil {
+		return nil, err
+	}
+	pkKey := sql.MapKey(
+		[]byte{client.SQLPrefix},
+		sql.RowPrefix,
+		sql.EncodeID(entry.DatabaseId),
+		sql.EncodeID(entry.TableId),
+		sql.EncodeID(sql.PKIndexID),
+		pkEncoded)
+
+	dualProof := schema.DualProofFromProto(entry.VerifiableTx.DualProof)
+	txID := entry.SqlEntry.Tx
+
+	var eh, sourceAlh, targetAlh [sha256.Size]byte
+	var sourceID, targetID uint64
+	if state.TxId <= txID {
+		eh = schema.DigestFromProto(entry.VerifiableTx.DualProof.TargetTxHeader.EH)
+		sourceID, sourceAlh = state.TxId, schema.DigestFromProto(state.TxHash)
+		targetID, targetAlh = txID, dualProof.TargetTxHeader.Alh()
+	} else {
+		eh = schema.DigestFromProto(entry.VerifiableTx.DualProof.SourceTxHeader.EH)
+		sourceID, sourceAlh = txID, dualProof.SourceTxHeader.Alh()
+		targetID, targetAlh = state.TxId, schema.DigestFromProto(state.TxHash)
+	}
+
+	e := &store.EntrySpec{Key: pkKey, Value: entry.SqlEntry.Value}
+	if !store.VerifyInclusion(schema.InclusionProofFromProto(entry.InclusionProof), entrySpecDigest(e), eh) {
+		return nil, store.ErrCorruptedData
+	}
+
+	if state.TxId > 0 {
+		if err := schema.FillMissingLinearAdvanceProof(ctx, dualProof, sourceID, targetID, service); err != nil {
+			return nil, err
+		}
+		if !store.VerifyDualProof(dualProof, sourceID, targetID, sourceAlh, targetAlh) {
+			return nil, store.ErrCorruptedData
+		}
+	}
+
+	return decodeRow(entry.SqlEntry.Value, entry.ColTypesById, entry.MaxColId)
+}
+
+// decodeRow decodes an encoded row value into its values by column ID.
+// Values of dropped columns are skipped.
+func decodeRow(encoded []byte, colTypes map[uint32]sql.SQLValueType, maxColID uint32) (map[uint32]*schema.SQLValue, error) {
+	if len(encoded) < sql.EncLenLen {
+		return nil, sql.ErrCorruptedData
+	}
+	count := binary.BigEndian.Uint32(encoded)
+	off := sql.EncLenLen
+
+	values := make(map[uint32]*schema.SQLValue, count)
+	for i := 0; i < int(count); i++ {
+		if len(encoded) < off+sql.EncIDLen {
+			return nil, sql.ErrCorruptedData
+		}
+		colID := binary.BigEndian.Uint32(encoded[off:])
+		off += sql.EncIDLen
+
+		colType, found := colTypes[colID]
+		if !found {
+			if colID > maxColID {
+				return nil, sql.ErrCorruptedData
+			}
+			vlen, voff, err := sql.DecodeValueLength(encoded[off:])
+			if err != nil {
+				return nil, err
+			}
+			off += vlen + voff
+			continue
+		}
+
+		value, n, err := sql.DecodeValue(encoded[off:], colType)
+		if err != nil {
+			return nil, err
+		}
+		values[colID] = schema.TypedValueToRowValue(value)
+		off += n
+	}
+
+	return values, nil
+}
+
+// VerifyState verifies the latest server state against the trusted state and
+// returns proof material for it. Reads pinned to the returned tx_id with
+// time travel observe exactly the verified state.
+// Spec: docs/specs/006-verified-reads.md#state-verification
+func (v *Verifier) VerifyState(ctx context.Context) (*pb.VerificationProof, error) {
+	latest, err := v.db.GetServiceClient().CurrentState(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read server state: %v", err)
+	}
+
+	// Proves consistency between the trusted state and the latest tx
+	tx, err := v.db.VerifiedTxByID(ctx, latest.TxId)
+	if err != nil {
+		return nil, status.Errorf(codes.DataLoss, "verification failed for tx %d: %v", latest.TxId, err)
+	}
+
+	state, err := v.db.CurrentState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read trusted state: %v", err)
+	}
+
+	proof := stateProof(state)
+	proof.TxId = latest.TxId
+	proof.TxHash = latest.TxHash
+	if tx != nil && tx.Header != nil {
+		proof.TxTime = timestamppb.New(time.Unix(tx.Header.Ts, 0))
+	}
+	return proof, nil
+}
+
+// proofFromEntry converts a verifiable SQL entry into proof material
+func proofFromEntry(entry *schema.VerifiableSQLEntry, state *schema.ImmutableState) *pb.VerificationProof {
+	proof := stateProof(state)
+
+	if entry.SqlEntry != nil {
+		proof.TxId = entry.SqlEntry.Tx
+	}
+	if entry.InclusionProof != nil {
+		proof.InclusionLeaf = entry.InclusionProof.Leaf
+		proof.InclusionWidth = entry.InclusionProof.Width
+		proof.InclusionProof = entry.InclusionProof.Terms
+	}
+	if entry.VerifiableTx != nil {
+		if tx := entry.VerifiableTx.Tx; tx != nil && tx.Header != nil {
+			proof.TxTime = timestamppb.New(time.Unix(tx.Header.Ts, 0))
+		}
+		if dual := entry.VerifiableTx.DualProof; dual != nil {
+			proof.ConsistencyProof = dual.ConsistencyProof
+		}
+	}
+
+	return proof
+}
+
+// stateProof fills the trusted state fields of a proof
+func stateProof(state *schema.ImmutableState) *pb.VerificationProof {
+	proof := &pb.VerificationProof{
+		Database:      state.Db,
+		TrustedTxId:   state.TxId,
+		TrustedTxHash: state.TxHash,
+		VerifiedAt:    timestamppb.Now(),
+	}
+	if state.Signature != nil {
+		proof.StateSignature = state.Signature.Signature
+		proof.SigningPublicKey = state.Signature.PublicKey
+	}
+	return proof
+}
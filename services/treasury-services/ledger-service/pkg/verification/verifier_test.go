@@ -0,0 +1,215 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// mockImmuClient mocks the ImmuDB client methods used by the verifier.
+// Calling any other method panics through the nil embedded interface.
+type mockImmuClient struct {
+	client.ImmuClient
+	mock.Mock
+	service *mockServiceClient
+}
+
+func (m *mockImmuClient) CurrentState(ctx context.Context) (*schema.ImmutableState, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*schema.ImmutableState), args.Error(1)
+}
+
+func (m *mockImmuClient) VerifiedTxByID(ctx context.Context, tx uint64) (*schema.Tx, error) {
+	args := m.Called(ctx, tx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*schema.Tx), args.Error(1)
+}
+
+func (m *mockImmuClient) GetServiceClient() schema.ImmuServiceClient {
+	return m.service
+}
+
+// mockServiceClient mocks the raw ImmuDB gRPC service client
+type mockServiceClient struct {
+	schema.ImmuServiceClient
+	mock.Mock
+}
+
+func (m *mockServiceClient) VerifiableSQLGet(ctx context.Context, in *schema.VerifiableSQLGetRequest, opts ...grpc.CallOption) (*schema.VerifiableSQLEntry, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*schema.VerifiableSQLEntry), args.Error(1)
+}
+
+func (m *mockServiceClient) CurrentState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*schema.ImmutableState, error) {
+	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*schema.ImmutableState), args.Error(1)
+}
+
+func newMockClient() *mockImmuClient {
+	return &mockImmuClient{service: new(mockServiceClient)}
+}
+
+// newTestClient returns a client of an in-process ImmuDB with an
+// accounts table
+func newTestClient(t *testing.T) client.ImmuClient {
+	t.Helper()
+
+	opts := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPgsqlServer(false).
+		WithMetricsServer(false).
+		WithWebServer(false)
+	bs := servertest.NewBufconnServer(opts)
+	require.NoError(t, bs.Start())
+	t.Cleanup(func() { bs.Stop() })
+
+	db, err := bs.NewAuthenticatedClient(client.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.CloseSession(context.Background()) })
+
+	_, err = db.SQLExec(context.Background(), `
+		CREATE TABLE accounts (
+			id VARCHAR(36), name VARCHAR(255), external_group_id VARCHAR(255), version INTEGER,
+			PRIMARY KEY (id))`, nil)
+	require.NoError(t, err)
+	return db
+}
+
+// TestVerifyRow tests verified row reads
+// Spec: docs/specs/006-verified-reads.md#row-verification
+func TestVerifyRow(t *testing.T) {
+	ctx := context.Background()
+	columns := []string{"id", "name", "external_group_id", "version"}
+
+	t.Run("row and proof come from the same revision", func(t *testing.T) {
+		db := newTestClient(t)
+		_, err := db.SQLExec(ctx, `INSERT INTO accounts (id, name, version) VALUES ('acc-1', 'Cash', 1)`, nil)
+		require.NoError(t, err)
+		updated, err := db.SQLExec(ctx, `UPSERT INTO accounts (id, name, version) VALUES ('acc-1', 'Operating Cash', 2)`, nil)
+		require.NoError(t, err)
+
+		row, proof, err := NewVerifier(db).VerifyRow(ctx, "accounts", StringKey("acc-1"), columns)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"(accounts.id)", "(accounts.name)", "(accounts.external_group_id)", "(accounts.version)"}, row.Columns)
+		assert.Equal(t, "acc-1", row.Values[0].GetS())
+		assert.Equal(t, "Operating Cash", row.Values[1].GetS())
+		assert.NotNil(t, row.Values[2].GetNull())
+		assert.Equal(t, int64(2), row.Values[3].GetN())
+		assert.Equal(t, updated.Txs[0].Header.Id, proof.TxId)
+		assert.NotZero(t, proof.InclusionWidth)
+		assert.NotNil(t, proof.TxTime)
+
+		// The trusted state advanced to the row's tx
+		state, err := db.CurrentState(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, state.TxId, proof.TxId)
+	})
+
+	t.Run("no row", func(t *testing.T) {
+		db := newTestClient(t)
+
+		row, proof, err := NewVerifier(db).VerifyRow(ctx, "accounts", StringKey("missing"), columns)
+
+		assert.NoError(t, err)
+		assert.Nil(t, row)
+		assert.Nil(t, proof)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		db := newTestClient(t)
+		_, err := db.SQLExec(ctx, `INSERT INTO accounts (id, name, version) VALUES ('acc-1', 'Cash', 1)`, nil)
+		require.NoError(t, err)
+
+		_, _, err = NewVerifier(db).VerifyRow(ctx, "accounts", StringKey("acc-1"), []string{"id", "balance"})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("tampered row", func(t *testing.T) {
+		db := newMockClient()
+		db.On("CurrentState", ctx).Return(&schema.ImmutableState{Db: "ledgerdb", TxId: 20}, nil).Once()
+		db.service.On("VerifiableSQLGet", ctx, mock.Anything).Return(&schema.VerifiableSQLEntry{
+			SqlEntry:       &schema.SQLEntry{Tx: 12, Value: []byte("tampered")},
+			PKIDs:          []uint32{1},
+			ColTypesById:   map[uint32]string{1: "VARCHAR"},
+			ColLenById:     map[uint32]int32{1: 36},
+			InclusionProof: &schema.InclusionProof{Leaf: 0, Width: 1},
+			VerifiableTx: &schema.VerifiableTx{
+				Tx: &schema.Tx{Header: &schema.TxHeader{Id: 12, Version: 1}},
+				DualProof: &schema.DualProof{
+					SourceTxHeader: &schema.TxHeader{Id: 12, EH: make([]byte, 32), PrevAlh: make([]byte, 32), BlRoot: make([]byte, 32)},
+					TargetTxHeader: &schema.TxHeader{Id: 20, EH: make([]byte, 32), PrevAlh: make([]byte, 32), BlRoot: make([]byte, 32)},
+				},
+			},
+		}, nil).Once()
+
+		row, proof, err := NewVerifier(db).VerifyRow(ctx, "accounts", StringKey("acc-1"), columns)
+
+		assert.Error(t, err)
+		assert.Nil(t, row)
+		assert.Nil(t, proof)
+		assert.Equal(t, codes.DataLoss, status.Code(err))
+	})
+}
+
+// TestVerifyState tests verification of the latest ledger state
+// Spec: docs/specs/006-verified-reads.md#state-verification
+func TestVerifyState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("verifies latest tx", func(t *testing.T) {
+		db := newMockClient()
+		db.service.On("CurrentState", ctx, mock.Anything).
+			Return(&schema.ImmutableState{Db: "ledgerdb", TxId: 50, TxHash: []byte("latest")}, nil).Once()
+		db.On("VerifiedTxByID", ctx, uint64(50)).
+			Return(&schema.Tx{Header: &schema.TxHeader{Id: 50, Ts: 1755734400}}, nil).Once()
+		db.On("CurrentState", ctx).
+			Return(&schema.ImmutableState{Db: "ledgerdb", TxId: 50, TxHash: []byte("latest")}, nil).Once()
+
+		proof, err := NewVerifier(db).VerifyState(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(50), proof.TxId)
+		assert.Equal(t, []byte("latest"), proof.TxHash)
+		assert.Equal(t, uint64(50), proof.TrustedTxId)
+		db.AssertExpectations(t)
+	})
+
+	t.Run("inconsistent state", func(t *testing.T) {
+		db := newMockClient()
+		db.service.On("CurrentState", ctx, mock.Anything).
+			Return(&schema.ImmutableState{TxId: 50}, nil).Once()
+		db.On("VerifiedTxByID", ctx, uint64(50)).
+			Return(nil, errors.New("consistency proof failed")).Once()
+
+		proof, err := NewVerifier(db).VerifyState(ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, proof)
+		assert.Equal(t, codes.DataLoss, status.Code(err))
+	})
+}
@@ -0,0 +1,20 @@
+package reporting
+
+import (
+	"context"
+
+	pb "example.com/go-mono-repo/proto/ledger"
+)
+
+// RepositoryInterface defines the interface for report repository operations
+type RepositoryInterface interface {
+	ListAccounts(ctx context.Context, query ReportQuery) ([]*ReportAccountRow, error)
+	GetAccountTotals(ctx context.Context, query ReportQuery) (map[string]*AccountTotals, error)
+}
+
+// ManagerInterface defines the interface for report manager operations
+type ManagerInterface interface {
+	GetTrialBalance(ctx context.Context, req *pb.GetTrialBalanceRequest) (*pb.GetTrialBalanceResponse, error)
+	GetBalanceSheet(ctx context.Context, req *pb.GetBalanceSheetRequest) (*pb.GetBalanceSheetResponse, error)
+	GetIncomeStatement(ctx context.Context, req *pb.GetIncomeStatementRequest) (*pb.GetIncomeStatementResponse, error)
+}
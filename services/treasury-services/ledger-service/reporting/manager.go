@@ -0,0 +1,322 @@
+package reporting
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Manager builds financial reports from posted journal lines
+// Spec: docs/specs/011-financial-reports.md
+type Manager struct {
+	repo       RepositoryInterface
+	currencies *account.Validator
+}
+
+// NewManager creates a new report manager
+func NewManager(repo RepositoryInterface, currencies *account.Validator) *Manager {
+	return &Manager{
+		repo:       repo,
+		currencies: currencies,
+	}
+}
+
+// reportAccount is an account with its posted totals
+type reportAccount struct {
+	row    *ReportAccountRow
+	totals *AccountTotals
+}
+
+// GetTrialBalance lists the net balance of every account with posted lines
+// in the debit or credit column and checks that the columns are equal
+// Spec: docs/specs/011-financial-reports.md#story-1-trial-balance
+func (m *Manager) GetTrialBalance(ctx context.Context, req *pb.GetTrialBalanceRequest) (*pb.GetTrialBalanceResponse, error) {
+	query, err := m.pointInTimeQuery(ctx, req.CurrencyCode, req.ExternalGroupId, req.AsOfTime, req.AsOfTx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := m.loadAccounts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetTrialBalanceResponse{
+		Lines:        make([]*pb.TrialBalanceLine, 0, len(accounts)),
+		CurrencyCode: query.CurrencyCode,
+		AsOfTx:       query.AsOfTx,
+	}
+	if query.AsOfTime != nil {
+		resp.AsOfTime = timestamppb.New(*query.AsOfTime)
+	}
+
+	var totalDebits, totalCredits int64
+	for _, acc := range accounts {
+		var debitBalance, creditBalance int64
+		if net := acc.totals.DebitTotal - acc.totals.CreditTotal; net >= 0 {
+			debitBalance = net
+		} else {
+			creditBalance = -net
+		}
+		totalDebits += debitBalance
+		totalCredits += creditBalance
+
+		resp.Lines = append(resp.Lines, &pb.TrialBalanceLine{
+			AccountId:     acc.row.ID,
+			AccountName:   acc.row.Name,
+			ExternalId:    acc.row.ExternalID,
+			AccountType:   accountTypeToProto(acc.row.AccountType),
+			DebitTotal:    amount.Format(acc.totals.DebitTotal),
+			CreditTotal:   amount.Format(acc.totals.CreditTotal),
+			DebitBalance:  amount.Format(debitBalance),
+			CreditBalance: amount.Format(creditBalance),
+		})
+	}
+
+	resp.TotalDebits = amount.Format(totalDebits)
+	resp.TotalCredits = amount.Format(totalCredits)
+	resp.IsBalanced = totalDebits == totalCredits
+	if !resp.IsBalanced {
+		logImbalance("Trial balance", query, resp.TotalDebits, resp.TotalCredits)
+	}
+
+	return resp, nil
+}
+
+// GetBalanceSheet reports assets, liabilities and equity at a point in time.
+// Revenue less expenses to date is reported as retained earnings, because
+// the ledger has no closing entries that move it into equity.
+// Spec: docs/specs/011-financial-reports.md#story-2-balance-sheet
+func (m *Manager) GetBalanceSheet(ctx context.Context, req *pb.GetBalanceSheetRequest) (*pb.GetBalanceSheetResponse, error) {
+	query, err := m.pointInTimeQuery(ctx, req.CurrencyCode, req.ExternalGroupId, req.AsOfTime, req.AsOfTx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := m.loadAccounts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	assets, totalAssets := buildSection(accountTypeAsset, accounts)
+	liabilities, totalLiabilities := buildSection(accountTypeLiability, accounts)
+	equity, totalEquity := buildSection(accountTypeEquity, accounts)
+	_, totalRevenue := buildSection(accountTypeRevenue, accounts)
+	_, totalExpenses := buildSection(accountTypeExpense, accounts)
+
+	retainedEarnings := totalRevenue - totalExpenses
+	totalLiabilitiesAndEquity := totalLiabilities + totalEquity + retainedEarnings
+
+	resp := &pb.GetBalanceSheetResponse{
+		Assets:                    assets,
+		Liabilities:               liabilities,
+		Equity:                    equity,
+		RetainedEarnings:          amount.Format(retainedEarnings),
+		TotalAssets:               amount.Format(totalAssets),
+		TotalLiabilitiesAndEquity: amount.Format(totalLiabilitiesAndEquity),
+		IsBalanced:                totalAssets == totalLiabilitiesAndEquity,
+		CurrencyCode:              query.CurrencyCode,
+		AsOfTx:                    query.AsOfTx,
+	}
+	if query.AsOfTime != nil {
+		resp.AsOfTime = timestamppb.New(*query.AsOfTime)
+	}
+	if !resp.IsBalanced {
+		logImbalance("Balance sheet", query, resp.TotalAssets, resp.TotalLiabilitiesAndEquity)
+	}
+
+	return resp, nil
+}
+
+// GetIncomeStatement reports revenue and expenses for entries dated in a
+// period
+// Spec: docs/specs/011-financial-reports.md#story-3-income-statement
+func (m *Manager) GetIncomeStatement(ctx context.Context, req *pb.GetIncomeStatementRequest) (*pb.GetIncomeStatementResponse, error) {
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time is required")
+	}
+	if req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "end_time is required")
+	}
+	if err := req.StartTime.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_time: %v", err)
+	}
+	if err := req.EndTime.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end_time: %v", err)
+	}
+
+	start := req.StartTime.AsTime()
+	end := req.EndTime.AsTime()
+	if !start.Before(end) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must be before end_time")
+	}
+
+	query, err := m.pointInTimeQuery(ctx, req.CurrencyCode, req.ExternalGroupId, nil, req.AsOfTx)
+	if err != nil {
+		return nil, err
+	}
+	query.StartTime = &start
+	query.EndTime = &end
+
+	accounts, err := m.loadAccounts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue, totalRevenue := buildSection(accountTypeRevenue, accounts)
+	expenses, totalExpenses := buildSection(accountTypeExpense, accounts)
+
+	return &pb.GetIncomeStatementResponse{
+		Revenue:       revenue,
+		Expenses:      expenses,
+		TotalRevenue:  amount.Format(totalRevenue),
+		TotalExpenses: amount.Format(totalExpenses),
+		NetIncome:     amount.Format(totalRevenue - totalExpenses),
+		CurrencyCode:  query.CurrencyCode,
+		StartTime:     req.StartTime,
+		EndTime:       req.EndTime,
+		AsOfTx:        query.AsOfTx,
+	}, nil
+}
+
+// pointInTimeQuery validates the request fields shared by every report
+func (m *Manager) pointInTimeQuery(ctx context.Context, currencyCode, externalGroupID string, asOfTime *timestamppb.Timestamp, asOfTx uint64) (ReportQuery, error) {
+	query := ReportQuery{
+		CurrencyCode:    currencyCode,
+		ExternalGroupID: externalGroupID,
+		AsOfTx:          asOfTx,
+	}
+
+	// Reports cover accounts in deprecated and inactive currencies too
+	if _, err := m.currencies.MoneyCurrency(ctx, currencyCode); err != nil {
+		return query, err
+	}
+
+	if asOfTime != nil {
+		if err := asOfTime.CheckValid(); err != nil {
+			return query, status.Errorf(codes.InvalidArgument, "invalid as_of_time: %v", err)
+		}
+		t := asOfTime.AsTime()
+		query.AsOfTime = &t
+	}
+
+	return query, nil
+}
+
+// loadAccounts returns the accounts with posted lines matching the query,
+// ordered by account type and then external ID
+func (m *Manager) loadAccounts(ctx context.Context, query ReportQuery) ([]reportAccount, error) {
+	rows, err := m.repo.ListAccounts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := m.repo.GetAccountTotals(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]reportAccount, 0, len(totals))
+	for _, row := range rows {
+		if t, found := totals[row.ID]; found {
+			accounts = append(accounts, reportAccount{row: row, totals: t})
+		}
+	}
+
+	sort.Slice(accounts, func(i, j int) bool {
+		a, b := accounts[i].row, accounts[j].row
+		if accountTypeOrder[a.AccountType] != accountTypeOrder[b.AccountType] {
+			return accountTypeOrder[a.AccountType] < accountTypeOrder[b.AccountType]
+		}
+		if a.ExternalID != b.ExternalID {
+			return a.ExternalID < b.ExternalID
+		}
+		return a.ID < b.ID
+	})
+
+	return accounts, nil
+}
+
+// buildSection collects the accounts of one type into a statement section.
+// Amounts are signed so that the section's normal balance is positive.
+// Spec: docs/specs/011-financial-reports.md#statement-signs
+func buildSection(accountType string, accounts []reportAccount) (*pb.ReportSection, int64) {
+	section := &pb.ReportSection{
+		AccountType: accountTypeToProto(accountType),
+		Lines:       []*pb.ReportLine{},
+	}
+
+	var total int64
+	for _, acc := range accounts {
+		if acc.row.AccountType != accountType {
+			continue
+		}
+
+		net := acc.totals.DebitTotal - acc.totals.CreditTotal
+		if creditNormalTypes[accountType] {
+			net = -net
+		}
+		total += net
+
+		section.Lines = append(section.Lines, &pb.ReportLine{
+			AccountId:   acc.row.ID,
+			AccountName: acc.row.Name,
+			ExternalId:  acc.row.ExternalID,
+			Amount:      amount.Format(net),
+		})
+	}
+
+	section.Total = amount.Format(total)
+	return section, total
+}
+
+// logImbalance records a report whose two sides differ. Reports limited to
+// one external group may legitimately not balance, because entries can post
+// across groups.
+func logImbalance(report string, query ReportQuery, left, right string) {
+	if query.ExternalGroupID != "" {
+		return
+	}
+	log.Printf("Warning: %s for %s is out of balance: %s != %s (as_of_tx=%d)",
+		report, query.CurrencyCode, left, right, query.AsOfTx)
+}
+
+// Helper functions
+
+// Account types as stored in the accounts table
+const (
+	accountTypeAsset     = "ASSET"
+	accountTypeLiability = "LIABILITY"
+	accountTypeEquity    = "EQUITY"
+	accountTypeRevenue   = "REVENUE"
+	accountTypeExpense   = "EXPENSE"
+)
+
+// accountTypeOrder is the order of account types in a chart of accounts
+var accountTypeOrder = map[string]int{
+	accountTypeAsset:     1,
+	accountTypeLiability: 2,
+	accountTypeEquity:    3,
+	accountTypeRevenue:   4,
+	accountTypeExpense:   5,
+}
+
+// creditNormalTypes are the account types that normally carry a credit
+// balance, matching the seed data in 002_add_account_constraints.sql
+var creditNormalTypes = map[string]bool{
+	accountTypeLiability: true,
+	accountTypeEquity:    true,
+	accountTypeRevenue:   true,
+}
+
+// accountTypeToProto converts a stored account type to the proto enum
+func accountTypeToProto(accountType string) pb.AccountType {
+	return pb.AccountType(pb.AccountType_value["ACCOUNT_TYPE_"+accountType])
+}
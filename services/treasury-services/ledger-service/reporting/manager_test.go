@@ -0,0 +1,289 @@
+package reporting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockRepository is a mock implementation of ReportRepository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListAccounts(ctx context.Context, query ReportQuery) ([]*ReportAccountRow, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ReportAccountRow), args.Error(1)
+}
+
+func (m *MockRepository) GetAccountTotals(ctx context.Context, query ReportQuery) (map[string]*AccountTotals, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]*AccountTotals), args.Error(1)
+}
+
+// testAccounts is a small chart of accounts in USD
+var testAccounts = []*ReportAccountRow{
+	{ID: "exp-1", Name: "Payroll", ExternalID: "5000", AccountType: "EXPENSE"},
+	{ID: "rev-1", Name: "Sales", ExternalID: "4000", AccountType: "REVENUE"},
+	{ID: "cash-2", Name: "Savings", ExternalID: "1010", AccountType: "ASSET"},
+	{ID: "cash-1", Name: "Operating Cash", ExternalID: "1000", AccountType: "ASSET"},
+	{ID: "loan-1", Name: "Bank Loan", ExternalID: "2000", AccountType: "LIABILITY"},
+	{ID: "eq-1", Name: "Share Capital", ExternalID: "3000", AccountType: "EQUITY"},
+	{ID: "idle-1", Name: "Unused", ExternalID: "1999", AccountType: "ASSET"},
+}
+
+// testTotals are balanced postings: capital 1000, loan 500, sales 300,
+// payroll 200 and a 100 transfer from operating cash to savings
+func testTotals() map[string]*AccountTotals {
+	return map[string]*AccountTotals{
+		"cash-1": {DebitTotal: 18000000, CreditTotal: 3000000, LineCount: 5},
+		"cash-2": {DebitTotal: 1000000, LineCount: 1},
+		"loan-1": {CreditTotal: 5000000, LineCount: 1},
+		"eq-1":   {CreditTotal: 10000000, LineCount: 1},
+		"rev-1":  {CreditTotal: 3000000, LineCount: 1},
+		"exp-1":  {DebitTotal: 2000000, LineCount: 1},
+	}
+}
+
+// TestGetTrialBalance tests the trial balance report
+// Spec: docs/specs/011-financial-reports.md#story-1-trial-balance
+func TestGetTrialBalance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("balanced ledger", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		query := ReportQuery{CurrencyCode: "USD"}
+		repo.On("ListAccounts", ctx, query).Return(testAccounts, nil).Once()
+		repo.On("GetAccountTotals", ctx, query).Return(testTotals(), nil).Once()
+
+		resp, err := manager.GetTrialBalance(ctx, &pb.GetTrialBalanceRequest{CurrencyCode: "USD"})
+
+		assert.NoError(t, err)
+		assert.True(t, resp.IsBalanced)
+		assert.Equal(t, "1800.0000", resp.TotalDebits)
+		assert.Equal(t, "1800.0000", resp.TotalCredits)
+		assert.Nil(t, resp.AsOfTime)
+
+		// Accounts without postings are omitted, the rest are in chart order
+		ids := []string{}
+		for _, line := range resp.Lines {
+			ids = append(ids, line.AccountId)
+		}
+		assert.Equal(t, []string{"cash-1", "cash-2", "loan-1", "eq-1", "rev-1", "exp-1"}, ids)
+
+		cash := resp.Lines[0]
+		assert.Equal(t, pb.AccountType_ACCOUNT_TYPE_ASSET, cash.AccountType)
+		assert.Equal(t, "1800.0000", cash.DebitTotal)
+		assert.Equal(t, "300.0000", cash.CreditTotal)
+		assert.Equal(t, "1500.0000", cash.DebitBalance)
+		assert.Equal(t, "0.0000", cash.CreditBalance)
+
+		loan := resp.Lines[2]
+		assert.Equal(t, "0.0000", loan.DebitBalance)
+		assert.Equal(t, "500.0000", loan.CreditBalance)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("out of balance group", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		asOf := time.Date(2025, 8, 31, 23, 59, 59, 0, time.UTC)
+		query := ReportQuery{CurrencyCode: "USD", ExternalGroupID: "store-1", AsOfTime: &asOf, AsOfTx: 42}
+		repo.On("ListAccounts", ctx, query).Return(testAccounts[:2], nil).Once()
+		repo.On("GetAccountTotals", ctx, query).Return(testTotals(), nil).Once()
+
+		resp, err := manager.GetTrialBalance(ctx, &pb.GetTrialBalanceRequest{
+			CurrencyCode:    "USD",
+			ExternalGroupId: "store-1",
+			AsOfTime:        timestamppb.New(asOf),
+			AsOfTx:          42,
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, resp.IsBalanced)
+		assert.Equal(t, "200.0000", resp.TotalDebits)
+		assert.Equal(t, "300.0000", resp.TotalCredits)
+		assert.Equal(t, asOf, resp.AsOfTime.AsTime())
+		assert.Equal(t, uint64(42), resp.AsOfTx)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("missing currency", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), account.NewValidator())
+
+		_, err := manager.GetTrialBalance(ctx, &pb.GetTrialBalanceRequest{})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		repo.On("ListAccounts", ctx, mock.Anything).
+			Return(nil, status.Error(codes.Internal, "failed to query report accounts: boom")).Once()
+
+		_, err := manager.GetTrialBalance(ctx, &pb.GetTrialBalanceRequest{CurrencyCode: "USD"})
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+		repo.AssertNotCalled(t, "GetAccountTotals", mock.Anything, mock.Anything)
+	})
+}
+
+// TestGetBalanceSheet tests the balance sheet report
+// Spec: docs/specs/011-financial-reports.md#story-2-balance-sheet
+func TestGetBalanceSheet(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("assets equal liabilities and equity", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		query := ReportQuery{CurrencyCode: "USD"}
+		repo.On("ListAccounts", ctx, query).Return(testAccounts, nil).Once()
+		repo.On("GetAccountTotals", ctx, query).Return(testTotals(), nil).Once()
+
+		resp, err := manager.GetBalanceSheet(ctx, &pb.GetBalanceSheetRequest{CurrencyCode: "USD"})
+
+		assert.NoError(t, err)
+		assert.True(t, resp.IsBalanced)
+		assert.Equal(t, "1600.0000", resp.TotalAssets)
+		assert.Equal(t, "1600.0000", resp.TotalLiabilitiesAndEquity)
+		assert.Equal(t, "100.0000", resp.RetainedEarnings)
+
+		assert.Equal(t, pb.AccountType_ACCOUNT_TYPE_ASSET, resp.Assets.AccountType)
+		assert.Len(t, resp.Assets.Lines, 2)
+		assert.Equal(t, "1500.0000", resp.Assets.Lines[0].Amount)
+		assert.Equal(t, "500.0000", resp.Liabilities.Total)
+		assert.Equal(t, "1000.0000", resp.Equity.Total)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("empty ledger", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		repo.On("ListAccounts", ctx, mock.Anything).Return([]*ReportAccountRow{}, nil).Once()
+		repo.On("GetAccountTotals", ctx, mock.Anything).Return(map[string]*AccountTotals{}, nil).Once()
+
+		resp, err := manager.GetBalanceSheet(ctx, &pb.GetBalanceSheetRequest{CurrencyCode: "EUR"})
+
+		assert.NoError(t, err)
+		assert.True(t, resp.IsBalanced)
+		assert.Equal(t, "0.0000", resp.TotalAssets)
+		assert.Empty(t, resp.Assets.Lines)
+	})
+
+	t.Run("invalid as_of_time", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), account.NewValidator())
+
+		_, err := manager.GetBalanceSheet(ctx, &pb.GetBalanceSheetRequest{
+			CurrencyCode: "USD",
+			AsOfTime:     &timestamppb.Timestamp{Nanos: -1},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestGetIncomeStatement tests the income statement report
+// Spec: docs/specs/011-financial-reports.md#story-3-income-statement
+func TestGetIncomeStatement(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("net income for the period", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		query := ReportQuery{CurrencyCode: "USD", StartTime: &start, EndTime: &end}
+		repo.On("ListAccounts", ctx, query).Return(testAccounts, nil).Once()
+		repo.On("GetAccountTotals", ctx, query).Return(testTotals(), nil).Once()
+
+		resp, err := manager.GetIncomeStatement(ctx, &pb.GetIncomeStatementRequest{
+			StartTime:    timestamppb.New(start),
+			EndTime:      timestamppb.New(end),
+			CurrencyCode: "USD",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "300.0000", resp.TotalRevenue)
+		assert.Equal(t, "200.0000", resp.TotalExpenses)
+		assert.Equal(t, "100.0000", resp.NetIncome)
+		assert.Len(t, resp.Revenue.Lines, 1)
+		assert.Equal(t, "Payroll", resp.Expenses.Lines[0].AccountName)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("net loss", func(t *testing.T) {
+		repo := new(MockRepository)
+		manager := NewManager(repo, account.NewValidator())
+
+		repo.On("ListAccounts", ctx, mock.Anything).Return(testAccounts, nil).Once()
+		repo.On("GetAccountTotals", ctx, mock.Anything).Return(map[string]*AccountTotals{
+			"exp-1": {DebitTotal: 2500000, LineCount: 1},
+		}, nil).Once()
+
+		resp, err := manager.GetIncomeStatement(ctx, &pb.GetIncomeStatementRequest{
+			StartTime:    timestamppb.New(start),
+			EndTime:      timestamppb.New(end),
+			CurrencyCode: "USD",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "-250.0000", resp.NetIncome)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		manager := NewManager(new(MockRepository), account.NewValidator())
+
+		tests := []struct {
+			name    string
+			req     *pb.GetIncomeStatementRequest
+			message string
+		}{
+			{
+				name:    "missing start",
+				req:     &pb.GetIncomeStatementRequest{EndTime: timestamppb.New(end), CurrencyCode: "USD"},
+				message: "start_time is required",
+			},
+			{
+				name:    "missing end",
+				req:     &pb.GetIncomeStatementRequest{StartTime: timestamppb.New(start), CurrencyCode: "USD"},
+				message: "end_time is required",
+			},
+			{
+				name:    "end before start",
+				req:     &pb.GetIncomeStatementRequest{StartTime: timestamppb.New(end), EndTime: timestamppb.New(start), CurrencyCode: "USD"},
+				message: "start_time must be before end_time",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := manager.GetIncomeStatement(ctx, tt.req)
+
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Equal(t, tt.message, status.Convert(err).Message())
+			})
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReportRepository reads the accounts and posted totals that reports are
+// built from
+// Spec: docs/specs/011-financial-reports.md
+type ReportRepository struct {
+	db client.ImmuClient
+}
+
+// NewReportRepository creates a new report repository
+func NewReportRepository(db client.ImmuClient) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+// ReportAccountRow is an account that a report covers
+type ReportAccountRow struct {
+	ID          string
+	Name        string
+	ExternalID  string
+	AccountType string
+}
+
+// AccountTotals contains posted journal totals for an account.
+// Amounts are scaled by 10^amount.Scale.
+type AccountTotals struct {
+	DebitTotal  int64
+	CreditTotal int64
+	LineCount   int64
+}
+
+// ReportQuery selects the accounts and journal lines a report covers
+type ReportQuery struct {
+	CurrencyCode    string     // Accounts in this currency
+	ExternalGroupID string     // Only accounts in this group, if set
+	AsOfTime        *time.Time // Only include entries dated on or before this time
+	StartTime       *time.Time // Only include entries dated on or after this time
+	EndTime         *time.Time // Only include entries dated before this time
+	AsOfTx          uint64     // Read the ledger as committed at this ImmuDB tx (0 = latest)
+}
+
+// period returns the ImmuDB time travel clause for the query
+func (q ReportQuery) period(params map[string]interface{}) string {
+	if q.AsOfTx == 0 {
+		return ""
+	}
+	params["as_of_tx"] = q.AsOfTx
+	return "UNTIL TX @as_of_tx"
+}
+
+// ListAccounts returns the accounts in the report currency and group
+// Spec: docs/specs/011-financial-reports.md#report-queries
+func (r *ReportRepository) ListAccounts(ctx context.Context, query ReportQuery) ([]*ReportAccountRow, error) {
+	params := map[string]interface{}{
+		"currency_code": query.CurrencyCode,
+	}
+	period := query.period(params)
+
+	whereClauses := []string{"currency_code = @currency_code"}
+	if query.ExternalGroupID != "" {
+		whereClauses = append(whereClauses, "external_group_id = @external_group_id")
+		params["external_group_id"] = query.ExternalGroupID
+	}
+
+	sqlQuery := fmt.Sprintf(`
+		SELECT id, name, external_id, account_type
+		FROM accounts %s
+		WHERE %s`,
+		period, strings.Join(whereClauses, " AND "))
+
+	result, err := r.db.SQLQuery(ctx, sqlQuery, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query report accounts: %v", err)
+	}
+
+	accounts := make([]*ReportAccountRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		accounts = append(accounts, &ReportAccountRow{
+			ID:          row.Values[0].GetS(),
+			Name:        row.Values[1].GetS(),
+			ExternalID:  row.Values[2].GetS(),
+			AccountType: strings.ToUpper(row.Values[3].GetS()),
+		})
+	}
+
+	return accounts, nil
+}
+
+// GetAccountTotals sums posted journal lines in the report currency per
+// account. Accounts without lines in the selected dates are omitted.
+// Spec: docs/specs/011-financial-reports.md#report-queries
+func (r *ReportRepository) GetAccountTotals(ctx context.Context, query ReportQuery) (map[string]*AccountTotals, error) {
+	params := map[string]interface{}{
+		"currency_code": query.CurrencyCode,
+	}
+	period := query.period(params)
+
+	whereClauses := []string{"l.currency_code = @currency_code"}
+	if query.AsOfTime != nil {
+		whereClauses = append(whereClauses, "e.entry_date <= @as_of_time")
+		params["as_of_time"] = *query.AsOfTime
+	}
+	if query.StartTime != nil {
+		whereClauses = append(whereClauses, "e.entry_date >= @start_time")
+		params["start_time"] = *query.StartTime
+	}
+	if query.EndTime != nil {
+		whereClauses = append(whereClauses, "e.entry_date < @end_time")
+		params["end_time"] = *query.EndTime
+	}
+
+	sqlQuery := fmt.Sprintf(`
+		SELECT l.account_id, COUNT(*), SUM(l.debit_amount), SUM(l.credit_amount)
+		FROM journal_entry_lines %s AS l
+		INNER JOIN journal_entries %s AS e ON l.journal_entry_id = e.id
+		WHERE %s
+		GROUP BY l.account_id`,
+		period, period, strings.Join(whereClauses, " AND "))
+
+	result, err := r.db.SQLQuery(ctx, sqlQuery, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query account totals: %v", err)
+	}
+
+	totals := make(map[string]*AccountTotals, len(result.Rows))
+	for _, row := range result.Rows {
+		totals[row.Values[0].GetS()] = &AccountTotals{
+			LineCount:   row.Values[1].GetN(),
+			DebitTotal:  row.Values[2].GetN(),
+			CreditTotal: row.Values[3].GetN(),
+		}
+	}
+
+	return totals, nil
+}
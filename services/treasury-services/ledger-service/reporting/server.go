@@ -0,0 +1,75 @@
+package reporting
+
+import (
+	"context"
+	"log"
+
+	"clarity/treasury-services/ledger-service/account"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/pkg/client"
+)
+
+// Server implements the ReportingService gRPC interface
+// Spec: docs/specs/011-financial-reports.md
+type Server struct {
+	pb.UnimplementedReportingServiceServer
+	manager ManagerInterface
+}
+
+// NewServer creates a new reporting server
+func NewServer(db client.ImmuClient, currencies *account.Validator) *Server {
+	repo := NewReportRepository(db)
+	manager := NewManager(repo, currencies)
+
+	return &Server{
+		manager: manager,
+	}
+}
+
+// GetTrialBalance returns the trial balance of a currency
+// Spec: docs/specs/011-financial-reports.md#story-1-trial-balance
+func (s *Server) GetTrialBalance(ctx context.Context, req *pb.GetTrialBalanceRequest) (*pb.GetTrialBalanceResponse, error) {
+	log.Printf("Getting trial balance: currency=%s, external_group_id=%s, as_of_tx=%d",
+		req.CurrencyCode, req.ExternalGroupId, req.AsOfTx)
+
+	resp, err := s.manager.GetTrialBalance(ctx, req)
+	if err != nil {
+		log.Printf("Failed to get trial balance: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Trial balance has %d accounts, balanced=%t", len(resp.Lines), resp.IsBalanced)
+	return resp, nil
+}
+
+// GetBalanceSheet returns the balance sheet of a currency
+// Spec: docs/specs/011-financial-reports.md#story-2-balance-sheet
+func (s *Server) GetBalanceSheet(ctx context.Context, req *pb.GetBalanceSheetRequest) (*pb.GetBalanceSheetResponse, error) {
+	log.Printf("Getting balance sheet: currency=%s, external_group_id=%s, as_of_tx=%d",
+		req.CurrencyCode, req.ExternalGroupId, req.AsOfTx)
+
+	resp, err := s.manager.GetBalanceSheet(ctx, req)
+	if err != nil {
+		log.Printf("Failed to get balance sheet: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Balance sheet total assets=%s, balanced=%t", resp.TotalAssets, resp.IsBalanced)
+	return resp, nil
+}
+
+// GetIncomeStatement returns the income statement of a currency for a period
+// Spec: docs/specs/011-financial-reports.md#story-3-income-statement
+func (s *Server) GetIncomeStatement(ctx context.Context, req *pb.GetIncomeStatementRequest) (*pb.GetIncomeStatementResponse, error) {
+	log.Printf("Getting income statement: currency=%s, external_group_id=%s",
+		req.CurrencyCode, req.ExternalGroupId)
+
+	resp, err := s.manager.GetIncomeStatement(ctx, req)
+	if err != nil {
+		log.Printf("Failed to get income statement: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Income statement net income=%s", resp.NetIncome)
+	return resp, nil
+}
@@ -0,0 +1,44 @@
+package revaluation
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/journal"
+	pb "example.com/go-mono-repo/proto/ledger"
+)
+
+// RepositoryInterface defines the interface for revaluation repository operations
+type RepositoryInterface interface {
+	ListForeignBalances(ctx context.Context, functionalCurrency string, asOf time.Time) ([]*ForeignBalanceRow, error)
+}
+
+// ManagerInterface defines the interface for revaluation manager operations
+type ManagerInterface interface {
+	RunRevaluation(ctx context.Context, req *pb.RunRevaluationRequest) (*pb.RunRevaluationResponse, error)
+}
+
+// AccountReaderInterface reads the gain and loss account
+type AccountReaderInterface interface {
+	GetAccountByID(ctx context.Context, accountID string) (*account.AccountRow, error)
+}
+
+// EntryPreparerInterface validates the revaluation entry without writing it
+// Spec: docs/specs/014-multi-currency.md#story-3-run-revaluation
+type EntryPreparerInterface interface {
+	PrepareJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*journal.PreparedEntry, error)
+}
+
+// EntryWriterInterface writes a prepared journal entry
+type EntryWriterInterface interface {
+	CreateJournalEntry(ctx context.Context, entry *journal.JournalEntryRow, lines []*journal.JournalEntryLineRow, accountVersions map[string]int64, entityIDs []string) error
+}
+
+// RateProviderInterface looks up the closing rates that are not passed in
+// the request
+// Spec: docs/specs/014-multi-currency.md#closing-rates
+type RateProviderInterface interface {
+	GetClosingRate(ctx context.Context, currency, functionalCurrency string, asOf time.Time, source string) (*big.Rat, error)
+}
@@ -0,0 +1,247 @@
+package revaluation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/audit"
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Account types whose foreign-currency balances are revalued
+var revaluedAccountTypes = map[string]bool{
+	"ASSET":     true,
+	"LIABILITY": true,
+}
+
+// Manager handles revaluation business logic
+// Spec: docs/specs/014-multi-currency.md
+type Manager struct {
+	repo               RepositoryInterface
+	accounts           AccountReaderInterface
+	entries            EntryPreparerInterface
+	writer             EntryWriterInterface
+	rates              RateProviderInterface
+	validator          *account.Validator
+	functionalCurrency string
+}
+
+// NewManager creates a new revaluation manager
+func NewManager(repo RepositoryInterface, accounts AccountReaderInterface, entries EntryPreparerInterface, writer EntryWriterInterface, rates RateProviderInterface, validator *account.Validator, functionalCurrency string) *Manager {
+	return &Manager{
+		repo:               repo,
+		accounts:           accounts,
+		entries:            entries,
+		writer:             writer,
+		rates:              rates,
+		validator:          validator,
+		functionalCurrency: functionalCurrency,
+	}
+}
+
+// RunRevaluation revalues the foreign-currency asset and liability accounts
+// at the closing rates and posts the unrealized gains and losses as one
+// journal entry dated as_of. Rates missing from the request are read from
+// the Treasury Service. Running it again with the same rates posts nothing.
+// Spec: docs/specs/014-multi-currency.md#story-3-run-revaluation
+func (m *Manager) RunRevaluation(ctx context.Context, req *pb.RunRevaluationRequest) (*pb.RunRevaluationResponse, error) {
+	if req.AsOf == nil {
+		return nil, status.Error(codes.InvalidArgument, "as_of is required")
+	}
+	if req.RateSource == "" {
+		return nil, status.Error(codes.InvalidArgument, "rate_source is required")
+	}
+	if len(req.RateSource) > 50 {
+		return nil, status.Error(codes.InvalidArgument, "rate_source must be 50 characters or less")
+	}
+	if req.GainLossAccountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "gain_loss_account_id is required")
+	}
+	actor := req.Actor
+	if actor == "" {
+		actor = audit.UserFromContext(ctx)
+	}
+	if err := m.validator.ValidateActor(actor); err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]*big.Rat, len(req.Rates))
+	for currency, text := range req.Rates {
+		rate, err := amount.ParseRate(text)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid rate for %s: %v", currency, err)
+		}
+		rates[currency] = rate
+	}
+
+	gainLoss, err := m.accounts.GetAccountByID(ctx, req.GainLossAccountId)
+	if err != nil {
+		return nil, err
+	}
+	if gainLoss.CurrencyCode != m.functionalCurrency {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"gain/loss account %s currency %s is not the functional currency %s",
+			gainLoss.ID, gainLoss.CurrencyCode, m.functionalCurrency)
+	}
+
+	asOf := req.AsOf.AsTime()
+	balances, err := m.repo.ListForeignBalances(ctx, m.functionalCurrency, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.RunRevaluationResponse{
+		FunctionalCurrencyCode: m.functionalCurrency,
+	}
+	var lines []*pb.JournalEntryLine
+	var net int64
+	for _, balance := range balances {
+		if !revaluedAccountTypes[balance.AccountType] {
+			continue
+		}
+
+		if needsRate(balance) && rates[balance.CurrencyCode] == nil {
+			rate, err := m.rates.GetClosingRate(ctx, balance.CurrencyCode, m.functionalCurrency, asOf, req.RateSource)
+			if err != nil {
+				return nil, err
+			}
+			if rate != nil {
+				rates[balance.CurrencyCode] = rate
+			}
+		}
+
+		revaluation, err := revalue(balance, rates, req.RateSource)
+		if err != nil {
+			return nil, err
+		}
+		resp.Revaluations = append(resp.Revaluations, revaluation.proto)
+		if revaluation.adjustment == 0 {
+			continue
+		}
+
+		line := &pb.JournalEntryLine{
+			AccountId:    balance.AccountID,
+			CurrencyCode: balance.CurrencyCode,
+			Description:  fmt.Sprintf("Revaluation at %s (%s)", revaluation.proto.ExchangeRate, req.RateSource),
+		}
+		if revaluation.proto.ExchangeRate == "" {
+			line.Description = "Revaluation of zero balance"
+		}
+		if revaluation.adjustment > 0 {
+			line.FunctionalDebitAmount = amount.Format(revaluation.adjustment)
+		} else {
+			line.FunctionalCreditAmount = amount.Format(-revaluation.adjustment)
+		}
+		lines = append(lines, line)
+		net += revaluation.adjustment
+	}
+
+	if len(lines) == 0 {
+		return resp, nil
+	}
+
+	// The net change is the unrealized gain (credit) or loss (debit)
+	if net != 0 {
+		line := &pb.JournalEntryLine{
+			AccountId:   gainLoss.ID,
+			Description: "Unrealized FX gain/loss",
+		}
+		if net > 0 {
+			line.CreditAmount = amount.Format(net)
+		} else {
+			line.DebitAmount = amount.Format(-net)
+		}
+		lines = append(lines, line)
+	}
+
+	entry, err := m.entries.PrepareJournalEntry(ctx, &pb.PostJournalEntryRequest{
+		EntryDate:    req.AsOf,
+		Description:  fmt.Sprintf("FX revaluation as of %s", asOf.UTC().Format(time.RFC3339)),
+		CurrencyCode: m.functionalCurrency,
+		Lines:        lines,
+		Metadata: map[string]string{
+			"revaluation_as_of": asOf.UTC().Format(time.RFC3339),
+			"rate_source":       req.RateSource,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+
+	entry.Entry.CreatedBy = sql.NullString{String: actor, Valid: true}
+	if err := m.writer.CreateJournalEntry(ctx, entry.Entry, entry.Lines, entry.AccountVersions, entry.EntityIDs); err != nil {
+		return nil, err
+	}
+	resp.JournalEntry = entry.Proto()
+
+	return resp, nil
+}
+
+// needsRate reports whether revaluing the balance needs a closing rate
+func needsRate(balance *ForeignBalanceRow) bool {
+	return balance.Status == account.StatusActive &&
+		balance.UnconvertedLines == 0 &&
+		balance.DebitTotal != balance.CreditTotal
+}
+
+// accountRevaluation is the revaluation of one account
+type accountRevaluation struct {
+	proto      *pb.AccountRevaluation
+	adjustment int64
+}
+
+// revalue computes the adjustment that brings the carried functional
+// balance of an account to its balance at the closing rate
+// Spec: docs/specs/014-multi-currency.md#revaluation
+func revalue(balance *ForeignBalanceRow, rates map[string]*big.Rat, rateSource string) (*accountRevaluation, error) {
+	native := balance.DebitTotal - balance.CreditTotal
+	carried := balance.FunctionalDebitTotal - balance.FunctionalCreditTotal
+	result := &accountRevaluation{
+		proto: &pb.AccountRevaluation{
+			AccountId:      balance.AccountID,
+			CurrencyCode:   balance.CurrencyCode,
+			Balance:        amount.Format(native),
+			CarriedBalance: amount.Format(carried),
+		},
+	}
+
+	if balance.Status != account.StatusActive {
+		result.proto.SkippedReason = fmt.Sprintf("account is %s", strings.ToLower(balance.Status))
+		return result, nil
+	}
+	if balance.UnconvertedLines > 0 {
+		result.proto.SkippedReason = fmt.Sprintf("%d lines have no functional amounts", balance.UnconvertedLines)
+		return result, nil
+	}
+
+	// A zero balance revalues to zero at any rate
+	revalued := int64(0)
+	if native != 0 {
+		rate, ok := rates[balance.CurrencyCode]
+		if !ok {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"no %s rate from %s for account %s", balance.CurrencyCode, rateSource, balance.AccountID)
+		}
+		var err error
+		if revalued, err = amount.Convert(native, rate); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "account %s: %v", balance.AccountID, err)
+		}
+		result.proto.ExchangeRate = amount.FormatRate(rate)
+	}
+
+	result.adjustment = revalued - carried
+	result.proto.RevaluedBalance = amount.Format(revalued)
+	result.proto.Adjustment = amount.Format(result.adjustment)
+	return result, nil
+}
@@ -0,0 +1,288 @@
+package revaluation
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/journal"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MockRepository is a mock implementation of RevaluationRepository
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListForeignBalances(ctx context.Context, functionalCurrency string, asOf time.Time) ([]*ForeignBalanceRow, error) {
+	args := m.Called(ctx, functionalCurrency, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ForeignBalanceRow), args.Error(1)
+}
+
+// MockAccountReader is a mock implementation of AccountReaderInterface
+type MockAccountReader struct {
+	mock.Mock
+}
+
+func (m *MockAccountReader) GetAccountByID(ctx context.Context, accountID string) (*account.AccountRow, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*account.AccountRow), args.Error(1)
+}
+
+// MockEntryPreparer is a mock implementation of EntryPreparerInterface
+type MockEntryPreparer struct {
+	mock.Mock
+}
+
+func (m *MockEntryPreparer) PrepareJournalEntry(ctx context.Context, req *pb.PostJournalEntryRequest) (*journal.PreparedEntry, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*journal.PreparedEntry), args.Error(1)
+}
+
+// MockEntryWriter is a mock implementation of EntryWriterInterface
+type MockEntryWriter struct {
+	mock.Mock
+}
+
+func (m *MockEntryWriter) CreateJournalEntry(ctx context.Context, entry *journal.JournalEntryRow, lines []*journal.JournalEntryLineRow, accountVersions map[string]int64, entityIDs []string) error {
+	args := m.Called(ctx, entry, lines, accountVersions, entityIDs)
+	return args.Error(0)
+}
+
+// MockRateProvider is a mock implementation of RateProviderInterface
+type MockRateProvider struct {
+	mock.Mock
+}
+
+func (m *MockRateProvider) GetClosingRate(ctx context.Context, currency, functionalCurrency string, asOf time.Time, source string) (*big.Rat, error) {
+	args := m.Called(ctx, currency, functionalCurrency, asOf, source)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*big.Rat), args.Error(1)
+}
+
+type testMocks struct {
+	repo     *MockRepository
+	accounts *MockAccountReader
+	entries  *MockEntryPreparer
+	writer   *MockEntryWriter
+	rates    *MockRateProvider
+}
+
+// newTestManager wires a manager with fresh mocks and USD as the
+// functional currency
+func newTestManager() (*Manager, *testMocks) {
+	mocks := &testMocks{
+		repo:     new(MockRepository),
+		accounts: new(MockAccountReader),
+		entries:  new(MockEntryPreparer),
+		writer:   new(MockEntryWriter),
+		rates:    new(MockRateProvider),
+	}
+	return NewManager(mocks.repo, mocks.accounts, mocks.entries, mocks.writer, mocks.rates, account.NewValidator(), "USD"), mocks
+}
+
+// TestRunRevaluation tests revaluation of foreign-currency balances
+// Spec: docs/specs/014-multi-currency.md#story-3-run-revaluation
+func TestRunRevaluation(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 9, 30, 23, 59, 59, 0, time.UTC)
+	gainLoss := &account.AccountRow{ID: "acc-fx", CurrencyCode: "USD", Status: account.StatusActive}
+	request := func() *pb.RunRevaluationRequest {
+		return &pb.RunRevaluationRequest{
+			AsOf:              timestamppb.New(asOf),
+			RateSource:        "ECB",
+			Rates:             map[string]string{"EUR": "1.10", "GBP": "1.25"},
+			GainLossAccountId: "acc-fx",
+			Actor:             "controller",
+		}
+	}
+	balances := func() []*ForeignBalanceRow {
+		return []*ForeignBalanceRow{
+			// EUR 100 carried at 108.50 revalues to 110.00
+			{AccountID: "acc-eur", CurrencyCode: "EUR", AccountType: "ASSET", Status: account.StatusActive,
+				DebitTotal: 1000000, FunctionalDebitTotal: 1085000},
+			// GBP 40 owed, carried at 52.00, revalues to 50.00
+			{AccountID: "acc-gbp", CurrencyCode: "GBP", AccountType: "LIABILITY", Status: account.StatusActive,
+				CreditTotal: 400000, FunctionalCreditTotal: 520000},
+			{AccountID: "acc-eur-rev", CurrencyCode: "EUR", AccountType: "REVENUE", Status: account.StatusActive,
+				CreditTotal: 1000000, FunctionalCreditTotal: 1085000},
+			{AccountID: "acc-eur-frozen", CurrencyCode: "EUR", AccountType: "ASSET", Status: account.StatusFrozen,
+				DebitTotal: 10000, FunctionalDebitTotal: 10000},
+			{AccountID: "acc-eur-legacy", CurrencyCode: "EUR", AccountType: "ASSET", Status: account.StatusActive,
+				DebitTotal: 10000, UnconvertedLines: 2},
+		}
+	}
+
+	t.Run("posts adjustments and net gain", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-fx").Return(gainLoss, nil).Once()
+		mocks.repo.On("ListForeignBalances", ctx, "USD", asOf).Return(balances(), nil).Once()
+
+		prepared := &journal.PreparedEntry{
+			Entry:           &journal.JournalEntryRow{ID: "entry-1", CurrencyCode: "USD"},
+			AccountVersions: map[string]int64{"acc-eur": 1},
+		}
+		mocks.entries.On("PrepareJournalEntry", ctx, mock.AnythingOfType("*ledger.PostJournalEntryRequest")).
+			Run(func(args mock.Arguments) {
+				req := args.Get(1).(*pb.PostJournalEntryRequest)
+				assert.Equal(t, "USD", req.CurrencyCode)
+				assert.Equal(t, asOf, req.EntryDate.AsTime())
+				assert.Equal(t, "ECB", req.Metadata["rate_source"])
+				assert.Len(t, req.Lines, 3)
+				assert.Equal(t, "acc-eur", req.Lines[0].AccountId)
+				assert.Equal(t, "EUR", req.Lines[0].CurrencyCode)
+				assert.Equal(t, "1.5000", req.Lines[0].FunctionalDebitAmount)
+				assert.Equal(t, "acc-gbp", req.Lines[1].AccountId)
+				assert.Equal(t, "2.0000", req.Lines[1].FunctionalDebitAmount)
+				assert.Equal(t, "acc-fx", req.Lines[2].AccountId)
+				assert.Equal(t, "3.5000", req.Lines[2].CreditAmount)
+			}).
+			Return(prepared, nil).Once()
+		mocks.writer.On("CreateJournalEntry", ctx, prepared.Entry, prepared.Lines, prepared.AccountVersions, prepared.EntityIDs).
+			Return(nil).Once()
+
+		resp, err := manager.RunRevaluation(ctx, request())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", resp.FunctionalCurrencyCode)
+		assert.Equal(t, "entry-1", resp.JournalEntry.Id)
+		assert.Equal(t, "controller", prepared.Entry.CreatedBy.String)
+		assert.Len(t, resp.Revaluations, 4)
+		assert.Equal(t, &pb.AccountRevaluation{
+			AccountId:       "acc-eur",
+			CurrencyCode:    "EUR",
+			Balance:         "100.0000",
+			ExchangeRate:    "1.1",
+			CarriedBalance:  "108.5000",
+			RevaluedBalance: "110.0000",
+			Adjustment:      "1.5000",
+		}, resp.Revaluations[0])
+		assert.Equal(t, "-50.0000", resp.Revaluations[1].RevaluedBalance)
+		assert.Equal(t, "account is frozen", resp.Revaluations[2].SkippedReason)
+		assert.Equal(t, "2 lines have no functional amounts", resp.Revaluations[3].SkippedReason)
+		mocks.writer.AssertExpectations(t)
+	})
+
+	t.Run("nothing to adjust", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-fx").Return(gainLoss, nil).Once()
+		mocks.repo.On("ListForeignBalances", ctx, "USD", asOf).Return([]*ForeignBalanceRow{
+			{AccountID: "acc-eur", CurrencyCode: "EUR", AccountType: "ASSET", Status: account.StatusActive,
+				DebitTotal: 1000000, FunctionalDebitTotal: 1100000},
+		}, nil).Once()
+
+		resp, err := manager.RunRevaluation(ctx, request())
+
+		assert.NoError(t, err)
+		assert.Nil(t, resp.JournalEntry)
+		assert.Equal(t, "0.0000", resp.Revaluations[0].Adjustment)
+		mocks.entries.AssertNotCalled(t, "PrepareJournalEntry", mock.Anything, mock.Anything)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-fx").Return(gainLoss, nil).Once()
+		mocks.repo.On("ListForeignBalances", ctx, "USD", asOf).Return(balances(), nil).Once()
+		mocks.entries.On("PrepareJournalEntry", ctx, mock.Anything).
+			Return(&journal.PreparedEntry{Entry: &journal.JournalEntryRow{}}, nil).Once()
+		req := request()
+		req.DryRun = true
+
+		resp, err := manager.RunRevaluation(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Nil(t, resp.JournalEntry)
+		assert.Equal(t, "1.5000", resp.Revaluations[0].Adjustment)
+		mocks.writer.AssertNotCalled(t, "CreateJournalEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing rate", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-fx").Return(gainLoss, nil).Once()
+		mocks.repo.On("ListForeignBalances", ctx, "USD", asOf).Return(balances(), nil).Once()
+		mocks.rates.On("GetClosingRate", ctx, "GBP", "USD", asOf, "ECB").Return(nil, nil).Once()
+		req := request()
+		delete(req.Rates, "GBP")
+
+		_, err := manager.RunRevaluation(ctx, req)
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, "no GBP rate from ECB for account acc-gbp", st.Message())
+	})
+
+	t.Run("reads missing rates from treasury", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-fx").Return(gainLoss, nil).Once()
+		mocks.repo.On("ListForeignBalances", ctx, "USD", asOf).Return(balances(), nil).Once()
+		mocks.rates.On("GetClosingRate", ctx, "EUR", "USD", asOf, "ECB").Return(big.NewRat(11, 10), nil).Once()
+		mocks.entries.On("PrepareJournalEntry", ctx, mock.Anything).
+			Return(&journal.PreparedEntry{Entry: &journal.JournalEntryRow{}}, nil).Once()
+		req := request()
+		req.Rates = map[string]string{"GBP": "1.25"}
+		req.DryRun = true
+
+		resp, err := manager.RunRevaluation(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1.1", resp.Revaluations[0].ExchangeRate)
+		assert.Equal(t, "1.5000", resp.Revaluations[0].Adjustment)
+		// One lookup per currency, skipped accounts need no rate
+		mocks.rates.AssertExpectations(t)
+	})
+
+	t.Run("gain/loss account in foreign currency", func(t *testing.T) {
+		manager, mocks := newTestManager()
+		mocks.accounts.On("GetAccountByID", ctx, "acc-fx").
+			Return(&account.AccountRow{ID: "acc-fx", CurrencyCode: "EUR"}, nil).Once()
+
+		_, err := manager.RunRevaluation(ctx, request())
+
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, "gain/loss account acc-fx currency EUR is not the functional currency USD", st.Message())
+	})
+
+	tests := []struct {
+		name    string
+		modify  func(req *pb.RunRevaluationRequest)
+		wantErr string
+	}{
+		{"missing as_of", func(req *pb.RunRevaluationRequest) { req.AsOf = nil }, "as_of is required"},
+		{"missing rate source", func(req *pb.RunRevaluationRequest) { req.RateSource = "" }, "rate_source is required"},
+		{"missing gain/loss account", func(req *pb.RunRevaluationRequest) { req.GainLossAccountId = "" }, "gain_loss_account_id is required"},
+		{"invalid rate", func(req *pb.RunRevaluationRequest) { req.Rates["EUR"] = "-1" }, `invalid rate for EUR: rate "-1" is not a decimal number`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, _ := newTestManager()
+			req := request()
+			tt.modify(req)
+
+			_, err := manager.RunRevaluation(ctx, req)
+
+			st, _ := status.FromError(err)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, tt.wantErr, st.Message())
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package revaluation
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"clarity/treasury-services/ledger-service/pkg/amount"
+	treasurypb "example.com/go-mono-repo/proto/treasury"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TreasuryRates reads closing rates from the Treasury Service
+// ExchangeRateService
+// Spec: docs/specs/014-multi-currency.md#closing-rates
+type TreasuryRates struct {
+	client        treasurypb.ExchangeRateServiceClient
+	lookupTimeout time.Duration
+}
+
+// NewTreasuryRates creates a rate provider backed by the Treasury Service
+func NewTreasuryRates(client treasurypb.ExchangeRateServiceClient, lookupTimeout time.Duration) *TreasuryRates {
+	return &TreasuryRates{
+		client:        client,
+		lookupTimeout: lookupTimeout,
+	}
+}
+
+// GetClosingRate returns the closing rate from currency to the functional
+// currency published by source at or before asOf, or nil when treasury has
+// none
+func (r *TreasuryRates) GetClosingRate(ctx context.Context, currency, functionalCurrency string, asOf time.Time, source string) (*big.Rat, error) {
+	if r.lookupTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.lookupTimeout)
+		defer cancel()
+	}
+
+	resp, err := r.client.GetRate(ctx, &treasurypb.GetRateRequest{
+		BaseCurrency:  currency,
+		QuoteCurrency: functionalCurrency,
+		AsOf:          timestamppb.New(asOf),
+		RateType:      treasurypb.RateType_RATE_TYPE_CLOSING,
+		Source:        source,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "cannot get %s rate: treasury service unavailable: %v", currency, err)
+	}
+
+	rate, err := amount.ParseRate(resp.Rate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid %s rate from treasury service: %v", currency, err)
+	}
+	return rate, nil
+}
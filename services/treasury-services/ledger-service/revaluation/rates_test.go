@@ -0,0 +1,71 @@
+package revaluation
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	treasurypb "example.com/go-mono-repo/proto/treasury"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MockExchangeRateClient is a mock Treasury Service ExchangeRateService
+// client. Only GetRate is used by TreasuryRates.
+type MockExchangeRateClient struct {
+	treasurypb.ExchangeRateServiceClient
+	mock.Mock
+}
+
+func (m *MockExchangeRateClient) GetRate(ctx context.Context, req *treasurypb.GetRateRequest, opts ...grpc.CallOption) (*treasurypb.GetRateResponse, error) {
+	args := m.Called(req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*treasurypb.GetRateResponse), args.Error(1)
+}
+
+// TestTreasuryRates tests closing rate lookups through the Treasury Service
+// Spec: docs/specs/014-multi-currency.md#closing-rates
+func TestTreasuryRates(t *testing.T) {
+	ctx := context.Background()
+	asOf := time.Date(2025, 9, 30, 23, 59, 59, 0, time.UTC)
+	closingEUR := mock.MatchedBy(func(req *treasurypb.GetRateRequest) bool {
+		return req.BaseCurrency == "EUR" && req.QuoteCurrency == "USD" && req.Source == "ECB" &&
+			req.RateType == treasurypb.RateType_RATE_TYPE_CLOSING && req.AsOf.AsTime().Equal(asOf)
+	})
+
+	t.Run("returns the closing rate", func(t *testing.T) {
+		client := new(MockExchangeRateClient)
+		client.On("GetRate", closingEUR).Return(&treasurypb.GetRateResponse{Rate: "1.1"}, nil).Once()
+
+		rate, err := NewTreasuryRates(client, time.Second).GetClosingRate(ctx, "EUR", "USD", asOf, "ECB")
+
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewRat(11, 10), rate)
+	})
+
+	t.Run("no rate", func(t *testing.T) {
+		client := new(MockExchangeRateClient)
+		client.On("GetRate", closingEUR).Return(nil, status.Error(codes.NotFound, "no closing rate")).Once()
+
+		rate, err := NewTreasuryRates(client, time.Second).GetClosingRate(ctx, "EUR", "USD", asOf, "ECB")
+
+		assert.NoError(t, err)
+		assert.Nil(t, rate)
+	})
+
+	t.Run("treasury unavailable", func(t *testing.T) {
+		client := new(MockExchangeRateClient)
+		client.On("GetRate", closingEUR).Return(nil, errors.New("connection refused")).Once()
+
+		_, err := NewTreasuryRates(client, time.Second).GetClosingRate(ctx, "EUR", "USD", asOf, "ECB")
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
@@ -0,0 +1,116 @@
+package revaluation
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevaluationRepository reads the foreign-currency balances a revaluation
+// adjusts
+// Spec: docs/specs/014-multi-currency.md#revaluation
+type RevaluationRepository struct {
+	db client.ImmuClient
+}
+
+// NewRevaluationRepository creates a new revaluation repository
+func NewRevaluationRepository(db client.ImmuClient) *RevaluationRepository {
+	return &RevaluationRepository{
+		db: db,
+	}
+}
+
+// ForeignBalanceRow is an account whose currency is not the functional
+// currency, with its posted totals. Amounts are scaled by 10^amount.Scale.
+type ForeignBalanceRow struct {
+	AccountID    string
+	CurrencyCode string
+	AccountType  string
+	Status       string
+
+	DebitTotal            int64 // In the account currency
+	CreditTotal           int64 // In the account currency
+	FunctionalDebitTotal  int64 // Carried value in the functional currency
+	FunctionalCreditTotal int64 // Carried value in the functional currency
+
+	// Lines without functional amounts, posted before multi-currency
+	// support or under another functional currency
+	UnconvertedLines int64
+}
+
+// ListForeignBalances returns every account not in the functional currency
+// with the totals of the journal lines dated on or before asOf, ordered by
+// account ID
+// Spec: docs/specs/014-multi-currency.md#revaluation
+func (r *RevaluationRepository) ListForeignBalances(ctx context.Context, functionalCurrency string, asOf time.Time) ([]*ForeignBalanceRow, error) {
+	params := map[string]interface{}{
+		"functional_currency": functionalCurrency,
+		"as_of":               asOf,
+	}
+
+	result, err := r.db.SQLQuery(ctx, `
+		SELECT id, currency_code, account_type, status
+		FROM accounts
+		WHERE currency_code != @functional_currency`, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query foreign-currency accounts: %v", err)
+	}
+
+	balances := make(map[string]*ForeignBalanceRow, len(result.Rows))
+	rows := make([]*ForeignBalanceRow, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		balance := &ForeignBalanceRow{
+			AccountID:    row.Values[0].GetS(),
+			CurrencyCode: row.Values[1].GetS(),
+			AccountType:  strings.ToUpper(row.Values[2].GetS()),
+			Status:       row.Values[3].GetS(),
+		}
+		balances[balance.AccountID] = balance
+		rows = append(rows, balance)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].AccountID < rows[j].AccountID })
+
+	// Lines of legacy entries have NULL functional amounts, which SUM skips
+	result, err = r.db.SQLQuery(ctx, `
+		SELECT l.account_id, COUNT(*), SUM(l.debit_amount), SUM(l.credit_amount),
+		       SUM(l.functional_debit_amount), SUM(l.functional_credit_amount)
+		FROM journal_entry_lines AS l
+		INNER JOIN journal_entries AS e ON l.journal_entry_id = e.id
+		WHERE l.currency_code != @functional_currency AND e.entry_date <= @as_of
+		GROUP BY l.account_id`, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query foreign-currency totals: %v", err)
+	}
+	for _, row := range result.Rows {
+		if balance, ok := balances[row.Values[0].GetS()]; ok {
+			balance.UnconvertedLines = row.Values[1].GetN()
+			balance.DebitTotal = row.Values[2].GetN()
+			balance.CreditTotal = row.Values[3].GetN()
+			balance.FunctionalDebitTotal = row.Values[4].GetN()
+			balance.FunctionalCreditTotal = row.Values[5].GetN()
+		}
+	}
+
+	result, err = r.db.SQLQuery(ctx, `
+		SELECT l.account_id, COUNT(*)
+		FROM journal_entry_lines AS l
+		INNER JOIN journal_entries AS e ON l.journal_entry_id = e.id
+		WHERE l.currency_code != @functional_currency AND e.entry_date <= @as_of
+		  AND e.functional_currency = @functional_currency
+		GROUP BY l.account_id`, params, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query converted lines: %v", err)
+	}
+	for _, row := range result.Rows {
+		if balance, ok := balances[row.Values[0].GetS()]; ok {
+			balance.UnconvertedLines -= row.Values[1].GetN()
+		}
+	}
+
+	return rows, nil
+}
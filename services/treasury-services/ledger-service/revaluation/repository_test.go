@@ -0,0 +1,89 @@
+package revaluation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/pkg/client"
+	"github.com/codenotary/immudb/pkg/server"
+	"github.com/codenotary/immudb/pkg/server/servertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSchema creates the journal tables as before migration 012 with a
+// legacy line on acc-legacy, then adds the functional columns
+var testSchema = []string{
+	`CREATE TABLE accounts (id VARCHAR(36), currency_code VARCHAR(3), account_type VARCHAR(20), status VARCHAR(20), PRIMARY KEY (id))`,
+	`CREATE TABLE journal_entries (id VARCHAR(36), entry_date TIMESTAMP, currency_code VARCHAR(3), PRIMARY KEY (id))`,
+	`CREATE TABLE journal_entry_lines (
+		id VARCHAR(36), journal_entry_id VARCHAR(36), account_id VARCHAR(36), currency_code VARCHAR(3),
+		debit_amount INTEGER, credit_amount INTEGER, PRIMARY KEY (id))`,
+	`INSERT INTO accounts (id, currency_code, account_type, status) VALUES
+		('acc-usd', 'USD', 'ASSET', 'ACTIVE'),
+		('acc-eur', 'EUR', 'ASSET', 'ACTIVE'),
+		('acc-legacy', 'EUR', 'LIABILITY', 'ACTIVE'),
+		('acc-idle', 'GBP', 'ASSET', 'ACTIVE')`,
+	`INSERT INTO journal_entries (id, entry_date, currency_code) VALUES ('je-legacy', CAST('2025-08-01 00:00:00' AS TIMESTAMP), 'EUR')`,
+	`INSERT INTO journal_entry_lines (id, journal_entry_id, account_id, currency_code, debit_amount, credit_amount) VALUES
+		('l-legacy', 'je-legacy', 'acc-legacy', 'EUR', 0, 50000)`,
+	`ALTER TABLE journal_entries ADD COLUMN functional_currency VARCHAR(3)`,
+	`ALTER TABLE journal_entry_lines ADD COLUMN exchange_rate VARCHAR(32)`,
+	`ALTER TABLE journal_entry_lines ADD COLUMN functional_debit_amount INTEGER`,
+	`ALTER TABLE journal_entry_lines ADD COLUMN functional_credit_amount INTEGER`,
+	`INSERT INTO journal_entries (id, entry_date, currency_code, functional_currency) VALUES
+		('je-1', CAST('2025-09-01 00:00:00' AS TIMESTAMP), 'USD', 'USD'),
+		('je-2', CAST('2025-09-15 00:00:00' AS TIMESTAMP), 'USD', 'USD'),
+		('je-3', CAST('2025-10-01 00:00:00' AS TIMESTAMP), 'USD', 'USD')`,
+	`INSERT INTO journal_entry_lines (id, journal_entry_id, account_id, currency_code, debit_amount, credit_amount,
+		exchange_rate, functional_debit_amount, functional_credit_amount) VALUES
+		('l-1a', 'je-1', 'acc-eur', 'EUR', 1000000, 0, '1.085', 1085000, 0),
+		('l-1b', 'je-1', 'acc-usd', 'USD', 0, 1085000, '1', 0, 1085000),
+		('l-2a', 'je-2', 'acc-eur', 'EUR', 0, 0, NULL, 15000, 0),
+		('l-2b', 'je-2', 'acc-legacy', 'EUR', 0, 0, NULL, 0, 2000),
+		('l-3a', 'je-3', 'acc-eur', 'EUR', 500000, 0, '1.1', 550000, 0)`,
+}
+
+// newTestRepository returns a repository backed by an in-process ImmuDB
+func newTestRepository(t *testing.T) *RevaluationRepository {
+	t.Helper()
+
+	opts := server.DefaultOptions().
+		WithDir(t.TempDir()).
+		WithPgsqlServer(false).
+		WithMetricsServer(false).
+		WithWebServer(false)
+	bs := servertest.NewBufconnServer(opts)
+	require.NoError(t, bs.Start())
+	t.Cleanup(func() { bs.Stop() })
+
+	db, err := bs.NewAuthenticatedClient(client.DefaultOptions().WithDir(t.TempDir()))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.CloseSession(context.Background()) })
+
+	for _, stmt := range testSchema {
+		_, err := db.SQLExec(context.Background(), stmt, nil)
+		require.NoError(t, err)
+	}
+
+	return NewRevaluationRepository(db)
+}
+
+// TestListForeignBalances tests the totals a revaluation starts from
+// Spec: docs/specs/014-multi-currency.md#revaluation
+func TestListForeignBalances(t *testing.T) {
+	repo := newTestRepository(t)
+	asOf := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	rows, err := repo.ListForeignBalances(context.Background(), "USD", asOf)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*ForeignBalanceRow{
+		{AccountID: "acc-eur", CurrencyCode: "EUR", AccountType: "ASSET", Status: "ACTIVE",
+			DebitTotal: 1000000, FunctionalDebitTotal: 1100000},
+		{AccountID: "acc-idle", CurrencyCode: "GBP", AccountType: "ASSET", Status: "ACTIVE"},
+		{AccountID: "acc-legacy", CurrencyCode: "EUR", AccountType: "LIABILITY", Status: "ACTIVE",
+			CreditTotal: 50000, FunctionalCreditTotal: 2000, UnconvertedLines: 1},
+	}, rows)
+}
@@ -0,0 +1,54 @@
+package revaluation
+
+import (
+	"context"
+	"log"
+
+	"clarity/treasury-services/ledger-service/account"
+	"clarity/treasury-services/ledger-service/journal"
+	"clarity/treasury-services/ledger-service/period"
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/ledger"
+	"github.com/codenotary/immudb/pkg/client"
+)
+
+// Server implements the RevaluationService gRPC interface
+// Spec: docs/specs/014-multi-currency.md
+type Server struct {
+	pb.UnimplementedRevaluationServiceServer
+	manager ManagerInterface
+}
+
+// NewServer creates a new revaluation server
+func NewServer(db client.ImmuClient, currencies *account.Validator, cursors *pagination.Codec, rates RateProviderInterface, functionalCurrency string) *Server {
+	repo := NewRevaluationRepository(db)
+	accountRepo := account.NewAccountRepository(db, cursors)
+	periods := period.NewManager(period.NewPeriodRepository(db), currencies)
+	journalRepo := journal.NewJournalRepository(db, cursors)
+	entries := journal.NewManager(journalRepo, accountRepo, journal.NewValidator(), currencies, periods, functionalCurrency)
+	manager := NewManager(repo, accountRepo, entries, journalRepo, rates, currencies, functionalCurrency)
+
+	return &Server{
+		manager: manager,
+	}
+}
+
+// RunRevaluation posts unrealized FX gains and losses for foreign-currency
+// accounts
+// Spec: docs/specs/014-multi-currency.md#story-3-run-revaluation
+func (s *Server) RunRevaluation(ctx context.Context, req *pb.RunRevaluationRequest) (*pb.RunRevaluationResponse, error) {
+	log.Printf("Running revaluation: as_of=%v, rate_source=%s, dry_run=%t", req.AsOf.AsTime(), req.RateSource, req.DryRun)
+
+	resp, err := s.manager.RunRevaluation(ctx, req)
+	if err != nil {
+		log.Printf("Failed to run revaluation: %v", err)
+		return nil, err
+	}
+
+	if resp.JournalEntry != nil {
+		log.Printf("Revalued %d accounts in journal entry %s", len(resp.Revaluations), resp.JournalEntry.Id)
+	} else {
+		log.Printf("Revalued %d accounts, no entry posted", len(resp.Revaluations))
+	}
+	return resp, nil
+}
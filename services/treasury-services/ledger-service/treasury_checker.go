@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"clarity/treasury-services/ledger-service/account"
+	pb "example.com/go-mono-repo/proto/ledger"
+)
+
+// TreasuryCurrencyChecker implements DependencyChecker for the Treasury
+// Service currency lookups made by the account validator
+// Spec: docs/specs/010-treasury-currency-validation.md#story-3-health-dependency
+type TreasuryCurrencyChecker struct {
+	validator *account.Validator
+	config    *TreasuryServiceConfig
+}
+
+// NewTreasuryCurrencyChecker creates a new Treasury Service health checker
+func NewTreasuryCurrencyChecker(validator *account.Validator, config *TreasuryServiceConfig) *TreasuryCurrencyChecker {
+	return &TreasuryCurrencyChecker{
+		validator: validator,
+		config:    config,
+	}
+}
+
+// Check reports the outcome of the most recent currency lookup.
+// Treasury is not critical because the validator falls back to its cache.
+func (t *TreasuryCurrencyChecker) Check(ctx context.Context) *pb.DependencyHealth {
+	lookup := t.validator.CurrencyLookupStatus()
+
+	dep := &pb.DependencyHealth{
+		Name:       t.Name(),
+		Type:       pb.DependencyType_GRPC_SERVICE,
+		IsCritical: false,
+		Config: &pb.DependencyConfig{
+			Hostname: t.config.Host,
+			Port:     int32(t.config.Port),
+			Protocol: "grpc",
+			Metadata: map[string]string{
+				"service":      "treasury",
+				"rpc":          "CurrencyService.GetCurrency",
+				"cache_ttl":    t.config.CurrencyCacheTTL.String(),
+				"cached_codes": strconv.Itoa(lookup.CachedCodes),
+			},
+		},
+	}
+
+	if !lookup.LastSuccess.IsZero() {
+		dep.LastSuccess = lookup.LastSuccess.Format(time.RFC3339)
+	}
+
+	switch {
+	case lookup.LastCheck.IsZero():
+		dep.Status = pb.ServiceStatus_HEALTHY
+		dep.Message = "No currency lookups yet"
+	case lookup.LastError != nil:
+		dep.Status = pb.ServiceStatus_UNHEALTHY
+		dep.Message = "Currency lookups failing, serving cached currencies"
+		dep.Error = lookup.LastError.Error()
+		dep.LastCheck = lookup.LastCheck.Format(time.RFC3339)
+	default:
+		dep.Status = pb.ServiceStatus_HEALTHY
+		dep.Message = "Currency lookups succeeding"
+		dep.LastCheck = lookup.LastCheck.Format(time.RFC3339)
+	}
+
+	return dep
+}
+
+// Name returns the name of this dependency checker
+func (t *TreasuryCurrencyChecker) Name() string {
+	return "treasury-service"
+}
@@ -0,0 +1,808 @@
+package bankaccount
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/treasury"
+	"github.com/jamestroutman/treasury-service/iban"
+)
+
+// Manager handles bank account database operations
+// Spec: docs/specs/008-bank-accounts.md
+type Manager struct {
+	db      *sql.DB
+	cursors *pagination.Codec
+}
+
+// NewManager creates a new bank account manager instance
+// Spec: docs/specs/008-bank-accounts.md
+func NewManager(db *sql.DB, cursors *pagination.Codec) *Manager {
+	return &Manager{
+		db:      db,
+		cursors: cursors,
+	}
+}
+
+// bankAccountsCursorScope binds ListBankAccounts page tokens to that RPC
+const bankAccountsCursorScope = "treasury.bank_accounts"
+
+// bankAccountColumns are the columns read into a BankAccount, selected
+// from bank_accounts a joined to financial_institutions i
+const bankAccountColumns = `a.id, i.code, a.account_name, a.account_number, a.iban, a.currency_code,
+	a.purpose, a.signatories, a.ledger_account_external_id, a.status, a.opened_at, a.closed_at,
+	a.close_reason, a.created_at, a.updated_at, a.created_by, a.updated_by, a.version`
+
+// bankAccountJoin joins a bank account to its institution for bankAccountColumns
+const bankAccountJoin = " a JOIN treasury.financial_institutions i ON i.id = a.institution_id"
+
+var (
+	// Account number validation regex (letters and digits, as held by the bank)
+	accountNumberRegex = regexp.MustCompile(`^[A-Z0-9]{4,34}$`)
+	// ISO 4217 code validation regex (3 uppercase letters)
+	isoCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// CreateBankAccount registers a company bank account at an active
+// institution, in an active currency, linked to a ledger cash account
+// Spec: docs/specs/008-bank-accounts.md#story-1-register-bank-account
+func (m *Manager) CreateBankAccount(ctx context.Context, req *pb.CreateBankAccountRequest) (*pb.BankAccount, error) {
+	accountNumber := normalizeAccountNumber(req.AccountNumber)
+	accountIBAN := iban.Normalize(req.Iban)
+	if err := validateCreateRequest(req, accountNumber, accountIBAN); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	signatories, err := signatoriesToJSON(req.Signatories)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	openedAt := time.Now()
+	if req.OpenedAt != nil {
+		openedAt = req.OpenedAt.AsTime()
+	}
+	createdBy := req.CreatedBy
+	if createdBy == "" {
+		createdBy = "system"
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	institution, err := activeInstitution(ctx, tx, req.InstitutionCode)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIBANInstitution(accountIBAN, institution); err != nil {
+		return nil, err
+	}
+	if err := checkActiveCurrency(ctx, tx, req.CurrencyCode); err != nil {
+		return nil, err
+	}
+
+	row := tx.QueryRowContext(ctx, `
+		WITH a AS (
+			INSERT INTO treasury.bank_accounts (
+				id, institution_id, account_name, account_number, iban, currency_code,
+				purpose, signatories, ledger_account_external_id, status, opened_at,
+				created_at, updated_at, created_by, updated_by, version
+			) VALUES (
+				$1, $2, $3, $4, $5, $6,
+				$7, $8, $9, 'active', $10,
+				CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, $11, $11, 1
+			)
+			RETURNING *
+		)
+		SELECT `+bankAccountColumns+` FROM`+bankAccountJoin,
+		uuid.New(), institution.id, req.AccountName, accountNumber, nullString(accountIBAN), req.CurrencyCode,
+		mapPurposeToString(req.Purpose), signatories, req.LedgerAccountExternalId, openedAt, createdBy)
+
+	account, err := scanBankAccount(row)
+	if err != nil {
+		if uniqueErr := uniqueViolation(err, req.InstitutionCode, req.LedgerAccountExternalId); uniqueErr != nil {
+			return nil, uniqueErr
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create bank account: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return account, nil
+}
+
+// GetBankAccount retrieves a bank account by ID, or the open bank account
+// linked to a ledger account
+// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+func (m *Manager) GetBankAccount(ctx context.Context, req *pb.GetBankAccountRequest) (*pb.BankAccount, error) {
+	query := "SELECT " + bankAccountColumns + " FROM treasury.bank_accounts" + bankAccountJoin
+	var arg interface{}
+
+	switch id := req.Identifier.(type) {
+	case *pb.GetBankAccountRequest_Id:
+		if _, err := uuid.Parse(id.Id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid bank account ID")
+		}
+		query += " WHERE a.id = $1"
+		arg = id.Id
+	case *pb.GetBankAccountRequest_LedgerAccountExternalId:
+		if id.LedgerAccountExternalId == "" {
+			return nil, status.Error(codes.InvalidArgument, "ledger_account_external_id is required")
+		}
+		query += " WHERE a.ledger_account_external_id = $1 AND a.status != 'closed'"
+		arg = id.LedgerAccountExternalId
+	default:
+		return nil, status.Error(codes.InvalidArgument, "id or ledger_account_external_id is required")
+	}
+
+	account, err := scanBankAccount(m.db.QueryRowContext(ctx, query, arg))
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "bank account not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get bank account: %v", err)
+	}
+
+	return account, nil
+}
+
+// UpdateBankAccount updates the name, purpose, signatories or ledger link
+// of an open bank account. The institution, account number, IBAN and
+// currency identify the account at the bank and cannot change; a moved
+// account is closed and registered again.
+// Spec: docs/specs/008-bank-accounts.md#story-3-update-bank-account
+func (m *Manager) UpdateBankAccount(ctx context.Context, req *pb.UpdateBankAccountRequest) (*pb.BankAccount, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid bank account ID")
+	}
+
+	// Build dynamic update query based on update mask
+	updates := []string{}
+	args := []interface{}{}
+	argCount := 1
+
+	if req.UpdateMask != nil {
+		for _, path := range req.UpdateMask.Paths {
+			switch path {
+			case "account_name":
+				if err := validateAccountName(req.AccountName); err != nil {
+					return nil, status.Error(codes.InvalidArgument, err.Error())
+				}
+				updates = append(updates, fmt.Sprintf("account_name = $%d", argCount))
+				args = append(args, req.AccountName)
+				argCount++
+			case "purpose":
+				if req.Purpose == pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED {
+					return nil, status.Error(codes.InvalidArgument, "purpose is required")
+				}
+				updates = append(updates, fmt.Sprintf("purpose = $%d", argCount))
+				args = append(args, mapPurposeToString(req.Purpose))
+				argCount++
+			case "signatories":
+				signatories, err := signatoriesToJSON(req.Signatories)
+				if err != nil {
+					return nil, status.Error(codes.InvalidArgument, err.Error())
+				}
+				updates = append(updates, fmt.Sprintf("signatories = $%d", argCount))
+				args = append(args, signatories)
+				argCount++
+			case "ledger_account_external_id":
+				if err := validateLedgerAccount(req.LedgerAccountExternalId); err != nil {
+					return nil, status.Error(codes.InvalidArgument, err.Error())
+				}
+				updates = append(updates, fmt.Sprintf("ledger_account_external_id = $%d", argCount))
+				args = append(args, req.LedgerAccountExternalId)
+				argCount++
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "field %s cannot be updated", path)
+			}
+		}
+	}
+
+	if len(updates) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	updatedBy := req.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = "system"
+	}
+	updates = append(updates,
+		"updated_at = CURRENT_TIMESTAMP",
+		fmt.Sprintf("updated_by = $%d", argCount),
+		"version = version + 1")
+	args = append(args, updatedBy, req.Id)
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockOpenAccount(ctx, tx, req.Id, req.Version); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`
+		WITH a AS (
+			UPDATE treasury.bank_accounts
+			SET %s
+			WHERE id = $%d
+			RETURNING *
+		)
+		SELECT %s FROM%s`,
+		strings.Join(updates, ", "), argCount+1, bankAccountColumns, bankAccountJoin)
+
+	account, err := scanBankAccount(tx.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if uniqueErr := uniqueViolation(err, "", req.LedgerAccountExternalId); uniqueErr != nil {
+			return nil, uniqueErr
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update bank account: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return account, nil
+}
+
+// CloseBankAccount marks a bank account closed. Closed accounts are kept
+// for the record, free their ledger account for a new bank account and no
+// longer block deleting their institution.
+// Spec: docs/specs/008-bank-accounts.md#story-4-close-bank-account
+func (m *Manager) CloseBankAccount(ctx context.Context, req *pb.CloseBankAccountRequest) (*pb.BankAccount, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid bank account ID")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	closedBy := req.ClosedBy
+	if closedBy == "" {
+		closedBy = "system"
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockOpenAccount(ctx, tx, req.Id, req.Version); err != nil {
+		return nil, err
+	}
+
+	account, err := scanBankAccount(tx.QueryRowContext(ctx, `
+		WITH a AS (
+			UPDATE treasury.bank_accounts
+			SET status = 'closed',
+				closed_at = CURRENT_TIMESTAMP,
+				close_reason = $1,
+				updated_at = CURRENT_TIMESTAMP,
+				updated_by = $2,
+				version = version + 1
+			WHERE id = $3
+			RETURNING *
+		)
+		SELECT `+bankAccountColumns+` FROM`+bankAccountJoin,
+		req.Reason, closedBy, req.Id))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to close bank account: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return account, nil
+}
+
+// ListBankAccounts retrieves bank accounts with optional filters, newest first
+// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+func (m *Manager) ListBankAccounts(ctx context.Context, req *pb.ListBankAccountsRequest) (*pb.ListBankAccountsResponse, error) {
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	}
+
+	query := "SELECT " + bankAccountColumns + " FROM treasury.bank_accounts" + bankAccountJoin + " WHERE 1=1"
+
+	// Add filters
+	filterClause, filterArgs := bankAccountFilterClause(req)
+	query += filterClause
+	args := append([]interface{}{}, filterArgs...)
+	argCount := len(args) + 1
+
+	// Resume after the last account of the previous page
+	// Spec: docs/specs/005-cursor-pagination.md
+	cursorFilters := pagination.Filters{
+		"institution_code": req.InstitutionCode,
+		"currency_code":    req.CurrencyCode,
+		"purpose":          req.Purpose.String(),
+		"status":           req.Status.String(),
+	}
+	if req.PageToken != "" {
+		keys, err := m.cursors.Decode(req.PageToken, bankAccountsCursorScope, cursorFilters, 2)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, keys[0])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		query += fmt.Sprintf(" AND (a.created_at, a.id) < ($%d, $%d)", argCount, argCount+1)
+		args = append(args, createdAt, keys[1])
+		argCount += 2
+	}
+
+	// Add ordering
+	query += " ORDER BY a.created_at DESC, a.id DESC"
+
+	// Add pagination, fetching one extra row to tell whether another page follows
+	if req.PageSize > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argCount)
+		args = append(args, req.PageSize+1)
+	}
+
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bank accounts: %v", err)
+	}
+	defer rows.Close()
+
+	accounts := []*pb.BankAccount{}
+	for rows.Next() {
+		account, err := scanBankAccount(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan bank account: %v", err)
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating bank accounts: %v", err)
+	}
+
+	nextPageToken := ""
+	if req.PageSize > 0 && len(accounts) > int(req.PageSize) {
+		accounts = accounts[:req.PageSize]
+		last := accounts[len(accounts)-1]
+		nextPageToken = m.cursors.Encode(bankAccountsCursorScope, cursorFilters,
+			last.CreatedAt.AsTime().Format(time.RFC3339Nano), last.Id)
+	}
+
+	// Count total matching accounts with the same filters as the page
+	// Spec: docs/specs/005-cursor-pagination.md#total-count
+	var totalCount int32
+	if !req.SkipTotalCount {
+		countQuery := "SELECT COUNT(*) FROM treasury.bank_accounts" + bankAccountJoin + " WHERE 1=1" + filterClause
+		if err := m.db.QueryRowContext(ctx, countQuery, filterArgs...).Scan(&totalCount); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count bank accounts: %v", err)
+		}
+	}
+
+	return &pb.ListBankAccountsResponse{
+		BankAccounts:  accounts,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// institutionRef is the part of an institution a bank account is checked
+// against
+type institutionRef struct {
+	id          string
+	countryCode string
+	bankCode    string
+}
+
+// activeInstitution returns an active institution. Deleted institutions
+// are not found; inactive and suspended ones cannot take new accounts.
+func activeInstitution(ctx context.Context, tx *sql.Tx, code string) (*institutionRef, error) {
+	var (
+		institution       institutionRef
+		institutionStatus string
+		bankCode          sql.NullString
+	)
+	err := tx.QueryRowContext(ctx,
+		"SELECT id, status, country_code, bank_code FROM treasury.financial_institutions WHERE code = $1 AND status != 'deleted'",
+		code).Scan(&institution.id, &institutionStatus, &institution.countryCode, &bankCode)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "institution %s not found", code)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check institution: %v", err)
+	}
+	if institutionStatus != "active" {
+		return nil, status.Errorf(codes.FailedPrecondition, "institution %s is %s", code, institutionStatus)
+	}
+	institution.bankCode = bankCode.String
+	return &institution, nil
+}
+
+// checkIBANInstitution rejects an IBAN whose bank code differs from the
+// national bank code of an institution in the same country
+// Spec: docs/specs/009-iban.md#bank-accounts
+func checkIBANInstitution(accountIBAN string, institution *institutionRef) error {
+	if accountIBAN == "" || institution.bankCode == "" {
+		return nil
+	}
+	parsed, err := iban.Parse(accountIBAN)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid iban: %v", err)
+	}
+	if parsed.CountryCode == institution.countryCode && parsed.BankCode != institution.bankCode {
+		return status.Errorf(codes.InvalidArgument, "iban bank code %s does not match institution bank code %s",
+			parsed.BankCode, institution.bankCode)
+	}
+	return nil
+}
+
+// checkActiveCurrency returns NOT_FOUND for an unknown currency and
+// FAILED_PRECONDITION for an inactive one
+func checkActiveCurrency(ctx context.Context, tx *sql.Tx, code string) error {
+	var isActive bool
+	err := tx.QueryRowContext(ctx,
+		"SELECT is_active FROM treasury.currencies WHERE code = $1",
+		code).Scan(&isActive)
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "currency %s not found", code)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check currency: %v", err)
+	}
+	if !isActive {
+		return status.Errorf(codes.FailedPrecondition, "currency %s is not active", code)
+	}
+	return nil
+}
+
+// lockOpenAccount locks a bank account row for update and checks that it
+// is open and, when version is set, unchanged
+func lockOpenAccount(ctx context.Context, tx *sql.Tx, id string, version int32) error {
+	var currentStatus string
+	var currentVersion int32
+	err := tx.QueryRowContext(ctx,
+		"SELECT status, version FROM treasury.bank_accounts WHERE id = $1 FOR UPDATE",
+		id).Scan(&currentStatus, &currentVersion)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "bank account not found")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check bank account: %v", err)
+	}
+	if currentStatus == "closed" {
+		return status.Error(codes.FailedPrecondition, "bank account is closed")
+	}
+	if version > 0 && version != currentVersion {
+		return status.Error(codes.Aborted, "version mismatch - bank account was modified by another process")
+	}
+	return nil
+}
+
+// uniqueViolation maps a unique constraint violation to ALREADY_EXISTS,
+// or returns nil for any other error
+func uniqueViolation(err error, institutionCode, ledgerAccount string) error {
+	pqErr, ok := err.(*pq.Error)
+	if !ok || pqErr.Code != "23505" {
+		return nil
+	}
+	switch pqErr.Constraint {
+	case "uk_bank_accounts_number":
+		return status.Errorf(codes.AlreadyExists, "account number already registered at institution %s", institutionCode)
+	case "uk_bank_accounts_ledger_account":
+		return status.Errorf(codes.AlreadyExists, "ledger account %s is already linked to an open bank account", ledgerAccount)
+	default:
+		return status.Errorf(codes.AlreadyExists, "bank account already exists: %v", pqErr.Message)
+	}
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanBankAccount reads the bankAccountColumns into a BankAccount with the
+// account number and IBAN masked
+// Spec: docs/specs/008-bank-accounts.md#masking
+func scanBankAccount(row rowScanner) (*pb.BankAccount, error) {
+	var (
+		id              string
+		institutionCode string
+		accountName     string
+		accountNumber   string
+		accountIBAN     sql.NullString
+		currencyCode    string
+		purpose         string
+		signatories     []byte
+		ledgerAccount   string
+		accountStatus   string
+		openedAt        time.Time
+		closedAt        sql.NullTime
+		closeReason     sql.NullString
+		createdAt       time.Time
+		updatedAt       time.Time
+		createdBy       sql.NullString
+		updatedBy       sql.NullString
+		version         int32
+	)
+
+	err := row.Scan(
+		&id, &institutionCode, &accountName, &accountNumber, &accountIBAN, &currencyCode,
+		&purpose, &signatories, &ledgerAccount, &accountStatus, &openedAt, &closedAt,
+		&closeReason, &createdAt, &updatedAt, &createdBy, &updatedBy, &version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &pb.BankAccount{
+		Id:                      id,
+		InstitutionCode:         institutionCode,
+		AccountName:             accountName,
+		AccountNumber:           MaskAccountNumber(accountNumber),
+		CurrencyCode:            strings.TrimSpace(currencyCode),
+		Purpose:                 mapPurpose(purpose),
+		LedgerAccountExternalId: ledgerAccount,
+		Status:                  mapStatus(accountStatus),
+		OpenedAt:                timestamppb.New(openedAt),
+		CreatedAt:               timestamppb.New(createdAt),
+		UpdatedAt:               timestamppb.New(updatedAt),
+		Version:                 version,
+	}
+	if account.Signatories, err = signatoriesFromJSON(signatories); err != nil {
+		return nil, err
+	}
+	if accountIBAN.Valid {
+		account.Iban = MaskIBAN(accountIBAN.String)
+	}
+	if closedAt.Valid {
+		account.ClosedAt = timestamppb.New(closedAt.Time)
+	}
+	if closeReason.Valid {
+		account.CloseReason = closeReason.String
+	}
+	if createdBy.Valid {
+		account.CreatedBy = createdBy.String
+	}
+	if updatedBy.Valid {
+		account.UpdatedBy = updatedBy.String
+	}
+
+	return account, nil
+}
+
+// MaskAccountNumber hides all but the last four characters of an account
+// number, e.g. 12345678 -> ****5678. Numbers shorter than eight characters
+// show only half, so a short number is never returned in full.
+// Spec: docs/specs/008-bank-accounts.md#masking
+func MaskAccountNumber(number string) string {
+	visible := 4
+	if len(number) < 8 {
+		visible = len(number) / 2
+	}
+	return "****" + number[len(number)-visible:]
+}
+
+// MaskIBAN keeps the country code, check digits and last four characters
+// of an IBAN, e.g. GB29NWBK60161331926819 -> GB29**************6819
+// Spec: docs/specs/008-bank-accounts.md#masking
+func MaskIBAN(number string) string {
+	if len(number) <= 8 {
+		return MaskAccountNumber(number)
+	}
+	return number[:4] + strings.Repeat("*", len(number)-8) + number[len(number)-4:]
+}
+
+// Helper functions
+
+// validateCreateRequest checks the fields of a bank account to create,
+// with the account number and IBAN already normalized
+func validateCreateRequest(req *pb.CreateBankAccountRequest, accountNumber, accountIBAN string) error {
+	if req.InstitutionCode == "" {
+		return fmt.Errorf("institution_code is required")
+	}
+	if err := validateAccountName(req.AccountName); err != nil {
+		return err
+	}
+	if !accountNumberRegex.MatchString(accountNumber) {
+		return fmt.Errorf("invalid account_number: must be 4-34 letters and digits")
+	}
+	if accountIBAN != "" {
+		if err := iban.Validate(accountIBAN); err != nil {
+			return fmt.Errorf("invalid iban: %v", err)
+		}
+	}
+	if !isoCodeRegex.MatchString(req.CurrencyCode) {
+		return fmt.Errorf("invalid currency_code: must be 3 uppercase letters")
+	}
+	if req.Purpose == pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED {
+		return fmt.Errorf("purpose is required")
+	}
+	return validateLedgerAccount(req.LedgerAccountExternalId)
+}
+
+func validateAccountName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("account_name is required")
+	}
+	if len(name) > 200 {
+		return fmt.Errorf("account_name must be 200 characters or less")
+	}
+	return nil
+}
+
+// validateLedgerAccount checks the format of a ledger account external_id.
+// Whether the ledger has the account is not checked, see
+// docs/specs/008-bank-accounts.md#ledger-link.
+func validateLedgerAccount(externalID string) error {
+	if externalID == "" {
+		return fmt.Errorf("ledger_account_external_id is required")
+	}
+	if len(externalID) > 100 {
+		return fmt.Errorf("ledger_account_external_id must be 100 characters or less")
+	}
+	return nil
+}
+
+// normalizeAccountNumber removes the spaces and hyphens account numbers are
+// often printed with and uppercases the rest
+func normalizeAccountNumber(number string) string {
+	number = strings.ReplaceAll(number, " ", "")
+	number = strings.ReplaceAll(number, "-", "")
+	return strings.ToUpper(number)
+}
+
+// signatory is the JSONB form of a Signatory
+type signatory struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Title string `json:"title,omitempty"`
+}
+
+// signatoriesToJSON validates signatories and encodes them for the
+// signatories column
+func signatoriesToJSON(signatories []*pb.Signatory) ([]byte, error) {
+	stored := make([]signatory, 0, len(signatories))
+	emails := map[string]bool{}
+	for i, s := range signatories {
+		if strings.TrimSpace(s.Name) == "" {
+			return nil, fmt.Errorf("signatories[%d]: name is required", i)
+		}
+		email := strings.ToLower(strings.TrimSpace(s.Email))
+		if !strings.Contains(email, "@") {
+			return nil, fmt.Errorf("signatories[%d]: invalid email %q", i, s.Email)
+		}
+		if emails[email] {
+			return nil, fmt.Errorf("signatories[%d]: duplicate email %s", i, email)
+		}
+		emails[email] = true
+		stored = append(stored, signatory{Name: s.Name, Email: email, Title: s.Title})
+	}
+	return json.Marshal(stored)
+}
+
+// signatoriesFromJSON decodes the signatories column
+func signatoriesFromJSON(data []byte) ([]*pb.Signatory, error) {
+	var stored []signatory
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("invalid signatories: %w", err)
+	}
+	signatories := make([]*pb.Signatory, 0, len(stored))
+	for _, s := range stored {
+		signatories = append(signatories, &pb.Signatory{Name: s.Name, Email: s.Email, Title: s.Title})
+	}
+	return signatories, nil
+}
+
+func mapPurpose(purpose string) pb.BankAccountPurpose {
+	switch purpose {
+	case "operating":
+		return pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_OPERATING
+	case "payroll":
+		return pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_PAYROLL
+	case "collections":
+		return pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_COLLECTIONS
+	case "disbursement":
+		return pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_DISBURSEMENT
+	case "reserve":
+		return pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_RESERVE
+	case "escrow":
+		return pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_ESCROW
+	default:
+		return pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED
+	}
+}
+
+func mapPurposeToString(purpose pb.BankAccountPurpose) string {
+	switch purpose {
+	case pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_OPERATING:
+		return "operating"
+	case pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_PAYROLL:
+		return "payroll"
+	case pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_COLLECTIONS:
+		return "collections"
+	case pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_DISBURSEMENT:
+		return "disbursement"
+	case pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_RESERVE:
+		return "reserve"
+	case pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_ESCROW:
+		return "escrow"
+	default:
+		return ""
+	}
+}
+
+func mapStatus(accountStatus string) pb.BankAccountStatus {
+	switch accountStatus {
+	case "active":
+		return pb.BankAccountStatus_BANK_ACCOUNT_STATUS_ACTIVE
+	case "closed":
+		return pb.BankAccountStatus_BANK_ACCOUNT_STATUS_CLOSED
+	default:
+		return pb.BankAccountStatus_BANK_ACCOUNT_STATUS_UNSPECIFIED
+	}
+}
+
+func mapStatusToString(accountStatus pb.BankAccountStatus) string {
+	switch accountStatus {
+	case pb.BankAccountStatus_BANK_ACCOUNT_STATUS_CLOSED:
+		return "closed"
+	default:
+		return "active"
+	}
+}
+
+// nullString creates a sql.NullString from a string
+func nullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: s, Valid: true}
+}
+
+// bankAccountFilterClause builds the WHERE conditions for the
+// ListBankAccounts filters, shared by the page and count queries
+func bankAccountFilterClause(req *pb.ListBankAccountsRequest) (string, []interface{}) {
+	clause := ""
+	args := []interface{}{}
+
+	if req.InstitutionCode != "" {
+		args = append(args, req.InstitutionCode)
+		clause += fmt.Sprintf(" AND i.code = $%d", len(args))
+	}
+
+	if req.CurrencyCode != "" {
+		args = append(args, req.CurrencyCode)
+		clause += fmt.Sprintf(" AND a.currency_code = $%d", len(args))
+	}
+
+	if req.Purpose != pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_UNSPECIFIED {
+		args = append(args, mapPurposeToString(req.Purpose))
+		clause += fmt.Sprintf(" AND a.purpose = $%d", len(args))
+	}
+
+	if req.Status != pb.BankAccountStatus_BANK_ACCOUNT_STATUS_UNSPECIFIED {
+		args = append(args, mapStatusToString(req.Status))
+		clause += fmt.Sprintf(" AND a.status = $%d", len(args))
+	}
+
+	return clause, args
+}
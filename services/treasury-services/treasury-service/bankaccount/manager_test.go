@@ -0,0 +1,470 @@
+package bankaccount
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// testCursors signs page tokens in tests
+var testCursors = pagination.NewCodec("test-secret")
+
+// bankAccountColumnNames are the columns returned by bank account queries
+var bankAccountColumnNames = []string{
+	"id", "code", "account_name", "account_number", "iban", "currency_code",
+	"purpose", "signatories", "ledger_account_external_id", "status", "opened_at", "closed_at",
+	"close_reason", "created_at", "updated_at", "created_by", "updated_by", "version",
+}
+
+// bankAccountRow returns a result set with one stored bank account
+func bankAccountRow(id, accountStatus string, version int32, createdAt time.Time) *sqlmock.Rows {
+	var closedAt, closeReason interface{}
+	if accountStatus == "closed" {
+		closedAt, closeReason = createdAt, "Moved to new bank"
+	}
+	return sqlmock.NewRows(bankAccountColumnNames).AddRow(
+		id, "CHASE", "Payroll USD", "000123456789", "GB29NWBK60161331926819", "USD",
+		"payroll", []byte(`[{"name":"Ada Lovelace","email":"ada@example.com","title":"CFO"}]`),
+		"cash-payroll-usd", accountStatus, createdAt, closedAt,
+		closeReason, createdAt, createdAt, "ops", "ops", version,
+	)
+}
+
+// createRequest returns a valid CreateBankAccountRequest
+func createRequest() *pb.CreateBankAccountRequest {
+	return &pb.CreateBankAccountRequest{
+		InstitutionCode: "CHASE",
+		AccountName:     "Payroll USD",
+		AccountNumber:   "0001-2345 6789",
+		Iban:            "gb29 nwbk 6016 1331 9268 19",
+		CurrencyCode:    "USD",
+		Purpose:         pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_PAYROLL,
+		Signatories: []*pb.Signatory{
+			{Name: "Ada Lovelace", Email: "Ada@Example.com", Title: "CFO"},
+		},
+		LedgerAccountExternalId: "cash-payroll-usd",
+		CreatedBy:               "ops",
+	}
+}
+
+// TestNewManager tests the creation of a new Manager
+func TestNewManager(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	manager := NewManager(db, testCursors)
+	assert.NotNil(t, manager)
+	assert.Equal(t, db, manager.db)
+}
+
+// TestCreateBankAccount tests the CreateBankAccount method
+// Spec: docs/specs/008-bank-accounts.md#story-1-register-bank-account
+func TestCreateBankAccount(t *testing.T) {
+	createdAt := time.Date(2025, 9, 19, 9, 0, 0, 0, time.UTC)
+	institutionID := uuid.New().String()
+
+	expectInstitution := func(mock sqlmock.Sqlmock, institutionStatus, bankCode string) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT id, status, country_code, bank_code FROM treasury.financial_institutions").
+			WithArgs("CHASE").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "status", "country_code", "bank_code"}).
+				AddRow(institutionID, institutionStatus, "GB", bankCode))
+	}
+	expectChecks := func(mock sqlmock.Sqlmock, institutionStatus string, currencyActive bool) {
+		expectInstitution(mock, institutionStatus, "NWBK")
+		if institutionStatus != "active" {
+			mock.ExpectRollback()
+			return
+		}
+		mock.ExpectQuery("SELECT is_active FROM treasury.currencies").
+			WithArgs("USD").
+			WillReturnRows(sqlmock.NewRows([]string{"is_active"}).AddRow(currencyActive))
+	}
+
+	t.Run("creates account with normalized numbers", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		id := uuid.New().String()
+		expectChecks(mock, "active", true)
+		mock.ExpectQuery("INSERT INTO treasury.bank_accounts").
+			WithArgs(sqlmock.AnyArg(), institutionID, "Payroll USD", "000123456789", "GB29NWBK60161331926819", "USD",
+				"payroll", []byte(`[{"name":"Ada Lovelace","email":"ada@example.com","title":"CFO"}]`),
+				"cash-payroll-usd", sqlmock.AnyArg(), "ops").
+			WillReturnRows(bankAccountRow(id, "active", 1, createdAt))
+		mock.ExpectCommit()
+
+		account, err := NewManager(db, testCursors).CreateBankAccount(context.Background(), createRequest())
+		require.NoError(t, err)
+		assert.Equal(t, id, account.Id)
+		assert.Equal(t, "CHASE", account.InstitutionCode)
+		assert.Equal(t, "****6789", account.AccountNumber)
+		assert.Equal(t, "GB29**************6819", account.Iban)
+		assert.Equal(t, pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_PAYROLL, account.Purpose)
+		assert.Equal(t, pb.BankAccountStatus_BANK_ACCOUNT_STATUS_ACTIVE, account.Status)
+		require.Len(t, account.Signatories, 1)
+		assert.Equal(t, "ada@example.com", account.Signatories[0].Email)
+		assert.Nil(t, account.ClosedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("suspended institution", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectChecks(mock, "suspended", true)
+
+		_, err = NewManager(db, testCursors).CreateBankAccount(context.Background(), createRequest())
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "institution CHASE is suspended")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("inactive currency", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectChecks(mock, "active", false)
+		mock.ExpectRollback()
+
+		_, err = NewManager(db, testCursors).CreateBankAccount(context.Background(), createRequest())
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "currency USD is not active")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectInstitution(mock, "active", "NWBK")
+		mock.ExpectQuery("SELECT is_active FROM treasury.currencies").
+			WithArgs("USD").
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err = NewManager(db, testCursors).CreateBankAccount(context.Background(), createRequest())
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Contains(t, err.Error(), "currency USD not found")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ledger account already linked", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectChecks(mock, "active", true)
+		mock.ExpectQuery("INSERT INTO treasury.bank_accounts").
+			WillReturnError(&pq.Error{Code: "23505", Constraint: "uk_bank_accounts_ledger_account"})
+		mock.ExpectRollback()
+
+		_, err = NewManager(db, testCursors).CreateBankAccount(context.Background(), createRequest())
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Contains(t, err.Error(), "ledger account cash-payroll-usd is already linked")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("iban from another bank", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectInstitution(mock, "active", "BARC")
+		mock.ExpectRollback()
+
+		_, err = NewManager(db, testCursors).CreateBankAccount(context.Background(), createRequest())
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "iban bank code NWBK does not match institution bank code BARC")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			modify  func(*pb.CreateBankAccountRequest)
+			wantErr string
+		}{
+			{"missing institution", func(r *pb.CreateBankAccountRequest) { r.InstitutionCode = "" }, "institution_code is required"},
+			{"short account number", func(r *pb.CreateBankAccountRequest) { r.AccountNumber = "12" }, "invalid account_number"},
+			{"bad iban", func(r *pb.CreateBankAccountRequest) { r.Iban = "GB29" }, "invalid iban"},
+			{"bad iban check digits", func(r *pb.CreateBankAccountRequest) { r.Iban = "GB28NWBK60161331926819" }, "invalid iban: invalid IBAN check digits"},
+			{"bad currency", func(r *pb.CreateBankAccountRequest) { r.CurrencyCode = "usd" }, "invalid currency_code"},
+			{"missing purpose", func(r *pb.CreateBankAccountRequest) { r.Purpose = 0 }, "purpose is required"},
+			{"missing ledger account", func(r *pb.CreateBankAccountRequest) { r.LedgerAccountExternalId = "" }, "ledger_account_external_id is required"},
+			{"signatory without email", func(r *pb.CreateBankAccountRequest) {
+				r.Signatories = []*pb.Signatory{{Name: "Ada Lovelace"}}
+			}, "signatories[0]: invalid email"},
+			{"duplicate signatory", func(r *pb.CreateBankAccountRequest) {
+				r.Signatories = append(r.Signatories, &pb.Signatory{Name: "A. Lovelace", Email: "ada@example.com"})
+			}, "signatories[1]: duplicate email ada@example.com"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				db, _, err := sqlmock.New()
+				require.NoError(t, err)
+				defer db.Close()
+
+				req := createRequest()
+				tt.modify(req)
+				_, err = NewManager(db, testCursors).CreateBankAccount(context.Background(), req)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Contains(t, err.Error(), tt.wantErr)
+			})
+		}
+	})
+}
+
+// TestGetBankAccount tests the GetBankAccount method
+// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+func TestGetBankAccount(t *testing.T) {
+	createdAt := time.Date(2025, 9, 19, 9, 0, 0, 0, time.UTC)
+	id := uuid.New().String()
+
+	t.Run("by ledger account", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("WHERE a.ledger_account_external_id = \\$1 AND a.status != 'closed'").
+			WithArgs("cash-payroll-usd").
+			WillReturnRows(bankAccountRow(id, "active", 2, createdAt))
+
+		account, err := NewManager(db, testCursors).GetBankAccount(context.Background(), &pb.GetBankAccountRequest{
+			Identifier: &pb.GetBankAccountRequest_LedgerAccountExternalId{LedgerAccountExternalId: "cash-payroll-usd"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, id, account.Id)
+		assert.Equal(t, int32(2), account.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("WHERE a.id = \\$1").
+			WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(bankAccountColumnNames))
+
+		_, err = NewManager(db, testCursors).GetBankAccount(context.Background(), &pb.GetBankAccountRequest{
+			Identifier: &pb.GetBankAccountRequest_Id{Id: id},
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		_, err = NewManager(db, testCursors).GetBankAccount(context.Background(), &pb.GetBankAccountRequest{
+			Identifier: &pb.GetBankAccountRequest_Id{Id: "not-a-uuid"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestUpdateBankAccount tests the UpdateBankAccount method
+// Spec: docs/specs/008-bank-accounts.md#story-3-update-bank-account
+func TestUpdateBankAccount(t *testing.T) {
+	createdAt := time.Date(2025, 9, 19, 9, 0, 0, 0, time.UTC)
+	id := uuid.New().String()
+	request := func() *pb.UpdateBankAccountRequest {
+		return &pb.UpdateBankAccountRequest{
+			Id:          id,
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"account_name", "purpose"}},
+			AccountName: "Operating USD",
+			Purpose:     pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_OPERATING,
+			UpdatedBy:   "ops",
+			Version:     1,
+		}
+	}
+	expectLock := func(mock sqlmock.Sqlmock, accountStatus string, version int32) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT status, version FROM treasury.bank_accounts WHERE id = \\$1 FOR UPDATE").
+			WithArgs(id).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "version"}).AddRow(accountStatus, version))
+	}
+
+	t.Run("updates masked fields", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectLock(mock, "active", 1)
+		mock.ExpectQuery("UPDATE treasury.bank_accounts").
+			WithArgs("Operating USD", "operating", "ops", id).
+			WillReturnRows(bankAccountRow(id, "active", 2, createdAt))
+		mock.ExpectCommit()
+
+		account, err := NewManager(db, testCursors).UpdateBankAccount(context.Background(), request())
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), account.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("closed account", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectLock(mock, "closed", 1)
+		mock.ExpectRollback()
+
+		_, err = NewManager(db, testCursors).UpdateBankAccount(context.Background(), request())
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectLock(mock, "active", 3)
+		mock.ExpectRollback()
+
+		_, err = NewManager(db, testCursors).UpdateBankAccount(context.Background(), request())
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("immutable field", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		req := request()
+		req.UpdateMask.Paths = []string{"account_number"}
+		_, err = NewManager(db, testCursors).UpdateBankAccount(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "field account_number cannot be updated")
+	})
+}
+
+// TestCloseBankAccount tests the CloseBankAccount method
+// Spec: docs/specs/008-bank-accounts.md#story-4-close-bank-account
+func TestCloseBankAccount(t *testing.T) {
+	createdAt := time.Date(2025, 9, 19, 9, 0, 0, 0, time.UTC)
+	id := uuid.New().String()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status, version FROM treasury.bank_accounts").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "version"}).AddRow("active", 2))
+	mock.ExpectQuery("SET status = 'closed'").
+		WithArgs("Moved to new bank", "ops", id).
+		WillReturnRows(bankAccountRow(id, "closed", 3, createdAt))
+	mock.ExpectCommit()
+
+	manager := NewManager(db, testCursors)
+	account, err := manager.CloseBankAccount(context.Background(), &pb.CloseBankAccountRequest{
+		Id:       id,
+		Reason:   "Moved to new bank",
+		ClosedBy: "ops",
+		Version:  2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pb.BankAccountStatus_BANK_ACCOUNT_STATUS_CLOSED, account.Status)
+	assert.Equal(t, "Moved to new bank", account.CloseReason)
+	assert.NotNil(t, account.ClosedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = manager.CloseBankAccount(context.Background(), &pb.CloseBankAccountRequest{Id: id})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "reason is required")
+}
+
+// TestListBankAccounts tests filtering and paging through bank accounts
+// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+func TestListBankAccounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	manager := NewManager(db, testCursors)
+	newer := time.Date(2025, 9, 19, 10, 0, 0, 0, time.UTC)
+	older := newer.Add(-time.Hour)
+	ids := []string{uuid.New().String(), uuid.New().String()}
+
+	rows := bankAccountRow(ids[0], "active", 1, newer)
+	rows.AddRow(ids[1], "CHASE", "Reserve USD", "99887766", nil, "USD",
+		"payroll", []byte(`[]`), "cash-reserve-usd", "active", older, nil,
+		nil, older, older, "ops", "ops", 1)
+
+	mock.ExpectQuery("WHERE 1=1 AND i.code = \\$1 AND a.purpose = \\$2 ORDER BY a.created_at DESC, a.id DESC LIMIT \\$3").
+		WithArgs("CHASE", "payroll", int32(2)).
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM treasury.bank_accounts").
+		WithArgs("CHASE", "payroll").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	req := &pb.ListBankAccountsRequest{
+		InstitutionCode: "CHASE",
+		Purpose:         pb.BankAccountPurpose_BANK_ACCOUNT_PURPOSE_PAYROLL,
+		PageSize:        1,
+	}
+	resp, err := manager.ListBankAccounts(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.BankAccounts, 1)
+	assert.Equal(t, ids[0], resp.BankAccounts[0].Id)
+	assert.Equal(t, int32(2), resp.TotalCount)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	mock.ExpectQuery("AND \\(a.created_at, a.id\\) < \\(\\$3, \\$4\\)").
+		WithArgs("CHASE", "payroll", newer, ids[0], int32(2)).
+		WillReturnRows(sqlmock.NewRows(bankAccountColumnNames).AddRow(
+			ids[1], "CHASE", "Reserve USD", "99887766", nil, "USD",
+			"payroll", []byte(`[]`), "cash-reserve-usd", "active", older, nil,
+			nil, older, older, "ops", "ops", 1))
+
+	req.PageToken = resp.NextPageToken
+	req.SkipTotalCount = true
+	resp, err = manager.ListBankAccounts(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.BankAccounts, 1)
+	assert.Equal(t, "****7766", resp.BankAccounts[0].AccountNumber)
+	assert.Empty(t, resp.BankAccounts[0].Iban)
+	assert.Empty(t, resp.NextPageToken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// A token from another filter set is rejected
+	req.InstitutionCode = "CITI"
+	_, err = manager.ListBankAccounts(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestMasking tests masking of account numbers and IBANs
+// Spec: docs/specs/008-bank-accounts.md#masking
+func TestMasking(t *testing.T) {
+	assert.Equal(t, "****5678", MaskAccountNumber("12345678"))
+	assert.Equal(t, "****456", MaskAccountNumber("123456"))
+	assert.Equal(t, "****34", MaskAccountNumber("1234"))
+	assert.Equal(t, "GB29**************6819", MaskIBAN("GB29NWBK60161331926819"))
+}
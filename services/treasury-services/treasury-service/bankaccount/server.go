@@ -0,0 +1,68 @@
+package bankaccount
+
+import (
+	"context"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// Server implements the BankAccountService gRPC interface
+// Spec: docs/specs/008-bank-accounts.md
+type Server struct {
+	pb.UnimplementedBankAccountServiceServer
+	manager *Manager
+}
+
+// NewServer creates a new bank account server instance
+// Spec: docs/specs/008-bank-accounts.md
+func NewServer(manager *Manager) *Server {
+	return &Server{
+		manager: manager,
+	}
+}
+
+// CreateBankAccount registers a company bank account
+// Spec: docs/specs/008-bank-accounts.md#story-1-register-bank-account
+func (s *Server) CreateBankAccount(ctx context.Context, req *pb.CreateBankAccountRequest) (*pb.CreateBankAccountResponse, error) {
+	account, err := s.manager.CreateBankAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateBankAccountResponse{BankAccount: account}, nil
+}
+
+// GetBankAccount retrieves a bank account
+// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+func (s *Server) GetBankAccount(ctx context.Context, req *pb.GetBankAccountRequest) (*pb.GetBankAccountResponse, error) {
+	account, err := s.manager.GetBankAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetBankAccountResponse{BankAccount: account}, nil
+}
+
+// UpdateBankAccount updates bank account metadata
+// Spec: docs/specs/008-bank-accounts.md#story-3-update-bank-account
+func (s *Server) UpdateBankAccount(ctx context.Context, req *pb.UpdateBankAccountRequest) (*pb.UpdateBankAccountResponse, error) {
+	account, err := s.manager.UpdateBankAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateBankAccountResponse{BankAccount: account}, nil
+}
+
+// CloseBankAccount closes a bank account
+// Spec: docs/specs/008-bank-accounts.md#story-4-close-bank-account
+func (s *Server) CloseBankAccount(ctx context.Context, req *pb.CloseBankAccountRequest) (*pb.CloseBankAccountResponse, error) {
+	account, err := s.manager.CloseBankAccount(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CloseBankAccountResponse{BankAccount: account}, nil
+}
+
+// ListBankAccounts lists bank accounts with filtering
+// Spec: docs/specs/008-bank-accounts.md#story-2-query-bank-accounts
+func (s *Server) ListBankAccounts(ctx context.Context, req *pb.ListBankAccountsRequest) (*pb.ListBankAccountsResponse, error) {
+	return s.manager.ListBankAccounts(ctx, req)
+}
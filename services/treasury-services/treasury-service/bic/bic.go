@@ -0,0 +1,102 @@
+// Package bic parses Business Identifier Codes (ISO 9362), the SWIFT codes
+// that identify institutions and their branches in international payments.
+// Spec: docs/specs/011-bic-directory.md
+package bic
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HeadOfficeBranch is the branch code of a head office in an 11-character BIC
+const HeadOfficeBranch = "XXX"
+
+// BIC is a parsed Business Identifier Code
+// Spec: docs/specs/011-bic-directory.md#bic-structure
+type BIC struct {
+	PartyPrefix string // Institution, 4 characters
+	CountryCode string // ISO 3166-1 alpha-2 country code
+	Location    string // 2 characters
+	Branch      string // 3 characters, empty for an 8-character BIC
+}
+
+// Normalize removes spaces from and uppercases a BIC
+func Normalize(s string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(s), ""))
+}
+
+// Parse validates an 8- or 11-character BIC. The party prefix and country
+// code must be letters, matching the swift_code check constraint.
+// Spec: docs/specs/011-bic-directory.md#bic-structure
+func Parse(s string) (*BIC, error) {
+	s = Normalize(s)
+	if len(s) != 8 && len(s) != 11 {
+		return nil, fmt.Errorf("BIC must be 8 or 11 characters, got %d", len(s))
+	}
+	if !isClass(s[:6], isUpper) {
+		return nil, fmt.Errorf("BIC party prefix and country code must be letters")
+	}
+	if !isClass(s[6:], isAlphanumeric) {
+		return nil, fmt.Errorf("BIC location and branch codes must be letters or digits")
+	}
+
+	parsed := &BIC{
+		PartyPrefix: s[:4],
+		CountryCode: s[4:6],
+		Location:    s[6:8],
+	}
+	if len(s) == 11 {
+		parsed.Branch = s[8:]
+	}
+	return parsed, nil
+}
+
+// Validate reports whether s is a valid BIC
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// String returns the BIC as parsed, 8 or 11 characters
+func (b *BIC) String() string {
+	return b.HeadOffice() + b.Branch
+}
+
+// HeadOffice returns the 8-character BIC of the institution's head office
+func (b *BIC) HeadOffice() string {
+	return b.PartyPrefix + b.CountryCode + b.Location
+}
+
+// IsHeadOffice reports whether the BIC identifies the head office rather
+// than a branch
+func (b *BIC) IsHeadOffice() bool {
+	return b.Branch == "" || b.Branch == HeadOfficeBranch
+}
+
+// Candidates returns the stored forms that identify the BIC, most specific
+// first: a branch BIC, then the 8- and 11-character head office forms
+// Spec: docs/specs/011-bic-directory.md#resolving-institutions
+func (b *BIC) Candidates() []string {
+	headOffice := b.HeadOffice()
+	if b.IsHeadOffice() {
+		return []string{headOffice, headOffice + HeadOfficeBranch}
+	}
+	return []string{b.String(), headOffice, headOffice + HeadOfficeBranch}
+}
+
+func isUpper(ch byte) bool {
+	return ch >= 'A' && ch <= 'Z'
+}
+
+func isAlphanumeric(ch byte) bool {
+	return isUpper(ch) || (ch >= '0' && ch <= '9')
+}
+
+func isClass(s string, class func(byte) bool) bool {
+	for i := 0; i < len(s); i++ {
+		if !class(s[i]) {
+			return false
+		}
+	}
+	return true
+}
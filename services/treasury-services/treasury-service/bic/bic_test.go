@@ -0,0 +1,67 @@
+package bic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse tests parsing head office and branch BICs
+// Spec: docs/specs/011-bic-directory.md#bic-structure
+func TestParse(t *testing.T) {
+	tests := []struct {
+		bic        string
+		headOffice string
+		branch     string
+		isHead     bool
+	}{
+		{"DEUTDEFF", "DEUTDEFF", "", true},
+		{"deut deff 500", "DEUTDEFF", "500", false},
+		{"CHASUS33XXX", "CHASUS33", "XXX", true},
+		{"NWBKGB2L", "NWBKGB2L", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.bic, func(t *testing.T) {
+			parsed, err := Parse(tt.bic)
+			require.NoError(t, err)
+			assert.Equal(t, tt.headOffice, parsed.HeadOffice())
+			assert.Equal(t, tt.branch, parsed.Branch)
+			assert.Equal(t, tt.isHead, parsed.IsHeadOffice())
+			assert.Equal(t, Normalize(tt.bic), parsed.String())
+		})
+	}
+}
+
+// TestParseErrors tests rejecting invalid BICs
+// Spec: docs/specs/011-bic-directory.md#bic-structure
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		bic     string
+		wantErr string
+	}{
+		{"DEUTDE", "BIC must be 8 or 11 characters, got 6"},
+		{"DEUTDEFF50", "BIC must be 8 or 11 characters, got 10"},
+		{"DEU1DEFF", "BIC party prefix and country code must be letters"},
+		{"DEUTDEF-", "BIC location and branch codes must be letters or digits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.bic, func(t *testing.T) {
+			assert.EqualError(t, Validate(tt.bic), tt.wantErr)
+		})
+	}
+}
+
+// TestCandidates tests the lookup order from branch to head office
+// Spec: docs/specs/011-bic-directory.md#resolving-institutions
+func TestCandidates(t *testing.T) {
+	parsed, err := Parse("DEUTDEFF500")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DEUTDEFF500", "DEUTDEFF", "DEUTDEFFXXX"}, parsed.Candidates())
+
+	parsed, err = Parse("CHASUS33XXX")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CHASUS33", "CHASUS33XXX"}, parsed.Candidates())
+}
@@ -0,0 +1,485 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+	"github.com/jamestroutman/treasury-service/bic"
+)
+
+// bicDirectoryColumns maps the header names used by BIC directory files
+// to the fields the import reads. Headers are compared uppercased, with
+// underscores and hyphens as spaces.
+// Spec: docs/specs/011-bic-directory.md#file-formats
+var bicDirectoryColumns = map[string][]string{
+	"bic":         {"BIC", "BIC11", "BIC CODE", "SWIFT CODE", "SWIFT BIC"},
+	"bic8":        {"BIC8"},
+	"branch_code": {"BRANCH BIC", "BRANCH CODE"},
+	"name":        {"INSTITUTION NAME", "BANK NAME", "NAME"},
+	"address":     {"STREET ADDRESS 1", "PHYSICAL ADDRESS 1", "STREET ADDRESS", "ADDRESS"},
+	"city":        {"CITY", "CITY HEADING", "CITY NAME"},
+	"postal_code": {"POSTAL CODE", "ZIP CODE", "POST CODE", "ZIP"},
+	"country":     {"COUNTRY CODE", "ISO COUNTRY CODE"},
+}
+
+// BicDirectoryEntry is one BIC read from a BIC directory file
+// Spec: docs/specs/011-bic-directory.md#file-formats
+type BicDirectoryEntry struct {
+	Line       int
+	BIC        *bic.BIC
+	Name       string
+	Address    string
+	City       string
+	PostalCode string
+}
+
+// DetectBicDirectoryFormat returns FLAT when the header line is tab-delimited
+// and CSV otherwise
+// Spec: docs/specs/011-bic-directory.md#file-formats
+func DetectBicDirectoryFormat(content []byte) pb.BicDirectoryFormat {
+	header, _, _ := bytes.Cut(content, []byte("\n"))
+	if bytes.Contains(header, []byte("\t")) {
+		return pb.BicDirectoryFormat_BIC_DIRECTORY_FORMAT_FLAT
+	}
+	return pb.BicDirectoryFormat_BIC_DIRECTORY_FORMAT_CSV
+}
+
+// ParseBicDirectory reads a BIC directory file with a header line. The BIC
+// is read from a BIC column or from BIC8 and branch code columns. Lines
+// that cannot be read are returned as errors and the rest are kept.
+// Spec: docs/specs/011-bic-directory.md#file-formats
+func ParseBicDirectory(format pb.BicDirectoryFormat, r io.Reader) ([]*BicDirectoryEntry, []string, error) {
+	reader := csv.NewReader(bufio.NewReader(r))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if format == pb.BicDirectoryFormat_BIC_DIRECTORY_FORMAT_FLAT {
+		reader.Comma = '\t'
+		reader.LazyQuotes = true
+	}
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, fmt.Errorf("directory file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	columns := bicHeaderColumns(header)
+	if _, ok := columns["bic"]; !ok {
+		if _, ok := columns["bic8"]; !ok {
+			return nil, nil, fmt.Errorf("header has no BIC column")
+		}
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, nil, fmt.Errorf("header has no institution name column")
+	}
+
+	entries := []*BicDirectoryEntry{}
+	rejected := []string{}
+	seen := map[string]int{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rejected = append(rejected, fmt.Sprintf("line %d: %v", parseErr.Line, parseErr.Err))
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read directory file: %w", err)
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		code := field("bic")
+		if code == "" {
+			code = field("bic8") + field("branch_code")
+		}
+		parsed, err := bic.Parse(code)
+		if err != nil {
+			rejected = append(rejected, fmt.Sprintf("line %d: invalid BIC %s: %v", line, code, err))
+			continue
+		}
+		if country := strings.ToUpper(field("country")); country != "" && country != parsed.CountryCode {
+			rejected = append(rejected, fmt.Sprintf("line %d: country code %s does not match BIC %s", line, country, parsed))
+			continue
+		}
+
+		entry := &BicDirectoryEntry{
+			Line:       line,
+			BIC:        parsed,
+			Name:       field("name"),
+			Address:    field("address"),
+			City:       field("city"),
+			PostalCode: field("postal_code"),
+		}
+		if entry.Name == "" {
+			rejected = append(rejected, fmt.Sprintf("line %d: institution name is required", line))
+			continue
+		}
+
+		// BIC8 and BIC8XXX are the same head office
+		key := parsed.String()
+		if parsed.IsHeadOffice() {
+			key = parsed.HeadOffice()
+		}
+		if first, ok := seen[key]; ok {
+			rejected = append(rejected, fmt.Sprintf("line %d: duplicate BIC %s, first on line %d", line, parsed, first))
+			continue
+		}
+		seen[key] = line
+		entries = append(entries, entry)
+		if len(entries) > maxDirectoryRecords {
+			return nil, nil, fmt.Errorf("directory file has more than %d records", maxDirectoryRecords)
+		}
+	}
+	if len(entries) == 0 && len(rejected) == 0 {
+		return nil, nil, fmt.Errorf("directory file has no records")
+	}
+
+	return entries, rejected, nil
+}
+
+// bicHeaderColumns returns the index of each known column in a header line
+func bicHeaderColumns(header []string) map[string]int {
+	indexes := map[string]int{}
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff")
+		name = strings.NewReplacer("_", " ", "-", " ").Replace(strings.ToUpper(name))
+		indexes[strings.Join(strings.Fields(name), " ")] = i
+	}
+
+	columns := map[string]int{}
+	for field, names := range bicDirectoryColumns {
+		for _, name := range names {
+			if i, ok := indexes[name]; ok {
+				columns[field] = i
+				break
+			}
+		}
+	}
+	return columns
+}
+
+// bicInstitution is the part of an institution the BIC directory import
+// matches, compares and updates
+type bicInstitution struct {
+	ID          string
+	Code        string
+	SwiftCode   string
+	Status      string
+	Name        string
+	Address     string
+	City        string
+	PostalCode  string
+	CountryCode string
+}
+
+// bicUpdate holds the fields of an institution to change; empty fields
+// are kept
+type bicUpdate struct {
+	Institution *bicInstitution
+	Line        int
+	Name        string
+	Address     string
+	City        string
+	PostalCode  string
+	CountryCode string
+	SwiftCode   string
+}
+
+// bicDirectoryPlan is the set of changes that brings institutions in line
+// with a BIC directory file
+// Spec: docs/specs/011-bic-directory.md#import-plan
+type bicDirectoryPlan struct {
+	Creates   []*BicDirectoryEntry
+	Updates   []*bicUpdate
+	Unchanged int
+	Branches  int
+	Errors    []string
+	Changes   []*pb.BicDirectoryChange
+}
+
+// planBicDirectory matches directory entries to institutions by SWIFT code.
+// Head office BICs match an institution holding the 8- or 11-character
+// form, or an institution whose code is the 8-character BIC; unknown head
+// offices get a new institution. Branch BICs resolve to their head office
+// and only change an institution that holds the branch BIC itself. Name,
+// address and country are replaced on institutions the import created and
+// only filled in where empty on institutions maintained by hand.
+// Spec: docs/specs/011-bic-directory.md#import-plan
+func planBicDirectory(entries []*BicDirectoryEntry, stored []*bicInstitution) *bicDirectoryPlan {
+	bySwift := map[string]*bicInstitution{}
+	byCode := map[string]*bicInstitution{}
+	for _, institution := range stored {
+		if institution.SwiftCode != "" {
+			bySwift[institution.SwiftCode] = institution
+		}
+		byCode[institution.Code] = institution
+	}
+
+	// Head offices listed only through their branches are created from
+	// their first branch, without the branch address
+	headOffices := map[string]bool{}
+	for _, entry := range entries {
+		if entry.BIC.IsHeadOffice() {
+			headOffices[entry.BIC.HeadOffice()] = true
+		}
+	}
+
+	plan := &bicDirectoryPlan{}
+	for _, entry := range entries {
+		headOffice := entry.BIC.HeadOffice()
+
+		if !entry.BIC.IsHeadOffice() {
+			plan.Branches++
+			if institution, ok := bySwift[entry.BIC.String()]; ok {
+				plan.match(institution, entry, entry.BIC.String(), false)
+			}
+			if headOffices[headOffice] {
+				continue
+			}
+			headOffices[headOffice] = true
+			parsed, _ := bic.Parse(headOffice)
+			entry = &BicDirectoryEntry{Line: entry.Line, BIC: parsed, Name: entry.Name}
+		}
+
+		institution := bySwift[headOffice]
+		if institution == nil {
+			institution = bySwift[headOffice+bic.HeadOfficeBranch]
+		}
+		if institution == nil {
+			institution = byCode[headOffice]
+			if institution != nil && institution.SwiftCode != "" {
+				plan.Errors = append(plan.Errors, fmt.Sprintf("line %d: institution code %s is used by SWIFT code %s", entry.Line, headOffice, institution.SwiftCode))
+				continue
+			}
+		}
+		if institution == nil {
+			plan.Creates = append(plan.Creates, entry)
+			plan.Changes = append(plan.Changes, &pb.BicDirectoryChange{
+				Type:            pb.BicDirectoryChangeType_BIC_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION,
+				SwiftCode:       headOffice,
+				InstitutionCode: headOffice,
+				Detail:          entry.Name,
+			})
+			continue
+		}
+		plan.match(institution, entry, headOffice, institution.Code == headOffice)
+	}
+	return plan
+}
+
+// match records the changes a directory entry makes to an institution.
+// owned institutions were created by the import and take the directory
+// details; others only have empty details filled in.
+func (plan *bicDirectoryPlan) match(institution *bicInstitution, entry *BicDirectoryEntry, swiftCode string, owned bool) {
+	if institution.Status == "deleted" {
+		plan.Errors = append(plan.Errors, fmt.Sprintf("line %d: BIC %s belongs to deleted institution %s", entry.Line, swiftCode, institution.Code))
+		return
+	}
+
+	update := &bicUpdate{Institution: institution, Line: entry.Line}
+	diff := []string{}
+	set := func(name, stored, value string, target *string) {
+		if value == "" || value == stored || (!owned && stored != "") {
+			return
+		}
+		*target = value
+		diff = append(diff, fmt.Sprintf("%s %s -> %s", name, stored, value))
+	}
+	set("name", institution.Name, entry.Name, &update.Name)
+	set("street_address_1", institution.Address, entry.Address, &update.Address)
+	set("city", institution.City, entry.City, &update.City)
+	set("postal_code", institution.PostalCode, entry.PostalCode, &update.PostalCode)
+	set("country_code", institution.CountryCode, entry.BIC.CountryCode, &update.CountryCode)
+	if institution.SwiftCode == "" {
+		set("swift_code", "", swiftCode, &update.SwiftCode)
+	}
+
+	if len(diff) == 0 {
+		plan.Unchanged++
+		return
+	}
+	plan.Updates = append(plan.Updates, update)
+	plan.Changes = append(plan.Changes, &pb.BicDirectoryChange{
+		Type:            pb.BicDirectoryChangeType_BIC_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION,
+		SwiftCode:       swiftCode,
+		InstitutionCode: institution.Code,
+		Detail:          strings.Join(diff, ", "),
+	})
+}
+
+// ImportBicDirectory imports a SWIFT BIC directory file. Rejected lines and
+// conflicts are reported and the rest are imported. A dry run reports the
+// same changes without applying them.
+// Spec: docs/specs/011-bic-directory.md
+func (im *InstitutionManager) ImportBicDirectory(ctx context.Context, req *pb.ImportBicDirectoryRequest) (*pb.ImportBicDirectoryResponse, error) {
+	if len(req.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	format := req.Format
+	if format == pb.BicDirectoryFormat_BIC_DIRECTORY_FORMAT_UNSPECIFIED {
+		format = DetectBicDirectoryFormat(req.Content)
+	}
+
+	entries, rejected, err := ParseBicDirectory(format, bytes.NewReader(req.Content))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid directory file: %v", err)
+	}
+
+	updatedBy := req.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = "system"
+	}
+
+	tx, err := im.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	stored, err := loadBicInstitutions(ctx, tx, entries)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load institutions: %v", err)
+	}
+
+	plan := planBicDirectory(entries, stored)
+	resp := &pb.ImportBicDirectoryResponse{
+		Format:              format,
+		RecordCount:         int32(len(entries)),
+		InstitutionsCreated: int32(len(plan.Creates)),
+		InstitutionsUpdated: int32(len(plan.Updates)),
+		UnchangedCount:      int32(plan.Unchanged),
+		BranchCount:         int32(plan.Branches),
+		Errors:              append(rejected, plan.Errors...),
+		Changes:             plan.Changes,
+		DryRun:              req.DryRun,
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+
+	if err := applyBicDirectoryPlan(ctx, tx, plan, updatedBy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import BIC directory: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return resp, nil
+}
+
+// loadBicInstitutions reads every institution with a SWIFT code, and those
+// whose code is a head office BIC of the file, including deleted ones
+// because SWIFT codes and institution codes stay unique after deletion
+func loadBicInstitutions(ctx context.Context, tx *sql.Tx, entries []*BicDirectoryEntry) ([]*bicInstitution, error) {
+	headOffices := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		headOffices = append(headOffices, entry.BIC.HeadOffice())
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, code, swift_code, status, name, street_address_1, city, postal_code, country_code
+		FROM treasury.financial_institutions
+		WHERE swift_code IS NOT NULL OR code = ANY($1::text[])
+		ORDER BY code`,
+		pq.Array(headOffices))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stored := []*bicInstitution{}
+	for rows.Next() {
+		var (
+			institution                          bicInstitution
+			swiftCode, address, city, postalCode sql.NullString
+		)
+		err := rows.Scan(
+			&institution.ID, &institution.Code, &swiftCode, &institution.Status, &institution.Name,
+			&address, &city, &postalCode, &institution.CountryCode,
+		)
+		if err != nil {
+			return nil, err
+		}
+		institution.SwiftCode = swiftCode.String
+		institution.Address = address.String
+		institution.City = city.String
+		institution.PostalCode = postalCode.String
+		stored = append(stored, &institution)
+	}
+	return stored, rows.Err()
+}
+
+// applyBicDirectoryPlan writes a BIC directory plan
+func applyBicDirectoryPlan(ctx context.Context, tx *sql.Tx, plan *bicDirectoryPlan, updatedBy string) error {
+	for _, entry := range plan.Creates {
+		headOffice := entry.BIC.HeadOffice()
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO treasury.financial_institutions (
+				id, code, name, swift_code, institution_type, country_code,
+				street_address_1, city, postal_code,
+				status, is_active, activated_at, created_at, updated_at, created_by, updated_by, version
+			) VALUES (
+				$1, $2, $3, $2, 'bank', $4,
+				$5, $6, $7,
+				'active', true, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, $8, $8, 1
+			)`,
+			uuid.New(), headOffice, entry.Name, entry.BIC.CountryCode,
+			nullString(entry.Address), nullString(entry.City), nullString(entry.PostalCode), updatedBy)
+		if err != nil {
+			return fmt.Errorf("line %d: failed to create institution %s: %w", entry.Line, headOffice, err)
+		}
+	}
+
+	for _, update := range plan.Updates {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE treasury.financial_institutions
+			SET name = COALESCE($1, name),
+				street_address_1 = COALESCE($2, street_address_1),
+				city = COALESCE($3, city),
+				postal_code = COALESCE($4, postal_code),
+				country_code = COALESCE($5, country_code),
+				swift_code = COALESCE($6, swift_code),
+				updated_at = CURRENT_TIMESTAMP,
+				updated_by = $7,
+				version = version + 1
+			WHERE id = $8`,
+			nullString(update.Name), nullString(update.Address), nullString(update.City),
+			nullString(update.PostalCode), nullString(update.CountryCode), nullString(update.SwiftCode),
+			updatedBy, update.Institution.ID)
+		if err != nil {
+			return fmt.Errorf("line %d: failed to update institution %s: %w", update.Line, update.Institution.Code, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+	"github.com/jamestroutman/treasury-service/bic"
+)
+
+// TestParseBicDirectoryCSV tests reading a CSV directory and rejecting bad lines
+// Spec: docs/specs/011-bic-directory.md#file-formats
+func TestParseBicDirectoryCSV(t *testing.T) {
+	content := strings.Join([]string{
+		"\ufeffBIC,Institution Name,Street Address 1,City,Postal Code,Country Code",
+		"DEUTDEFFXXX,DEUTSCHE BANK AG,TAUNUSANLAGE 12,FRANKFURT AM MAIN,60325,DE",
+		"deutdeff500,DEUTSCHE BANK AG,,FRANKFURT AM MAIN,,DE",
+		"DEUTDEFF,DEUTSCHE BANK AG,,,,DE",
+		"NWBKGB2L,NATIONAL WESTMINSTER BANK PLC,250 BISHOPSGATE,LONDON,EC2M 4AA,DE",
+		"NWBK1B2L,NATIONAL WESTMINSTER BANK PLC,,LONDON,,",
+		"",
+	}, "\n")
+
+	if got := DetectBicDirectoryFormat([]byte(content)); got != pb.BicDirectoryFormat_BIC_DIRECTORY_FORMAT_CSV {
+		t.Fatalf("DetectBicDirectoryFormat() = %v, want CSV", got)
+	}
+
+	entries, rejected, err := ParseBicDirectory(pb.BicDirectoryFormat_BIC_DIRECTORY_FORMAT_CSV, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseBicDirectory() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("ParseBicDirectory() returned %d entries, want 2", len(entries))
+	}
+	if entries[0].BIC.String() != "DEUTDEFFXXX" || entries[0].Address != "TAUNUSANLAGE 12" || entries[0].PostalCode != "60325" {
+		t.Errorf("ParseBicDirectory() entry = %+v", entries[0])
+	}
+	if entries[1].BIC.String() != "DEUTDEFF500" || entries[1].Line != 3 {
+		t.Errorf("ParseBicDirectory() branch entry = %+v", entries[1])
+	}
+
+	wantRejected := []string{
+		"line 4: duplicate BIC DEUTDEFF, first on line 2",
+		"line 5: country code DE does not match BIC NWBKGB2L",
+		"line 6: invalid BIC NWBK1B2L: BIC party prefix and country code must be letters",
+	}
+	if strings.Join(rejected, "\n") != strings.Join(wantRejected, "\n") {
+		t.Errorf("ParseBicDirectory() rejected = %q, want %q", rejected, wantRejected)
+	}
+}
+
+// TestParseBicDirectoryFlat tests reading a tab-delimited flat file with
+// separate BIC8 and branch code columns
+// Spec: docs/specs/011-bic-directory.md#file-formats
+func TestParseBicDirectoryFlat(t *testing.T) {
+	content := "BIC8\tBRANCH_BIC\tINSTITUTION_NAME\tCITY_HEADING\n" +
+		"CHASUS33\tXXX\tJPMORGAN CHASE BANK, N.A.\tNEW YORK\n" +
+		"CHASUS33\tMIA\tJPMORGAN CHASE BANK, N.A.\tMIAMI\n"
+
+	format := DetectBicDirectoryFormat([]byte(content))
+	if format != pb.BicDirectoryFormat_BIC_DIRECTORY_FORMAT_FLAT {
+		t.Fatalf("DetectBicDirectoryFormat() = %v, want FLAT", format)
+	}
+
+	entries, rejected, err := ParseBicDirectory(format, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseBicDirectory() error = %v", err)
+	}
+	if len(rejected) != 0 {
+		t.Errorf("ParseBicDirectory() rejected = %v, want none", rejected)
+	}
+	if len(entries) != 2 || entries[0].BIC.String() != "CHASUS33XXX" || entries[1].BIC.String() != "CHASUS33MIA" || entries[1].City != "MIAMI" {
+		t.Errorf("ParseBicDirectory() entries = %v", entries)
+	}
+
+	_, _, err = ParseBicDirectory(format, strings.NewReader("NAME\tCITY\nDEUTSCHE BANK AG\tFRANKFURT\n"))
+	if err == nil || err.Error() != "header has no BIC column" {
+		t.Errorf("ParseBicDirectory() error = %v, want missing BIC column", err)
+	}
+}
+
+// TestPlanBicDirectory tests matching directory entries to institutions
+// Spec: docs/specs/011-bic-directory.md#import-plan
+func TestPlanBicDirectory(t *testing.T) {
+	entry := func(line int, code, name, city string) *BicDirectoryEntry {
+		parsed, err := bic.Parse(code)
+		if err != nil {
+			t.Fatalf("bic.Parse(%s) error = %v", code, err)
+		}
+		return &BicDirectoryEntry{Line: line, BIC: parsed, Name: name, City: city}
+	}
+
+	stored := []*bicInstitution{
+		{ID: "1", Code: "DEUTDEFF", SwiftCode: "DEUTDEFF", Status: "active", Name: "DEUTSCHE BANK AG", City: "FRANKFURT", CountryCode: "DE"},
+		{ID: "2", Code: "CHASE", SwiftCode: "CHASUS33XXX", Status: "active", Name: "JPMorgan Chase", CountryCode: "US"},
+		{ID: "3", Code: "CHASE-MIAMI", SwiftCode: "CHASUS33MIA", Status: "active", Name: "Chase Miami", CountryCode: "US"},
+		{ID: "4", Code: "OLD", SwiftCode: "COBADEFF", Status: "deleted", Name: "Commerzbank", CountryCode: "DE"},
+		{ID: "5", Code: "NWBKGB2L", SwiftCode: "NWBKGB22", Status: "active", Name: "NatWest", CountryCode: "GB"},
+	}
+	entries := []*BicDirectoryEntry{
+		entry(1, "DEUTDEFFXXX", "DEUTSCHE BANK AG", "FRANKFURT AM MAIN"),
+		entry(2, "DEUTDEFF500", "DEUTSCHE BANK AG", "FRANKFURT AM MAIN"),
+		entry(3, "CHASUS33", "JPMORGAN CHASE BANK, N.A.", "NEW YORK"),
+		entry(4, "CHASUS33MIA", "JPMORGAN CHASE BANK, N.A.", "MIAMI"),
+		entry(5, "BNPAFRPPLYO", "BNP PARIBAS", "LYON"),
+		entry(6, "COBADEFFXXX", "COMMERZBANK AG", "FRANKFURT AM MAIN"),
+		entry(7, "NWBKGB2L", "NATIONAL WESTMINSTER BANK PLC", "LONDON"),
+	}
+
+	plan := planBicDirectory(entries, stored)
+
+	if plan.Branches != 3 {
+		t.Errorf("Branches = %d, want 3", plan.Branches)
+	}
+	if len(plan.Creates) != 1 || plan.Creates[0].BIC.String() != "BNPAFRPP" || plan.Creates[0].City != "" {
+		t.Errorf("Creates = %v, want BNPAFRPP without the branch city", plan.Creates)
+	}
+	if plan.Unchanged != 0 {
+		t.Errorf("Unchanged = %d, want 0", plan.Unchanged)
+	}
+
+	wantChanges := []string{
+		"BIC_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION DEUTDEFF DEUTDEFF city FRANKFURT -> FRANKFURT AM MAIN",
+		"BIC_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION CHASUS33 CHASE city  -> NEW YORK",
+		"BIC_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION CHASUS33MIA CHASE-MIAMI city  -> MIAMI",
+		"BIC_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION BNPAFRPP BNPAFRPP BNP PARIBAS",
+	}
+	if len(plan.Changes) != len(wantChanges) {
+		t.Fatalf("Changes = %v, want %d changes", plan.Changes, len(wantChanges))
+	}
+	for i, change := range plan.Changes {
+		got := fmt.Sprintf("%s %s %s %s", change.Type, change.SwiftCode, change.InstitutionCode, change.Detail)
+		if got != wantChanges[i] {
+			t.Errorf("change %d = %q, want %q", i, got, wantChanges[i])
+		}
+	}
+
+	wantErrors := []string{
+		"line 6: BIC COBADEFF belongs to deleted institution OLD",
+		"line 7: institution code NWBKGB2L is used by SWIFT code NWBKGB22",
+	}
+	if strings.Join(plan.Errors, "\n") != strings.Join(wantErrors, "\n") {
+		t.Errorf("Errors = %q, want %q", plan.Errors, wantErrors)
+	}
+}
+
+// TestGetInstitutionBySwiftCodeFallback tests that a branch BIC resolves
+// to its head office
+// Spec: docs/specs/011-bic-directory.md#resolving-institutions
+func TestGetInstitutionBySwiftCodeFallback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	manager := NewInstitutionManager(db, nil)
+
+	_, err = manager.GetInstitution(context.Background(), &pb.GetInstitutionRequest{
+		Identifier: &pb.GetInstitutionRequest_SwiftCode{SwiftCode: "DEUTDEFF50"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetInstitution() with a 10-character BIC error = %v, want InvalidArgument", err)
+	}
+
+	mock.ExpectQuery("WHERE i.swift_code = ANY\\(\\$1::text\\[\\]\\)").
+		WithArgs(`{"DEUTDEFF500","DEUTDEFF","DEUTDEFFXXX"}`).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = manager.GetInstitution(context.Background(), &pb.GetInstitutionRequest{
+		Identifier: &pb.GetInstitutionRequest_SwiftCode{SwiftCode: "deutdeff500"},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetInstitution() error = %v, want NotFound", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,793 @@
+package calendar
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// Manager handles holiday calendar database operations and business-day
+// queries
+// Spec: docs/specs/012-holiday-calendars.md
+type Manager struct {
+	db *sql.DB
+}
+
+// NewManager creates a new calendar manager instance
+// Spec: docs/specs/012-holiday-calendars.md
+func NewManager(db *sql.DB) *Manager {
+	return &Manager{
+		db: db,
+	}
+}
+
+// queryer is satisfied by both *sql.DB and *sql.Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// calendarColumns are the columns read into a HolidayCalendar
+const calendarColumns = `id, code, name, description, weekend_days, is_active,
+	created_at, updated_at, created_by, updated_by, version`
+
+// ruleColumns are the columns read into a Rule
+const ruleColumns = `name, rule_type, month, day, weekday, nth, offset_days,
+	holiday_date, observance, from_year, to_year`
+
+// maxBusinessDays bounds the days of AddBusinessDays and NextSettlementDate
+const maxBusinessDays = 3650
+
+// Years GetCalendar lists holidays for
+const (
+	minYear = 1900
+	maxYear = 2200
+)
+
+var (
+	// Calendar code validation regex, e.g. US-FED or TARGET2
+	calendarCodeRegex = regexp.MustCompile(`^[A-Z0-9]+(-[A-Z0-9]+)*$`)
+	// ISO 4217 code validation regex (3 uppercase letters)
+	isoCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// CreateCalendar creates a holiday calendar with its rules
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func (m *Manager) CreateCalendar(ctx context.Context, req *pb.CreateCalendarRequest) (*pb.HolidayCalendar, error) {
+	code := normalizeCode(req.Code)
+	if err := validateCode(code); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "calendar name is required")
+	}
+	weekendDays := req.WeekendDays
+	if len(weekendDays) == 0 {
+		weekendDays = []int32{int32(time.Sunday), int32(time.Saturday)}
+	}
+	if err := ValidateWeekend(weekdays(weekendDays)); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rules, err := rulesFromProto(req.Rules)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	createdBy := req.CreatedBy
+	if createdBy == "" {
+		createdBy = "system"
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	calendarID := uuid.New()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO treasury.holiday_calendars (
+			id, code, name, description, weekend_days, is_active,
+			created_at, updated_at, created_by, updated_by, version
+		) VALUES ($1, $2, $3, $4, $5, true, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, $6, $6, 1)`,
+		calendarID, code, req.Name, nullString(req.Description), pq.Array(weekendDays), createdBy)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, status.Errorf(codes.AlreadyExists, "calendar with code %s already exists", code)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create calendar: %v", err)
+	}
+
+	if err := insertRules(ctx, tx, calendarID.String(), rules); err != nil {
+		return nil, err
+	}
+
+	calendar, err := loadCalendar(ctx, tx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return calendar, nil
+}
+
+// GetCalendar retrieves a calendar by code, with the holidays of a year
+// when one is given
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func (m *Manager) GetCalendar(ctx context.Context, req *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+	code := normalizeCode(req.Code)
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "calendar code is required")
+	}
+	if req.Year != 0 && (req.Year < minYear || req.Year > maxYear) {
+		return nil, status.Errorf(codes.InvalidArgument, "year must be between %d and %d", minYear, maxYear)
+	}
+
+	calendar, err := loadCalendar(ctx, m.db, code)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetCalendarResponse{Calendar: calendar}
+	if req.Year != 0 {
+		engine, err := engineCalendar(calendar)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid stored calendar %s: %v", code, err)
+		}
+		for _, h := range engine.Holidays(int(req.Year)) {
+			resp.Holidays = append(resp.Holidays, &pb.Holiday{
+				Date:         h.Date.Format(DateLayout),
+				Name:         h.Name,
+				CalendarCode: h.Calendar,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// UpdateCalendar updates the name, description, weekend, rules or active
+// flag of a calendar. Rules given in the update replace all stored rules.
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func (m *Manager) UpdateCalendar(ctx context.Context, req *pb.UpdateCalendarRequest) (*pb.HolidayCalendar, error) {
+	code := normalizeCode(req.Code)
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "calendar code is required")
+	}
+
+	// Build dynamic update query based on update mask
+	updates := []string{}
+	args := []interface{}{}
+	argCount := 1
+	var rules []Rule
+	replaceRules := false
+
+	if req.UpdateMask != nil {
+		for _, path := range req.UpdateMask.Paths {
+			switch path {
+			case "name":
+				if strings.TrimSpace(req.Name) == "" {
+					return nil, status.Error(codes.InvalidArgument, "calendar name is required")
+				}
+				updates = append(updates, fmt.Sprintf("name = $%d", argCount))
+				args = append(args, req.Name)
+				argCount++
+			case "description":
+				updates = append(updates, fmt.Sprintf("description = $%d", argCount))
+				args = append(args, nullString(req.Description))
+				argCount++
+			case "weekend_days":
+				if err := ValidateWeekend(weekdays(req.WeekendDays)); err != nil {
+					return nil, status.Error(codes.InvalidArgument, err.Error())
+				}
+				updates = append(updates, fmt.Sprintf("weekend_days = $%d", argCount))
+				args = append(args, pq.Array(req.WeekendDays))
+				argCount++
+			case "rules":
+				var err error
+				if rules, err = rulesFromProto(req.Rules); err != nil {
+					return nil, status.Error(codes.InvalidArgument, err.Error())
+				}
+				replaceRules = true
+			case "is_active":
+				updates = append(updates, fmt.Sprintf("is_active = $%d", argCount))
+				args = append(args, req.IsActive)
+				argCount++
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "field %s cannot be updated", path)
+			}
+		}
+	}
+
+	if len(updates) == 0 && !replaceRules {
+		return nil, status.Error(codes.InvalidArgument, "no fields to update")
+	}
+
+	updatedBy := req.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = "system"
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	// Check calendar exists and get current version
+	var calendarID string
+	var currentVersion int32
+	err = tx.QueryRowContext(ctx,
+		"SELECT id, version FROM treasury.holiday_calendars WHERE code = $1 FOR UPDATE",
+		code).Scan(&calendarID, &currentVersion)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check calendar: %v", err)
+	}
+
+	// Check optimistic locking
+	if req.Version > 0 && req.Version != currentVersion {
+		return nil, status.Error(codes.Aborted, "version mismatch - calendar was modified by another process")
+	}
+
+	updates = append(updates,
+		"updated_at = CURRENT_TIMESTAMP",
+		fmt.Sprintf("updated_by = $%d", argCount),
+		"version = version + 1")
+	args = append(args, updatedBy, calendarID)
+	query := fmt.Sprintf("UPDATE treasury.holiday_calendars SET %s WHERE id = $%d",
+		strings.Join(updates, ", "), argCount+1)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update calendar: %v", err)
+	}
+
+	if replaceRules {
+		if _, err := tx.ExecContext(ctx,
+			"DELETE FROM treasury.holiday_rules WHERE calendar_id = $1", calendarID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to replace holiday rules: %v", err)
+		}
+		if err := insertRules(ctx, tx, calendarID, rules); err != nil {
+			return nil, err
+		}
+	}
+
+	calendar, err := loadCalendar(ctx, tx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return calendar, nil
+}
+
+// SetSettlementCalendar sets the settlement calendar of a currency, or
+// clears it when no calendar code is given
+// Spec: docs/specs/012-holiday-calendars.md#story-2-currency-settlement-calendars
+func (m *Manager) SetSettlementCalendar(ctx context.Context, req *pb.SetSettlementCalendarRequest) (*pb.SetSettlementCalendarResponse, error) {
+	currencyCode := strings.ToUpper(strings.TrimSpace(req.CurrencyCode))
+	if !isoCodeRegex.MatchString(currencyCode) {
+		return nil, status.Error(codes.InvalidArgument, "invalid currency code format: must be 3 uppercase letters")
+	}
+	calendarCode := normalizeCode(req.CalendarCode)
+
+	updatedBy := req.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = "system"
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM treasury.currencies WHERE code = $1 AND status != 'deleted')",
+		currencyCode).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check currency: %v", err)
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "currency not found")
+	}
+
+	if calendarCode == "" {
+		_, err = tx.ExecContext(ctx,
+			"DELETE FROM treasury.currency_settlement_calendars WHERE currency_code = $1", currencyCode)
+	} else {
+		var calendarID string
+		var isActive bool
+		err = tx.QueryRowContext(ctx,
+			"SELECT id, is_active FROM treasury.holiday_calendars WHERE code = $1",
+			calendarCode).Scan(&calendarID, &isActive)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "calendar not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check calendar: %v", err)
+		}
+		if !isActive {
+			return nil, status.Errorf(codes.FailedPrecondition, "calendar %s is inactive", calendarCode)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO treasury.currency_settlement_calendars (currency_code, calendar_id, updated_at, updated_by)
+			VALUES ($1, $2, CURRENT_TIMESTAMP, $3)
+			ON CONFLICT (currency_code) DO UPDATE
+			SET calendar_id = EXCLUDED.calendar_id,
+				updated_at = EXCLUDED.updated_at,
+				updated_by = EXCLUDED.updated_by`,
+			currencyCode, calendarID, updatedBy)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set settlement calendar: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return &pb.SetSettlementCalendarResponse{
+		CurrencyCode: currencyCode,
+		CalendarCode: calendarCode,
+	}, nil
+}
+
+// IsBusinessDay checks a date against the combined calendars of a query
+// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+func (m *Manager) IsBusinessDay(ctx context.Context, req *pb.IsBusinessDayRequest) (*pb.IsBusinessDayResponse, error) {
+	d, err := ParseDate(req.Date)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	set, err := m.resolveSet(ctx, req.InstitutionCode, req.CurrencyCode, req.CalendarCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, reason := set.Check(d)
+	next, err := set.NextBusinessDay(d)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &pb.IsBusinessDayResponse{
+		IsBusinessDay:   ok,
+		Reason:          reason,
+		NextBusinessDay: next.Format(DateLayout),
+		CalendarCodes:   set.Codes(),
+	}, nil
+}
+
+// AddBusinessDays moves a date by business days of the combined calendars
+// Spec: docs/specs/012-holiday-calendars.md#business-day-arithmetic
+func (m *Manager) AddBusinessDays(ctx context.Context, req *pb.AddBusinessDaysRequest) (*pb.AddBusinessDaysResponse, error) {
+	d, err := ParseDate(req.Date)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Days < -maxBusinessDays || req.Days > maxBusinessDays {
+		return nil, status.Errorf(codes.InvalidArgument, "days must be between %d and %d", -maxBusinessDays, maxBusinessDays)
+	}
+	set, err := m.resolveSet(ctx, req.InstitutionCode, req.CurrencyCode, req.CalendarCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := set.AddBusinessDays(d, int(req.Days))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &pb.AddBusinessDaysResponse{
+		Date:          result.Format(DateLayout),
+		CalendarCodes: set.Codes(),
+	}, nil
+}
+
+// NextSettlementDate returns the settlement date of a trade: the trade
+// date rolled forward to a business day, then moved by the settlement days
+// Spec: docs/specs/012-holiday-calendars.md#business-day-arithmetic
+func (m *Manager) NextSettlementDate(ctx context.Context, req *pb.NextSettlementDateRequest) (*pb.NextSettlementDateResponse, error) {
+	tradeDate := Day(time.Now().UTC())
+	if req.TradeDate != "" {
+		var err error
+		if tradeDate, err = ParseDate(req.TradeDate); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.SettlementDays < 0 || req.SettlementDays > maxBusinessDays {
+		return nil, status.Errorf(codes.InvalidArgument, "settlement_days must be between 0 and %d", maxBusinessDays)
+	}
+	set, err := m.resolveSet(ctx, req.InstitutionCode, req.CurrencyCode, req.CalendarCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := set.NextBusinessDay(tradeDate)
+	if err == nil {
+		settlement, err = set.AddBusinessDays(settlement, int(req.SettlementDays))
+	}
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &pb.NextSettlementDateResponse{
+		SettlementDate: settlement.Format(DateLayout),
+		CalendarCodes:  set.Codes(),
+	}, nil
+}
+
+// resolveSet combines the explicit calendars, the institution's holiday
+// calendar and the currency's settlement calendar
+// Spec: docs/specs/012-holiday-calendars.md#combining-calendars
+func (m *Manager) resolveSet(ctx context.Context, institutionCode, currencyCode string, calendarCodes []string) (*Set, error) {
+	var calendarList []string
+	seen := map[string]bool{}
+	add := func(code string) {
+		code = normalizeCode(code)
+		if code != "" && !seen[code] {
+			seen[code] = true
+			calendarList = append(calendarList, code)
+		}
+	}
+
+	for _, code := range calendarCodes {
+		add(code)
+	}
+
+	if institutionCode != "" {
+		var holidayCalendar sql.NullString
+		err := m.db.QueryRowContext(ctx,
+			"SELECT holiday_calendar FROM treasury.financial_institutions WHERE code = $1 AND status != 'deleted'",
+			institutionCode).Scan(&holidayCalendar)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "institution not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get institution: %v", err)
+		}
+		add(holidayCalendar.String)
+	}
+
+	if currencyCode != "" {
+		var settlementCalendar sql.NullString
+		err := m.db.QueryRowContext(ctx, `
+			SELECT hc.code
+			FROM treasury.currencies c
+			LEFT JOIN treasury.currency_settlement_calendars sc ON sc.currency_code = c.code
+			LEFT JOIN treasury.holiday_calendars hc ON hc.id = sc.calendar_id
+			WHERE c.code = $1 AND c.status != 'deleted'`,
+			strings.ToUpper(currencyCode)).Scan(&settlementCalendar)
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "currency not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get currency: %v", err)
+		}
+		add(settlementCalendar.String)
+	}
+
+	if len(calendarList) == 0 {
+		return nil, status.Error(codes.FailedPrecondition,
+			"no holiday calendar: give calendar_codes, or an institution or currency with a calendar")
+	}
+
+	calendars := make([]*Calendar, 0, len(calendarList))
+	for _, code := range calendarList {
+		stored, err := scanCalendar(m.db.QueryRowContext(ctx,
+			"SELECT "+calendarColumns+" FROM treasury.holiday_calendars WHERE code = $1", code))
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "calendar %s not found", code)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get calendar: %v", err)
+		}
+		if !stored.IsActive {
+			return nil, status.Errorf(codes.FailedPrecondition, "calendar %s is inactive", code)
+		}
+		if stored.Rules, err = loadRules(ctx, m.db, stored.Id); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to load holiday rules: %v", err)
+		}
+		engine, err := engineCalendar(stored)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid stored calendar %s: %v", code, err)
+		}
+		calendars = append(calendars, engine)
+	}
+
+	return NewSet(calendars...), nil
+}
+
+// loadCalendar reads a calendar with its rules and settlement currencies
+func loadCalendar(ctx context.Context, q queryer, code string) (*pb.HolidayCalendar, error) {
+	calendar, err := scanCalendar(q.QueryRowContext(ctx,
+		"SELECT "+calendarColumns+" FROM treasury.holiday_calendars WHERE code = $1", code))
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "calendar not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get calendar: %v", err)
+	}
+
+	if calendar.Rules, err = loadRules(ctx, q, calendar.Id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load holiday rules: %v", err)
+	}
+
+	rows, err := q.QueryContext(ctx,
+		"SELECT currency_code FROM treasury.currency_settlement_calendars WHERE calendar_id = $1 ORDER BY currency_code",
+		calendar.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load settlement currencies: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var currencyCode string
+		if err := rows.Scan(&currencyCode); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan settlement currency: %v", err)
+		}
+		calendar.SettlementCurrencies = append(calendar.SettlementCurrencies, currencyCode)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating settlement currencies: %v", err)
+	}
+
+	return calendar, nil
+}
+
+// scanCalendar scans calendarColumns into a HolidayCalendar without rules
+func scanCalendar(row *sql.Row) (*pb.HolidayCalendar, error) {
+	var (
+		calendar             pb.HolidayCalendar
+		description          sql.NullString
+		weekendDays          []int64
+		createdAt, updatedAt time.Time
+		createdBy, updatedBy sql.NullString
+	)
+	err := row.Scan(&calendar.Id, &calendar.Code, &calendar.Name, &description,
+		pq.Array(&weekendDays), &calendar.IsActive,
+		&createdAt, &updatedAt, &createdBy, &updatedBy, &calendar.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar.Description = description.String
+	for _, day := range weekendDays {
+		calendar.WeekendDays = append(calendar.WeekendDays, int32(day))
+	}
+	calendar.CreatedAt = timestamppb.New(createdAt)
+	calendar.UpdatedAt = timestamppb.New(updatedAt)
+	calendar.CreatedBy = createdBy.String
+	calendar.UpdatedBy = updatedBy.String
+	return &calendar, nil
+}
+
+// loadRules reads the rules of a calendar
+func loadRules(ctx context.Context, q queryer, calendarID string) ([]*pb.HolidayRule, error) {
+	rows, err := q.QueryContext(ctx,
+		"SELECT "+ruleColumns+" FROM treasury.holiday_rules WHERE calendar_id = $1 ORDER BY name",
+		calendarID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []*pb.HolidayRule{}
+	for rows.Next() {
+		var (
+			rule                                               pb.HolidayRule
+			ruleType, observance                               string
+			month, day, weekday, nth, offset, fromYear, toYear sql.NullInt32
+			holidayDate                                        sql.NullTime
+		)
+		err := rows.Scan(&rule.Name, &ruleType, &month, &day, &weekday, &nth, &offset,
+			&holidayDate, &observance, &fromYear, &toYear)
+		if err != nil {
+			return nil, err
+		}
+		rule.Type = mapStringToRuleType(ruleType)
+		rule.Month = month.Int32
+		rule.Day = day.Int32
+		rule.Weekday = weekday.Int32
+		rule.Nth = nth.Int32
+		rule.OffsetDays = offset.Int32
+		if holidayDate.Valid {
+			rule.Date = holidayDate.Time.Format(DateLayout)
+		}
+		rule.Observance = mapStringToObservance(observance)
+		rule.FromYear = fromYear.Int32
+		rule.ToYear = toYear.Int32
+		rules = append(rules, &rule)
+	}
+	return rules, rows.Err()
+}
+
+// insertRules stores the rules of a calendar
+func insertRules(ctx context.Context, tx *sql.Tx, calendarID string, rules []Rule) error {
+	for _, rule := range rules {
+		var month, day, weekday, nth, offset interface{}
+		var holidayDate interface{}
+		switch rule.Type {
+		case RuleFixed:
+			month, day = int(rule.Month), rule.Day
+		case RuleNthWeekday:
+			month, weekday, nth = int(rule.Month), int(rule.Weekday), rule.Nth
+		case RuleEaster:
+			offset = rule.Offset
+		case RuleDate:
+			holidayDate = rule.Date
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO treasury.holiday_rules (
+				id, calendar_id, name, rule_type, month, day, weekday, nth, offset_days,
+				holiday_date, observance, from_year, to_year, created_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, CURRENT_TIMESTAMP)`,
+			uuid.New(), calendarID, rule.Name, rule.Type, month, day, weekday, nth, offset,
+			holidayDate, rule.Observance, nullYear(rule.FromYear), nullYear(rule.ToYear))
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create holiday rule %q: %v", rule.Name, err)
+		}
+	}
+	return nil
+}
+
+// engineCalendar converts a stored calendar for business-day calculations
+func engineCalendar(calendar *pb.HolidayCalendar) (*Calendar, error) {
+	rules, err := rulesFromProto(calendar.Rules)
+	if err != nil {
+		return nil, err
+	}
+	return &Calendar{
+		Code:    calendar.Code,
+		Weekend: weekdays(calendar.WeekendDays),
+		Rules:   rules,
+	}, nil
+}
+
+// rulesFromProto converts and validates holiday rules
+// Spec: docs/specs/012-holiday-calendars.md#holiday-rules
+func rulesFromProto(rules []*pb.HolidayRule) ([]Rule, error) {
+	converted := make([]Rule, 0, len(rules))
+	for _, r := range rules {
+		rule := Rule{
+			Name:       strings.TrimSpace(r.Name),
+			Type:       mapRuleTypeToString(r.Type),
+			Observance: mapObservanceToString(r.Observance),
+			FromYear:   int(r.FromYear),
+			ToYear:     int(r.ToYear),
+		}
+		switch rule.Type {
+		case RuleFixed:
+			rule.Month, rule.Day = time.Month(r.Month), int(r.Day)
+		case RuleNthWeekday:
+			rule.Month, rule.Weekday, rule.Nth = time.Month(r.Month), time.Weekday(r.Weekday), int(r.Nth)
+		case RuleEaster:
+			rule.Offset = int(r.OffsetDays)
+		case RuleDate:
+			if r.Date != "" {
+				d, err := ParseDate(r.Date)
+				if err != nil {
+					return nil, fmt.Errorf("rule %q: %v", rule.Name, err)
+				}
+				rule.Date = d
+			}
+		}
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+		converted = append(converted, rule)
+	}
+	return converted, nil
+}
+
+// Helper functions
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func validateCode(code string) error {
+	if code == "" {
+		return fmt.Errorf("calendar code is required")
+	}
+	if len(code) > 20 || !calendarCodeRegex.MatchString(code) {
+		return fmt.Errorf("invalid calendar code %s: must be up to 20 letters and digits in hyphen-separated parts", code)
+	}
+	return nil
+}
+
+func weekdays(days []int32) []time.Weekday {
+	converted := make([]time.Weekday, 0, len(days))
+	for _, day := range days {
+		converted = append(converted, time.Weekday(day))
+	}
+	return converted
+}
+
+func nullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: s, Valid: true}
+}
+
+func nullYear(year int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(year), Valid: year != 0}
+}
+
+func mapRuleTypeToString(t pb.HolidayRuleType) string {
+	switch t {
+	case pb.HolidayRuleType_HOLIDAY_RULE_TYPE_FIXED:
+		return RuleFixed
+	case pb.HolidayRuleType_HOLIDAY_RULE_TYPE_NTH_WEEKDAY:
+		return RuleNthWeekday
+	case pb.HolidayRuleType_HOLIDAY_RULE_TYPE_EASTER:
+		return RuleEaster
+	case pb.HolidayRuleType_HOLIDAY_RULE_TYPE_DATE:
+		return RuleDate
+	default:
+		return ""
+	}
+}
+
+func mapStringToRuleType(s string) pb.HolidayRuleType {
+	switch s {
+	case RuleFixed:
+		return pb.HolidayRuleType_HOLIDAY_RULE_TYPE_FIXED
+	case RuleNthWeekday:
+		return pb.HolidayRuleType_HOLIDAY_RULE_TYPE_NTH_WEEKDAY
+	case RuleEaster:
+		return pb.HolidayRuleType_HOLIDAY_RULE_TYPE_EASTER
+	case RuleDate:
+		return pb.HolidayRuleType_HOLIDAY_RULE_TYPE_DATE
+	default:
+		return pb.HolidayRuleType_HOLIDAY_RULE_TYPE_UNSPECIFIED
+	}
+}
+
+func mapObservanceToString(o pb.HolidayObservance) string {
+	switch o {
+	case pb.HolidayObservance_HOLIDAY_OBSERVANCE_SUNDAY_TO_MONDAY:
+		return ObserveSundayToMonday
+	case pb.HolidayObservance_HOLIDAY_OBSERVANCE_NEAREST_WEEKDAY:
+		return ObserveNearestWeekday
+	case pb.HolidayObservance_HOLIDAY_OBSERVANCE_NEXT_WEEKDAY:
+		return ObserveNextWeekday
+	default:
+		return ObserveNone
+	}
+}
+
+func mapStringToObservance(s string) pb.HolidayObservance {
+	switch s {
+	case ObserveSundayToMonday:
+		return pb.HolidayObservance_HOLIDAY_OBSERVANCE_SUNDAY_TO_MONDAY
+	case ObserveNearestWeekday:
+		return pb.HolidayObservance_HOLIDAY_OBSERVANCE_NEAREST_WEEKDAY
+	case ObserveNextWeekday:
+		return pb.HolidayObservance_HOLIDAY_OBSERVANCE_NEXT_WEEKDAY
+	default:
+		return pb.HolidayObservance_HOLIDAY_OBSERVANCE_NONE
+	}
+}
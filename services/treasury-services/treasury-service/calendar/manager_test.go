@@ -0,0 +1,325 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// calendarColumnNames are the columns returned by calendar queries
+var calendarColumnNames = []string{
+	"id", "code", "name", "description", "weekend_days", "is_active",
+	"created_at", "updated_at", "created_by", "updated_by", "version",
+}
+
+// ruleColumnNames are the columns returned by holiday rule queries
+var ruleColumnNames = []string{
+	"name", "rule_type", "month", "day", "weekday", "nth", "offset_days",
+	"holiday_date", "observance", "from_year", "to_year",
+}
+
+// calendarRow returns a result set with one stored calendar
+func calendarRow(id, code string, isActive bool) *sqlmock.Rows {
+	createdAt := time.Date(2025, 9, 29, 9, 0, 0, 0, time.UTC)
+	return sqlmock.NewRows(calendarColumnNames).AddRow(
+		id, code, code+" holidays", nil, []byte("{0,6}"), isActive,
+		createdAt, createdAt, "system", "system", 1,
+	)
+}
+
+// expectCalendar expects a calendar and its rules to be read by code
+func expectCalendar(mock sqlmock.Sqlmock, id, code string, isActive bool, rules *sqlmock.Rows) {
+	mock.ExpectQuery("SELECT .+ FROM treasury.holiday_calendars WHERE code = \\$1").
+		WithArgs(code).
+		WillReturnRows(calendarRow(id, code, isActive))
+	mock.ExpectQuery("FROM treasury.holiday_rules WHERE calendar_id = \\$1").
+		WithArgs(id).
+		WillReturnRows(rules)
+}
+
+// usFedRules returns stored US-FED rules around Independence Day
+func usFedRules() *sqlmock.Rows {
+	return sqlmock.NewRows(ruleColumnNames).
+		AddRow("Independence Day", "fixed", 7, 4, nil, nil, nil, nil, "sunday_to_monday", nil, nil).
+		AddRow("Labor Day", "nth_weekday", 9, nil, 1, 1, nil, nil, "none", nil, nil)
+}
+
+// ukBacsRules returns stored UK-BACS rules around Easter
+func ukBacsRules() *sqlmock.Rows {
+	return sqlmock.NewRows(ruleColumnNames).
+		AddRow("Easter Monday", "easter", nil, nil, nil, nil, 1, nil, "none", nil, nil).
+		AddRow("Good Friday", "easter", nil, nil, nil, nil, -2, nil, "none", nil, nil)
+}
+
+// TestNewManager tests the creation of a new Manager
+func TestNewManager(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	manager := NewManager(db)
+	assert.NotNil(t, manager)
+	assert.Equal(t, db, manager.db)
+}
+
+// TestCreateCalendarValidation tests that invalid calendars are rejected
+// before the database is touched
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func TestCreateCalendarValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.CreateCalendarRequest
+		message string
+	}{
+		{
+			name:    "missing code",
+			req:     &pb.CreateCalendarRequest{Name: "Federal Reserve"},
+			message: "calendar code is required",
+		},
+		{
+			name:    "invalid code",
+			req:     &pb.CreateCalendarRequest{Code: "US_FED", Name: "Federal Reserve"},
+			message: "invalid calendar code US_FED",
+		},
+		{
+			name:    "missing name",
+			req:     &pb.CreateCalendarRequest{Code: "US-FED"},
+			message: "calendar name is required",
+		},
+		{
+			name:    "every day a weekend",
+			req:     &pb.CreateCalendarRequest{Code: "US-FED", Name: "Federal Reserve", WeekendDays: []int32{0, 1, 2, 3, 4, 5, 6}},
+			message: "weekend must leave at least one working day",
+		},
+		{
+			name: "invalid rule",
+			req: &pb.CreateCalendarRequest{Code: "US-FED", Name: "Federal Reserve", Rules: []*pb.HolidayRule{
+				{Name: "Independence Day", Type: pb.HolidayRuleType_HOLIDAY_RULE_TYPE_FIXED, Month: 7, Day: 32},
+			}},
+			message: `rule "Independence Day": day 32 is not in month 7`,
+		},
+		{
+			name: "invalid rule date",
+			req: &pb.CreateCalendarRequest{Code: "UK-BACS", Name: "Bacs", Rules: []*pb.HolidayRule{
+				{Name: "Coronation", Type: pb.HolidayRuleType_HOLIDAY_RULE_TYPE_DATE, Date: "08/05/2023"},
+			}},
+			message: `rule "Coronation": date must be YYYY-MM-DD`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			_, err = NewManager(db).CreateCalendar(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), tt.message)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestCreateCalendar tests that a calendar is stored with its rules
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func TestCreateCalendar(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	calendarID := "4b3f9d1e-7c6a-4f1e-9a59-0c1f5f1d2e3a"
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO treasury.holiday_calendars").
+		WithArgs(sqlmock.AnyArg(), "US-FED", "Federal Reserve", nil, "{0,6}", "ops").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO treasury.holiday_rules").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "Independence Day", "fixed", 7, 4, nil, nil, nil,
+			nil, "sunday_to_monday", nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO treasury.holiday_rules").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "Labor Day", "nth_weekday", 9, nil, 1, 1, nil,
+			nil, "none", nil, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectCalendar(mock, calendarID, "US-FED", true, usFedRules())
+	mock.ExpectQuery("SELECT currency_code FROM treasury.currency_settlement_calendars").
+		WithArgs(calendarID).
+		WillReturnRows(sqlmock.NewRows([]string{"currency_code"}))
+	mock.ExpectCommit()
+
+	calendar, err := NewManager(db).CreateCalendar(context.Background(), &pb.CreateCalendarRequest{
+		Code: " us-fed ",
+		Name: "Federal Reserve",
+		Rules: []*pb.HolidayRule{
+			{Name: "Independence Day", Type: pb.HolidayRuleType_HOLIDAY_RULE_TYPE_FIXED, Month: 7, Day: 4,
+				Observance: pb.HolidayObservance_HOLIDAY_OBSERVANCE_SUNDAY_TO_MONDAY},
+			{Name: "Labor Day", Type: pb.HolidayRuleType_HOLIDAY_RULE_TYPE_NTH_WEEKDAY, Month: 9, Weekday: 1, Nth: 1},
+		},
+		CreatedBy: "ops",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "US-FED", calendar.Code)
+	assert.Equal(t, []int32{0, 6}, calendar.WeekendDays)
+	require.Len(t, calendar.Rules, 2)
+	assert.Equal(t, pb.HolidayObservance_HOLIDAY_OBSERVANCE_SUNDAY_TO_MONDAY, calendar.Rules[0].Observance)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetCalendarHolidays tests the holidays listed for a year
+// Spec: docs/specs/012-holiday-calendars.md#observance
+func TestGetCalendarHolidays(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	calendarID := "4b3f9d1e-7c6a-4f1e-9a59-0c1f5f1d2e3a"
+	expectCalendar(mock, calendarID, "US-FED", true, usFedRules())
+	mock.ExpectQuery("SELECT currency_code FROM treasury.currency_settlement_calendars").
+		WithArgs(calendarID).
+		WillReturnRows(sqlmock.NewRows([]string{"currency_code"}).AddRow("USD"))
+
+	resp, err := NewManager(db).GetCalendar(context.Background(), &pb.GetCalendarRequest{Code: "US-FED", Year: 2027})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"USD"}, resp.Calendar.SettlementCurrencies)
+	// Independence Day 2027 is a Sunday
+	require.Len(t, resp.Holidays, 2)
+	assert.Equal(t, "2027-07-05", resp.Holidays[0].Date)
+	assert.Equal(t, "Independence Day (observed)", resp.Holidays[0].Name)
+	assert.Equal(t, "2027-09-06", resp.Holidays[1].Date)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestUpdateCalendarVersionMismatch tests optimistic locking
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func TestUpdateCalendarVersionMismatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, version FROM treasury.holiday_calendars WHERE code = \\$1 FOR UPDATE").
+		WithArgs("US-FED").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow("4b3f9d1e-7c6a-4f1e-9a59-0c1f5f1d2e3a", 3))
+	mock.ExpectRollback()
+
+	_, err = NewManager(db).UpdateCalendar(context.Background(), &pb.UpdateCalendarRequest{
+		Code:       "US-FED",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+		Name:       "Federal Reserve Banks",
+		Version:    2,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestIsBusinessDay tests that an institution's calendar is combined with
+// the currency's settlement calendar
+// Spec: docs/specs/012-holiday-calendars.md#combining-calendars
+func TestIsBusinessDay(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT holiday_calendar FROM treasury.financial_institutions").
+		WithArgs("CHASE").
+		WillReturnRows(sqlmock.NewRows([]string{"holiday_calendar"}).AddRow("US-FED"))
+	mock.ExpectQuery("FROM treasury.currencies c").
+		WithArgs("GBP").
+		WillReturnRows(sqlmock.NewRows([]string{"code"}).AddRow("UK-BACS"))
+	expectCalendar(mock, "4b3f9d1e-7c6a-4f1e-9a59-0c1f5f1d2e3a", "US-FED", true, usFedRules())
+	expectCalendar(mock, "9d2c7a55-1b0e-4c3a-8f6d-2e4b6a8c0d1f", "UK-BACS", true, ukBacsRules())
+
+	// Good Friday 2027 is a business day in the US but not in the UK
+	resp, err := NewManager(db).IsBusinessDay(context.Background(), &pb.IsBusinessDayRequest{
+		Date:            "2027-03-26",
+		InstitutionCode: "CHASE",
+		CurrencyCode:    "gbp",
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.IsBusinessDay)
+	assert.Equal(t, "UK-BACS: Good Friday", resp.Reason)
+	assert.Equal(t, "2027-03-30", resp.NextBusinessDay)
+	assert.Equal(t, []string{"US-FED", "UK-BACS"}, resp.CalendarCodes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestIsBusinessDayErrors tests calendars that cannot be resolved
+// Spec: docs/specs/012-holiday-calendars.md#combining-calendars
+func TestIsBusinessDayErrors(t *testing.T) {
+	t.Run("no calendar", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT holiday_calendar FROM treasury.financial_institutions").
+			WithArgs("CHASE").
+			WillReturnRows(sqlmock.NewRows([]string{"holiday_calendar"}).AddRow(nil))
+
+		_, err = NewManager(db).IsBusinessDay(context.Background(), &pb.IsBusinessDayRequest{
+			Date:            "2027-03-26",
+			InstitutionCode: "CHASE",
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("inactive calendar", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT .+ FROM treasury.holiday_calendars WHERE code = \\$1").
+			WithArgs("TARGET2").
+			WillReturnRows(calendarRow("4b3f9d1e-7c6a-4f1e-9a59-0c1f5f1d2e3a", "TARGET2", false))
+
+		_, err = NewManager(db).IsBusinessDay(context.Background(), &pb.IsBusinessDayRequest{
+			Date:          "2027-03-26",
+			CalendarCodes: []string{"target2"},
+		})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		_, err = NewManager(db).IsBusinessDay(context.Background(), &pb.IsBusinessDayRequest{
+			Date:          "26/03/2027",
+			CalendarCodes: []string{"US-FED"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestNextSettlementDate tests T+n settlement over a holiday
+// Spec: docs/specs/012-holiday-calendars.md#business-day-arithmetic
+func TestNextSettlementDate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	expectCalendar(mock, "9d2c7a55-1b0e-4c3a-8f6d-2e4b6a8c0d1f", "UK-BACS", true, ukBacsRules())
+
+	// Traded on Thursday before Easter 2027: Friday and Monday are holidays
+	resp, err := NewManager(db).NextSettlementDate(context.Background(), &pb.NextSettlementDateRequest{
+		TradeDate:      "2027-03-25",
+		SettlementDays: 2,
+		CalendarCodes:  []string{"UK-BACS"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2027-03-31", resp.SettlementDate)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,385 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Rule types
+// Spec: docs/specs/012-holiday-calendars.md#holiday-rules
+const (
+	RuleFixed      = "fixed"       // Same month and day every year
+	RuleNthWeekday = "nth_weekday" // e.g. the third Monday of January, or the last Monday of May
+	RuleEaster     = "easter"      // Days from Western Easter Sunday
+	RuleDate       = "date"        // One date, such as a state funeral
+)
+
+// Observances move a holiday that falls on a weekend
+// Spec: docs/specs/012-holiday-calendars.md#observance
+const (
+	ObserveNone           = "none"             // Not moved
+	ObserveSundayToMonday = "sunday_to_monday" // Sunday to Monday, Saturday not moved (Federal Reserve)
+	ObserveNearestWeekday = "nearest_weekday"  // Saturday to Friday, Sunday to Monday
+	ObserveNextWeekday    = "next_weekday"     // Next weekday that is not already a holiday (UK substitute days)
+)
+
+// DateLayout is the format of dates in requests and responses
+const DateLayout = "2006-01-02"
+
+// maxSearchDays bounds the search for a business day
+const maxSearchDays = 3660
+
+// Rule describes how to find a holiday in a given year
+// Spec: docs/specs/012-holiday-calendars.md#holiday-rules
+type Rule struct {
+	Name       string
+	Type       string
+	Month      time.Month   // fixed, nth_weekday
+	Day        int          // fixed
+	Weekday    time.Weekday // nth_weekday
+	Nth        int          // nth_weekday: 1 to 5, or -1 for the last
+	Offset     int          // easter
+	Date       time.Time    // date
+	Observance string
+	FromYear   int // First year the rule applies, 0 for no limit
+	ToYear     int // Last year the rule applies, 0 for no limit
+}
+
+// Calendar is a named set of weekend days and holiday rules
+type Calendar struct {
+	Code    string
+	Weekend []time.Weekday
+	Rules   []Rule
+}
+
+// Holiday is an observed holiday of a calendar
+type Holiday struct {
+	Date     time.Time
+	Name     string
+	Calendar string
+}
+
+// Validate checks that a rule can produce a date
+func (r Rule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("rule name is required")
+	}
+
+	switch r.Type {
+	case RuleFixed:
+		if r.Month < time.January || r.Month > time.December {
+			return fmt.Errorf("rule %q: month must be 1 to 12", r.Name)
+		}
+		// 2000 is a leap year, so 29 February is accepted
+		if r.Day < 1 || r.Day > daysIn(r.Month, 2000) {
+			return fmt.Errorf("rule %q: day %d is not in month %d", r.Name, r.Day, r.Month)
+		}
+	case RuleNthWeekday:
+		if r.Month < time.January || r.Month > time.December {
+			return fmt.Errorf("rule %q: month must be 1 to 12", r.Name)
+		}
+		if r.Weekday < time.Sunday || r.Weekday > time.Saturday {
+			return fmt.Errorf("rule %q: weekday must be 0 (Sunday) to 6 (Saturday)", r.Name)
+		}
+		if r.Nth != -1 && (r.Nth < 1 || r.Nth > 5) {
+			return fmt.Errorf("rule %q: nth must be 1 to 5, or -1 for the last", r.Name)
+		}
+	case RuleEaster:
+		if r.Offset < -100 || r.Offset > 100 {
+			return fmt.Errorf("rule %q: offset must be within 100 days of Easter", r.Name)
+		}
+	case RuleDate:
+		if r.Date.IsZero() {
+			return fmt.Errorf("rule %q: date is required", r.Name)
+		}
+	default:
+		return fmt.Errorf("rule %q: unknown rule type %q", r.Name, r.Type)
+	}
+
+	switch r.Observance {
+	case ObserveNone, ObserveSundayToMonday, ObserveNearestWeekday, ObserveNextWeekday:
+	default:
+		return fmt.Errorf("rule %q: unknown observance %q", r.Name, r.Observance)
+	}
+	if r.FromYear != 0 && r.ToYear != 0 && r.ToYear < r.FromYear {
+		return fmt.Errorf("rule %q: to_year is before from_year", r.Name)
+	}
+	return nil
+}
+
+// ValidateWeekend checks that weekend days are distinct days of the week
+// and leave at least one working day
+func ValidateWeekend(weekend []time.Weekday) error {
+	seen := map[time.Weekday]bool{}
+	for _, day := range weekend {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("weekend day must be 0 (Sunday) to 6 (Saturday), got %d", day)
+		}
+		if seen[day] {
+			return fmt.Errorf("weekend day %s is listed twice", day)
+		}
+		seen[day] = true
+	}
+	if len(seen) == 7 {
+		return fmt.Errorf("weekend must leave at least one working day")
+	}
+	return nil
+}
+
+// date returns the date of the rule in a year, before observance
+func (r Rule) date(year int) (time.Time, bool) {
+	if (r.FromYear != 0 && year < r.FromYear) || (r.ToYear != 0 && year > r.ToYear) {
+		return time.Time{}, false
+	}
+
+	switch r.Type {
+	case RuleFixed:
+		if r.Day > daysIn(r.Month, year) {
+			return time.Time{}, false
+		}
+		return time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.UTC), true
+	case RuleNthWeekday:
+		if r.Nth == -1 {
+			last := time.Date(year, r.Month, daysIn(r.Month, year), 0, 0, 0, 0, time.UTC)
+			return last.AddDate(0, 0, -int((last.Weekday()-r.Weekday+7)%7)), true
+		}
+		first := time.Date(year, r.Month, 1, 0, 0, 0, 0, time.UTC)
+		d := first.AddDate(0, 0, int((r.Weekday-first.Weekday()+7)%7)+7*(r.Nth-1))
+		if d.Month() != r.Month {
+			return time.Time{}, false
+		}
+		return d, true
+	case RuleEaster:
+		return Easter(year).AddDate(0, 0, r.Offset), true
+	case RuleDate:
+		if r.Date.Year() != year {
+			return time.Time{}, false
+		}
+		return Day(r.Date), true
+	}
+	return time.Time{}, false
+}
+
+// Easter returns Western Easter Sunday of a year, using the anonymous
+// Gregorian algorithm
+func Easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// IsWeekend reports whether a date falls on one of the calendar's weekend days
+func (c *Calendar) IsWeekend(d time.Time) bool {
+	for _, day := range c.Weekend {
+		if d.Weekday() == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Holidays returns the observed holidays of a year in date order. A
+// holiday moved by its observance is named "{name} (observed)".
+// Spec: docs/specs/012-holiday-calendars.md#observance
+func (c *Calendar) Holidays(year int) []Holiday {
+	// Observance can move a holiday into the previous or next year
+	var holidays []Holiday
+	for _, y := range []int{year - 1, year, year + 1} {
+		for _, h := range c.observe(y) {
+			if h.Date.Year() == year {
+				holidays = append(holidays, h)
+			}
+		}
+	}
+	sort.SliceStable(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+// observe applies observance to the rule dates of one year. Holidays on
+// weekdays keep their dates; weekend holidays are then moved in date
+// order, so two substitutes never share a day.
+func (c *Calendar) observe(year int) []Holiday {
+	type dated struct {
+		date time.Time
+		rule Rule
+	}
+	var dates []dated
+	for _, rule := range c.Rules {
+		if d, ok := rule.date(year); ok {
+			dates = append(dates, dated{d, rule})
+		}
+	}
+	sort.SliceStable(dates, func(i, j int) bool { return dates[i].date.Before(dates[j].date) })
+
+	taken := map[time.Time]bool{}
+	for _, d := range dates {
+		if !c.IsWeekend(d.date) {
+			taken[d.date] = true
+		}
+	}
+
+	holidays := make([]Holiday, 0, len(dates))
+	for _, d := range dates {
+		observed := d.date
+		if c.IsWeekend(d.date) {
+			switch d.rule.Observance {
+			case ObserveSundayToMonday:
+				if d.date.Weekday() == time.Sunday {
+					observed = c.nextFree(d.date, taken)
+				}
+			case ObserveNearestWeekday:
+				switch d.date.Weekday() {
+				case time.Saturday:
+					observed = d.date.AddDate(0, 0, -1)
+				case time.Sunday:
+					observed = d.date.AddDate(0, 0, 1)
+				}
+			case ObserveNextWeekday:
+				observed = c.nextFree(d.date, taken)
+			}
+		}
+
+		name := d.rule.Name
+		if !observed.Equal(d.date) {
+			name += " (observed)"
+			taken[observed] = true
+		}
+		holidays = append(holidays, Holiday{Date: observed, Name: name, Calendar: c.Code})
+	}
+	return holidays
+}
+
+// nextFree returns the first day after d that is neither a weekend day
+// nor already a holiday
+func (c *Calendar) nextFree(d time.Time, taken map[time.Time]bool) time.Time {
+	for i := 0; i < 14; i++ {
+		d = d.AddDate(0, 0, 1)
+		if !c.IsWeekend(d) && !taken[d] {
+			return d
+		}
+	}
+	return d
+}
+
+// Set combines calendars: a day is a business day only when it is one in
+// every calendar of the set
+// Spec: docs/specs/012-holiday-calendars.md#combining-calendars
+type Set struct {
+	calendars []*Calendar
+	years     map[int]map[time.Time]string
+}
+
+// NewSet creates a combined calendar
+func NewSet(calendars ...*Calendar) *Set {
+	return &Set{calendars: calendars, years: map[int]map[time.Time]string{}}
+}
+
+// Codes returns the codes of the calendars in the set
+func (s *Set) Codes() []string {
+	codes := make([]string, 0, len(s.calendars))
+	for _, c := range s.calendars {
+		codes = append(codes, c.Code)
+	}
+	return codes
+}
+
+// Check reports whether d is a business day, and if not, why: e.g.
+// "weekend (US-FED)" or "US-FED: Independence Day"
+func (s *Set) Check(d time.Time) (bool, string) {
+	d = Day(d)
+	for _, c := range s.calendars {
+		if c.IsWeekend(d) {
+			return false, fmt.Sprintf("weekend (%s)", c.Code)
+		}
+	}
+
+	holidays, ok := s.years[d.Year()]
+	if !ok {
+		holidays = map[time.Time]string{}
+		for _, c := range s.calendars {
+			for _, h := range c.Holidays(d.Year()) {
+				if _, exists := holidays[h.Date]; !exists {
+					holidays[h.Date] = h.Calendar + ": " + h.Name
+				}
+			}
+		}
+		s.years[d.Year()] = holidays
+	}
+	if reason, ok := holidays[d]; ok {
+		return false, reason
+	}
+	return true, ""
+}
+
+// IsBusinessDay reports whether d is a business day in every calendar
+func (s *Set) IsBusinessDay(d time.Time) bool {
+	ok, _ := s.Check(d)
+	return ok
+}
+
+// NextBusinessDay returns d if it is a business day, or the first business
+// day after it
+func (s *Set) NextBusinessDay(d time.Time) (time.Time, error) {
+	d = Day(d)
+	for i := 0; i < maxSearchDays; i++ {
+		if s.IsBusinessDay(d) {
+			return d, nil
+		}
+		d = d.AddDate(0, 0, 1)
+	}
+	return time.Time{}, fmt.Errorf("no business day within %d days", maxSearchDays)
+}
+
+// AddBusinessDays moves n business days from d, backwards when n is
+// negative. With n = 0 it returns the next business day on or after d.
+// Spec: docs/specs/012-holiday-calendars.md#business-day-arithmetic
+func (s *Set) AddBusinessDays(d time.Time, n int) (time.Time, error) {
+	d = Day(d)
+	if n == 0 {
+		return s.NextBusinessDay(d)
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for i := 0; n > 0; i++ {
+		if i >= maxSearchDays {
+			return time.Time{}, fmt.Errorf("no business day within %d days", maxSearchDays)
+		}
+		d = d.AddDate(0, 0, step)
+		if s.IsBusinessDay(d) {
+			n--
+		}
+	}
+	return d, nil
+}
+
+// Day truncates a time to its calendar date in UTC
+func Day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ParseDate parses a YYYY-MM-DD date
+func ParseDate(s string) (time.Time, error) {
+	d, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("date must be YYYY-MM-DD, got %q", s)
+	}
+	return d, nil
+}
+
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,190 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var weekend = []time.Weekday{time.Saturday, time.Sunday}
+
+// usFed mirrors the US-FED calendar seeded by migration 000010
+func usFed() *Calendar {
+	return &Calendar{Code: "US-FED", Weekend: weekend, Rules: []Rule{
+		{Name: "New Year's Day", Type: RuleFixed, Month: time.January, Day: 1, Observance: ObserveSundayToMonday},
+		{Name: "Birthday of Martin Luther King, Jr.", Type: RuleNthWeekday, Month: time.January, Weekday: time.Monday, Nth: 3, Observance: ObserveNone},
+		{Name: "Washington's Birthday", Type: RuleNthWeekday, Month: time.February, Weekday: time.Monday, Nth: 3, Observance: ObserveNone},
+		{Name: "Memorial Day", Type: RuleNthWeekday, Month: time.May, Weekday: time.Monday, Nth: -1, Observance: ObserveNone},
+		{Name: "Juneteenth National Independence Day", Type: RuleFixed, Month: time.June, Day: 19, Observance: ObserveSundayToMonday, FromYear: 2022},
+		{Name: "Independence Day", Type: RuleFixed, Month: time.July, Day: 4, Observance: ObserveSundayToMonday},
+		{Name: "Labor Day", Type: RuleNthWeekday, Month: time.September, Weekday: time.Monday, Nth: 1, Observance: ObserveNone},
+		{Name: "Columbus Day", Type: RuleNthWeekday, Month: time.October, Weekday: time.Monday, Nth: 2, Observance: ObserveNone},
+		{Name: "Veterans Day", Type: RuleFixed, Month: time.November, Day: 11, Observance: ObserveSundayToMonday},
+		{Name: "Thanksgiving Day", Type: RuleNthWeekday, Month: time.November, Weekday: time.Thursday, Nth: 4, Observance: ObserveNone},
+		{Name: "Christmas Day", Type: RuleFixed, Month: time.December, Day: 25, Observance: ObserveSundayToMonday},
+	}}
+}
+
+// target2 mirrors the TARGET2 calendar seeded by migration 000010
+func target2() *Calendar {
+	return &Calendar{Code: "TARGET2", Weekend: weekend, Rules: []Rule{
+		{Name: "New Year's Day", Type: RuleFixed, Month: time.January, Day: 1, Observance: ObserveNone},
+		{Name: "Good Friday", Type: RuleEaster, Offset: -2, Observance: ObserveNone},
+		{Name: "Easter Monday", Type: RuleEaster, Offset: 1, Observance: ObserveNone},
+		{Name: "Labour Day", Type: RuleFixed, Month: time.May, Day: 1, Observance: ObserveNone},
+		{Name: "Christmas Day", Type: RuleFixed, Month: time.December, Day: 25, Observance: ObserveNone},
+		{Name: "Christmas Holiday", Type: RuleFixed, Month: time.December, Day: 26, Observance: ObserveNone},
+	}}
+}
+
+// ukBacs mirrors the UK-BACS substitute day rules seeded by migration 000010
+func ukBacs() *Calendar {
+	return &Calendar{Code: "UK-BACS", Weekend: weekend, Rules: []Rule{
+		{Name: "New Year's Day", Type: RuleFixed, Month: time.January, Day: 1, Observance: ObserveNextWeekday},
+		{Name: "Christmas Day", Type: RuleFixed, Month: time.December, Day: 25, Observance: ObserveNextWeekday},
+		{Name: "Boxing Day", Type: RuleFixed, Month: time.December, Day: 26, Observance: ObserveNextWeekday},
+		{Name: "State Funeral of Queen Elizabeth II", Type: RuleDate, Date: date("2022-09-19"), Observance: ObserveNone},
+	}}
+}
+
+func date(s string) time.Time {
+	d, err := ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func holidayDates(c *Calendar, year int) []string {
+	var dates []string
+	for _, h := range c.Holidays(year) {
+		dates = append(dates, h.Date.Format(DateLayout)+" "+h.Name)
+	}
+	return dates
+}
+
+// TestEaster tests Easter Sunday against published dates
+func TestEaster(t *testing.T) {
+	for year, want := range map[int]string{
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2038: "2038-04-25",
+	} {
+		assert.Equal(t, want, Easter(year).Format(DateLayout), year)
+	}
+}
+
+// TestUSFedHolidays tests Federal Reserve holidays and the Sunday rule. A
+// Saturday holiday stays on Saturday; a moved holiday is listed on its
+// observed date only.
+// Spec: docs/specs/012-holiday-calendars.md#observance
+func TestUSFedHolidays(t *testing.T) {
+	assert.Equal(t, []string{
+		"2022-01-01 New Year's Day",
+		"2022-01-17 Birthday of Martin Luther King, Jr.",
+		"2022-02-21 Washington's Birthday",
+		"2022-05-30 Memorial Day",
+		"2022-06-20 Juneteenth National Independence Day (observed)",
+		"2022-07-04 Independence Day",
+		"2022-09-05 Labor Day",
+		"2022-10-10 Columbus Day",
+		"2022-11-11 Veterans Day",
+		"2022-11-24 Thanksgiving Day",
+		"2022-12-26 Christmas Day (observed)",
+	}, holidayDates(usFed(), 2022))
+
+	// Juneteenth applies from 2022
+	for _, h := range usFed().Holidays(2021) {
+		assert.NotContains(t, h.Name, "Juneteenth")
+	}
+}
+
+// TestUKSubstituteDays tests that weekend holidays move to the next free weekday
+// Spec: docs/specs/012-holiday-calendars.md#observance
+func TestUKSubstituteDays(t *testing.T) {
+	// Christmas on Saturday, Boxing Day on Sunday
+	assert.Equal(t, []string{
+		"2021-01-01 New Year's Day",
+		"2021-12-27 Christmas Day (observed)",
+		"2021-12-28 Boxing Day (observed)",
+	}, holidayDates(ukBacs(), 2021))
+
+	// Christmas on Sunday, Boxing Day on Monday
+	assert.Equal(t, []string{
+		"2022-01-03 New Year's Day (observed)",
+		"2022-09-19 State Funeral of Queen Elizabeth II",
+		"2022-12-26 Boxing Day",
+		"2022-12-27 Christmas Day (observed)",
+	}, holidayDates(ukBacs(), 2022))
+}
+
+// TestSetBusinessDays tests combining calendars and business day arithmetic
+// Spec: docs/specs/012-holiday-calendars.md#business-day-arithmetic
+func TestSetBusinessDays(t *testing.T) {
+	set := NewSet(usFed(), target2())
+	assert.Equal(t, []string{"US-FED", "TARGET2"}, set.Codes())
+
+	ok, reason := set.Check(date("2024-07-04"))
+	assert.False(t, ok)
+	assert.Equal(t, "US-FED: Independence Day", reason)
+
+	ok, reason = set.Check(date("2024-03-29"))
+	assert.False(t, ok)
+	assert.Equal(t, "TARGET2: Good Friday", reason)
+
+	ok, reason = set.Check(date("2024-03-30"))
+	assert.False(t, ok)
+	assert.Equal(t, "weekend (US-FED)", reason)
+
+	assert.True(t, set.IsBusinessDay(date("2024-03-28")))
+
+	// Thursday before Good Friday + 1 skips Friday, the weekend and Easter Monday
+	got, err := set.AddBusinessDays(date("2024-03-28"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-04-02", got.Format(DateLayout))
+
+	got, err = set.AddBusinessDays(date("2024-04-02"), -1)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-28", got.Format(DateLayout))
+
+	got, err = set.AddBusinessDays(date("2024-12-24"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-12-30", got.Format(DateLayout))
+
+	got, err = set.AddBusinessDays(date("2024-03-30"), 0)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-04-02", got.Format(DateLayout))
+}
+
+// TestRuleValidate tests rejecting rules that cannot produce a date
+// Spec: docs/specs/012-holiday-calendars.md#holiday-rules
+func TestRuleValidate(t *testing.T) {
+	tests := []struct {
+		rule    Rule
+		wantErr string
+	}{
+		{Rule{Name: "Leap Day", Type: RuleFixed, Month: time.February, Day: 29, Observance: ObserveNone}, ""},
+		{Rule{Name: "", Type: RuleFixed}, "rule name is required"},
+		{Rule{Name: "Bad", Type: RuleFixed, Month: time.April, Day: 31, Observance: ObserveNone}, `rule "Bad": day 31 is not in month 4`},
+		{Rule{Name: "Bad", Type: RuleNthWeekday, Month: time.May, Weekday: time.Monday, Nth: 0, Observance: ObserveNone}, `rule "Bad": nth must be 1 to 5, or -1 for the last`},
+		{Rule{Name: "Bad", Type: RuleDate, Observance: ObserveNone}, `rule "Bad": date is required`},
+		{Rule{Name: "Bad", Type: "lunar", Observance: ObserveNone}, `rule "Bad": unknown rule type "lunar"`},
+		{Rule{Name: "Bad", Type: RuleEaster, Observance: "previous_friday"}, `rule "Bad": unknown observance "previous_friday"`},
+	}
+
+	for _, tt := range tests {
+		err := tt.rule.Validate()
+		if tt.wantErr == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, tt.wantErr)
+		}
+	}
+
+	assert.NoError(t, ValidateWeekend([]time.Weekday{time.Friday, time.Saturday}))
+	assert.EqualError(t, ValidateWeekend([]time.Weekday{time.Sunday, time.Sunday}), "weekend day Sunday is listed twice")
+	assert.EqualError(t, ValidateWeekend([]time.Weekday{0, 1, 2, 3, 4, 5, 6}), "weekend must leave at least one working day")
+}
@@ -0,0 +1,72 @@
+package calendar
+
+import (
+	"context"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// Server implements the CalendarService gRPC interface
+// Spec: docs/specs/012-holiday-calendars.md
+type Server struct {
+	pb.UnimplementedCalendarServiceServer
+	manager *Manager
+}
+
+// NewServer creates a new calendar server instance
+// Spec: docs/specs/012-holiday-calendars.md
+func NewServer(manager *Manager) *Server {
+	return &Server{
+		manager: manager,
+	}
+}
+
+// CreateCalendar creates a holiday calendar
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func (s *Server) CreateCalendar(ctx context.Context, req *pb.CreateCalendarRequest) (*pb.CreateCalendarResponse, error) {
+	calendar, err := s.manager.CreateCalendar(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateCalendarResponse{Calendar: calendar}, nil
+}
+
+// GetCalendar retrieves a holiday calendar
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func (s *Server) GetCalendar(ctx context.Context, req *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+	return s.manager.GetCalendar(ctx, req)
+}
+
+// UpdateCalendar updates a holiday calendar
+// Spec: docs/specs/012-holiday-calendars.md#story-1-maintain-holiday-calendars
+func (s *Server) UpdateCalendar(ctx context.Context, req *pb.UpdateCalendarRequest) (*pb.UpdateCalendarResponse, error) {
+	calendar, err := s.manager.UpdateCalendar(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateCalendarResponse{Calendar: calendar}, nil
+}
+
+// SetSettlementCalendar sets the settlement calendar of a currency
+// Spec: docs/specs/012-holiday-calendars.md#story-2-currency-settlement-calendars
+func (s *Server) SetSettlementCalendar(ctx context.Context, req *pb.SetSettlementCalendarRequest) (*pb.SetSettlementCalendarResponse, error) {
+	return s.manager.SetSettlementCalendar(ctx, req)
+}
+
+// IsBusinessDay checks whether a date is a business day
+// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+func (s *Server) IsBusinessDay(ctx context.Context, req *pb.IsBusinessDayRequest) (*pb.IsBusinessDayResponse, error) {
+	return s.manager.IsBusinessDay(ctx, req)
+}
+
+// AddBusinessDays moves a date by business days
+// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+func (s *Server) AddBusinessDays(ctx context.Context, req *pb.AddBusinessDaysRequest) (*pb.AddBusinessDaysResponse, error) {
+	return s.manager.AddBusinessDays(ctx, req)
+}
+
+// NextSettlementDate returns the settlement date of a trade
+// Spec: docs/specs/012-holiday-calendars.md#story-3-business-day-queries
+func (s *Server) NextSettlementDate(ctx context.Context, req *pb.NextSettlementDateRequest) (*pb.NextSettlementDateResponse, error) {
+	return s.manager.NextSettlementDate(ctx, req)
+}
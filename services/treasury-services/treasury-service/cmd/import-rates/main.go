@@ -0,0 +1,140 @@
+// Command import-rates loads an exchange rate file into the Treasury Service
+// through ExchangeRateService.ImportRates.
+// Spec: docs/specs/005-exchange-rates.md#story-4-import-rate-files
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+	"github.com/jamestroutman/treasury-service/exchangerate"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50052", "Treasury Service address")
+	format := flag.String("format", "", "File format: ecb or csv (default from the file extension)")
+	source := flag.String("source", "", "Rate source recorded on every rate (default ECB for ecb files)")
+	rateType := flag.String("rate-type", "spot", "Rate type: spot, average or closing")
+	updatedBy := flag.String("updated-by", "import-rates", "User recorded on imported rates")
+	timeout := flag.Duration("timeout", 60*time.Second, "Import timeout")
+	dryRun := flag.Bool("dry-run", false, "Parse the file and print the rates without importing")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: import-rates [flags] <file>\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	path := flag.Arg(0)
+
+	fileFormat, err := parseFormat(*format, path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *source == "" && fileFormat == pb.RateFileFormat_RATE_FILE_FORMAT_ECB_XML {
+		*source = "ECB"
+	}
+	if *source == "" {
+		log.Fatal("-source is required for csv files")
+	}
+	rateTypeValue, ok := pb.RateType_value["RATE_TYPE_"+strings.ToUpper(*rateType)]
+	if !ok || rateTypeValue == 0 {
+		log.Fatalf("invalid -rate-type %q: must be spot, average or closing", *rateType)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", path, err)
+	}
+
+	if *dryRun {
+		if err := printRates(fileFormat, content); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to create treasury service client: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := pb.NewExchangeRateServiceClient(conn).ImportRates(ctx, &pb.ImportRatesRequest{
+		Format:    fileFormat,
+		Content:   content,
+		Source:    *source,
+		RateType:  pb.RateType(rateTypeValue),
+		FileName:  filepath.Base(path),
+		UpdatedBy: *updatedBy,
+	})
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	fmt.Printf("Imported %s (%s, %s)\n", filepath.Base(path), *source, *rateType)
+	fmt.Printf("  Dates:    %s\n", strings.Join(resp.Dates, ", "))
+	fmt.Printf("  Created:  %d\n", resp.CreatedCount)
+	fmt.Printf("  Updated:  %d\n", resp.UpdatedCount)
+	fmt.Printf("  Skipped:  %d\n", resp.SkippedCount)
+	fmt.Printf("  Rejected: %d\n", len(resp.Errors))
+	for _, rejected := range resp.Errors {
+		fmt.Printf("    %s\n", rejected)
+	}
+	if len(resp.Errors) > 0 {
+		os.Exit(1)
+	}
+}
+
+// parseFormat returns the file format from the -format flag or, when it is
+// not set, from the file extension
+func parseFormat(format, path string) (pb.RateFileFormat, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+		if format == "xml" {
+			format = "ecb"
+		}
+	}
+	switch format {
+	case "ecb":
+		return pb.RateFileFormat_RATE_FILE_FORMAT_ECB_XML, nil
+	case "csv":
+		return pb.RateFileFormat_RATE_FILE_FORMAT_CSV, nil
+	default:
+		return 0, fmt.Errorf("unknown format %q: use -format ecb or -format csv", format)
+	}
+}
+
+// printRates prints the rates of a file without importing them
+func printRates(format pb.RateFileFormat, content []byte) error {
+	parse := exchangerate.ParseCSV
+	if format == pb.RateFileFormat_RATE_FILE_FORMAT_ECB_XML {
+		parse = exchangerate.ParseECB
+	}
+	rates, err := parse(bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	for _, rate := range rates {
+		fmt.Printf("%-16s %s %s/%s %s\n", rate.Ref, rate.Date, rate.Base, rate.Quote, rate.Rate)
+	}
+	fmt.Printf("%d rates\n", len(rates))
+	return nil
+}
@@ -0,0 +1,127 @@
+// Command import-routing-directory loads a Federal Reserve FedACH or Fedwire
+// routing directory file into the Treasury Service through
+// FinancialInstitutionService.ImportRoutingDirectory.
+// Spec: docs/specs/010-routing-directory.md
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50052", "Treasury Service address")
+	format := flag.String("format", "", "File format: fedach or fedwire (default detected from the record length)")
+	updatedBy := flag.String("updated-by", "import-routing-directory", "User recorded on created and updated institutions")
+	timeout := flag.Duration("timeout", 5*time.Minute, "Import timeout")
+	dryRun := flag.Bool("dry-run", false, "Print the changes the import would make without applying them")
+	verbose := flag.Bool("v", false, "Print every change")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: import-routing-directory [flags] <file>\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	path := flag.Arg(0)
+
+	fileFormat, err := parseFormat(*format)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", path, err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to create treasury service client: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := pb.NewFinancialInstitutionServiceClient(conn).ImportRoutingDirectory(ctx, &pb.ImportRoutingDirectoryRequest{
+		Format:    fileFormat,
+		Content:   content,
+		DryRun:    *dryRun,
+		FileName:  filepath.Base(path),
+		UpdatedBy: *updatedBy,
+	})
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	verb := "Imported"
+	if resp.DryRun {
+		verb = "Dry run of"
+	}
+	fmt.Printf("%s %s (%s)\n", verb, filepath.Base(path), formatName(resp.Format))
+	fmt.Printf("  Records:      %d\n", resp.RecordCount)
+	fmt.Printf("  Created:      %d institutions\n", resp.InstitutionsCreated)
+	fmt.Printf("  Updated:      %d institutions\n", resp.InstitutionsUpdated)
+	fmt.Printf("  Added:        %d routing numbers\n", resp.RoutingNumbersAdded)
+	fmt.Printf("  Removed:      %d routing numbers\n", resp.RoutingNumbersRemoved)
+	fmt.Printf("  Restored:     %d routing numbers\n", resp.RoutingNumbersRestored)
+	fmt.Printf("  Unchanged:    %d\n", resp.UnchangedCount)
+	fmt.Printf("  Skipped:      %d\n", resp.SkippedCount)
+	fmt.Printf("  Rejected:     %d\n", len(resp.Errors))
+	for _, rejected := range resp.Errors {
+		fmt.Printf("    %s\n", rejected)
+	}
+	if len(resp.Errors) > 0 {
+		fmt.Println("  Removed routing numbers are not flagged while lines are rejected")
+	}
+
+	if *verbose || resp.DryRun {
+		for _, change := range resp.Changes {
+			fmt.Printf("%-18s %s %-14s %s\n", changeName(change.Type), change.RoutingNumber, change.InstitutionCode, change.Detail)
+		}
+	}
+
+	if len(resp.Errors) > 0 {
+		os.Exit(1)
+	}
+}
+
+// parseFormat returns the directory format from the -format flag, leaving
+// it unspecified so the server detects it when the flag is not set
+func parseFormat(format string) (pb.RoutingDirectoryFormat, error) {
+	switch format {
+	case "":
+		return pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_UNSPECIFIED, nil
+	case "fedach":
+		return pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDACH, nil
+	case "fedwire":
+		return pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDWIRE, nil
+	default:
+		return 0, fmt.Errorf("unknown format %q: use -format fedach or -format fedwire", format)
+	}
+}
+
+// formatName returns the flag name of a directory format
+func formatName(format pb.RoutingDirectoryFormat) string {
+	return strings.ToLower(strings.TrimPrefix(format.String(), "ROUTING_DIRECTORY_FORMAT_"))
+}
+
+// changeName returns a change type without its enum prefix, e.g. FLAG_REMOVED
+func changeName(changeType pb.RoutingDirectoryChangeType) string {
+	return strings.TrimPrefix(changeType.String(), "ROUTING_DIRECTORY_CHANGE_TYPE_")
+}
@@ -0,0 +1,202 @@
+// Command iso4217-sync brings the Treasury Service currencies in line with
+// the ISO 4217 lists. It reads list one (current currencies) and
+// optionally list three (historic currencies), compares them with
+// CurrencyService.ListCurrencies and applies the differences through
+// BulkCreateCurrencies and UpdateCurrency.
+// Spec: docs/specs/007-iso4217-sync.md
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+	"github.com/jamestroutman/treasury-service/currency"
+)
+
+// listPageSize is the page size used to read the stored currencies
+const listPageSize = 200
+
+func main() {
+	addr := flag.String("addr", "localhost:50052", "Treasury Service address")
+	listThree := flag.String("list-three", "", "ISO 4217 list three XML of historic currencies, to deprecate withdrawn codes")
+	includeFunds := flag.Bool("include-funds", false, "Also create and update fund codes such as USN and CLF")
+	timeout := flag.Duration("timeout", 60*time.Second, "Sync timeout")
+	dryRun := flag.Bool("dry-run", false, "Print the plan without applying it")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: iso4217-sync [flags] <list-one.xml>\n\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	current, err := readList(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var historic *currency.ISOList
+	if *listThree != "" {
+		if historic, err = readList(*listThree); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to create treasury service client: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewCurrencyServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	existing, err := listCurrencies(ctx, client)
+	if err != nil {
+		log.Fatalf("Failed to list currencies: %v", err)
+	}
+
+	plan := currency.PlanISO4217Sync(current, historic, existing, *includeFunds)
+	printPlan(plan, current, historic, existing)
+	if *dryRun || plan.IsEmpty() {
+		return
+	}
+
+	if failed := applyPlan(ctx, client, plan); failed > 0 {
+		fmt.Printf("%d changes failed\n", failed)
+		os.Exit(1)
+	}
+	fmt.Println("Sync complete")
+}
+
+// readList parses an ISO 4217 XML file
+func readList(path string) (*currency.ISOList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	defer file.Close()
+
+	list, err := currency.ParseISO4217(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return list, nil
+}
+
+// listCurrencies reads every stored currency, page by page
+func listCurrencies(ctx context.Context, client pb.CurrencyServiceClient) ([]*pb.Currency, error) {
+	var currencies []*pb.Currency
+	pageToken := ""
+	for {
+		resp, err := client.ListCurrencies(ctx, &pb.ListCurrenciesRequest{
+			PageSize:       listPageSize,
+			PageToken:      pageToken,
+			SkipTotalCount: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		currencies = append(currencies, resp.Currencies...)
+		if resp.NextPageToken == "" {
+			return currencies, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// printPlan prints every change of the plan with the stored value it replaces
+func printPlan(plan *currency.SyncPlan, current, historic *currency.ISOList, existing []*pb.Currency) {
+	stored := map[string]*pb.Currency{}
+	for _, c := range existing {
+		stored[c.Code] = c
+	}
+	withdrawn := map[string]string{}
+	if historic != nil {
+		for _, c := range historic.Currencies {
+			withdrawn[c.Code] = c.WithdrawnOn
+		}
+	}
+
+	fmt.Printf("ISO 4217 list published %s, %d stored currencies\n", current.Published, len(existing))
+	fmt.Printf("Create: %d\n", len(plan.Creates))
+	for _, c := range plan.Creates {
+		fmt.Printf("  %s %s %q, %d minor units\n", c.Code, c.NumericCode, c.Name, c.MinorUnits)
+	}
+	fmt.Printf("Update: %d\n", len(plan.Updates))
+	for _, u := range plan.Updates {
+		changes := []string{}
+		for _, path := range u.UpdateMask.Paths {
+			switch path {
+			case "name":
+				changes = append(changes, fmt.Sprintf("name %q -> %q", stored[u.Code].Name, u.Name))
+			case "minor_units":
+				changes = append(changes, fmt.Sprintf("minor units %d -> %d", stored[u.Code].MinorUnits, u.MinorUnits))
+			}
+		}
+		fmt.Printf("  %s %s\n", u.Code, strings.Join(changes, ", "))
+	}
+	fmt.Printf("Deprecate: %d\n", len(plan.Deprecations))
+	for _, d := range plan.Deprecations {
+		fmt.Printf("  %s %q, withdrawn %s\n", d.Code, stored[d.Code].Name, withdrawn[d.Code])
+	}
+	if len(plan.Skipped) > 0 {
+		fmt.Printf("Skipped: %d\n", len(plan.Skipped))
+		for _, skipped := range plan.Skipped {
+			fmt.Printf("  %s\n", skipped)
+		}
+	}
+}
+
+// applyPlan applies the plan and returns the number of changes that failed.
+// Each update carries the version it was planned against, so a currency
+// changed since it was listed fails instead of being overwritten.
+func applyPlan(ctx context.Context, client pb.CurrencyServiceClient, plan *currency.SyncPlan) int {
+	failed := 0
+	if len(plan.Creates) > 0 {
+		resp, err := client.BulkCreateCurrencies(ctx, &pb.BulkCreateCurrenciesRequest{
+			Currencies:     plan.Creates,
+			SkipDuplicates: true,
+		})
+		if err != nil {
+			log.Printf("Create failed: %v", err)
+			failed += len(plan.Creates)
+		} else {
+			fmt.Printf("Created %d, skipped %d\n", resp.CreatedCount, resp.SkippedCount)
+			for _, rejected := range resp.Errors {
+				fmt.Printf("  %s\n", rejected)
+			}
+			failed += len(resp.Errors)
+		}
+	}
+
+	updated := applyUpdates(ctx, client, plan.Updates)
+	deprecated := applyUpdates(ctx, client, plan.Deprecations)
+	fmt.Printf("Updated %d, deprecated %d\n", updated, deprecated)
+	return failed + len(plan.Updates) - updated + len(plan.Deprecations) - deprecated
+}
+
+// applyUpdates sends each update and returns how many succeeded
+func applyUpdates(ctx context.Context, client pb.CurrencyServiceClient, updates []*pb.UpdateCurrencyRequest) int {
+	applied := 0
+	for _, update := range updates {
+		if _, err := client.UpdateCurrency(ctx, update); err != nil {
+			log.Printf("%s: %v", update.Code, err)
+			continue
+		}
+		applied++
+	}
+	return applied
+}
@@ -36,6 +36,22 @@ type Config struct {
 	// Features
 	EnabledFeatures []string `envconfig:"ENABLED_FEATURES" default:"base,manifest"`
 
+	// Secret used to sign list page tokens. Must be shared by all replicas.
+	// Spec: docs/specs/005-cursor-pagination.md#configuration
+	PageTokenSecret string `envconfig:"PAGE_TOKEN_SECRET"`
+
+	// How long an idempotency key is kept after its first request
+	// Spec: docs/specs/006-idempotency-keys.md#configuration
+	IdempotencyKeyTTLHours int `envconfig:"IDEMPOTENCY_KEY_TTL_HOURS" default:"24"`
+
+	// Currency that exchange rates are triangulated through
+	// Spec: docs/specs/005-exchange-rates.md#triangulation
+	ExchangeRatePivotCurrency string `envconfig:"EXCHANGE_RATE_PIVOT_CURRENCY" default:"USD"`
+
+	// Seconds between runs that apply due scheduled currency changes
+	// Spec: docs/specs/006-currency-history.md#applying-changes
+	CurrencyChangeIntervalSeconds int `envconfig:"CURRENCY_CHANGE_INTERVAL_SECONDS" default:"60"`
+
 	// Logging
 	LogLevel  string `envconfig:"LOG_LEVEL" default:"info"`
 	LogFormat string `envconfig:"LOG_FORMAT" default:"json"`
@@ -284,6 +300,18 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("service version is required")
 	}
 
+	if c.IdempotencyKeyTTLHours < 1 {
+		return fmt.Errorf("invalid idempotency key TTL: %d hours (must be at least 1)", c.IdempotencyKeyTTLHours)
+	}
+
+	if !isCurrencyCode(c.ExchangeRatePivotCurrency) {
+		return fmt.Errorf("invalid exchange rate pivot currency: %q (must be 3 uppercase letters)", c.ExchangeRatePivotCurrency)
+	}
+
+	if c.CurrencyChangeIntervalSeconds < 1 {
+		return fmt.Errorf("invalid currency change interval: %d seconds (must be at least 1)", c.CurrencyChangeIntervalSeconds)
+	}
+
 	validEnvironments := map[string]bool{
 		"dev":     true,
 		"staging": true,
@@ -360,4 +388,17 @@ func getEnvOrDefault(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+// isCurrencyCode reports whether code is three uppercase letters
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,597 @@
+package currency
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// maxAppliedChanges is the largest number of scheduled changes applied in
+// one ApplyDueChanges call
+const maxAppliedChanges = 100
+
+// historyColumns are the currency_history columns read by scanVersion
+const historyColumns = `currency_id, code, version, change_type, scheduled_change_id,
+	numeric_code, name, minor_units, symbol, symbol_position, country_codes,
+	is_active, is_crypto, status, valid_from, valid_to, changed_at, changed_by`
+
+// changeColumns are the currency_scheduled_changes columns read by scanChange
+const changeColumns = `id, code, effective_at, name, minor_units, symbol, status, reason,
+	created_at, created_by, applied_at, cancelled_at, cancelled_by`
+
+// pendingChangeFilter matches scheduled changes that are neither applied
+// nor cancelled
+const pendingChangeFilter = "applied_at IS NULL AND cancelled_at IS NULL"
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// GetCurrencyHistory returns every version of a currency, newest first,
+// and the changes scheduled for it, soonest first
+// Spec: docs/specs/006-currency-history.md#story-1-currency-history
+func (cm *Manager) GetCurrencyHistory(ctx context.Context, req *pb.GetCurrencyHistoryRequest) (*pb.GetCurrencyHistoryResponse, error) {
+	if !isoCodeRegex.MatchString(req.Code) {
+		return nil, status.Error(codes.InvalidArgument, "invalid ISO code format: must be 3 uppercase letters")
+	}
+
+	var currencyID string
+	err := cm.db.QueryRowContext(ctx,
+		"SELECT id FROM treasury.currencies WHERE code = $1", req.Code).Scan(&currencyID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "currency not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get currency: %v", err)
+	}
+
+	rows, err := cm.db.QueryContext(ctx, `
+		SELECT `+historyColumns+`
+		FROM treasury.currency_history
+		WHERE currency_id = $1
+		ORDER BY valid_from DESC, version DESC`, currencyID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get currency history: %v", err)
+	}
+	defer rows.Close()
+
+	resp := &pb.GetCurrencyHistoryResponse{}
+	for rows.Next() {
+		version, err := scanVersion(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan currency version: %v", err)
+		}
+		resp.Versions = append(resp.Versions, version)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating currency history: %v", err)
+	}
+
+	resp.PendingChanges, err = cm.pendingChanges(ctx, currencyID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ScheduleCurrencyChange records a change to a currency that is applied at
+// effective_at, such as a deprecation date or a redenomination
+// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+func (cm *Manager) ScheduleCurrencyChange(ctx context.Context, req *pb.ScheduleCurrencyChangeRequest) (*pb.CurrencyChange, error) {
+	if !isoCodeRegex.MatchString(req.Code) {
+		return nil, status.Error(codes.InvalidArgument, "invalid ISO code format: must be 3 uppercase letters")
+	}
+	if req.EffectiveAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "effective_at is required")
+	}
+	effectiveAt := req.EffectiveAt.AsTime()
+	if !effectiveAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "effective_at must be in the future")
+	}
+
+	change := &pb.CurrencyChange{
+		Code:        req.Code,
+		EffectiveAt: req.EffectiveAt,
+		UpdateMask:  &fieldmaskpb.FieldMask{},
+		Reason:      req.Reason,
+		CreatedBy:   req.CreatedBy,
+	}
+	if change.CreatedBy == "" {
+		change.CreatedBy = "system"
+	}
+
+	var name, symbol, statusStr sql.NullString
+	var minorUnits sql.NullInt32
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "name":
+			if req.Name == "" {
+				return nil, status.Error(codes.InvalidArgument, "name must not be empty")
+			}
+			name = sql.NullString{String: req.Name, Valid: true}
+			change.Name = req.Name
+		case "minor_units":
+			if req.MinorUnits < 0 || req.MinorUnits > 8 {
+				return nil, status.Error(codes.InvalidArgument, "minor_units must be between 0 and 8")
+			}
+			minorUnits = sql.NullInt32{Int32: req.MinorUnits, Valid: true}
+			change.MinorUnits = req.MinorUnits
+		case "symbol":
+			if req.Symbol == "" {
+				return nil, status.Error(codes.InvalidArgument, "symbol must not be empty")
+			}
+			symbol = sql.NullString{String: req.Symbol, Valid: true}
+			change.Symbol = req.Symbol
+		case "status":
+			switch req.Status {
+			case pb.CurrencyStatus_CURRENCY_STATUS_ACTIVE,
+				pb.CurrencyStatus_CURRENCY_STATUS_INACTIVE,
+				pb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED:
+			default:
+				return nil, status.Error(codes.InvalidArgument, "status must be active, inactive or deprecated")
+			}
+			statusStr = sql.NullString{String: mapStatusToString(req.Status), Valid: true}
+			change.Status = req.Status
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "field %q cannot be scheduled", path)
+		}
+		change.UpdateMask.Paths = append(change.UpdateMask.Paths, path)
+	}
+	if len(change.UpdateMask.Paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no fields to change")
+	}
+
+	var currencyID string
+	err := cm.db.QueryRowContext(ctx,
+		"SELECT id FROM treasury.currencies WHERE code = $1", req.Code).Scan(&currencyID)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "currency not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get currency: %v", err)
+	}
+
+	// Check for another change at the same time
+	var exists bool
+	err = cm.db.QueryRowContext(ctx, `
+		SELECT EXISTS(SELECT 1 FROM treasury.currency_scheduled_changes
+			WHERE currency_id = $1 AND effective_at = $2 AND cancelled_at IS NULL)`,
+		currencyID, effectiveAt).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check scheduled changes: %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "a change to %s is already scheduled at %s",
+			req.Code, effectiveAt.UTC().Format(time.RFC3339))
+	}
+
+	id := uuid.New()
+	var createdAt time.Time
+	err = cm.db.QueryRowContext(ctx, `
+		INSERT INTO treasury.currency_scheduled_changes (
+			id, currency_id, code, effective_at, name, minor_units, symbol, status,
+			reason, created_at, created_by
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8,
+			$9, CURRENT_TIMESTAMP, $10
+		) RETURNING created_at`,
+		id, currencyID, req.Code, effectiveAt, name, minorUnits, symbol, statusStr,
+		nullString(req.Reason), change.CreatedBy,
+	).Scan(&createdAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to schedule currency change: %v", err)
+	}
+
+	change.Id = id.String()
+	change.CreatedAt = timestamppb.New(createdAt)
+	return change, nil
+}
+
+// CancelCurrencyChange cancels a scheduled change that has not been applied
+// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+func (cm *Manager) CancelCurrencyChange(ctx context.Context, req *pb.CancelCurrencyChangeRequest) (*pb.CurrencyChange, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid change id")
+	}
+	cancelledBy := req.CancelledBy
+	if cancelledBy == "" {
+		cancelledBy = "system"
+	}
+
+	change, err := scanChange(cm.db.QueryRowContext(ctx, `
+		UPDATE treasury.currency_scheduled_changes
+		SET cancelled_at = CURRENT_TIMESTAMP, cancelled_by = $1
+		WHERE id = $2 AND `+pendingChangeFilter+`
+		RETURNING `+changeColumns,
+		cancelledBy, req.Id))
+	if err == nil {
+		return change, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to cancel currency change: %v", err)
+	}
+
+	// Tell a missing change apart from one that already happened
+	var appliedAt, cancelledAt sql.NullTime
+	err = cm.db.QueryRowContext(ctx,
+		"SELECT applied_at, cancelled_at FROM treasury.currency_scheduled_changes WHERE id = $1",
+		req.Id).Scan(&appliedAt, &cancelledAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, status.Error(codes.NotFound, "currency change not found")
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to get currency change: %v", err)
+	case appliedAt.Valid:
+		return nil, status.Error(codes.FailedPrecondition, "currency change has already been applied")
+	default:
+		return nil, status.Error(codes.FailedPrecondition, "currency change has already been cancelled")
+	}
+}
+
+// ApplyDueChanges applies scheduled changes whose effective time is at or
+// before now, oldest first, and returns how many were applied. Each
+// applied change produces a currency version starting at its effective
+// time.
+// Spec: docs/specs/006-currency-history.md#applying-changes
+func (cm *Manager) ApplyDueChanges(ctx context.Context, now time.Time) (int, error) {
+	tx, err := cm.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Another instance applying at the same time skips the locked rows
+	rows, err := tx.QueryContext(ctx, `
+		SELECT `+changeColumns+`
+		FROM treasury.currency_scheduled_changes
+		WHERE `+pendingChangeFilter+` AND effective_at <= $1
+		ORDER BY effective_at, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`, now, maxAppliedChanges)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load scheduled changes: %w", err)
+	}
+	var due []*pb.CurrencyChange
+	for rows.Next() {
+		change, err := scanChange(rows)
+		if err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan scheduled change: %w", err)
+		}
+		due = append(due, change)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating scheduled changes: %w", err)
+	}
+
+	for _, change := range due {
+		effectiveAt := change.EffectiveAt.AsTime()
+
+		// The history trigger reads these to date the new version
+		_, err := tx.ExecContext(ctx,
+			"SELECT set_config('treasury.scheduled_change_id', $1, true), set_config('treasury.change_effective_at', $2, true)",
+			change.Id, effectiveAt.UTC().Format(time.RFC3339Nano))
+		if err != nil {
+			return 0, fmt.Errorf("failed to apply change %s: %w", change.Id, err)
+		}
+
+		assignments, args := changeAssignments(change, effectiveAt)
+		args = append(args, change.CreatedBy, change.Code)
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+			UPDATE treasury.currencies
+			SET %s, updated_by = $%d, version = version + 1
+			WHERE code = $%d`,
+			strings.Join(assignments, ", "), len(args)-1, len(args)), args...)
+		if err != nil {
+			return 0, fmt.Errorf("failed to apply change %s: %w", change.Id, err)
+		}
+
+		_, err = tx.ExecContext(ctx,
+			"UPDATE treasury.currency_scheduled_changes SET applied_at = CURRENT_TIMESTAMP WHERE id = $1",
+			change.Id)
+		if err != nil {
+			return 0, fmt.Errorf("failed to mark change %s applied: %w", change.Id, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return len(due), nil
+}
+
+// RunScheduledChanges applies due changes every interval until ctx is done
+// Spec: docs/specs/006-currency-history.md#applying-changes
+func (cm *Manager) RunScheduledChanges(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		applied, err := cm.ApplyDueChanges(ctx, time.Now())
+		if err != nil {
+			log.Printf("Failed to apply scheduled currency changes: %v", err)
+		} else if applied > 0 {
+			log.Printf("Applied %d scheduled currency changes", applied)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// currencyAsOf returns the version of a currency that applied at asOf.
+// Pending changes due by asOf are applied on top of the current version,
+// so future dates show the currency as it is scheduled to be.
+// Spec: docs/specs/006-currency-history.md#point-in-time-lookup
+func (cm *Manager) currencyAsOf(ctx context.Context, current *pb.Currency, asOf time.Time) (*pb.Currency, error) {
+	version, err := scanVersion(cm.db.QueryRowContext(ctx, `
+		SELECT `+historyColumns+`
+		FROM treasury.currency_history
+		WHERE currency_id = $1 AND valid_from <= $2 AND (valid_to IS NULL OR valid_to > $2)
+		ORDER BY valid_from DESC, version DESC
+		LIMIT 1`, current.Id, asOf))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "currency %s did not exist at %s",
+			current.Code, asOf.UTC().Format(time.RFC3339))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get currency history: %v", err)
+	}
+
+	currency := version.Currency
+	currency.CreatedAt = current.CreatedAt
+	currency.CreatedBy = current.CreatedBy
+	if version.ValidTo != nil {
+		return currency, nil
+	}
+
+	pending, err := cm.pendingChanges(ctx, current.Id, &asOf)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range pending {
+		applyChange(currency, change)
+	}
+	return currency, nil
+}
+
+// pendingChanges returns the unapplied changes of a currency, soonest
+// first, optionally only those due by a time
+func (cm *Manager) pendingChanges(ctx context.Context, currencyID string, dueBy *time.Time) ([]*pb.CurrencyChange, error) {
+	query := `
+		SELECT ` + changeColumns + `
+		FROM treasury.currency_scheduled_changes
+		WHERE currency_id = $1 AND ` + pendingChangeFilter
+	args := []interface{}{currencyID}
+	if dueBy != nil {
+		query += " AND effective_at <= $2"
+		args = append(args, *dueBy)
+	}
+	query += " ORDER BY effective_at"
+
+	rows, err := cm.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get scheduled changes: %v", err)
+	}
+	defer rows.Close()
+
+	changes := []*pb.CurrencyChange{}
+	for rows.Next() {
+		change, err := scanChange(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan scheduled change: %v", err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating scheduled changes: %v", err)
+	}
+	return changes, nil
+}
+
+// scanVersion reads the historyColumns into a CurrencyVersion
+func scanVersion(row rowScanner) (*pb.CurrencyVersion, error) {
+	var (
+		currencyID        string
+		code              string
+		version           int32
+		changeType        string
+		scheduledChangeID sql.NullString
+		numericCode       sql.NullString
+		name              string
+		minorUnits        int32
+		symbol            sql.NullString
+		symbolPosition    sql.NullString
+		countryCodes      pq.StringArray
+		isActive          bool
+		isCrypto          bool
+		statusStr         string
+		validFrom         time.Time
+		validTo           sql.NullTime
+		changedAt         time.Time
+		changedBy         sql.NullString
+	)
+	err := row.Scan(
+		&currencyID, &code, &version, &changeType, &scheduledChangeID,
+		&numericCode, &name, &minorUnits, &symbol, &symbolPosition, &countryCodes,
+		&isActive, &isCrypto, &statusStr, &validFrom, &validTo, &changedAt, &changedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.CurrencyVersion{
+		Currency: &pb.Currency{
+			Id:             currencyID,
+			Code:           strings.TrimSpace(code),
+			NumericCode:    numericCode.String,
+			Name:           name,
+			MinorUnits:     minorUnits,
+			Symbol:         symbol.String,
+			SymbolPosition: symbolPosition.String,
+			CountryCodes:   countryCodes,
+			IsActive:       isActive,
+			IsCrypto:       isCrypto,
+			Status:         mapCurrencyStatus(statusStr),
+			UpdatedAt:      timestamppb.New(changedAt),
+			UpdatedBy:      changedBy.String,
+			Version:        version,
+		},
+		ChangeType:        mapChangeType(changeType),
+		ValidFrom:         timestamppb.New(validFrom),
+		ChangedAt:         timestamppb.New(changedAt),
+		ChangedBy:         changedBy.String,
+		ScheduledChangeId: scheduledChangeID.String,
+	}
+	if validTo.Valid {
+		result.ValidTo = timestamppb.New(validTo.Time)
+	}
+	return result, nil
+}
+
+// scanChange reads the changeColumns into a CurrencyChange. The update
+// mask lists the fields the change sets.
+func scanChange(row rowScanner) (*pb.CurrencyChange, error) {
+	var (
+		id          string
+		code        string
+		effectiveAt time.Time
+		name        sql.NullString
+		minorUnits  sql.NullInt32
+		symbol      sql.NullString
+		statusStr   sql.NullString
+		reason      sql.NullString
+		createdAt   time.Time
+		createdBy   sql.NullString
+		appliedAt   sql.NullTime
+		cancelledAt sql.NullTime
+		cancelledBy sql.NullString
+	)
+	err := row.Scan(
+		&id, &code, &effectiveAt, &name, &minorUnits, &symbol, &statusStr, &reason,
+		&createdAt, &createdBy, &appliedAt, &cancelledAt, &cancelledBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &pb.CurrencyChange{
+		Id:          id,
+		Code:        strings.TrimSpace(code),
+		EffectiveAt: timestamppb.New(effectiveAt),
+		UpdateMask:  &fieldmaskpb.FieldMask{},
+		Reason:      reason.String,
+		CreatedAt:   timestamppb.New(createdAt),
+		CreatedBy:   createdBy.String,
+		CancelledBy: cancelledBy.String,
+	}
+	if name.Valid {
+		change.UpdateMask.Paths = append(change.UpdateMask.Paths, "name")
+		change.Name = name.String
+	}
+	if minorUnits.Valid {
+		change.UpdateMask.Paths = append(change.UpdateMask.Paths, "minor_units")
+		change.MinorUnits = minorUnits.Int32
+	}
+	if symbol.Valid {
+		change.UpdateMask.Paths = append(change.UpdateMask.Paths, "symbol")
+		change.Symbol = symbol.String
+	}
+	if statusStr.Valid {
+		change.UpdateMask.Paths = append(change.UpdateMask.Paths, "status")
+		change.Status = mapCurrencyStatus(statusStr.String)
+	}
+	if appliedAt.Valid {
+		change.AppliedAt = timestamppb.New(appliedAt.Time)
+	}
+	if cancelledAt.Valid {
+		change.CancelledAt = timestamppb.New(cancelledAt.Time)
+	}
+	return change, nil
+}
+
+// changeAssignments builds the SET assignments and arguments that apply a
+// scheduled change to treasury.currencies
+func changeAssignments(change *pb.CurrencyChange, effectiveAt time.Time) ([]string, []interface{}) {
+	assignments := []string{}
+	args := []interface{}{}
+	for _, path := range change.UpdateMask.GetPaths() {
+		switch path {
+		case "name":
+			args = append(args, change.Name)
+			assignments = append(assignments, fmt.Sprintf("name = $%d", len(args)))
+		case "minor_units":
+			args = append(args, change.MinorUnits)
+			assignments = append(assignments, fmt.Sprintf("minor_units = $%d", len(args)))
+		case "symbol":
+			args = append(args, change.Symbol)
+			assignments = append(assignments, fmt.Sprintf("symbol = $%d", len(args)))
+		case "status":
+			active := change.Status == pb.CurrencyStatus_CURRENCY_STATUS_ACTIVE
+			args = append(args, mapStatusToString(change.Status), active, effectiveAt)
+			assignments = append(assignments,
+				fmt.Sprintf("status = $%d", len(args)-2),
+				fmt.Sprintf("is_active = $%d", len(args)-1))
+			if active {
+				assignments = append(assignments, fmt.Sprintf("activated_at = $%d", len(args)))
+			} else {
+				assignments = append(assignments, fmt.Sprintf("deactivated_at = $%d", len(args)))
+			}
+		}
+	}
+	return assignments, args
+}
+
+// applyChange sets the fields of a scheduled change on a currency
+func applyChange(currency *pb.Currency, change *pb.CurrencyChange) {
+	for _, path := range change.UpdateMask.GetPaths() {
+		switch path {
+		case "name":
+			currency.Name = change.Name
+		case "minor_units":
+			currency.MinorUnits = change.MinorUnits
+		case "symbol":
+			currency.Symbol = change.Symbol
+		case "status":
+			currency.Status = change.Status
+			currency.IsActive = change.Status == pb.CurrencyStatus_CURRENCY_STATUS_ACTIVE
+			if currency.IsActive {
+				currency.ActivatedAt = change.EffectiveAt
+			} else {
+				currency.DeactivatedAt = change.EffectiveAt
+			}
+		}
+	}
+}
+
+// mapChangeType converts a currency_history change_type to its enum
+func mapChangeType(changeType string) pb.CurrencyChangeType {
+	switch changeType {
+	case "created":
+		return pb.CurrencyChangeType_CURRENCY_CHANGE_TYPE_CREATED
+	case "updated":
+		return pb.CurrencyChangeType_CURRENCY_CHANGE_TYPE_UPDATED
+	case "deactivated":
+		return pb.CurrencyChangeType_CURRENCY_CHANGE_TYPE_DEACTIVATED
+	case "scheduled":
+		return pb.CurrencyChangeType_CURRENCY_CHANGE_TYPE_SCHEDULED
+	default:
+		return pb.CurrencyChangeType_CURRENCY_CHANGE_TYPE_UNSPECIFIED
+	}
+}
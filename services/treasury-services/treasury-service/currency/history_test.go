@@ -0,0 +1,365 @@
+package currency
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+const (
+	testCurrencyID = "3f1e6a52-8c1d-4d0e-9a57-1b7a3e2f9c10"
+	testChangeID   = "8d2c4b1e-5f6a-4b3c-9d8e-7f6a5b4c3d2e"
+)
+
+// versionColumns are the columns returned by currency history queries
+var versionColumns = []string{
+	"currency_id", "code", "version", "change_type", "scheduled_change_id",
+	"numeric_code", "name", "minor_units", "symbol", "symbol_position", "country_codes",
+	"is_active", "is_crypto", "status", "valid_from", "valid_to", "changed_at", "changed_by",
+}
+
+// scheduledChangeColumns are the columns returned by scheduled change queries
+var scheduledChangeColumns = []string{
+	"id", "code", "effective_at", "name", "minor_units", "symbol", "status", "reason",
+	"created_at", "created_by", "applied_at", "cancelled_at", "cancelled_by",
+}
+
+// TestGetCurrencyHistory tests listing versions and pending changes
+// Spec: docs/specs/006-currency-history.md#story-1-currency-history
+func TestGetCurrencyHistory(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	renamed := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	redenominate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT id FROM treasury.currencies WHERE code").
+		WithArgs("HRK").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testCurrencyID))
+	mock.ExpectQuery("FROM treasury.currency_history").
+		WithArgs(testCurrencyID).
+		WillReturnRows(sqlmock.NewRows(versionColumns).
+			AddRow(testCurrencyID, "HRK", 2, "updated", nil,
+				"191", "Croatian Kuna", 2, "kn", "after", pq.StringArray{"HR"},
+				true, false, "active", renamed, nil, renamed, "admin").
+			AddRow(testCurrencyID, "HRK", 1, "created", nil,
+				"191", "Kuna", 2, "kn", "after", pq.StringArray{"HR"},
+				true, false, "active", created, renamed, created, "system"))
+	mock.ExpectQuery("FROM treasury.currency_scheduled_changes").
+		WithArgs(testCurrencyID).
+		WillReturnRows(sqlmock.NewRows(scheduledChangeColumns).
+			AddRow(testChangeID, "HRK", redenominate, nil, nil, nil, "deprecated", "Replaced by EUR",
+				renamed, "admin", nil, nil, nil))
+
+	manager := NewManager(db, testCursors)
+	resp, err := manager.GetCurrencyHistory(context.Background(), &pb.GetCurrencyHistoryRequest{Code: "HRK"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Versions, 2)
+	assert.Equal(t, "Croatian Kuna", resp.Versions[0].Currency.Name)
+	assert.Equal(t, pb.CurrencyChangeType_CURRENCY_CHANGE_TYPE_UPDATED, resp.Versions[0].ChangeType)
+	assert.Nil(t, resp.Versions[0].ValidTo)
+	assert.Equal(t, renamed, resp.Versions[1].ValidTo.AsTime())
+	require.Len(t, resp.PendingChanges, 1)
+	assert.Equal(t, []string{"status"}, resp.PendingChanges[0].UpdateMask.Paths)
+	assert.Equal(t, pb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED, resp.PendingChanges[0].Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestScheduleCurrencyChange tests scheduling a future change
+// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+func TestScheduleCurrencyChange(t *testing.T) {
+	effectiveAt := time.Now().UTC().Add(30 * 24 * time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name      string
+		request   *pb.ScheduleCurrencyChangeRequest
+		setupMock func(sqlmock.Sqlmock)
+		errCode   codes.Code
+		errMsg    string
+	}{
+		{
+			name: "redenomination",
+			request: &pb.ScheduleCurrencyChangeRequest{
+				Code:        "ISK",
+				EffectiveAt: timestamppb.New(effectiveAt),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"minor_units"}},
+				MinorUnits:  0,
+				Reason:      "ISO 4217 amendment",
+				CreatedBy:   "admin",
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id FROM treasury.currencies WHERE code").
+					WithArgs("ISK").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testCurrencyID))
+				mock.ExpectQuery("SELECT EXISTS").
+					WithArgs(testCurrencyID, effectiveAt).
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+				mock.ExpectQuery("INSERT INTO treasury.currency_scheduled_changes").
+					WithArgs(sqlmock.AnyArg(), testCurrencyID, "ISK", effectiveAt,
+						sql.NullString{}, sql.NullInt32{Int32: 0, Valid: true}, sql.NullString{}, sql.NullString{},
+						sql.NullString{String: "ISO 4217 amendment", Valid: true}, "admin").
+					WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+			},
+		},
+		{
+			name: "change already scheduled",
+			request: &pb.ScheduleCurrencyChangeRequest{
+				Code:        "ISK",
+				EffectiveAt: timestamppb.New(effectiveAt),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"symbol"}},
+				Symbol:      "kr",
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id FROM treasury.currencies WHERE code").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testCurrencyID))
+				mock.ExpectQuery("SELECT EXISTS").
+					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+			},
+			errCode: codes.AlreadyExists,
+			errMsg:  "already scheduled",
+		},
+		{
+			name: "unknown currency",
+			request: &pb.ScheduleCurrencyChangeRequest{
+				Code:        "XXX",
+				EffectiveAt: timestamppb.New(effectiveAt),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+				Name:        "Unknown",
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id FROM treasury.currencies WHERE code").
+					WillReturnError(sql.ErrNoRows)
+			},
+			errCode: codes.NotFound,
+			errMsg:  "currency not found",
+		},
+		{
+			name: "effective time in the past",
+			request: &pb.ScheduleCurrencyChangeRequest{
+				Code:        "ISK",
+				EffectiveAt: timestamppb.New(time.Now().Add(-time.Hour)),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+				Name:        "Krona",
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "effective_at must be in the future",
+		},
+		{
+			name: "deleted status",
+			request: &pb.ScheduleCurrencyChangeRequest{
+				Code:        "ISK",
+				EffectiveAt: timestamppb.New(effectiveAt),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+				Status:      pb.CurrencyStatus_CURRENCY_STATUS_DELETED,
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "status must be active, inactive or deprecated",
+		},
+		{
+			name: "unsupported field",
+			request: &pb.ScheduleCurrencyChangeRequest{
+				Code:        "ISK",
+				EffectiveAt: timestamppb.New(effectiveAt),
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"country_codes"}},
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  `field "country_codes" cannot be scheduled`,
+		},
+		{
+			name: "no fields",
+			request: &pb.ScheduleCurrencyChangeRequest{
+				Code:        "ISK",
+				EffectiveAt: timestamppb.New(effectiveAt),
+			},
+			errCode: codes.InvalidArgument,
+			errMsg:  "no fields to change",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			manager := NewManager(db, testCursors)
+			change, err := manager.ScheduleCurrencyChange(context.Background(), tt.request)
+
+			if tt.errCode != codes.OK {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.errCode, st.Code())
+				assert.Contains(t, st.Message(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+				assert.NotEmpty(t, change.Id)
+				assert.Equal(t, []string{"minor_units"}, change.UpdateMask.Paths)
+				assert.Equal(t, "admin", change.CreatedBy)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestCancelCurrencyChange tests cancelling pending and applied changes
+// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+func TestCancelCurrencyChange(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery("UPDATE treasury.currency_scheduled_changes").
+		WithArgs("admin", testChangeID).
+		WillReturnRows(sqlmock.NewRows(scheduledChangeColumns).
+			AddRow(testChangeID, "ISK", now.Add(time.Hour), nil, 0, nil, nil, nil,
+				now, "admin", nil, now, "admin"))
+	mock.ExpectQuery("UPDATE treasury.currency_scheduled_changes").
+		WithArgs("system", testChangeID).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT applied_at, cancelled_at").
+		WithArgs(testChangeID).
+		WillReturnRows(sqlmock.NewRows([]string{"applied_at", "cancelled_at"}).AddRow(now, nil))
+
+	manager := NewManager(db, testCursors)
+	change, err := manager.CancelCurrencyChange(context.Background(),
+		&pb.CancelCurrencyChangeRequest{Id: testChangeID, CancelledBy: "admin"})
+	require.NoError(t, err)
+	assert.NotNil(t, change.CancelledAt)
+
+	_, err = manager.CancelCurrencyChange(context.Background(), &pb.CancelCurrencyChangeRequest{Id: testChangeID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = manager.CancelCurrencyChange(context.Background(), &pb.CancelCurrencyChangeRequest{Id: "not-a-uuid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestApplyDueChanges tests applying a due change to the currency
+// Spec: docs/specs/006-currency-history.md#applying-changes
+func TestApplyDueChanges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Date(2026, 1, 1, 0, 5, 0, 0, time.UTC)
+	effectiveAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+		WithArgs(now, maxAppliedChanges).
+		WillReturnRows(sqlmock.NewRows(scheduledChangeColumns).
+			AddRow(testChangeID, "HRK", effectiveAt, nil, 0, nil, "deprecated", nil,
+				now, "admin", nil, nil, nil))
+	mock.ExpectExec("set_config").
+		WithArgs(testChangeID, "2026-01-01T00:00:00Z").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE treasury.currencies\s+SET minor_units = \$1, status = \$2, is_active = \$3, deactivated_at = \$4, updated_by = \$5, version = version \+ 1\s+WHERE code = \$6`).
+		WithArgs(int32(0), "deprecated", false, effectiveAt, "admin", "HRK").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE treasury.currency_scheduled_changes SET applied_at").
+		WithArgs(testChangeID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	manager := NewManager(db, testCursors)
+	applied, err := manager.ApplyDueChanges(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, applied)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetCurrencyAsOf tests point in time lookups
+// Spec: docs/specs/006-currency-history.md#point-in-time-lookup
+func TestGetCurrencyAsOf(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	redenominated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	currentRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows(currencyColumns).AddRow(
+			testCurrencyID, "ISK", "352", "Icelandic Krona", 2, "kr", "after",
+			pq.StringArray{"IS"}, true, false, "active", created, nil,
+			created, created, "system", "system", 1)
+	}
+	versionRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows(versionColumns).AddRow(
+			testCurrencyID, "ISK", 1, "created", nil,
+			"352", "Icelandic Krona", 2, "kr", "after", pq.StringArray{"IS"},
+			true, false, "active", created, nil, created, "system")
+	}
+
+	t.Run("future date applies scheduled changes", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		asOf := redenominated.Add(24 * time.Hour)
+		mock.ExpectQuery("SELECT (.+) FROM treasury.currencies WHERE code").
+			WithArgs("ISK").
+			WillReturnRows(currentRow())
+		mock.ExpectQuery("FROM treasury.currency_history").
+			WithArgs(testCurrencyID, asOf).
+			WillReturnRows(versionRow())
+		mock.ExpectQuery("FROM treasury.currency_scheduled_changes").
+			WithArgs(testCurrencyID, asOf).
+			WillReturnRows(sqlmock.NewRows(scheduledChangeColumns).
+				AddRow(testChangeID, "ISK", redenominated, nil, 0, nil, nil, nil,
+					created, "admin", nil, nil, nil))
+
+		manager := NewManager(db, testCursors)
+		currency, err := manager.GetCurrency(context.Background(), &pb.GetCurrencyRequest{
+			Identifier: &pb.GetCurrencyRequest_Code{Code: "ISK"},
+			AsOf:       timestamppb.New(asOf),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), currency.MinorUnits)
+		assert.Equal(t, "kr", currency.Symbol)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("before the currency existed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		asOf := created.Add(-24 * time.Hour)
+		mock.ExpectQuery("SELECT (.+) FROM treasury.currencies WHERE code").
+			WillReturnRows(currentRow())
+		mock.ExpectQuery("FROM treasury.currency_history").
+			WithArgs(testCurrencyID, asOf).
+			WillReturnError(sql.ErrNoRows)
+
+		manager := NewManager(db, testCursors)
+		_, err = manager.GetCurrency(context.Background(), &pb.GetCurrencyRequest{
+			Identifier: &pb.GetCurrencyRequest_Code{Code: "ISK"},
+			AsOf:       timestamppb.New(asOf),
+		})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.Equal(t, "currency ISK did not exist at 2023-12-31T00:00:00Z", st.Message())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
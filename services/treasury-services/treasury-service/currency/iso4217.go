@@ -0,0 +1,206 @@
+package currency
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// ISOList is an ISO 4217 list published by the maintenance agency. List
+// one holds current currencies and list three historic ones.
+// Spec: docs/specs/007-iso4217-sync.md#iso-4217-files
+type ISOList struct {
+	Published  string
+	Currencies []*ISOCurrency // One per code, sorted by code
+}
+
+// ISOCurrency is one currency code of an ISO 4217 list, merged across the
+// countries that use it
+type ISOCurrency struct {
+	Code          string
+	NumericCode   string
+	Name          string
+	MinorUnits    int32
+	HasMinorUnits bool     // False for "N.A." entries such as XAU
+	IsFund        bool     // Fund codes such as USN and CLF
+	Countries     []string // Country names as published
+	WithdrawnOn   string   // List three only, e.g. "2023-01"
+}
+
+// isoDocument is the layout shared by list one and list three:
+// <ISO_4217 Pblshd="..."><CcyTbl><CcyNtry>...</CcyNtry></CcyTbl></ISO_4217>
+type isoDocument struct {
+	Published string     `xml:"Pblshd,attr"`
+	Current   []isoEntry `xml:"CcyTbl>CcyNtry"`
+	Historic  []isoEntry `xml:"HstrcCcyTbl>HstrcCcyNtry"`
+}
+
+type isoEntry struct {
+	Country string `xml:"CtryNm"`
+	Name    struct {
+		Value  string `xml:",chardata"`
+		IsFund bool   `xml:"IsFund,attr"`
+	} `xml:"CcyNm"`
+	Code        string `xml:"Ccy"`
+	Number      string `xml:"CcyNbr"`
+	MinorUnits  string `xml:"CcyMnrUnts"`
+	WithdrawnOn string `xml:"WthdrwlDt"`
+}
+
+// ParseISO4217 reads an ISO 4217 list one or list three XML file. Entries
+// without a currency, such as ANTARCTICA, are dropped and the entries of
+// a code are merged into one currency.
+// Spec: docs/specs/007-iso4217-sync.md#iso-4217-files
+func ParseISO4217(r io.Reader) (*ISOList, error) {
+	var doc isoDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid ISO 4217 XML: %w", err)
+	}
+	entries := append(doc.Current, doc.Historic...)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("invalid ISO 4217 XML: no CcyNtry or HstrcCcyNtry elements")
+	}
+
+	byCode := map[string]*ISOCurrency{}
+	for _, entry := range entries {
+		code := strings.TrimSpace(entry.Code)
+		if code == "" {
+			continue
+		}
+		if !isoCodeRegex.MatchString(code) {
+			return nil, fmt.Errorf("invalid ISO 4217 XML: invalid currency code %q", code)
+		}
+
+		currency, ok := byCode[code]
+		if !ok {
+			currency = &ISOCurrency{
+				Code:        code,
+				NumericCode: strings.TrimSpace(entry.Number),
+				Name:        strings.TrimSpace(entry.Name.Value),
+				IsFund:      entry.Name.IsFund,
+			}
+			if units, err := strconv.Atoi(strings.TrimSpace(entry.MinorUnits)); err == nil {
+				currency.MinorUnits = int32(units)
+				currency.HasMinorUnits = true
+			}
+			byCode[code] = currency
+		}
+		if country := strings.TrimSpace(entry.Country); country != "" {
+			currency.Countries = append(currency.Countries, country)
+		}
+		// Keep the latest withdrawal of a code withdrawn in several countries
+		if withdrawn := strings.TrimSpace(entry.WithdrawnOn); withdrawn > currency.WithdrawnOn {
+			currency.WithdrawnOn = withdrawn
+		}
+	}
+
+	list := &ISOList{Published: doc.Published}
+	for _, currency := range byCode {
+		list.Currencies = append(list.Currencies, currency)
+	}
+	sort.Slice(list.Currencies, func(i, j int) bool {
+		return list.Currencies[i].Code < list.Currencies[j].Code
+	})
+	return list, nil
+}
+
+// SyncPlan is the set of changes that brings treasury.currencies in line
+// with ISO 4217
+// Spec: docs/specs/007-iso4217-sync.md#sync-plan
+type SyncPlan struct {
+	Creates      []*pb.CreateCurrencyRequest
+	Updates      []*pb.UpdateCurrencyRequest
+	Deprecations []*pb.UpdateCurrencyRequest
+	Skipped      []string // ISO currencies left out, with the reason
+}
+
+// IsEmpty reports whether the plan changes nothing
+func (p *SyncPlan) IsEmpty() bool {
+	return len(p.Creates) == 0 && len(p.Updates) == 0 && len(p.Deprecations) == 0
+}
+
+// PlanISO4217Sync compares the current and historic ISO 4217 lists with
+// the stored currencies. Current codes that are missing are created and
+// those whose name or minor units differ are updated. Stored codes that
+// appear only in the historic list are deprecated. Cryptocurrencies and
+// codes in neither list are left alone. historic may be nil.
+// Spec: docs/specs/007-iso4217-sync.md#sync-plan
+func PlanISO4217Sync(current, historic *ISOList, existing []*pb.Currency, includeFunds bool) *SyncPlan {
+	stored := map[string]*pb.Currency{}
+	for _, currency := range existing {
+		stored[currency.Code] = currency
+	}
+
+	plan := &SyncPlan{}
+	listed := map[string]bool{}
+	for _, iso := range current.Currencies {
+		listed[iso.Code] = true
+		if iso.IsFund && !includeFunds {
+			plan.Skipped = append(plan.Skipped, fmt.Sprintf("%s: fund code", iso.Code))
+			continue
+		}
+		if !iso.HasMinorUnits {
+			plan.Skipped = append(plan.Skipped, fmt.Sprintf("%s: no minor units", iso.Code))
+			continue
+		}
+
+		currency, ok := stored[iso.Code]
+		if !ok {
+			plan.Creates = append(plan.Creates, &pb.CreateCurrencyRequest{
+				Code:        iso.Code,
+				NumericCode: iso.NumericCode,
+				Name:        iso.Name,
+				MinorUnits:  iso.MinorUnits,
+			})
+			continue
+		}
+		if currency.IsCrypto {
+			continue
+		}
+
+		update := &pb.UpdateCurrencyRequest{
+			Code:       iso.Code,
+			UpdateMask: &fieldmaskpb.FieldMask{},
+			Version:    currency.Version,
+		}
+		if currency.Name != iso.Name {
+			update.UpdateMask.Paths = append(update.UpdateMask.Paths, "name")
+			update.Name = iso.Name
+		}
+		if currency.MinorUnits != iso.MinorUnits {
+			update.UpdateMask.Paths = append(update.UpdateMask.Paths, "minor_units")
+			update.MinorUnits = iso.MinorUnits
+		}
+		if len(update.UpdateMask.Paths) > 0 {
+			plan.Updates = append(plan.Updates, update)
+		}
+	}
+
+	if historic == nil {
+		return plan
+	}
+	for _, iso := range historic.Currencies {
+		currency, ok := stored[iso.Code]
+		if !ok || listed[iso.Code] || currency.IsCrypto {
+			continue
+		}
+		switch currency.Status {
+		case pb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED, pb.CurrencyStatus_CURRENCY_STATUS_DELETED:
+			continue
+		}
+		plan.Deprecations = append(plan.Deprecations, &pb.UpdateCurrencyRequest{
+			Code:       iso.Code,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+			Status:     pb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED,
+			Version:    currency.Version,
+		})
+	}
+	return plan
+}
@@ -0,0 +1,111 @@
+package currency
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// isoListOne is an excerpt of the ISO 4217 list one file
+const isoListOne = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<ISO_4217 Pblshd="2024-06-25">
+	<CcyTbl>
+		<CcyNtry><CtryNm>ANTARCTICA</CtryNm><CcyNm>No universal currency</CcyNm></CcyNtry>
+		<CcyNtry><CtryNm>ICELAND</CtryNm><CcyNm>Iceland Krona</CcyNm><Ccy>ISK</Ccy><CcyNbr>352</CcyNbr><CcyMnrUnts>0</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>UNITED STATES OF AMERICA (THE)</CtryNm><CcyNm>US Dollar</CcyNm><Ccy>USD</Ccy><CcyNbr>840</CcyNbr><CcyMnrUnts>2</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>ECUADOR</CtryNm><CcyNm>US Dollar</CcyNm><Ccy>USD</Ccy><CcyNbr>840</CcyNbr><CcyMnrUnts>2</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>UNITED STATES OF AMERICA (THE)</CtryNm><CcyNm IsFund="true">US Dollar (Next day)</CcyNm><Ccy>USN</Ccy><CcyNbr>997</CcyNbr><CcyMnrUnts>2</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>ZZ08_Gold</CtryNm><CcyNm>Gold</CcyNm><Ccy>XAU</Ccy><CcyNbr>959</CcyNbr><CcyMnrUnts>N.A.</CcyMnrUnts></CcyNtry>
+		<CcyNtry><CtryNm>BAHRAIN</CtryNm><CcyNm>Bahraini Dinar</CcyNm><Ccy>BHD</Ccy><CcyNbr>048</CcyNbr><CcyMnrUnts>3</CcyMnrUnts></CcyNtry>
+	</CcyTbl>
+</ISO_4217>`
+
+// isoListThree is an excerpt of the ISO 4217 list three file
+const isoListThree = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<ISO_4217 Pblshd="2024-06-25">
+	<HstrcCcyTbl>
+		<HstrcCcyNtry><CtryNm>CROATIA</CtryNm><CcyNm>Kuna</CcyNm><Ccy>HRK</Ccy><CcyNbr>191</CcyNbr><WthdrwlDt>2015-06</WthdrwlDt></HstrcCcyNtry>
+		<HstrcCcyNtry><CtryNm>CROATIA</CtryNm><CcyNm>Kuna</CcyNm><Ccy>HRK</Ccy><CcyNbr>191</CcyNbr><WthdrwlDt>2023-01</WthdrwlDt></HstrcCcyNtry>
+		<HstrcCcyNtry><CtryNm>ICELAND</CtryNm><CcyNm>Old Krona</CcyNm><Ccy>ISJ</Ccy><CcyNbr>352</CcyNbr><WthdrwlDt>1989 to 1990</WthdrwlDt></HstrcCcyNtry>
+		<HstrcCcyNtry><CtryNm>SIERRA LEONE</CtryNm><CcyNm>Leone</CcyNm><Ccy>SLL</Ccy><CcyNbr>694</CcyNbr><WthdrwlDt>2023-12</WthdrwlDt></HstrcCcyNtry>
+	</HstrcCcyTbl>
+</ISO_4217>`
+
+// TestParseISO4217 tests reading list one and list three
+// Spec: docs/specs/007-iso4217-sync.md#iso-4217-files
+func TestParseISO4217(t *testing.T) {
+	current, err := ParseISO4217(strings.NewReader(isoListOne))
+	require.NoError(t, err)
+	assert.Equal(t, "2024-06-25", current.Published)
+	require.Len(t, current.Currencies, 5)
+
+	codes := []string{}
+	for _, c := range current.Currencies {
+		codes = append(codes, c.Code)
+	}
+	assert.Equal(t, []string{"BHD", "ISK", "USD", "USN", "XAU"}, codes)
+	assert.Equal(t, &ISOCurrency{
+		Code:          "USD",
+		NumericCode:   "840",
+		Name:          "US Dollar",
+		MinorUnits:    2,
+		HasMinorUnits: true,
+		Countries:     []string{"UNITED STATES OF AMERICA (THE)", "ECUADOR"},
+	}, current.Currencies[2])
+	assert.True(t, current.Currencies[3].IsFund)
+	assert.False(t, current.Currencies[4].HasMinorUnits)
+
+	historic, err := ParseISO4217(strings.NewReader(isoListThree))
+	require.NoError(t, err)
+	require.Len(t, historic.Currencies, 3)
+	assert.Equal(t, "2023-01", historic.Currencies[0].WithdrawnOn)
+
+	_, err = ParseISO4217(strings.NewReader("<ISO_4217></ISO_4217>"))
+	assert.EqualError(t, err, "invalid ISO 4217 XML: no CcyNtry or HstrcCcyNtry elements")
+}
+
+// TestPlanISO4217Sync tests diffing the lists against stored currencies
+// Spec: docs/specs/007-iso4217-sync.md#sync-plan
+func TestPlanISO4217Sync(t *testing.T) {
+	current, err := ParseISO4217(strings.NewReader(isoListOne))
+	require.NoError(t, err)
+	historic, err := ParseISO4217(strings.NewReader(isoListThree))
+	require.NoError(t, err)
+
+	existing := []*pb.Currency{
+		{Code: "USD", Name: "United States Dollar", MinorUnits: 2, Version: 3, Status: pb.CurrencyStatus_CURRENCY_STATUS_ACTIVE},
+		{Code: "ISK", Name: "Iceland Krona", MinorUnits: 2, Version: 1, Status: pb.CurrencyStatus_CURRENCY_STATUS_ACTIVE},
+		{Code: "HRK", Name: "Croatian Kuna", MinorUnits: 2, Version: 5, Status: pb.CurrencyStatus_CURRENCY_STATUS_ACTIVE},
+		{Code: "SLL", Name: "Leone", MinorUnits: 2, Version: 2, Status: pb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED},
+		{Code: "BTC", Name: "Bitcoin", MinorUnits: 8, Version: 1, IsCrypto: true, Status: pb.CurrencyStatus_CURRENCY_STATUS_ACTIVE},
+	}
+
+	plan := PlanISO4217Sync(current, historic, existing, false)
+
+	require.Len(t, plan.Creates, 1)
+	assert.Equal(t, &pb.CreateCurrencyRequest{Code: "BHD", NumericCode: "048", Name: "Bahraini Dinar", MinorUnits: 3}, plan.Creates[0])
+
+	require.Len(t, plan.Updates, 2)
+	assert.Equal(t, "ISK", plan.Updates[0].Code)
+	assert.Equal(t, []string{"minor_units"}, plan.Updates[0].UpdateMask.Paths)
+	assert.Equal(t, int32(0), plan.Updates[0].MinorUnits)
+	assert.Equal(t, "USD", plan.Updates[1].Code)
+	assert.Equal(t, []string{"name"}, plan.Updates[1].UpdateMask.Paths)
+	assert.Equal(t, int32(3), plan.Updates[1].Version)
+
+	require.Len(t, plan.Deprecations, 1)
+	assert.Equal(t, "HRK", plan.Deprecations[0].Code)
+	assert.Equal(t, pb.CurrencyStatus_CURRENCY_STATUS_DEPRECATED, plan.Deprecations[0].Status)
+	assert.Equal(t, int32(5), plan.Deprecations[0].Version)
+
+	assert.Equal(t, []string{"USN: fund code", "XAU: no minor units"}, plan.Skipped)
+
+	withFunds := PlanISO4217Sync(current, nil, existing, true)
+	assert.Len(t, withFunds.Creates, 2)
+	assert.Empty(t, withFunds.Deprecations)
+	assert.False(t, withFunds.IsEmpty())
+}
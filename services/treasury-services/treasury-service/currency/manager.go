@@ -14,23 +14,29 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"example.com/go-mono-repo/common/pagination"
 	pb "example.com/go-mono-repo/proto/treasury"
 )
 
 // Manager handles currency database operations
 // Spec: docs/specs/003-currency-management.md
 type Manager struct {
-	db *sql.DB
+	db      *sql.DB
+	cursors *pagination.Codec
 }
 
 // NewManager creates a new currency manager instance
 // Spec: docs/specs/003-currency-management.md
-func NewManager(db *sql.DB) *Manager {
+func NewManager(db *sql.DB, cursors *pagination.Codec) *Manager {
 	return &Manager{
-		db: db,
+		db:      db,
+		cursors: cursors,
 	}
 }
 
+// currenciesCursorScope binds ListCurrencies page tokens to that RPC
+const currenciesCursorScope = "treasury.currencies"
+
 var (
 	// ISO 4217 code validation regex (3 uppercase letters)
 	isoCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
@@ -113,7 +119,8 @@ func (cm *Manager) CreateCurrency(ctx context.Context, req *pb.CreateCurrencyReq
 	}, nil
 }
 
-// GetCurrency retrieves a currency by code, numeric code, or ID
+// GetCurrency retrieves a currency by code, numeric code, or ID. With
+// as_of set it returns the version that applied at that time.
 // Spec: docs/specs/003-currency-management.md#story-2-query-currency-information
 func (cm *Manager) GetCurrency(ctx context.Context, req *pb.GetCurrencyRequest) (*pb.Currency, error) {
 	var query string
@@ -212,6 +219,11 @@ func (cm *Manager) GetCurrency(ctx context.Context, req *pb.GetCurrencyRequest)
 		currency.UpdatedBy = updatedBy.String
 	}
 
+	// Spec: docs/specs/006-currency-history.md#point-in-time-lookup
+	if req.AsOf != nil {
+		return cm.currencyAsOf(ctx, currency, req.AsOf.AsTime())
+	}
+
 	return currency, nil
 }
 
@@ -423,6 +435,10 @@ func (cm *Manager) DeactivateCurrency(ctx context.Context, req *pb.DeactivateCur
 // ListCurrencies retrieves currencies with optional filters
 // Spec: docs/specs/003-currency-management.md#story-2-query-currency-information
 func (cm *Manager) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesRequest) (*pb.ListCurrenciesResponse, error) {
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	}
+
 	query := `
 		SELECT id, code, numeric_code, name, minor_units, symbol, symbol_position,
 			   country_codes, is_active, is_crypto, status, activated_at, deactivated_at,
@@ -430,46 +446,40 @@ func (cm *Manager) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesReq
 		FROM treasury.currencies
 		WHERE 1=1`
 
-	args := []interface{}{}
-	argCount := 1
-
 	// Add filters
-	if req.Status != pb.CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED {
-		query += fmt.Sprintf(" AND status = $%d", argCount)
-		args = append(args, mapStatusToString(req.Status))
-		argCount++
-	}
-
-	if req.IsActive {
-		query += fmt.Sprintf(" AND is_active = $%d", argCount)
-		args = append(args, req.IsActive)
-		argCount++
-	}
-
-	if req.IsCrypto {
-		query += fmt.Sprintf(" AND is_crypto = $%d", argCount)
-		args = append(args, req.IsCrypto)
-		argCount++
-	}
-
-	if req.CountryCode != "" {
-		query += fmt.Sprintf(" AND $%d = ANY(country_codes)", argCount)
-		args = append(args, req.CountryCode)
+	filterClause, filterArgs := currencyFilterClause(req)
+	query += filterClause
+	args := append([]interface{}{}, filterArgs...)
+	argCount := len(args) + 1
+
+	// Resume after the last code of the previous page
+	// Spec: docs/specs/005-cursor-pagination.md
+	cursorFilters := pagination.Filters{
+		"status":       req.Status.String(),
+		"is_active":    boolFilter(req.IsActive),
+		"is_crypto":    boolFilter(req.IsCrypto),
+		"country_code": req.CountryCode,
+	}
+	if req.PageToken != "" {
+		keys, err := cm.cursors.Decode(req.PageToken, currenciesCursorScope, cursorFilters, 1)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		query += fmt.Sprintf(" AND code > $%d", argCount)
+		args = append(args, keys[0])
 		argCount++
 	}
 
 	// Add ordering
 	query += " ORDER BY code"
 
-	// Add pagination
+	// Add pagination, fetching one extra row to tell whether another page follows
 	if req.PageSize > 0 {
 		query += fmt.Sprintf(" LIMIT $%d", argCount)
-		args = append(args, req.PageSize)
+		args = append(args, req.PageSize+1)
 		argCount++
 	}
 
-	// TODO: Implement page token logic for cursor-based pagination
-
 	rows, err := cm.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list currencies: %v", err)
@@ -532,19 +542,30 @@ func (cm *Manager) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesReq
 		currencies = append(currencies, currency)
 	}
 
-	// Get total count
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating currencies: %v", err)
+	}
+
+	nextPageToken := ""
+	if req.PageSize > 0 && len(currencies) > int(req.PageSize) {
+		currencies = currencies[:req.PageSize]
+		nextPageToken = cm.cursors.Encode(currenciesCursorScope, cursorFilters, currencies[len(currencies)-1].Code)
+	}
+
+	// Count total matching currencies with the same filters as the page
+	// Spec: docs/specs/005-cursor-pagination.md#total-count
 	var totalCount int32
-	countQuery := "SELECT COUNT(*) FROM treasury.currencies WHERE 1=1"
-	if req.Status != pb.CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED {
-		countQuery += " AND status = $1"
-		cm.db.QueryRowContext(ctx, countQuery, mapStatusToString(req.Status)).Scan(&totalCount)
-	} else {
-		cm.db.QueryRowContext(ctx, countQuery).Scan(&totalCount)
+	if !req.SkipTotalCount {
+		countQuery := "SELECT COUNT(*) FROM treasury.currencies WHERE 1=1" + filterClause
+		if err := cm.db.QueryRowContext(ctx, countQuery, filterArgs...).Scan(&totalCount); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count currencies: %v", err)
+		}
 	}
 
 	return &pb.ListCurrenciesResponse{
-		Currencies: currencies,
-		TotalCount: totalCount,
+		Currencies:    currencies,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
 	}, nil
 }
 
@@ -580,7 +601,8 @@ func (cm *Manager) BulkCreateCurrencies(ctx context.Context, req *pb.BulkCreateC
 				_, err = tx.ExecContext(ctx, `
 					UPDATE treasury.currencies 
 					SET name = $1, minor_units = $2, symbol = $3, 
-						country_codes = $4, updated_at = CURRENT_TIMESTAMP
+						country_codes = $4, updated_at = CURRENT_TIMESTAMP,
+						updated_by = 'system', version = version + 1
 					WHERE code = $5`,
 					currency.Name, currency.MinorUnits, nullString(currency.Symbol),
 					pq.Array(currency.CountryCodes), currency.Code)
@@ -665,4 +687,42 @@ func mapStatusToString(status pb.CurrencyStatus) string {
 	default:
 		return "active"
 	}
-}
\ No newline at end of file
+}
+
+// currencyFilterClause builds the WHERE conditions for the ListCurrencies
+// filters. The page query and the count query share it, so total_count
+// always matches the filters applied to the page.
+func currencyFilterClause(req *pb.ListCurrenciesRequest) (string, []interface{}) {
+	clause := ""
+	args := []interface{}{}
+
+	if req.Status != pb.CurrencyStatus_CURRENCY_STATUS_UNSPECIFIED {
+		args = append(args, mapStatusToString(req.Status))
+		clause += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+
+	if req.IsActive {
+		args = append(args, req.IsActive)
+		clause += fmt.Sprintf(" AND is_active = $%d", len(args))
+	}
+
+	if req.IsCrypto {
+		args = append(args, req.IsCrypto)
+		clause += fmt.Sprintf(" AND is_crypto = $%d", len(args))
+	}
+
+	if req.CountryCode != "" {
+		args = append(args, req.CountryCode)
+		clause += fmt.Sprintf(" AND $%d = ANY(country_codes)", len(args))
+	}
+
+	return clause, args
+}
+
+// boolFilter returns the page token filter value for an optional flag filter
+func boolFilter(set bool) string {
+	if !set {
+		return ""
+	}
+	return "true"
+}
@@ -15,16 +15,28 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
+	"example.com/go-mono-repo/common/pagination"
 	pb "example.com/go-mono-repo/proto/treasury"
 )
 
+// testCursors signs page tokens in tests
+var testCursors = pagination.NewCodec("test-secret")
+
+// currencyColumns are the columns returned by currency list queries
+var currencyColumns = []string{
+	"id", "code", "numeric_code", "name", "minor_units",
+	"symbol", "symbol_position", "country_codes", "is_active",
+	"is_crypto", "status", "activated_at", "deactivated_at",
+	"created_at", "updated_at", "created_by", "updated_by", "version",
+}
+
 // TestNewManager tests the creation of a new Manager
 func TestNewManager(t *testing.T) {
 	db, _, err := sqlmock.New()
 	require.NoError(t, err)
 	defer db.Close()
 
-	manager := NewManager(db)
+	manager := NewManager(db, testCursors)
 	assert.NotNil(t, manager)
 	assert.Equal(t, db, manager.db)
 }
@@ -158,7 +170,7 @@ func TestCreateCurrency(t *testing.T) {
 
 			tt.setupMock(mock)
 
-			manager := NewManager(db)
+			manager := NewManager(db, testCursors)
 			result, err := manager.CreateCurrency(context.Background(), tt.request)
 
 			if tt.wantErr {
@@ -302,7 +314,7 @@ func TestGetCurrency(t *testing.T) {
 
 			tt.setupMock(mock)
 
-			manager := NewManager(db)
+			manager := NewManager(db, testCursors)
 			result, err := manager.GetCurrency(context.Background(), tt.request)
 
 			if tt.wantErr {
@@ -418,7 +430,7 @@ func TestUpdateCurrency(t *testing.T) {
 
 			tt.setupMock(mock)
 
-			manager := NewManager(db)
+			manager := NewManager(db, testCursors)
 			result, err := manager.UpdateCurrency(context.Background(), tt.request)
 
 			if tt.wantErr {
@@ -502,7 +514,7 @@ func TestDeactivateCurrency(t *testing.T) {
 
 			tt.setupMock(mock)
 
-			manager := NewManager(db)
+			manager := NewManager(db, testCursors)
 			result, err := manager.DeactivateCurrency(context.Background(), tt.request)
 
 			if tt.wantErr {
@@ -590,19 +602,113 @@ func TestListCurrencies(t *testing.T) {
 					fixedTime, fixedTime, "system", nil, 1,
 				)
 				mock.ExpectQuery("SELECT .* FROM treasury.currencies").
-					WithArgs("active", true, int32(10)).
+					WithArgs("active", true, int32(11)).
 					WillReturnRows(rows)
 
 				countRows := sqlmock.NewRows([]string{"count"}).AddRow(1)
-				mock.ExpectQuery("SELECT COUNT").
-					WithArgs("active").
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM treasury.currencies WHERE 1=1 AND status = \$1 AND is_active = \$2`).
+					WithArgs("active", true).
 					WillReturnRows(countRows)
 			},
 			wantErr: false,
 			validate: func(t *testing.T, resp *pb.ListCurrenciesResponse) {
 				assert.Len(t, resp.Currencies, 1)
 				assert.Equal(t, int32(1), resp.TotalCount)
+				assert.Empty(t, resp.NextPageToken)
+			},
+		},
+		{
+			name:    "first page returns a cursor",
+			request: &pb.ListCurrenciesRequest{PageSize: 2},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(currencyColumns)
+				for _, code := range []string{"AUD", "CAD", "CHF"} {
+					rows.AddRow(
+						uuid.New().String(), code, nil, code, 2,
+						nil, nil, pq.Array([]string{}), true,
+						false, "active", nil, nil,
+						fixedTime, fixedTime, nil, nil, 1,
+					)
+				}
+				mock.ExpectQuery(`SELECT .* FROM treasury.currencies WHERE 1=1 ORDER BY code LIMIT \$1`).
+					WithArgs(int32(3)).
+					WillReturnRows(rows)
+
+				mock.ExpectQuery("SELECT COUNT").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			},
+			validate: func(t *testing.T, resp *pb.ListCurrenciesResponse) {
+				assert.Len(t, resp.Currencies, 2)
+				assert.Equal(t, "CAD", resp.Currencies[1].Code)
+
+				keys, err := testCursors.Decode(resp.NextPageToken, currenciesCursorScope, pagination.Filters{"status": "CURRENCY_STATUS_UNSPECIFIED"}, 1)
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"CAD"}, keys)
+			},
+		},
+		{
+			name: "next page resumes after the cursor",
+			request: &pb.ListCurrenciesRequest{
+				IsCrypto:  true,
+				PageSize:  2,
+				PageToken: testCursors.Encode(currenciesCursorScope, pagination.Filters{"status": "CURRENCY_STATUS_UNSPECIFIED", "is_crypto": "true"}, "BTC"),
 			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(currencyColumns).AddRow(
+					uuid.New().String(), "ETH", nil, "Ether", 18,
+					nil, nil, pq.Array([]string{}), true,
+					true, "active", nil, nil,
+					fixedTime, fixedTime, nil, nil, 1,
+				)
+				mock.ExpectQuery(`SELECT .* FROM treasury.currencies WHERE 1=1 AND is_crypto = \$1 AND code > \$2 ORDER BY code LIMIT \$3`).
+					WithArgs(true, "BTC", int32(3)).
+					WillReturnRows(rows)
+
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM treasury.currencies WHERE 1=1 AND is_crypto = \$1$`).
+					WithArgs(true).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			},
+			validate: func(t *testing.T, resp *pb.ListCurrenciesResponse) {
+				assert.Len(t, resp.Currencies, 1)
+				assert.Empty(t, resp.NextPageToken)
+				assert.Equal(t, int32(2), resp.TotalCount)
+			},
+		},
+		{
+			name: "skip total count",
+			request: &pb.ListCurrenciesRequest{
+				CountryCode:    "US",
+				SkipTotalCount: true,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(currencyColumns).AddRow(
+					fixedUUID.String(), "USD", "840", "United States Dollar", 2,
+					"$", "before", pq.Array([]string{"US"}), true,
+					false, "active", fixedTime, nil,
+					fixedTime, fixedTime, "system", nil, 1,
+				)
+				mock.ExpectQuery(`SELECT .* FROM treasury.currencies WHERE 1=1 AND \$1 = ANY\(country_codes\) ORDER BY code`).
+					WithArgs("US").
+					WillReturnRows(rows)
+			},
+			validate: func(t *testing.T, resp *pb.ListCurrenciesResponse) {
+				assert.Len(t, resp.Currencies, 1)
+				assert.Zero(t, resp.TotalCount)
+			},
+		},
+		{
+			name: "page token reused with other filters",
+			request: &pb.ListCurrenciesRequest{
+				PageToken: testCursors.Encode(currenciesCursorScope, pagination.Filters{"status": "CURRENCY_STATUS_UNSPECIFIED", "is_crypto": "true"}, "BTC"),
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {},
+			wantErr:   true,
+		},
+		{
+			name:      "offset page token",
+			request:   &pb.ListCurrenciesRequest{PageToken: "10"},
+			setupMock: func(mock sqlmock.Sqlmock) {},
+			wantErr:   true,
 		},
 	}
 
@@ -614,7 +720,7 @@ func TestListCurrencies(t *testing.T) {
 
 			tt.setupMock(mock)
 
-			manager := NewManager(db)
+			manager := NewManager(db, testCursors)
 			result, err := manager.ListCurrencies(context.Background(), tt.request)
 
 			if tt.wantErr {
@@ -786,7 +892,7 @@ func TestBulkCreateCurrencies(t *testing.T) {
 
 			tt.setupMock(mock)
 
-			manager := NewManager(db)
+			manager := NewManager(db, testCursors)
 			result, err := manager.BulkCreateCurrencies(context.Background(), tt.request)
 
 			if tt.wantErr {
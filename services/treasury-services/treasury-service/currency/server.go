@@ -74,4 +74,29 @@ func (s *Server) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesReque
 // Spec: docs/specs/003-currency-management.md#story-5-bulk-currency-operations
 func (s *Server) BulkCreateCurrencies(ctx context.Context, req *pb.BulkCreateCurrenciesRequest) (*pb.BulkCreateCurrenciesResponse, error) {
 	return s.manager.BulkCreateCurrencies(ctx, req)
-}
\ No newline at end of file
+}
+// GetCurrencyHistory returns every version of a currency and its pending changes
+// Spec: docs/specs/006-currency-history.md#story-1-currency-history
+func (s *Server) GetCurrencyHistory(ctx context.Context, req *pb.GetCurrencyHistoryRequest) (*pb.GetCurrencyHistoryResponse, error) {
+	return s.manager.GetCurrencyHistory(ctx, req)
+}
+
+// ScheduleCurrencyChange schedules a change to a currency at a future time
+// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+func (s *Server) ScheduleCurrencyChange(ctx context.Context, req *pb.ScheduleCurrencyChangeRequest) (*pb.ScheduleCurrencyChangeResponse, error) {
+	change, err := s.manager.ScheduleCurrencyChange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ScheduleCurrencyChangeResponse{Change: change}, nil
+}
+
+// CancelCurrencyChange cancels a scheduled change that has not been applied
+// Spec: docs/specs/006-currency-history.md#story-2-scheduled-changes
+func (s *Server) CancelCurrencyChange(ctx context.Context, req *pb.CancelCurrencyChangeRequest) (*pb.CancelCurrencyChangeResponse, error) {
+	change, err := s.manager.CancelCurrencyChange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CancelCurrencyChangeResponse{Change: change}, nil
+}
@@ -0,0 +1,267 @@
+package exchangerate
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// maxImportRows is the largest number of rates accepted in one file
+const maxImportRows = 50000
+
+// dateLayout is the layout of rate dates in imported files
+const dateLayout = "2006-01-02"
+
+// ImportedRate is one rate read from a rate file, before validation
+// Spec: docs/specs/005-exchange-rates.md#file-formats
+type ImportedRate struct {
+	Ref   string // Where the rate is in the file, used in error messages
+	Date  string
+	Base  string
+	Quote string
+	Rate  string
+}
+
+// ecbEnvelope is the ECB euro foreign exchange reference rate document:
+// <Cube><Cube time="2025-09-30"><Cube currency="USD" rate="1.1741"/></Cube></Cube>
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECB reads an ECB reference rate XML file. The daily, 90-day and
+// historical files share the layout. All rates are quoted against EUR.
+// Spec: docs/specs/005-exchange-rates.md#ecb-xml
+func ParseECB(r io.Reader) ([]*ImportedRate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("invalid ECB XML: %w", err)
+	}
+	if len(envelope.Days) == 0 {
+		return nil, fmt.Errorf("invalid ECB XML: no dated Cube elements")
+	}
+
+	rates := []*ImportedRate{}
+	for _, day := range envelope.Days {
+		for _, rate := range day.Rates {
+			rates = append(rates, &ImportedRate{
+				Ref:   fmt.Sprintf("%s %s", day.Time, rate.Currency),
+				Date:  day.Time,
+				Base:  "EUR",
+				Quote: rate.Currency,
+				Rate:  rate.Rate,
+			})
+		}
+	}
+	return rates, nil
+}
+
+// ParseCSV reads a CSV file with date, base, quote and rate columns. A
+// first row starting with "date" is treated as a header.
+// Spec: docs/specs/005-exchange-rates.md#csv
+func ParseCSV(r io.Reader) ([]*ImportedRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rates := []*ImportedRate{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		if line == 1 && len(record) > 0 && strings.EqualFold(strings.TrimPrefix(record[0], "\ufeff"), "date") {
+			continue
+		}
+
+		rate := &ImportedRate{Ref: fmt.Sprintf("line %d", line)}
+		if len(record) == 4 {
+			rate.Date = strings.TrimSpace(record[0])
+			rate.Base = strings.TrimSpace(record[1])
+			rate.Quote = strings.TrimSpace(record[2])
+			rate.Rate = strings.TrimSpace(record[3])
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+// ImportRates stores the rates of a rate file. Rows that fail validation
+// are reported in errors and the rest are imported. Importing the same
+// file again changes nothing.
+// Spec: docs/specs/005-exchange-rates.md#story-4-import-rate-files
+func (m *Manager) ImportRates(ctx context.Context, req *pb.ImportRatesRequest) (*pb.ImportRatesResponse, error) {
+	if len(req.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	if req.Source == "" {
+		return nil, status.Error(codes.InvalidArgument, "source is required")
+	}
+	if len(req.Source) > 50 {
+		return nil, status.Error(codes.InvalidArgument, "source must be 50 characters or less")
+	}
+
+	var rates []*ImportedRate
+	var err error
+	switch req.Format {
+	case pb.RateFileFormat_RATE_FILE_FORMAT_ECB_XML:
+		rates, err = ParseECB(bytes.NewReader(req.Content))
+	case pb.RateFileFormat_RATE_FILE_FORMAT_CSV:
+		rates, err = ParseCSV(bytes.NewReader(req.Content))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "format is required")
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(rates) > maxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d rates can be imported at once", maxImportRows)
+	}
+
+	rateType := req.RateType
+	if rateType == pb.RateType_RATE_TYPE_UNSPECIFIED {
+		rateType = pb.RateType_RATE_TYPE_SPOT
+	}
+	updatedBy := req.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = "system"
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	active, err := activeCurrencies(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ImportRatesResponse{}
+	dates := map[string]bool{}
+	for _, rate := range rates {
+		effectiveAt, err := validateImportedRate(rate, active)
+		if err != nil {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("%s: %v", rate.Ref, err))
+			continue
+		}
+		dates[rate.Date] = true
+
+		// Only a changed rate is written, so a re-import leaves versions alone
+		var inserted bool
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO treasury.exchange_rates (
+				id, base_currency, quote_currency, rate, rate_type, effective_at, source,
+				created_at, updated_at, created_by, updated_by, version
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7,
+				CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, $8, $8, 1
+			)
+			ON CONFLICT (base_currency, quote_currency, rate_type, source, effective_at) DO UPDATE
+			SET rate = EXCLUDED.rate,
+				updated_at = CURRENT_TIMESTAMP,
+				updated_by = EXCLUDED.updated_by,
+				version = treasury.exchange_rates.version + 1
+			WHERE treasury.exchange_rates.rate <> EXCLUDED.rate
+			RETURNING (xmax = 0) AS inserted`,
+			uuid.New(), rate.Base, rate.Quote, rate.Rate,
+			mapRateTypeToString(rateType), effectiveAt, req.Source, updatedBy).Scan(&inserted)
+		switch {
+		case err == sql.ErrNoRows:
+			resp.SkippedCount++
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "failed to import %s: %v", rate.Ref, err)
+		case inserted:
+			resp.CreatedCount++
+		default:
+			resp.UpdatedCount++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	for date := range dates {
+		resp.Dates = append(resp.Dates, date)
+	}
+	sort.Strings(resp.Dates)
+
+	return resp, nil
+}
+
+// validateImportedRate checks one rate of a file and returns its effective
+// time, midnight UTC of its date
+func validateImportedRate(rate *ImportedRate, active map[string]bool) (time.Time, error) {
+	if rate.Date == "" && rate.Base == "" && rate.Quote == "" && rate.Rate == "" {
+		return time.Time{}, fmt.Errorf("expected 4 columns: date, base, quote, rate")
+	}
+	effectiveAt, err := time.Parse(dateLayout, rate.Date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: must be YYYY-MM-DD", rate.Date)
+	}
+	for _, code := range []string{rate.Base, rate.Quote} {
+		if !isoCodeRegex.MatchString(code) {
+			return time.Time{}, fmt.Errorf("invalid currency code %q", code)
+		}
+		if !active[code] {
+			return time.Time{}, fmt.Errorf("currency %s is not active", code)
+		}
+	}
+	if rate.Base == rate.Quote {
+		return time.Time{}, fmt.Errorf("base and quote currency must differ")
+	}
+	if !rateRegex.MatchString(rate.Rate) {
+		return time.Time{}, fmt.Errorf("invalid rate %q: must be a decimal with at most %d decimal places", rate.Rate, rateScale)
+	}
+	if value, _ := new(big.Rat).SetString(rate.Rate); value.Sign() <= 0 {
+		return time.Time{}, fmt.Errorf("rate must be positive")
+	}
+	return effectiveAt, nil
+}
+
+// activeCurrencies returns the codes of all active currencies
+// Spec: docs/specs/005-exchange-rates.md#currency-validation
+func activeCurrencies(ctx context.Context, q queryer) (map[string]bool, error) {
+	rows, err := q.QueryContext(ctx, "SELECT code FROM treasury.currencies WHERE is_active = true")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load currencies: %v", err)
+	}
+	defer rows.Close()
+
+	active := map[string]bool{}
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan currency: %v", err)
+		}
+		active[strings.TrimSpace(code)] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating currencies: %v", err)
+	}
+	return active, nil
+}
@@ -0,0 +1,178 @@
+package exchangerate
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// ecbDaily is an excerpt of the ECB eurofxref-daily.xml file
+const ecbDaily = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time='2025-09-30'>
+			<Cube currency='USD' rate='1.1741'/>
+			<Cube currency='JPY' rate='174.47'/>
+			<Cube currency='XAU' rate='0.0003'/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+// TestParseECB tests reading the ECB reference rate XML
+// Spec: docs/specs/005-exchange-rates.md#ecb-xml
+func TestParseECB(t *testing.T) {
+	rates, err := ParseECB(strings.NewReader(ecbDaily))
+
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+	assert.Equal(t, &ImportedRate{Ref: "2025-09-30 USD", Date: "2025-09-30", Base: "EUR", Quote: "USD", Rate: "1.1741"}, rates[0])
+	assert.Equal(t, "174.47", rates[1].Rate)
+
+	_, err = ParseECB(strings.NewReader("<html>not rates</html>"))
+	assert.EqualError(t, err, "invalid ECB XML: no dated Cube elements")
+}
+
+// TestParseCSV tests reading date, base, quote, rate rows
+// Spec: docs/specs/005-exchange-rates.md#csv
+func TestParseCSV(t *testing.T) {
+	rates, err := ParseCSV(strings.NewReader("date,base,quote,rate\n2025-09-30, GBP, USD, 1.3442\n2025-09-30,GBP\n"))
+
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, &ImportedRate{Ref: "line 2", Date: "2025-09-30", Base: "GBP", Quote: "USD", Rate: "1.3442"}, rates[0])
+	assert.Equal(t, &ImportedRate{Ref: "line 3"}, rates[1])
+
+	// No header
+	rates, err = ParseCSV(strings.NewReader("2025-09-30,GBP,USD,1.3442\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "line 1", rates[0].Ref)
+}
+
+// TestImportRates tests importing a rate file
+// Spec: docs/specs/005-exchange-rates.md#story-4-import-rate-files
+func TestImportRates(t *testing.T) {
+	effectiveAt := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+	inserted := func(value bool) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"inserted"}).AddRow(value)
+	}
+
+	tests := []struct {
+		name      string
+		request   *pb.ImportRatesRequest
+		setupMock func(sqlmock.Sqlmock)
+		wantErr   string
+		validate  func(*testing.T, *pb.ImportRatesResponse)
+	}{
+		{
+			name: "ECB file",
+			request: &pb.ImportRatesRequest{
+				Format:  pb.RateFileFormat_RATE_FILE_FORMAT_ECB_XML,
+				Content: []byte(ecbDaily),
+				Source:  "ECB",
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT code FROM treasury.currencies WHERE is_active = true").
+					WillReturnRows(sqlmock.NewRows([]string{"code"}).AddRow("EUR").AddRow("USD").AddRow("JPY"))
+				mock.ExpectQuery("INSERT INTO treasury.exchange_rates").
+					WithArgs(sqlmock.AnyArg(), "EUR", "USD", "1.1741", "spot", effectiveAt, "ECB", "system").
+					WillReturnRows(inserted(true))
+				mock.ExpectQuery("INSERT INTO treasury.exchange_rates").
+					WithArgs(sqlmock.AnyArg(), "EUR", "JPY", "174.47", "spot", effectiveAt, "ECB", "system").
+					WillReturnRows(inserted(false))
+				mock.ExpectCommit()
+			},
+			validate: func(t *testing.T, resp *pb.ImportRatesResponse) {
+				assert.Equal(t, int32(1), resp.CreatedCount)
+				assert.Equal(t, int32(1), resp.UpdatedCount)
+				assert.Equal(t, []string{"2025-09-30 XAU: currency XAU is not active"}, resp.Errors)
+				assert.Equal(t, []string{"2025-09-30"}, resp.Dates)
+			},
+		},
+		{
+			name: "re-import skips unchanged rates",
+			request: &pb.ImportRatesRequest{
+				Format:   pb.RateFileFormat_RATE_FILE_FORMAT_CSV,
+				Content:  []byte("2025-09-30,GBP,USD,1.3442\n30/09/2025,GBP,USD,1.3442\n2025-09-30,GBP,USD,-1\n"),
+				Source:   "BANK",
+				RateType: pb.RateType_RATE_TYPE_CLOSING,
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT code FROM treasury.currencies").
+					WillReturnRows(sqlmock.NewRows([]string{"code"}).AddRow("GBP").AddRow("USD"))
+				mock.ExpectQuery(`INSERT INTO treasury.exchange_rates .* WHERE treasury.exchange_rates.rate <> EXCLUDED.rate`).
+					WithArgs(sqlmock.AnyArg(), "GBP", "USD", "1.3442", "closing", effectiveAt, "BANK", "system").
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectCommit()
+			},
+			validate: func(t *testing.T, resp *pb.ImportRatesResponse) {
+				assert.Equal(t, int32(0), resp.CreatedCount)
+				assert.Equal(t, int32(1), resp.SkippedCount)
+				assert.Equal(t, []string{
+					`line 2: invalid date "30/09/2025": must be YYYY-MM-DD`,
+					`line 3: invalid rate "-1": must be a decimal with at most 12 decimal places`,
+				}, resp.Errors)
+			},
+		},
+		{
+			name: "unreadable file",
+			request: &pb.ImportRatesRequest{
+				Format:  pb.RateFileFormat_RATE_FILE_FORMAT_CSV,
+				Content: []byte("2025-09-30,\"GBP,USD\n"),
+				Source:  "BANK",
+			},
+			wantErr: "invalid CSV",
+		},
+		{
+			name:    "missing source",
+			request: &pb.ImportRatesRequest{Format: pb.RateFileFormat_RATE_FILE_FORMAT_CSV, Content: []byte("x")},
+			wantErr: "source is required",
+		},
+		{
+			name:    "missing format",
+			request: &pb.ImportRatesRequest{Content: []byte("x"), Source: "BANK"},
+			wantErr: "format is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			manager := NewManager(db, testCursors, "USD")
+			resp, err := manager.ImportRates(context.Background(), tt.request)
+
+			if tt.wantErr != "" {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, st.Code())
+				assert.Contains(t, st.Message(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				tt.validate(t, resp)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
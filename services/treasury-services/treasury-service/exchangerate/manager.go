@@ -0,0 +1,600 @@
+package exchangerate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// Manager handles exchange rate database operations
+// Spec: docs/specs/005-exchange-rates.md
+type Manager struct {
+	db            *sql.DB
+	cursors       *pagination.Codec
+	pivotCurrency string
+}
+
+// NewManager creates a new exchange rate manager instance. Rates between
+// two currencies without a stored rate are triangulated through
+// pivotCurrency.
+// Spec: docs/specs/005-exchange-rates.md
+func NewManager(db *sql.DB, cursors *pagination.Codec, pivotCurrency string) *Manager {
+	return &Manager{
+		db:            db,
+		cursors:       cursors,
+		pivotCurrency: pivotCurrency,
+	}
+}
+
+// ratesCursorScope binds ListRates page tokens to that RPC
+const ratesCursorScope = "treasury.exchange_rates"
+
+// rateScale is the number of decimal places a rate is stored and derived with
+// Spec: docs/specs/005-exchange-rates.md#rate-precision
+const rateScale = 12
+
+// maxUpsertRates is the largest batch accepted by UpsertRates
+const maxUpsertRates = 1000
+
+// rateColumns are the columns read into an ExchangeRate
+const rateColumns = `id, base_currency, quote_currency, rate, rate_type, effective_at, source,
+	created_at, updated_at, created_by, updated_by, version`
+
+var (
+	// ISO 4217 code validation regex (3 uppercase letters)
+	isoCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+	// Rate validation regex, matching NUMERIC(30, 12)
+	rateRegex = regexp.MustCompile(`^[0-9]{1,18}(\.[0-9]{1,12})?$`)
+)
+
+// UpsertRates creates rates and corrects existing ones in a single
+// transaction. A rate is identified by its pair, type, source and
+// effective time.
+// Spec: docs/specs/005-exchange-rates.md#story-1-upsert-rates
+func (m *Manager) UpsertRates(ctx context.Context, req *pb.UpsertRatesRequest) (*pb.UpsertRatesResponse, error) {
+	if len(req.Rates) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one rate is required")
+	}
+	if len(req.Rates) > maxUpsertRates {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d rates can be upserted at once", maxUpsertRates)
+	}
+
+	currencyCodes := []string{}
+	for i, input := range req.Rates {
+		if err := validateRateInput(input); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "rates[%d]: %v", i, err)
+		}
+		currencyCodes = append(currencyCodes, input.BaseCurrency, input.QuoteCurrency)
+	}
+
+	updatedBy := req.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = "system"
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkActiveCurrencies(ctx, tx, currencyCodes); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.UpsertRatesResponse{}
+	for i, input := range req.Rates {
+		var inserted bool
+		row := tx.QueryRowContext(ctx, `
+			INSERT INTO treasury.exchange_rates (
+				id, base_currency, quote_currency, rate, rate_type, effective_at, source,
+				created_at, updated_at, created_by, updated_by, version
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7,
+				CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, $8, $8, 1
+			)
+			ON CONFLICT (base_currency, quote_currency, rate_type, source, effective_at) DO UPDATE
+			SET rate = EXCLUDED.rate,
+				updated_at = CURRENT_TIMESTAMP,
+				updated_by = EXCLUDED.updated_by,
+				version = treasury.exchange_rates.version + 1
+			RETURNING `+rateColumns+`, (xmax = 0) AS inserted`,
+			uuid.New(), input.BaseCurrency, input.QuoteCurrency, input.Rate,
+			mapRateTypeToString(input.RateType), input.EffectiveAt.AsTime(), input.Source, updatedBy)
+
+		rate, err := scanRate(row, &inserted)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to upsert rates[%d]: %v", i, err)
+		}
+		if inserted {
+			resp.CreatedCount++
+		} else {
+			resp.UpdatedCount++
+		}
+		resp.Rates = append(resp.Rates, rate)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return resp, nil
+}
+
+// GetRate returns the latest rate between two currencies effective at or
+// before as_of. Without a stored rate for the pair it uses the inverse of
+// the reverse pair, then the product of the rates through the pivot
+// currency.
+// Spec: docs/specs/005-exchange-rates.md#story-2-get-rate
+func (m *Manager) GetRate(ctx context.Context, req *pb.GetRateRequest) (*pb.GetRateResponse, error) {
+	if !isoCodeRegex.MatchString(req.BaseCurrency) {
+		return nil, status.Error(codes.InvalidArgument, "invalid base_currency: must be 3 uppercase letters")
+	}
+	if !isoCodeRegex.MatchString(req.QuoteCurrency) {
+		return nil, status.Error(codes.InvalidArgument, "invalid quote_currency: must be 3 uppercase letters")
+	}
+
+	rateType := req.RateType
+	if rateType == pb.RateType_RATE_TYPE_UNSPECIFIED {
+		rateType = pb.RateType_RATE_TYPE_SPOT
+	}
+	asOf := time.Now()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+
+	if err := checkActiveCurrencies(ctx, m.db, []string{req.BaseCurrency, req.QuoteCurrency}); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetRateResponse{
+		BaseCurrency:  req.BaseCurrency,
+		QuoteCurrency: req.QuoteCurrency,
+		RateType:      rateType,
+	}
+
+	if req.BaseCurrency == req.QuoteCurrency {
+		resp.Rate = "1"
+		resp.EffectiveAt = timestamppb.New(asOf)
+		resp.Derivation = pb.RateDerivation_RATE_DERIVATION_IDENTITY
+		return resp, nil
+	}
+
+	lookup := rateLookup{rateType: mapRateTypeToString(rateType), source: req.Source, asOf: asOf}
+	result, err := m.findPairRate(ctx, lookup, req.BaseCurrency, req.QuoteCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Triangulate through the pivot currency
+	// Spec: docs/specs/005-exchange-rates.md#triangulation
+	if result == nil && req.BaseCurrency != m.pivotCurrency && req.QuoteCurrency != m.pivotCurrency {
+		toPivot, err := m.findPairRate(ctx, lookup, req.BaseCurrency, m.pivotCurrency)
+		if err != nil {
+			return nil, err
+		}
+		if toPivot != nil {
+			fromPivot, err := m.findPairRate(ctx, lookup, m.pivotCurrency, req.QuoteCurrency)
+			if err != nil {
+				return nil, err
+			}
+			if fromPivot != nil {
+				result = &pairRate{
+					rate:       new(big.Rat).Mul(toPivot.rate, fromPivot.rate),
+					derivation: pb.RateDerivation_RATE_DERIVATION_TRIANGULATED,
+					legs:       append(toPivot.legs, fromPivot.legs...),
+				}
+				resp.PivotCurrency = m.pivotCurrency
+			}
+		}
+	}
+
+	if result == nil {
+		return nil, status.Errorf(codes.NotFound, "no %s rate for %s/%s at %s",
+			lookup.rateType, req.BaseCurrency, req.QuoteCurrency, asOf.UTC().Format(time.RFC3339))
+	}
+
+	resp.Rate = formatRate(result.rate)
+	resp.Derivation = result.derivation
+	resp.Legs = result.legs
+	resp.EffectiveAt = result.legs[0].EffectiveAt
+	for _, leg := range result.legs[1:] {
+		if leg.EffectiveAt.AsTime().Before(resp.EffectiveAt.AsTime()) {
+			resp.EffectiveAt = leg.EffectiveAt
+		}
+	}
+
+	return resp, nil
+}
+
+// ListRates retrieves stored rates with optional filters, newest first
+// Spec: docs/specs/005-exchange-rates.md#story-3-list-rates
+func (m *Manager) ListRates(ctx context.Context, req *pb.ListRatesRequest) (*pb.ListRatesResponse, error) {
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	}
+
+	query := "SELECT " + rateColumns + " FROM treasury.exchange_rates WHERE 1=1"
+
+	// Add filters
+	filterClause, filterArgs := rateFilterClause(req)
+	query += filterClause
+	args := append([]interface{}{}, filterArgs...)
+	argCount := len(args) + 1
+
+	// Resume after the last rate of the previous page
+	// Spec: docs/specs/005-cursor-pagination.md
+	cursorFilters := pagination.Filters{
+		"base_currency":  req.BaseCurrency,
+		"quote_currency": req.QuoteCurrency,
+		"rate_type":      req.RateType.String(),
+		"source":         req.Source,
+		"effective_from": timestampFilter(req.EffectiveFrom),
+		"effective_to":   timestampFilter(req.EffectiveTo),
+	}
+	if req.PageToken != "" {
+		keys, err := m.cursors.Decode(req.PageToken, ratesCursorScope, cursorFilters, 2)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		effectiveAt, err := time.Parse(time.RFC3339Nano, keys[0])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		query += fmt.Sprintf(" AND (effective_at, id) < ($%d, $%d)", argCount, argCount+1)
+		args = append(args, effectiveAt, keys[1])
+		argCount += 2
+	}
+
+	// Add ordering
+	query += " ORDER BY effective_at DESC, id DESC"
+
+	// Add pagination, fetching one extra row to tell whether another page follows
+	if req.PageSize > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argCount)
+		args = append(args, req.PageSize+1)
+	}
+
+	rows, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list rates: %v", err)
+	}
+	defer rows.Close()
+
+	rates := []*pb.ExchangeRate{}
+	for rows.Next() {
+		rate, err := scanRate(rows)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan rate: %v", err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rates: %v", err)
+	}
+
+	nextPageToken := ""
+	if req.PageSize > 0 && len(rates) > int(req.PageSize) {
+		rates = rates[:req.PageSize]
+		last := rates[len(rates)-1]
+		nextPageToken = m.cursors.Encode(ratesCursorScope, cursorFilters,
+			last.EffectiveAt.AsTime().Format(time.RFC3339Nano), last.Id)
+	}
+
+	// Count total matching rates with the same filters as the page
+	// Spec: docs/specs/005-cursor-pagination.md#total-count
+	var totalCount int32
+	if !req.SkipTotalCount {
+		countQuery := "SELECT COUNT(*) FROM treasury.exchange_rates WHERE 1=1" + filterClause
+		if err := m.db.QueryRowContext(ctx, countQuery, filterArgs...).Scan(&totalCount); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count rates: %v", err)
+		}
+	}
+
+	return &pb.ListRatesResponse{
+		Rates:         rates,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// rateLookup selects the stored rates GetRate may use
+type rateLookup struct {
+	rateType string
+	source   string // Any source when empty
+	asOf     time.Time
+}
+
+// pairRate is a rate for a currency pair with the stored rates it was
+// derived from
+type pairRate struct {
+	rate       *big.Rat
+	derivation pb.RateDerivation
+	legs       []*pb.ExchangeRate
+}
+
+// findPairRate returns the stored rate for base/quote or, failing that,
+// the inverse of the stored rate for quote/base. It returns nil when
+// neither exists.
+func (m *Manager) findPairRate(ctx context.Context, lookup rateLookup, base, quote string) (*pairRate, error) {
+	direct, err := m.latestRate(ctx, lookup, base, quote)
+	if err != nil || direct != nil {
+		return direct, err
+	}
+
+	reverse, err := m.latestRate(ctx, lookup, quote, base)
+	if err != nil || reverse == nil {
+		return nil, err
+	}
+	return &pairRate{
+		rate:       new(big.Rat).Inv(reverse.rate),
+		derivation: pb.RateDerivation_RATE_DERIVATION_INVERSE,
+		legs:       reverse.legs,
+	}, nil
+}
+
+// latestRate returns the stored base/quote rate with the latest
+// effective_at not after the lookup time, or nil. Rates from different
+// sources with the same effective_at are ordered by source.
+func (m *Manager) latestRate(ctx context.Context, lookup rateLookup, base, quote string) (*pairRate, error) {
+	query := "SELECT " + rateColumns + ` FROM treasury.exchange_rates
+		WHERE base_currency = $1 AND quote_currency = $2 AND rate_type = $3 AND effective_at <= $4`
+	args := []interface{}{base, quote, lookup.rateType, lookup.asOf}
+	if lookup.source != "" {
+		query += " AND source = $5"
+		args = append(args, lookup.source)
+	}
+	query += " ORDER BY effective_at DESC, source LIMIT 1"
+
+	stored, err := scanRate(m.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rate: %v", err)
+	}
+
+	rate, ok := new(big.Rat).SetString(stored.Rate)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "invalid stored rate %s for %s/%s", stored.Rate, base, quote)
+	}
+	return &pairRate{
+		rate:       rate,
+		derivation: pb.RateDerivation_RATE_DERIVATION_DIRECT,
+		legs:       []*pb.ExchangeRate{stored},
+	}, nil
+}
+
+// rowScanner is implemented by *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanRate reads the rateColumns into an ExchangeRate. Extra destinations
+// receive columns selected after rateColumns.
+func scanRate(row rowScanner, extra ...interface{}) (*pb.ExchangeRate, error) {
+	var (
+		id            string
+		baseCurrency  string
+		quoteCurrency string
+		rate          string
+		rateType      string
+		effectiveAt   time.Time
+		source        string
+		createdAt     time.Time
+		updatedAt     time.Time
+		createdBy     sql.NullString
+		updatedBy     sql.NullString
+		version       int32
+	)
+
+	dest := append([]interface{}{
+		&id, &baseCurrency, &quoteCurrency, &rate, &rateType, &effectiveAt, &source,
+		&createdAt, &updatedAt, &createdBy, &updatedBy, &version,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	exchangeRate := &pb.ExchangeRate{
+		Id:            id,
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+		Rate:          trimRate(rate),
+		RateType:      mapRateType(rateType),
+		EffectiveAt:   timestamppb.New(effectiveAt),
+		Source:        source,
+		CreatedAt:     timestamppb.New(createdAt),
+		UpdatedAt:     timestamppb.New(updatedAt),
+		Version:       version,
+	}
+	if createdBy.Valid {
+		exchangeRate.CreatedBy = createdBy.String
+	}
+	if updatedBy.Valid {
+		exchangeRate.UpdatedBy = updatedBy.String
+	}
+
+	return exchangeRate, nil
+}
+
+// queryer is implemented by *sql.DB and *sql.Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// checkActiveCurrencies returns FAILED_PRECONDITION unless every code is an
+// active currency
+// Spec: docs/specs/005-exchange-rates.md#currency-validation
+func checkActiveCurrencies(ctx context.Context, q queryer, currencyCodes []string) error {
+	unique := map[string]bool{}
+	for _, code := range currencyCodes {
+		unique[code] = false
+	}
+	list := make([]string, 0, len(unique))
+	for code := range unique {
+		list = append(list, code)
+	}
+	sort.Strings(list)
+
+	rows, err := q.QueryContext(ctx,
+		"SELECT code FROM treasury.currencies WHERE code = ANY($1) AND is_active = true",
+		pq.Array(list))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check currencies: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var code string
+		if err := rows.Scan(&code); err != nil {
+			return status.Errorf(codes.Internal, "failed to scan currency: %v", err)
+		}
+		unique[strings.TrimSpace(code)] = true
+	}
+	if err := rows.Err(); err != nil {
+		return status.Errorf(codes.Internal, "error iterating currencies: %v", err)
+	}
+
+	for _, code := range list {
+		if !unique[code] {
+			return status.Errorf(codes.FailedPrecondition, "currency %s is not active", code)
+		}
+	}
+	return nil
+}
+
+// Helper functions
+
+// validateRateInput checks the fields of a rate to upsert
+func validateRateInput(input *pb.ExchangeRateInput) error {
+	if !isoCodeRegex.MatchString(input.BaseCurrency) {
+		return fmt.Errorf("invalid base_currency: must be 3 uppercase letters")
+	}
+	if !isoCodeRegex.MatchString(input.QuoteCurrency) {
+		return fmt.Errorf("invalid quote_currency: must be 3 uppercase letters")
+	}
+	if input.BaseCurrency == input.QuoteCurrency {
+		return fmt.Errorf("base_currency and quote_currency must differ")
+	}
+	if !rateRegex.MatchString(input.Rate) {
+		return fmt.Errorf("invalid rate %q: must be a decimal with at most %d decimal places", input.Rate, rateScale)
+	}
+	if rate, _ := new(big.Rat).SetString(input.Rate); rate.Sign() <= 0 {
+		return fmt.Errorf("rate must be positive")
+	}
+	if input.RateType == pb.RateType_RATE_TYPE_UNSPECIFIED {
+		return fmt.Errorf("rate_type is required")
+	}
+	if input.EffectiveAt == nil {
+		return fmt.Errorf("effective_at is required")
+	}
+	if input.Source == "" {
+		return fmt.Errorf("source is required")
+	}
+	if len(input.Source) > 50 {
+		return fmt.Errorf("source must be 50 characters or less")
+	}
+	return nil
+}
+
+// formatRate formats a derived rate rounded to rateScale decimal places
+func formatRate(rate *big.Rat) string {
+	return trimRate(rate.FloatString(rateScale))
+}
+
+// trimRate removes trailing zeros from a decimal string, e.g. the
+// NUMERIC(30, 12) value 1.085000000000 -> 1.085
+func trimRate(rate string) string {
+	if !strings.Contains(rate, ".") {
+		return rate
+	}
+	return strings.TrimSuffix(strings.TrimRight(rate, "0"), ".")
+}
+
+func mapRateType(rateType string) pb.RateType {
+	switch rateType {
+	case "spot":
+		return pb.RateType_RATE_TYPE_SPOT
+	case "average":
+		return pb.RateType_RATE_TYPE_AVERAGE
+	case "closing":
+		return pb.RateType_RATE_TYPE_CLOSING
+	default:
+		return pb.RateType_RATE_TYPE_UNSPECIFIED
+	}
+}
+
+func mapRateTypeToString(rateType pb.RateType) string {
+	switch rateType {
+	case pb.RateType_RATE_TYPE_AVERAGE:
+		return "average"
+	case pb.RateType_RATE_TYPE_CLOSING:
+		return "closing"
+	default:
+		return "spot"
+	}
+}
+
+// rateFilterClause builds the WHERE conditions for the ListRates filters.
+// The page query and the count query share it, so total_count always
+// matches the filters applied to the page.
+func rateFilterClause(req *pb.ListRatesRequest) (string, []interface{}) {
+	clause := ""
+	args := []interface{}{}
+
+	if req.BaseCurrency != "" {
+		args = append(args, req.BaseCurrency)
+		clause += fmt.Sprintf(" AND base_currency = $%d", len(args))
+	}
+
+	if req.QuoteCurrency != "" {
+		args = append(args, req.QuoteCurrency)
+		clause += fmt.Sprintf(" AND quote_currency = $%d", len(args))
+	}
+
+	if req.RateType != pb.RateType_RATE_TYPE_UNSPECIFIED {
+		args = append(args, mapRateTypeToString(req.RateType))
+		clause += fmt.Sprintf(" AND rate_type = $%d", len(args))
+	}
+
+	if req.Source != "" {
+		args = append(args, req.Source)
+		clause += fmt.Sprintf(" AND source = $%d", len(args))
+	}
+
+	if req.EffectiveFrom != nil {
+		args = append(args, req.EffectiveFrom.AsTime())
+		clause += fmt.Sprintf(" AND effective_at >= $%d", len(args))
+	}
+
+	if req.EffectiveTo != nil {
+		args = append(args, req.EffectiveTo.AsTime())
+		clause += fmt.Sprintf(" AND effective_at < $%d", len(args))
+	}
+
+	return clause, args
+}
+
+// timestampFilter returns the page token filter value for an optional
+// timestamp filter
+func timestampFilter(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,418 @@
+package exchangerate
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"example.com/go-mono-repo/common/pagination"
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// testCursors signs page tokens in tests
+var testCursors = pagination.NewCodec("test-secret")
+
+// rateColumnNames are the columns returned by rate queries
+var rateColumnNames = []string{
+	"id", "base_currency", "quote_currency", "rate", "rate_type", "effective_at", "source",
+	"created_at", "updated_at", "created_by", "updated_by", "version",
+}
+
+// rateRow returns a result set with one stored rate
+func rateRow(base, quote, rate string, effectiveAt time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows(rateColumnNames).AddRow(
+		uuid.New().String(), base, quote, rate, "spot", effectiveAt, "ECB",
+		effectiveAt, effectiveAt, "importer", "importer", 1,
+	)
+}
+
+// expectActive expects the active currency check to find the given codes
+func expectActive(mock sqlmock.Sqlmock, checked []string, active ...string) {
+	rows := sqlmock.NewRows([]string{"code"})
+	for _, code := range active {
+		rows.AddRow(code)
+	}
+	mock.ExpectQuery("SELECT code FROM treasury.currencies").
+		WithArgs(pq.Array(checked)).
+		WillReturnRows(rows)
+}
+
+// TestNewManager tests the creation of a new Manager
+func TestNewManager(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	manager := NewManager(db, testCursors, "USD")
+	assert.NotNil(t, manager)
+	assert.Equal(t, db, manager.db)
+	assert.Equal(t, "USD", manager.pivotCurrency)
+}
+
+// TestUpsertRates tests the UpsertRates method
+// Spec: docs/specs/005-exchange-rates.md#story-1-upsert-rates
+func TestUpsertRates(t *testing.T) {
+	effectiveAt := time.Date(2025, 9, 30, 16, 0, 0, 0, time.UTC)
+	input := func() *pb.ExchangeRateInput {
+		return &pb.ExchangeRateInput{
+			BaseCurrency:  "EUR",
+			QuoteCurrency: "USD",
+			Rate:          "1.0850",
+			RateType:      pb.RateType_RATE_TYPE_SPOT,
+			EffectiveAt:   timestamppb.New(effectiveAt),
+			Source:        "ECB",
+		}
+	}
+	returned := func(rate string, version int32, inserted bool) *sqlmock.Rows {
+		return sqlmock.NewRows(append(append([]string{}, rateColumnNames...), "inserted")).AddRow(
+			uuid.New().String(), "EUR", "USD", rate, "spot", effectiveAt, "ECB",
+			effectiveAt, effectiveAt, "importer", "importer", version, inserted,
+		)
+	}
+
+	tests := []struct {
+		name      string
+		request   *pb.UpsertRatesRequest
+		setupMock func(sqlmock.Sqlmock)
+		wantErr   string
+		errCode   codes.Code
+		validate  func(*testing.T, *pb.UpsertRatesResponse)
+	}{
+		{
+			name: "creates and updates rates",
+			request: &pb.UpsertRatesRequest{
+				Rates:     []*pb.ExchangeRateInput{input(), input()},
+				UpdatedBy: "importer",
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectActive(mock, []string{"EUR", "USD"}, "EUR", "USD")
+				mock.ExpectQuery("INSERT INTO treasury.exchange_rates").
+					WithArgs(sqlmock.AnyArg(), "EUR", "USD", "1.0850", "spot", effectiveAt, "ECB", "importer").
+					WillReturnRows(returned("1.085000000000", 1, true))
+				mock.ExpectQuery("INSERT INTO treasury.exchange_rates").
+					WillReturnRows(returned("1.085000000000", 2, false))
+				mock.ExpectCommit()
+			},
+			validate: func(t *testing.T, resp *pb.UpsertRatesResponse) {
+				assert.Equal(t, int32(1), resp.CreatedCount)
+				assert.Equal(t, int32(1), resp.UpdatedCount)
+				assert.Len(t, resp.Rates, 2)
+				assert.Equal(t, "1.085", resp.Rates[0].Rate)
+				assert.Equal(t, pb.RateType_RATE_TYPE_SPOT, resp.Rates[0].RateType)
+				assert.Equal(t, int32(2), resp.Rates[1].Version)
+			},
+		},
+		{
+			name:    "inactive currency",
+			request: &pb.UpsertRatesRequest{Rates: []*pb.ExchangeRateInput{input()}},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectActive(mock, []string{"EUR", "USD"}, "USD")
+				mock.ExpectRollback()
+			},
+			wantErr: "currency EUR is not active",
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "no rates",
+			request: &pb.UpsertRatesRequest{},
+			wantErr: "at least one rate is required",
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			manager := NewManager(db, testCursors, "USD")
+			resp, err := manager.UpsertRates(context.Background(), tt.request)
+
+			if tt.wantErr != "" {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.errCode, st.Code())
+				assert.Equal(t, tt.wantErr, st.Message())
+			} else {
+				require.NoError(t, err)
+				tt.validate(t, resp)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestUpsertRatesValidation tests that invalid rates are rejected before
+// anything is written
+// Spec: docs/specs/005-exchange-rates.md#story-1-upsert-rates
+func TestUpsertRatesValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*pb.ExchangeRateInput)
+		wantErr string
+	}{
+		{"invalid base", func(in *pb.ExchangeRateInput) { in.BaseCurrency = "eur" }, "rates[1]: invalid base_currency: must be 3 uppercase letters"},
+		{"same currencies", func(in *pb.ExchangeRateInput) { in.QuoteCurrency = "EUR" }, "rates[1]: base_currency and quote_currency must differ"},
+		{"inexact rate", func(in *pb.ExchangeRateInput) { in.Rate = "1.0850000000001" }, `rates[1]: invalid rate "1.0850000000001": must be a decimal with at most 12 decimal places`},
+		{"zero rate", func(in *pb.ExchangeRateInput) { in.Rate = "0.000" }, "rates[1]: rate must be positive"},
+		{"missing rate type", func(in *pb.ExchangeRateInput) { in.RateType = pb.RateType_RATE_TYPE_UNSPECIFIED }, "rates[1]: rate_type is required"},
+		{"missing effective_at", func(in *pb.ExchangeRateInput) { in.EffectiveAt = nil }, "rates[1]: effective_at is required"},
+		{"missing source", func(in *pb.ExchangeRateInput) { in.Source = "" }, "rates[1]: source is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			input := func() *pb.ExchangeRateInput {
+				return &pb.ExchangeRateInput{
+					BaseCurrency:  "EUR",
+					QuoteCurrency: "USD",
+					Rate:          "1.085",
+					RateType:      pb.RateType_RATE_TYPE_SPOT,
+					EffectiveAt:   timestamppb.Now(),
+					Source:        "ECB",
+				}
+			}
+			invalid := input()
+			tt.modify(invalid)
+
+			manager := NewManager(db, testCursors, "USD")
+			_, err = manager.UpsertRates(context.Background(), &pb.UpsertRatesRequest{
+				Rates: []*pb.ExchangeRateInput{input(), invalid},
+			})
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.Equal(t, tt.wantErr, st.Message())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestGetRate tests direct, inverse and triangulated lookups
+// Spec: docs/specs/005-exchange-rates.md#story-2-get-rate
+func TestGetRate(t *testing.T) {
+	asOf := time.Date(2025, 9, 30, 23, 59, 59, 0, time.UTC)
+	early := time.Date(2025, 9, 29, 16, 0, 0, 0, time.UTC)
+	late := time.Date(2025, 9, 30, 16, 0, 0, 0, time.UTC)
+	noRows := func() *sqlmock.Rows { return sqlmock.NewRows(rateColumnNames) }
+	lookup := `SELECT .* FROM treasury.exchange_rates\s+WHERE base_currency = \$1 AND quote_currency = \$2`
+
+	tests := []struct {
+		name      string
+		request   *pb.GetRateRequest
+		setupMock func(sqlmock.Sqlmock)
+		wantErr   string
+		errCode   codes.Code
+		validate  func(*testing.T, *pb.GetRateResponse)
+	}{
+		{
+			name:    "direct rate",
+			request: &pb.GetRateRequest{BaseCurrency: "EUR", QuoteCurrency: "USD", AsOf: timestamppb.New(asOf)},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectActive(mock, []string{"EUR", "USD"}, "EUR", "USD")
+				mock.ExpectQuery(lookup).
+					WithArgs("EUR", "USD", "spot", asOf).
+					WillReturnRows(rateRow("EUR", "USD", "1.085000000000", late))
+			},
+			validate: func(t *testing.T, resp *pb.GetRateResponse) {
+				assert.Equal(t, "1.085", resp.Rate)
+				assert.Equal(t, pb.RateDerivation_RATE_DERIVATION_DIRECT, resp.Derivation)
+				assert.Equal(t, pb.RateType_RATE_TYPE_SPOT, resp.RateType)
+				assert.Equal(t, late, resp.EffectiveAt.AsTime())
+				assert.Len(t, resp.Legs, 1)
+			},
+		},
+		{
+			name: "inverse rate from one source",
+			request: &pb.GetRateRequest{
+				BaseCurrency: "USD", QuoteCurrency: "EUR", AsOf: timestamppb.New(asOf),
+				RateType: pb.RateType_RATE_TYPE_CLOSING, Source: "ECB",
+			},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectActive(mock, []string{"EUR", "USD"}, "EUR", "USD")
+				mock.ExpectQuery(lookup+`.* AND source = \$5`).
+					WithArgs("USD", "EUR", "closing", asOf, "ECB").
+					WillReturnRows(noRows())
+				mock.ExpectQuery(lookup).
+					WithArgs("EUR", "USD", "closing", asOf, "ECB").
+					WillReturnRows(rateRow("EUR", "USD", "1.250000000000", late))
+			},
+			validate: func(t *testing.T, resp *pb.GetRateResponse) {
+				assert.Equal(t, "0.8", resp.Rate)
+				assert.Equal(t, pb.RateDerivation_RATE_DERIVATION_INVERSE, resp.Derivation)
+				assert.Equal(t, "EUR", resp.Legs[0].BaseCurrency)
+			},
+		},
+		{
+			name:    "triangulated through the pivot",
+			request: &pb.GetRateRequest{BaseCurrency: "EUR", QuoteCurrency: "JPY", AsOf: timestamppb.New(asOf)},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectActive(mock, []string{"EUR", "JPY"}, "EUR", "JPY")
+				mock.ExpectQuery(lookup).WithArgs("EUR", "JPY", "spot", asOf).WillReturnRows(noRows())
+				mock.ExpectQuery(lookup).WithArgs("JPY", "EUR", "spot", asOf).WillReturnRows(noRows())
+				mock.ExpectQuery(lookup).WithArgs("EUR", "USD", "spot", asOf).
+					WillReturnRows(rateRow("EUR", "USD", "1.085000000000", late))
+				mock.ExpectQuery(lookup).WithArgs("USD", "JPY", "spot", asOf).WillReturnRows(noRows())
+				mock.ExpectQuery(lookup).WithArgs("JPY", "USD", "spot", asOf).
+					WillReturnRows(rateRow("JPY", "USD", "0.006700000000", early))
+			},
+			validate: func(t *testing.T, resp *pb.GetRateResponse) {
+				// 1.085 / 0.0067 = 161.940298507462686...
+				assert.Equal(t, "161.940298507463", resp.Rate)
+				assert.Equal(t, pb.RateDerivation_RATE_DERIVATION_TRIANGULATED, resp.Derivation)
+				assert.Equal(t, "USD", resp.PivotCurrency)
+				assert.Len(t, resp.Legs, 2)
+				assert.Equal(t, early, resp.EffectiveAt.AsTime())
+			},
+		},
+		{
+			name:    "identity",
+			request: &pb.GetRateRequest{BaseCurrency: "EUR", QuoteCurrency: "EUR", AsOf: timestamppb.New(asOf)},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectActive(mock, []string{"EUR"}, "EUR")
+			},
+			validate: func(t *testing.T, resp *pb.GetRateResponse) {
+				assert.Equal(t, "1", resp.Rate)
+				assert.Equal(t, pb.RateDerivation_RATE_DERIVATION_IDENTITY, resp.Derivation)
+				assert.Empty(t, resp.Legs)
+			},
+		},
+		{
+			name:    "no rate",
+			request: &pb.GetRateRequest{BaseCurrency: "EUR", QuoteCurrency: "USD", AsOf: timestamppb.New(asOf)},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectActive(mock, []string{"EUR", "USD"}, "EUR", "USD")
+				mock.ExpectQuery(lookup).WithArgs("EUR", "USD", "spot", asOf).WillReturnRows(noRows())
+				mock.ExpectQuery(lookup).WithArgs("USD", "EUR", "spot", asOf).WillReturnRows(noRows())
+			},
+			wantErr: "no spot rate for EUR/USD at 2025-09-30T23:59:59Z",
+			errCode: codes.NotFound,
+		},
+		{
+			name:    "inactive currency",
+			request: &pb.GetRateRequest{BaseCurrency: "EUR", QuoteCurrency: "XAU", AsOf: timestamppb.New(asOf)},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectActive(mock, []string{"EUR", "XAU"}, "EUR")
+			},
+			wantErr: "currency XAU is not active",
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "invalid quote currency",
+			request: &pb.GetRateRequest{BaseCurrency: "EUR", QuoteCurrency: "US"},
+			wantErr: "invalid quote_currency: must be 3 uppercase letters",
+			errCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			manager := NewManager(db, testCursors, "USD")
+			resp, err := manager.GetRate(context.Background(), tt.request)
+
+			if tt.wantErr != "" {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.errCode, st.Code())
+				assert.Equal(t, tt.wantErr, st.Message())
+			} else {
+				require.NoError(t, err)
+				tt.validate(t, resp)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestListRates tests filtering and paging through rates
+// Spec: docs/specs/005-exchange-rates.md#story-3-list-rates
+func TestListRates(t *testing.T) {
+	newest := time.Date(2025, 9, 30, 16, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	manager := NewManager(db, testCursors, "USD")
+
+	// First page
+	rows := sqlmock.NewRows(rateColumnNames)
+	for i := 0; i < 3; i++ {
+		at := newest.AddDate(0, 0, -i)
+		rows.AddRow(uuid.New().String(), "EUR", "USD", "1.08", "spot", at, "ECB", at, at, nil, nil, 1)
+	}
+	mock.ExpectQuery(`SELECT .* FROM treasury.exchange_rates WHERE 1=1 AND base_currency = \$1 AND rate_type = \$2 ORDER BY effective_at DESC, id DESC LIMIT \$3`).
+		WithArgs("EUR", "spot", int32(3)).
+		WillReturnRows(rows)
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM treasury.exchange_rates WHERE 1=1 AND base_currency = \$1 AND rate_type = \$2`).
+		WithArgs("EUR", "spot").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	req := &pb.ListRatesRequest{BaseCurrency: "EUR", RateType: pb.RateType_RATE_TYPE_SPOT, PageSize: 2}
+	resp, err := manager.ListRates(ctx, req)
+	require.NoError(t, err)
+	assert.Len(t, resp.Rates, 2)
+	assert.Equal(t, int32(3), resp.TotalCount)
+	require.NotEmpty(t, resp.NextPageToken)
+	last := resp.Rates[1]
+
+	// Second page resumes after the last rate
+	mock.ExpectQuery(`SELECT .* FROM treasury.exchange_rates WHERE 1=1 AND base_currency = \$1 AND rate_type = \$2 AND \(effective_at, id\) < \(\$3, \$4\) ORDER BY effective_at DESC, id DESC LIMIT \$5`).
+		WithArgs("EUR", "spot", newest.AddDate(0, 0, -1), last.Id, int32(3)).
+		WillReturnRows(sqlmock.NewRows(rateColumnNames).
+			AddRow(uuid.New().String(), "EUR", "USD", "1.08", "spot", newest.AddDate(0, 0, -2), "ECB", newest, newest, nil, nil, 1))
+
+	req.PageToken = resp.NextPageToken
+	req.SkipTotalCount = true
+	resp, err = manager.ListRates(ctx, req)
+	require.NoError(t, err)
+	assert.Len(t, resp.Rates, 1)
+	assert.Empty(t, resp.NextPageToken)
+
+	// A token cannot be reused with different filters
+	req.BaseCurrency = "GBP"
+	req.PageToken = testCursors.Encode(ratesCursorScope, pagination.Filters{"base_currency": "EUR"}, newest.Format(time.RFC3339Nano), last.Id)
+	_, err = manager.ListRates(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestFormatRate tests rounding and trimming of derived rates
+func TestFormatRate(t *testing.T) {
+	assert.Equal(t, "0.333333333333", formatRate(big.NewRat(1, 3)))
+	assert.Equal(t, "0.666666666667", formatRate(big.NewRat(2, 3)))
+	assert.Equal(t, "2", formatRate(big.NewRat(4, 2)))
+	assert.Equal(t, "1.085", trimRate("1.085000000000"))
+	assert.Equal(t, "150", trimRate("150"))
+}
@@ -0,0 +1,57 @@
+package exchangerate
+
+import (
+	"context"
+	"log"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// Server implements the ExchangeRateService gRPC interface
+// Spec: docs/specs/005-exchange-rates.md
+type Server struct {
+	pb.UnimplementedExchangeRateServiceServer
+	manager *Manager
+}
+
+// NewServer creates a new exchange rate server instance
+// Spec: docs/specs/005-exchange-rates.md
+func NewServer(manager *Manager) *Server {
+	return &Server{
+		manager: manager,
+	}
+}
+
+// UpsertRates creates or corrects a batch of rates
+// Spec: docs/specs/005-exchange-rates.md#story-1-upsert-rates
+func (s *Server) UpsertRates(ctx context.Context, req *pb.UpsertRatesRequest) (*pb.UpsertRatesResponse, error) {
+	return s.manager.UpsertRates(ctx, req)
+}
+
+// GetRate returns the rate between two currencies at a point in time
+// Spec: docs/specs/005-exchange-rates.md#story-2-get-rate
+func (s *Server) GetRate(ctx context.Context, req *pb.GetRateRequest) (*pb.GetRateResponse, error) {
+	return s.manager.GetRate(ctx, req)
+}
+
+// ListRates lists stored rates
+// Spec: docs/specs/005-exchange-rates.md#story-3-list-rates
+func (s *Server) ListRates(ctx context.Context, req *pb.ListRatesRequest) (*pb.ListRatesResponse, error) {
+	return s.manager.ListRates(ctx, req)
+}
+
+// ImportRates imports an ECB XML or CSV rate file
+// Spec: docs/specs/005-exchange-rates.md#story-4-import-rate-files
+func (s *Server) ImportRates(ctx context.Context, req *pb.ImportRatesRequest) (*pb.ImportRatesResponse, error) {
+	log.Printf("Importing rates: file=%s, source=%s, size=%d", req.FileName, req.Source, len(req.Content))
+
+	resp, err := s.manager.ImportRates(ctx, req)
+	if err != nil {
+		log.Printf("Failed to import rates: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Imported rates for %v: created=%d, updated=%d, skipped=%d, rejected=%d",
+		resp.Dates, resp.CreatedCount, resp.UpdatedCount, resp.SkippedCount, len(resp.Errors))
+	return resp, nil
+}
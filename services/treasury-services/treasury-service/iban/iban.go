@@ -0,0 +1,212 @@
+// Package iban validates, parses and builds International Bank Account
+// Numbers (ISO 13616) using the per-country structures of the SWIFT IBAN
+// registry.
+// Spec: docs/specs/009-iban.md
+package iban
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IBAN is a parsed International Bank Account Number
+// Spec: docs/specs/009-iban.md#parsing
+type IBAN struct {
+	CountryCode   string
+	CheckDigits   string
+	BBAN          string
+	BankCode      string
+	BranchCode    string // Empty for countries without a branch identifier
+	AccountNumber string // The rest of the BBAN, in BBAN order
+}
+
+// Normalize removes the spaces of the print format and uppercases an IBAN
+func Normalize(s string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(s), ""))
+}
+
+// Parse validates an IBAN in print or electronic format and splits its
+// BBAN into bank code, branch code and account number
+// Spec: docs/specs/009-iban.md#parsing
+func Parse(s string) (*IBAN, error) {
+	s = Normalize(s)
+	if len(s) < 4 {
+		return nil, fmt.Errorf("IBAN is too short")
+	}
+
+	countryCode := s[:2]
+	country, ok := Lookup(countryCode)
+	if !ok {
+		return nil, fmt.Errorf("unsupported IBAN country %q", countryCode)
+	}
+	if len(s) != country.Length {
+		return nil, fmt.Errorf("%s IBAN must be %d characters, got %d", countryCode, country.Length, len(s))
+	}
+	if s[2] < '0' || s[2] > '9' || s[3] < '0' || s[3] > '9' {
+		return nil, fmt.Errorf("IBAN check digits must be digits")
+	}
+
+	bban := s[4:]
+	if !country.matches(bban, 0) {
+		return nil, fmt.Errorf("%s IBAN does not match the BBAN structure %s", countryCode, country.BBAN)
+	}
+	if mod97(bban+s[:4]) != 1 {
+		return nil, fmt.Errorf("invalid IBAN check digits")
+	}
+
+	return split(country, s[2:4], bban), nil
+}
+
+// Validate reports whether s is a valid IBAN
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// Build creates an IBAN from national components. The bank and branch
+// codes go to their registry positions and the account number fills the
+// other BBAN positions in order, including any national check digits.
+// A numeric account number shorter than its positions is padded with
+// leading zeros.
+// Spec: docs/specs/009-iban.md#building
+func Build(countryCode, bankCode, branchCode, accountNumber string) (*IBAN, error) {
+	country, ok := Lookup(countryCode)
+	if !ok {
+		return nil, fmt.Errorf("unsupported IBAN country %q", countryCode)
+	}
+	if err := country.ValidateBankCode(bankCode); err != nil {
+		return nil, err
+	}
+	if err := country.ValidateBranchCode(branchCode); err != nil {
+		return nil, err
+	}
+
+	accountNumber = Normalize(accountNumber)
+	accountLength := len(country.classes) - country.Bank.Length - country.Branch.Length
+	if len(accountNumber) < accountLength && isDigits(accountNumber) {
+		accountNumber = strings.Repeat("0", accountLength-len(accountNumber)) + accountNumber
+	}
+	if len(accountNumber) != accountLength {
+		return nil, fmt.Errorf("%s account number must be %d characters, got %d", countryCode, accountLength, len(accountNumber))
+	}
+
+	bban := make([]byte, 0, len(country.classes))
+	for i := 0; i < len(country.classes); {
+		switch {
+		case i == country.Bank.Offset:
+			bban = append(bban, bankCode...)
+			i += country.Bank.Length
+		case country.Branch.Length > 0 && i == country.Branch.Offset:
+			bban = append(bban, branchCode...)
+			i += country.Branch.Length
+		default:
+			bban = append(bban, accountNumber[0])
+			accountNumber = accountNumber[1:]
+			i++
+		}
+	}
+	if !country.matches(string(bban), 0) {
+		return nil, fmt.Errorf("%s account number does not match the BBAN structure %s", countryCode, country.BBAN)
+	}
+
+	checkDigits := fmt.Sprintf("%02d", 98-mod97(string(bban)+countryCode+"00"))
+	return split(country, checkDigits, string(bban)), nil
+}
+
+// ValidateBankCode checks a national bank code against the bank identifier
+// of the BBAN structure
+// Spec: docs/specs/009-iban.md#institution-codes
+func (c *Country) ValidateBankCode(bankCode string) error {
+	if len(bankCode) != c.Bank.Length || !c.matches(bankCode, c.Bank.Offset) {
+		return fmt.Errorf("%s bank code must match %s", c.Code, c.describe(c.Bank))
+	}
+	return nil
+}
+
+// ValidateBranchCode checks a national branch code against the branch
+// identifier of the BBAN structure. It must be empty for countries without
+// one.
+// Spec: docs/specs/009-iban.md#institution-codes
+func (c *Country) ValidateBranchCode(branchCode string) error {
+	if c.Branch.Length == 0 {
+		if branchCode != "" {
+			return fmt.Errorf("%s IBANs have no branch code", c.Code)
+		}
+		return nil
+	}
+	if len(branchCode) != c.Branch.Length || !c.matches(branchCode, c.Branch.Offset) {
+		return fmt.Errorf("%s branch code must match %s", c.Code, c.describe(c.Branch))
+	}
+	return nil
+}
+
+// String returns the IBAN in electronic format
+func (i *IBAN) String() string {
+	return i.CountryCode + i.CheckDigits + i.BBAN
+}
+
+// PrintFormat returns the IBAN in groups of four characters, e.g.
+// GB29 NWBK 6016 1331 9268 19
+func (i *IBAN) PrintFormat() string {
+	s := i.String()
+	var groups []string
+	for len(s) > 4 {
+		groups = append(groups, s[:4])
+		s = s[4:]
+	}
+	return strings.Join(append(groups, s), " ")
+}
+
+// split breaks a validated BBAN into its components
+func split(country *Country, checkDigits, bban string) *IBAN {
+	parsed := &IBAN{
+		CountryCode: country.Code,
+		CheckDigits: checkDigits,
+		BBAN:        bban,
+		BankCode:    bban[country.Bank.Offset : country.Bank.Offset+country.Bank.Length],
+	}
+	if country.Branch.Length > 0 {
+		parsed.BranchCode = bban[country.Branch.Offset : country.Branch.Offset+country.Branch.Length]
+	}
+
+	var account strings.Builder
+	for i := 0; i < len(bban); i++ {
+		inBank := i >= country.Bank.Offset && i < country.Bank.Offset+country.Bank.Length
+		inBranch := country.Branch.Length > 0 && i >= country.Branch.Offset && i < country.Branch.Offset+country.Branch.Length
+		if !inBank && !inBranch {
+			account.WriteByte(bban[i])
+		}
+	}
+	parsed.AccountNumber = account.String()
+	return parsed
+}
+
+// mod97 returns s modulo 97 with letters replaced by 10-35, the ISO 7064
+// MOD 97-10 check used by IBANs. s must hold only digits and uppercase
+// letters.
+func mod97(s string) int {
+	remainder := 0
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ch >= 'A' && ch <= 'Z' {
+			remainder = (remainder*100 + int(ch-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(ch-'0')) % 97
+		}
+	}
+	return remainder
+}
+
+// describe returns the registry notation of a span, e.g. "4!a"
+func (c *Country) describe(span Span) string {
+	return fmt.Sprintf("%d!%c", span.Length, c.classes[span.Offset])
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,115 @@
+package iban
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRegistry tests that every registry entry is consistent
+// Spec: docs/specs/009-iban.md#country-registry
+func TestRegistry(t *testing.T) {
+	for code, c := range countries {
+		assert.Equal(t, code, c.Code)
+		assert.Len(t, c.classes, c.Length-4, code)
+		assert.LessOrEqual(t, c.Bank.Offset+c.Bank.Length, len(c.classes), code)
+		assert.LessOrEqual(t, c.Branch.Offset+c.Branch.Length, len(c.classes), code)
+	}
+
+	_, err := parseStructure("4!a6n")
+	assert.Error(t, err)
+	_, err = parseStructure("4!x")
+	assert.Error(t, err)
+}
+
+// TestParse tests parsing registry example IBANs
+// Spec: docs/specs/009-iban.md#parsing
+func TestParse(t *testing.T) {
+	tests := []struct {
+		iban    string
+		bank    string
+		branch  string
+		account string
+	}{
+		{"GB29 NWBK 6016 1331 9268 19", "NWBK", "601613", "31926819"},
+		{"DE89370400440532013000", "37040044", "", "0532013000"},
+		{"FR1420041010050500013M02606", "20041", "01005", "0500013M02606"},
+		{"IT60X0542811101000000123456", "05428", "11101", "X000000123456"},
+		{"nl91abna0417164300", "ABNA", "", "0417164300"},
+		{"CH9300762011623852957", "00762", "", "011623852957"},
+		{"BE68539007547034", "539", "", "007547034"},
+		{"ES9121000418450200051332", "2100", "0418", "450200051332"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.iban, func(t *testing.T) {
+			parsed, err := Parse(tt.iban)
+			require.NoError(t, err)
+			assert.Equal(t, tt.bank, parsed.BankCode)
+			assert.Equal(t, tt.branch, parsed.BranchCode)
+			assert.Equal(t, tt.account, parsed.AccountNumber)
+			assert.Equal(t, Normalize(tt.iban), parsed.String())
+		})
+	}
+}
+
+// TestParseErrors tests rejecting invalid IBANs
+// Spec: docs/specs/009-iban.md#parsing
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		iban    string
+		wantErr string
+	}{
+		{"GB", "IBAN is too short"},
+		{"XX29NWBK60161331926819", `unsupported IBAN country "XX"`},
+		{"GB29NWBK6016133192681", "GB IBAN must be 22 characters, got 21"},
+		{"GBAANWBK60161331926819", "IBAN check digits must be digits"},
+		{"GB29NWBK6016133192681X", "GB IBAN does not match the BBAN structure 4!a6!n8!n"},
+		{"GB28NWBK60161331926819", "invalid IBAN check digits"},
+		{"GB29NWBK60161331926818", "invalid IBAN check digits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.iban, func(t *testing.T) {
+			assert.EqualError(t, Validate(tt.iban), tt.wantErr)
+		})
+	}
+}
+
+// TestBuild tests building IBANs from national components
+// Spec: docs/specs/009-iban.md#building
+func TestBuild(t *testing.T) {
+	built, err := Build("GB", "NWBK", "601613", "31926819")
+	require.NoError(t, err)
+	assert.Equal(t, "GB29NWBK60161331926819", built.String())
+	assert.Equal(t, "GB29 NWBK 6016 1331 9268 19", built.PrintFormat())
+
+	// Account numbers are padded with leading zeros
+	built, err = Build("DE", "37040044", "", "532013000")
+	require.NoError(t, err)
+	assert.Equal(t, "DE89370400440532013000", built.String())
+
+	// The national check character precedes the Italian bank code
+	built, err = Build("IT", "05428", "11101", "X000000123456")
+	require.NoError(t, err)
+	assert.Equal(t, "IT60X0542811101000000123456", built.String())
+
+	_, err = Build("DE", "3704004", "", "532013000")
+	assert.EqualError(t, err, "DE bank code must match 8!n")
+	_, err = Build("DE", "37040044", "100", "532013000")
+	assert.EqualError(t, err, "DE IBANs have no branch code")
+	_, err = Build("GB", "NWBK", "60-16-13", "31926819")
+	assert.EqualError(t, err, "GB branch code must match 6!n")
+	_, err = Build("GB", "NWBK", "601613", "3192681A")
+	assert.EqualError(t, err, "GB account number does not match the BBAN structure 4!a6!n8!n")
+	_, err = Build("US", "021000021", "", "123456")
+	assert.EqualError(t, err, `unsupported IBAN country "US"`)
+}
+
+// TestMod97 tests the ISO 7064 MOD 97-10 remainder
+func TestMod97(t *testing.T) {
+	assert.Equal(t, 1, mod97("NWBK60161331926819GB29"))
+	assert.Equal(t, 1, mod97("3214282912345698765432161182"))
+	assert.Equal(t, 0, mod97("97"))
+}
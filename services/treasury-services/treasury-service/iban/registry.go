@@ -0,0 +1,139 @@
+package iban
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Country is the IBAN structure of one country, as published in the SWIFT
+// IBAN registry
+// Spec: docs/specs/009-iban.md#country-registry
+type Country struct {
+	Code   string // ISO 3166-1 alpha-2 country code
+	Length int    // Length of the IBAN in electronic format
+	BBAN   string // BBAN structure in registry notation, e.g. "4!a6!n8!n"
+	Bank   Span   // Bank identifier position within the BBAN
+	Branch Span   // Branch identifier position within the BBAN, zero if none
+
+	classes []byte // Character class of each BBAN position: 'n', 'a' or 'c'
+}
+
+// Span is a position within the BBAN
+type Span struct {
+	Offset int
+	Length int
+}
+
+// countries is the IBAN registry for the countries treasury holds accounts
+// in. Bank and branch positions follow the registry; national check digits
+// are part of the account number.
+var countries = map[string]*Country{}
+
+func init() {
+	for _, c := range []*Country{
+		{Code: "AD", Length: 24, BBAN: "4!n4!n12!c", Bank: Span{0, 4}, Branch: Span{4, 4}},
+		{Code: "AE", Length: 23, BBAN: "3!n16!n", Bank: Span{0, 3}},
+		{Code: "AT", Length: 20, BBAN: "5!n11!n", Bank: Span{0, 5}},
+		{Code: "BE", Length: 16, BBAN: "3!n7!n2!n", Bank: Span{0, 3}},
+		{Code: "BG", Length: 22, BBAN: "4!a4!n2!n8!c", Bank: Span{0, 4}, Branch: Span{4, 4}},
+		{Code: "CH", Length: 21, BBAN: "5!n12!c", Bank: Span{0, 5}},
+		{Code: "CY", Length: 28, BBAN: "3!n5!n16!c", Bank: Span{0, 3}, Branch: Span{3, 5}},
+		{Code: "CZ", Length: 24, BBAN: "4!n6!n10!n", Bank: Span{0, 4}},
+		{Code: "DE", Length: 22, BBAN: "8!n10!n", Bank: Span{0, 8}},
+		{Code: "DK", Length: 18, BBAN: "4!n9!n1!n", Bank: Span{0, 4}},
+		{Code: "EE", Length: 20, BBAN: "2!n2!n11!n1!n", Bank: Span{0, 2}},
+		{Code: "ES", Length: 24, BBAN: "4!n4!n1!n1!n10!n", Bank: Span{0, 4}, Branch: Span{4, 4}},
+		{Code: "FI", Length: 18, BBAN: "3!n11!n", Bank: Span{0, 3}},
+		{Code: "FR", Length: 27, BBAN: "5!n5!n11!c2!n", Bank: Span{0, 5}, Branch: Span{5, 5}},
+		{Code: "GB", Length: 22, BBAN: "4!a6!n8!n", Bank: Span{0, 4}, Branch: Span{4, 6}},
+		{Code: "GI", Length: 23, BBAN: "4!a15!c", Bank: Span{0, 4}},
+		{Code: "GR", Length: 27, BBAN: "3!n4!n16!c", Bank: Span{0, 3}, Branch: Span{3, 4}},
+		{Code: "HR", Length: 21, BBAN: "7!n10!n", Bank: Span{0, 7}},
+		{Code: "HU", Length: 28, BBAN: "3!n4!n1!n15!n1!n", Bank: Span{0, 3}, Branch: Span{3, 4}},
+		{Code: "IE", Length: 22, BBAN: "4!a6!n8!n", Bank: Span{0, 4}, Branch: Span{4, 6}},
+		{Code: "IT", Length: 27, BBAN: "1!a5!n5!n12!c", Bank: Span{1, 5}, Branch: Span{6, 5}},
+		{Code: "LI", Length: 21, BBAN: "5!n12!c", Bank: Span{0, 5}},
+		{Code: "LT", Length: 20, BBAN: "5!n11!n", Bank: Span{0, 5}},
+		{Code: "LU", Length: 20, BBAN: "3!n13!c", Bank: Span{0, 3}},
+		{Code: "LV", Length: 21, BBAN: "4!a13!c", Bank: Span{0, 4}},
+		{Code: "MC", Length: 27, BBAN: "5!n5!n11!c2!n", Bank: Span{0, 5}, Branch: Span{5, 5}},
+		{Code: "MT", Length: 31, BBAN: "4!a5!n18!c", Bank: Span{0, 4}, Branch: Span{4, 5}},
+		{Code: "NL", Length: 18, BBAN: "4!a10!n", Bank: Span{0, 4}},
+		{Code: "NO", Length: 15, BBAN: "4!n6!n1!n", Bank: Span{0, 4}},
+		{Code: "PT", Length: 25, BBAN: "4!n4!n11!n2!n", Bank: Span{0, 4}, Branch: Span{4, 4}},
+		{Code: "RO", Length: 24, BBAN: "4!a16!c", Bank: Span{0, 4}},
+		{Code: "SA", Length: 24, BBAN: "2!n18!c", Bank: Span{0, 2}},
+		{Code: "SE", Length: 24, BBAN: "3!n16!n1!n", Bank: Span{0, 3}},
+		{Code: "SK", Length: 24, BBAN: "4!n6!n10!n", Bank: Span{0, 4}},
+		{Code: "SM", Length: 27, BBAN: "1!a5!n5!n12!c", Bank: Span{1, 5}, Branch: Span{6, 5}},
+		{Code: "TR", Length: 26, BBAN: "5!n1!n16!c", Bank: Span{0, 5}},
+	} {
+		classes, err := parseStructure(c.BBAN)
+		if err != nil {
+			panic(fmt.Sprintf("iban: %s: %v", c.Code, err))
+		}
+		if len(classes) != c.Length-4 {
+			panic(fmt.Sprintf("iban: %s: BBAN structure has %d positions, want %d", c.Code, len(classes), c.Length-4))
+		}
+		c.classes = classes
+		countries[c.Code] = c
+	}
+}
+
+// Lookup returns the IBAN structure of a country
+func Lookup(countryCode string) (*Country, bool) {
+	c, ok := countries[countryCode]
+	return c, ok
+}
+
+// parseStructure expands registry notation such as "4!a6!n" into one
+// character class per position
+func parseStructure(structure string) ([]byte, error) {
+	var classes []byte
+	for i := 0; i < len(structure); {
+		j := i
+		for j < len(structure) && structure[j] >= '0' && structure[j] <= '9' {
+			j++
+		}
+		if j == i || j+1 >= len(structure) || structure[j] != '!' {
+			return nil, fmt.Errorf("invalid BBAN structure %q", structure)
+		}
+		n, _ := strconv.Atoi(structure[i:j])
+		class := structure[j+1]
+		if class != 'n' && class != 'a' && class != 'c' {
+			return nil, fmt.Errorf("invalid character class %q in BBAN structure %q", class, structure)
+		}
+		for k := 0; k < n; k++ {
+			classes = append(classes, class)
+		}
+		i = j + 2
+	}
+	return classes, nil
+}
+
+// matches reports whether s fits the BBAN positions starting at offset
+func (c *Country) matches(s string, offset int) bool {
+	if offset+len(s) > len(c.classes) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		isDigit := ch >= '0' && ch <= '9'
+		isUpper := ch >= 'A' && ch <= 'Z'
+		switch c.classes[offset+i] {
+		case 'n':
+			if !isDigit {
+				return false
+			}
+		case 'a':
+			if !isUpper {
+				return false
+			}
+		default:
+			if !isDigit && !isUpper {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example.com/go-mono-repo/common/idempotency"
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// idempotentMethods are the mutating RPCs that honour the idempotency-key
+// header
+// Spec: docs/specs/006-idempotency-keys.md#covered-rpcs
+var idempotentMethods = []string{
+	pb.CurrencyService_CreateCurrency_FullMethodName,
+	pb.CurrencyService_UpdateCurrency_FullMethodName,
+	pb.CurrencyService_DeactivateCurrency_FullMethodName,
+	pb.CurrencyService_BulkCreateCurrencies_FullMethodName,
+	pb.CurrencyService_ScheduleCurrencyChange_FullMethodName,
+	pb.CurrencyService_CancelCurrencyChange_FullMethodName,
+	pb.FinancialInstitutionService_CreateInstitution_FullMethodName,
+	pb.FinancialInstitutionService_UpdateInstitution_FullMethodName,
+	pb.FinancialInstitutionService_DeleteInstitution_FullMethodName,
+	pb.FinancialInstitutionService_BulkCreateInstitutions_FullMethodName,
+	pb.FinancialInstitutionService_ImportRoutingDirectory_FullMethodName,
+	pb.FinancialInstitutionService_ImportBicDirectory_FullMethodName,
+	pb.ExchangeRateService_UpsertRates_FullMethodName,
+	pb.ExchangeRateService_ImportRates_FullMethodName,
+	pb.BankAccountService_CreateBankAccount_FullMethodName,
+	pb.BankAccountService_UpdateBankAccount_FullMethodName,
+	pb.BankAccountService_CloseBankAccount_FullMethodName,
+	pb.CalendarService_CreateCalendar_FullMethodName,
+	pb.CalendarService_UpdateCalendar_FullMethodName,
+	pb.CalendarService_SetSettlementCalendar_FullMethodName,
+}
+
+// IdempotencyStore stores idempotency keys in PostgreSQL
+// Spec: docs/specs/006-idempotency-keys.md#store-interface
+type IdempotencyStore struct {
+	db *sql.DB
+}
+
+// NewIdempotencyStore creates a new idempotency store
+func NewIdempotencyStore(db *sql.DB) *IdempotencyStore {
+	return &IdempotencyStore{db: db}
+}
+
+// Reserve claims a caller's key, replacing it if it has expired. PENDING
+// records are never replaced.
+func (s *IdempotencyStore) Reserve(ctx context.Context, rec *idempotency.Record) (*idempotency.Record, error) {
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO treasury.idempotency_keys (
+			caller, idempotency_key, method, request_hash, status, response, created_at, expires_at
+		) VALUES ($1, $2, $3, $4, $5, NULL, $6, $7)
+		ON CONFLICT (caller, idempotency_key) DO UPDATE SET
+			method = EXCLUDED.method,
+			request_hash = EXCLUDED.request_hash,
+			status = EXCLUDED.status,
+			response = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE treasury.idempotency_keys.expires_at <= EXCLUDED.created_at
+			AND treasury.idempotency_keys.status <> 'PENDING'`,
+		rec.Caller, rec.Key, rec.Method, rec.RequestHash, rec.Status, rec.CreatedAt, rec.ExpiresAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reserve idempotency key: %v", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reserve idempotency key: %v", err)
+	}
+	if rows == 1 {
+		return nil, nil
+	}
+
+	// The key is held by a PENDING or unexpired record
+	existing := &idempotency.Record{Caller: rec.Caller, Key: rec.Key}
+	err = s.db.QueryRowContext(ctx, `
+		SELECT method, request_hash, status, response, created_at, expires_at
+		FROM treasury.idempotency_keys
+		WHERE caller = $1 AND idempotency_key = $2`, rec.Caller, rec.Key).
+		Scan(&existing.Method, &existing.RequestHash, &existing.Status, &existing.Response,
+			&existing.CreatedAt, &existing.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Released by a failed request since the insert above
+		return nil, status.Errorf(codes.Aborted, "%s %q was released, retry", idempotency.MetadataKey, rec.Key)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get idempotency key: %v", err)
+	}
+
+	return existing, nil
+}
+
+// Complete stores the response of a reserved request
+func (s *IdempotencyStore) Complete(ctx context.Context, rec *idempotency.Record) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE treasury.idempotency_keys
+		SET status = $1, response = $2
+		WHERE caller = $3 AND idempotency_key = $4 AND request_hash = $5`,
+		rec.Status, rec.Response, rec.Caller, rec.Key, rec.RequestHash)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to store idempotent response: %v", err)
+	}
+	return nil
+}
+
+// Release removes the reservation of a failed request
+func (s *IdempotencyStore) Release(ctx context.Context, rec *idempotency.Record) error {
+	_, err := s.db.ExecContext(ctx, `
+		DELETE FROM treasury.idempotency_keys
+		WHERE caller = $1 AND idempotency_key = $2 AND status = $3`,
+		rec.Caller, rec.Key, idempotency.StatusPending)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to release idempotency key: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example.com/go-mono-repo/common/idempotency"
+)
+
+// TestIdempotencyStoreReserve tests claiming idempotency keys in PostgreSQL
+// Spec: docs/specs/006-idempotency-keys.md#store-interface
+func TestIdempotencyStoreReserve(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 9, 5, 10, 0, 0, 0, time.UTC)
+	rec := &idempotency.Record{
+		Caller:      "alice",
+		Key:         "key-1",
+		Method:      "/treasury.CurrencyService/CreateCurrency",
+		RequestHash: "hash-1",
+		Status:      idempotency.StatusPending,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(24 * time.Hour),
+	}
+
+	t.Run("new key", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT INTO treasury.idempotency_keys").
+			WithArgs(rec.Caller, rec.Key, rec.Method, rec.RequestHash, rec.Status, rec.CreatedAt, rec.ExpiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		existing, err := NewIdempotencyStore(db).Reserve(ctx, rec)
+
+		assert.NoError(t, err)
+		assert.Nil(t, existing)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("key already used", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT INTO treasury.idempotency_keys").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT method, request_hash, status, response").
+			WithArgs(rec.Caller, rec.Key).
+			WillReturnRows(sqlmock.NewRows([]string{"method", "request_hash", "status", "response", "created_at", "expires_at"}).
+				AddRow(rec.Method, "hash-1", idempotency.StatusCompleted, []byte("response"), now.Add(-time.Hour), now.Add(23*time.Hour)))
+
+		existing, err := NewIdempotencyStore(db).Reserve(ctx, rec)
+
+		require.NoError(t, err)
+		assert.Equal(t, idempotency.StatusCompleted, existing.Status)
+		assert.Equal(t, []byte("response"), existing.Response)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("expired pending key is kept", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`INSERT INTO treasury.idempotency_keys .* AND treasury.idempotency_keys.status <> 'PENDING'`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT method, request_hash, status, response").
+			WithArgs(rec.Caller, rec.Key).
+			WillReturnRows(sqlmock.NewRows([]string{"method", "request_hash", "status", "response", "created_at", "expires_at"}).
+				AddRow(rec.Method, "hash-1", idempotency.StatusPending, nil, now.Add(-25*time.Hour), now.Add(-time.Hour)))
+
+		existing, err := NewIdempotencyStore(db).Reserve(ctx, rec)
+
+		require.NoError(t, err)
+		assert.Equal(t, idempotency.StatusPending, existing.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("key released meanwhile", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT INTO treasury.idempotency_keys").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT method, request_hash, status, response").
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = NewIdempotencyStore(db).Reserve(ctx, rec)
+
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+}
+
+// TestIdempotencyStoreRelease tests that only pending keys are released
+// Spec: docs/specs/006-idempotency-keys.md#store-interface
+func TestIdempotencyStoreRelease(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("DELETE FROM treasury.idempotency_keys").
+		WithArgs("alice", "key-1", idempotency.StatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewIdempotencyStore(db).Release(context.Background(), &idempotency.Record{Caller: "alice", Key: "key-1"})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
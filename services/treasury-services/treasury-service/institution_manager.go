@@ -10,28 +10,37 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"example.com/go-mono-repo/common/pagination"
 	pb "example.com/go-mono-repo/proto/treasury"
+	"github.com/jamestroutman/treasury-service/bic"
+	"github.com/jamestroutman/treasury-service/iban"
 )
 
 // InstitutionManager handles financial institution database operations
 // Spec: docs/specs/004-financial-institutions.md
 type InstitutionManager struct {
-	db *sql.DB
+	db      *sql.DB
+	cursors *pagination.Codec
 }
 
 // NewInstitutionManager creates a new institution manager instance
 // Spec: docs/specs/004-financial-institutions.md
-func NewInstitutionManager(db *sql.DB) *InstitutionManager {
+func NewInstitutionManager(db *sql.DB, cursors *pagination.Codec) *InstitutionManager {
 	return &InstitutionManager{
-		db: db,
+		db:      db,
+		cursors: cursors,
 	}
 }
 
+// institutionsCursorScope binds ListInstitutions page tokens to that RPC
+const institutionsCursorScope = "treasury.institutions"
+
 var (
 	// SWIFT code validation regex (8 or 11 characters)
 	swiftCodeRegex = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
@@ -110,6 +119,25 @@ func (im *InstitutionManager) CreateInstitution(ctx context.Context, req *pb.Cre
 		}
 	}
 
+	// Validate a national bank code, and its branch code when one is given,
+	// against the IBAN structure of the country. Institutions without a
+	// bank code are created as before.
+	// Spec: docs/specs/009-iban.md#institution-codes
+	ibanPrefix := ""
+	if country, ok := iban.Lookup(req.CountryCode); ok {
+		ibanPrefix = country.Code
+		if req.BankCode != "" {
+			if err := country.ValidateBankCode(req.BankCode); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid bank code: %v", err)
+			}
+			if req.BranchCode != "" {
+				if err := country.ValidateBranchCode(req.BranchCode); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid bank code: %v", err)
+				}
+			}
+		}
+	}
+
 	// Validate routing numbers for US banks
 	if req.CountryCode == "US" && len(req.RoutingNumbers) > 0 {
 		for _, rn := range req.RoutingNumbers {
@@ -119,6 +147,14 @@ func (im *InstitutionManager) CreateInstitution(ctx context.Context, req *pb.Cre
 		}
 	}
 
+	// Validate holiday calendar if provided
+	// Spec: docs/specs/012-holiday-calendars.md#institution-calendars
+	if req.HolidayCalendar != "" {
+		if err := checkHolidayCalendar(ctx, im.db, req.HolidayCalendar); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check for duplicate code
 	var exists bool
 	err := im.db.QueryRowContext(ctx,
@@ -184,14 +220,14 @@ func (im *InstitutionManager) CreateInstitution(ctx context.Context, req *pb.Cre
 
 	err = tx.QueryRowContext(ctx, query,
 		institutionID, req.Code, req.Name, nullString(req.ShortName), nullString(req.SwiftCode),
-		nil, nullString(req.BankCode), nullString(req.BranchCode),
+		nullString(ibanPrefix), nullString(req.BankCode), nullString(req.BranchCode),
 		institutionTypeStr, req.CountryCode, nullString(req.PrimaryCurrency),
 		addressField(address, "street_address_1"), addressField(address, "street_address_2"),
 		addressField(address, "city"), addressField(address, "state_province"),
 		addressField(address, "postal_code"),
 		contactField(contact, "phone_number"), contactField(contact, "fax_number"),
 		contactField(contact, "email_address"), contactField(contact, "website_url"),
-		nullString(req.TimeZone), structToJSON(req.Capabilities), nil,
+		nullString(req.TimeZone), structToJSON(req.Capabilities), nullString(req.HolidayCalendar),
 		nil, nil, nil,
 		"active", true, now,
 		structToJSON(req.Capabilities), nullString(req.Notes), nil,
@@ -252,6 +288,7 @@ func (im *InstitutionManager) CreateInstitution(ctx context.Context, req *pb.Cre
 		ShortName:        req.ShortName,
 		RoutingNumbers:   routingNumbers,
 		SwiftCode:        req.SwiftCode,
+		IbanPrefix:       ibanPrefix,
 		BankCode:         req.BankCode,
 		BranchCode:       req.BranchCode,
 		InstitutionType:  req.InstitutionType,
@@ -317,6 +354,13 @@ func (im *InstitutionManager) GetInstitution(ctx context.Context, req *pb.GetIns
 		args = []interface{}{id.RoutingNumber}
 
 	case *pb.GetInstitutionRequest_SwiftCode:
+		// A branch BIC falls back to its head office, stored with 8
+		// characters or with the XXX branch code
+		// Spec: docs/specs/011-bic-directory.md#resolving-institutions
+		parsed, err := bic.Parse(id.SwiftCode)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid SWIFT code: %v", err)
+		}
 		query = `
 			SELECT i.id, i.code, i.name, i.short_name, i.swift_code,
 				i.iban_prefix, i.bank_code, i.branch_code,
@@ -329,8 +373,10 @@ func (im *InstitutionManager) GetInstitution(ctx context.Context, req *pb.GetIns
 				i.capabilities, i.notes, i.external_references,
 				i.created_at, i.updated_at, i.created_by, i.updated_by, i.version
 			FROM treasury.financial_institutions i
-			WHERE i.swift_code = $1 AND i.status != 'deleted'`
-		args = []interface{}{id.SwiftCode}
+			WHERE i.swift_code = ANY($1::text[]) AND i.status != 'deleted'
+			ORDER BY array_position($1::text[], i.swift_code::text)
+			LIMIT 1`
+		args = []interface{}{pq.Array(parsed.Candidates())}
 
 	case *pb.GetInstitutionRequest_Id:
 		query = `
@@ -348,6 +394,33 @@ func (im *InstitutionManager) GetInstitution(ctx context.Context, req *pb.GetIns
 			WHERE i.id = $1 AND i.status != 'deleted'`
 		args = []interface{}{id.Id}
 
+	case *pb.GetInstitutionRequest_Iban:
+		// Resolve the owning institution from the IBAN's bank and branch
+		// codes, preferring an institution registered for the branch
+		// Spec: docs/specs/009-iban.md#resolving-institutions
+		parsed, err := iban.Parse(id.Iban)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid IBAN: %v", err)
+		}
+		query = `
+			SELECT i.id, i.code, i.name, i.short_name, i.swift_code,
+				i.iban_prefix, i.bank_code, i.branch_code,
+				i.institution_type, i.country_code, i.primary_currency,
+				i.street_address_1, i.street_address_2, i.city, i.state_province, i.postal_code,
+				i.phone_number, i.fax_number, i.email_address, i.website_url,
+				i.time_zone, i.business_hours, i.holiday_calendar,
+				i.regulatory_id, i.tax_id, i.licenses,
+				i.status, i.is_active, i.activated_at, i.deactivated_at, i.suspension_reason,
+				i.capabilities, i.notes, i.external_references,
+				i.created_at, i.updated_at, i.created_by, i.updated_by, i.version
+			FROM treasury.financial_institutions i
+			WHERE i.country_code = $1 AND i.bank_code = $2
+				AND (i.branch_code IS NULL OR i.branch_code = $3 OR $3 = '')
+				AND i.status != 'deleted'
+			ORDER BY i.branch_code IS NULL, i.code
+			LIMIT 1`
+		args = []interface{}{parsed.CountryCode, parsed.BankCode, parsed.BranchCode}
+
 	default:
 		return nil, status.Error(codes.InvalidArgument, "identifier is required")
 	}
@@ -442,6 +515,15 @@ func (im *InstitutionManager) UpdateInstitution(ctx context.Context, req *pb.Upd
 				updateFields = append(updateFields, fmt.Sprintf("notes = $%d", argCount))
 				updateArgs = append(updateArgs, nullString(req.Notes))
 				argCount++
+			case "holiday_calendar":
+				if req.HolidayCalendar != "" {
+					if err := checkHolidayCalendar(ctx, tx, req.HolidayCalendar); err != nil {
+						return nil, err
+					}
+				}
+				updateFields = append(updateFields, fmt.Sprintf("holiday_calendar = $%d", argCount))
+				updateArgs = append(updateArgs, nullString(req.HolidayCalendar))
+				argCount++
 			case "capabilities":
 				updateFields = append(updateFields, fmt.Sprintf("capabilities = $%d", argCount))
 				updateArgs = append(updateArgs, structToJSON(req.Capabilities))
@@ -595,6 +677,10 @@ func (im *InstitutionManager) DeleteInstitution(ctx context.Context, req *pb.Del
 // ListInstitutions lists institutions with filtering
 // Spec: docs/specs/004-financial-institutions.md#story-2-query-financial-institution-information
 func (im *InstitutionManager) ListInstitutions(ctx context.Context, req *pb.ListInstitutionsRequest) (*pb.ListInstitutionsResponse, error) {
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	}
+
 	// Build query with filters
 	query := `
 		SELECT i.id, i.code, i.name, i.short_name, i.swift_code,
@@ -610,35 +696,40 @@ func (im *InstitutionManager) ListInstitutions(ctx context.Context, req *pb.List
 		FROM treasury.financial_institutions i
 		WHERE i.status != 'deleted'`
 
-	args := []interface{}{}
-	argCount := 1
-
 	// Apply filters
-	if req.Status != pb.InstitutionStatus_INSTITUTION_STATUS_UNSPECIFIED {
-		query += fmt.Sprintf(" AND i.status = $%d", argCount)
-		args = append(args, institutionStatusToString(req.Status))
-		argCount++
-	}
-
-	if req.InstitutionType != pb.InstitutionType_INSTITUTION_TYPE_UNSPECIFIED {
-		query += fmt.Sprintf(" AND i.institution_type = $%d", argCount)
-		args = append(args, institutionTypeToString(req.InstitutionType))
-		argCount++
-	}
-
-	if req.CountryCode != "" {
-		query += fmt.Sprintf(" AND i.country_code = $%d", argCount)
-		args = append(args, req.CountryCode)
-		argCount++
+	filterClause, filterArgs := institutionFilterClause(req)
+	query += filterClause
+	args := append([]interface{}{}, filterArgs...)
+	argCount := len(args) + 1
+
+	// Resume after the last institution of the previous page. Names are not
+	// unique, so the id breaks ties.
+	// Spec: docs/specs/005-cursor-pagination.md
+	cursorFilters := pagination.Filters{
+		"status":           req.Status.String(),
+		"institution_type": req.InstitutionType.String(),
+		"country_code":     req.CountryCode,
+	}
+	if req.IsActive {
+		cursorFilters["is_active"] = "true"
+	}
+	if req.PageToken != "" {
+		keys, err := im.cursors.Decode(req.PageToken, institutionsCursorScope, cursorFilters, 2)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		query += fmt.Sprintf(" AND (i.name, i.id) > ($%d, $%d)", argCount, argCount+1)
+		args = append(args, keys[0], keys[1])
+		argCount += 2
 	}
 
 	// Apply ordering
-	query += " ORDER BY i.name ASC"
+	query += " ORDER BY i.name ASC, i.id ASC"
 
-	// Apply pagination
+	// Apply pagination, fetching one extra row to tell whether another page follows
 	if req.PageSize > 0 {
 		query += fmt.Sprintf(" LIMIT $%d", argCount)
-		args = append(args, req.PageSize)
+		args = append(args, req.PageSize+1)
 		argCount++
 	}
 
@@ -651,7 +742,14 @@ func (im *InstitutionManager) ListInstitutions(ctx context.Context, req *pb.List
 
 	// Scan results
 	var institutions []*pb.FinancialInstitution
+	hasMore := false
 	for rows.Next() {
+		// The extra row only shows that another page follows
+		if req.PageSize > 0 && len(institutions) == int(req.PageSize) {
+			hasMore = true
+			break
+		}
+
 		institution, err := im.scanInstitutionFromRows(rows)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan institution: %v", err)
@@ -671,22 +769,62 @@ func (im *InstitutionManager) ListInstitutions(ctx context.Context, req *pb.List
 		return nil, status.Errorf(codes.Internal, "error iterating institutions: %v", err)
 	}
 
-	// Get total count
+	nextPageToken := ""
+	if hasMore {
+		last := institutions[len(institutions)-1]
+		nextPageToken = im.cursors.Encode(institutionsCursorScope, cursorFilters, last.Name, last.Id)
+	}
+
+	// Count total matching institutions with the same filters as the page
+	// Spec: docs/specs/005-cursor-pagination.md#total-count
 	var totalCount int32
-	countQuery := `
+	if !req.SkipTotalCount {
+		countQuery := `
 		SELECT COUNT(*) FROM treasury.financial_institutions i
-		WHERE i.status != 'deleted'`
-	err = im.db.QueryRowContext(ctx, countQuery).Scan(&totalCount)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get total count: %v", err)
+		WHERE i.status != 'deleted'` + filterClause
+		err = im.db.QueryRowContext(ctx, countQuery, filterArgs...).Scan(&totalCount)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get total count: %v", err)
+		}
 	}
 
 	return &pb.ListInstitutionsResponse{
-		Institutions: institutions,
-		TotalCount:   totalCount,
+		Institutions:  institutions,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
 	}, nil
 }
 
+// institutionFilterClause builds the WHERE conditions for the
+// ListInstitutions filters. The page query and the count query share it,
+// so total_count always matches the filters applied to the page.
+func institutionFilterClause(req *pb.ListInstitutionsRequest) (string, []interface{}) {
+	clause := ""
+	args := []interface{}{}
+
+	if req.Status != pb.InstitutionStatus_INSTITUTION_STATUS_UNSPECIFIED {
+		args = append(args, institutionStatusToString(req.Status))
+		clause += fmt.Sprintf(" AND i.status = $%d", len(args))
+	}
+
+	if req.InstitutionType != pb.InstitutionType_INSTITUTION_TYPE_UNSPECIFIED {
+		args = append(args, institutionTypeToString(req.InstitutionType))
+		clause += fmt.Sprintf(" AND i.institution_type = $%d", len(args))
+	}
+
+	if req.CountryCode != "" {
+		args = append(args, req.CountryCode)
+		clause += fmt.Sprintf(" AND i.country_code = $%d", len(args))
+	}
+
+	if req.IsActive {
+		args = append(args, req.IsActive)
+		clause += fmt.Sprintf(" AND i.is_active = $%d", len(args))
+	}
+
+	return clause, args
+}
+
 // CheckReferences checks for references to an institution
 // Spec: docs/specs/004-financial-institutions.md#story-4-deactivate-financial-institution
 func (im *InstitutionManager) CheckReferences(ctx context.Context, code string) ([]*pb.CheckInstitutionReferencesResponse_Reference, error) {
@@ -702,28 +840,49 @@ func (im *InstitutionManager) CheckReferences(ctx context.Context, code string)
 		return nil, err
 	}
 
-	// Check for references in known tables
-	// This is a placeholder - in a real system, you'd check actual referencing tables
+	// Check for references in known tables. Closed bank accounts keep
+	// their institution for the record and do not block a delete.
+	// Spec: docs/specs/008-bank-accounts.md#institution-references
 	var references []*pb.CheckInstitutionReferencesResponse_Reference
 
-	// Example: Check treasury_accounts table (when it exists)
-	// var count int32
-	// err = im.db.QueryRowContext(ctx,
-	//     "SELECT COUNT(*) FROM treasury.treasury_accounts WHERE institution_id = $1",
-	//     institutionID).Scan(&count)
-	// if err == nil && count > 0 {
-	//     references = append(references, &pb.CheckInstitutionReferencesResponse_Reference{
-	//         TableName:  "treasury_accounts",
-	//         ColumnName: "institution_id",
-	//         Count:      count,
-	//     })
-	// }
+	var count int32
+	err = im.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM treasury.bank_accounts WHERE institution_id = $1 AND status != 'closed'",
+		institutionID).Scan(&count)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		references = append(references, &pb.CheckInstitutionReferencesResponse_Reference{
+			TableName:  "bank_accounts",
+			ColumnName: "institution_id",
+			Count:      count,
+		})
+	}
 
 	return references, nil
 }
 
 // Helper functions
 
+// checkHolidayCalendar checks that a holiday calendar exists
+// Spec: docs/specs/012-holiday-calendars.md#institution-calendars
+func checkHolidayCalendar(ctx context.Context, q interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, code string) error {
+	var exists bool
+	err := q.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM treasury.holiday_calendars WHERE code = $1)",
+		code).Scan(&exists)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check holiday calendar: %v", err)
+	}
+	if !exists {
+		return status.Errorf(codes.InvalidArgument, "unknown holiday calendar %s", code)
+	}
+	return nil
+}
+
 // nullString creates a sql.NullString from a string
 func nullString(s string) sql.NullString {
 	if s == "" {
@@ -948,7 +1107,7 @@ func (im *InstitutionManager) scanInstitutionFromRows(rows *sql.Rows) (*pb.Finan
 func (im *InstitutionManager) loadRoutingNumbers(ctx context.Context, institutionID string) ([]*pb.RoutingNumber, error) {
 	query := `
 		SELECT id, routing_number, routing_type, is_primary, description,
-			created_at, updated_at
+			created_at, updated_at, removed_from_directory_at
 		FROM treasury.institution_routing_numbers
 		WHERE institution_id = $1
 		ORDER BY is_primary DESC, routing_number ASC`
@@ -965,10 +1124,11 @@ func (im *InstitutionManager) loadRoutingNumbers(ctx context.Context, institutio
 		var id uuid.UUID
 		var description sql.NullString
 		var createdAt, updatedAt time.Time
+		var removedAt sql.NullTime
 
 		err := rows.Scan(
 			&id, &rn.RoutingNumber, &rn.RoutingType, &rn.IsPrimary,
-			&description, &createdAt, &updatedAt,
+			&description, &createdAt, &updatedAt, &removedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -978,6 +1138,9 @@ func (im *InstitutionManager) loadRoutingNumbers(ctx context.Context, institutio
 		rn.Description = description.String
 		rn.CreatedAt = timestamppb.New(createdAt)
 		rn.UpdatedAt = timestamppb.New(updatedAt)
+		if removedAt.Valid {
+			rn.RemovedFromDirectoryAt = timestamppb.New(removedAt.Time)
+		}
 
 		routingNumbers = append(routingNumbers, &rn)
 	}
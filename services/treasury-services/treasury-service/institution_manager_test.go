@@ -1,8 +1,16 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	pb "example.com/go-mono-repo/proto/treasury"
 )
@@ -482,4 +490,278 @@ func TestNullStringHelper(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+// TestInstitutionFilterClause tests the filters shared by the ListInstitutions
+// page and count queries
+// Spec: docs/specs/005-cursor-pagination.md#total-count
+func TestInstitutionFilterClause(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *pb.ListInstitutionsRequest
+		wantClause string
+		wantArgs   int
+	}{
+		{
+			name:       "no filters",
+			req:        &pb.ListInstitutionsRequest{},
+			wantClause: "",
+			wantArgs:   0,
+		},
+		{
+			name: "type and country",
+			req: &pb.ListInstitutionsRequest{
+				InstitutionType: pb.InstitutionType_INSTITUTION_TYPE_BANK,
+				CountryCode:     "US",
+			},
+			wantClause: " AND i.institution_type = $1 AND i.country_code = $2",
+			wantArgs:   2,
+		},
+		{
+			name: "all filters",
+			req: &pb.ListInstitutionsRequest{
+				Status:          pb.InstitutionStatus_INSTITUTION_STATUS_ACTIVE,
+				InstitutionType: pb.InstitutionType_INSTITUTION_TYPE_BANK,
+				CountryCode:     "GB",
+				IsActive:        true,
+			},
+			wantClause: " AND i.status = $1 AND i.institution_type = $2 AND i.country_code = $3 AND i.is_active = $4",
+			wantArgs:   4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotClause, gotArgs := institutionFilterClause(tt.req)
+			if gotClause != tt.wantClause {
+				t.Errorf("institutionFilterClause() clause = %q, want %q", gotClause, tt.wantClause)
+			}
+			if len(gotArgs) != tt.wantArgs {
+				t.Errorf("institutionFilterClause() args = %v, want %d args", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+// TestDeleteInstitutionBlockedByBankAccounts tests that open bank accounts
+// are reported as blocking references
+// Spec: docs/specs/008-bank-accounts.md#institution-references
+func TestDeleteInstitutionBlockedByBankAccounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	institutionID := uuid.New()
+	mock.ExpectQuery("SELECT id FROM treasury.financial_institutions").
+		WithArgs("CHASE").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(institutionID))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM treasury.bank_accounts WHERE institution_id = \\$1 AND status != 'closed'").
+		WithArgs(institutionID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	manager := NewInstitutionManager(db, nil)
+	resp, err := manager.DeleteInstitution(context.Background(), &pb.DeleteInstitutionRequest{Code: "CHASE"})
+	if err != nil {
+		t.Fatalf("DeleteInstitution() error = %v", err)
+	}
+	if resp.Success {
+		t.Error("DeleteInstitution() succeeded, want blocked")
+	}
+	want := "bank_accounts.institution_id (2 references)"
+	if len(resp.BlockingReferences) != 1 || resp.BlockingReferences[0] != want {
+		t.Errorf("DeleteInstitution() blocking references = %v, want [%s]", resp.BlockingReferences, want)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+// TestGetInstitutionByIBAN tests resolving the owning institution of an IBAN
+// Spec: docs/specs/009-iban.md#resolving-institutions
+func TestGetInstitutionByIBAN(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	manager := NewInstitutionManager(db, nil)
+
+	_, err = manager.GetInstitution(context.Background(), &pb.GetInstitutionRequest{
+		Identifier: &pb.GetInstitutionRequest_Iban{Iban: "GB28NWBK60161331926819"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetInstitution() with bad check digits error = %v, want InvalidArgument", err)
+	}
+
+	mock.ExpectQuery("WHERE i.country_code = \\$1 AND i.bank_code = \\$2").
+		WithArgs("GB", "NWBK", "601613").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = manager.GetInstitution(context.Background(), &pb.GetInstitutionRequest{
+		Identifier: &pb.GetInstitutionRequest_Iban{Iban: "GB29 NWBK 6016 1331 9268 19"},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetInstitution() error = %v, want NotFound", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+// TestCreateInstitutionBankCode tests validating national bank codes
+// against the IBAN structure
+// Spec: docs/specs/009-iban.md#institution-codes
+func TestCreateInstitutionBankCode(t *testing.T) {
+	rejected := []struct {
+		name    string
+		country string
+		bank    string
+		branch  string
+		want    string
+	}{
+		{"malformed branch code", "GB", "NWBK", "60-16-13", "invalid bank code: GB branch code must match 6!n"},
+		{"malformed bank code", "DE", "3704004", "", "invalid bank code: DE bank code must match 8!n"},
+		{"branch code in country without branches", "NL", "ABNA", "0417", "invalid bank code: NL IBANs have no branch code"},
+	}
+
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := NewInstitutionManager(nil, nil)
+
+			_, err := manager.CreateInstitution(context.Background(), &pb.CreateInstitutionRequest{
+				Code:            "BANK",
+				Name:            "Bank",
+				CountryCode:     tt.country,
+				InstitutionType: pb.InstitutionType_INSTITUTION_TYPE_BANK,
+				BankCode:        tt.bank,
+				BranchCode:      tt.branch,
+			})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("CreateInstitution() error = %v, want InvalidArgument", err)
+			}
+			if status.Convert(err).Message() != tt.want {
+				t.Errorf("CreateInstitution() message = %q, want %q", status.Convert(err).Message(), tt.want)
+			}
+		})
+	}
+
+	accepted := []struct {
+		name    string
+		country string
+		bank    string
+		branch  string
+		prefix  string
+	}{
+		{"bank and branch code", "GB", "NWBK", "601613", "GB"},
+		{"bank code for all branches", "GB", "NWBK", "", "GB"},
+		{"no bank code", "GB", "", "60-16-13", "GB"},
+		{"country outside the registry", "US", "021000021", "", ""},
+	}
+
+	for _, tt := range accepted {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectQuery("SELECT EXISTS").
+				WithArgs("BANK").
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			mock.ExpectBegin()
+			mock.ExpectQuery("INSERT INTO treasury.financial_institutions").
+				WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(time.Now(), time.Now()))
+			mock.ExpectCommit()
+
+			manager := NewInstitutionManager(db, nil)
+			institution, err := manager.CreateInstitution(context.Background(), &pb.CreateInstitutionRequest{
+				Code:            "BANK",
+				Name:            "Bank",
+				CountryCode:     tt.country,
+				InstitutionType: pb.InstitutionType_INSTITUTION_TYPE_BANK,
+				BankCode:        tt.bank,
+				BranchCode:      tt.branch,
+			})
+			if err != nil {
+				t.Fatalf("CreateInstitution() error = %v", err)
+			}
+			if institution.IbanPrefix != tt.prefix {
+				t.Errorf("CreateInstitution() iban_prefix = %q, want %q", institution.IbanPrefix, tt.prefix)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestUpdateInstitutionExistingBankCode tests that institutions stored
+// before bank codes were checked can still be updated
+// Spec: docs/specs/009-iban.md#institution-codes
+func TestUpdateInstitutionExistingBankCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	institutionID := uuid.New()
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, version FROM treasury.financial_institutions").
+		WithArgs("NWBK").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version"}).AddRow(institutionID, 3))
+	mock.ExpectExec("UPDATE treasury.financial_institutions SET").
+		WithArgs("NatWest", "NWBK").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	columns := []string{
+		"id", "code", "name", "short_name", "swift_code",
+		"iban_prefix", "bank_code", "branch_code",
+		"institution_type", "country_code", "primary_currency",
+		"street_address_1", "street_address_2", "city", "state_province", "postal_code",
+		"phone_number", "fax_number", "email_address", "website_url",
+		"time_zone", "business_hours", "holiday_calendar",
+		"regulatory_id", "tax_id", "licenses",
+		"status", "is_active", "activated_at", "deactivated_at", "suspension_reason",
+		"capabilities", "notes", "external_references",
+		"created_at", "updated_at", "created_by", "updated_by", "version",
+	}
+	now := time.Now()
+	mock.ExpectQuery("WHERE i.code = \\$1").
+		WithArgs("NWBK").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			institutionID, "NWBK", "NatWest", nil, nil,
+			nil, "NWBK", "60-16-13",
+			"bank", "GB", nil,
+			nil, nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			nil, nil, nil,
+			nil, nil, nil,
+			"active", true, now, nil, nil,
+			nil, nil, nil,
+			now, now, "system", nil, 4,
+		))
+	mock.ExpectQuery("FROM treasury.institution_routing_numbers").
+		WithArgs(institutionID.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	manager := NewInstitutionManager(db, nil)
+	institution, err := manager.UpdateInstitution(context.Background(), &pb.UpdateInstitutionRequest{
+		Code:       "NWBK",
+		Name:       "NatWest",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateInstitution() error = %v", err)
+	}
+	if institution.Name != "NatWest" || institution.BranchCode != "60-16-13" {
+		t.Errorf("UpdateInstitution() = %s with branch code %q, want NatWest with 60-16-13", institution.Name, institution.BranchCode)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"log"
 
 	pb "example.com/go-mono-repo/proto/treasury"
 )
@@ -121,4 +122,39 @@ func (s *InstitutionServer) BulkCreateInstitutions(ctx context.Context, req *pb.
 		SkippedCount: skippedCount,
 		Errors:       errors,
 	}, nil
-}
\ No newline at end of file
+}
+
+// ImportRoutingDirectory imports a FedACH or Fedwire routing directory file
+// Spec: docs/specs/010-routing-directory.md
+func (s *InstitutionServer) ImportRoutingDirectory(ctx context.Context, req *pb.ImportRoutingDirectoryRequest) (*pb.ImportRoutingDirectoryResponse, error) {
+	log.Printf("Importing routing directory: file=%s, format=%s, size=%d, dry_run=%t",
+		req.FileName, req.Format, len(req.Content), req.DryRun)
+
+	resp, err := s.manager.ImportRoutingDirectory(ctx, req)
+	if err != nil {
+		log.Printf("Failed to import routing directory: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Imported %s routing directory: records=%d, created=%d, updated=%d, added=%d, removed=%d, restored=%d, rejected=%d, dry_run=%t",
+		resp.Format, resp.RecordCount, resp.InstitutionsCreated, resp.InstitutionsUpdated,
+		resp.RoutingNumbersAdded, resp.RoutingNumbersRemoved, resp.RoutingNumbersRestored, len(resp.Errors), resp.DryRun)
+	return resp, nil
+}
+
+// ImportBicDirectory imports a SWIFT BIC directory file
+// Spec: docs/specs/011-bic-directory.md
+func (s *InstitutionServer) ImportBicDirectory(ctx context.Context, req *pb.ImportBicDirectoryRequest) (*pb.ImportBicDirectoryResponse, error) {
+	log.Printf("Importing BIC directory: file=%s, format=%s, size=%d, dry_run=%t",
+		req.FileName, req.Format, len(req.Content), req.DryRun)
+
+	resp, err := s.manager.ImportBicDirectory(ctx, req)
+	if err != nil {
+		log.Printf("Failed to import BIC directory: %v", err)
+		return nil, err
+	}
+
+	log.Printf("Imported BIC directory: records=%d, created=%d, updated=%d, branches=%d, rejected=%d, dry_run=%t",
+		resp.RecordCount, resp.InstitutionsCreated, resp.InstitutionsUpdated, resp.BranchCount, len(resp.Errors), resp.DryRun)
+	return resp, nil
+}
@@ -10,8 +10,13 @@ import (
 	"syscall"
 	"time"
 
+	"example.com/go-mono-repo/common/idempotency"
+	"example.com/go-mono-repo/common/pagination"
 	"example.com/go-mono-repo/common/tracing"
+	"github.com/jamestroutman/treasury-service/bankaccount"
+	"github.com/jamestroutman/treasury-service/calendar"
 	"github.com/jamestroutman/treasury-service/currency"
+	"github.com/jamestroutman/treasury-service/exchangerate"
 	pb "example.com/go-mono-repo/proto/treasury"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -98,22 +103,57 @@ func main() {
 		}
 	}
 	
+	// Page tokens are signed so clients cannot forge or edit them
+	// Spec: docs/specs/005-cursor-pagination.md
+	if cfg.PageTokenSecret == "" {
+		log.Println("Warning: PAGE_TOKEN_SECRET not set, page tokens will not survive a restart")
+	}
+	cursors := pagination.NewCodec(cfg.PageTokenSecret)
+	
 	// Initialize currency server if database is available
 	// Spec: docs/specs/003-currency-management.md
 	var currencyServer *currency.Server
 	if dbManager.GetDB() != nil {
-		currencyManager := currency.NewManager(dbManager.GetDB())
+		currencyManager := currency.NewManager(dbManager.GetDB(), cursors)
 		currencyServer = currency.NewServer(currencyManager)
+		
+		// Apply scheduled currency changes as they fall due
+		// Spec: docs/specs/006-currency-history.md#applying-changes
+		go currencyManager.RunScheduledChanges(ctx, time.Duration(cfg.CurrencyChangeIntervalSeconds)*time.Second)
 	}
 	
 	// Initialize institution server if database is available
 	// Spec: docs/specs/004-financial-institutions.md
 	var institutionServer *InstitutionServer
 	if dbManager.GetDB() != nil {
-		institutionManager := NewInstitutionManager(dbManager.GetDB())
+		institutionManager := NewInstitutionManager(dbManager.GetDB(), cursors)
 		institutionServer = NewInstitutionServer(institutionManager)
 	}
 	
+	// Initialize exchange rate server if database is available
+	// Spec: docs/specs/005-exchange-rates.md
+	var exchangeRateServer *exchangerate.Server
+	if dbManager.GetDB() != nil {
+		exchangeRateManager := exchangerate.NewManager(dbManager.GetDB(), cursors, cfg.ExchangeRatePivotCurrency)
+		exchangeRateServer = exchangerate.NewServer(exchangeRateManager)
+	}
+	
+	// Initialize bank account server if database is available
+	// Spec: docs/specs/008-bank-accounts.md
+	var bankAccountServer *bankaccount.Server
+	if dbManager.GetDB() != nil {
+		bankAccountManager := bankaccount.NewManager(dbManager.GetDB(), cursors)
+		bankAccountServer = bankaccount.NewServer(bankAccountManager)
+	}
+	
+	// Initialize calendar server if database is available
+	// Spec: docs/specs/012-holiday-calendars.md
+	var calendarServer *calendar.Server
+	if dbManager.GetDB() != nil {
+		calendarManager := calendar.NewManager(dbManager.GetDB())
+		calendarServer = calendar.NewServer(calendarManager)
+	}
+	
 	// Create manifest server with cached data
 	// Spec: docs/specs/001-manifest.md
 	manifestServer := NewManifestServer(cfg, startTime)
@@ -148,6 +188,15 @@ func main() {
 		if institutionServer != nil {
 			fmt.Printf("Services: Financial Institutions\n")
 		}
+		if exchangeRateServer != nil {
+			fmt.Printf("Services: Exchange Rates (pivot %s)\n", cfg.ExchangeRatePivotCurrency)
+		}
+		if bankAccountServer != nil {
+			fmt.Printf("Services: Bank Accounts\n")
+		}
+		if calendarServer != nil {
+			fmt.Printf("Services: Holiday Calendars\n")
+		}
 	}
 	fmt.Println("=================================")
 	
@@ -159,10 +208,22 @@ func main() {
 	// Create gRPC server with tracing interceptors
 	// Spec: docs/specs/004-opentelemetry-tracing.md#3-service-integration-pattern
 	unaryInterceptor, streamInterceptor := tracing.NewServerInterceptors()
-	grpcServer := grpc.NewServer(
+	grpcOpts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(unaryInterceptor),
 		grpc.StreamInterceptor(streamInterceptor),
-	)
+	}
+	
+	// Replay retried mutations that carry an idempotency-key header
+	// Spec: docs/specs/006-idempotency-keys.md
+	if dbManager.GetDB() != nil {
+		idempotencyInterceptor := idempotency.NewInterceptor(
+			NewIdempotencyStore(dbManager.GetDB()),
+			time.Duration(cfg.IdempotencyKeyTTLHours)*time.Hour,
+			idempotentMethods...,
+		)
+		grpcOpts = append(grpcOpts, grpc.ChainUnaryInterceptor(idempotencyInterceptor.Unary()))
+	}
+	grpcServer := grpc.NewServer(grpcOpts...)
 	pb.RegisterManifestServer(grpcServer, manifestServer)
 	pb.RegisterHealthServer(grpcServer, healthServer)
 	
@@ -178,6 +239,24 @@ func main() {
 		pb.RegisterFinancialInstitutionServiceServer(grpcServer, institutionServer)
 	}
 	
+	// Register exchange rate service if available
+	// Spec: docs/specs/005-exchange-rates.md
+	if exchangeRateServer != nil {
+		pb.RegisterExchangeRateServiceServer(grpcServer, exchangeRateServer)
+	}
+	
+	// Register bank account service if available
+	// Spec: docs/specs/008-bank-accounts.md
+	if bankAccountServer != nil {
+		pb.RegisterBankAccountServiceServer(grpcServer, bankAccountServer)
+	}
+	
+	// Register calendar service if available
+	// Spec: docs/specs/012-holiday-calendars.md
+	if calendarServer != nil {
+		pb.RegisterCalendarServiceServer(grpcServer, calendarServer)
+	}
+	
 	// Mark gRPC as ready after registration
 	// Spec: docs/specs/003-health-check-liveness.md
 	healthServer.SetGRPCReady(true)
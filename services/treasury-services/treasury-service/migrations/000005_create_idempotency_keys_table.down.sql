@@ -0,0 +1,12 @@
+-- Migration: 000005_create_idempotency_keys_table.down.sql
+-- Spec: docs/specs/006-idempotency-keys.md
+
+BEGIN;
+
+-- Drop indexes
+DROP INDEX IF EXISTS treasury.idx_idempotency_keys_expires_at;
+
+-- Drop idempotency keys table
+DROP TABLE IF EXISTS treasury.idempotency_keys;
+
+COMMIT;
@@ -0,0 +1,22 @@
+-- Migration: 000005_create_idempotency_keys_table.up.sql
+-- Spec: docs/specs/006-idempotency-keys.md
+
+BEGIN;
+
+-- Create idempotency keys table
+CREATE TABLE IF NOT EXISTS treasury.idempotency_keys (
+    idempotency_key VARCHAR(255) PRIMARY KEY,  -- Client supplied idempotency-key header
+    method VARCHAR(255) NOT NULL,              -- Full gRPC method name
+    request_hash CHAR(64) NOT NULL,            -- SHA-256 of method and request body
+    status VARCHAR(20) NOT NULL,               -- PENDING or COMPLETED
+    response BYTEA,                            -- Encoded response, set when COMPLETED
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    CONSTRAINT chk_idempotency_keys_status CHECK (status IN ('PENDING', 'COMPLETED'))
+);
+
+-- Index for purging expired keys
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON treasury.idempotency_keys(expires_at);
+
+COMMIT;
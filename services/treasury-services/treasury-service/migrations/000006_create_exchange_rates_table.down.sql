@@ -0,0 +1,16 @@
+-- Migration: 000006_create_exchange_rates_table.down.sql
+-- Spec: docs/specs/005-exchange-rates.md
+
+BEGIN;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_exchange_rates_updated_at ON treasury.exchange_rates;
+
+-- Drop indexes
+DROP INDEX IF EXISTS treasury.idx_exchange_rates_effective_at;
+DROP INDEX IF EXISTS treasury.idx_exchange_rates_lookup;
+
+-- Drop exchange rates table
+DROP TABLE IF EXISTS treasury.exchange_rates;
+
+COMMIT;
@@ -0,0 +1,40 @@
+-- Migration: 000006_create_exchange_rates_table.up.sql
+-- Spec: docs/specs/005-exchange-rates.md
+
+BEGIN;
+
+-- Create exchange rates table
+CREATE TABLE IF NOT EXISTS treasury.exchange_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    base_currency CHAR(3) NOT NULL REFERENCES treasury.currencies(code),
+    quote_currency CHAR(3) NOT NULL REFERENCES treasury.currencies(code),
+    rate NUMERIC(30, 12) NOT NULL,             -- Units of quote currency per unit of base currency
+    rate_type VARCHAR(20) NOT NULL,            -- spot, average, closing
+    effective_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    source VARCHAR(50) NOT NULL,               -- Rate provider (ECB, FED, BLOOMBERG)
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255),
+    updated_by VARCHAR(255),
+    version INTEGER NOT NULL DEFAULT 1,
+
+    CONSTRAINT uk_exchange_rates UNIQUE (base_currency, quote_currency, rate_type, source, effective_at),
+    CONSTRAINT chk_exchange_rates_pair CHECK (base_currency <> quote_currency),
+    CONSTRAINT chk_exchange_rates_rate CHECK (rate > 0),
+    CONSTRAINT chk_exchange_rates_rate_type CHECK (rate_type IN ('spot', 'average', 'closing'))
+);
+
+-- Index for the latest rate of a pair at a point in time
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_lookup
+    ON treasury.exchange_rates(base_currency, quote_currency, rate_type, effective_at DESC);
+
+-- Index for listing rates newest first
+CREATE INDEX IF NOT EXISTS idx_exchange_rates_effective_at ON treasury.exchange_rates(effective_at DESC, id);
+
+-- Trigger for updated_at
+CREATE TRIGGER update_exchange_rates_updated_at
+    BEFORE UPDATE ON treasury.exchange_rates
+    FOR EACH ROW
+    EXECUTE FUNCTION treasury.update_updated_at_column();
+
+COMMIT;
@@ -0,0 +1,19 @@
+-- Migration: 000007_create_currency_history.down.sql
+-- Spec: docs/specs/006-currency-history.md
+
+BEGIN;
+
+-- Drop trigger and function
+DROP TRIGGER IF EXISTS record_currency_history ON treasury.currencies;
+DROP FUNCTION IF EXISTS treasury.record_currency_history();
+
+-- Drop indexes
+DROP INDEX IF EXISTS treasury.idx_currency_history_lookup;
+DROP INDEX IF EXISTS treasury.idx_currency_scheduled_changes_pending;
+DROP INDEX IF EXISTS treasury.uk_currency_scheduled_changes;
+
+-- Drop tables
+DROP TABLE IF EXISTS treasury.currency_history;
+DROP TABLE IF EXISTS treasury.currency_scheduled_changes;
+
+COMMIT;
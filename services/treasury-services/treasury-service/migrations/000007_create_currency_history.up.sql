@@ -0,0 +1,141 @@
+-- Migration: 000007_create_currency_history.up.sql
+-- Spec: docs/specs/006-currency-history.md
+
+BEGIN;
+
+-- Changes scheduled to apply to a currency at a future time
+CREATE TABLE IF NOT EXISTS treasury.currency_scheduled_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    currency_id UUID NOT NULL REFERENCES treasury.currencies(id),
+    code CHAR(3) NOT NULL,
+    effective_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- New values, NULL when the change leaves the field alone
+    name VARCHAR(100),
+    minor_units SMALLINT,
+    symbol VARCHAR(10),
+    status VARCHAR(20),
+
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255),
+    applied_at TIMESTAMP WITH TIME ZONE,
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    cancelled_by VARCHAR(255),
+
+    CONSTRAINT chk_currency_scheduled_changes_minor_units CHECK (minor_units IS NULL OR (minor_units >= 0 AND minor_units <= 8)),
+    CONSTRAINT chk_currency_scheduled_changes_status CHECK (status IS NULL OR status IN ('active', 'inactive', 'deprecated')),
+    CONSTRAINT chk_currency_scheduled_changes_fields CHECK (
+        name IS NOT NULL OR minor_units IS NOT NULL OR symbol IS NOT NULL OR status IS NOT NULL)
+);
+
+-- One live change per currency and time. Cancelled changes do not count.
+CREATE UNIQUE INDEX IF NOT EXISTS uk_currency_scheduled_changes
+    ON treasury.currency_scheduled_changes(currency_id, effective_at)
+    WHERE cancelled_at IS NULL;
+
+-- Index for changes waiting to be applied
+CREATE INDEX IF NOT EXISTS idx_currency_scheduled_changes_pending
+    ON treasury.currency_scheduled_changes(effective_at)
+    WHERE applied_at IS NULL AND cancelled_at IS NULL;
+
+-- One row per version of a currency, valid from valid_from until valid_to
+CREATE TABLE IF NOT EXISTS treasury.currency_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    currency_id UUID NOT NULL REFERENCES treasury.currencies(id),
+    code CHAR(3) NOT NULL,
+    version INTEGER NOT NULL,
+    change_type VARCHAR(20) NOT NULL,          -- created, updated, deactivated, scheduled
+    scheduled_change_id UUID REFERENCES treasury.currency_scheduled_changes(id),
+
+    -- Currency attributes as of this version
+    numeric_code CHAR(3),
+    name VARCHAR(100) NOT NULL,
+    minor_units SMALLINT NOT NULL,
+    symbol VARCHAR(10),
+    symbol_position VARCHAR(10),
+    country_codes TEXT[],
+    is_active BOOLEAN NOT NULL,
+    is_crypto BOOLEAN NOT NULL,
+    status VARCHAR(20) NOT NULL,
+
+    valid_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    valid_to TIMESTAMP WITH TIME ZONE,         -- NULL for the current version
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    changed_by VARCHAR(255),
+
+    CONSTRAINT chk_currency_history_change_type CHECK (change_type IN ('created', 'updated', 'deactivated', 'scheduled'))
+);
+
+-- Index for the version valid at a point in time
+CREATE INDEX IF NOT EXISTS idx_currency_history_lookup
+    ON treasury.currency_history(currency_id, valid_from DESC);
+
+-- Record every insert and update of a currency. A scheduled change sets
+-- treasury.scheduled_change_id and treasury.change_effective_at for its
+-- transaction so the version starts at the scheduled time.
+CREATE OR REPLACE FUNCTION treasury.record_currency_history()
+RETURNS TRIGGER AS $$
+DECLARE
+    change_id UUID := NULLIF(current_setting('treasury.scheduled_change_id', true), '')::UUID;
+    effective_at TIMESTAMP WITH TIME ZONE := COALESCE(
+        NULLIF(current_setting('treasury.change_effective_at', true), '')::TIMESTAMP WITH TIME ZONE,
+        CURRENT_TIMESTAMP);
+    previous_from TIMESTAMP WITH TIME ZONE;
+    kind VARCHAR(20);
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        kind := 'created';
+        effective_at := COALESCE(NEW.activated_at, NEW.created_at, CURRENT_TIMESTAMP);
+    ELSIF change_id IS NOT NULL THEN
+        kind := 'scheduled';
+    ELSIF OLD.is_active AND NOT NEW.is_active THEN
+        kind := 'deactivated';
+    ELSE
+        kind := 'updated';
+    END IF;
+
+    -- A version never starts before the one it replaces
+    SELECT valid_from INTO previous_from
+    FROM treasury.currency_history
+    WHERE currency_id = NEW.id AND valid_to IS NULL;
+    IF previous_from IS NOT NULL AND previous_from > effective_at THEN
+        effective_at := previous_from;
+    END IF;
+
+    UPDATE treasury.currency_history
+    SET valid_to = effective_at
+    WHERE currency_id = NEW.id AND valid_to IS NULL;
+
+    INSERT INTO treasury.currency_history (
+        currency_id, code, version, change_type, scheduled_change_id,
+        numeric_code, name, minor_units, symbol, symbol_position, country_codes,
+        is_active, is_crypto, status, valid_from, changed_at, changed_by
+    ) VALUES (
+        NEW.id, NEW.code, NEW.version, kind, change_id,
+        NEW.numeric_code, NEW.name, NEW.minor_units, NEW.symbol, NEW.symbol_position, NEW.country_codes,
+        NEW.is_active, NEW.is_crypto, NEW.status, effective_at, CURRENT_TIMESTAMP,
+        COALESCE(NEW.updated_by, NEW.created_by)
+    );
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER record_currency_history
+    AFTER INSERT OR UPDATE ON treasury.currencies
+    FOR EACH ROW
+    EXECUTE FUNCTION treasury.record_currency_history();
+
+-- Existing currencies start their history at their current version
+INSERT INTO treasury.currency_history (
+    currency_id, code, version, change_type,
+    numeric_code, name, minor_units, symbol, symbol_position, country_codes,
+    is_active, is_crypto, status, valid_from, changed_at, changed_by
+)
+SELECT id, code, version, 'created',
+    numeric_code, name, minor_units, symbol, symbol_position, country_codes,
+    is_active, is_crypto, status, COALESCE(activated_at, created_at), updated_at,
+    COALESCE(updated_by, created_by)
+FROM treasury.currencies;
+
+COMMIT;
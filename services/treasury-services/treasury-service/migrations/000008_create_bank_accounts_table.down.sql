@@ -0,0 +1,18 @@
+-- Migration: 000008_create_bank_accounts_table.down.sql
+-- Spec: docs/specs/008-bank-accounts.md
+
+BEGIN;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_bank_accounts_updated_at ON treasury.bank_accounts;
+
+-- Drop indexes
+DROP INDEX IF EXISTS treasury.idx_bank_accounts_created_at;
+DROP INDEX IF EXISTS treasury.idx_bank_accounts_currency;
+DROP INDEX IF EXISTS treasury.idx_bank_accounts_institution;
+DROP INDEX IF EXISTS treasury.uk_bank_accounts_ledger_account;
+
+-- Drop bank accounts table
+DROP TABLE IF EXISTS treasury.bank_accounts;
+
+COMMIT;
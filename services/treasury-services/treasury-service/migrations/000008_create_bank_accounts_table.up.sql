@@ -0,0 +1,49 @@
+-- Migration: 000008_create_bank_accounts_table.up.sql
+-- Spec: docs/specs/008-bank-accounts.md
+
+BEGIN;
+
+-- Create bank accounts table for the company's own accounts
+CREATE TABLE IF NOT EXISTS treasury.bank_accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    institution_id UUID NOT NULL REFERENCES treasury.financial_institutions(id),
+    account_name VARCHAR(200) NOT NULL,
+    account_number VARCHAR(34) NOT NULL,       -- Stored in full, masked in responses
+    iban VARCHAR(34),
+    currency_code CHAR(3) NOT NULL REFERENCES treasury.currencies(code),
+    purpose VARCHAR(20) NOT NULL,              -- operating, payroll, collections, disbursement, reserve, escrow
+    signatories JSONB NOT NULL DEFAULT '[]',   -- [{"name", "email", "title"}]
+    ledger_account_external_id VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    close_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255),
+    updated_by VARCHAR(255),
+    version INTEGER NOT NULL DEFAULT 1,
+
+    CONSTRAINT uk_bank_accounts_number UNIQUE (institution_id, account_number),
+    CONSTRAINT chk_bank_accounts_iban_format CHECK (iban IS NULL OR iban ~ '^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$'),
+    CONSTRAINT chk_bank_accounts_purpose CHECK (purpose IN ('operating', 'payroll', 'collections', 'disbursement', 'reserve', 'escrow')),
+    CONSTRAINT chk_bank_accounts_status CHECK (status IN ('active', 'closed'))
+);
+
+-- One open bank account per ledger cash account
+CREATE UNIQUE INDEX IF NOT EXISTS uk_bank_accounts_ledger_account
+    ON treasury.bank_accounts(ledger_account_external_id)
+    WHERE status != 'closed';
+
+-- Indexes for lookups and reference checks
+CREATE INDEX IF NOT EXISTS idx_bank_accounts_institution ON treasury.bank_accounts(institution_id) WHERE status != 'closed';
+CREATE INDEX IF NOT EXISTS idx_bank_accounts_currency ON treasury.bank_accounts(currency_code);
+CREATE INDEX IF NOT EXISTS idx_bank_accounts_created_at ON treasury.bank_accounts(created_at DESC, id);
+
+-- Trigger for updated_at
+CREATE TRIGGER update_bank_accounts_updated_at
+    BEFORE UPDATE ON treasury.bank_accounts
+    FOR EACH ROW
+    EXECUTE FUNCTION treasury.update_updated_at_column();
+
+COMMIT;
@@ -0,0 +1,9 @@
+-- Migration: 000009_add_routing_directory_status.down.sql
+-- Spec: docs/specs/010-routing-directory.md
+
+BEGIN;
+
+ALTER TABLE treasury.institution_routing_numbers
+    DROP COLUMN IF EXISTS removed_from_directory_at;
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- Migration: 000009_add_routing_directory_status.up.sql
+-- Spec: docs/specs/010-routing-directory.md
+
+BEGIN;
+
+-- Routing numbers missing from the latest Federal Reserve directory import
+ALTER TABLE treasury.institution_routing_numbers
+    ADD COLUMN IF NOT EXISTS removed_from_directory_at TIMESTAMP WITH TIME ZONE;
+
+COMMIT;
@@ -0,0 +1,14 @@
+-- Migration: 000010_create_holiday_calendars.down.sql
+-- Spec: docs/specs/012-holiday-calendars.md
+
+BEGIN;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_holiday_calendars_updated_at ON treasury.holiday_calendars;
+
+-- Drop tables
+DROP TABLE IF EXISTS treasury.currency_settlement_calendars;
+DROP TABLE IF EXISTS treasury.holiday_rules;
+DROP TABLE IF EXISTS treasury.holiday_calendars;
+
+COMMIT;
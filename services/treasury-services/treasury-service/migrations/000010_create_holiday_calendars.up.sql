@@ -0,0 +1,132 @@
+-- Migration: 000010_create_holiday_calendars.up.sql
+-- Spec: docs/specs/012-holiday-calendars.md
+
+BEGIN;
+
+-- Named holiday calendars referenced by financial_institutions.holiday_calendar
+CREATE TABLE IF NOT EXISTS treasury.holiday_calendars (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(20) NOT NULL,                 -- US-FED, TARGET2, UK-BACS
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    weekend_days SMALLINT[] NOT NULL DEFAULT '{0,6}', -- 0 Sunday to 6 Saturday
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_by VARCHAR(255),
+    updated_by VARCHAR(255),
+    version INTEGER NOT NULL DEFAULT 1,
+
+    CONSTRAINT uk_holiday_calendars_code UNIQUE (code),
+    CONSTRAINT chk_holiday_calendars_code_format CHECK (code ~ '^[A-Z0-9]+(-[A-Z0-9]+)*$')
+);
+
+-- Holiday rules; the dates of a year are computed from them
+CREATE TABLE IF NOT EXISTS treasury.holiday_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    calendar_id UUID NOT NULL REFERENCES treasury.holiday_calendars(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    rule_type VARCHAR(20) NOT NULL,            -- fixed, nth_weekday, easter, date
+    month SMALLINT,                            -- fixed, nth_weekday
+    day SMALLINT,                              -- fixed
+    weekday SMALLINT,                          -- nth_weekday, 0 Sunday to 6 Saturday
+    nth SMALLINT,                              -- nth_weekday, 1 to 5 or -1 for the last
+    offset_days SMALLINT,                      -- easter, days from Easter Sunday
+    holiday_date DATE,                         -- date
+    observance VARCHAR(20) NOT NULL DEFAULT 'none', -- none, sunday_to_monday, nearest_weekday, next_weekday
+    from_year INTEGER,
+    to_year INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT chk_holiday_rules_type CHECK (rule_type IN ('fixed', 'nth_weekday', 'easter', 'date')),
+    CONSTRAINT chk_holiday_rules_observance CHECK (observance IN ('none', 'sunday_to_monday', 'nearest_weekday', 'next_weekday'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_holiday_rules_calendar ON treasury.holiday_rules(calendar_id);
+
+-- Settlement calendar of each currency
+CREATE TABLE IF NOT EXISTS treasury.currency_settlement_calendars (
+    currency_code CHAR(3) PRIMARY KEY REFERENCES treasury.currencies(code),
+    calendar_id UUID NOT NULL REFERENCES treasury.holiday_calendars(id),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_by VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS idx_currency_settlement_calendars_calendar ON treasury.currency_settlement_calendars(calendar_id);
+
+-- Trigger for updated_at
+CREATE TRIGGER update_holiday_calendars_updated_at
+    BEFORE UPDATE ON treasury.holiday_calendars
+    FOR EACH ROW
+    EXECUTE FUNCTION treasury.update_updated_at_column();
+
+-- Seed calendars
+INSERT INTO treasury.holiday_calendars (code, name, description, created_by) VALUES
+    ('US-FED', 'Federal Reserve', 'Federal Reserve Bank holidays; Fedwire and FedACH are closed', 'system'),
+    ('TARGET2', 'TARGET2', 'TARGET2 closing days for euro settlement', 'system'),
+    ('UK-BACS', 'Bacs', 'England and Wales bank holidays; Bacs and CHAPS are closed', 'system');
+
+-- Federal Reserve: a holiday on a Sunday is observed on Monday, one on a
+-- Saturday is not moved
+INSERT INTO treasury.holiday_rules (calendar_id, name, rule_type, month, day, weekday, nth, observance, from_year)
+SELECT c.id, r.name, r.rule_type, r.month, r.day, r.weekday, r.nth, r.observance, r.from_year
+FROM treasury.holiday_calendars c
+CROSS JOIN (VALUES
+    ('New Year''s Day', 'fixed', 1, 1, NULL, NULL, 'sunday_to_monday', NULL),
+    ('Birthday of Martin Luther King, Jr.', 'nth_weekday', 1, NULL, 1, 3, 'none', NULL),
+    ('Washington''s Birthday', 'nth_weekday', 2, NULL, 1, 3, 'none', NULL),
+    ('Memorial Day', 'nth_weekday', 5, NULL, 1, -1, 'none', NULL),
+    ('Juneteenth National Independence Day', 'fixed', 6, 19, NULL, NULL, 'sunday_to_monday', 2022),
+    ('Independence Day', 'fixed', 7, 4, NULL, NULL, 'sunday_to_monday', NULL),
+    ('Labor Day', 'nth_weekday', 9, NULL, 1, 1, 'none', NULL),
+    ('Columbus Day', 'nth_weekday', 10, NULL, 1, 2, 'none', NULL),
+    ('Veterans Day', 'fixed', 11, 11, NULL, NULL, 'sunday_to_monday', NULL),
+    ('Thanksgiving Day', 'nth_weekday', 11, NULL, 4, 4, 'none', NULL),
+    ('Christmas Day', 'fixed', 12, 25, NULL, NULL, 'sunday_to_monday', NULL)
+) AS r(name, rule_type, month, day, weekday, nth, observance, from_year)
+WHERE c.code = 'US-FED';
+
+-- TARGET2: closing days are not moved when they fall on a weekend
+INSERT INTO treasury.holiday_rules (calendar_id, name, rule_type, month, day, offset_days)
+SELECT c.id, r.name, r.rule_type, r.month, r.day, r.offset_days
+FROM treasury.holiday_calendars c
+CROSS JOIN (VALUES
+    ('New Year''s Day', 'fixed', 1, 1, NULL),
+    ('Good Friday', 'easter', NULL, NULL, -2),
+    ('Easter Monday', 'easter', NULL, NULL, 1),
+    ('Labour Day', 'fixed', 5, 1, NULL),
+    ('Christmas Day', 'fixed', 12, 25, NULL),
+    ('Christmas Holiday', 'fixed', 12, 26, NULL)
+) AS r(name, rule_type, month, day, offset_days)
+WHERE c.code = 'TARGET2';
+
+-- Bacs: a holiday on a weekend is substituted by the next weekday that is
+-- not already a holiday
+INSERT INTO treasury.holiday_rules (calendar_id, name, rule_type, month, day, weekday, nth, offset_days, holiday_date, observance)
+SELECT c.id, r.name, r.rule_type, r.month, r.day, r.weekday, r.nth, r.offset_days, r.holiday_date::DATE, r.observance
+FROM treasury.holiday_calendars c
+CROSS JOIN (VALUES
+    ('New Year''s Day', 'fixed', 1, 1, NULL, NULL, NULL, NULL, 'next_weekday'),
+    ('Good Friday', 'easter', NULL, NULL, NULL, NULL, -2, NULL, 'none'),
+    ('Easter Monday', 'easter', NULL, NULL, NULL, NULL, 1, NULL, 'none'),
+    ('Early May Bank Holiday', 'nth_weekday', 5, NULL, 1, 1, NULL, NULL, 'none'),
+    ('Spring Bank Holiday', 'nth_weekday', 5, NULL, 1, -1, NULL, NULL, 'none'),
+    ('Summer Bank Holiday', 'nth_weekday', 8, NULL, 1, -1, NULL, NULL, 'none'),
+    ('Christmas Day', 'fixed', 12, 25, NULL, NULL, NULL, NULL, 'next_weekday'),
+    ('Boxing Day', 'fixed', 12, 26, NULL, NULL, NULL, NULL, 'next_weekday'),
+    ('State Funeral of Queen Elizabeth II', 'date', NULL, NULL, NULL, NULL, NULL, '2022-09-19', 'none'),
+    ('Coronation of King Charles III', 'date', NULL, NULL, NULL, NULL, NULL, '2023-05-08', 'none')
+) AS r(name, rule_type, month, day, weekday, nth, offset_days, holiday_date, observance)
+WHERE c.code = 'UK-BACS';
+
+-- Settlement calendars of the seeded currencies
+INSERT INTO treasury.currency_settlement_calendars (currency_code, calendar_id, updated_by)
+SELECT cu.code, c.id, 'system'
+FROM treasury.currencies cu
+JOIN treasury.holiday_calendars c ON c.code = CASE cu.code
+    WHEN 'USD' THEN 'US-FED'
+    WHEN 'EUR' THEN 'TARGET2'
+    WHEN 'GBP' THEN 'UK-BACS'
+END;
+
+COMMIT;
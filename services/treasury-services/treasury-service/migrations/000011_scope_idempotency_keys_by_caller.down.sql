@@ -0,0 +1,18 @@
+-- Migration: 000011_scope_idempotency_keys_by_caller.down.sql
+-- Spec: docs/specs/006-idempotency-keys.md
+
+BEGIN;
+
+-- Keys of named callers may collide once the caller is dropped
+DELETE FROM treasury.idempotency_keys WHERE caller <> 'anonymous';
+
+ALTER TABLE treasury.idempotency_keys
+    DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+
+ALTER TABLE treasury.idempotency_keys
+    ADD CONSTRAINT idempotency_keys_pkey PRIMARY KEY (idempotency_key);
+
+ALTER TABLE treasury.idempotency_keys
+    DROP COLUMN IF EXISTS caller;
+
+COMMIT;
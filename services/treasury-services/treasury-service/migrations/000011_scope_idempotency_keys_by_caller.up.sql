@@ -0,0 +1,16 @@
+-- Migration: 000011_scope_idempotency_keys_by_caller.up.sql
+-- Spec: docs/specs/006-idempotency-keys.md
+
+BEGIN;
+
+-- Keys are unique per caller identity (x-user-id header)
+ALTER TABLE treasury.idempotency_keys
+    ADD COLUMN IF NOT EXISTS caller VARCHAR(255) NOT NULL DEFAULT 'anonymous';
+
+ALTER TABLE treasury.idempotency_keys
+    DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+
+ALTER TABLE treasury.idempotency_keys
+    ADD CONSTRAINT idempotency_keys_pkey PRIMARY KEY (caller, idempotency_key);
+
+COMMIT;
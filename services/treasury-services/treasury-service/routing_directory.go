@@ -0,0 +1,592 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// Record lengths of the Federal Reserve E-Payments routing directory files
+// Spec: docs/specs/010-routing-directory.md#file-formats
+const (
+	fedACHRecordLength  = 155
+	fedACHMinimumLength = 150 // Records without the trailing filler
+	fedwireRecordLength = 101
+)
+
+// directoryCodePrefix starts the code of every institution created by the
+// directory import; the routing number follows, e.g. ABA021000021
+const directoryCodePrefix = "ABA"
+
+// maxDirectoryRecords is the largest number of records accepted in one file
+const maxDirectoryRecords = 100000
+
+// DirectoryEntry is one routing number read from a Federal Reserve routing
+// directory file
+// Spec: docs/specs/010-routing-directory.md#file-formats
+type DirectoryEntry struct {
+	Line          int
+	RoutingNumber string
+	Name          string
+	ShortName     string // Fedwire telegraphic name
+	Address       string // FedACH only
+	City          string
+	State         string
+	PostalCode    string // FedACH only
+	Phone         string // FedACH only
+}
+
+// DetectDirectoryFormat returns the directory format from the length of
+// the first record
+// Spec: docs/specs/010-routing-directory.md#file-formats
+func DetectDirectoryFormat(content []byte) (pb.RoutingDirectoryFormat, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		switch {
+		case len(line) >= fedACHMinimumLength && len(line) <= fedACHRecordLength:
+			return pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDACH, nil
+		case len(line) == fedwireRecordLength:
+			return pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDWIRE, nil
+		default:
+			return 0, fmt.Errorf("cannot detect directory format from a %d-character record", len(line))
+		}
+	}
+	return 0, fmt.Errorf("directory file is empty")
+}
+
+// ParseRoutingDirectory reads a FedACH or Fedwire directory file. Lines
+// that cannot be read are returned as errors and the rest are kept. The
+// skipped count is the number of Fedwire records not eligible for funds
+// transfers.
+// Spec: docs/specs/010-routing-directory.md#file-formats
+func ParseRoutingDirectory(format pb.RoutingDirectoryFormat, r io.Reader) ([]*DirectoryEntry, int, []string, error) {
+	recordLength := fedACHRecordLength
+	if format == pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDWIRE {
+		recordLength = fedwireRecordLength
+	}
+
+	entries := []*DirectoryEntry{}
+	errors := []string{}
+	skipped := 0
+	seen := map[string]int{}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(line) > recordLength {
+			errors = append(errors, fmt.Sprintf("line %d: record must be %d characters, got %d", lineNumber, recordLength, len(line)))
+			continue
+		}
+		// Trailing blanks are often trimmed by downloads
+		line += strings.Repeat(" ", recordLength-len(line))
+
+		var entry *DirectoryEntry
+		if format == pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDWIRE {
+			entry = parseFedwireRecord(line)
+			if entry == nil {
+				skipped++
+				continue
+			}
+		} else {
+			entry = parseFedACHRecord(line)
+		}
+		entry.Line = lineNumber
+
+		if err := ValidateRoutingNumber(entry.RoutingNumber); err != nil {
+			errors = append(errors, fmt.Sprintf("line %d: invalid routing number %s: %v", lineNumber, entry.RoutingNumber, err))
+			continue
+		}
+		if entry.Name == "" {
+			errors = append(errors, fmt.Sprintf("line %d: customer name is required", lineNumber))
+			continue
+		}
+		if first, ok := seen[entry.RoutingNumber]; ok {
+			errors = append(errors, fmt.Sprintf("line %d: duplicate routing number %s, first on line %d", lineNumber, entry.RoutingNumber, first))
+			continue
+		}
+		seen[entry.RoutingNumber] = lineNumber
+		entries = append(entries, entry)
+		if len(entries) > maxDirectoryRecords {
+			return nil, 0, nil, fmt.Errorf("directory file has more than %d records", maxDirectoryRecords)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to read directory file: %w", err)
+	}
+	if len(entries) == 0 && len(errors) == 0 && skipped == 0 {
+		return nil, 0, nil, fmt.Errorf("directory file has no records")
+	}
+
+	return entries, skipped, errors, nil
+}
+
+// parseFedACHRecord reads a 155-character FedACH directory record
+func parseFedACHRecord(line string) *DirectoryEntry {
+	field := func(start, end int) string {
+		return strings.TrimSpace(line[start-1 : end])
+	}
+
+	postalCode := field(130, 134)
+	if extension := field(135, 138); extension != "" && extension != "0000" {
+		postalCode += "-" + extension
+	}
+
+	return &DirectoryEntry{
+		RoutingNumber: field(1, 9),
+		Name:          field(36, 71),
+		Address:       field(72, 107),
+		City:          field(108, 127),
+		State:         field(128, 129),
+		PostalCode:    postalCode,
+		Phone:         field(139, 148),
+	}
+}
+
+// parseFedwireRecord reads a 101-character Fedwire directory record, or
+// returns nil when the institution cannot receive funds transfers
+func parseFedwireRecord(line string) *DirectoryEntry {
+	field := func(start, end int) string {
+		return strings.TrimSpace(line[start-1 : end])
+	}
+
+	if field(91, 91) != "Y" {
+		return nil
+	}
+
+	return &DirectoryEntry{
+		RoutingNumber: field(1, 9),
+		ShortName:     field(10, 27),
+		Name:          field(28, 63),
+		State:         field(64, 65),
+		City:          field(66, 90),
+	}
+}
+
+// directoryInstitution is the part of an institution the directory import
+// compares and updates
+type directoryInstitution struct {
+	ID         string
+	Code       string
+	Name       string
+	ShortName  string
+	Address    string
+	City       string
+	State      string
+	PostalCode string
+	Phone      string
+}
+
+// storedRouting is a stored routing number with its institution
+type storedRouting struct {
+	ID            string
+	RoutingNumber string
+	RoutingType   string
+	Removed       bool // removed_from_directory_at is set
+	Institution   *directoryInstitution
+}
+
+// directoryUpdate is an imported institution whose directory details changed
+type directoryUpdate struct {
+	Institution *directoryInstitution
+	Entry       *DirectoryEntry
+}
+
+// directoryAdd is a routing number to add to an existing institution
+type directoryAdd struct {
+	Institution *directoryInstitution
+	Entry       *DirectoryEntry
+}
+
+// directoryPlan is the set of changes that brings the stored routing
+// numbers of one type in line with a directory file
+// Spec: docs/specs/010-routing-directory.md#import-plan
+type directoryPlan struct {
+	Creates   []*DirectoryEntry
+	Updates   []*directoryUpdate
+	Adds      []*directoryAdd
+	Removed   []*storedRouting
+	Restored  []*storedRouting
+	Unchanged int
+	Changes   []*pb.RoutingDirectoryChange
+}
+
+// planRoutingDirectory compares directory entries with the stored routing
+// numbers. Unknown routing numbers get a new institution. A routing number
+// held by an institution without the directory's routing type gets that
+// type added. Only institutions created by the import have their name and
+// address updated; institutions maintained by hand keep theirs. Stored
+// routing numbers of the type that are missing from the file are flagged
+// when flagRemoved is set.
+// Spec: docs/specs/010-routing-directory.md#import-plan
+func planRoutingDirectory(routingType string, entries []*DirectoryEntry, stored []*storedRouting, flagRemoved bool) *directoryPlan {
+	byNumber := map[string][]*storedRouting{}
+	for _, routing := range stored {
+		byNumber[routing.RoutingNumber] = append(byNumber[routing.RoutingNumber], routing)
+	}
+
+	plan := &directoryPlan{}
+	inFile := map[string]bool{}
+	updated := map[string]bool{}
+	for _, entry := range entries {
+		inFile[entry.RoutingNumber] = true
+
+		holders := byNumber[entry.RoutingNumber]
+		if len(holders) == 0 {
+			plan.Creates = append(plan.Creates, entry)
+			plan.Changes = append(plan.Changes, &pb.RoutingDirectoryChange{
+				Type:            pb.RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION,
+				RoutingNumber:   entry.RoutingNumber,
+				InstitutionCode: directoryCodePrefix + entry.RoutingNumber,
+				Detail:          entry.Name,
+			})
+			continue
+		}
+
+		var sameType *storedRouting
+		for _, routing := range holders {
+			if routing.RoutingType == routingType {
+				sameType = routing
+				break
+			}
+		}
+		institution := holders[0].Institution
+		if sameType != nil {
+			institution = sameType.Institution
+		}
+
+		changed := false
+		switch {
+		case sameType == nil:
+			plan.Adds = append(plan.Adds, &directoryAdd{Institution: institution, Entry: entry})
+			plan.Changes = append(plan.Changes, &pb.RoutingDirectoryChange{
+				Type:            pb.RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_ADD_ROUTING_NUMBER,
+				RoutingNumber:   entry.RoutingNumber,
+				InstitutionCode: institution.Code,
+				Detail:          routingType,
+			})
+			changed = true
+		case sameType.Removed:
+			plan.Restored = append(plan.Restored, sameType)
+			plan.Changes = append(plan.Changes, &pb.RoutingDirectoryChange{
+				Type:            pb.RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_RESTORE,
+				RoutingNumber:   entry.RoutingNumber,
+				InstitutionCode: institution.Code,
+			})
+			changed = true
+		}
+
+		if institution.Code == directoryCodePrefix+entry.RoutingNumber && !updated[institution.ID] {
+			if diff := institutionDiff(institution, entry); len(diff) > 0 {
+				updated[institution.ID] = true
+				plan.Updates = append(plan.Updates, &directoryUpdate{Institution: institution, Entry: entry})
+				plan.Changes = append(plan.Changes, &pb.RoutingDirectoryChange{
+					Type:            pb.RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION,
+					RoutingNumber:   entry.RoutingNumber,
+					InstitutionCode: institution.Code,
+					Detail:          strings.Join(diff, ", "),
+				})
+				changed = true
+			}
+		}
+
+		if !changed {
+			plan.Unchanged++
+		}
+	}
+
+	if !flagRemoved {
+		return plan
+	}
+	for _, routing := range stored {
+		if routing.RoutingType != routingType || routing.Removed || inFile[routing.RoutingNumber] {
+			continue
+		}
+		plan.Removed = append(plan.Removed, routing)
+		plan.Changes = append(plan.Changes, &pb.RoutingDirectoryChange{
+			Type:            pb.RoutingDirectoryChangeType_ROUTING_DIRECTORY_CHANGE_TYPE_FLAG_REMOVED,
+			RoutingNumber:   routing.RoutingNumber,
+			InstitutionCode: routing.Institution.Code,
+		})
+	}
+	return plan
+}
+
+// institutionDiff lists the directory details of an entry that differ from
+// the stored institution, e.g. "city NEW YORK -> BROOKLYN". Details the
+// file does not carry are not compared.
+func institutionDiff(institution *directoryInstitution, entry *DirectoryEntry) []string {
+	fields := []struct {
+		name          string
+		stored, entry string
+	}{
+		{"name", institution.Name, entry.Name},
+		{"short_name", institution.ShortName, entry.ShortName},
+		{"street_address_1", institution.Address, entry.Address},
+		{"city", institution.City, entry.City},
+		{"state_province", institution.State, entry.State},
+		{"postal_code", institution.PostalCode, entry.PostalCode},
+		{"phone_number", institution.Phone, entry.Phone},
+	}
+
+	diff := []string{}
+	for _, f := range fields {
+		if f.entry != "" && f.entry != f.stored {
+			diff = append(diff, fmt.Sprintf("%s %s -> %s", f.name, f.stored, f.entry))
+		}
+	}
+	return diff
+}
+
+// ImportRoutingDirectory imports a FedACH or Fedwire routing directory
+// file. FedACH records maintain ach routing numbers and Fedwire records
+// fedwire routing numbers. Unreadable lines are reported and the rest are
+// imported, but removed routing numbers are only flagged when every line
+// was read, so a damaged file cannot flag thousands of numbers. A dry run
+// reports the same changes without applying them.
+// Spec: docs/specs/010-routing-directory.md
+func (im *InstitutionManager) ImportRoutingDirectory(ctx context.Context, req *pb.ImportRoutingDirectoryRequest) (*pb.ImportRoutingDirectoryResponse, error) {
+	if len(req.Content) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+
+	format := req.Format
+	if format == pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_UNSPECIFIED {
+		detected, err := DetectDirectoryFormat(req.Content)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid directory file: %v", err)
+		}
+		format = detected
+	}
+	routingType := "ach"
+	if format == pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDWIRE {
+		routingType = "fedwire"
+	}
+
+	entries, skipped, errors, err := ParseRoutingDirectory(format, bytes.NewReader(req.Content))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid directory file: %v", err)
+	}
+
+	updatedBy := req.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = "system"
+	}
+
+	tx, err := im.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	stored, err := loadStoredRoutings(ctx, tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load routing numbers: %v", err)
+	}
+
+	plan := planRoutingDirectory(routingType, entries, stored, len(errors) == 0)
+	resp := &pb.ImportRoutingDirectoryResponse{
+		Format:                 format,
+		RecordCount:            int32(len(entries)),
+		InstitutionsCreated:    int32(len(plan.Creates)),
+		InstitutionsUpdated:    int32(len(plan.Updates)),
+		RoutingNumbersAdded:    int32(len(plan.Adds)),
+		RoutingNumbersRemoved:  int32(len(plan.Removed)),
+		RoutingNumbersRestored: int32(len(plan.Restored)),
+		UnchangedCount:         int32(plan.Unchanged),
+		SkippedCount:           int32(skipped),
+		Errors:                 errors,
+		Changes:                plan.Changes,
+		DryRun:                 req.DryRun,
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+
+	if err := applyDirectoryPlan(ctx, tx, plan, routingType, updatedBy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import routing directory: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit transaction: %v", err)
+	}
+
+	return resp, nil
+}
+
+// loadStoredRoutings reads every routing number of institutions that are
+// not deleted
+func loadStoredRoutings(ctx context.Context, tx *sql.Tx) ([]*storedRouting, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT r.id, r.routing_number, r.routing_type, r.removed_from_directory_at IS NOT NULL,
+			i.id, i.code, i.name, i.short_name, i.street_address_1, i.city, i.state_province,
+			i.postal_code, i.phone_number
+		FROM treasury.institution_routing_numbers r
+		JOIN treasury.financial_institutions i ON i.id = r.institution_id
+		WHERE i.status != 'deleted'
+		ORDER BY r.routing_number, i.code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	institutions := map[string]*directoryInstitution{}
+	stored := []*storedRouting{}
+	for rows.Next() {
+		var (
+			routing                                 storedRouting
+			institution                             directoryInstitution
+			shortName, address, city, state, postal sql.NullString
+			phone                                   sql.NullString
+		)
+		err := rows.Scan(
+			&routing.ID, &routing.RoutingNumber, &routing.RoutingType, &routing.Removed,
+			&institution.ID, &institution.Code, &institution.Name, &shortName, &address, &city, &state,
+			&postal, &phone,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if existing, ok := institutions[institution.ID]; ok {
+			routing.Institution = existing
+		} else {
+			institution.ShortName = shortName.String
+			institution.Address = address.String
+			institution.City = city.String
+			institution.State = state.String
+			institution.PostalCode = postal.String
+			institution.Phone = phone.String
+			institutions[institution.ID] = &institution
+			routing.Institution = &institution
+		}
+		stored = append(stored, &routing)
+	}
+	return stored, rows.Err()
+}
+
+// applyDirectoryPlan writes a directory plan
+func applyDirectoryPlan(ctx context.Context, tx *sql.Tx, plan *directoryPlan, routingType, updatedBy string) error {
+	description := "FedACH directory"
+	if routingType == "fedwire" {
+		description = "Fedwire directory"
+	}
+
+	for _, entry := range plan.Creates {
+		// An institution left with the code after its routing numbers were
+		// removed by hand is reused
+		var institutionID string
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO treasury.financial_institutions (
+				id, code, name, short_name, institution_type, country_code, primary_currency,
+				street_address_1, city, state_province, postal_code, phone_number,
+				status, is_active, activated_at, created_at, updated_at, created_by, updated_by, version
+			) VALUES (
+				$1, $2, $3, $4, 'bank', 'US', 'USD',
+				$5, $6, $7, $8, $9,
+				'active', true, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, $10, $10, 1
+			)
+			ON CONFLICT (code) DO UPDATE SET updated_at = treasury.financial_institutions.updated_at
+			RETURNING id`,
+			uuid.New(), directoryCodePrefix+entry.RoutingNumber, entry.Name, nullString(entry.ShortName),
+			nullString(entry.Address), nullString(entry.City), nullString(entry.State),
+			nullString(entry.PostalCode), nullString(entry.Phone), updatedBy,
+		).Scan(&institutionID)
+		if err != nil {
+			return fmt.Errorf("line %d: failed to create institution: %w", entry.Line, err)
+		}
+		if err := insertDirectoryRouting(ctx, tx, institutionID, entry, routingType, description, true); err != nil {
+			return err
+		}
+	}
+
+	for _, add := range plan.Adds {
+		if err := insertDirectoryRouting(ctx, tx, add.Institution.ID, add.Entry, routingType, description, false); err != nil {
+			return err
+		}
+	}
+
+	for _, update := range plan.Updates {
+		entry := update.Entry
+		_, err := tx.ExecContext(ctx, `
+			UPDATE treasury.financial_institutions
+			SET name = $1,
+				short_name = COALESCE($2, short_name),
+				street_address_1 = COALESCE($3, street_address_1),
+				city = COALESCE($4, city),
+				state_province = COALESCE($5, state_province),
+				postal_code = COALESCE($6, postal_code),
+				phone_number = COALESCE($7, phone_number),
+				updated_at = CURRENT_TIMESTAMP,
+				updated_by = $8,
+				version = version + 1
+			WHERE id = $9`,
+			entry.Name, nullString(entry.ShortName), nullString(entry.Address), nullString(entry.City),
+			nullString(entry.State), nullString(entry.PostalCode), nullString(entry.Phone),
+			updatedBy, update.Institution.ID)
+		if err != nil {
+			return fmt.Errorf("line %d: failed to update institution %s: %w", entry.Line, update.Institution.Code, err)
+		}
+	}
+
+	// Flag and restore routing numbers in one statement each
+	// Spec: docs/specs/010-routing-directory.md#removed-routing-numbers
+	if len(plan.Removed) > 0 {
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE treasury.institution_routing_numbers SET removed_from_directory_at = CURRENT_TIMESTAMP WHERE id = ANY($1)",
+			pq.Array(routingIDs(plan.Removed))); err != nil {
+			return fmt.Errorf("failed to flag removed routing numbers: %w", err)
+		}
+	}
+	if len(plan.Restored) > 0 {
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE treasury.institution_routing_numbers SET removed_from_directory_at = NULL WHERE id = ANY($1)",
+			pq.Array(routingIDs(plan.Restored))); err != nil {
+			return fmt.Errorf("failed to restore routing numbers: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// insertDirectoryRouting adds a directory routing number to an institution
+func insertDirectoryRouting(ctx context.Context, tx *sql.Tx, institutionID string, entry *DirectoryEntry, routingType, description string, isPrimary bool) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO treasury.institution_routing_numbers (
+			id, institution_id, routing_number, routing_type, is_primary, description
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (institution_id, routing_number, routing_type) DO NOTHING`,
+		uuid.New(), institutionID, entry.RoutingNumber, routingType, isPrimary, description)
+	if err != nil {
+		return fmt.Errorf("line %d: failed to add routing number %s: %w", entry.Line, entry.RoutingNumber, err)
+	}
+	return nil
+}
+
+// routingIDs returns the IDs of stored routing numbers
+func routingIDs(routings []*storedRouting) []string {
+	ids := make([]string, 0, len(routings))
+	for _, routing := range routings {
+		ids = append(ids, routing.ID)
+	}
+	return ids
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	pb "example.com/go-mono-repo/proto/treasury"
+)
+
+// fedACHRecord builds a 155-character FedACH directory record
+func fedACHRecord(routing, name, address, city, state, zip, zipExt, phone string) string {
+	return fmt.Sprintf("%-9s%-1s%-9s%-1s%-6s%-9s%-36s%-36s%-20s%-2s%-5s%-4s%-10s%-1s%-1s%-5s",
+		routing, "O", "011000015", "0", "122415", "000000000", name, address, city, state, zip, zipExt, phone, "1", "1", "")
+}
+
+// fedwireRecord builds a 101-character Fedwire directory record
+func fedwireRecord(routing, telegraphicName, name, state, city, eligible string) string {
+	return fmt.Sprintf("%-9s%-18s%-36s%-2s%-25s%-1s%-1s%-1s%-8s",
+		routing, telegraphicName, name, state, city, eligible, " ", "Y", "20241215")
+}
+
+// TestDetectDirectoryFormat tests detecting the format from the record length
+// Spec: docs/specs/010-routing-directory.md#file-formats
+func TestDetectDirectoryFormat(t *testing.T) {
+	ach := fedACHRecord("021000021", "JPMORGAN CHASE BANK, NA", "", "TAMPA", "FL", "33610", "0000", "8132884000")
+	wire := fedwireRecord("021000021", "JPMCHASE", "JPMORGAN CHASE BANK, NA", "NY", "NEW YORK", "Y")
+
+	tests := []struct {
+		name    string
+		content string
+		want    pb.RoutingDirectoryFormat
+		wantErr bool
+	}{
+		{"fedach", "\n" + ach + "\r\n", pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDACH, false},
+		{"fedach without filler", strings.TrimRight(ach, " "), pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDACH, false},
+		{"fedwire", wire, pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDWIRE, false},
+		{"unknown length", "021000021,JPMORGAN", 0, true},
+		{"empty", "\n\n", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectDirectoryFormat([]byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DetectDirectoryFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DetectDirectoryFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseFedACHDirectory tests reading FedACH records and rejecting bad lines
+// Spec: docs/specs/010-routing-directory.md#file-formats
+func TestParseFedACHDirectory(t *testing.T) {
+	content := strings.Join([]string{
+		fedACHRecord("021000021", "JPMORGAN CHASE BANK, NA", "10430 HIGHLAND MANOR DR", "TAMPA", "FL", "33610", "0000", "8132884000"),
+		fedACHRecord("026009593", "BANK OF AMERICA, N.A.", "8001 VILLA PARK DRIVE", "HENRICO", "VA", "23228", "1234", "8004466362"),
+		fedACHRecord("021000022", "BAD CHECK DIGIT BANK", "", "NEW YORK", "NY", "10001", "0000", ""),
+		fedACHRecord("021000021", "JPMORGAN CHASE BANK, NA", "", "TAMPA", "FL", "33610", "0000", ""),
+		fedACHRecord("011000138", "BANK OF AMERICA, N.A.", "", "BOSTON", "MA", "02110", "0000", "") + "EXTRA",
+	}, "\n")
+
+	entries, skipped, errors, err := ParseRoutingDirectory(pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDACH, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseRoutingDirectory() error = %v", err)
+	}
+	if skipped != 0 {
+		t.Errorf("ParseRoutingDirectory() skipped = %d, want 0", skipped)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("ParseRoutingDirectory() returned %d entries, want 2", len(entries))
+	}
+
+	want := DirectoryEntry{
+		Line:          2,
+		RoutingNumber: "026009593",
+		Name:          "BANK OF AMERICA, N.A.",
+		Address:       "8001 VILLA PARK DRIVE",
+		City:          "HENRICO",
+		State:         "VA",
+		PostalCode:    "23228-1234",
+		Phone:         "8004466362",
+	}
+	if *entries[1] != want {
+		t.Errorf("ParseRoutingDirectory() entry = %+v, want %+v", *entries[1], want)
+	}
+	if entries[0].PostalCode != "33610" {
+		t.Errorf("ParseRoutingDirectory() postal code = %q, want 33610", entries[0].PostalCode)
+	}
+
+	wantErrors := []string{
+		"line 3: invalid routing number 021000022",
+		"line 4: duplicate routing number 021000021, first on line 1",
+		"line 5: record must be 155 characters, got 160",
+	}
+	if len(errors) != len(wantErrors) {
+		t.Fatalf("ParseRoutingDirectory() errors = %v, want %d errors", errors, len(wantErrors))
+	}
+	for i, prefix := range wantErrors {
+		if !strings.HasPrefix(errors[i], prefix) {
+			t.Errorf("ParseRoutingDirectory() error %d = %q, want prefix %q", i, errors[i], prefix)
+		}
+	}
+}
+
+// TestParseFedwireDirectory tests reading Fedwire records and skipping
+// institutions that cannot receive funds transfers
+// Spec: docs/specs/010-routing-directory.md#file-formats
+func TestParseFedwireDirectory(t *testing.T) {
+	content := strings.Join([]string{
+		fedwireRecord("021000021", "JPMCHASE", "JPMORGAN CHASE BANK, NA", "NY", "NEW YORK", "Y"),
+		fedwireRecord("026009593", "BK AMER NYC", "BANK OF AMERICA, N.A.", "NY", "NEW YORK", "N"),
+	}, "\n")
+
+	entries, skipped, errors, err := ParseRoutingDirectory(pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDWIRE, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("ParseRoutingDirectory() error = %v", err)
+	}
+	if len(errors) != 0 {
+		t.Errorf("ParseRoutingDirectory() errors = %v, want none", errors)
+	}
+	if skipped != 1 {
+		t.Errorf("ParseRoutingDirectory() skipped = %d, want 1", skipped)
+	}
+
+	want := DirectoryEntry{
+		Line:          1,
+		RoutingNumber: "021000021",
+		Name:          "JPMORGAN CHASE BANK, NA",
+		ShortName:     "JPMCHASE",
+		City:          "NEW YORK",
+		State:         "NY",
+	}
+	if len(entries) != 1 || *entries[0] != want {
+		t.Errorf("ParseRoutingDirectory() entries = %v, want [%+v]", entries, want)
+	}
+}
+
+// TestPlanRoutingDirectory tests comparing a directory with stored routing numbers
+// Spec: docs/specs/010-routing-directory.md#import-plan
+func TestPlanRoutingDirectory(t *testing.T) {
+	imported := &directoryInstitution{ID: "1", Code: "ABA021000021", Name: "JPMORGAN CHASE BANK, NA", City: "TAMPA", State: "FL"}
+	manual := &directoryInstitution{ID: "2", Code: "BOFA", Name: "Bank of America", City: "Charlotte"}
+	closed := &directoryInstitution{ID: "3", Code: "ABA011000138", Name: "OLD BANK", City: "BOSTON"}
+	flagged := &directoryInstitution{ID: "4", Code: "ABA122000247", Name: "WELLS FARGO BANK", City: "MINNEAPOLIS"}
+	stored := []*storedRouting{
+		{ID: "r1", RoutingNumber: "021000021", RoutingType: "ach", Institution: imported},
+		{ID: "r2", RoutingNumber: "026009593", RoutingType: "standard", Institution: manual},
+		{ID: "r3", RoutingNumber: "011000138", RoutingType: "ach", Institution: closed},
+		{ID: "r4", RoutingNumber: "011000138", RoutingType: "fedwire", Institution: closed},
+		{ID: "r5", RoutingNumber: "122000247", RoutingType: "ach", Removed: true, Institution: flagged},
+	}
+	entries := []*DirectoryEntry{
+		{Line: 1, RoutingNumber: "021000021", Name: "JPMORGAN CHASE BANK, NA", City: "BRANDON", State: "FL"},
+		{Line: 2, RoutingNumber: "026009593", Name: "BANK OF AMERICA, N.A.", City: "HENRICO"},
+		{Line: 3, RoutingNumber: "122000247", Name: "WELLS FARGO BANK", City: "MINNEAPOLIS"},
+		{Line: 4, RoutingNumber: "031100209", Name: "CITIBANK, NA", City: "NEW CASTLE"},
+	}
+
+	plan := planRoutingDirectory("ach", entries, stored, true)
+
+	if len(plan.Creates) != 1 || plan.Creates[0].RoutingNumber != "031100209" {
+		t.Errorf("Creates = %v, want 031100209", plan.Creates)
+	}
+	if len(plan.Updates) != 1 || plan.Updates[0].Institution != imported {
+		t.Errorf("Updates = %v, want ABA021000021 only", plan.Updates)
+	}
+	if len(plan.Adds) != 1 || plan.Adds[0].Institution != manual {
+		t.Errorf("Adds = %v, want an ach routing number for BOFA", plan.Adds)
+	}
+	if len(plan.Restored) != 1 || plan.Restored[0].ID != "r5" {
+		t.Errorf("Restored = %v, want r5", plan.Restored)
+	}
+	if len(plan.Removed) != 1 || plan.Removed[0].ID != "r3" {
+		t.Errorf("Removed = %v, want r3", plan.Removed)
+	}
+	if plan.Unchanged != 0 {
+		t.Errorf("Unchanged = %d, want 0", plan.Unchanged)
+	}
+
+	wantChanges := []string{
+		"ROUTING_DIRECTORY_CHANGE_TYPE_UPDATE_INSTITUTION 021000021 ABA021000021 city TAMPA -> BRANDON",
+		"ROUTING_DIRECTORY_CHANGE_TYPE_ADD_ROUTING_NUMBER 026009593 BOFA ach",
+		"ROUTING_DIRECTORY_CHANGE_TYPE_RESTORE 122000247 ABA122000247 ",
+		"ROUTING_DIRECTORY_CHANGE_TYPE_CREATE_INSTITUTION 031100209 ABA031100209 CITIBANK, NA",
+		"ROUTING_DIRECTORY_CHANGE_TYPE_FLAG_REMOVED 011000138 ABA011000138 ",
+	}
+	if len(plan.Changes) != len(wantChanges) {
+		t.Fatalf("Changes = %v, want %d changes", plan.Changes, len(wantChanges))
+	}
+	for i, change := range plan.Changes {
+		got := fmt.Sprintf("%s %s %s %s", change.Type, change.RoutingNumber, change.InstitutionCode, change.Detail)
+		if got != wantChanges[i] {
+			t.Errorf("change %d = %q, want %q", i, got, wantChanges[i])
+		}
+	}
+
+	// A file with rejected lines does not flag missing routing numbers
+	plan = planRoutingDirectory("ach", entries, stored, false)
+	if len(plan.Removed) != 0 {
+		t.Errorf("Removed = %v, want none when flagging is off", plan.Removed)
+	}
+}
+
+// TestImportRoutingDirectoryDryRun tests that a dry run reports changes and
+// rolls back
+// Spec: docs/specs/010-routing-directory.md#dry-run
+func TestImportRoutingDirectoryDryRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM treasury.institution_routing_numbers r").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "routing_number", "routing_type", "removed",
+			"id", "code", "name", "short_name", "street_address_1", "city", "state_province",
+			"postal_code", "phone_number",
+		}).AddRow("r1", "026009593", "ach", false, "i1", "ABA026009593", "BANK OF AMERICA, N.A.", nil, nil, "HENRICO", "VA", nil, nil))
+	mock.ExpectRollback()
+
+	manager := NewInstitutionManager(db, nil)
+	resp, err := manager.ImportRoutingDirectory(context.Background(), &pb.ImportRoutingDirectoryRequest{
+		Content: []byte(fedACHRecord("021000021", "JPMORGAN CHASE BANK, NA", "", "TAMPA", "FL", "33610", "0000", "")),
+		DryRun:  true,
+	})
+	if err != nil {
+		t.Fatalf("ImportRoutingDirectory() error = %v", err)
+	}
+	if resp.Format != pb.RoutingDirectoryFormat_ROUTING_DIRECTORY_FORMAT_FEDACH {
+		t.Errorf("ImportRoutingDirectory() format = %v, want FEDACH", resp.Format)
+	}
+	if !resp.DryRun || resp.RecordCount != 1 || resp.InstitutionsCreated != 1 || resp.RoutingNumbersRemoved != 1 {
+		t.Errorf("ImportRoutingDirectory() = %+v, want 1 record, 1 created and 1 removed", resp)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}